@@ -13,11 +13,11 @@ import (
 
 const createAccountApiKey = `-- name: CreateAccountApiKey :one
 INSERT INTO neosync_api.account_api_keys (
-  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id
+  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id, scopes, job_ids
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
-RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id
+RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, job_ids
 `
 
 type CreateAccountApiKeyParams struct {
@@ -28,6 +28,8 @@ type CreateAccountApiKeyParams struct {
 	CreatedByID pgtype.UUID
 	UpdatedByID pgtype.UUID
 	UserID      pgtype.UUID
+	Scopes      []string
+	JobIds      []pgtype.UUID
 }
 
 func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error) {
@@ -39,6 +41,8 @@ func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAc
 		arg.CreatedByID,
 		arg.UpdatedByID,
 		arg.UserID,
+		arg.Scopes,
+		arg.JobIds,
 	)
 	var i NeosyncApiAccountApiKey
 	err := row.Scan(
@@ -52,12 +56,14 @@ func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAc
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.JobIds,
 	)
 	return i, err
 }
 
 const getAccountApiKeyById = `-- name: GetAccountApiKeyById :one
-SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id from neosync_api.account_api_keys WHERE id = $1
+SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, job_ids from neosync_api.account_api_keys WHERE id = $1
 `
 
 func (q *Queries) GetAccountApiKeyById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountApiKey, error) {
@@ -74,12 +80,14 @@ func (q *Queries) GetAccountApiKeyById(ctx context.Context, db DBTX, id pgtype.U
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.JobIds,
 	)
 	return i, err
 }
 
 const getAccountApiKeyByKeyValue = `-- name: GetAccountApiKeyByKeyValue :one
-SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id from neosync_api.account_api_keys WHERE key_value = $1
+SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, job_ids from neosync_api.account_api_keys WHERE key_value = $1
 `
 
 func (q *Queries) GetAccountApiKeyByKeyValue(ctx context.Context, db DBTX, keyValue string) (NeosyncApiAccountApiKey, error) {
@@ -96,6 +104,8 @@ func (q *Queries) GetAccountApiKeyByKeyValue(ctx context.Context, db DBTX, keyVa
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.JobIds,
 	)
 	return i, err
 }
@@ -126,6 +136,8 @@ func (q *Queries) GetAccountApiKeys(ctx context.Context, db DBTX, accountid pgty
 			&i.ExpiresAt,
 			&i.KeyName,
 			&i.UserID,
+			&i.Scopes,
+			&i.JobIds,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +183,7 @@ SET key_value = $1,
     expires_at = $2,
     updated_by_id = $3
 WHERE id = $4
-RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id
+RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, job_ids
 `
 
 type UpdateAccountApiKeyValueParams struct {
@@ -200,6 +212,8 @@ func (q *Queries) UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg Upd
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.JobIds,
 	)
 	return i, err
 }
@@ -69,6 +69,39 @@ func (q *Queries) CreateConnection(ctx context.Context, db DBTX, arg CreateConne
 	return i, err
 }
 
+const getAllConnections = `-- name: GetAllConnections :many
+SELECT id, created_at, updated_at, name, account_id, connection_config, created_by_id, updated_by_id from neosync_api.connections ORDER BY created_at
+`
+
+func (q *Queries) GetAllConnections(ctx context.Context, db DBTX) ([]NeosyncApiConnection, error) {
+	rows, err := db.Query(ctx, getAllConnections)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiConnection
+	for rows.Next() {
+		var i NeosyncApiConnection
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AccountID,
+			&i.ConnectionConfig,
+			&i.CreatedByID,
+			&i.UpdatedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getConnectionById = `-- name: GetConnectionById :one
 SELECT id, created_at, updated_at, name, account_id, connection_config, created_by_id, updated_by_id from neosync_api.connections WHERE id = $1
 `
@@ -279,3 +312,23 @@ func (q *Queries) UpdateConnection(ctx context.Context, db DBTX, arg UpdateConne
 	)
 	return i, err
 }
+
+const updateConnectionConfig = `-- name: UpdateConnectionConfig :execrows
+UPDATE neosync_api.connections
+SET connection_config = $1
+WHERE id = $2 AND connection_config = $3
+`
+
+type UpdateConnectionConfigParams struct {
+	ConnectionConfig         *pg_models.ConnectionConfig
+	ID                       pgtype.UUID
+	PreviousConnectionConfig *pg_models.ConnectionConfig
+}
+
+func (q *Queries) UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) (int64, error) {
+	result, err := db.Exec(ctx, updateConnectionConfig, arg.ConnectionConfig, arg.ID, arg.PreviousConnectionConfig)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -82,6 +82,112 @@ func (_c *MockQuerier_AreConnectionsInAccount_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// BackfillJobRun provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) BackfillJobRun(ctx context.Context, db DBTX, arg BackfillJobRunParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillJobRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, BackfillJobRunParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_BackfillJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillJobRun'
+type MockQuerier_BackfillJobRun_Call struct {
+	*mock.Call
+}
+
+// BackfillJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg BackfillJobRunParams
+func (_e *MockQuerier_Expecter) BackfillJobRun(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_BackfillJobRun_Call {
+	return &MockQuerier_BackfillJobRun_Call{Call: _e.mock.On("BackfillJobRun", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_BackfillJobRun_Call) Run(run func(ctx context.Context, db DBTX, arg BackfillJobRunParams)) *MockQuerier_BackfillJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(BackfillJobRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_BackfillJobRun_Call) Return(_a0 error) *MockQuerier_BackfillJobRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_BackfillJobRun_Call) RunAndReturn(run func(context.Context, DBTX, BackfillJobRunParams) error) *MockQuerier_BackfillJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseJobRun provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CloseJobRun(ctx context.Context, db DBTX, arg CloseJobRunParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseJobRun")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CloseJobRunParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CloseJobRunParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CloseJobRunParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CloseJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseJobRun'
+type MockQuerier_CloseJobRun_Call struct {
+	*mock.Call
+}
+
+// CloseJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CloseJobRunParams
+func (_e *MockQuerier_Expecter) CloseJobRun(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CloseJobRun_Call {
+	return &MockQuerier_CloseJobRun_Call{Call: _e.mock.On("CloseJobRun", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CloseJobRun_Call) Run(run func(ctx context.Context, db DBTX, arg CloseJobRunParams)) *MockQuerier_CloseJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CloseJobRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CloseJobRun_Call) Return(_a0 int64, _a1 error) *MockQuerier_CloseJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CloseJobRun_Call) RunAndReturn(run func(context.Context, DBTX, CloseJobRunParams) (int64, error)) *MockQuerier_CloseJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAccountApiKey provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -256,6 +362,54 @@ func (_c *MockQuerier_CreateAccountUserAssociation_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CreateAuditEvent provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateAuditEventParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type MockQuerier_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateAuditEventParams
+func (_e *MockQuerier_Expecter) CreateAuditEvent(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateAuditEvent_Call {
+	return &MockQuerier_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Run(run func(ctx context.Context, db DBTX, arg CreateAuditEventParams)) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateAuditEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Return(_a0 error) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) RunAndReturn(run func(context.Context, DBTX, CreateAuditEventParams) error) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConnection provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -546,6 +700,102 @@ func (_c *MockQuerier_CreateJobConnectionDestinations_Call) RunAndReturn(run fun
 	return _c
 }
 
+// CreateJobNotificationSubscription provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobNotificationSubscription(ctx context.Context, db DBTX, arg CreateJobNotificationSubscriptionParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobNotificationSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobNotificationSubscriptionParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateJobNotificationSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobNotificationSubscription'
+type MockQuerier_CreateJobNotificationSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateJobNotificationSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateJobNotificationSubscriptionParams
+func (_e *MockQuerier_Expecter) CreateJobNotificationSubscription(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobNotificationSubscription_Call {
+	return &MockQuerier_CreateJobNotificationSubscription_Call{Call: _e.mock.On("CreateJobNotificationSubscription", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateJobNotificationSubscription_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobNotificationSubscriptionParams)) *MockQuerier_CreateJobNotificationSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobNotificationSubscriptionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobNotificationSubscription_Call) Return(_a0 error) *MockQuerier_CreateJobNotificationSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobNotificationSubscription_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobNotificationSubscriptionParams) error) *MockQuerier_CreateJobNotificationSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJobRunLogs provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobRunLogs(ctx context.Context, db DBTX, arg CreateJobRunLogsParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunLogsParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateJobRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunLogs'
+type MockQuerier_CreateJobRunLogs_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateJobRunLogsParams
+func (_e *MockQuerier_Expecter) CreateJobRunLogs(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobRunLogs_Call {
+	return &MockQuerier_CreateJobRunLogs_Call{Call: _e.mock.On("CreateJobRunLogs", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateJobRunLogs_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobRunLogsParams)) *MockQuerier_CreateJobRunLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobRunLogsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobRunLogs_Call) Return(_a0 error) *MockQuerier_CreateJobRunLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobRunLogs_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobRunLogsParams) error) *MockQuerier_CreateJobRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateMachineUser provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
 	ret := _m.Called(ctx, db)
@@ -660,6 +910,64 @@ func (_c *MockQuerier_CreateNonMachineUser_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CreateNotificationChannel provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateNotificationChannel(ctx context.Context, db DBTX, arg CreateNotificationChannelParams) (NeosyncApiNotificationChannel, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationChannel")
+	}
+
+	var r0 NeosyncApiNotificationChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationChannelParams) (NeosyncApiNotificationChannel, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationChannelParams) NeosyncApiNotificationChannel); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationChannel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateNotificationChannelParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateNotificationChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationChannel'
+type MockQuerier_CreateNotificationChannel_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateNotificationChannelParams
+func (_e *MockQuerier_Expecter) CreateNotificationChannel(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateNotificationChannel_Call {
+	return &MockQuerier_CreateNotificationChannel_Call{Call: _e.mock.On("CreateNotificationChannel", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateNotificationChannel_Call) Run(run func(ctx context.Context, db DBTX, arg CreateNotificationChannelParams)) *MockQuerier_CreateNotificationChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateNotificationChannelParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationChannel_Call) Return(_a0 NeosyncApiNotificationChannel, _a1 error) *MockQuerier_CreateNotificationChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationChannel_Call) RunAndReturn(run func(context.Context, DBTX, CreateNotificationChannelParams) (NeosyncApiNotificationChannel, error)) *MockQuerier_CreateNotificationChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePersonalAccount provides a mock function with given fields: ctx, db, accountSlug
 func (_m *MockQuerier) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, accountSlug)
@@ -882,6 +1190,64 @@ func (_c *MockQuerier_DeleteJob_Call) RunAndReturn(run func(context.Context, DBT
 	return _c
 }
 
+// DeleteJobRunLogsOlderThan provides a mock function with given fields: ctx, db, before
+func (_m *MockQuerier) DeleteJobRunLogsOlderThan(ctx context.Context, db DBTX, before pgtype.Timestamp) (int64, error) {
+	ret := _m.Called(ctx, db, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJobRunLogsOlderThan")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.Timestamp) (int64, error)); ok {
+		return rf(ctx, db, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.Timestamp) int64); ok {
+		r0 = rf(ctx, db, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.Timestamp) error); ok {
+		r1 = rf(ctx, db, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeleteJobRunLogsOlderThan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJobRunLogsOlderThan'
+type MockQuerier_DeleteJobRunLogsOlderThan_Call struct {
+	*mock.Call
+}
+
+// DeleteJobRunLogsOlderThan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - before pgtype.Timestamp
+func (_e *MockQuerier_Expecter) DeleteJobRunLogsOlderThan(ctx interface{}, db interface{}, before interface{}) *MockQuerier_DeleteJobRunLogsOlderThan_Call {
+	return &MockQuerier_DeleteJobRunLogsOlderThan_Call{Call: _e.mock.On("DeleteJobRunLogsOlderThan", ctx, db, before)}
+}
+
+func (_c *MockQuerier_DeleteJobRunLogsOlderThan_Call) Run(run func(ctx context.Context, db DBTX, before pgtype.Timestamp)) *MockQuerier_DeleteJobRunLogsOlderThan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.Timestamp))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteJobRunLogsOlderThan_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeleteJobRunLogsOlderThan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteJobRunLogsOlderThan_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.Timestamp) (int64, error)) *MockQuerier_DeleteJobRunLogsOlderThan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserDefinedTransformerById provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) DeleteUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
@@ -1398,6 +1764,64 @@ func (_c *MockQuerier_GetAccountUserAssociation_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetAccountUserRoleCount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountUserRoleCount(ctx context.Context, db DBTX, arg GetAccountUserRoleCountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountUserRoleCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserRoleCountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserRoleCountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserRoleCountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserRoleCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserRoleCount'
+type MockQuerier_GetAccountUserRoleCount_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserRoleCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountUserRoleCountParams
+func (_e *MockQuerier_Expecter) GetAccountUserRoleCount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserRoleCount_Call {
+	return &MockQuerier_GetAccountUserRoleCount_Call{Call: _e.mock.On("GetAccountUserRoleCount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAccountUserRoleCount_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountUserRoleCountParams)) *MockQuerier_GetAccountUserRoleCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAccountUserRoleCountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserRoleCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_GetAccountUserRoleCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserRoleCount_Call) RunAndReturn(run func(context.Context, DBTX, GetAccountUserRoleCountParams) (int64, error)) *MockQuerier_GetAccountUserRoleCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAccountsByUser provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, id)
@@ -1518,6 +1942,65 @@ func (_c *MockQuerier_GetActiveAccountInvites_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetAllConnections provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) GetAllConnections(ctx context.Context, db DBTX) ([]NeosyncApiConnection, error) {
+	ret := _m.Called(ctx, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllConnections")
+	}
+
+	var r0 []NeosyncApiConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) ([]NeosyncApiConnection, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) []NeosyncApiConnection); ok {
+		r0 = rf(ctx, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAllConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllConnections'
+type MockQuerier_GetAllConnections_Call struct {
+	*mock.Call
+}
+
+// GetAllConnections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) GetAllConnections(ctx interface{}, db interface{}) *MockQuerier_GetAllConnections_Call {
+	return &MockQuerier_GetAllConnections_Call{Call: _e.mock.On("GetAllConnections", ctx, db)}
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) RunAndReturn(run func(context.Context, DBTX) ([]NeosyncApiConnection, error)) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAnonymousUser provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
 	ret := _m.Called(ctx, db)
@@ -1575,6 +2058,66 @@ func (_c *MockQuerier_GetAnonymousUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetAuditEventsByAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAuditEventsByAccount(ctx context.Context, db DBTX, arg GetAuditEventsByAccountParams) ([]NeosyncApiAuditEvent, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEventsByAccount")
+	}
+
+	var r0 []NeosyncApiAuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsByAccountParams) ([]NeosyncApiAuditEvent, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsByAccountParams) []NeosyncApiAuditEvent); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAuditEventsByAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAuditEventsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEventsByAccount'
+type MockQuerier_GetAuditEventsByAccount_Call struct {
+	*mock.Call
+}
+
+// GetAuditEventsByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAuditEventsByAccountParams
+func (_e *MockQuerier_Expecter) GetAuditEventsByAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAuditEventsByAccount_Call {
+	return &MockQuerier_GetAuditEventsByAccount_Call{Call: _e.mock.On("GetAuditEventsByAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAuditEventsByAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetAuditEventsByAccountParams)) *MockQuerier_GetAuditEventsByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAuditEventsByAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEventsByAccount_Call) Return(_a0 []NeosyncApiAuditEvent, _a1 error) *MockQuerier_GetAuditEventsByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEventsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetAuditEventsByAccountParams) ([]NeosyncApiAuditEvent, error)) *MockQuerier_GetAuditEventsByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionById provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, id)
@@ -1691,6 +2234,66 @@ func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetConnectionColumnTags provides a mock function with given fields: ctx, db, connectionID
+func (_m *MockQuerier) GetConnectionColumnTags(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error) {
+	ret := _m.Called(ctx, db, connectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionColumnTags")
+	}
+
+	var r0 []NeosyncApiConnectionColumnTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)); ok {
+		return rf(ctx, db, connectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnectionColumnTag); ok {
+		r0 = rf(ctx, db, connectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnectionColumnTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, connectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionColumnTags'
+type MockQuerier_GetConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// GetConnectionColumnTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - connectionID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetConnectionColumnTags(ctx interface{}, db interface{}, connectionID interface{}) *MockQuerier_GetConnectionColumnTags_Call {
+	return &MockQuerier_GetConnectionColumnTags_Call{Call: _e.mock.On("GetConnectionColumnTags", ctx, db, connectionID)}
+}
+
+func (_c *MockQuerier_GetConnectionColumnTags_Call) Run(run func(ctx context.Context, db DBTX, connectionID pgtype.UUID)) *MockQuerier_GetConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetConnectionColumnTags_Call) Return(_a0 []NeosyncApiConnectionColumnTag, _a1 error) *MockQuerier_GetConnectionColumnTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetConnectionColumnTags_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)) *MockQuerier_GetConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionsByAccount provides a mock function with given fields: ctx, db, accountid
 func (_m *MockQuerier) GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, accountid)
@@ -2105,29 +2708,29 @@ func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) RunAndReturn(ru
 	return _c
 }
 
-// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetJobMappingPolicyConfigByAccount provides a mock function with given fields: ctx, db, accountID
+func (_m *MockQuerier) GetJobMappingPolicyConfigByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) (*pg_models.JobMappingPolicyConfig, error) {
+	ret := _m.Called(ctx, db, accountID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobsByAccount")
+		panic("no return value specified for GetJobMappingPolicyConfigByAccount")
 	}
 
-	var r0 []NeosyncApiJob
+	var r0 *pg_models.JobMappingPolicyConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.JobMappingPolicyConfig, error)); ok {
+		return rf(ctx, db, accountID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.JobMappingPolicyConfig); ok {
+		r0 = rf(ctx, db, accountID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiJob)
+			r0 = ret.Get(0).(*pg_models.JobMappingPolicyConfig)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, accountID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2135,57 +2738,59 @@ func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid
 	return r0, r1
 }
 
-// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
-type MockQuerier_GetJobsByAccount_Call struct {
+// MockQuerier_GetJobMappingPolicyConfigByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobMappingPolicyConfigByAccount'
+type MockQuerier_GetJobMappingPolicyConfigByAccount_Call struct {
 	*mock.Call
 }
 
-// GetJobsByAccount is a helper method to define mock.On call
+// GetJobMappingPolicyConfigByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
-	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
+//   - accountID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobMappingPolicyConfigByAccount(ctx interface{}, db interface{}, accountID interface{}) *MockQuerier_GetJobMappingPolicyConfigByAccount_Call {
+	return &MockQuerier_GetJobMappingPolicyConfigByAccount_Call{Call: _e.mock.On("GetJobMappingPolicyConfigByAccount", ctx, db, accountID)}
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobMappingPolicyConfigByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountID pgtype.UUID)) *MockQuerier_GetJobMappingPolicyConfigByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobMappingPolicyConfigByAccount_Call) Return(_a0 *pg_models.JobMappingPolicyConfig, _a1 error) *MockQuerier_GetJobMappingPolicyConfigByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobMappingPolicyConfigByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.JobMappingPolicyConfig, error)) *MockQuerier_GetJobMappingPolicyConfigByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetJobNotificationSubscriptions provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetJobNotificationSubscriptions(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobNotificationSubscription, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonalAccountByUserId")
+		panic("no return value specified for GetJobNotificationSubscriptions")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 []NeosyncApiJobNotificationSubscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobNotificationSubscription, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobNotificationSubscription); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobNotificationSubscription)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2193,56 +2798,56 @@ func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
-type MockQuerier_GetPersonalAccountByUserId_Call struct {
+// MockQuerier_GetJobNotificationSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobNotificationSubscriptions'
+type MockQuerier_GetJobNotificationSubscriptions_Call struct {
 	*mock.Call
 }
 
-// GetPersonalAccountByUserId is a helper method to define mock.On call
+// GetJobNotificationSubscriptions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
-	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobNotificationSubscriptions(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetJobNotificationSubscriptions_Call {
+	return &MockQuerier_GetJobNotificationSubscriptions_Call{Call: _e.mock.On("GetJobNotificationSubscriptions", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobNotificationSubscriptions_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetJobNotificationSubscriptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobNotificationSubscriptions_Call) Return(_a0 []NeosyncApiJobNotificationSubscription, _a1 error) *MockQuerier_GetJobNotificationSubscriptions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobNotificationSubscriptions_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobNotificationSubscription, error)) *MockQuerier_GetJobNotificationSubscriptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRunContextByKey provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetRunContextByKey(ctx context.Context, db DBTX, arg GetRunContextByKeyParams) (NeosyncApiRuncontext, error) {
+// GetJobRunById provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunById(ctx context.Context, db DBTX, arg GetJobRunByIdParams) (NeosyncApiJobRun, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRunContextByKey")
+		panic("no return value specified for GetJobRunById")
 	}
 
-	var r0 NeosyncApiRuncontext
+	var r0 NeosyncApiJobRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetRunContextByKeyParams) (NeosyncApiRuncontext, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunByIdParams) (NeosyncApiJobRun, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetRunContextByKeyParams) NeosyncApiRuncontext); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunByIdParams) NeosyncApiJobRun); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiRuncontext)
+		r0 = ret.Get(0).(NeosyncApiJobRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetRunContextByKeyParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunByIdParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2251,59 +2856,59 @@ func (_m *MockQuerier) GetRunContextByKey(ctx context.Context, db DBTX, arg GetR
 	return r0, r1
 }
 
-// MockQuerier_GetRunContextByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunContextByKey'
-type MockQuerier_GetRunContextByKey_Call struct {
+// MockQuerier_GetJobRunById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunById'
+type MockQuerier_GetJobRunById_Call struct {
 	*mock.Call
 }
 
-// GetRunContextByKey is a helper method to define mock.On call
+// GetJobRunById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetRunContextByKeyParams
-func (_e *MockQuerier_Expecter) GetRunContextByKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetRunContextByKey_Call {
-	return &MockQuerier_GetRunContextByKey_Call{Call: _e.mock.On("GetRunContextByKey", ctx, db, arg)}
+//   - arg GetJobRunByIdParams
+func (_e *MockQuerier_Expecter) GetJobRunById(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunById_Call {
+	return &MockQuerier_GetJobRunById_Call{Call: _e.mock.On("GetJobRunById", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetRunContextByKey_Call) Run(run func(ctx context.Context, db DBTX, arg GetRunContextByKeyParams)) *MockQuerier_GetRunContextByKey_Call {
+func (_c *MockQuerier_GetJobRunById_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunByIdParams)) *MockQuerier_GetJobRunById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetRunContextByKeyParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunByIdParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetRunContextByKey_Call) Return(_a0 NeosyncApiRuncontext, _a1 error) *MockQuerier_GetRunContextByKey_Call {
+func (_c *MockQuerier_GetJobRunById_Call) Return(_a0 NeosyncApiJobRun, _a1 error) *MockQuerier_GetJobRunById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetRunContextByKey_Call) RunAndReturn(run func(context.Context, DBTX, GetRunContextByKeyParams) (NeosyncApiRuncontext, error)) *MockQuerier_GetRunContextByKey_Call {
+func (_c *MockQuerier_GetJobRunById_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunByIdParams) (NeosyncApiJobRun, error)) *MockQuerier_GetJobRunById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetJobRunLogs provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunLogs(ctx context.Context, db DBTX, arg GetJobRunLogsParams) ([]NeosyncApiJobRunLog, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamAccountsByUserId")
+		panic("no return value specified for GetJobRunLogs")
 	}
 
-	var r0 []NeosyncApiAccount
+	var r0 []NeosyncApiJobRunLog
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunLogsParams) ([]NeosyncApiJobRunLog, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunLogsParams) []NeosyncApiJobRunLog); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiAccount)
+			r0 = ret.Get(0).([]NeosyncApiJobRunLog)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunLogsParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2311,32 +2916,626 @@ func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, use
 	return r0, r1
 }
 
-// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
-type MockQuerier_GetTeamAccountsByUserId_Call struct {
+// MockQuerier_GetJobRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunLogs'
+type MockQuerier_GetJobRunLogs_Call struct {
 	*mock.Call
 }
 
-// GetTeamAccountsByUserId is a helper method to define mock.On call
+// GetJobRunLogs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
-	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+//   - arg GetJobRunLogsParams
+func (_e *MockQuerier_Expecter) GetJobRunLogs(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunLogs_Call {
+	return &MockQuerier_GetJobRunLogs_Call{Call: _e.mock.On("GetJobRunLogs", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunLogs_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunLogsParams)) *MockQuerier_GetJobRunLogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunLogsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunLogs_Call) Return(_a0 []NeosyncApiJobRunLog, _a1 error) *MockQuerier_GetJobRunLogs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunLogs_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunLogsParams) ([]NeosyncApiJobRunLog, error)) *MockQuerier_GetJobRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRuns provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRuns(ctx context.Context, db DBTX, arg GetJobRunsParams) ([]NeosyncApiJobRun, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRuns")
+	}
+
+	var r0 []NeosyncApiJobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunsParams) ([]NeosyncApiJobRun, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunsParams) []NeosyncApiJobRun); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
+type MockQuerier_GetJobRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetJobRunsParams
+func (_e *MockQuerier_Expecter) GetJobRuns(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRuns_Call {
+	return &MockQuerier_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetJobRuns_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunsParams)) *MockQuerier_GetJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRuns_Call) Return(_a0 []NeosyncApiJobRun, _a1 error) *MockQuerier_GetJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRuns_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunsParams) ([]NeosyncApiJobRun, error)) *MockQuerier_GetJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunsByStatus provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunsByStatus(ctx context.Context, db DBTX, arg GetJobRunsByStatusParams) ([]NeosyncApiJobRun, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunsByStatus")
+	}
+
+	var r0 []NeosyncApiJobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunsByStatusParams) ([]NeosyncApiJobRun, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunsByStatusParams) []NeosyncApiJobRun); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunsByStatusParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobRunsByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunsByStatus'
+type MockQuerier_GetJobRunsByStatus_Call struct {
+	*mock.Call
+}
+
+// GetJobRunsByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetJobRunsByStatusParams
+func (_e *MockQuerier_Expecter) GetJobRunsByStatus(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunsByStatus_Call {
+	return &MockQuerier_GetJobRunsByStatus_Call{Call: _e.mock.On("GetJobRunsByStatus", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetJobRunsByStatus_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunsByStatusParams)) *MockQuerier_GetJobRunsByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunsByStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunsByStatus_Call) Return(_a0 []NeosyncApiJobRun, _a1 error) *MockQuerier_GetJobRunsByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunsByStatus_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunsByStatusParams) ([]NeosyncApiJobRun, error)) *MockQuerier_GetJobRunsByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobsByAccount")
+	}
+
+	var r0 []NeosyncApiJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
+type MockQuerier_GetJobsByAccount_Call struct {
+	*mock.Call
+}
+
+// GetJobsByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
+	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestJobRunLogs provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetLatestJobRunLogs(ctx context.Context, db DBTX, arg GetLatestJobRunLogsParams) ([]NeosyncApiJobRunLog, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestJobRunLogs")
+	}
+
+	var r0 []NeosyncApiJobRunLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetLatestJobRunLogsParams) ([]NeosyncApiJobRunLog, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetLatestJobRunLogsParams) []NeosyncApiJobRunLog); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetLatestJobRunLogsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetLatestJobRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestJobRunLogs'
+type MockQuerier_GetLatestJobRunLogs_Call struct {
+	*mock.Call
+}
+
+// GetLatestJobRunLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetLatestJobRunLogsParams
+func (_e *MockQuerier_Expecter) GetLatestJobRunLogs(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetLatestJobRunLogs_Call {
+	return &MockQuerier_GetLatestJobRunLogs_Call{Call: _e.mock.On("GetLatestJobRunLogs", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetLatestJobRunLogs_Call) Run(run func(ctx context.Context, db DBTX, arg GetLatestJobRunLogsParams)) *MockQuerier_GetLatestJobRunLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetLatestJobRunLogsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestJobRunLogs_Call) Return(_a0 []NeosyncApiJobRunLog, _a1 error) *MockQuerier_GetLatestJobRunLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestJobRunLogs_Call) RunAndReturn(run func(context.Context, DBTX, GetLatestJobRunLogsParams) ([]NeosyncApiJobRunLog, error)) *MockQuerier_GetLatestJobRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationChannelById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetNotificationChannelById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiNotificationChannel, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationChannelById")
+	}
+
+	var r0 NeosyncApiNotificationChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiNotificationChannel, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiNotificationChannel); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationChannel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationChannelById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationChannelById'
+type MockQuerier_GetNotificationChannelById_Call struct {
+	*mock.Call
+}
+
+// GetNotificationChannelById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetNotificationChannelById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetNotificationChannelById_Call {
+	return &MockQuerier_GetNotificationChannelById_Call{Call: _e.mock.On("GetNotificationChannelById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetNotificationChannelById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetNotificationChannelById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelById_Call) Return(_a0 NeosyncApiNotificationChannel, _a1 error) *MockQuerier_GetNotificationChannelById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiNotificationChannel, error)) *MockQuerier_GetNotificationChannelById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationChannelsByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetNotificationChannelsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiNotificationChannel, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationChannelsByAccount")
+	}
+
+	var r0 []NeosyncApiNotificationChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiNotificationChannel, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiNotificationChannel); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationChannel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationChannelsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationChannelsByAccount'
+type MockQuerier_GetNotificationChannelsByAccount_Call struct {
+	*mock.Call
+}
+
+// GetNotificationChannelsByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetNotificationChannelsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetNotificationChannelsByAccount_Call {
+	return &MockQuerier_GetNotificationChannelsByAccount_Call{Call: _e.mock.On("GetNotificationChannelsByAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetNotificationChannelsByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByAccount_Call) Return(_a0 []NeosyncApiNotificationChannel, _a1 error) *MockQuerier_GetNotificationChannelsByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiNotificationChannel, error)) *MockQuerier_GetNotificationChannelsByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationChannelsByJobEvent provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetNotificationChannelsByJobEvent(ctx context.Context, db DBTX, arg GetNotificationChannelsByJobEventParams) ([]NeosyncApiNotificationChannel, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationChannelsByJobEvent")
+	}
+
+	var r0 []NeosyncApiNotificationChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationChannelsByJobEventParams) ([]NeosyncApiNotificationChannel, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationChannelsByJobEventParams) []NeosyncApiNotificationChannel); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationChannel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetNotificationChannelsByJobEventParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationChannelsByJobEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationChannelsByJobEvent'
+type MockQuerier_GetNotificationChannelsByJobEvent_Call struct {
+	*mock.Call
+}
+
+// GetNotificationChannelsByJobEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetNotificationChannelsByJobEventParams
+func (_e *MockQuerier_Expecter) GetNotificationChannelsByJobEvent(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetNotificationChannelsByJobEvent_Call {
+	return &MockQuerier_GetNotificationChannelsByJobEvent_Call{Call: _e.mock.On("GetNotificationChannelsByJobEvent", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByJobEvent_Call) Run(run func(ctx context.Context, db DBTX, arg GetNotificationChannelsByJobEventParams)) *MockQuerier_GetNotificationChannelsByJobEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetNotificationChannelsByJobEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByJobEvent_Call) Return(_a0 []NeosyncApiNotificationChannel, _a1 error) *MockQuerier_GetNotificationChannelsByJobEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationChannelsByJobEvent_Call) RunAndReturn(run func(context.Context, DBTX, GetNotificationChannelsByJobEventParams) ([]NeosyncApiNotificationChannel, error)) *MockQuerier_GetNotificationChannelsByJobEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonalAccountByUserId")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
+type MockQuerier_GetPersonalAccountByUserId_Call struct {
+	*mock.Call
+}
+
+// GetPersonalAccountByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
+	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunContextByKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetRunContextByKey(ctx context.Context, db DBTX, arg GetRunContextByKeyParams) (NeosyncApiRuncontext, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunContextByKey")
+	}
+
+	var r0 NeosyncApiRuncontext
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetRunContextByKeyParams) (NeosyncApiRuncontext, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetRunContextByKeyParams) NeosyncApiRuncontext); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiRuncontext)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetRunContextByKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunContextByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunContextByKey'
+type MockQuerier_GetRunContextByKey_Call struct {
+	*mock.Call
+}
+
+// GetRunContextByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetRunContextByKeyParams
+func (_e *MockQuerier_Expecter) GetRunContextByKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetRunContextByKey_Call {
+	return &MockQuerier_GetRunContextByKey_Call{Call: _e.mock.On("GetRunContextByKey", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetRunContextByKey_Call) Run(run func(ctx context.Context, db DBTX, arg GetRunContextByKeyParams)) *MockQuerier_GetRunContextByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetRunContextByKeyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunContextByKey_Call) Return(_a0 NeosyncApiRuncontext, _a1 error) *MockQuerier_GetRunContextByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunContextByKey_Call) RunAndReturn(run func(context.Context, DBTX, GetRunContextByKeyParams) (NeosyncApiRuncontext, error)) *MockQuerier_GetRunContextByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamAccountsByUserId")
+	}
+
+	var r0 []NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
+type MockQuerier_GetTeamAccountsByUserId_Call struct {
+	*mock.Call
+}
+
+// GetTeamAccountsByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
+	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2754,23 +3953,23 @@ func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) RunAndReturn(run
 }
 
 // GetUserIdentitiesByTeamAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error) {
 	ret := _m.Called(ctx, db, accountid)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserIdentitiesByTeamAccount")
 	}
 
-	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r0 []GetUserIdentitiesByTeamAccountRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)); ok {
 		return rf(ctx, db, accountid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []GetUserIdentitiesByTeamAccountRow); ok {
 		r0 = rf(ctx, db, accountid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+			r0 = ret.Get(0).([]GetUserIdentitiesByTeamAccountRow)
 		}
 	}
 
@@ -2803,12 +4002,12 @@ func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []GetUserIdentitiesByTeamAccountRow, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3519,6 +4718,54 @@ func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) RunAndReturn(run fu
 	return _c
 }
 
+// RemoveConnectionColumnTags provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveConnectionColumnTags(ctx context.Context, db DBTX, arg RemoveConnectionColumnTagsParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionColumnTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveConnectionColumnTagsParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionColumnTags'
+type MockQuerier_RemoveConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionColumnTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveConnectionColumnTagsParams
+func (_e *MockQuerier_Expecter) RemoveConnectionColumnTags(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveConnectionColumnTags_Call {
+	return &MockQuerier_RemoveConnectionColumnTags_Call{Call: _e.mock.On("RemoveConnectionColumnTags", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveConnectionColumnTags_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveConnectionColumnTagsParams)) *MockQuerier_RemoveConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveConnectionColumnTagsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionColumnTags_Call) Return(_a0 error) *MockQuerier_RemoveConnectionColumnTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionColumnTags_Call) RunAndReturn(run func(context.Context, DBTX, RemoveConnectionColumnTagsParams) error) *MockQuerier_RemoveConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveJobById provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
@@ -3663,6 +4910,102 @@ func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run fun
 	return _c
 }
 
+// RemoveJobNotificationSubscriptions provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) RemoveJobNotificationSubscriptions(ctx context.Context, db DBTX, jobID pgtype.UUID) error {
+	ret := _m.Called(ctx, db, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobNotificationSubscriptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveJobNotificationSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobNotificationSubscriptions'
+type MockQuerier_RemoveJobNotificationSubscriptions_Call struct {
+	*mock.Call
+}
+
+// RemoveJobNotificationSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobNotificationSubscriptions(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_RemoveJobNotificationSubscriptions_Call {
+	return &MockQuerier_RemoveJobNotificationSubscriptions_Call{Call: _e.mock.On("RemoveJobNotificationSubscriptions", ctx, db, jobID)}
+}
+
+func (_c *MockQuerier_RemoveJobNotificationSubscriptions_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_RemoveJobNotificationSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobNotificationSubscriptions_Call) Return(_a0 error) *MockQuerier_RemoveJobNotificationSubscriptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobNotificationSubscriptions_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobNotificationSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNotificationChannelById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveNotificationChannelById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationChannelById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveNotificationChannelById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationChannelById'
+type MockQuerier_RemoveNotificationChannelById_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationChannelById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveNotificationChannelById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveNotificationChannelById_Call {
+	return &MockQuerier_RemoveNotificationChannelById_Call{Call: _e.mock.On("RemoveNotificationChannelById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveNotificationChannelById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveNotificationChannelById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveNotificationChannelById_Call) Return(_a0 error) *MockQuerier_RemoveNotificationChannelById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveNotificationChannelById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveNotificationChannelById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAnonymousUser provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
 	ret := _m.Called(ctx, db)
@@ -3720,6 +5063,102 @@ func (_c *MockQuerier_SetAnonymousUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SetJobRunOverrides provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobRunOverrides(ctx context.Context, db DBTX, arg SetJobRunOverridesParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunOverrides")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunOverridesParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetJobRunOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunOverrides'
+type MockQuerier_SetJobRunOverrides_Call struct {
+	*mock.Call
+}
+
+// SetJobRunOverrides is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg SetJobRunOverridesParams
+func (_e *MockQuerier_Expecter) SetJobRunOverrides(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobRunOverrides_Call {
+	return &MockQuerier_SetJobRunOverrides_Call{Call: _e.mock.On("SetJobRunOverrides", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_SetJobRunOverrides_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobRunOverridesParams)) *MockQuerier_SetJobRunOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobRunOverridesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetJobRunOverrides_Call) Return(_a0 error) *MockQuerier_SetJobRunOverrides_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetJobRunOverrides_Call) RunAndReturn(run func(context.Context, DBTX, SetJobRunOverridesParams) error) *MockQuerier_SetJobRunOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunTriggeredBy provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobRunTriggeredBy(ctx context.Context, db DBTX, arg SetJobRunTriggeredByParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunTriggeredBy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunTriggeredByParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetJobRunTriggeredBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunTriggeredBy'
+type MockQuerier_SetJobRunTriggeredBy_Call struct {
+	*mock.Call
+}
+
+// SetJobRunTriggeredBy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg SetJobRunTriggeredByParams
+func (_e *MockQuerier_Expecter) SetJobRunTriggeredBy(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobRunTriggeredBy_Call {
+	return &MockQuerier_SetJobRunTriggeredBy_Call{Call: _e.mock.On("SetJobRunTriggeredBy", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_SetJobRunTriggeredBy_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobRunTriggeredByParams)) *MockQuerier_SetJobRunTriggeredBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobRunTriggeredByParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetJobRunTriggeredBy_Call) Return(_a0 error) *MockQuerier_SetJobRunTriggeredBy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetJobRunTriggeredBy_Call) RunAndReturn(run func(context.Context, DBTX, SetJobRunTriggeredByParams) error) *MockQuerier_SetJobRunTriggeredBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobSyncOptions provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -3983,43 +5422,101 @@ func (_e *MockQuerier_Expecter) UpdateAccountInviteToAccepted(ctx interface{}, d
 	return &MockQuerier_UpdateAccountInviteToAccepted_Call{Call: _e.mock.On("UpdateAccountInviteToAccepted", ctx, db, id)}
 }
 
-func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) Return(_a0 NeosyncApiAccountInvite, _a1 error) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccountInvite, error)) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccountOnboardingConfig provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountOnboardingConfig")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateAccountOnboardingConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountOnboardingConfig'
+type MockQuerier_UpdateAccountOnboardingConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountOnboardingConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateAccountOnboardingConfigParams
+func (_e *MockQuerier_Expecter) UpdateAccountOnboardingConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountOnboardingConfig_Call {
+	return &MockQuerier_UpdateAccountOnboardingConfig_Call{Call: _e.mock.On("UpdateAccountOnboardingConfig", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams)) *MockQuerier_UpdateAccountOnboardingConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountOnboardingConfigParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) Return(_a0 NeosyncApiAccountInvite, _a1 error) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_UpdateAccountOnboardingConfig_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccountInvite, error)) *MockQuerier_UpdateAccountInviteToAccepted_Call {
+func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)) *MockQuerier_UpdateAccountOnboardingConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAccountOnboardingConfig provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error) {
+// UpdateAccountUserRole provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAccountOnboardingConfig")
+		panic("no return value specified for UpdateAccountUserRole")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 NeosyncApiAccountUserAssociation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) NeosyncApiAccount); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) NeosyncApiAccountUserAssociation); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		r0 = ret.Get(0).(NeosyncApiAccountUserAssociation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountUserRoleParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -4028,32 +5525,32 @@ func (_m *MockQuerier) UpdateAccountOnboardingConfig(ctx context.Context, db DBT
 	return r0, r1
 }
 
-// MockQuerier_UpdateAccountOnboardingConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountOnboardingConfig'
-type MockQuerier_UpdateAccountOnboardingConfig_Call struct {
+// MockQuerier_UpdateAccountUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountUserRole'
+type MockQuerier_UpdateAccountUserRole_Call struct {
 	*mock.Call
 }
 
-// UpdateAccountOnboardingConfig is a helper method to define mock.On call
+// UpdateAccountUserRole is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg UpdateAccountOnboardingConfigParams
-func (_e *MockQuerier_Expecter) UpdateAccountOnboardingConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountOnboardingConfig_Call {
-	return &MockQuerier_UpdateAccountOnboardingConfig_Call{Call: _e.mock.On("UpdateAccountOnboardingConfig", ctx, db, arg)}
+//   - arg UpdateAccountUserRoleParams
+func (_e *MockQuerier_Expecter) UpdateAccountUserRole(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountUserRole_Call {
+	return &MockQuerier_UpdateAccountUserRole_Call{Call: _e.mock.On("UpdateAccountUserRole", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams)) *MockQuerier_UpdateAccountOnboardingConfig_Call {
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams)) *MockQuerier_UpdateAccountUserRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountOnboardingConfigParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountUserRoleParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_UpdateAccountOnboardingConfig_Call {
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Return(_a0 NeosyncApiAccountUserAssociation, _a1 error) *MockQuerier_UpdateAccountUserRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)) *MockQuerier_UpdateAccountOnboardingConfig_Call {
+func (_c *MockQuerier_UpdateAccountUserRole_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)) *MockQuerier_UpdateAccountUserRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4174,6 +5671,64 @@ func (_c *MockQuerier_UpdateConnection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateConnectionConfig provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnectionConfig")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionConfigParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionConfigParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateConnectionConfigParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateConnectionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionConfig'
+type MockQuerier_UpdateConnectionConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConnectionConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateConnectionConfigParams
+func (_e *MockQuerier_Expecter) UpdateConnectionConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateConnectionConfig_Call {
+	return &MockQuerier_UpdateConnectionConfig_Call{Call: _e.mock.On("UpdateConnectionConfig", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams)) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateConnectionConfigParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpdateConnectionConfigParams) (int64, error)) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobConnectionDestination provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4290,6 +5845,54 @@ func (_c *MockQuerier_UpdateJobMappings_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UpdateJobRunStatus provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateJobRunStatus(ctx context.Context, db DBTX, arg UpdateJobRunStatusParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobRunStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateJobRunStatusParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpdateJobRunStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobRunStatus'
+type MockQuerier_UpdateJobRunStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateJobRunStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateJobRunStatusParams
+func (_e *MockQuerier_Expecter) UpdateJobRunStatus(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateJobRunStatus_Call {
+	return &MockQuerier_UpdateJobRunStatus_Call{Call: _e.mock.On("UpdateJobRunStatus", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateJobRunStatus_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateJobRunStatusParams)) *MockQuerier_UpdateJobRunStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateJobRunStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateJobRunStatus_Call) Return(_a0 error) *MockQuerier_UpdateJobRunStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateJobRunStatus_Call) RunAndReturn(run func(context.Context, DBTX, UpdateJobRunStatusParams) error) *MockQuerier_UpdateJobRunStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobSchedule provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobScheduleParams) (NeosyncApiJob, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4464,6 +6067,64 @@ func (_c *MockQuerier_UpdateJobVirtualForeignKeys_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// UpdateNotificationChannel provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateNotificationChannel(ctx context.Context, db DBTX, arg UpdateNotificationChannelParams) (NeosyncApiNotificationChannel, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationChannel")
+	}
+
+	var r0 NeosyncApiNotificationChannel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateNotificationChannelParams) (NeosyncApiNotificationChannel, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateNotificationChannelParams) NeosyncApiNotificationChannel); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationChannel)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateNotificationChannelParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateNotificationChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationChannel'
+type MockQuerier_UpdateNotificationChannel_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateNotificationChannelParams
+func (_e *MockQuerier_Expecter) UpdateNotificationChannel(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateNotificationChannel_Call {
+	return &MockQuerier_UpdateNotificationChannel_Call{Call: _e.mock.On("UpdateNotificationChannel", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateNotificationChannel_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateNotificationChannelParams)) *MockQuerier_UpdateNotificationChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateNotificationChannelParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationChannel_Call) Return(_a0 NeosyncApiNotificationChannel, _a1 error) *MockQuerier_UpdateNotificationChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationChannel_Call) RunAndReturn(run func(context.Context, DBTX, UpdateNotificationChannelParams) (NeosyncApiNotificationChannel, error)) *MockQuerier_UpdateNotificationChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTemporalConfigByAccount provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4580,6 +6241,160 @@ func (_c *MockQuerier_UpdateUserDefinedTransformer_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpsertConnectionColumnTags provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertConnectionColumnTags(ctx context.Context, db DBTX, arg UpsertConnectionColumnTagsParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertConnectionColumnTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertConnectionColumnTagsParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpsertConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertConnectionColumnTags'
+type MockQuerier_UpsertConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// UpsertConnectionColumnTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertConnectionColumnTagsParams
+func (_e *MockQuerier_Expecter) UpsertConnectionColumnTags(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertConnectionColumnTags_Call {
+	return &MockQuerier_UpsertConnectionColumnTags_Call{Call: _e.mock.On("UpsertConnectionColumnTags", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertConnectionColumnTags_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertConnectionColumnTagsParams)) *MockQuerier_UpsertConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertConnectionColumnTagsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertConnectionColumnTags_Call) Return(_a0 error) *MockQuerier_UpsertConnectionColumnTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertConnectionColumnTags_Call) RunAndReturn(run func(context.Context, DBTX, UpsertConnectionColumnTagsParams) error) *MockQuerier_UpsertConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertJobMappingPolicyConfig provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertJobMappingPolicyConfig(ctx context.Context, db DBTX, arg UpsertJobMappingPolicyConfigParams) (NeosyncApiAccountJobMappingPolicy, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertJobMappingPolicyConfig")
+	}
+
+	var r0 NeosyncApiAccountJobMappingPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertJobMappingPolicyConfigParams) (NeosyncApiAccountJobMappingPolicy, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertJobMappingPolicyConfigParams) NeosyncApiAccountJobMappingPolicy); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccountJobMappingPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpsertJobMappingPolicyConfigParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertJobMappingPolicyConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertJobMappingPolicyConfig'
+type MockQuerier_UpsertJobMappingPolicyConfig_Call struct {
+	*mock.Call
+}
+
+// UpsertJobMappingPolicyConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertJobMappingPolicyConfigParams
+func (_e *MockQuerier_Expecter) UpsertJobMappingPolicyConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertJobMappingPolicyConfig_Call {
+	return &MockQuerier_UpsertJobMappingPolicyConfig_Call{Call: _e.mock.On("UpsertJobMappingPolicyConfig", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertJobMappingPolicyConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertJobMappingPolicyConfigParams)) *MockQuerier_UpsertJobMappingPolicyConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertJobMappingPolicyConfigParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJobMappingPolicyConfig_Call) Return(_a0 NeosyncApiAccountJobMappingPolicy, _a1 error) *MockQuerier_UpsertJobMappingPolicyConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJobMappingPolicyConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpsertJobMappingPolicyConfigParams) (NeosyncApiAccountJobMappingPolicy, error)) *MockQuerier_UpsertJobMappingPolicyConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertJobRun provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertJobRun(ctx context.Context, db DBTX, arg UpsertJobRunParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertJobRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertJobRunParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpsertJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertJobRun'
+type MockQuerier_UpsertJobRun_Call struct {
+	*mock.Call
+}
+
+// UpsertJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertJobRunParams
+func (_e *MockQuerier_Expecter) UpsertJobRun(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertJobRun_Call {
+	return &MockQuerier_UpsertJobRun_Call{Call: _e.mock.On("UpsertJobRun", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertJobRun_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertJobRunParams)) *MockQuerier_UpsertJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertJobRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJobRun_Call) Return(_a0 error) *MockQuerier_UpsertJobRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJobRun_Call) RunAndReturn(run func(context.Context, DBTX, UpsertJobRunParams) error) *MockQuerier_UpsertJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQuerier(t interface {
@@ -30,6 +30,8 @@ type NeosyncApiAccountApiKey struct {
 	ExpiresAt   pgtype.Timestamp
 	KeyName     string
 	UserID      pgtype.UUID
+	Scopes      []string
+	JobIds      []pgtype.UUID
 }
 
 type NeosyncApiAccountInvite struct {
@@ -42,6 +44,16 @@ type NeosyncApiAccountInvite struct {
 	CreatedAt    pgtype.Timestamp
 	UpdatedAt    pgtype.Timestamp
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
+}
+
+type NeosyncApiAccountJobMappingPolicy struct {
+	AccountID   pgtype.UUID
+	Config      *pg_models.JobMappingPolicyConfig
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+	CreatedByID pgtype.UUID
+	UpdatedByID pgtype.UUID
 }
 
 type NeosyncApiAccountUserAssociation struct {
@@ -50,6 +62,20 @@ type NeosyncApiAccountUserAssociation struct {
 	UserID    pgtype.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	Role      int16
+}
+
+type NeosyncApiAuditEvent struct {
+	ID            pgtype.UUID
+	AccountID     pgtype.UUID
+	ActorUserID   pgtype.UUID
+	ActorApiKeyID pgtype.UUID
+	Procedure     string
+	ResourceID    pgtype.Text
+	Request       []byte
+	ErrorCode     pgtype.Text
+	CreatedAt     pgtype.Timestamp
+	Changes       []byte
 }
 
 type NeosyncApiConnection struct {
@@ -63,6 +89,19 @@ type NeosyncApiConnection struct {
 	UpdatedByID      pgtype.UUID
 }
 
+type NeosyncApiConnectionColumnTag struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	SchemaName   string
+	TableName    string
+	ColumnName   string
+	Tags         []string
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+	CreatedByID  pgtype.UUID
+	UpdatedByID  pgtype.UUID
+}
+
 type NeosyncApiJob struct {
 	ID                 pgtype.UUID
 	CreatedAt          pgtype.Timestamp
@@ -89,6 +128,54 @@ type NeosyncApiJobDestinationConnectionAssociation struct {
 	Options      *pg_models.JobDestinationOptions
 }
 
+type NeosyncApiJobNotificationSubscription struct {
+	JobID     pgtype.UUID
+	ChannelID pgtype.UUID
+	Events    []int16
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type NeosyncApiJobRun struct {
+	ID           string
+	AccountID    pgtype.UUID
+	JobID        pgtype.UUID
+	Status       int16
+	StartedAt    pgtype.Timestamp
+	CompletedAt  pgtype.Timestamp
+	Tables       []*pg_models.JobRunTableSummary
+	ErrorMessage pgtype.Text
+	TriggeredBy  pgtype.Text
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+	Overrides    *pg_models.JobRunOverrides
+}
+
+type NeosyncApiJobRunLog struct {
+	ID         int64
+	AccountID  pgtype.UUID
+	JobRunID   string
+	LogTime    pgtype.Timestamp
+	Level      string
+	Message    string
+	Activity   pgtype.Text
+	SchemaName pgtype.Text
+	TableName  pgtype.Text
+	Attributes []byte
+	CreatedAt  pgtype.Timestamp
+}
+
+type NeosyncApiNotificationChannel struct {
+	ID          pgtype.UUID
+	AccountID   pgtype.UUID
+	Name        string
+	Config      *pg_models.NotificationChannelConfig
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+	CreatedByID pgtype.UUID
+	UpdatedByID pgtype.UUID
+}
+
 type NeosyncApiRuncontext struct {
 	WorkflowID  string
 	ExternalID  string
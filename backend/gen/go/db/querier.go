@@ -13,20 +13,28 @@ import (
 
 type Querier interface {
 	AreConnectionsInAccount(ctx context.Context, db DBTX, arg AreConnectionsInAccountParams) (int64, error)
+	// Records a run that was started before the run history was kept, runs that are already recorded are left as is
+	BackfillJobRun(ctx context.Context, db DBTX, arg BackfillJobRunParams) error
+	CloseJobRun(ctx context.Context, db DBTX, arg CloseJobRunParams) (int64, error)
 	CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error)
 	CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error)
 	CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) error
 	CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error)
 	CreateIdentityProviderAssociation(ctx context.Context, db DBTX, arg CreateIdentityProviderAssociationParams) (NeosyncApiUserIdentityProviderAssociation, error)
 	CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error)
 	CreateJobConnectionDestination(ctx context.Context, db DBTX, arg CreateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	CreateJobConnectionDestinations(ctx context.Context, db DBTX, arg []CreateJobConnectionDestinationsParams) (int64, error)
+	CreateJobNotificationSubscription(ctx context.Context, db DBTX, arg CreateJobNotificationSubscriptionParams) error
+	CreateJobRunLogs(ctx context.Context, db DBTX, arg CreateJobRunLogsParams) error
 	CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	CreateNotificationChannel(ctx context.Context, db DBTX, arg CreateNotificationChannelParams) (NeosyncApiNotificationChannel, error)
 	CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateUserDefinedTransformer(ctx context.Context, db DBTX, arg CreateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
 	DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
+	DeleteJobRunLogsOlderThan(ctx context.Context, db DBTX, before pgtype.Timestamp) (int64, error)
 	DeleteUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccount, error)
 	GetAccountApiKeyById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountApiKey, error)
@@ -36,11 +44,16 @@ type Querier interface {
 	GetAccountInviteByToken(ctx context.Context, db DBTX, token string) (NeosyncApiAccountInvite, error)
 	GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)
 	GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	// Locks the counted rows so that concurrent role changes and removals are serialized until the transaction ends
+	GetAccountUserRoleCount(ctx context.Context, db DBTX, arg GetAccountUserRoleCountParams) (int64, error)
 	GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetActiveAccountInvites(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountInvite, error)
+	GetAllConnections(ctx context.Context, db DBTX) ([]NeosyncApiConnection, error)
 	GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	GetAuditEventsByAccount(ctx context.Context, db DBTX, arg GetAuditEventsByAccountParams) ([]NeosyncApiAuditEvent, error)
 	GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error)
 	GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)
+	GetConnectionColumnTags(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)
 	GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error)
 	GetConnectionsByIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiConnection, error)
 	GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error)
@@ -48,7 +61,17 @@ type Querier interface {
 	GetJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
+	GetJobMappingPolicyConfigByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) (*pg_models.JobMappingPolicyConfig, error)
+	GetJobNotificationSubscriptions(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobNotificationSubscription, error)
+	GetJobRunById(ctx context.Context, db DBTX, arg GetJobRunByIdParams) (NeosyncApiJobRun, error)
+	GetJobRunLogs(ctx context.Context, db DBTX, arg GetJobRunLogsParams) ([]NeosyncApiJobRunLog, error)
+	GetJobRuns(ctx context.Context, db DBTX, arg GetJobRunsParams) ([]NeosyncApiJobRun, error)
+	GetJobRunsByStatus(ctx context.Context, db DBTX, arg GetJobRunsByStatusParams) ([]NeosyncApiJobRun, error)
 	GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error)
+	GetLatestJobRunLogs(ctx context.Context, db DBTX, arg GetLatestJobRunLogsParams) ([]NeosyncApiJobRunLog, error)
+	GetNotificationChannelById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiNotificationChannel, error)
+	GetNotificationChannelsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiNotificationChannel, error)
+	GetNotificationChannelsByJobEvent(ctx context.Context, db DBTX, arg GetNotificationChannelsByJobEventParams) ([]NeosyncApiNotificationChannel, error)
 	GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error)
 	GetRunContextByKey(ctx context.Context, db DBTX, arg GetRunContextByKeyParams) (NeosyncApiRuncontext, error)
 	GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error)
@@ -59,7 +82,7 @@ type Querier interface {
 	GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error)
 	GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error)
 	GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error)
-	GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
+	GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)
 	GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
 	GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)
 	IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error)
@@ -73,25 +96,37 @@ type Querier interface {
 	RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error
 	RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error
+	RemoveConnectionColumnTags(ctx context.Context, db DBTX, arg RemoveConnectionColumnTagsParams) error
 	RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error
+	RemoveJobNotificationSubscriptions(ctx context.Context, db DBTX, jobID pgtype.UUID) error
+	RemoveNotificationChannelById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	SetJobRunOverrides(ctx context.Context, db DBTX, arg SetJobRunOverridesParams) error
+	SetJobRunTriggeredBy(ctx context.Context, db DBTX, arg SetJobRunTriggeredByParams) error
 	SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error)
 	SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJobWorkflowOptionsParams) (NeosyncApiJob, error)
 	SetRunContext(ctx context.Context, db DBTX, arg SetRunContextParams) error
 	UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg UpdateAccountApiKeyValueParams) (NeosyncApiAccountApiKey, error)
 	UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
 	UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)
+	UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)
 	UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error)
 	UpdateConnection(ctx context.Context, db DBTX, arg UpdateConnectionParams) (NeosyncApiConnection, error)
+	UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) (int64, error)
 	UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobMappingsParams) (NeosyncApiJob, error)
+	UpdateJobRunStatus(ctx context.Context, db DBTX, arg UpdateJobRunStatusParams) error
 	UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobScheduleParams) (NeosyncApiJob, error)
 	UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSourceParams) (NeosyncApiJob, error)
 	UpdateJobVirtualForeignKeys(ctx context.Context, db DBTX, arg UpdateJobVirtualForeignKeysParams) (NeosyncApiJob, error)
+	UpdateNotificationChannel(ctx context.Context, db DBTX, arg UpdateNotificationChannelParams) (NeosyncApiNotificationChannel, error)
 	UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error)
 	UpdateUserDefinedTransformer(ctx context.Context, db DBTX, arg UpdateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
+	UpsertConnectionColumnTags(ctx context.Context, db DBTX, arg UpsertConnectionColumnTagsParams) error
+	UpsertJobMappingPolicyConfig(ctx context.Context, db DBTX, arg UpsertJobMappingPolicyConfigParams) (NeosyncApiAccountJobMappingPolicy, error)
+	UpsertJobRun(ctx context.Context, db DBTX, arg UpsertJobRunParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -14,11 +14,11 @@ import (
 
 const createAccountInvite = `-- name: CreateAccountInvite :one
 INSERT INTO neosync_api.account_invites (
-  account_id, sender_user_id, email, expires_at
+  account_id, sender_user_id, email, expires_at, role
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 )
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type CreateAccountInviteParams struct {
@@ -26,6 +26,7 @@ type CreateAccountInviteParams struct {
 	SenderUserID pgtype.UUID
 	Email        string
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
 }
 
 func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error) {
@@ -34,6 +35,7 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		arg.SenderUserID,
 		arg.Email,
 		arg.ExpiresAt,
+		arg.Role,
 	)
 	var i NeosyncApiAccountInvite
 	err := row.Scan(
@@ -46,26 +48,28 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const createAccountUserAssociation = `-- name: CreateAccountUserAssociation :one
 INSERT INTO neosync_api.account_user_associations (
-  account_id, user_id
+  account_id, user_id, role
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )
-RETURNING id, account_id, user_id, created_at, updated_at
+RETURNING id, account_id, user_id, created_at, updated_at, role
 `
 
 type CreateAccountUserAssociationParams struct {
 	AccountID pgtype.UUID
 	UserID    pgtype.UUID
+	Role      int16
 }
 
 func (q *Queries) CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error) {
-	row := db.QueryRow(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID)
+	row := db.QueryRow(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID, arg.Role)
 	var i NeosyncApiAccountUserAssociation
 	err := row.Scan(
 		&i.ID,
@@ -73,6 +77,7 @@ func (q *Queries) CreateAccountUserAssociation(ctx context.Context, db DBTX, arg
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -215,7 +220,7 @@ func (q *Queries) GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 }
 
 const getAccountInvite = `-- name: GetAccountInvite :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE id = $1
 `
 
@@ -232,12 +237,13 @@ func (q *Queries) GetAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getAccountInviteByToken = `-- name: GetAccountInviteByToken :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE token = $1
 `
 
@@ -254,6 +260,7 @@ func (q *Queries) GetAccountInviteByToken(ctx context.Context, db DBTX, token st
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -272,7 +279,7 @@ func (q *Queries) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pg
 }
 
 const getAccountUserAssociation = `-- name: GetAccountUserAssociation :one
-SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at from neosync_api.account_user_associations aua
+SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at, aua.role from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = $1 AND u.id = $2
@@ -292,10 +299,32 @@ func (q *Queries) GetAccountUserAssociation(ctx context.Context, db DBTX, arg Ge
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
 
+const getAccountUserRoleCount = `-- name: GetAccountUserRoleCount :one
+SELECT count(locked.id) FROM (
+  SELECT id FROM neosync_api.account_user_associations
+  WHERE account_id = $1 AND role = $2
+  FOR UPDATE
+) locked
+`
+
+type GetAccountUserRoleCountParams struct {
+	AccountId pgtype.UUID
+	Role      int16
+}
+
+// Locks the counted rows so that concurrent role changes and removals are serialized until the transaction ends
+func (q *Queries) GetAccountUserRoleCount(ctx context.Context, db DBTX, arg GetAccountUserRoleCountParams) (int64, error) {
+	row := db.QueryRow(ctx, getAccountUserRoleCount, arg.AccountId, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAccountsByUser = `-- name: GetAccountsByUser :many
 SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config
 FROM neosync_api.accounts a
@@ -341,7 +370,7 @@ func (q *Queries) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID
 }
 
 const getActiveAccountInvites = `-- name: GetActiveAccountInvites :many
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE account_id = $1 AND expires_at > CURRENT_TIMESTAMP AND accepted = false
 `
 
@@ -364,6 +393,7 @@ func (q *Queries) GetActiveAccountInvites(ctx context.Context, db DBTX, accounti
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExpiresAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -536,27 +566,37 @@ func (q *Queries) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub
 }
 
 const getUserIdentitiesByTeamAccount = `-- name: GetUserIdentitiesByTeamAccount :many
-SELECT aipa.id, aipa.user_id, aipa.provider_sub, aipa.created_at, aipa.updated_at FROM neosync_api.user_identity_provider_associations aipa
+SELECT aipa.id, aipa.user_id, aipa.provider_sub, aipa.created_at, aipa.updated_at, aua.role FROM neosync_api.user_identity_provider_associations aipa
 JOIN neosync_api.account_user_associations aua ON aua.user_id = aipa.user_id
 JOIN neosync_api.accounts a ON a.id = aua.account_id
 WHERE aua.account_id = $1 AND a.account_type = 1
 `
 
-func (q *Queries) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+type GetUserIdentitiesByTeamAccountRow struct {
+	ID          pgtype.UUID
+	UserID      pgtype.UUID
+	ProviderSub string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+	Role        int16
+}
+
+func (q *Queries) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error) {
 	rows, err := db.Query(ctx, getUserIdentitiesByTeamAccount, accountid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []NeosyncApiUserIdentityProviderAssociation
+	var items []GetUserIdentitiesByTeamAccountRow
 	for rows.Next() {
-		var i NeosyncApiUserIdentityProviderAssociation
+		var i GetUserIdentitiesByTeamAccountRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.ProviderSub,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -689,7 +729,7 @@ const updateAccountInviteToAccepted = `-- name: UpdateAccountInviteToAccepted :o
 UPDATE neosync_api.account_invites
 SET accepted = true
 WHERE id = $1
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error) {
@@ -705,6 +745,7 @@ func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -736,11 +777,38 @@ func (q *Queries) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, ar
 	return i, err
 }
 
+const updateAccountUserRole = `-- name: UpdateAccountUserRole :one
+UPDATE neosync_api.account_user_associations
+SET role = $1
+WHERE account_id = $2 AND user_id = $3
+RETURNING id, account_id, user_id, created_at, updated_at, role
+`
+
+type UpdateAccountUserRoleParams struct {
+	Role      int16
+	AccountId pgtype.UUID
+	UserId    pgtype.UUID
+}
+
+func (q *Queries) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
+	row := db.QueryRow(ctx, updateAccountUserRole, arg.Role, arg.AccountId, arg.UserId)
+	var i NeosyncApiAccountUserAssociation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+	)
+	return i, err
+}
+
 const updateActiveAccountInvitesToExpired = `-- name: UpdateActiveAccountInvitesToExpired :one
 UPDATE neosync_api.account_invites
 SET expires_at = CURRENT_TIMESTAMP
 WHERE account_id = $1 AND email = $2 AND expires_at > CURRENT_TIMESTAMP
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type UpdateActiveAccountInvitesToExpiredParams struct {
@@ -761,6 +829,7 @@ func (q *Queries) UpdateActiveAccountInvitesToExpired(ctx context.Context, db DB
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -510,6 +510,243 @@ var _ interface {
 	ErrorName() string
 } = GetConnectionResponseValidationError{}
 
+// Validate checks the field values on GetConnectionUnmaskedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionUnmaskedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionUnmaskedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConnectionUnmaskedRequestMultiError, or nil if none found.
+func (m *GetConnectionUnmaskedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionUnmaskedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetConnectionUnmaskedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionUnmaskedRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionUnmaskedRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetConnectionUnmaskedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionUnmaskedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionUnmaskedRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionUnmaskedRequestValidationError is the validation error returned
+// by GetConnectionUnmaskedRequest.Validate if the designated constraints
+// aren't met.
+type GetConnectionUnmaskedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionUnmaskedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionUnmaskedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionUnmaskedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionUnmaskedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionUnmaskedRequestValidationError) ErrorName() string {
+	return "GetConnectionUnmaskedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionUnmaskedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionUnmaskedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionUnmaskedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionUnmaskedRequestValidationError{}
+
+// Validate checks the field values on GetConnectionUnmaskedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionUnmaskedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionUnmaskedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConnectionUnmaskedResponseMultiError, or nil if none found.
+func (m *GetConnectionUnmaskedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionUnmaskedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionUnmaskedResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConnectionUnmaskedResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionUnmaskedResponseValidationError{
+				field:  "Connection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionUnmaskedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionUnmaskedResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionUnmaskedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetConnectionUnmaskedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionUnmaskedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionUnmaskedResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionUnmaskedResponseValidationError is the validation error
+// returned by GetConnectionUnmaskedResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionUnmaskedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionUnmaskedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionUnmaskedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionUnmaskedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionUnmaskedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionUnmaskedResponseValidationError) ErrorName() string {
+	return "GetConnectionUnmaskedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionUnmaskedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionUnmaskedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionUnmaskedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionUnmaskedResponseValidationError{}
+
 // Validate checks the field values on CreateConnectionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1299,6 +1536,10 @@ func (m *CheckConnectionConfigRequest) validate(all bool) error {
 		}
 	}
 
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
 	if len(errors) > 0 {
 		return CheckConnectionConfigRequestMultiError(errors)
 	}
@@ -2219,6 +2460,88 @@ func (m *ConnectionConfig) validate(all bool) error {
 			}
 		}
 
+	case *ConnectionConfig_ClickhouseConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetClickhouseConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "ClickhouseConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "ClickhouseConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClickhouseConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "ClickhouseConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_KafkaConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKafkaConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKafkaConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "KafkaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -2478,83 +2801,117 @@ var _ interface {
 	ErrorName() string
 } = MssqlConnectionConfigValidationError{}
 
-// Validate checks the field values on DynamoDBConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ClickhouseConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DynamoDBConnectionConfig) Validate() error {
+func (m *ClickhouseConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DynamoDBConnectionConfig with the
+// ValidateAll checks the field values on ClickhouseConnectionConfig with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DynamoDBConnectionConfigMultiError, or nil if none found.
-func (m *DynamoDBConnectionConfig) ValidateAll() error {
+// ClickhouseConnectionConfigMultiError, or nil if none found.
+func (m *ClickhouseConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DynamoDBConnectionConfig) validate(all bool) error {
+func (m *ClickhouseConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Credentials != nil {
-
-		if all {
-			switch v := interface{}(m.GetCredentials()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DynamoDBConnectionConfigValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DynamoDBConnectionConfigValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetConnectionOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClickhouseConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return DynamoDBConnectionConfigValidationError{
-					field:  "Credentials",
+				errors = append(errors, ClickhouseConnectionConfigValidationError{
+					field:  "ConnectionOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClickhouseConnectionConfigValidationError{
+				field:  "ConnectionOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	if m.Region != nil {
-		// no validation rules for Region
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClickhouseConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClickhouseConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClickhouseConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.Endpoint != nil {
-		// no validation rules for Endpoint
+	switch v := m.ConnectionConfig.(type) {
+	case *ClickhouseConnectionConfig_Url:
+		if v == nil {
+			err := ClickhouseConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return DynamoDBConnectionConfigMultiError(errors)
+		return ClickhouseConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// DynamoDBConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by DynamoDBConnectionConfig.ValidateAll() if the designated
-// constraints aren't met.
-type DynamoDBConnectionConfigMultiError []error
+// ClickhouseConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by ClickhouseConnectionConfig.ValidateAll() if
+// the designated constraints aren't met.
+type ClickhouseConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DynamoDBConnectionConfigMultiError) Error() string {
+func (m ClickhouseConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2563,11 +2920,11 @@ func (m DynamoDBConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DynamoDBConnectionConfigMultiError) AllErrors() []error { return m }
+func (m ClickhouseConnectionConfigMultiError) AllErrors() []error { return m }
 
-// DynamoDBConnectionConfigValidationError is the validation error returned by
-// DynamoDBConnectionConfig.Validate if the designated constraints aren't met.
-type DynamoDBConnectionConfigValidationError struct {
+// ClickhouseConnectionConfigValidationError is the validation error returned
+// by ClickhouseConnectionConfig.Validate if the designated constraints aren't met.
+type ClickhouseConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2575,24 +2932,24 @@ type DynamoDBConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e DynamoDBConnectionConfigValidationError) Field() string { return e.field }
+func (e ClickhouseConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DynamoDBConnectionConfigValidationError) Reason() string { return e.reason }
+func (e ClickhouseConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DynamoDBConnectionConfigValidationError) Cause() error { return e.cause }
+func (e ClickhouseConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DynamoDBConnectionConfigValidationError) Key() bool { return e.key }
+func (e ClickhouseConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DynamoDBConnectionConfigValidationError) ErrorName() string {
-	return "DynamoDBConnectionConfigValidationError"
+func (e ClickhouseConnectionConfigValidationError) ErrorName() string {
+	return "ClickhouseConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DynamoDBConnectionConfigValidationError) Error() string {
+func (e ClickhouseConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2604,14 +2961,14 @@ func (e DynamoDBConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDynamoDBConnectionConfig.%s: %s%s",
+		"invalid %sClickhouseConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DynamoDBConnectionConfigValidationError{}
+var _ error = ClickhouseConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -2619,119 +2976,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DynamoDBConnectionConfigValidationError{}
+} = ClickhouseConnectionConfigValidationError{}
 
-// Validate checks the field values on MongoConnectionConfig with the rules
+// Validate checks the field values on KafkaConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MongoConnectionConfig) Validate() error {
+func (m *KafkaConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MongoConnectionConfig with the rules
+// ValidateAll checks the field values on KafkaConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MongoConnectionConfigMultiError, or nil if none found.
-func (m *MongoConnectionConfig) ValidateAll() error {
+// KafkaConnectionConfigMultiError, or nil if none found.
+func (m *KafkaConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MongoConnectionConfig) validate(all bool) error {
+func (m *KafkaConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTunnel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MongoConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.Sasl != nil {
+
+		if all {
+			switch v := interface{}(m.GetSasl()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetSasl()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MongoConnectionConfigValidationError{
-					field:  "Tunnel",
+				return KafkaConnectionConfigValidationError{
+					field:  "Sasl",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MongoConnectionConfigValidationError{
-				field:  "Tunnel",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetClientTls()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MongoConnectionConfigValidationError{
-					field:  "ClientTls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.Tls != nil {
+
+		if all {
+			switch v := interface{}(m.GetTls()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MongoConnectionConfigValidationError{
-					field:  "ClientTls",
+				return KafkaConnectionConfigValidationError{
+					field:  "Tls",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MongoConnectionConfigValidationError{
-				field:  "ClientTls",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	switch v := m.ConnectionConfig.(type) {
-	case *MongoConnectionConfig_Url:
-		if v == nil {
-			err := MongoConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Url
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return MongoConnectionConfigMultiError(errors)
+		return KafkaConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// MongoConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by MongoConnectionConfig.ValidateAll() if the designated
+// KafkaConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by KafkaConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type MongoConnectionConfigMultiError []error
+type KafkaConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MongoConnectionConfigMultiError) Error() string {
+func (m KafkaConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2740,11 +3088,11 @@ func (m MongoConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MongoConnectionConfigMultiError) AllErrors() []error { return m }
+func (m KafkaConnectionConfigMultiError) AllErrors() []error { return m }
 
-// MongoConnectionConfigValidationError is the validation error returned by
-// MongoConnectionConfig.Validate if the designated constraints aren't met.
-type MongoConnectionConfigValidationError struct {
+// KafkaConnectionConfigValidationError is the validation error returned by
+// KafkaConnectionConfig.Validate if the designated constraints aren't met.
+type KafkaConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2752,24 +3100,24 @@ type MongoConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MongoConnectionConfigValidationError) Field() string { return e.field }
+func (e KafkaConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MongoConnectionConfigValidationError) Reason() string { return e.reason }
+func (e KafkaConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MongoConnectionConfigValidationError) Cause() error { return e.cause }
+func (e KafkaConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MongoConnectionConfigValidationError) Key() bool { return e.key }
+func (e KafkaConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MongoConnectionConfigValidationError) ErrorName() string {
-	return "MongoConnectionConfigValidationError"
+func (e KafkaConnectionConfigValidationError) ErrorName() string {
+	return "KafkaConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MongoConnectionConfigValidationError) Error() string {
+func (e KafkaConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2781,14 +3129,14 @@ func (e MongoConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMongoConnectionConfig.%s: %s%s",
+		"invalid %sKafkaConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MongoConnectionConfigValidationError{}
+var _ error = KafkaConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -2796,48 +3144,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MongoConnectionConfigValidationError{}
+} = KafkaConnectionConfigValidationError{}
 
-// Validate checks the field values on OpenAiConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OpenAiConnectionConfig) Validate() error {
+// Validate checks the field values on KafkaSaslConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KafkaSaslConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OpenAiConnectionConfig with the rules
+// ValidateAll checks the field values on KafkaSaslConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OpenAiConnectionConfigMultiError, or nil if none found.
-func (m *OpenAiConnectionConfig) ValidateAll() error {
+// KafkaSaslConfigMultiError, or nil if none found.
+func (m *KafkaSaslConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OpenAiConnectionConfig) validate(all bool) error {
+func (m *KafkaSaslConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApiKey
+	// no validation rules for Mechanism
 
-	// no validation rules for ApiUrl
+	// no validation rules for Username
+
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return OpenAiConnectionConfigMultiError(errors)
+		return KafkaSaslConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// OpenAiConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by OpenAiConnectionConfig.ValidateAll() if the designated
-// constraints aren't met.
-type OpenAiConnectionConfigMultiError []error
+// KafkaSaslConfigMultiError is an error wrapping multiple validation errors
+// returned by KafkaSaslConfig.ValidateAll() if the designated constraints
+// aren't met.
+type KafkaSaslConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OpenAiConnectionConfigMultiError) Error() string {
+func (m KafkaSaslConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2846,11 +3196,11 @@ func (m OpenAiConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OpenAiConnectionConfigMultiError) AllErrors() []error { return m }
+func (m KafkaSaslConfigMultiError) AllErrors() []error { return m }
 
-// OpenAiConnectionConfigValidationError is the validation error returned by
-// OpenAiConnectionConfig.Validate if the designated constraints aren't met.
-type OpenAiConnectionConfigValidationError struct {
+// KafkaSaslConfigValidationError is the validation error returned by
+// KafkaSaslConfig.Validate if the designated constraints aren't met.
+type KafkaSaslConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2858,24 +3208,22 @@ type OpenAiConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e OpenAiConnectionConfigValidationError) Field() string { return e.field }
+func (e KafkaSaslConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OpenAiConnectionConfigValidationError) Reason() string { return e.reason }
+func (e KafkaSaslConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OpenAiConnectionConfigValidationError) Cause() error { return e.cause }
+func (e KafkaSaslConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OpenAiConnectionConfigValidationError) Key() bool { return e.key }
+func (e KafkaSaslConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OpenAiConnectionConfigValidationError) ErrorName() string {
-	return "OpenAiConnectionConfigValidationError"
-}
+func (e KafkaSaslConfigValidationError) ErrorName() string { return "KafkaSaslConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OpenAiConnectionConfigValidationError) Error() string {
+func (e KafkaSaslConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2887,14 +3235,14 @@ func (e OpenAiConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOpenAiConnectionConfig.%s: %s%s",
+		"invalid %sKafkaSaslConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OpenAiConnectionConfigValidationError{}
+var _ error = KafkaSaslConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -2902,46 +3250,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OpenAiConnectionConfigValidationError{}
+} = KafkaSaslConfigValidationError{}
 
-// Validate checks the field values on LocalDirectoryConnectionConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LocalDirectoryConnectionConfig) Validate() error {
+// Validate checks the field values on KafkaTlsConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KafkaTlsConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LocalDirectoryConnectionConfig with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// LocalDirectoryConnectionConfigMultiError, or nil if none found.
-func (m *LocalDirectoryConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on KafkaTlsConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KafkaTlsConfigMultiError,
+// or nil if none found.
+func (m *KafkaTlsConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LocalDirectoryConnectionConfig) validate(all bool) error {
+func (m *KafkaTlsConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
+	// no validation rules for SkipCertVerify
+
+	if all {
+		switch v := interface{}(m.GetClientTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KafkaTlsConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KafkaTlsConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KafkaTlsConfigValidationError{
+				field:  "ClientTls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return LocalDirectoryConnectionConfigMultiError(errors)
+		return KafkaTlsConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// LocalDirectoryConnectionConfigMultiError is an error wrapping multiple
-// validation errors returned by LocalDirectoryConnectionConfig.ValidateAll()
-// if the designated constraints aren't met.
-type LocalDirectoryConnectionConfigMultiError []error
+// KafkaTlsConfigMultiError is an error wrapping multiple validation errors
+// returned by KafkaTlsConfig.ValidateAll() if the designated constraints
+// aren't met.
+type KafkaTlsConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LocalDirectoryConnectionConfigMultiError) Error() string {
+func (m KafkaTlsConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2950,12 +3327,11 @@ func (m LocalDirectoryConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LocalDirectoryConnectionConfigMultiError) AllErrors() []error { return m }
+func (m KafkaTlsConfigMultiError) AllErrors() []error { return m }
 
-// LocalDirectoryConnectionConfigValidationError is the validation error
-// returned by LocalDirectoryConnectionConfig.Validate if the designated
-// constraints aren't met.
-type LocalDirectoryConnectionConfigValidationError struct {
+// KafkaTlsConfigValidationError is the validation error returned by
+// KafkaTlsConfig.Validate if the designated constraints aren't met.
+type KafkaTlsConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2963,24 +3339,22 @@ type LocalDirectoryConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e LocalDirectoryConnectionConfigValidationError) Field() string { return e.field }
+func (e KafkaTlsConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LocalDirectoryConnectionConfigValidationError) Reason() string { return e.reason }
+func (e KafkaTlsConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LocalDirectoryConnectionConfigValidationError) Cause() error { return e.cause }
+func (e KafkaTlsConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LocalDirectoryConnectionConfigValidationError) Key() bool { return e.key }
+func (e KafkaTlsConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LocalDirectoryConnectionConfigValidationError) ErrorName() string {
-	return "LocalDirectoryConnectionConfigValidationError"
-}
+func (e KafkaTlsConfigValidationError) ErrorName() string { return "KafkaTlsConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LocalDirectoryConnectionConfigValidationError) Error() string {
+func (e KafkaTlsConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2992,14 +3366,14 @@ func (e LocalDirectoryConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLocalDirectoryConnectionConfig.%s: %s%s",
+		"invalid %sKafkaTlsConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LocalDirectoryConnectionConfigValidationError{}
+var _ error = KafkaTlsConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3007,189 +3381,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LocalDirectoryConnectionConfigValidationError{}
+} = KafkaTlsConfigValidationError{}
 
-// Validate checks the field values on PostgresConnectionConfig with the rules
+// Validate checks the field values on DynamoDBConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresConnectionConfig) Validate() error {
+func (m *DynamoDBConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresConnectionConfig with the
+// ValidateAll checks the field values on DynamoDBConnectionConfig with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresConnectionConfigMultiError, or nil if none found.
-func (m *PostgresConnectionConfig) ValidateAll() error {
+// DynamoDBConnectionConfigMultiError, or nil if none found.
+func (m *DynamoDBConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresConnectionConfig) validate(all bool) error {
+func (m *DynamoDBConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTunnel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "Tunnel",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetConnectionOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "ConnectionOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetClientTls()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ClientTls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ClientTls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "ClientTls",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	switch v := m.ConnectionConfig.(type) {
-	case *PostgresConnectionConfig_Url:
-		if v == nil {
-			err := PostgresConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Url
-	case *PostgresConnectionConfig_Connection:
-		if v == nil {
-			err := PostgresConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if m.Credentials != nil {
 
 		if all {
-			switch v := interface{}(m.GetConnection()).(type) {
+			switch v := interface{}(m.GetCredentials()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresConnectionConfigValidationError{
-						field:  "Connection",
+					errors = append(errors, DynamoDBConnectionConfigValidationError{
+						field:  "Credentials",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresConnectionConfigValidationError{
-						field:  "Connection",
+					errors = append(errors, DynamoDBConnectionConfigValidationError{
+						field:  "Credentials",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PostgresConnectionConfigValidationError{
-					field:  "Connection",
+				return DynamoDBConnectionConfigValidationError{
+					field:  "Credentials",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if m.Endpoint != nil {
+		// no validation rules for Endpoint
 	}
 
 	if len(errors) > 0 {
-		return PostgresConnectionConfigMultiError(errors)
+		return DynamoDBConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by PostgresConnectionConfig.ValidateAll() if the designated
+// DynamoDBConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by DynamoDBConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type PostgresConnectionConfigMultiError []error
+type DynamoDBConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresConnectionConfigMultiError) Error() string {
+func (m DynamoDBConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3198,11 +3468,11 @@ func (m PostgresConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresConnectionConfigMultiError) AllErrors() []error { return m }
+func (m DynamoDBConnectionConfigMultiError) AllErrors() []error { return m }
 
-// PostgresConnectionConfigValidationError is the validation error returned by
-// PostgresConnectionConfig.Validate if the designated constraints aren't met.
-type PostgresConnectionConfigValidationError struct {
+// DynamoDBConnectionConfigValidationError is the validation error returned by
+// DynamoDBConnectionConfig.Validate if the designated constraints aren't met.
+type DynamoDBConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3210,24 +3480,24 @@ type PostgresConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresConnectionConfigValidationError) Field() string { return e.field }
+func (e DynamoDBConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresConnectionConfigValidationError) Reason() string { return e.reason }
+func (e DynamoDBConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresConnectionConfigValidationError) Cause() error { return e.cause }
+func (e DynamoDBConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresConnectionConfigValidationError) Key() bool { return e.key }
+func (e DynamoDBConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresConnectionConfigValidationError) ErrorName() string {
-	return "PostgresConnectionConfigValidationError"
+func (e DynamoDBConnectionConfigValidationError) ErrorName() string {
+	return "DynamoDBConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresConnectionConfigValidationError) Error() string {
+func (e DynamoDBConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3239,14 +3509,14 @@ func (e PostgresConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresConnectionConfig.%s: %s%s",
+		"invalid %sDynamoDBConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresConnectionConfigValidationError{}
+var _ error = DynamoDBConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3254,56 +3524,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresConnectionConfigValidationError{}
+} = DynamoDBConnectionConfigValidationError{}
 
-// Validate checks the field values on ClientTlsConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ClientTlsConfig) Validate() error {
+// Validate checks the field values on MongoConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MongoConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClientTlsConfig with the rules
+// ValidateAll checks the field values on MongoConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClientTlsConfigMultiError, or nil if none found.
-func (m *ClientTlsConfig) ValidateAll() error {
+// MongoConnectionConfigMultiError, or nil if none found.
+func (m *MongoConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClientTlsConfig) validate(all bool) error {
+func (m *MongoConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.RootCert != nil {
-		// no validation rules for RootCert
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MongoConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MongoConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MongoConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.ClientCert != nil {
-		// no validation rules for ClientCert
+	if all {
+		switch v := interface{}(m.GetClientTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MongoConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MongoConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MongoConnectionConfigValidationError{
+				field:  "ClientTls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.ClientKey != nil {
-		// no validation rules for ClientKey
+	switch v := m.ConnectionConfig.(type) {
+	case *MongoConnectionConfig_Url:
+		if v == nil {
+			err := MongoConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return ClientTlsConfigMultiError(errors)
+		return MongoConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClientTlsConfigMultiError is an error wrapping multiple validation errors
-// returned by ClientTlsConfig.ValidateAll() if the designated constraints
-// aren't met.
-type ClientTlsConfigMultiError []error
+// MongoConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by MongoConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MongoConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClientTlsConfigMultiError) Error() string {
+func (m MongoConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3312,11 +3645,11 @@ func (m ClientTlsConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClientTlsConfigMultiError) AllErrors() []error { return m }
+func (m MongoConnectionConfigMultiError) AllErrors() []error { return m }
 
-// ClientTlsConfigValidationError is the validation error returned by
-// ClientTlsConfig.Validate if the designated constraints aren't met.
-type ClientTlsConfigValidationError struct {
+// MongoConnectionConfigValidationError is the validation error returned by
+// MongoConnectionConfig.Validate if the designated constraints aren't met.
+type MongoConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3324,22 +3657,24 @@ type ClientTlsConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClientTlsConfigValidationError) Field() string { return e.field }
+func (e MongoConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClientTlsConfigValidationError) Reason() string { return e.reason }
+func (e MongoConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClientTlsConfigValidationError) Cause() error { return e.cause }
+func (e MongoConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClientTlsConfigValidationError) Key() bool { return e.key }
+func (e MongoConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClientTlsConfigValidationError) ErrorName() string { return "ClientTlsConfigValidationError" }
+func (e MongoConnectionConfigValidationError) ErrorName() string {
+	return "MongoConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClientTlsConfigValidationError) Error() string {
+func (e MongoConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3351,14 +3686,14 @@ func (e ClientTlsConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClientTlsConfig.%s: %s%s",
+		"invalid %sMongoConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClientTlsConfigValidationError{}
+var _ error = MongoConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3366,48 +3701,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClientTlsConfigValidationError{}
+} = MongoConnectionConfigValidationError{}
 
-// Validate checks the field values on SqlConnectionOptions with the rules
+// Validate checks the field values on OpenAiConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SqlConnectionOptions) Validate() error {
+func (m *OpenAiConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SqlConnectionOptions with the rules
+// ValidateAll checks the field values on OpenAiConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SqlConnectionOptionsMultiError, or nil if none found.
-func (m *SqlConnectionOptions) ValidateAll() error {
+// OpenAiConnectionConfigMultiError, or nil if none found.
+func (m *OpenAiConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SqlConnectionOptions) validate(all bool) error {
+func (m *OpenAiConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.MaxConnectionLimit != nil {
-		// no validation rules for MaxConnectionLimit
-	}
+	// no validation rules for ApiKey
+
+	// no validation rules for ApiUrl
 
 	if len(errors) > 0 {
-		return SqlConnectionOptionsMultiError(errors)
+		return OpenAiConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SqlConnectionOptionsMultiError is an error wrapping multiple validation
-// errors returned by SqlConnectionOptions.ValidateAll() if the designated
+// OpenAiConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by OpenAiConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type SqlConnectionOptionsMultiError []error
+type OpenAiConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SqlConnectionOptionsMultiError) Error() string {
+func (m OpenAiConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3416,11 +3751,11 @@ func (m SqlConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SqlConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m OpenAiConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SqlConnectionOptionsValidationError is the validation error returned by
-// SqlConnectionOptions.Validate if the designated constraints aren't met.
-type SqlConnectionOptionsValidationError struct {
+// OpenAiConnectionConfigValidationError is the validation error returned by
+// OpenAiConnectionConfig.Validate if the designated constraints aren't met.
+type OpenAiConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3428,24 +3763,24 @@ type SqlConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e SqlConnectionOptionsValidationError) Field() string { return e.field }
+func (e OpenAiConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SqlConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e OpenAiConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SqlConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e OpenAiConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SqlConnectionOptionsValidationError) Key() bool { return e.key }
+func (e OpenAiConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SqlConnectionOptionsValidationError) ErrorName() string {
-	return "SqlConnectionOptionsValidationError"
+func (e OpenAiConnectionConfigValidationError) ErrorName() string {
+	return "OpenAiConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SqlConnectionOptionsValidationError) Error() string {
+func (e OpenAiConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3457,14 +3792,14 @@ func (e SqlConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSqlConnectionOptions.%s: %s%s",
+		"invalid %sOpenAiConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SqlConnectionOptionsValidationError{}
+var _ error = OpenAiConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3472,82 +3807,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SqlConnectionOptionsValidationError{}
+} = OpenAiConnectionConfigValidationError{}
 
-// Validate checks the field values on SSHTunnel with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHTunnel) Validate() error {
+// Validate checks the field values on LocalDirectoryConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocalDirectoryConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHTunnel with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHTunnelMultiError, or nil
-// if none found.
-func (m *SSHTunnel) ValidateAll() error {
+// ValidateAll checks the field values on LocalDirectoryConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// LocalDirectoryConnectionConfigMultiError, or nil if none found.
+func (m *LocalDirectoryConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHTunnel) validate(all bool) error {
+func (m *LocalDirectoryConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Host
-
-	// no validation rules for Port
-
-	// no validation rules for User
-
-	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SSHTunnelValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SSHTunnelValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SSHTunnelValidationError{
-				field:  "Authentication",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.KnownHostPublicKey != nil {
-		// no validation rules for KnownHostPublicKey
-	}
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return SSHTunnelMultiError(errors)
+		return LocalDirectoryConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHTunnelMultiError is an error wrapping multiple validation errors returned
-// by SSHTunnel.ValidateAll() if the designated constraints aren't met.
-type SSHTunnelMultiError []error
+// LocalDirectoryConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by LocalDirectoryConnectionConfig.ValidateAll()
+// if the designated constraints aren't met.
+type LocalDirectoryConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHTunnelMultiError) Error() string {
+func (m LocalDirectoryConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3556,11 +3855,12 @@ func (m SSHTunnelMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHTunnelMultiError) AllErrors() []error { return m }
+func (m LocalDirectoryConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SSHTunnelValidationError is the validation error returned by
-// SSHTunnel.Validate if the designated constraints aren't met.
-type SSHTunnelValidationError struct {
+// LocalDirectoryConnectionConfigValidationError is the validation error
+// returned by LocalDirectoryConnectionConfig.Validate if the designated
+// constraints aren't met.
+type LocalDirectoryConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3568,22 +3868,24 @@ type SSHTunnelValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHTunnelValidationError) Field() string { return e.field }
+func (e LocalDirectoryConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHTunnelValidationError) Reason() string { return e.reason }
+func (e LocalDirectoryConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHTunnelValidationError) Cause() error { return e.cause }
+func (e LocalDirectoryConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHTunnelValidationError) Key() bool { return e.key }
+func (e LocalDirectoryConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHTunnelValidationError) ErrorName() string { return "SSHTunnelValidationError" }
+func (e LocalDirectoryConnectionConfigValidationError) ErrorName() string {
+	return "LocalDirectoryConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SSHTunnelValidationError) Error() string {
+func (e LocalDirectoryConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3595,14 +3897,14 @@ func (e SSHTunnelValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHTunnel.%s: %s%s",
+		"invalid %sLocalDirectoryConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHTunnelValidationError{}
+var _ error = LocalDirectoryConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3610,76 +3912,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHTunnelValidationError{}
+} = LocalDirectoryConnectionConfigValidationError{}
 
-// Validate checks the field values on SSHAuthentication with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SSHAuthentication) Validate() error {
+// Validate checks the field values on PostgresConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHAuthentication with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PostgresConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SSHAuthenticationMultiError, or nil if none found.
-func (m *SSHAuthentication) ValidateAll() error {
+// PostgresConnectionConfigMultiError, or nil if none found.
+func (m *PostgresConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHAuthentication) validate(all bool) error {
+func (m *PostgresConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.AuthConfig.(type) {
-	case *SSHAuthentication_Passphrase:
-		if v == nil {
-			err := SSHAuthenticationValidationError{
-				field:  "AuthConfig",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetPassphrase()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "Passphrase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "Passphrase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetConnectionOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetPassphrase()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SSHAuthenticationValidationError{
-					field:  "Passphrase",
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ConnectionOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "ConnectionOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *SSHAuthentication_PrivateKey:
+	if all {
+		switch v := interface{}(m.GetClientTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "ClientTls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.ConnectionConfig.(type) {
+	case *PostgresConnectionConfig_Url:
 		if v == nil {
-			err := SSHAuthenticationValidationError{
-				field:  "AuthConfig",
+			err := PostgresConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	case *PostgresConnectionConfig_Connection:
+		if v == nil {
+			err := PostgresConnectionConfigValidationError{
+				field:  "ConnectionConfig",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -3689,28 +4049,28 @@ func (m *SSHAuthentication) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPrivateKey()).(type) {
+			switch v := interface{}(m.GetConnection()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "PrivateKey",
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "Connection",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "PrivateKey",
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "Connection",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPrivateKey()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SSHAuthenticationValidationError{
-					field:  "PrivateKey",
+				return PostgresConnectionConfigValidationError{
+					field:  "Connection",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3722,19 +4082,19 @@ func (m *SSHAuthentication) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SSHAuthenticationMultiError(errors)
+		return PostgresConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHAuthenticationMultiError is an error wrapping multiple validation errors
-// returned by SSHAuthentication.ValidateAll() if the designated constraints
-// aren't met.
-type SSHAuthenticationMultiError []error
+// PostgresConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by PostgresConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHAuthenticationMultiError) Error() string {
+func (m PostgresConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3743,11 +4103,11 @@ func (m SSHAuthenticationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHAuthenticationMultiError) AllErrors() []error { return m }
+func (m PostgresConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SSHAuthenticationValidationError is the validation error returned by
-// SSHAuthentication.Validate if the designated constraints aren't met.
-type SSHAuthenticationValidationError struct {
+// PostgresConnectionConfigValidationError is the validation error returned by
+// PostgresConnectionConfig.Validate if the designated constraints aren't met.
+type PostgresConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3755,24 +4115,24 @@ type SSHAuthenticationValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHAuthenticationValidationError) Field() string { return e.field }
+func (e PostgresConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHAuthenticationValidationError) Reason() string { return e.reason }
+func (e PostgresConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHAuthenticationValidationError) Cause() error { return e.cause }
+func (e PostgresConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHAuthenticationValidationError) Key() bool { return e.key }
+func (e PostgresConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHAuthenticationValidationError) ErrorName() string {
-	return "SSHAuthenticationValidationError"
+func (e PostgresConnectionConfigValidationError) ErrorName() string {
+	return "PostgresConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SSHAuthenticationValidationError) Error() string {
+func (e PostgresConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3784,14 +4144,14 @@ func (e SSHAuthenticationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHAuthentication.%s: %s%s",
+		"invalid %sPostgresConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHAuthenticationValidationError{}
+var _ error = PostgresConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3799,46 +4159,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHAuthenticationValidationError{}
+} = PostgresConnectionConfigValidationError{}
 
-// Validate checks the field values on SSHPassphrase with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHPassphrase) Validate() error {
+// Validate checks the field values on ClientTlsConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClientTlsConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHPassphrase with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHPassphraseMultiError, or
-// nil if none found.
-func (m *SSHPassphrase) ValidateAll() error {
+// ValidateAll checks the field values on ClientTlsConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClientTlsConfigMultiError, or nil if none found.
+func (m *ClientTlsConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHPassphrase) validate(all bool) error {
+func (m *ClientTlsConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Value
+	if m.RootCert != nil {
+		// no validation rules for RootCert
+	}
 
-	if len(errors) > 0 {
-		return SSHPassphraseMultiError(errors)
+	if m.ClientCert != nil {
+		// no validation rules for ClientCert
+	}
+
+	if m.ClientKey != nil {
+		// no validation rules for ClientKey
+	}
+
+	if len(errors) > 0 {
+		return ClientTlsConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHPassphraseMultiError is an error wrapping multiple validation errors
-// returned by SSHPassphrase.ValidateAll() if the designated constraints
+// ClientTlsConfigMultiError is an error wrapping multiple validation errors
+// returned by ClientTlsConfig.ValidateAll() if the designated constraints
 // aren't met.
-type SSHPassphraseMultiError []error
+type ClientTlsConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHPassphraseMultiError) Error() string {
+func (m ClientTlsConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3847,11 +4217,11 @@ func (m SSHPassphraseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHPassphraseMultiError) AllErrors() []error { return m }
+func (m ClientTlsConfigMultiError) AllErrors() []error { return m }
 
-// SSHPassphraseValidationError is the validation error returned by
-// SSHPassphrase.Validate if the designated constraints aren't met.
-type SSHPassphraseValidationError struct {
+// ClientTlsConfigValidationError is the validation error returned by
+// ClientTlsConfig.Validate if the designated constraints aren't met.
+type ClientTlsConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3859,22 +4229,22 @@ type SSHPassphraseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHPassphraseValidationError) Field() string { return e.field }
+func (e ClientTlsConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHPassphraseValidationError) Reason() string { return e.reason }
+func (e ClientTlsConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHPassphraseValidationError) Cause() error { return e.cause }
+func (e ClientTlsConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHPassphraseValidationError) Key() bool { return e.key }
+func (e ClientTlsConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHPassphraseValidationError) ErrorName() string { return "SSHPassphraseValidationError" }
+func (e ClientTlsConfigValidationError) ErrorName() string { return "ClientTlsConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SSHPassphraseValidationError) Error() string {
+func (e ClientTlsConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3886,14 +4256,14 @@ func (e SSHPassphraseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHPassphrase.%s: %s%s",
+		"invalid %sClientTlsConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHPassphraseValidationError{}
+var _ error = ClientTlsConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3901,50 +4271,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHPassphraseValidationError{}
+} = ClientTlsConfigValidationError{}
 
-// Validate checks the field values on SSHPrivateKey with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHPrivateKey) Validate() error {
+// Validate checks the field values on SqlConnectionOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqlConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHPrivateKey with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHPrivateKeyMultiError, or
-// nil if none found.
-func (m *SSHPrivateKey) ValidateAll() error {
+// ValidateAll checks the field values on SqlConnectionOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlConnectionOptionsMultiError, or nil if none found.
+func (m *SqlConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHPrivateKey) validate(all bool) error {
+func (m *SqlConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Value
-
-	if m.Passphrase != nil {
-		// no validation rules for Passphrase
+	if m.MaxConnectionLimit != nil {
+		// no validation rules for MaxConnectionLimit
 	}
 
 	if len(errors) > 0 {
-		return SSHPrivateKeyMultiError(errors)
+		return SqlConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHPrivateKeyMultiError is an error wrapping multiple validation errors
-// returned by SSHPrivateKey.ValidateAll() if the designated constraints
-// aren't met.
-type SSHPrivateKeyMultiError []error
+// SqlConnectionOptionsMultiError is an error wrapping multiple validation
+// errors returned by SqlConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type SqlConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHPrivateKeyMultiError) Error() string {
+func (m SqlConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3953,11 +4321,11 @@ func (m SSHPrivateKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHPrivateKeyMultiError) AllErrors() []error { return m }
+func (m SqlConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// SSHPrivateKeyValidationError is the validation error returned by
-// SSHPrivateKey.Validate if the designated constraints aren't met.
-type SSHPrivateKeyValidationError struct {
+// SqlConnectionOptionsValidationError is the validation error returned by
+// SqlConnectionOptions.Validate if the designated constraints aren't met.
+type SqlConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3965,22 +4333,24 @@ type SSHPrivateKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHPrivateKeyValidationError) Field() string { return e.field }
+func (e SqlConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHPrivateKeyValidationError) Reason() string { return e.reason }
+func (e SqlConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHPrivateKeyValidationError) Cause() error { return e.cause }
+func (e SqlConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHPrivateKeyValidationError) Key() bool { return e.key }
+func (e SqlConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHPrivateKeyValidationError) ErrorName() string { return "SSHPrivateKeyValidationError" }
+func (e SqlConnectionOptionsValidationError) ErrorName() string {
+	return "SqlConnectionOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SSHPrivateKeyValidationError) Error() string {
+func (e SqlConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3992,14 +4362,14 @@ func (e SSHPrivateKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHPrivateKey.%s: %s%s",
+		"invalid %sSqlConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHPrivateKeyValidationError{}
+var _ error = SqlConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4007,24 +4377,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHPrivateKeyValidationError{}
+} = SqlConnectionOptionsValidationError{}
 
-// Validate checks the field values on PostgresConnection with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresConnection) Validate() error {
+// Validate checks the field values on SSHTunnel with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHTunnel) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresConnection with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PostgresConnectionMultiError, or nil if none found.
-func (m *PostgresConnection) ValidateAll() error {
+// ValidateAll checks the field values on SSHTunnel with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHTunnelMultiError, or nil
+// if none found.
+func (m *SSHTunnel) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresConnection) validate(all bool) error {
+func (m *SSHTunnel) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4035,30 +4405,54 @@ func (m *PostgresConnection) validate(all bool) error {
 
 	// no validation rules for Port
 
-	// no validation rules for Name
-
 	// no validation rules for User
 
-	// no validation rules for Pass
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SSHTunnelValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SSHTunnelValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SSHTunnelValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if m.SslMode != nil {
-		// no validation rules for SslMode
+	if m.KnownHostPublicKey != nil {
+		// no validation rules for KnownHostPublicKey
 	}
 
 	if len(errors) > 0 {
-		return PostgresConnectionMultiError(errors)
+		return SSHTunnelMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresConnectionMultiError is an error wrapping multiple validation errors
-// returned by PostgresConnection.ValidateAll() if the designated constraints
-// aren't met.
-type PostgresConnectionMultiError []error
+// SSHTunnelMultiError is an error wrapping multiple validation errors returned
+// by SSHTunnel.ValidateAll() if the designated constraints aren't met.
+type SSHTunnelMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresConnectionMultiError) Error() string {
+func (m SSHTunnelMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4067,11 +4461,11 @@ func (m PostgresConnectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresConnectionMultiError) AllErrors() []error { return m }
+func (m SSHTunnelMultiError) AllErrors() []error { return m }
 
-// PostgresConnectionValidationError is the validation error returned by
-// PostgresConnection.Validate if the designated constraints aren't met.
-type PostgresConnectionValidationError struct {
+// SSHTunnelValidationError is the validation error returned by
+// SSHTunnel.Validate if the designated constraints aren't met.
+type SSHTunnelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4079,24 +4473,22 @@ type PostgresConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresConnectionValidationError) Field() string { return e.field }
+func (e SSHTunnelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresConnectionValidationError) Reason() string { return e.reason }
+func (e SSHTunnelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresConnectionValidationError) Cause() error { return e.cause }
+func (e SSHTunnelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresConnectionValidationError) Key() bool { return e.key }
+func (e SSHTunnelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresConnectionValidationError) ErrorName() string {
-	return "PostgresConnectionValidationError"
-}
+func (e SSHTunnelValidationError) ErrorName() string { return "SSHTunnelValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostgresConnectionValidationError) Error() string {
+func (e SSHTunnelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4108,14 +4500,14 @@ func (e PostgresConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresConnection.%s: %s%s",
+		"invalid %sSSHTunnel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresConnectionValidationError{}
+var _ error = SSHTunnelValidationError{}
 
 var _ interface {
 	Field() string
@@ -4123,69 +4515,1190 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresConnectionValidationError{}
+} = SSHTunnelValidationError{}
 
-// Validate checks the field values on MysqlConnection with the rules defined
+// Validate checks the field values on SSHAuthentication with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *MysqlConnection) Validate() error {
+func (m *SSHAuthentication) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlConnection with the rules
+// ValidateAll checks the field values on SSHAuthentication with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlConnectionMultiError, or nil if none found.
-func (m *MysqlConnection) ValidateAll() error {
+// SSHAuthenticationMultiError, or nil if none found.
+func (m *SSHAuthentication) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlConnection) validate(all bool) error {
+func (m *SSHAuthentication) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for User
-
-	// no validation rules for Pass
-
-	// no validation rules for Protocol
-
-	// no validation rules for Host
-
-	// no validation rules for Port
-
-	// no validation rules for Name
-
-	if len(errors) > 0 {
-		return MysqlConnectionMultiError(errors)
-	}
-
-	return nil
-}
-
-// MysqlConnectionMultiError is an error wrapping multiple validation errors
-// returned by MysqlConnection.ValidateAll() if the designated constraints
-// aren't met.
-type MysqlConnectionMultiError []error
+	switch v := m.AuthConfig.(type) {
+	case *SSHAuthentication_Passphrase:
+		if v == nil {
+			err := SSHAuthenticationValidationError{
+				field:  "AuthConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m MysqlConnectionMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
+		if all {
+			switch v := interface{}(m.GetPassphrase()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "Passphrase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "Passphrase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPassphrase()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SSHAuthenticationValidationError{
+					field:  "Passphrase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SSHAuthentication_PrivateKey:
+		if v == nil {
+			err := SSHAuthenticationValidationError{
+				field:  "AuthConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPrivateKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "PrivateKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "PrivateKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrivateKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SSHAuthenticationValidationError{
+					field:  "PrivateKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SSHAuthenticationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHAuthenticationMultiError is an error wrapping multiple validation errors
+// returned by SSHAuthentication.ValidateAll() if the designated constraints
+// aren't met.
+type SSHAuthenticationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHAuthenticationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHAuthenticationMultiError) AllErrors() []error { return m }
+
+// SSHAuthenticationValidationError is the validation error returned by
+// SSHAuthentication.Validate if the designated constraints aren't met.
+type SSHAuthenticationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHAuthenticationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHAuthenticationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHAuthenticationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHAuthenticationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHAuthenticationValidationError) ErrorName() string {
+	return "SSHAuthenticationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SSHAuthenticationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHAuthentication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHAuthenticationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHAuthenticationValidationError{}
+
+// Validate checks the field values on SSHPassphrase with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHPassphrase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHPassphrase with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHPassphraseMultiError, or
+// nil if none found.
+func (m *SSHPassphrase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHPassphrase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return SSHPassphraseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHPassphraseMultiError is an error wrapping multiple validation errors
+// returned by SSHPassphrase.ValidateAll() if the designated constraints
+// aren't met.
+type SSHPassphraseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHPassphraseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHPassphraseMultiError) AllErrors() []error { return m }
+
+// SSHPassphraseValidationError is the validation error returned by
+// SSHPassphrase.Validate if the designated constraints aren't met.
+type SSHPassphraseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHPassphraseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHPassphraseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHPassphraseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHPassphraseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHPassphraseValidationError) ErrorName() string { return "SSHPassphraseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSHPassphraseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHPassphrase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHPassphraseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHPassphraseValidationError{}
+
+// Validate checks the field values on SSHPrivateKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHPrivateKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHPrivateKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHPrivateKeyMultiError, or
+// nil if none found.
+func (m *SSHPrivateKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHPrivateKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if m.Passphrase != nil {
+		// no validation rules for Passphrase
+	}
+
+	if len(errors) > 0 {
+		return SSHPrivateKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHPrivateKeyMultiError is an error wrapping multiple validation errors
+// returned by SSHPrivateKey.ValidateAll() if the designated constraints
+// aren't met.
+type SSHPrivateKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHPrivateKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHPrivateKeyMultiError) AllErrors() []error { return m }
+
+// SSHPrivateKeyValidationError is the validation error returned by
+// SSHPrivateKey.Validate if the designated constraints aren't met.
+type SSHPrivateKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHPrivateKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHPrivateKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHPrivateKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHPrivateKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHPrivateKeyValidationError) ErrorName() string { return "SSHPrivateKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSHPrivateKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHPrivateKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHPrivateKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHPrivateKeyValidationError{}
+
+// Validate checks the field values on PostgresConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresConnectionMultiError, or nil if none found.
+func (m *PostgresConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	if m.SslMode != nil {
+		// no validation rules for SslMode
+	}
+
+	if len(errors) > 0 {
+		return PostgresConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresConnectionMultiError is an error wrapping multiple validation errors
+// returned by PostgresConnection.ValidateAll() if the designated constraints
+// aren't met.
+type PostgresConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresConnectionMultiError) AllErrors() []error { return m }
+
+// PostgresConnectionValidationError is the validation error returned by
+// PostgresConnection.Validate if the designated constraints aren't met.
+type PostgresConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresConnectionValidationError) ErrorName() string {
+	return "PostgresConnectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresConnectionValidationError{}
+
+// Validate checks the field values on MysqlConnection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MysqlConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlConnectionMultiError, or nil if none found.
+func (m *MysqlConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	// no validation rules for Protocol
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return MysqlConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlConnectionMultiError is an error wrapping multiple validation errors
+// returned by MysqlConnection.ValidateAll() if the designated constraints
+// aren't met.
+type MysqlConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlConnectionMultiError) AllErrors() []error { return m }
+
+// MysqlConnectionValidationError is the validation error returned by
+// MysqlConnection.Validate if the designated constraints aren't met.
+type MysqlConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlConnectionValidationError) ErrorName() string { return "MysqlConnectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MysqlConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlConnectionValidationError{}
+
+// Validate checks the field values on MysqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlConnectionConfigMultiError, or nil if none found.
+func (m *MysqlConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConnectionOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlConnectionConfigValidationError{
+				field:  "ConnectionOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.ConnectionConfig.(type) {
+	case *MysqlConnectionConfig_Url:
+		if v == nil {
+			err := MysqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	case *MysqlConnectionConfig_Connection:
+		if v == nil {
+			err := MysqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConnection()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlConnectionConfigValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return MysqlConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlConnectionConfigMultiError) AllErrors() []error { return m }
+
+// MysqlConnectionConfigValidationError is the validation error returned by
+// MysqlConnectionConfig.Validate if the designated constraints aren't met.
+type MysqlConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlConnectionConfigValidationError) ErrorName() string {
+	return "MysqlConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlConnectionConfigValidationError{}
+
+// Validate checks the field values on AwsS3ConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3ConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3ConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3ConnectionConfigMultiError, or nil if none found.
+func (m *AwsS3ConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3ConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketArn
+
+	// no validation rules for Bucket
+
+	if m.PathPrefix != nil {
+		// no validation rules for PathPrefix
+	}
+
+	if m.Credentials != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AwsS3ConnectionConfigValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AwsS3ConnectionConfigValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AwsS3ConnectionConfigValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if m.Endpoint != nil {
+		// no validation rules for Endpoint
+	}
+
+	if len(errors) > 0 {
+		return AwsS3ConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3ConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by AwsS3ConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type AwsS3ConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3ConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3ConnectionConfigMultiError) AllErrors() []error { return m }
+
+// AwsS3ConnectionConfigValidationError is the validation error returned by
+// AwsS3ConnectionConfig.Validate if the designated constraints aren't met.
+type AwsS3ConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3ConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3ConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3ConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3ConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3ConnectionConfigValidationError) ErrorName() string {
+	return "AwsS3ConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsS3ConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3ConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3ConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3ConnectionConfigValidationError{}
+
+// Validate checks the field values on AwsS3Credentials with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3Credentials) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3Credentials with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3CredentialsMultiError, or nil if none found.
+func (m *AwsS3Credentials) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3Credentials) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Profile != nil {
+		// no validation rules for Profile
+	}
+
+	if m.AccessKeyId != nil {
+		// no validation rules for AccessKeyId
+	}
+
+	if m.SecretAccessKey != nil {
+		// no validation rules for SecretAccessKey
+	}
+
+	if m.SessionToken != nil {
+		// no validation rules for SessionToken
+	}
+
+	if m.FromEc2Role != nil {
+		// no validation rules for FromEc2Role
+	}
+
+	if m.RoleArn != nil {
+		// no validation rules for RoleArn
+	}
+
+	if m.RoleExternalId != nil {
+		// no validation rules for RoleExternalId
+	}
+
+	if len(errors) > 0 {
+		return AwsS3CredentialsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3CredentialsMultiError is an error wrapping multiple validation errors
+// returned by AwsS3Credentials.ValidateAll() if the designated constraints
+// aren't met.
+type AwsS3CredentialsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3CredentialsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3CredentialsMultiError) AllErrors() []error { return m }
+
+// AwsS3CredentialsValidationError is the validation error returned by
+// AwsS3Credentials.Validate if the designated constraints aren't met.
+type AwsS3CredentialsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3CredentialsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3CredentialsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3CredentialsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3CredentialsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3CredentialsValidationError) ErrorName() string { return "AwsS3CredentialsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AwsS3CredentialsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3Credentials.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3CredentialsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3CredentialsValidationError{}
+
+// Validate checks the field values on GcpCloudStorageConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GcpCloudStorageConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GcpCloudStorageConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GcpCloudStorageConnectionConfigMultiError, or nil if none found.
+func (m *GcpCloudStorageConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GcpCloudStorageConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bucket
+
+	if m.PathPrefix != nil {
+		// no validation rules for PathPrefix
+	}
+
+	if m.ServiceAccountCredentials != nil {
+		// no validation rules for ServiceAccountCredentials
+	}
+
+	if len(errors) > 0 {
+		return GcpCloudStorageConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// GcpCloudStorageConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by GcpCloudStorageConnectionConfig.ValidateAll()
+// if the designated constraints aren't met.
+type GcpCloudStorageConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GcpCloudStorageConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlConnectionMultiError) AllErrors() []error { return m }
+func (m GcpCloudStorageConnectionConfigMultiError) AllErrors() []error { return m }
 
-// MysqlConnectionValidationError is the validation error returned by
-// MysqlConnection.Validate if the designated constraints aren't met.
-type MysqlConnectionValidationError struct {
+// GcpCloudStorageConnectionConfigValidationError is the validation error
+// returned by GcpCloudStorageConnectionConfig.Validate if the designated
+// constraints aren't met.
+type GcpCloudStorageConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4193,22 +5706,24 @@ type MysqlConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlConnectionValidationError) Field() string { return e.field }
+func (e GcpCloudStorageConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlConnectionValidationError) Reason() string { return e.reason }
+func (e GcpCloudStorageConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlConnectionValidationError) Cause() error { return e.cause }
+func (e GcpCloudStorageConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlConnectionValidationError) Key() bool { return e.key }
+func (e GcpCloudStorageConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlConnectionValidationError) ErrorName() string { return "MysqlConnectionValidationError" }
+func (e GcpCloudStorageConnectionConfigValidationError) ErrorName() string {
+	return "GcpCloudStorageConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MysqlConnectionValidationError) Error() string {
+func (e GcpCloudStorageConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4220,14 +5735,14 @@ func (e MysqlConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlConnection.%s: %s%s",
+		"invalid %sGcpCloudStorageConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlConnectionValidationError{}
+var _ error = GcpCloudStorageConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4235,160 +5750,157 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlConnectionValidationError{}
+} = GcpCloudStorageConnectionConfigValidationError{}
 
-// Validate checks the field values on MysqlConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlConnectionConfig) Validate() error {
+// Validate checks the field values on IsConnectionNameAvailableRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IsConnectionNameAvailableRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlConnectionConfigMultiError, or nil if none found.
-func (m *MysqlConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on IsConnectionNameAvailableRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IsConnectionNameAvailableRequestMultiError, or nil if none found.
+func (m *IsConnectionNameAvailableRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlConnectionConfig) validate(all bool) error {
+func (m *IsConnectionNameAvailableRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTunnel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlConnectionConfigValidationError{
-				field:  "Tunnel",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for AccountId
+
+	// no validation rules for ConnectionName
+
+	if len(errors) > 0 {
+		return IsConnectionNameAvailableRequestMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetConnectionOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlConnectionConfigValidationError{
-				field:  "ConnectionOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// IsConnectionNameAvailableRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IsConnectionNameAvailableRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsConnectionNameAvailableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	switch v := m.ConnectionConfig.(type) {
-	case *MysqlConnectionConfig_Url:
-		if v == nil {
-			err := MysqlConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Url
-	case *MysqlConnectionConfig_Connection:
-		if v == nil {
-			err := MysqlConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// AllErrors returns a list of validation violation errors.
+func (m IsConnectionNameAvailableRequestMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(m.GetConnection()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlConnectionConfigValidationError{
-						field:  "Connection",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlConnectionConfigValidationError{
-						field:  "Connection",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MysqlConnectionConfigValidationError{
-					field:  "Connection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// IsConnectionNameAvailableRequestValidationError is the validation error
+// returned by IsConnectionNameAvailableRequest.Validate if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsConnectionNameAvailableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsConnectionNameAvailableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsConnectionNameAvailableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsConnectionNameAvailableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsConnectionNameAvailableRequestValidationError) ErrorName() string {
+	return "IsConnectionNameAvailableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsConnectionNameAvailableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsConnectionNameAvailableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsConnectionNameAvailableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsConnectionNameAvailableRequestValidationError{}
+
+// Validate checks the field values on IsConnectionNameAvailableResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IsConnectionNameAvailableResponse) Validate() error {
+	return m.validate(false)
+}
 
-	default:
-		_ = v // ensures v is used
+// ValidateAll checks the field values on IsConnectionNameAvailableResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IsConnectionNameAvailableResponseMultiError, or nil if none found.
+func (m *IsConnectionNameAvailableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsConnectionNameAvailableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for IsAvailable
+
 	if len(errors) > 0 {
-		return MysqlConnectionConfigMultiError(errors)
+		return IsConnectionNameAvailableResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by MysqlConnectionConfig.ValidateAll() if the designated
+// IsConnectionNameAvailableResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IsConnectionNameAvailableResponse.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlConnectionConfigMultiError []error
+type IsConnectionNameAvailableResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlConnectionConfigMultiError) Error() string {
+func (m IsConnectionNameAvailableResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4397,11 +5909,12 @@ func (m MysqlConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlConnectionConfigMultiError) AllErrors() []error { return m }
+func (m IsConnectionNameAvailableResponseMultiError) AllErrors() []error { return m }
 
-// MysqlConnectionConfigValidationError is the validation error returned by
-// MysqlConnectionConfig.Validate if the designated constraints aren't met.
-type MysqlConnectionConfigValidationError struct {
+// IsConnectionNameAvailableResponseValidationError is the validation error
+// returned by IsConnectionNameAvailableResponse.Validate if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4409,24 +5922,24 @@ type MysqlConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlConnectionConfigValidationError) Field() string { return e.field }
+func (e IsConnectionNameAvailableResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlConnectionConfigValidationError) Reason() string { return e.reason }
+func (e IsConnectionNameAvailableResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlConnectionConfigValidationError) Cause() error { return e.cause }
+func (e IsConnectionNameAvailableResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlConnectionConfigValidationError) Key() bool { return e.key }
+func (e IsConnectionNameAvailableResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlConnectionConfigValidationError) ErrorName() string {
-	return "MysqlConnectionConfigValidationError"
+func (e IsConnectionNameAvailableResponseValidationError) ErrorName() string {
+	return "IsConnectionNameAvailableResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlConnectionConfigValidationError) Error() string {
+func (e IsConnectionNameAvailableResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4438,14 +5951,14 @@ func (e MysqlConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlConnectionConfig.%s: %s%s",
+		"invalid %sIsConnectionNameAvailableResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlConnectionConfigValidationError{}
+var _ error = IsConnectionNameAvailableResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4453,93 +5966,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlConnectionConfigValidationError{}
+} = IsConnectionNameAvailableResponseValidationError{}
 
-// Validate checks the field values on AwsS3ConnectionConfig with the rules
+// Validate checks the field values on CheckSqlQueryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3ConnectionConfig) Validate() error {
+func (m *CheckSqlQueryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3ConnectionConfig with the rules
+// ValidateAll checks the field values on CheckSqlQueryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3ConnectionConfigMultiError, or nil if none found.
-func (m *AwsS3ConnectionConfig) ValidateAll() error {
+// CheckSqlQueryRequestMultiError, or nil if none found.
+func (m *CheckSqlQueryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3ConnectionConfig) validate(all bool) error {
+func (m *CheckSqlQueryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BucketArn
-
-	// no validation rules for Bucket
-
-	if m.PathPrefix != nil {
-		// no validation rules for PathPrefix
-	}
-
-	if m.Credentials != nil {
-
-		if all {
-			switch v := interface{}(m.GetCredentials()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AwsS3ConnectionConfigValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AwsS3ConnectionConfigValidationError{
-						field:  "Credentials",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AwsS3ConnectionConfigValidationError{
-					field:  "Credentials",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.Region != nil {
-		// no validation rules for Region
-	}
+	// no validation rules for Id
 
-	if m.Endpoint != nil {
-		// no validation rules for Endpoint
-	}
+	// no validation rules for Query
 
 	if len(errors) > 0 {
-		return AwsS3ConnectionConfigMultiError(errors)
+		return CheckSqlQueryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3ConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by AwsS3ConnectionConfig.ValidateAll() if the designated
+// CheckSqlQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckSqlQueryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AwsS3ConnectionConfigMultiError []error
+type CheckSqlQueryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3ConnectionConfigMultiError) Error() string {
+func (m CheckSqlQueryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4548,11 +6016,11 @@ func (m AwsS3ConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3ConnectionConfigMultiError) AllErrors() []error { return m }
+func (m CheckSqlQueryRequestMultiError) AllErrors() []error { return m }
 
-// AwsS3ConnectionConfigValidationError is the validation error returned by
-// AwsS3ConnectionConfig.Validate if the designated constraints aren't met.
-type AwsS3ConnectionConfigValidationError struct {
+// CheckSqlQueryRequestValidationError is the validation error returned by
+// CheckSqlQueryRequest.Validate if the designated constraints aren't met.
+type CheckSqlQueryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4560,24 +6028,24 @@ type AwsS3ConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3ConnectionConfigValidationError) Field() string { return e.field }
+func (e CheckSqlQueryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3ConnectionConfigValidationError) Reason() string { return e.reason }
+func (e CheckSqlQueryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3ConnectionConfigValidationError) Cause() error { return e.cause }
+func (e CheckSqlQueryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3ConnectionConfigValidationError) Key() bool { return e.key }
+func (e CheckSqlQueryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3ConnectionConfigValidationError) ErrorName() string {
-	return "AwsS3ConnectionConfigValidationError"
+func (e CheckSqlQueryRequestValidationError) ErrorName() string {
+	return "CheckSqlQueryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3ConnectionConfigValidationError) Error() string {
+func (e CheckSqlQueryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4589,14 +6057,14 @@ func (e AwsS3ConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3ConnectionConfig.%s: %s%s",
+		"invalid %sCheckSqlQueryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3ConnectionConfigValidationError{}
+var _ error = CheckSqlQueryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4604,72 +6072,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3ConnectionConfigValidationError{}
+} = CheckSqlQueryRequestValidationError{}
 
-// Validate checks the field values on AwsS3Credentials with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3Credentials) Validate() error {
+// Validate checks the field values on CheckSqlQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSqlQueryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3Credentials with the rules
+// ValidateAll checks the field values on CheckSqlQueryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3CredentialsMultiError, or nil if none found.
-func (m *AwsS3Credentials) ValidateAll() error {
+// CheckSqlQueryResponseMultiError, or nil if none found.
+func (m *CheckSqlQueryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3Credentials) validate(all bool) error {
+func (m *CheckSqlQueryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Profile != nil {
-		// no validation rules for Profile
-	}
-
-	if m.AccessKeyId != nil {
-		// no validation rules for AccessKeyId
-	}
-
-	if m.SecretAccessKey != nil {
-		// no validation rules for SecretAccessKey
-	}
-
-	if m.SessionToken != nil {
-		// no validation rules for SessionToken
-	}
-
-	if m.FromEc2Role != nil {
-		// no validation rules for FromEc2Role
-	}
-
-	if m.RoleArn != nil {
-		// no validation rules for RoleArn
-	}
+	// no validation rules for IsValid
 
-	if m.RoleExternalId != nil {
-		// no validation rules for RoleExternalId
+	if m.ErorrMessage != nil {
+		// no validation rules for ErorrMessage
 	}
 
 	if len(errors) > 0 {
-		return AwsS3CredentialsMultiError(errors)
+		return CheckSqlQueryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3CredentialsMultiError is an error wrapping multiple validation errors
-// returned by AwsS3Credentials.ValidateAll() if the designated constraints
-// aren't met.
-type AwsS3CredentialsMultiError []error
+// CheckSqlQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckSqlQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckSqlQueryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3CredentialsMultiError) Error() string {
+func (m CheckSqlQueryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4678,11 +6124,11 @@ func (m AwsS3CredentialsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3CredentialsMultiError) AllErrors() []error { return m }
+func (m CheckSqlQueryResponseMultiError) AllErrors() []error { return m }
 
-// AwsS3CredentialsValidationError is the validation error returned by
-// AwsS3Credentials.Validate if the designated constraints aren't met.
-type AwsS3CredentialsValidationError struct {
+// CheckSqlQueryResponseValidationError is the validation error returned by
+// CheckSqlQueryResponse.Validate if the designated constraints aren't met.
+type CheckSqlQueryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4690,22 +6136,24 @@ type AwsS3CredentialsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3CredentialsValidationError) Field() string { return e.field }
+func (e CheckSqlQueryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3CredentialsValidationError) Reason() string { return e.reason }
+func (e CheckSqlQueryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3CredentialsValidationError) Cause() error { return e.cause }
+func (e CheckSqlQueryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3CredentialsValidationError) Key() bool { return e.key }
+func (e CheckSqlQueryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3CredentialsValidationError) ErrorName() string { return "AwsS3CredentialsValidationError" }
+func (e CheckSqlQueryResponseValidationError) ErrorName() string {
+	return "CheckSqlQueryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AwsS3CredentialsValidationError) Error() string {
+func (e CheckSqlQueryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4717,14 +6165,14 @@ func (e AwsS3CredentialsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3Credentials.%s: %s%s",
+		"invalid %sCheckSqlQueryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3CredentialsValidationError{}
+var _ error = CheckSqlQueryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4732,54 +6180,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3CredentialsValidationError{}
+} = CheckSqlQueryResponseValidationError{}
 
-// Validate checks the field values on GcpCloudStorageConnectionConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GcpCloudStorageConnectionConfig) Validate() error {
+// Validate checks the field values on ColumnTags with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnTags) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GcpCloudStorageConnectionConfig with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GcpCloudStorageConnectionConfigMultiError, or nil if none found.
-func (m *GcpCloudStorageConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on ColumnTags with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnTagsMultiError, or
+// nil if none found.
+func (m *ColumnTags) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GcpCloudStorageConnectionConfig) validate(all bool) error {
+func (m *ColumnTags) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Bucket
+	// no validation rules for Schema
 
-	if m.PathPrefix != nil {
-		// no validation rules for PathPrefix
-	}
+	// no validation rules for Table
 
-	if m.ServiceAccountCredentials != nil {
-		// no validation rules for ServiceAccountCredentials
-	}
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return GcpCloudStorageConnectionConfigMultiError(errors)
+		return ColumnTagsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GcpCloudStorageConnectionConfigMultiError is an error wrapping multiple
-// validation errors returned by GcpCloudStorageConnectionConfig.ValidateAll()
-// if the designated constraints aren't met.
-type GcpCloudStorageConnectionConfigMultiError []error
+// ColumnTagsMultiError is an error wrapping multiple validation errors
+// returned by ColumnTags.ValidateAll() if the designated constraints aren't met.
+type ColumnTagsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GcpCloudStorageConnectionConfigMultiError) Error() string {
+func (m ColumnTagsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4788,12 +6231,11 @@ func (m GcpCloudStorageConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GcpCloudStorageConnectionConfigMultiError) AllErrors() []error { return m }
+func (m ColumnTagsMultiError) AllErrors() []error { return m }
 
-// GcpCloudStorageConnectionConfigValidationError is the validation error
-// returned by GcpCloudStorageConnectionConfig.Validate if the designated
-// constraints aren't met.
-type GcpCloudStorageConnectionConfigValidationError struct {
+// ColumnTagsValidationError is the validation error returned by
+// ColumnTags.Validate if the designated constraints aren't met.
+type ColumnTagsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4801,24 +6243,22 @@ type GcpCloudStorageConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e GcpCloudStorageConnectionConfigValidationError) Field() string { return e.field }
+func (e ColumnTagsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GcpCloudStorageConnectionConfigValidationError) Reason() string { return e.reason }
+func (e ColumnTagsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GcpCloudStorageConnectionConfigValidationError) Cause() error { return e.cause }
+func (e ColumnTagsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GcpCloudStorageConnectionConfigValidationError) Key() bool { return e.key }
+func (e ColumnTagsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GcpCloudStorageConnectionConfigValidationError) ErrorName() string {
-	return "GcpCloudStorageConnectionConfigValidationError"
-}
+func (e ColumnTagsValidationError) ErrorName() string { return "ColumnTagsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GcpCloudStorageConnectionConfigValidationError) Error() string {
+func (e ColumnTagsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4830,14 +6270,14 @@ func (e GcpCloudStorageConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGcpCloudStorageConnectionConfig.%s: %s%s",
+		"invalid %sColumnTags.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GcpCloudStorageConnectionConfigValidationError{}
+var _ error = ColumnTagsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4845,50 +6285,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GcpCloudStorageConnectionConfigValidationError{}
+} = ColumnTagsValidationError{}
 
-// Validate checks the field values on IsConnectionNameAvailableRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IsConnectionNameAvailableRequest) Validate() error {
+// Validate checks the field values on GetConnectionColumnTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionColumnTagsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsConnectionNameAvailableRequest with
+// ValidateAll checks the field values on GetConnectionColumnTagsRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// IsConnectionNameAvailableRequestMultiError, or nil if none found.
-func (m *IsConnectionNameAvailableRequest) ValidateAll() error {
+// GetConnectionColumnTagsRequestMultiError, or nil if none found.
+func (m *GetConnectionColumnTagsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsConnectionNameAvailableRequest) validate(all bool) error {
+func (m *GetConnectionColumnTagsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
-
-	// no validation rules for ConnectionName
+	// no validation rules for ConnectionId
 
 	if len(errors) > 0 {
-		return IsConnectionNameAvailableRequestMultiError(errors)
+		return GetConnectionColumnTagsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsConnectionNameAvailableRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IsConnectionNameAvailableRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IsConnectionNameAvailableRequestMultiError []error
+// GetConnectionColumnTagsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionColumnTagsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetConnectionColumnTagsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsConnectionNameAvailableRequestMultiError) Error() string {
+func (m GetConnectionColumnTagsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4897,12 +6333,12 @@ func (m IsConnectionNameAvailableRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsConnectionNameAvailableRequestMultiError) AllErrors() []error { return m }
+func (m GetConnectionColumnTagsRequestMultiError) AllErrors() []error { return m }
 
-// IsConnectionNameAvailableRequestValidationError is the validation error
-// returned by IsConnectionNameAvailableRequest.Validate if the designated
+// GetConnectionColumnTagsRequestValidationError is the validation error
+// returned by GetConnectionColumnTagsRequest.Validate if the designated
 // constraints aren't met.
-type IsConnectionNameAvailableRequestValidationError struct {
+type GetConnectionColumnTagsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4910,24 +6346,24 @@ type IsConnectionNameAvailableRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsConnectionNameAvailableRequestValidationError) Field() string { return e.field }
+func (e GetConnectionColumnTagsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsConnectionNameAvailableRequestValidationError) Reason() string { return e.reason }
+func (e GetConnectionColumnTagsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsConnectionNameAvailableRequestValidationError) Cause() error { return e.cause }
+func (e GetConnectionColumnTagsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsConnectionNameAvailableRequestValidationError) Key() bool { return e.key }
+func (e GetConnectionColumnTagsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsConnectionNameAvailableRequestValidationError) ErrorName() string {
-	return "IsConnectionNameAvailableRequestValidationError"
+func (e GetConnectionColumnTagsRequestValidationError) ErrorName() string {
+	return "GetConnectionColumnTagsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsConnectionNameAvailableRequestValidationError) Error() string {
+func (e GetConnectionColumnTagsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4939,14 +6375,14 @@ func (e IsConnectionNameAvailableRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsConnectionNameAvailableRequest.%s: %s%s",
+		"invalid %sGetConnectionColumnTagsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsConnectionNameAvailableRequestValidationError{}
+var _ error = GetConnectionColumnTagsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4954,48 +6390,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsConnectionNameAvailableRequestValidationError{}
+} = GetConnectionColumnTagsRequestValidationError{}
 
-// Validate checks the field values on IsConnectionNameAvailableResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IsConnectionNameAvailableResponse) Validate() error {
+// Validate checks the field values on GetConnectionColumnTagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionColumnTagsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsConnectionNameAvailableResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IsConnectionNameAvailableResponseMultiError, or nil if none found.
-func (m *IsConnectionNameAvailableResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionColumnTagsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConnectionColumnTagsResponseMultiError, or nil if none found.
+func (m *GetConnectionColumnTagsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsConnectionNameAvailableResponse) validate(all bool) error {
+func (m *GetConnectionColumnTagsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsAvailable
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IsConnectionNameAvailableResponseMultiError(errors)
+		return GetConnectionColumnTagsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsConnectionNameAvailableResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IsConnectionNameAvailableResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IsConnectionNameAvailableResponseMultiError []error
+// GetConnectionColumnTagsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionColumnTagsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetConnectionColumnTagsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsConnectionNameAvailableResponseMultiError) Error() string {
+func (m GetConnectionColumnTagsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5004,12 +6470,12 @@ func (m IsConnectionNameAvailableResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsConnectionNameAvailableResponseMultiError) AllErrors() []error { return m }
+func (m GetConnectionColumnTagsResponseMultiError) AllErrors() []error { return m }
 
-// IsConnectionNameAvailableResponseValidationError is the validation error
-// returned by IsConnectionNameAvailableResponse.Validate if the designated
+// GetConnectionColumnTagsResponseValidationError is the validation error
+// returned by GetConnectionColumnTagsResponse.Validate if the designated
 // constraints aren't met.
-type IsConnectionNameAvailableResponseValidationError struct {
+type GetConnectionColumnTagsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5017,24 +6483,24 @@ type IsConnectionNameAvailableResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsConnectionNameAvailableResponseValidationError) Field() string { return e.field }
+func (e GetConnectionColumnTagsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsConnectionNameAvailableResponseValidationError) Reason() string { return e.reason }
+func (e GetConnectionColumnTagsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsConnectionNameAvailableResponseValidationError) Cause() error { return e.cause }
+func (e GetConnectionColumnTagsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsConnectionNameAvailableResponseValidationError) Key() bool { return e.key }
+func (e GetConnectionColumnTagsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsConnectionNameAvailableResponseValidationError) ErrorName() string {
-	return "IsConnectionNameAvailableResponseValidationError"
+func (e GetConnectionColumnTagsResponseValidationError) ErrorName() string {
+	return "GetConnectionColumnTagsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsConnectionNameAvailableResponseValidationError) Error() string {
+func (e GetConnectionColumnTagsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5046,14 +6512,14 @@ func (e IsConnectionNameAvailableResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsConnectionNameAvailableResponse.%s: %s%s",
+		"invalid %sGetConnectionColumnTagsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsConnectionNameAvailableResponseValidationError{}
+var _ error = GetConnectionColumnTagsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5061,48 +6527,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsConnectionNameAvailableResponseValidationError{}
+} = GetConnectionColumnTagsResponseValidationError{}
 
-// Validate checks the field values on CheckSqlQueryRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetConnectionColumnTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckSqlQueryRequest) Validate() error {
+func (m *SetConnectionColumnTagsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckSqlQueryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CheckSqlQueryRequestMultiError, or nil if none found.
-func (m *CheckSqlQueryRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetConnectionColumnTagsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetConnectionColumnTagsRequestMultiError, or nil if none found.
+func (m *SetConnectionColumnTagsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckSqlQueryRequest) validate(all bool) error {
+func (m *SetConnectionColumnTagsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ConnectionId
 
-	// no validation rules for Query
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetConnectionColumnTagsRequestValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetConnectionColumnTagsRequestValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetConnectionColumnTagsRequestValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CheckSqlQueryRequestMultiError(errors)
+		return SetConnectionColumnTagsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckSqlQueryRequestMultiError is an error wrapping multiple validation
-// errors returned by CheckSqlQueryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CheckSqlQueryRequestMultiError []error
+// SetConnectionColumnTagsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetConnectionColumnTagsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetConnectionColumnTagsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckSqlQueryRequestMultiError) Error() string {
+func (m SetConnectionColumnTagsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5111,11 +6609,12 @@ func (m CheckSqlQueryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckSqlQueryRequestMultiError) AllErrors() []error { return m }
+func (m SetConnectionColumnTagsRequestMultiError) AllErrors() []error { return m }
 
-// CheckSqlQueryRequestValidationError is the validation error returned by
-// CheckSqlQueryRequest.Validate if the designated constraints aren't met.
-type CheckSqlQueryRequestValidationError struct {
+// SetConnectionColumnTagsRequestValidationError is the validation error
+// returned by SetConnectionColumnTagsRequest.Validate if the designated
+// constraints aren't met.
+type SetConnectionColumnTagsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5123,24 +6622,24 @@ type CheckSqlQueryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckSqlQueryRequestValidationError) Field() string { return e.field }
+func (e SetConnectionColumnTagsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckSqlQueryRequestValidationError) Reason() string { return e.reason }
+func (e SetConnectionColumnTagsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckSqlQueryRequestValidationError) Cause() error { return e.cause }
+func (e SetConnectionColumnTagsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckSqlQueryRequestValidationError) Key() bool { return e.key }
+func (e SetConnectionColumnTagsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckSqlQueryRequestValidationError) ErrorName() string {
-	return "CheckSqlQueryRequestValidationError"
+func (e SetConnectionColumnTagsRequestValidationError) ErrorName() string {
+	return "SetConnectionColumnTagsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckSqlQueryRequestValidationError) Error() string {
+func (e SetConnectionColumnTagsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5152,14 +6651,14 @@ func (e CheckSqlQueryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckSqlQueryRequest.%s: %s%s",
+		"invalid %sSetConnectionColumnTagsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckSqlQueryRequestValidationError{}
+var _ error = SetConnectionColumnTagsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5167,50 +6666,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckSqlQueryRequestValidationError{}
+} = SetConnectionColumnTagsRequestValidationError{}
 
-// Validate checks the field values on CheckSqlQueryResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetConnectionColumnTagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckSqlQueryResponse) Validate() error {
+func (m *SetConnectionColumnTagsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckSqlQueryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CheckSqlQueryResponseMultiError, or nil if none found.
-func (m *CheckSqlQueryResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetConnectionColumnTagsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetConnectionColumnTagsResponseMultiError, or nil if none found.
+func (m *SetConnectionColumnTagsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckSqlQueryResponse) validate(all bool) error {
+func (m *SetConnectionColumnTagsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsValid
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetConnectionColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetConnectionColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetConnectionColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.ErorrMessage != nil {
-		// no validation rules for ErorrMessage
 	}
 
 	if len(errors) > 0 {
-		return CheckSqlQueryResponseMultiError(errors)
+		return SetConnectionColumnTagsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckSqlQueryResponseMultiError is an error wrapping multiple validation
-// errors returned by CheckSqlQueryResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CheckSqlQueryResponseMultiError []error
+// SetConnectionColumnTagsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetConnectionColumnTagsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetConnectionColumnTagsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckSqlQueryResponseMultiError) Error() string {
+func (m SetConnectionColumnTagsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5219,11 +6746,12 @@ func (m CheckSqlQueryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckSqlQueryResponseMultiError) AllErrors() []error { return m }
+func (m SetConnectionColumnTagsResponseMultiError) AllErrors() []error { return m }
 
-// CheckSqlQueryResponseValidationError is the validation error returned by
-// CheckSqlQueryResponse.Validate if the designated constraints aren't met.
-type CheckSqlQueryResponseValidationError struct {
+// SetConnectionColumnTagsResponseValidationError is the validation error
+// returned by SetConnectionColumnTagsResponse.Validate if the designated
+// constraints aren't met.
+type SetConnectionColumnTagsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5231,24 +6759,24 @@ type CheckSqlQueryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckSqlQueryResponseValidationError) Field() string { return e.field }
+func (e SetConnectionColumnTagsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckSqlQueryResponseValidationError) Reason() string { return e.reason }
+func (e SetConnectionColumnTagsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckSqlQueryResponseValidationError) Cause() error { return e.cause }
+func (e SetConnectionColumnTagsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckSqlQueryResponseValidationError) Key() bool { return e.key }
+func (e SetConnectionColumnTagsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckSqlQueryResponseValidationError) ErrorName() string {
-	return "CheckSqlQueryResponseValidationError"
+func (e SetConnectionColumnTagsResponseValidationError) ErrorName() string {
+	return "SetConnectionColumnTagsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckSqlQueryResponseValidationError) Error() string {
+func (e SetConnectionColumnTagsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5260,14 +6788,14 @@ func (e CheckSqlQueryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckSqlQueryResponse.%s: %s%s",
+		"invalid %sSetConnectionColumnTagsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckSqlQueryResponseValidationError{}
+var _ error = SetConnectionColumnTagsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5275,4 +6803,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckSqlQueryResponseValidationError{}
+} = SetConnectionColumnTagsResponseValidationError{}
@@ -1465,6 +1465,108 @@ var _ interface {
 	ErrorName() string
 } = MssqlSchemaConfigValidationError{}
 
+// Validate checks the field values on ClickhouseSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClickhouseSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickhouseSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClickhouseSchemaConfigMultiError, or nil if none found.
+func (m *ClickhouseSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickhouseSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ClickhouseSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickhouseSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by ClickhouseSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type ClickhouseSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickhouseSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickhouseSchemaConfigMultiError) AllErrors() []error { return m }
+
+// ClickhouseSchemaConfigValidationError is the validation error returned by
+// ClickhouseSchemaConfig.Validate if the designated constraints aren't met.
+type ClickhouseSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickhouseSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickhouseSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickhouseSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickhouseSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickhouseSchemaConfigValidationError) ErrorName() string {
+	return "ClickhouseSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClickhouseSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickhouseSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickhouseSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickhouseSchemaConfigValidationError{}
+
 // Validate checks the field values on AwsS3SchemaConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2242,6 +2344,47 @@ func (m *ConnectionSchemaConfig) validate(all bool) error {
 			}
 		}
 
+	case *ConnectionSchemaConfig_ClickhouseConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetClickhouseConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "ClickhouseConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "ClickhouseConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClickhouseConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "ClickhouseConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -6205,3 +6348,1389 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetTableRowCountResponseValidationError{}
+
+// Validate checks the field values on CompareConnectionSchemasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareConnectionSchemasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareConnectionSchemasRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CompareConnectionSchemasRequestMultiError, or nil if none found.
+func (m *CompareConnectionSchemasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareConnectionSchemasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceConnectionId
+
+	// no validation rules for DestinationConnectionId
+
+	// no validation rules for IncludeMigrationStatements
+
+	if len(errors) > 0 {
+		return CompareConnectionSchemasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareConnectionSchemasRequestMultiError is an error wrapping multiple
+// validation errors returned by CompareConnectionSchemasRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CompareConnectionSchemasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareConnectionSchemasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareConnectionSchemasRequestMultiError) AllErrors() []error { return m }
+
+// CompareConnectionSchemasRequestValidationError is the validation error
+// returned by CompareConnectionSchemasRequest.Validate if the designated
+// constraints aren't met.
+type CompareConnectionSchemasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareConnectionSchemasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareConnectionSchemasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareConnectionSchemasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareConnectionSchemasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareConnectionSchemasRequestValidationError) ErrorName() string {
+	return "CompareConnectionSchemasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareConnectionSchemasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareConnectionSchemasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareConnectionSchemasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareConnectionSchemasRequestValidationError{}
+
+// Validate checks the field values on CompareConnectionSchemasResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CompareConnectionSchemasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareConnectionSchemasResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CompareConnectionSchemasResponseMultiError, or nil if none found.
+func (m *CompareConnectionSchemasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareConnectionSchemasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareConnectionSchemasResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareConnectionSchemasResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareConnectionSchemasResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CompareConnectionSchemasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareConnectionSchemasResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CompareConnectionSchemasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CompareConnectionSchemasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareConnectionSchemasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareConnectionSchemasResponseMultiError) AllErrors() []error { return m }
+
+// CompareConnectionSchemasResponseValidationError is the validation error
+// returned by CompareConnectionSchemasResponse.Validate if the designated
+// constraints aren't met.
+type CompareConnectionSchemasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareConnectionSchemasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareConnectionSchemasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareConnectionSchemasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareConnectionSchemasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareConnectionSchemasResponseValidationError) ErrorName() string {
+	return "CompareConnectionSchemasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareConnectionSchemasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareConnectionSchemasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareConnectionSchemasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareConnectionSchemasResponseValidationError{}
+
+// Validate checks the field values on TableSchemaDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableSchemaDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSchemaDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableSchemaDiffMultiError, or nil if none found.
+func (m *TableSchemaDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSchemaDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Type
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSchemaDiffValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSchemaDiffValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTriggers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSchemaDiffValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSchemaDiffValidationError{
+					field:  fmt.Sprintf("Triggers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TableSchemaDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSchemaDiffMultiError is an error wrapping multiple validation errors
+// returned by TableSchemaDiff.ValidateAll() if the designated constraints
+// aren't met.
+type TableSchemaDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSchemaDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSchemaDiffMultiError) AllErrors() []error { return m }
+
+// TableSchemaDiffValidationError is the validation error returned by
+// TableSchemaDiff.Validate if the designated constraints aren't met.
+type TableSchemaDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSchemaDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSchemaDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSchemaDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSchemaDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSchemaDiffValidationError) ErrorName() string { return "TableSchemaDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableSchemaDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSchemaDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSchemaDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSchemaDiffValidationError{}
+
+// Validate checks the field values on ColumnSchemaDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnSchemaDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnSchemaDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnSchemaDiffMultiError, or nil if none found.
+func (m *ColumnSchemaDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnSchemaDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for Type
+
+	if m.Source != nil {
+
+		if all {
+			switch v := interface{}(m.GetSource()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnSchemaDiffValidationError{
+						field:  "Source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnSchemaDiffValidationError{
+						field:  "Source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnSchemaDiffValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Destination != nil {
+
+		if all {
+			switch v := interface{}(m.GetDestination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnSchemaDiffValidationError{
+						field:  "Destination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnSchemaDiffValidationError{
+						field:  "Destination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDestination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnSchemaDiffValidationError{
+					field:  "Destination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ColumnSchemaDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnSchemaDiffMultiError is an error wrapping multiple validation errors
+// returned by ColumnSchemaDiff.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnSchemaDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnSchemaDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnSchemaDiffMultiError) AllErrors() []error { return m }
+
+// ColumnSchemaDiffValidationError is the validation error returned by
+// ColumnSchemaDiff.Validate if the designated constraints aren't met.
+type ColumnSchemaDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnSchemaDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnSchemaDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnSchemaDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnSchemaDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnSchemaDiffValidationError) ErrorName() string { return "ColumnSchemaDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnSchemaDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnSchemaDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnSchemaDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnSchemaDiffValidationError{}
+
+// Validate checks the field values on ColumnSchemaDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnSchemaDefinition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnSchemaDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnSchemaDefinitionMultiError, or nil if none found.
+func (m *ColumnSchemaDefinition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnSchemaDefinition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DataType
+
+	// no validation rules for IsNullable
+
+	if m.ColumnDefault != nil {
+		// no validation rules for ColumnDefault
+	}
+
+	if len(errors) > 0 {
+		return ColumnSchemaDefinitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnSchemaDefinitionMultiError is an error wrapping multiple validation
+// errors returned by ColumnSchemaDefinition.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnSchemaDefinitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnSchemaDefinitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnSchemaDefinitionMultiError) AllErrors() []error { return m }
+
+// ColumnSchemaDefinitionValidationError is the validation error returned by
+// ColumnSchemaDefinition.Validate if the designated constraints aren't met.
+type ColumnSchemaDefinitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnSchemaDefinitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnSchemaDefinitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnSchemaDefinitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnSchemaDefinitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnSchemaDefinitionValidationError) ErrorName() string {
+	return "ColumnSchemaDefinitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnSchemaDefinitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnSchemaDefinition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnSchemaDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnSchemaDefinitionValidationError{}
+
+// Validate checks the field values on ConstraintSchemaDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConstraintSchemaDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConstraintSchemaDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConstraintSchemaDiffMultiError, or nil if none found.
+func (m *ConstraintSchemaDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConstraintSchemaDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConstraintType
+
+	// no validation rules for Type
+
+	if m.ForeignKey != nil {
+
+		if all {
+			switch v := interface{}(m.GetForeignKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConstraintSchemaDiffValidationError{
+						field:  "ForeignKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConstraintSchemaDiffValidationError{
+						field:  "ForeignKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConstraintSchemaDiffValidationError{
+					field:  "ForeignKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConstraintSchemaDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConstraintSchemaDiffMultiError is an error wrapping multiple validation
+// errors returned by ConstraintSchemaDiff.ValidateAll() if the designated
+// constraints aren't met.
+type ConstraintSchemaDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConstraintSchemaDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConstraintSchemaDiffMultiError) AllErrors() []error { return m }
+
+// ConstraintSchemaDiffValidationError is the validation error returned by
+// ConstraintSchemaDiff.Validate if the designated constraints aren't met.
+type ConstraintSchemaDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConstraintSchemaDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConstraintSchemaDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConstraintSchemaDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConstraintSchemaDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConstraintSchemaDiffValidationError) ErrorName() string {
+	return "ConstraintSchemaDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConstraintSchemaDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConstraintSchemaDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConstraintSchemaDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConstraintSchemaDiffValidationError{}
+
+// Validate checks the field values on TriggerSchemaDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerSchemaDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerSchemaDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerSchemaDiffMultiError, or nil if none found.
+func (m *TriggerSchemaDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerSchemaDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if m.SourceDefinition != nil {
+		// no validation rules for SourceDefinition
+	}
+
+	if m.DestinationDefinition != nil {
+		// no validation rules for DestinationDefinition
+	}
+
+	if len(errors) > 0 {
+		return TriggerSchemaDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerSchemaDiffMultiError is an error wrapping multiple validation errors
+// returned by TriggerSchemaDiff.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerSchemaDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerSchemaDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerSchemaDiffMultiError) AllErrors() []error { return m }
+
+// TriggerSchemaDiffValidationError is the validation error returned by
+// TriggerSchemaDiff.Validate if the designated constraints aren't met.
+type TriggerSchemaDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerSchemaDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerSchemaDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerSchemaDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerSchemaDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerSchemaDiffValidationError) ErrorName() string {
+	return "TriggerSchemaDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerSchemaDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerSchemaDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerSchemaDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerSchemaDiffValidationError{}
+
+// Validate checks the field values on ScanConnectionForPiiRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanConnectionForPiiRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanConnectionForPiiRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanConnectionForPiiRequestMultiError, or nil if none found.
+func (m *ScanConnectionForPiiRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanConnectionForPiiRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for UpdateColumnTags
+
+	if m.SampleSize != nil {
+		// no validation rules for SampleSize
+	}
+
+	if len(errors) > 0 {
+		return ScanConnectionForPiiRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanConnectionForPiiRequestMultiError is an error wrapping multiple
+// validation errors returned by ScanConnectionForPiiRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ScanConnectionForPiiRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanConnectionForPiiRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanConnectionForPiiRequestMultiError) AllErrors() []error { return m }
+
+// ScanConnectionForPiiRequestValidationError is the validation error returned
+// by ScanConnectionForPiiRequest.Validate if the designated constraints
+// aren't met.
+type ScanConnectionForPiiRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanConnectionForPiiRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanConnectionForPiiRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanConnectionForPiiRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanConnectionForPiiRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanConnectionForPiiRequestValidationError) ErrorName() string {
+	return "ScanConnectionForPiiRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanConnectionForPiiRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanConnectionForPiiRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanConnectionForPiiRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanConnectionForPiiRequestValidationError{}
+
+// Validate checks the field values on ScanConnectionForPiiResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanConnectionForPiiResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanConnectionForPiiResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanConnectionForPiiResponseMultiError, or nil if none found.
+func (m *ScanConnectionForPiiResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanConnectionForPiiResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScanConnectionForPiiResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScanConnectionForPiiResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScanConnectionForPiiResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScanConnectionForPiiResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanConnectionForPiiResponseMultiError is an error wrapping multiple
+// validation errors returned by ScanConnectionForPiiResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ScanConnectionForPiiResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanConnectionForPiiResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanConnectionForPiiResponseMultiError) AllErrors() []error { return m }
+
+// ScanConnectionForPiiResponseValidationError is the validation error returned
+// by ScanConnectionForPiiResponse.Validate if the designated constraints
+// aren't met.
+type ScanConnectionForPiiResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanConnectionForPiiResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanConnectionForPiiResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanConnectionForPiiResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanConnectionForPiiResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanConnectionForPiiResponseValidationError) ErrorName() string {
+	return "ScanConnectionForPiiResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanConnectionForPiiResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanConnectionForPiiResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanConnectionForPiiResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanConnectionForPiiResponseValidationError{}
+
+// Validate checks the field values on ColumnPiiScanResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnPiiScanResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnPiiScanResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnPiiScanResultMultiError, or nil if none found.
+func (m *ColumnPiiScanResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnPiiScanResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for DataType
+
+	// no validation rules for PiiType
+
+	// no validation rules for Confidence
+
+	// no validation rules for NameMatch
+
+	// no validation rules for SampledCount
+
+	// no validation rules for MatchedCount
+
+	if all {
+		switch v := interface{}(m.GetRecommendedTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnPiiScanResultValidationError{
+					field:  "RecommendedTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnPiiScanResultValidationError{
+					field:  "RecommendedTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecommendedTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnPiiScanResultValidationError{
+				field:  "RecommendedTransformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnPiiScanResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnPiiScanResultMultiError is an error wrapping multiple validation
+// errors returned by ColumnPiiScanResult.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnPiiScanResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnPiiScanResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnPiiScanResultMultiError) AllErrors() []error { return m }
+
+// ColumnPiiScanResultValidationError is the validation error returned by
+// ColumnPiiScanResult.Validate if the designated constraints aren't met.
+type ColumnPiiScanResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnPiiScanResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnPiiScanResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnPiiScanResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnPiiScanResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnPiiScanResultValidationError) ErrorName() string {
+	return "ColumnPiiScanResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnPiiScanResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnPiiScanResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnPiiScanResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnPiiScanResultValidationError{}
@@ -1904,6 +1904,40 @@ func (m *MongoDBSourceConnectionOptions) validate(all bool) error {
 
 	// no validation rules for ConnectionId
 
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MongoDBSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MongoDBSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MongoDBSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MongoDBSourceConnectionOptionsMultiError(errors)
 	}
@@ -1985,6 +2019,261 @@ var _ interface {
 	ErrorName() string
 } = MongoDBSourceConnectionOptionsValidationError{}
 
+// Validate checks the field values on MongoDBSourceCollectionOption with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MongoDBSourceCollectionOption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MongoDBSourceCollectionOption with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MongoDBSourceCollectionOptionMultiError, or nil if none found.
+func (m *MongoDBSourceCollectionOption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MongoDBSourceCollectionOption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Database
+
+	// no validation rules for Collection
+
+	// no validation rules for Projection
+
+	for idx, item := range m.GetSort() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MongoDBSourceCollectionOptionValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MongoDBSourceCollectionOptionValidationError{
+						field:  fmt.Sprintf("Sort[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MongoDBSourceCollectionOptionValidationError{
+					field:  fmt.Sprintf("Sort[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Filter != nil {
+		// no validation rules for Filter
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return MongoDBSourceCollectionOptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MongoDBSourceCollectionOptionMultiError is an error wrapping multiple
+// validation errors returned by MongoDBSourceCollectionOption.ValidateAll()
+// if the designated constraints aren't met.
+type MongoDBSourceCollectionOptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MongoDBSourceCollectionOptionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MongoDBSourceCollectionOptionMultiError) AllErrors() []error { return m }
+
+// MongoDBSourceCollectionOptionValidationError is the validation error
+// returned by MongoDBSourceCollectionOption.Validate if the designated
+// constraints aren't met.
+type MongoDBSourceCollectionOptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBSourceCollectionOptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBSourceCollectionOptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBSourceCollectionOptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBSourceCollectionOptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBSourceCollectionOptionValidationError) ErrorName() string {
+	return "MongoDBSourceCollectionOptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MongoDBSourceCollectionOptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDBSourceCollectionOption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBSourceCollectionOptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBSourceCollectionOptionValidationError{}
+
+// Validate checks the field values on MongoDBSortField with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MongoDBSortField) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MongoDBSortField with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MongoDBSortFieldMultiError, or nil if none found.
+func (m *MongoDBSortField) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MongoDBSortField) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Direction
+
+	if len(errors) > 0 {
+		return MongoDBSortFieldMultiError(errors)
+	}
+
+	return nil
+}
+
+// MongoDBSortFieldMultiError is an error wrapping multiple validation errors
+// returned by MongoDBSortField.ValidateAll() if the designated constraints
+// aren't met.
+type MongoDBSortFieldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MongoDBSortFieldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MongoDBSortFieldMultiError) AllErrors() []error { return m }
+
+// MongoDBSortFieldValidationError is the validation error returned by
+// MongoDBSortField.Validate if the designated constraints aren't met.
+type MongoDBSortFieldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBSortFieldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBSortFieldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBSortFieldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBSortFieldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBSortFieldValidationError) ErrorName() string { return "MongoDBSortFieldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MongoDBSortFieldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDBSortField.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBSortFieldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBSortFieldValidationError{}
+
 // Validate checks the field values on DynamoDBSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4066,13 +4355,95 @@ func (m *JobDestinationOptions) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
+	case *JobDestinationOptions_ClickhouseOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if len(errors) > 0 {
-		return JobDestinationOptionsMultiError(errors)
-	}
+		if all {
+			switch v := interface{}(m.GetClickhouseOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "ClickhouseOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "ClickhouseOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClickhouseOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "ClickhouseOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_KafkaOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKafkaOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "KafkaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "KafkaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKafkaOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "KafkaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobDestinationOptionsMultiError(errors)
+	}
 
 	return nil
 }
@@ -4173,6 +4544,44 @@ func (m *MongoDBDestinationConnectionOptions) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for TruncateBeforeInsert
+
+	// no validation rules for InitCollection
+
+	for idx, item := range m.GetUpsertKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MongoDBDestinationConnectionOptionsValidationError{
+						field:  fmt.Sprintf("UpsertKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MongoDBDestinationConnectionOptionsValidationError{
+						field:  fmt.Sprintf("UpsertKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MongoDBDestinationConnectionOptionsValidationError{
+					field:  fmt.Sprintf("UpsertKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MongoDBDestinationConnectionOptionsMultiError(errors)
 	}
@@ -4255,6 +4664,113 @@ var _ interface {
 	ErrorName() string
 } = MongoDBDestinationConnectionOptionsValidationError{}
 
+// Validate checks the field values on MongoDBDestinationUpsertKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MongoDBDestinationUpsertKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MongoDBDestinationUpsertKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MongoDBDestinationUpsertKeyMultiError, or nil if none found.
+func (m *MongoDBDestinationUpsertKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MongoDBDestinationUpsertKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Database
+
+	// no validation rules for Collection
+
+	if len(errors) > 0 {
+		return MongoDBDestinationUpsertKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MongoDBDestinationUpsertKeyMultiError is an error wrapping multiple
+// validation errors returned by MongoDBDestinationUpsertKey.ValidateAll() if
+// the designated constraints aren't met.
+type MongoDBDestinationUpsertKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MongoDBDestinationUpsertKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MongoDBDestinationUpsertKeyMultiError) AllErrors() []error { return m }
+
+// MongoDBDestinationUpsertKeyValidationError is the validation error returned
+// by MongoDBDestinationUpsertKey.Validate if the designated constraints
+// aren't met.
+type MongoDBDestinationUpsertKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBDestinationUpsertKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBDestinationUpsertKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBDestinationUpsertKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBDestinationUpsertKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBDestinationUpsertKeyValidationError) ErrorName() string {
+	return "MongoDBDestinationUpsertKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MongoDBDestinationUpsertKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDBDestinationUpsertKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBDestinationUpsertKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBDestinationUpsertKeyValidationError{}
+
 // Validate checks the field values on
 // GcpCloudStorageDestinationConnectionOptions with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -4418,6 +4934,12 @@ func (m *DynamoDBDestinationConnectionOptions) validate(all bool) error {
 
 	}
 
+	// no validation rules for WriteMode
+
+	// no validation rules for TruncateBeforeInsert
+
+	// no validation rules for InitTable
+
 	if len(errors) > 0 {
 		return DynamoDBDestinationConnectionOptionsMultiError(errors)
 	}
@@ -5729,44 +6251,84 @@ var _ interface {
 	ErrorName() string
 } = MssqlOnConflictConfigValidationError{}
 
-// Validate checks the field values on AwsS3DestinationConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AwsS3DestinationConnectionOptions) Validate() error {
+// Validate checks the field values on ClickhouseDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ClickhouseDestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
-func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on
+// ClickhouseDestinationConnectionOptions with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ClickhouseDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *ClickhouseDestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
+func (m *ClickhouseDestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClickhouseDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClickhouseDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClickhouseDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if m.TableEngine != nil {
+		// no validation rules for TableEngine
+	}
+
+	if m.PartitionBy != nil {
+		// no validation rules for PartitionBy
+	}
+
 	if len(errors) > 0 {
-		return AwsS3DestinationConnectionOptionsMultiError(errors)
+		return ClickhouseDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
+// ClickhouseDestinationConnectionOptionsMultiError is an error wrapping
+// multiple validation errors returned by
+// ClickhouseDestinationConnectionOptions.ValidateAll() if the designated
 // constraints aren't met.
-type AwsS3DestinationConnectionOptionsMultiError []error
+type ClickhouseDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
+func (m ClickhouseDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5775,12 +6337,12 @@ func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m ClickhouseDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// AwsS3DestinationConnectionOptionsValidationError is the validation error
-// returned by AwsS3DestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type AwsS3DestinationConnectionOptionsValidationError struct {
+// ClickhouseDestinationConnectionOptionsValidationError is the validation
+// error returned by ClickhouseDestinationConnectionOptions.Validate if the
+// designated constraints aren't met.
+type ClickhouseDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5788,24 +6350,24 @@ type AwsS3DestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e ClickhouseDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e ClickhouseDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e ClickhouseDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e ClickhouseDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
-	return "AwsS3DestinationConnectionOptionsValidationError"
+func (e ClickhouseDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "ClickhouseDestinationConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
+func (e ClickhouseDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5817,14 +6379,14 @@ func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
+		"invalid %sClickhouseDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3DestinationConnectionOptionsValidationError{}
+var _ error = ClickhouseDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5832,208 +6394,153 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3DestinationConnectionOptionsValidationError{}
+} = ClickhouseDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on CreateJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRequest) Validate() error {
+// Validate checks the field values on ClickhouseTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClickhouseTruncateTableConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateJobRequestMultiError, or nil if none found.
-func (m *CreateJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on ClickhouseTruncateTableConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ClickhouseTruncateTableConfigMultiError, or nil if none found.
+func (m *ClickhouseTruncateTableConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRequest) validate(all bool) error {
+func (m *ClickhouseTruncateTableConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	// no validation rules for TruncateBeforeInsert
 
-	// no validation rules for JobName
+	if len(errors) > 0 {
+		return ClickhouseTruncateTableConfigMultiError(errors)
+	}
 
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ClickhouseTruncateTableConfigMultiError is an error wrapping multiple
+// validation errors returned by ClickhouseTruncateTableConfig.ValidateAll()
+// if the designated constraints aren't met.
+type ClickhouseTruncateTableConfigMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickhouseTruncateTableConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+// AllErrors returns a list of validation violation errors.
+func (m ClickhouseTruncateTableConfigMultiError) AllErrors() []error { return m }
 
-	for idx, item := range m.GetDestinations() {
-		_, _ = idx, item
+// ClickhouseTruncateTableConfigValidationError is the validation error
+// returned by ClickhouseTruncateTableConfig.Validate if the designated
+// constraints aren't met.
+type ClickhouseTruncateTableConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Field function returns field value.
+func (e ClickhouseTruncateTableConfigValidationError) Field() string { return e.field }
 
-	}
+// Reason function returns reason value.
+func (e ClickhouseTruncateTableConfigValidationError) Reason() string { return e.reason }
 
-	// no validation rules for InitiateJobRun
+// Cause function returns cause value.
+func (e ClickhouseTruncateTableConfigValidationError) Cause() error { return e.cause }
 
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Key function returns key value.
+func (e ClickhouseTruncateTableConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickhouseTruncateTableConfigValidationError) ErrorName() string {
+	return "ClickhouseTruncateTableConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClickhouseTruncateTableConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	for idx, item := range m.GetVirtualForeignKeys() {
+	return fmt.Sprintf(
+		"invalid %sClickhouseTruncateTableConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickhouseTruncateTableConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickhouseTruncateTableConfigValidationError{}
+
+// Validate checks the field values on KafkaDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *KafkaDestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KafkaDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// KafkaDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *KafkaDestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KafkaDestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeyColumns() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, KafkaDestinationConnectionOptionsValidationError{
+						field:  fmt.Sprintf("KeyColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, KafkaDestinationConnectionOptionsValidationError{
+						field:  fmt.Sprintf("KeyColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6041,8 +6548,8 @@ func (m *CreateJobRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+				return KafkaDestinationConnectionOptionsValidationError{
+					field:  fmt.Sprintf("KeyColumns[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6051,24 +6558,27 @@ func (m *CreateJobRequest) validate(all bool) error {
 
 	}
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
+	// no validation rules for Format
+
+	if m.Topic != nil {
+		// no validation rules for Topic
 	}
 
 	if len(errors) > 0 {
-		return CreateJobRequestMultiError(errors)
+		return KafkaDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateJobRequestMultiError []error
+// KafkaDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// KafkaDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type KafkaDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRequestMultiError) Error() string {
+func (m KafkaDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6077,11 +6587,12 @@ func (m CreateJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+func (m KafkaDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// CreateJobRequestValidationError is the validation error returned by
-// CreateJobRequest.Validate if the designated constraints aren't met.
-type CreateJobRequestValidationError struct {
+// KafkaDestinationConnectionOptionsValidationError is the validation error
+// returned by KafkaDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type KafkaDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6089,22 +6600,24 @@ type CreateJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRequestValidationError) Field() string { return e.field }
+func (e KafkaDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+func (e KafkaDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+func (e KafkaDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRequestValidationError) Key() bool { return e.key }
+func (e KafkaDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+func (e KafkaDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "KafkaDestinationConnectionOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateJobRequestValidationError) Error() string {
+func (e KafkaDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6116,14 +6629,14 @@ func (e CreateJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRequest.%s: %s%s",
+		"invalid %sKafkaDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRequestValidationError{}
+var _ error = KafkaDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6131,48 +6644,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRequestValidationError{}
+} = KafkaDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on WorkflowOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WorkflowOptions) Validate() error {
+// Validate checks the field values on KafkaTableKeyColumns with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KafkaTableKeyColumns) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WorkflowOptions with the rules
+// ValidateAll checks the field values on KafkaTableKeyColumns with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WorkflowOptionsMultiError, or nil if none found.
-func (m *WorkflowOptions) ValidateAll() error {
+// KafkaTableKeyColumnsMultiError, or nil if none found.
+func (m *KafkaTableKeyColumns) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WorkflowOptions) validate(all bool) error {
+func (m *KafkaTableKeyColumns) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.RunTimeout != nil {
-		// no validation rules for RunTimeout
-	}
+	// no validation rules for Schema
+
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return WorkflowOptionsMultiError(errors)
+		return KafkaTableKeyColumnsMultiError(errors)
 	}
 
 	return nil
 }
 
-// WorkflowOptionsMultiError is an error wrapping multiple validation errors
-// returned by WorkflowOptions.ValidateAll() if the designated constraints
-// aren't met.
-type WorkflowOptionsMultiError []error
+// KafkaTableKeyColumnsMultiError is an error wrapping multiple validation
+// errors returned by KafkaTableKeyColumns.ValidateAll() if the designated
+// constraints aren't met.
+type KafkaTableKeyColumnsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WorkflowOptionsMultiError) Error() string {
+func (m KafkaTableKeyColumnsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6181,11 +6694,11 @@ func (m WorkflowOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
+func (m KafkaTableKeyColumnsMultiError) AllErrors() []error { return m }
 
-// WorkflowOptionsValidationError is the validation error returned by
-// WorkflowOptions.Validate if the designated constraints aren't met.
-type WorkflowOptionsValidationError struct {
+// KafkaTableKeyColumnsValidationError is the validation error returned by
+// KafkaTableKeyColumns.Validate if the designated constraints aren't met.
+type KafkaTableKeyColumnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6193,22 +6706,24 @@ type WorkflowOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e WorkflowOptionsValidationError) Field() string { return e.field }
+func (e KafkaTableKeyColumnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
+func (e KafkaTableKeyColumnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
+func (e KafkaTableKeyColumnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WorkflowOptionsValidationError) Key() bool { return e.key }
+func (e KafkaTableKeyColumnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
+func (e KafkaTableKeyColumnsValidationError) ErrorName() string {
+	return "KafkaTableKeyColumnsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WorkflowOptionsValidationError) Error() string {
+func (e KafkaTableKeyColumnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6220,14 +6735,14 @@ func (e WorkflowOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWorkflowOptions.%s: %s%s",
+		"invalid %sKafkaTableKeyColumns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WorkflowOptionsValidationError{}
+var _ error = KafkaTableKeyColumnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6235,81 +6750,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WorkflowOptionsValidationError{}
+} = KafkaTableKeyColumnsValidationError{}
 
-// Validate checks the field values on ActivityOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityOptions) Validate() error {
+// Validate checks the field values on AwsS3DestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AwsS3DestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityOptions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ActivityOptionsMultiError, or nil if none found.
-func (m *ActivityOptions) ValidateAll() error {
+// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
+func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityOptions) validate(all bool) error {
+func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRetryPolicy()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ActivityOptionsValidationError{
-				field:  "RetryPolicy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.ScheduleToCloseTimeout != nil {
-		// no validation rules for ScheduleToCloseTimeout
-	}
-
-	if m.StartToCloseTimeout != nil {
-		// no validation rules for StartToCloseTimeout
-	}
-
 	if len(errors) > 0 {
-		return ActivityOptionsMultiError(errors)
+		return AwsS3DestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityOptionsMultiError is an error wrapping multiple validation errors
-// returned by ActivityOptions.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityOptionsMultiError []error
+// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityOptionsMultiError) Error() string {
+func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6318,11 +6798,12 @@ func (m ActivityOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityOptionsMultiError) AllErrors() []error { return m }
+func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// ActivityOptionsValidationError is the validation error returned by
-// ActivityOptions.Validate if the designated constraints aren't met.
-type ActivityOptionsValidationError struct {
+// AwsS3DestinationConnectionOptionsValidationError is the validation error
+// returned by AwsS3DestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6330,22 +6811,24 @@ type ActivityOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityOptionsValidationError) Field() string { return e.field }
+func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityOptionsValidationError) Reason() string { return e.reason }
+func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityOptionsValidationError) Cause() error { return e.cause }
+func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityOptionsValidationError) Key() bool { return e.key }
+func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
+func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
+	return "AwsS3DestinationConnectionOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityOptionsValidationError) Error() string {
+func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6357,14 +6840,14 @@ func (e ActivityOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityOptions.%s: %s%s",
+		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityOptionsValidationError{}
+var _ error = AwsS3DestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6372,83 +6855,279 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityOptionsValidationError{}
+} = AwsS3DestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RetryPolicy) Validate() error {
+// Validate checks the field values on CreateJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RetryPolicyMultiError, or
-// nil if none found.
-func (m *RetryPolicy) ValidateAll() error {
+// ValidateAll checks the field values on CreateJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRequestMultiError, or nil if none found.
+func (m *CreateJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RetryPolicy) validate(all bool) error {
+func (m *CreateJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.MaximumAttempts != nil {
-		// no validation rules for MaximumAttempts
-	}
+	// no validation rules for AccountId
 
-	if len(errors) > 0 {
-		return RetryPolicyMultiError(errors)
-	}
+	// no validation rules for JobName
 
-	return nil
-}
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
 
-// RetryPolicyMultiError is an error wrapping multiple validation errors
-// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
-type RetryPolicyMultiError []error
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m RetryPolicyMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m RetryPolicyMultiError) AllErrors() []error { return m }
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// RetryPolicyValidationError is the validation error returned by
-// RetryPolicy.Validate if the designated constraints aren't met.
-type RetryPolicyValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
 
-// Field function returns field value.
-func (e RetryPolicyValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e RetryPolicyValidationError) Reason() string { return e.reason }
+	}
+
+	// no validation rules for InitiateJobRun
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetVirtualForeignKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRequestValidationError is the validation error returned by
+// CreateJobRequest.Validate if the designated constraints aren't met.
+type CreateJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RetryPolicyValidationError) Cause() error { return e.cause }
+func (e CreateJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RetryPolicyValidationError) Key() bool { return e.key }
+func (e CreateJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RetryPolicyValidationError) Error() string {
+func (e CreateJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6460,14 +7139,14 @@ func (e RetryPolicyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRetryPolicy.%s: %s%s",
+		"invalid %sCreateJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RetryPolicyValidationError{}
+var _ error = CreateJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6475,73 +7154,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RetryPolicyValidationError{}
+} = CreateJobRequestValidationError{}
 
-// Validate checks the field values on CreateJobResponse with the rules defined
+// Validate checks the field values on WorkflowOptions with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobResponse) Validate() error {
+func (m *WorkflowOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobResponse with the rules
+// ValidateAll checks the field values on WorkflowOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobResponseMultiError, or nil if none found.
-func (m *CreateJobResponse) ValidateAll() error {
+// WorkflowOptionsMultiError, or nil if none found.
+func (m *WorkflowOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobResponse) validate(all bool) error {
+func (m *WorkflowOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.RunTimeout != nil {
+		// no validation rules for RunTimeout
 	}
 
 	if len(errors) > 0 {
-		return CreateJobResponseMultiError(errors)
+		return WorkflowOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateJobResponse.ValidateAll() if the designated constraints
+// WorkflowOptionsMultiError is an error wrapping multiple validation errors
+// returned by WorkflowOptions.ValidateAll() if the designated constraints
 // aren't met.
-type CreateJobResponseMultiError []error
+type WorkflowOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobResponseMultiError) Error() string {
+func (m WorkflowOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6550,11 +7204,11 @@ func (m CreateJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
 
-// CreateJobResponseValidationError is the validation error returned by
-// CreateJobResponse.Validate if the designated constraints aren't met.
-type CreateJobResponseValidationError struct {
+// WorkflowOptionsValidationError is the validation error returned by
+// WorkflowOptions.Validate if the designated constraints aren't met.
+type WorkflowOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6562,24 +7216,22 @@ type CreateJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobResponseValidationError) Field() string { return e.field }
+func (e WorkflowOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobResponseValidationError) Key() bool { return e.key }
+func (e WorkflowOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobResponseValidationError) ErrorName() string {
-	return "CreateJobResponseValidationError"
-}
+func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobResponseValidationError) Error() string {
+func (e WorkflowOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6591,14 +7243,14 @@ func (e CreateJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobResponse.%s: %s%s",
+		"invalid %sWorkflowOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobResponseValidationError{}
+var _ error = WorkflowOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6606,75 +7258,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobResponseValidationError{}
+} = WorkflowOptionsValidationError{}
 
-// Validate checks the field values on JobMappingTransformer with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobMappingTransformer) Validate() error {
+// Validate checks the field values on ActivityOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMappingTransformer with the rules
+// ValidateAll checks the field values on ActivityOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobMappingTransformerMultiError, or nil if none found.
-func (m *JobMappingTransformer) ValidateAll() error {
+// ActivityOptionsMultiError, or nil if none found.
+func (m *ActivityOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMappingTransformer) validate(all bool) error {
+func (m *ActivityOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Source
-
 	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobMappingTransformerValidationError{
-				field:  "Config",
+			return ActivityOptionsValidationError{
+				field:  "RetryPolicy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.ScheduleToCloseTimeout != nil {
+		// no validation rules for ScheduleToCloseTimeout
+	}
+
+	if m.StartToCloseTimeout != nil {
+		// no validation rules for StartToCloseTimeout
+	}
+
 	if len(errors) > 0 {
-		return JobMappingTransformerMultiError(errors)
+		return ActivityOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingTransformerMultiError is an error wrapping multiple validation
-// errors returned by JobMappingTransformer.ValidateAll() if the designated
-// constraints aren't met.
-type JobMappingTransformerMultiError []error
+// ActivityOptionsMultiError is an error wrapping multiple validation errors
+// returned by ActivityOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingTransformerMultiError) Error() string {
+func (m ActivityOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6683,11 +7341,11 @@ func (m JobMappingTransformerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
+func (m ActivityOptionsMultiError) AllErrors() []error { return m }
 
-// JobMappingTransformerValidationError is the validation error returned by
-// JobMappingTransformer.Validate if the designated constraints aren't met.
-type JobMappingTransformerValidationError struct {
+// ActivityOptionsValidationError is the validation error returned by
+// ActivityOptions.Validate if the designated constraints aren't met.
+type ActivityOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6695,24 +7353,22 @@ type JobMappingTransformerValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingTransformerValidationError) Field() string { return e.field }
+func (e ActivityOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
+func (e ActivityOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
+func (e ActivityOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingTransformerValidationError) Key() bool { return e.key }
+func (e ActivityOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingTransformerValidationError) ErrorName() string {
-	return "JobMappingTransformerValidationError"
-}
+func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobMappingTransformerValidationError) Error() string {
+func (e ActivityOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6724,14 +7380,14 @@ func (e JobMappingTransformerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMappingTransformer.%s: %s%s",
+		"invalid %sActivityOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingTransformerValidationError{}
+var _ error = ActivityOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6739,78 +7395,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingTransformerValidationError{}
+} = ActivityOptionsValidationError{}
 
-// Validate checks the field values on JobMapping with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *JobMapping) Validate() error {
+func (m *RetryPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMapping with the rules defined in
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobMappingMultiError, or
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
 // nil if none found.
-func (m *JobMapping) ValidateAll() error {
+func (m *RetryPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMapping) validate(all bool) error {
+func (m *RetryPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	// no validation rules for Column
-
-	if all {
-		switch v := interface{}(m.GetTransformer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobMappingValidationError{
-				field:  "Transformer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.MaximumAttempts != nil {
+		// no validation rules for MaximumAttempts
 	}
 
 	if len(errors) > 0 {
-		return JobMappingMultiError(errors)
+		return RetryPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingMultiError is an error wrapping multiple validation errors
-// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
-type JobMappingMultiError []error
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingMultiError) Error() string {
+func (m RetryPolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6819,11 +7444,11 @@ func (m JobMappingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingMultiError) AllErrors() []error { return m }
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
 
-// JobMappingValidationError is the validation error returned by
-// JobMapping.Validate if the designated constraints aren't met.
-type JobMappingValidationError struct {
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6831,22 +7456,22 @@ type JobMappingValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingValidationError) Field() string { return e.field }
+func (e RetryPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingValidationError) Reason() string { return e.reason }
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingValidationError) Cause() error { return e.cause }
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingValidationError) Key() bool { return e.key }
+func (e RetryPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobMappingValidationError) Error() string {
+func (e RetryPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6858,14 +7483,14 @@ func (e JobMappingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMapping.%s: %s%s",
+		"invalid %sRetryPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingValidationError{}
+var _ error = RetryPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6873,46 +7498,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingValidationError{}
+} = RetryPolicyValidationError{}
 
-// Validate checks the field values on GetJobRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobRequest) Validate() error {
+// Validate checks the field values on CreateJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobRequestMultiError, or
-// nil if none found.
-func (m *GetJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobResponseMultiError, or nil if none found.
+func (m *CreateJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRequest) validate(all bool) error {
+func (m *CreateJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetJobRequestMultiError(errors)
+		return CreateJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRequest.ValidateAll() if the designated constraints
+// CreateJobResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateJobResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobRequestMultiError []error
+type CreateJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRequestMultiError) Error() string {
+func (m CreateJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6921,11 +7573,11 @@ func (m GetJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRequestMultiError) AllErrors() []error { return m }
+func (m CreateJobResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRequestValidationError is the validation error returned by
-// GetJobRequest.Validate if the designated constraints aren't met.
-type GetJobRequestValidationError struct {
+// CreateJobResponseValidationError is the validation error returned by
+// CreateJobResponse.Validate if the designated constraints aren't met.
+type CreateJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6933,22 +7585,24 @@ type GetJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRequestValidationError) Field() string { return e.field }
+func (e CreateJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRequestValidationError) Reason() string { return e.reason }
+func (e CreateJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRequestValidationError) Cause() error { return e.cause }
+func (e CreateJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRequestValidationError) Key() bool { return e.key }
+func (e CreateJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
+func (e CreateJobResponseValidationError) ErrorName() string {
+	return "CreateJobResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRequestValidationError) Error() string {
+func (e CreateJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6960,14 +7614,14 @@ func (e GetJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRequest.%s: %s%s",
+		"invalid %sCreateJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRequestValidationError{}
+var _ error = CreateJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6975,53 +7629,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRequestValidationError{}
+} = CreateJobResponseValidationError{}
 
-// Validate checks the field values on GetJobResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobResponse) Validate() error {
+// Validate checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingTransformer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobResponseMultiError,
-// or nil if none found.
-func (m *GetJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobMappingTransformerMultiError, or nil if none found.
+func (m *JobMappingTransformer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobResponse) validate(all bool) error {
+func (m *JobMappingTransformer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Source
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetJobResponseValidationError{
-				field:  "Job",
+			return JobMappingTransformerValidationError{
+				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7029,19 +7685,19 @@ func (m *GetJobResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJobResponseMultiError(errors)
+		return JobMappingTransformerMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobResponseMultiError []error
+// JobMappingTransformerMultiError is an error wrapping multiple validation
+// errors returned by JobMappingTransformer.ValidateAll() if the designated
+// constraints aren't met.
+type JobMappingTransformerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobResponseMultiError) Error() string {
+func (m JobMappingTransformerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7050,11 +7706,11 @@ func (m GetJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobResponseMultiError) AllErrors() []error { return m }
+func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
 
-// GetJobResponseValidationError is the validation error returned by
-// GetJobResponse.Validate if the designated constraints aren't met.
-type GetJobResponseValidationError struct {
+// JobMappingTransformerValidationError is the validation error returned by
+// JobMappingTransformer.Validate if the designated constraints aren't met.
+type JobMappingTransformerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7062,22 +7718,24 @@ type GetJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobResponseValidationError) Field() string { return e.field }
+func (e JobMappingTransformerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobResponseValidationError) Reason() string { return e.reason }
+func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobResponseValidationError) Cause() error { return e.cause }
+func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobResponseValidationError) Key() bool { return e.key }
+func (e JobMappingTransformerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
+func (e JobMappingTransformerValidationError) ErrorName() string {
+	return "JobMappingTransformerValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobResponseValidationError) Error() string {
+func (e JobMappingTransformerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7089,14 +7747,14 @@ func (e GetJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobResponse.%s: %s%s",
+		"invalid %sJobMappingTransformer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobResponseValidationError{}
+var _ error = JobMappingTransformerValidationError{}
 
 var _ interface {
 	Field() string
@@ -7104,50 +7762,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobResponseValidationError{}
+} = JobMappingTransformerValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleRequest) Validate() error {
+// Validate checks the field values on JobMapping with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobMapping) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleRequestMultiError, or nil if none found.
-func (m *UpdateJobScheduleRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobMapping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobMappingMultiError, or
+// nil if none found.
+func (m *JobMapping) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleRequest) validate(all bool) error {
+func (m *JobMapping) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Schema
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if all {
+		switch v := interface{}(m.GetTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingValidationError{
+				field:  "Transformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobScheduleRequestMultiError(errors)
+		return JobMappingMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobScheduleRequestMultiError []error
+// JobMappingMultiError is an error wrapping multiple validation errors
+// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
+type JobMappingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleRequestMultiError) Error() string {
+func (m JobMappingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7156,11 +7842,11 @@ func (m UpdateJobScheduleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingMultiError) AllErrors() []error { return m }
 
-// UpdateJobScheduleRequestValidationError is the validation error returned by
-// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
-type UpdateJobScheduleRequestValidationError struct {
+// JobMappingValidationError is the validation error returned by
+// JobMapping.Validate if the designated constraints aren't met.
+type JobMappingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7168,24 +7854,22 @@ type UpdateJobScheduleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
+func (e JobMappingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
+func (e JobMappingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
-	return "UpdateJobScheduleRequestValidationError"
-}
+func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobScheduleRequestValidationError) Error() string {
+func (e JobMappingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7197,14 +7881,14 @@ func (e UpdateJobScheduleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
+		"invalid %sJobMapping.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobScheduleRequestValidationError{}
+var _ error = JobMappingValidationError{}
 
 var _ interface {
 	Field() string
@@ -7212,73 +7896,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobScheduleRequestValidationError{}
+} = JobMappingValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleResponse) Validate() error {
+// Validate checks the field values on GetJobRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleResponseMultiError, or nil if none found.
-func (m *UpdateJobScheduleResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobRequestMultiError, or
+// nil if none found.
+func (m *GetJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleResponse) validate(all bool) error {
+func (m *GetJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobScheduleResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateJobScheduleResponseMultiError(errors)
+		return GetJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateJobScheduleResponseMultiError []error
+// GetJobRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleResponseMultiError) Error() string {
+func (m GetJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7287,11 +7944,11 @@ func (m UpdateJobScheduleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobScheduleResponseValidationError is the validation error returned by
-// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
-type UpdateJobScheduleResponseValidationError struct {
+// GetJobRequestValidationError is the validation error returned by
+// GetJobRequest.Validate if the designated constraints aren't met.
+type GetJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7299,24 +7956,22 @@ type UpdateJobScheduleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
+func (e GetJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
+func (e GetJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
-	return "UpdateJobScheduleResponseValidationError"
-}
+func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobScheduleResponseValidationError) Error() string {
+func (e GetJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7328,14 +7983,14 @@ func (e UpdateJobScheduleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
+		"invalid %sGetJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobScheduleResponseValidationError{}
+var _ error = GetJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7343,52 +7998,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobScheduleResponseValidationError{}
+} = GetJobRequestValidationError{}
 
-// Validate checks the field values on PauseJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobRequest) Validate() error {
+// Validate checks the field values on GetJobResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PauseJobRequestMultiError, or nil if none found.
-func (m *PauseJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobResponseMultiError,
+// or nil if none found.
+func (m *GetJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseJobRequest) validate(all bool) error {
+func (m *GetJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Pause
-
-	if m.Note != nil {
-		// no validation rules for Note
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return PauseJobRequestMultiError(errors)
+		return GetJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobRequestMultiError is an error wrapping multiple validation errors
-// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// GetJobResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobResponse.ValidateAll() if the designated constraints
 // aren't met.
-type PauseJobRequestMultiError []error
+type GetJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobRequestMultiError) Error() string {
+func (m GetJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7397,11 +8073,11 @@ func (m PauseJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobRequestMultiError) AllErrors() []error { return m }
+func (m GetJobResponseMultiError) AllErrors() []error { return m }
 
-// PauseJobRequestValidationError is the validation error returned by
-// PauseJobRequest.Validate if the designated constraints aren't met.
-type PauseJobRequestValidationError struct {
+// GetJobResponseValidationError is the validation error returned by
+// GetJobResponse.Validate if the designated constraints aren't met.
+type GetJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7409,22 +8085,22 @@ type PauseJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobRequestValidationError) Field() string { return e.field }
+func (e GetJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobRequestValidationError) Reason() string { return e.reason }
+func (e GetJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobRequestValidationError) Cause() error { return e.cause }
+func (e GetJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobRequestValidationError) Key() bool { return e.key }
+func (e GetJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
+func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseJobRequestValidationError) Error() string {
+func (e GetJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7436,14 +8112,14 @@ func (e PauseJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobRequest.%s: %s%s",
+		"invalid %sGetJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobRequestValidationError{}
+var _ error = GetJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7451,73 +8127,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobRequestValidationError{}
+} = GetJobResponseValidationError{}
 
-// Validate checks the field values on PauseJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobResponse) Validate() error {
+// Validate checks the field values on UpdateJobScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateJobScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PauseJobResponseMultiError, or nil if none found.
-func (m *PauseJobResponse) ValidateAll() error {
+// UpdateJobScheduleRequestMultiError, or nil if none found.
+func (m *UpdateJobScheduleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseJobResponse) validate(all bool) error {
+func (m *UpdateJobScheduleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PauseJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Id
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
 	}
 
 	if len(errors) > 0 {
-		return PauseJobResponseMultiError(errors)
+		return UpdateJobScheduleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobResponseMultiError is an error wrapping multiple validation errors
-// returned by PauseJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type PauseJobResponseMultiError []error
+// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobScheduleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobResponseMultiError) Error() string {
+func (m UpdateJobScheduleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7526,11 +8179,11 @@ func (m PauseJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobResponseMultiError) AllErrors() []error { return m }
+func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
 
-// PauseJobResponseValidationError is the validation error returned by
-// PauseJobResponse.Validate if the designated constraints aren't met.
-type PauseJobResponseValidationError struct {
+// UpdateJobScheduleRequestValidationError is the validation error returned by
+// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
+type UpdateJobScheduleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7538,22 +8191,24 @@ type PauseJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobResponseValidationError) Field() string { return e.field }
+func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobResponseValidationError) Reason() string { return e.reason }
+func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobResponseValidationError) Cause() error { return e.cause }
+func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobResponseValidationError) Key() bool { return e.key }
+func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
+func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
+	return "UpdateJobScheduleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PauseJobResponseValidationError) Error() string {
+func (e UpdateJobScheduleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7565,14 +8220,14 @@ func (e PauseJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobResponse.%s: %s%s",
+		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobResponseValidationError{}
+var _ error = UpdateJobScheduleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7580,145 +8235,183 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobResponseValidationError{}
+} = UpdateJobScheduleRequestValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionRequest) Validate() error {
+// Validate checks the field values on UpdateJobScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateJobScheduleResponseMultiError, or nil if none found.
+func (m *UpdateJobScheduleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
+func (m *UpdateJobScheduleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if all {
-		switch v := interface{}(m.GetSource()).(type) {
+		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionRequestValidationError{
-				field:  "Source",
+			return UpdateJobScheduleResponseValidationError{
+				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return UpdateJobScheduleResponseMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateJobSourceConnectionRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateJobScheduleResponseMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetVirtualForeignKeys() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateJobSourceConnectionRequestValidationError{
-					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// UpdateJobScheduleResponseValidationError is the validation error returned by
+// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
+type UpdateJobScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
+	return "UpdateJobScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobScheduleResponseValidationError{}
 
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobScheduleResponseValidationError{}
+
+// Validate checks the field values on PauseJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobRequestMultiError, or nil if none found.
+func (m *PauseJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Pause
+
+	if m.Note != nil {
+		// no validation rules for Note
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionRequestMultiError(errors)
+		return PauseJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestMultiError []error
+// PauseJobRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
+func (m PauseJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7727,12 +8420,11 @@ func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
+func (m PauseJobRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionRequestValidationError is the validation error
-// returned by UpdateJobSourceConnectionRequest.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestValidationError struct {
+// PauseJobRequestValidationError is the validation error returned by
+// PauseJobRequest.Validate if the designated constraints aren't met.
+type PauseJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7740,24 +8432,22 @@ type UpdateJobSourceConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
+func (e PauseJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
+func (e PauseJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
+func (e PauseJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
+func (e PauseJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionRequestValidationError"
-}
+func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
+func (e PauseJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7769,14 +8459,14 @@ func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
+		"invalid %sPauseJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionRequestValidationError{}
+var _ error = PauseJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7784,25 +8474,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionRequestValidationError{}
+} = PauseJobRequestValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionResponse) Validate() error {
+// Validate checks the field values on PauseJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on PauseJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobResponseMultiError, or nil if none found.
+func (m *PauseJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
+func (m *PauseJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7813,7 +8502,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+				errors = append(errors, PauseJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7821,7 +8510,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+				errors = append(errors, PauseJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7830,7 +8519,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionResponseValidationError{
+			return PauseJobResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7839,20 +8528,19 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionResponseMultiError(errors)
+		return PauseJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionResponseMultiError []error
+// PauseJobResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
+func (m PauseJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7861,12 +8549,11 @@ func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
+func (m PauseJobResponseMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionResponseValidationError is the validation error
-// returned by UpdateJobSourceConnectionResponse.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionResponseValidationError struct {
+// PauseJobResponseValidationError is the validation error returned by
+// PauseJobResponse.Validate if the designated constraints aren't met.
+type PauseJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7874,24 +8561,22 @@ type UpdateJobSourceConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
+func (e PauseJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
+func (e PauseJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
+func (e PauseJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
+func (e PauseJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionResponseValidationError"
-}
+func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
+func (e PauseJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7903,14 +8588,14 @@ func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
+		"invalid %sPauseJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionResponseValidationError{}
+var _ error = PauseJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7918,56 +8603,122 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionResponseValidationError{}
+} = PauseJobResponseValidationError{}
 
-// Validate checks the field values on PostgresSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceSchemaSubset) Validate() error {
+// Validate checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PostgresSourceSchemaSubsetMultiError, or nil if none found.
-func (m *PostgresSourceSchemaSubset) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceSchemaSubset) validate(all bool) error {
+func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPostgresSchemas() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PostgresSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobSourceConnectionRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVirtualForeignKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobSourceConnectionRequestValidationError{
+					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7977,19 +8728,20 @@ func (m *PostgresSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PostgresSourceSchemaSubsetMultiError(errors)
+		return UpdateJobSourceConnectionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
-// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
-// the designated constraints aren't met.
-type PostgresSourceSchemaSubsetMultiError []error
+// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceSchemaSubsetMultiError) Error() string {
+func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7998,11 +8750,12 @@ func (m PostgresSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
 
-// PostgresSourceSchemaSubsetValidationError is the validation error returned
-// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
-type PostgresSourceSchemaSubsetValidationError struct {
+// UpdateJobSourceConnectionRequestValidationError is the validation error
+// returned by UpdateJobSourceConnectionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8010,24 +8763,24 @@ type PostgresSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
-	return "PostgresSourceSchemaSubsetValidationError"
+func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceSchemaSubsetValidationError) Error() string {
+func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8039,14 +8792,14 @@ func (e PostgresSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
+		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceSchemaSubsetValidationError{}
+var _ error = UpdateJobSourceConnectionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8054,78 +8807,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceSchemaSubsetValidationError{}
+} = UpdateJobSourceConnectionRequestValidationError{}
 
-// Validate checks the field values on MysqlSourceSchemaSubset with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceSchemaSubset) Validate() error {
+// Validate checks the field values on UpdateJobSourceConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlSourceSchemaSubsetMultiError, or nil if none found.
-func (m *MysqlSourceSchemaSubset) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceSchemaSubset) validate(all bool) error {
+func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMysqlSchemas() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MysqlSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return MysqlSourceSchemaSubsetMultiError(errors)
+		return UpdateJobSourceConnectionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
-// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
+// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlSourceSchemaSubsetMultiError []error
+type UpdateJobSourceConnectionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceSchemaSubsetMultiError) Error() string {
+func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8134,11 +8884,12 @@ func (m MysqlSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
 
-// MysqlSourceSchemaSubsetValidationError is the validation error returned by
-// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
-type MysqlSourceSchemaSubsetValidationError struct {
+// UpdateJobSourceConnectionResponseValidationError is the validation error
+// returned by UpdateJobSourceConnectionResponse.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8146,24 +8897,24 @@ type MysqlSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
-	return "MysqlSourceSchemaSubsetValidationError"
+func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceSchemaSubsetValidationError) Error() string {
+func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8175,14 +8926,14 @@ func (e MysqlSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
+		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceSchemaSubsetValidationError{}
+var _ error = UpdateJobSourceConnectionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8190,47 +8941,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceSchemaSubsetValidationError{}
+} = UpdateJobSourceConnectionResponseValidationError{}
 
-// Validate checks the field values on DynamoDBSourceSchemaSubset with the
+// Validate checks the field values on PostgresSourceSchemaSubset with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DynamoDBSourceSchemaSubset) Validate() error {
+func (m *PostgresSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DynamoDBSourceSchemaSubset with the
+// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DynamoDBSourceSchemaSubsetMultiError, or nil if none found.
-func (m *DynamoDBSourceSchemaSubset) ValidateAll() error {
+// PostgresSourceSchemaSubsetMultiError, or nil if none found.
+func (m *PostgresSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DynamoDBSourceSchemaSubset) validate(all bool) error {
+func (m *PostgresSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTables() {
+	for idx, item := range m.GetPostgresSchemas() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DynamoDBSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("Tables[%v]", idx),
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DynamoDBSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("Tables[%v]", idx),
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8238,8 +8989,8 @@ func (m *DynamoDBSourceSchemaSubset) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DynamoDBSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("Tables[%v]", idx),
+				return PostgresSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8249,19 +9000,19 @@ func (m *DynamoDBSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DynamoDBSourceSchemaSubsetMultiError(errors)
+		return PostgresSourceSchemaSubsetMultiError(errors)
 	}
 
 	return nil
 }
 
-// DynamoDBSourceSchemaSubsetMultiError is an error wrapping multiple
-// validation errors returned by DynamoDBSourceSchemaSubset.ValidateAll() if
+// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
+// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
 // the designated constraints aren't met.
-type DynamoDBSourceSchemaSubsetMultiError []error
+type PostgresSourceSchemaSubsetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DynamoDBSourceSchemaSubsetMultiError) Error() string {
+func (m PostgresSourceSchemaSubsetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8270,11 +9021,11 @@ func (m DynamoDBSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DynamoDBSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
 
-// DynamoDBSourceSchemaSubsetValidationError is the validation error returned
-// by DynamoDBSourceSchemaSubset.Validate if the designated constraints aren't met.
-type DynamoDBSourceSchemaSubsetValidationError struct {
+// PostgresSourceSchemaSubsetValidationError is the validation error returned
+// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
+type PostgresSourceSchemaSubsetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8282,24 +9033,24 @@ type DynamoDBSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e DynamoDBSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DynamoDBSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DynamoDBSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DynamoDBSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DynamoDBSourceSchemaSubsetValidationError) ErrorName() string {
-	return "DynamoDBSourceSchemaSubsetValidationError"
+func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
+	return "PostgresSourceSchemaSubsetValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DynamoDBSourceSchemaSubsetValidationError) Error() string {
+func (e PostgresSourceSchemaSubsetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8311,14 +9062,14 @@ func (e DynamoDBSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDynamoDBSourceSchemaSubset.%s: %s%s",
+		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DynamoDBSourceSchemaSubsetValidationError{}
+var _ error = PostgresSourceSchemaSubsetValidationError{}
 
 var _ interface {
 	Field() string
@@ -8326,47 +9077,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DynamoDBSourceSchemaSubsetValidationError{}
+} = PostgresSourceSchemaSubsetValidationError{}
 
-// Validate checks the field values on MssqlSourceSchemaSubset with the rules
+// Validate checks the field values on MysqlSourceSchemaSubset with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MssqlSourceSchemaSubset) Validate() error {
+func (m *MysqlSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MssqlSourceSchemaSubset with the
+// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MssqlSourceSchemaSubsetMultiError, or nil if none found.
-func (m *MssqlSourceSchemaSubset) ValidateAll() error {
+// MysqlSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MysqlSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MssqlSourceSchemaSubset) validate(all bool) error {
+func (m *MysqlSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMssqlSchemas() {
+	for idx, item := range m.GetMysqlSchemas() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8374,8 +9125,8 @@ func (m *MssqlSourceSchemaSubset) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MssqlSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+				return MysqlSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8385,19 +9136,19 @@ func (m *MssqlSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MssqlSourceSchemaSubsetMultiError(errors)
+		return MysqlSourceSchemaSubsetMultiError(errors)
 	}
 
 	return nil
 }
 
-// MssqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
-// errors returned by MssqlSourceSchemaSubset.ValidateAll() if the designated
+// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
 // constraints aren't met.
-type MssqlSourceSchemaSubsetMultiError []error
+type MysqlSourceSchemaSubsetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MssqlSourceSchemaSubsetMultiError) Error() string {
+func (m MysqlSourceSchemaSubsetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8406,11 +9157,11 @@ func (m MssqlSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MssqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
 
-// MssqlSourceSchemaSubsetValidationError is the validation error returned by
-// MssqlSourceSchemaSubset.Validate if the designated constraints aren't met.
-type MssqlSourceSchemaSubsetValidationError struct {
+// MysqlSourceSchemaSubsetValidationError is the validation error returned by
+// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MysqlSourceSchemaSubsetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8418,24 +9169,24 @@ type MssqlSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e MssqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MssqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MssqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MssqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MssqlSourceSchemaSubsetValidationError) ErrorName() string {
-	return "MssqlSourceSchemaSubsetValidationError"
+func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MysqlSourceSchemaSubsetValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MssqlSourceSchemaSubsetValidationError) Error() string {
+func (e MysqlSourceSchemaSubsetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8447,14 +9198,14 @@ func (e MssqlSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMssqlSourceSchemaSubset.%s: %s%s",
+		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MssqlSourceSchemaSubsetValidationError{}
+var _ error = MysqlSourceSchemaSubsetValidationError{}
 
 var _ interface {
 	Field() string
@@ -8462,213 +9213,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MssqlSourceSchemaSubsetValidationError{}
+} = MysqlSourceSchemaSubsetValidationError{}
 
-// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DynamoDBSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobSourceSqlSubetSchemas) Validate() error {
+func (m *DynamoDBSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
+// ValidateAll checks the field values on DynamoDBSourceSchemaSubset with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobSourceSqlSubetSchemasMultiError, or nil if none found.
-func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+// DynamoDBSourceSchemaSubsetMultiError, or nil if none found.
+func (m *DynamoDBSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+func (m *DynamoDBSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Schemas.(type) {
-	case *JobSourceSqlSubetSchemas_PostgresSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPostgresSubset()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
+					errors = append(errors, DynamoDBSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
+					errors = append(errors, DynamoDBSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "PostgresSubset",
+				return DynamoDBSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *JobSourceSqlSubetSchemas_MysqlSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetMysqlSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "MysqlSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return DynamoDBSourceSchemaSubsetMultiError(errors)
+	}
 
-	case *JobSourceSqlSubetSchemas_DynamodbSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetDynamodbSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "DynamodbSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "DynamodbSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDynamodbSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "DynamodbSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *JobSourceSqlSubetSchemas_MssqlSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMssqlSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MssqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MssqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMssqlSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "MssqlSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return JobSourceSqlSubetSchemasMultiError(errors)
-	}
-
-	return nil
-}
-
-// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
-// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
-// constraints aren't met.
-type JobSourceSqlSubetSchemasMultiError []error
+// DynamoDBSourceSchemaSubsetMultiError is an error wrapping multiple
+// validation errors returned by DynamoDBSourceSchemaSubset.ValidateAll() if
+// the designated constraints aren't met.
+type DynamoDBSourceSchemaSubsetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+func (m DynamoDBSourceSchemaSubsetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8677,11 +9293,11 @@ func (m JobSourceSqlSubetSchemasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+func (m DynamoDBSourceSchemaSubsetMultiError) AllErrors() []error { return m }
 
-// JobSourceSqlSubetSchemasValidationError is the validation error returned by
-// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
-type JobSourceSqlSubetSchemasValidationError struct {
+// DynamoDBSourceSchemaSubsetValidationError is the validation error returned
+// by DynamoDBSourceSchemaSubset.Validate if the designated constraints aren't met.
+type DynamoDBSourceSchemaSubsetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8689,24 +9305,24 @@ type JobSourceSqlSubetSchemasValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+func (e DynamoDBSourceSchemaSubsetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+func (e DynamoDBSourceSchemaSubsetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+func (e DynamoDBSourceSchemaSubsetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+func (e DynamoDBSourceSchemaSubsetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
-	return "JobSourceSqlSubetSchemasValidationError"
+func (e DynamoDBSourceSchemaSubsetValidationError) ErrorName() string {
+	return "DynamoDBSourceSchemaSubsetValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+func (e DynamoDBSourceSchemaSubsetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8718,14 +9334,14 @@ func (e JobSourceSqlSubetSchemasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		"invalid %sDynamoDBSourceSchemaSubset.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobSourceSqlSubetSchemasValidationError{}
+var _ error = DynamoDBSourceSchemaSubsetValidationError{}
 
 var _ interface {
 	Field() string
@@ -8733,80 +9349,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobSourceSqlSubetSchemasValidationError{}
+} = DynamoDBSourceSchemaSubsetValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+// Validate checks the field values on MssqlSourceSchemaSubset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+// ValidateAll checks the field values on MssqlSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MssqlSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+func (m *MssqlSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetMssqlSchemas() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetSchemas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
+				return MssqlSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
-				field:  "Schemas",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for SubsetByForeignKeyConstraints
+	}
 
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+		return MssqlSourceSchemaSubsetMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
+// MssqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MssqlSourceSchemaSubset.ValidateAll() if the designated
 // constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+type MssqlSourceSchemaSubsetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+func (m MssqlSourceSchemaSubsetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8815,12 +9429,11 @@ func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+func (m MssqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+// MssqlSourceSchemaSubsetValidationError is the validation error returned by
+// MssqlSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MssqlSourceSchemaSubsetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8828,24 +9441,24 @@ type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+func (e MssqlSourceSchemaSubsetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+func (e MssqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+func (e MssqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+func (e MssqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+func (e MssqlSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MssqlSourceSchemaSubsetValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+func (e MssqlSourceSchemaSubsetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8857,14 +9470,14 @@ func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		"invalid %sMssqlSourceSchemaSubset.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+var _ error = MssqlSourceSchemaSubsetValidationError{}
 
 var _ interface {
 	Field() string
@@ -8872,76 +9485,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+} = MssqlSourceSchemaSubsetValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+// Validate checks the field values on MongoDBSourceSchemaSubset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MongoDBSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
+// ValidateAll checks the field values on MongoDBSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MongoDBSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MongoDBSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
+func (m *MongoDBSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MongoDBSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MongoDBSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
+				return MongoDBSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+		return MongoDBSourceSchemaSubsetMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+// MongoDBSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MongoDBSourceSchemaSubset.ValidateAll() if the
+// designated constraints aren't met.
+type MongoDBSourceSchemaSubsetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+func (m MongoDBSourceSchemaSubsetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8950,12 +9565,597 @@ func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+func (m MongoDBSourceSchemaSubsetMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
+// MongoDBSourceSchemaSubsetValidationError is the validation error returned by
+// MongoDBSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MongoDBSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MongoDBSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MongoDBSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MongoDBSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MongoDBSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MongoDBSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MongoDBSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MongoDBSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMongoDBSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MongoDBSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MongoDBSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobSourceSqlSubetSchemas) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobSourceSqlSubetSchemasMultiError, or nil if none found.
+func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Schemas.(type) {
+	case *JobSourceSqlSubetSchemas_PostgresSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "PostgresSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MysqlSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MysqlSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_DynamodbSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDynamodbSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "DynamodbSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "DynamodbSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDynamodbSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "DynamodbSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MssqlSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MssqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MssqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MssqlSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MongodbSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MongodbSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MongodbSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MongodbSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobSourceSqlSubetSchemasMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
+// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
+// constraints aren't met.
+type JobSourceSqlSubetSchemasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+
+// JobSourceSqlSubetSchemasValidationError is the validation error returned by
+// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
+type JobSourceSqlSubetSchemasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
+	return "JobSourceSqlSubetSchemasValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobSourceSqlSubetSchemasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobSourceSqlSubetSchemasValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSchemas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
+				field:  "Schemas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8966,21 +10166,2338 @@ type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
 func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionRequestValidationError is the validation error
+// returned by UpdateJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionResponseValidationError is the validation
+// error returned by UpdateJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionRequestValidationError is the validation error
+// returned by DeleteJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionResponseValidationError is the validation
+// error returned by DeleteJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobDestinationConnectionsRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsRequestValidationError is the validation
+// error returned by CreateJobDestinationConnectionsRequest.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsRequestValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobDestinationConnectionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsResponseValidationError is the validation
+// error returned by CreateJobDestinationConnectionsResponse.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRequestMultiError, or nil if none found.
+func (m *DeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRequestValidationError is the validation error returned by
+// DeleteJobRequest.Validate if the designated constraints aren't met.
+type DeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRequestValidationError{}
+
+// Validate checks the field values on DeleteJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobResponseMultiError, or nil if none found.
+func (m *DeleteJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobResponseValidationError is the validation error returned by
+// DeleteJobResponse.Validate if the designated constraints aren't met.
+type DeleteJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobResponseValidationError) ErrorName() string {
+	return "DeleteJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobResponseValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableRequestMultiError, or nil if none found.
+func (m *IsJobNameAvailableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
+// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
+// designated constraints aren't met.
+type IsJobNameAvailableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableRequestValidationError is the validation error returned by
+// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
+type IsJobNameAvailableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
+	return "IsJobNameAvailableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableRequestValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableResponseMultiError, or nil if none found.
+func (m *IsJobNameAvailableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsAvailable
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableResponseMultiError is an error wrapping multiple
+// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IsJobNameAvailableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableResponseValidationError is the validation error returned
+// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
+type IsJobNameAvailableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
+	return "IsJobNameAvailableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableResponseValidationError{}
+
+// Validate checks the field values on GetJobRunsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsRequestMultiError, or nil if none found.
+func (m *GetJobRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	switch v := m.Id.(type) {
+	case *GetJobRunsRequest_JobId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *GetJobRunsRequest_AccountId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AccountId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.StartedAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartedAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunsRequestValidationError{
+						field:  "StartedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunsRequestValidationError{
+						field:  "StartedAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartedAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunsRequestValidationError{
+					field:  "StartedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.StartedBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartedBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunsRequestValidationError{
+						field:  "StartedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunsRequestValidationError{
+						field:  "StartedBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartedBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunsRequestValidationError{
+					field:  "StartedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunsRequestValidationError is the validation error returned by
+// GetJobRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsRequestValidationError) ErrorName() string {
+	return "GetJobRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsResponseMultiError, or nil if none found.
+func (m *GetJobRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunsResponseValidationError{
+					field:  fmt.Sprintf("JobRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextPageToken != nil {
+		// no validation rules for NextPageToken
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunsResponseValidationError is the validation error returned by
+// GetJobRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsResponseValidationError) ErrorName() string {
+	return "GetJobRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsResponseValidationError{}
+
+// Validate checks the field values on GetJobRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunRequestMultiError, or nil if none found.
+func (m *GetJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunRequestValidationError is the validation error returned by
+// GetJobRunRequest.Validate if the designated constraints aren't met.
+type GetJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunRequestValidationError{}
+
+// Validate checks the field values on GetJobRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunResponseMultiError, or nil if none found.
+func (m *GetJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJobRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobRunResponseValidationError{
+				field:  "JobRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunResponseValidationError is the validation error returned by
+// GetJobRunResponse.Validate if the designated constraints aren't met.
+type GetJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunResponseValidationError) ErrorName() string {
+	return "GetJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunResponseValidationError{}
+
+// Validate checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunRequestMultiError, or nil if none found.
+func (m *CreateJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if m.Overrides != nil {
+
+		if all {
+			switch v := interface{}(m.GetOverrides()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRunRequestValidationError{
+						field:  "Overrides",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRunRequestValidationError{
+						field:  "Overrides",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOverrides()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRunRequestValidationError{
+					field:  "Overrides",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRunRequestValidationError is the validation error returned by
+// CreateJobRunRequest.Validate if the designated constraints aren't met.
+type CreateJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunRequestValidationError) ErrorName() string {
+	return "CreateJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunRequestValidationError{}
+
+// Validate checks the field values on JobRunOverrides with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobRunOverrides) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunOverrides with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunOverridesMultiError, or nil if none found.
+func (m *JobRunOverrides) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunOverrides) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunOverridesValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunOverridesValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunOverridesValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunOverridesValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunOverridesValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunOverridesValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobRunOverridesMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunOverridesMultiError is an error wrapping multiple validation errors
+// returned by JobRunOverrides.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunOverridesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunOverridesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunOverridesMultiError) AllErrors() []error { return m }
+
+// JobRunOverridesValidationError is the validation error returned by
+// JobRunOverrides.Validate if the designated constraints aren't met.
+type JobRunOverridesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunOverridesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunOverridesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunOverridesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunOverridesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunOverridesValidationError) ErrorName() string { return "JobRunOverridesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunOverridesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunOverridesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunOverridesValidationError{}
+
+// Validate checks the field values on JobRunTableOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunTableOverride) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunTableOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunTableOverrideMultiError, or nil if none found.
+func (m *JobRunTableOverride) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunTableOverride) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
+
+	if m.RowCount != nil {
+		// no validation rules for RowCount
+	}
+
+	if len(errors) > 0 {
+		return JobRunTableOverrideMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunTableOverrideMultiError is an error wrapping multiple validation
+// errors returned by JobRunTableOverride.ValidateAll() if the designated
+// constraints aren't met.
+type JobRunTableOverrideMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunTableOverrideMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunTableOverrideMultiError) AllErrors() []error { return m }
+
+// JobRunTableOverrideValidationError is the validation error returned by
+// JobRunTableOverride.Validate if the designated constraints aren't met.
+type JobRunTableOverrideValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunTableOverrideValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunTableOverrideValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunTableOverrideValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunTableOverrideValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunTableOverrideValidationError) ErrorName() string {
+	return "JobRunTableOverrideValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobRunTableOverrideValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunTableOverrideValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunTableOverrideValidationError{}
+
+// Validate checks the field values on JobRunDestinationOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunDestinationOverride) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunDestinationOverride with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunDestinationOverrideMultiError, or nil if none found.
+func (m *JobRunDestinationOverride) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunDestinationOverride) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	if m.ConnectionId != nil {
+		// no validation rules for ConnectionId
+	}
+
+	if m.Options != nil {
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunDestinationOverrideValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunDestinationOverrideValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunDestinationOverrideValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobRunDestinationOverrideMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunDestinationOverrideMultiError is an error wrapping multiple validation
+// errors returned by JobRunDestinationOverride.ValidateAll() if the
+// designated constraints aren't met.
+type JobRunDestinationOverrideMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunDestinationOverrideMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunDestinationOverrideMultiError) AllErrors() []error { return m }
+
+// JobRunDestinationOverrideValidationError is the validation error returned by
+// JobRunDestinationOverride.Validate if the designated constraints aren't met.
+type JobRunDestinationOverrideValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunDestinationOverrideValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunDestinationOverrideValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+func (e JobRunDestinationOverrideValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+func (e JobRunDestinationOverrideValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+func (e JobRunDestinationOverrideValidationError) ErrorName() string {
+	return "JobRunDestinationOverrideValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+func (e JobRunDestinationOverrideValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8992,14 +12509,14 @@ func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		"invalid %sGenerateSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+var _ error = JobRunDestinationOverrideValidationError{}
 
 var _ interface {
 	Field() string
@@ -9007,81 +12524,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+} = JobRunDestinationOverrideValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunResponseMultiError, or nil if none found.
+func (m *CreateJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+func (m *CreateJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
-
-	// no validation rules for ConnectionId
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.JobRunId != nil {
+		// no validation rules for JobRunId
 	}
 
-	// no validation rules for DestinationId
-
 	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionRequestMultiError(errors)
+		return CreateJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
+// CreateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobDestinationConnectionRequestMultiError []error
+type CreateJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+func (m CreateJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9090,12 +12574,11 @@ func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionRequestValidationError is the validation error
-// returned by UpdateJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionRequestValidationError struct {
+// CreateJobRunResponseValidationError is the validation error returned by
+// CreateJobRunResponse.Validate if the designated constraints aren't met.
+type CreateJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9103,24 +12586,24 @@ type UpdateJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e CreateJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionRequestValidationError"
+func (e CreateJobRunResponseValidationError) ErrorName() string {
+	return "CreateJobRunResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+func (e CreateJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9132,14 +12615,14 @@ func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sCreateJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+var _ error = CreateJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9147,76 +12630,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionRequestValidationError{}
+} = CreateJobRunResponseValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// UpdateJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunRequestMultiError, or nil if none found.
+func (m *CancelJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+func (m *CancelJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionResponseMultiError(errors)
+		return CancelJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
+// CancelJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobDestinationConnectionResponseMultiError []error
+type CancelJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+func (m CancelJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9225,12 +12680,11 @@ func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionResponseValidationError is the validation
-// error returned by UpdateJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionResponseValidationError struct {
+// CancelJobRunRequestValidationError is the validation error returned by
+// CancelJobRunRequest.Validate if the designated constraints aren't met.
+type CancelJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9238,24 +12692,24 @@ type UpdateJobDestinationConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+func (e CancelJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionResponseValidationError"
+func (e CancelJobRunRequestValidationError) ErrorName() string {
+	return "CancelJobRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+func (e CancelJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9267,14 +12721,14 @@ func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		"invalid %sCancelJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+var _ error = CancelJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9282,48 +12736,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionResponseValidationError{}
+} = CancelJobRunRequestValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunResponseMultiError, or nil if none found.
+func (m *CancelJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+func (m *CancelJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DestinationId
-
 	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionRequestMultiError(errors)
+		return CancelJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
+// CancelJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteJobDestinationConnectionRequestMultiError []error
+type CancelJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+func (m CancelJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9332,12 +12782,11 @@ func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobDestinationConnectionRequestValidationError is the validation error
-// returned by DeleteJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionRequestValidationError struct {
+// CancelJobRunResponseValidationError is the validation error returned by
+// CancelJobRunResponse.Validate if the designated constraints aren't met.
+type CancelJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9345,24 +12794,24 @@ type DeleteJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e CancelJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionRequestValidationError"
+func (e CancelJobRunResponseValidationError) ErrorName() string {
+	return "CancelJobRunResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+func (e CancelJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9374,14 +12823,14 @@ func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sCancelJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+var _ error = CancelJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9389,157 +12838,269 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobDestinationConnectionRequestValidationError{}
+} = CancelJobRunResponseValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// DeleteJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+func (m *Job) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionResponseMultiError(errors)
-	}
-
-	return nil
-}
+	// no validation rules for Id
 
-// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobDestinationConnectionResponseMultiError []error
+	// no validation rules for CreatedByUserId
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
-
-// DeleteJobDestinationConnectionResponseValidationError is the validation
-// error returned by DeleteJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
 
-// Field function returns field value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+	// no validation rules for UpdatedByUserId
 
-// Reason function returns reason value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// Cause function returns cause value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+	// no validation rules for Name
 
-// Key function returns key value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// ErrorName returns error name.
-func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionResponseValidationError"
-}
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
 
-// Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
 	}
 
-	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = DeleteJobDestinationConnectionResponseValidationError{}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Validate checks the field values on CreateJobDestinationConnectionsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsRequest) Validate() error {
-	return m.validate(false)
-}
+	}
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for AccountId
 
-func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
-
-	// no validation rules for JobId
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	for idx, item := range m.GetDestinations() {
+	for idx, item := range m.GetVirtualForeignKeys() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9547,8 +13108,8 @@ func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateJobDestinationConnectionsRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
+				return JobValidationError{
+					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9557,21 +13118,23 @@ func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
 
 	}
 
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsRequestMultiError(errors)
+		return JobMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobDestinationConnectionsRequestMultiError []error
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+func (m JobMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9580,12 +13143,11 @@ func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+func (m JobMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsRequestValidationError is the validation
-// error returned by CreateJobDestinationConnectionsRequest.Validate if the
+// JobValidationError is the validation error returned by Job.Validate if the
 // designated constraints aren't met.
-type CreateJobDestinationConnectionsRequestValidationError struct {
+type JobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9593,24 +13155,22 @@ type CreateJobDestinationConnectionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+func (e JobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+func (e JobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+func (e JobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+func (e JobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsRequestValidationError"
-}
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+func (e JobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9622,14 +13182,14 @@ func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		"invalid %sJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+var _ error = JobValidationError{}
 
 var _ interface {
 	Field() string
@@ -9637,26 +13197,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsRequestValidationError{}
+} = JobValidationError{}
 
-// Validate checks the field values on CreateJobDestinationConnectionsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+// Validate checks the field values on JobRecentRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRecentRun) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobRecentRun with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRecentRunMultiError, or
+// nil if none found.
+func (m *JobRecentRun) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+func (m *JobRecentRun) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9664,49 +13222,49 @@ func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetStartTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateJobDestinationConnectionsResponseValidationError{
-				field:  "Job",
+			return JobRecentRunValidationError{
+				field:  "StartTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for JobRunId
+
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsResponseMultiError(errors)
+		return JobRecentRunMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobDestinationConnectionsResponseMultiError []error
+// JobRecentRunMultiError is an error wrapping multiple validation errors
+// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
+type JobRecentRunMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+func (m JobRecentRunMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9715,12 +13273,11 @@ func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+func (m JobRecentRunMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsResponseValidationError is the validation
-// error returned by CreateJobDestinationConnectionsResponse.Validate if the
-// designated constraints aren't met.
-type CreateJobDestinationConnectionsResponseValidationError struct {
+// JobRecentRunValidationError is the validation error returned by
+// JobRecentRun.Validate if the designated constraints aren't met.
+type JobRecentRunValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9728,24 +13285,22 @@ type CreateJobDestinationConnectionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+func (e JobRecentRunValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+func (e JobRecentRunValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+func (e JobRecentRunValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+func (e JobRecentRunValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsResponseValidationError"
-}
+func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+func (e JobRecentRunValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9757,14 +13312,14 @@ func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		"invalid %sJobRecentRun.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+var _ error = JobRecentRunValidationError{}
 
 var _ interface {
 	Field() string
@@ -9772,46 +13327,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsResponseValidationError{}
+} = JobRecentRunValidationError{}
 
-// Validate checks the field values on DeleteJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRequest) Validate() error {
+// Validate checks the field values on GetJobRecentRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRecentRunsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRecentRunsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobRequestMultiError, or nil if none found.
-func (m *DeleteJobRequest) ValidateAll() error {
+// GetJobRecentRunsRequestMultiError, or nil if none found.
+func (m *GetJobRecentRunsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRequest) validate(all bool) error {
+func (m *GetJobRecentRunsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return DeleteJobRequestMultiError(errors)
+		return GetJobRecentRunsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobRequestMultiError []error
+// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRecentRunsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRequestMultiError) Error() string {
+func (m GetJobRecentRunsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9820,11 +13375,11 @@ func (m DeleteJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteJobRequestValidationError is the validation error returned by
-// DeleteJobRequest.Validate if the designated constraints aren't met.
-type DeleteJobRequestValidationError struct {
+// GetJobRecentRunsRequestValidationError is the validation error returned by
+// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRecentRunsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9832,22 +13387,24 @@ type DeleteJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRequestValidationError) Field() string { return e.field }
+func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
+	return "GetJobRecentRunsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRequestValidationError) Error() string {
+func (e GetJobRecentRunsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9859,14 +13416,14 @@ func (e DeleteJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRequest.%s: %s%s",
+		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRequestValidationError{}
+var _ error = GetJobRecentRunsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9874,44 +13431,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRequestValidationError{}
+} = GetJobRecentRunsRequestValidationError{}
 
-// Validate checks the field values on DeleteJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobResponse) Validate() error {
+// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRecentRunsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRecentRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobResponseMultiError, or nil if none found.
-func (m *DeleteJobResponse) ValidateAll() error {
+// GetJobRecentRunsResponseMultiError, or nil if none found.
+func (m *GetJobRecentRunsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobResponse) validate(all bool) error {
+func (m *GetJobRecentRunsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetRecentRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRecentRunsResponseValidationError{
+					field:  fmt.Sprintf("RecentRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobResponseMultiError(errors)
+		return GetJobRecentRunsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobResponseMultiError []error
+// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRecentRunsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobResponseMultiError) Error() string {
+func (m GetJobRecentRunsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9920,11 +13511,11 @@ func (m DeleteJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobResponseValidationError is the validation error returned by
-// DeleteJobResponse.Validate if the designated constraints aren't met.
-type DeleteJobResponseValidationError struct {
+// GetJobRecentRunsResponseValidationError is the validation error returned by
+// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRecentRunsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9932,24 +13523,24 @@ type DeleteJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobResponseValidationError) Field() string { return e.field }
+func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobResponseValidationError) ErrorName() string {
-	return "DeleteJobResponseValidationError"
+func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
+	return "GetJobRecentRunsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobResponseValidationError) Error() string {
+func (e GetJobRecentRunsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9961,14 +13552,14 @@ func (e DeleteJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobResponse.%s: %s%s",
+		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobResponseValidationError{}
+var _ error = GetJobRecentRunsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9976,48 +13567,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobResponseValidationError{}
+} = GetJobRecentRunsResponseValidationError{}
 
-// Validate checks the field values on IsJobNameAvailableRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableRequest) Validate() error {
+// Validate checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobNextRuns) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableRequestMultiError, or nil if none found.
-func (m *IsJobNameAvailableRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobNextRunsMultiError, or
+// nil if none found.
+func (m *JobNextRuns) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableRequest) validate(all bool) error {
+func (m *JobNextRuns) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	for idx, item := range m.GetNextRunTimes() {
+		_, _ = idx, item
 
-	// no validation rules for AccountId
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobNextRunsValidationError{
+					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IsJobNameAvailableRequestMultiError(errors)
+		return JobNextRunsMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
-// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
-// designated constraints aren't met.
-type IsJobNameAvailableRequestMultiError []error
+// JobNextRunsMultiError is an error wrapping multiple validation errors
+// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
+type JobNextRunsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableRequestMultiError) Error() string {
+func (m JobNextRunsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10026,11 +13646,11 @@ func (m IsJobNameAvailableRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+func (m JobNextRunsMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableRequestValidationError is the validation error returned by
-// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
-type IsJobNameAvailableRequestValidationError struct {
+// JobNextRunsValidationError is the validation error returned by
+// JobNextRuns.Validate if the designated constraints aren't met.
+type JobNextRunsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10038,24 +13658,22 @@ type IsJobNameAvailableRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+func (e JobNextRunsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+func (e JobNextRunsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+func (e JobNextRunsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+func (e JobNextRunsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
-	return "IsJobNameAvailableRequestValidationError"
-}
+func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableRequestValidationError) Error() string {
+func (e JobNextRunsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10067,14 +13685,14 @@ func (e IsJobNameAvailableRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		"invalid %sJobNextRuns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableRequestValidationError{}
+var _ error = JobNextRunsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10082,46 +13700,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableRequestValidationError{}
+} = JobNextRunsValidationError{}
 
-// Validate checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobNextRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableResponse) Validate() error {
+func (m *GetJobNextRunsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableResponseMultiError, or nil if none found.
-func (m *IsJobNameAvailableResponse) ValidateAll() error {
+// GetJobNextRunsRequestMultiError, or nil if none found.
+func (m *GetJobNextRunsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableResponse) validate(all bool) error {
+func (m *GetJobNextRunsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsAvailable
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return IsJobNameAvailableResponseMultiError(errors)
+		return GetJobNextRunsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsJobNameAvailableResponseMultiError is an error wrapping multiple
-// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
-// the designated constraints aren't met.
-type IsJobNameAvailableResponseMultiError []error
+// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableResponseMultiError) Error() string {
+func (m GetJobNextRunsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10130,11 +13748,11 @@ func (m IsJobNameAvailableResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableResponseValidationError is the validation error returned
-// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
-type IsJobNameAvailableResponseValidationError struct {
+// GetJobNextRunsRequestValidationError is the validation error returned by
+// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
+type GetJobNextRunsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10142,24 +13760,24 @@ type IsJobNameAvailableResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
-	return "IsJobNameAvailableResponseValidationError"
+func (e GetJobNextRunsRequestValidationError) ErrorName() string {
+	return "GetJobNextRunsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableResponseValidationError) Error() string {
+func (e GetJobNextRunsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10171,14 +13789,14 @@ func (e IsJobNameAvailableResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		"invalid %sGetJobNextRunsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableResponseValidationError{}
+var _ error = GetJobNextRunsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10186,73 +13804,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableResponseValidationError{}
+} = GetJobNextRunsRequestValidationError{}
 
-// Validate checks the field values on GetJobRunsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsRequest) Validate() error {
+// Validate checks the field values on GetJobNextRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobNextRunsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsRequest with the rules
+// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunsRequestMultiError, or nil if none found.
-func (m *GetJobRunsRequest) ValidateAll() error {
+// GetJobNextRunsResponseMultiError, or nil if none found.
+func (m *GetJobNextRunsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsRequest) validate(all bool) error {
+func (m *GetJobNextRunsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *GetJobRunsRequest_JobId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetNextRuns()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for JobId
-	case *GetJobRunsRequest_AccountId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobNextRunsResponseValidationError{
+				field:  "NextRuns",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for AccountId
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunsRequestMultiError(errors)
+		return GetJobNextRunsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunsRequestMultiError []error
+// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsRequestMultiError) Error() string {
+func (m GetJobNextRunsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10261,11 +13879,11 @@ func (m GetJobRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunsRequestValidationError is the validation error returned by
-// GetJobRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRunsRequestValidationError struct {
+// GetJobNextRunsResponseValidationError is the validation error returned by
+// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
+type GetJobNextRunsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10273,24 +13891,24 @@ type GetJobRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsRequestValidationError) ErrorName() string {
-	return "GetJobRunsRequestValidationError"
+func (e GetJobNextRunsResponseValidationError) ErrorName() string {
+	return "GetJobNextRunsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsRequestValidationError) Error() string {
+func (e GetJobNextRunsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10302,14 +13920,14 @@ func (e GetJobRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsRequest.%s: %s%s",
+		"invalid %sGetJobNextRunsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsRequestValidationError{}
+var _ error = GetJobNextRunsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10317,78 +13935,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsRequestValidationError{}
+} = GetJobNextRunsResponseValidationError{}
 
-// Validate checks the field values on GetJobRunsResponse with the rules
+// Validate checks the field values on GetJobStatusRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsResponse) Validate() error {
+func (m *GetJobStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsResponse with the rules
+// ValidateAll checks the field values on GetJobStatusRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunsResponseMultiError, or nil if none found.
-func (m *GetJobRunsResponse) ValidateAll() error {
+// GetJobStatusRequestMultiError, or nil if none found.
+func (m *GetJobStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsResponse) validate(all bool) error {
+func (m *GetJobStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetJobRuns() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunsResponseValidationError{
-					field:  fmt.Sprintf("JobRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return GetJobRunsResponseMultiError(errors)
+		return GetJobStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunsResponseMultiError []error
+// GetJobStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsResponseMultiError) Error() string {
+func (m GetJobStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10397,11 +13983,11 @@ func (m GetJobRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunsResponseValidationError is the validation error returned by
-// GetJobRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRunsResponseValidationError struct {
+// GetJobStatusRequestValidationError is the validation error returned by
+// GetJobStatusRequest.Validate if the designated constraints aren't met.
+type GetJobStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10409,24 +13995,24 @@ type GetJobRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+func (e GetJobStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsResponseValidationError) ErrorName() string {
-	return "GetJobRunsResponseValidationError"
+func (e GetJobStatusRequestValidationError) ErrorName() string {
+	return "GetJobStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsResponseValidationError) Error() string {
+func (e GetJobStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10438,14 +14024,14 @@ func (e GetJobRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsResponse.%s: %s%s",
+		"invalid %sGetJobStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsResponseValidationError{}
+var _ error = GetJobStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10453,48 +14039,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsResponseValidationError{}
-
-// Validate checks the field values on GetJobRunRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunRequest) Validate() error {
+} = GetJobStatusRequestValidationError{}
+
+// Validate checks the field values on GetJobStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunRequest with the rules
+// ValidateAll checks the field values on GetJobStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunRequestMultiError, or nil if none found.
-func (m *GetJobRunRequest) ValidateAll() error {
+// GetJobStatusResponseMultiError, or nil if none found.
+func (m *GetJobStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunRequest) validate(all bool) error {
+func (m *GetJobStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return GetJobRunRequestMultiError(errors)
+		return GetJobStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunRequestMultiError []error
+// GetJobStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunRequestMultiError) Error() string {
+func (m GetJobStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10503,11 +14087,11 @@ func (m GetJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunRequestValidationError is the validation error returned by
-// GetJobRunRequest.Validate if the designated constraints aren't met.
-type GetJobRunRequestValidationError struct {
+// GetJobStatusResponseValidationError is the validation error returned by
+// GetJobStatusResponse.Validate if the designated constraints aren't met.
+type GetJobStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10515,22 +14099,24 @@ type GetJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunRequestValidationError) Field() string { return e.field }
+func (e GetJobStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+func (e GetJobStatusResponseValidationError) ErrorName() string {
+	return "GetJobStatusResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRunRequestValidationError) Error() string {
+func (e GetJobStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10542,14 +14128,14 @@ func (e GetJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunRequest.%s: %s%s",
+		"invalid %sGetJobStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunRequestValidationError{}
+var _ error = GetJobStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10557,73 +14143,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunRequestValidationError{}
+} = GetJobStatusResponseValidationError{}
 
-// Validate checks the field values on GetJobRunResponse with the rules defined
+// Validate checks the field values on JobStatusRecord with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunResponse) Validate() error {
+func (m *JobStatusRecord) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunResponse with the rules
+// ValidateAll checks the field values on JobStatusRecord with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunResponseMultiError, or nil if none found.
-func (m *GetJobRunResponse) ValidateAll() error {
+// JobStatusRecordMultiError, or nil if none found.
+func (m *JobStatusRecord) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunResponse) validate(all bool) error {
+func (m *JobStatusRecord) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJobRun()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetJobRunResponseValidationError{
-				field:  "JobRun",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for JobId
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return GetJobRunResponseMultiError(errors)
+		return JobStatusRecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunResponse.ValidateAll() if the designated constraints
+// JobStatusRecordMultiError is an error wrapping multiple validation errors
+// returned by JobStatusRecord.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobRunResponseMultiError []error
+type JobStatusRecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunResponseMultiError) Error() string {
+func (m JobStatusRecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10632,11 +14193,11 @@ func (m GetJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+func (m JobStatusRecordMultiError) AllErrors() []error { return m }
 
-// GetJobRunResponseValidationError is the validation error returned by
-// GetJobRunResponse.Validate if the designated constraints aren't met.
-type GetJobRunResponseValidationError struct {
+// JobStatusRecordValidationError is the validation error returned by
+// JobStatusRecord.Validate if the designated constraints aren't met.
+type JobStatusRecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10644,24 +14205,22 @@ type GetJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunResponseValidationError) Field() string { return e.field }
+func (e JobStatusRecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+func (e JobStatusRecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+func (e JobStatusRecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+func (e JobStatusRecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunResponseValidationError) ErrorName() string {
-	return "GetJobRunResponseValidationError"
-}
+func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunResponseValidationError) Error() string {
+func (e JobStatusRecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10673,14 +14232,14 @@ func (e GetJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunResponse.%s: %s%s",
+		"invalid %sJobStatusRecord.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunResponseValidationError{}
+var _ error = JobStatusRecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -10688,46 +14247,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunResponseValidationError{}
+} = JobStatusRecordValidationError{}
 
-// Validate checks the field values on CreateJobRunRequest with the rules
+// Validate checks the field values on GetJobStatusesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunRequest) Validate() error {
+func (m *GetJobStatusesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunRequest with the rules
+// ValidateAll checks the field values on GetJobStatusesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobRunRequestMultiError, or nil if none found.
-func (m *CreateJobRunRequest) ValidateAll() error {
+// GetJobStatusesRequestMultiError, or nil if none found.
+func (m *GetJobStatusesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunRequest) validate(all bool) error {
+func (m *GetJobStatusesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return CreateJobRunRequestMultiError(errors)
+		return GetJobStatusesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunRequest.ValidateAll() if the designated
+// GetJobStatusesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobRunRequestMultiError []error
+type GetJobStatusesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunRequestMultiError) Error() string {
+func (m GetJobStatusesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10736,11 +14295,11 @@ func (m CreateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobRunRequestValidationError is the validation error returned by
-// CreateJobRunRequest.Validate if the designated constraints aren't met.
-type CreateJobRunRequestValidationError struct {
+// GetJobStatusesRequestValidationError is the validation error returned by
+// GetJobStatusesRequest.Validate if the designated constraints aren't met.
+type GetJobStatusesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10748,24 +14307,24 @@ type CreateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunRequestValidationError) ErrorName() string {
-	return "CreateJobRunRequestValidationError"
+func (e GetJobStatusesRequestValidationError) ErrorName() string {
+	return "GetJobStatusesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunRequestValidationError) Error() string {
+func (e GetJobStatusesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10777,14 +14336,14 @@ func (e CreateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunRequest.%s: %s%s",
+		"invalid %sGetJobStatusesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunRequestValidationError{}
+var _ error = GetJobStatusesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10792,44 +14351,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunRequestValidationError{}
+} = GetJobStatusesRequestValidationError{}
 
-// Validate checks the field values on CreateJobRunResponse with the rules
+// Validate checks the field values on GetJobStatusesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunResponse) Validate() error {
+func (m *GetJobStatusesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunResponse with the rules
+// ValidateAll checks the field values on GetJobStatusesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobRunResponseMultiError, or nil if none found.
-func (m *CreateJobRunResponse) ValidateAll() error {
+// GetJobStatusesResponseMultiError, or nil if none found.
+func (m *GetJobStatusesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunResponse) validate(all bool) error {
+func (m *GetJobStatusesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateJobRunResponseMultiError(errors)
+		return GetJobStatusesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunResponse.ValidateAll() if the designated
+// GetJobStatusesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobRunResponseMultiError []error
+type GetJobStatusesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunResponseMultiError) Error() string {
+func (m GetJobStatusesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10838,11 +14431,11 @@ func (m CreateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobRunResponseValidationError is the validation error returned by
-// CreateJobRunResponse.Validate if the designated constraints aren't met.
-type CreateJobRunResponseValidationError struct {
+// GetJobStatusesResponseValidationError is the validation error returned by
+// GetJobStatusesResponse.Validate if the designated constraints aren't met.
+type GetJobStatusesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10850,24 +14443,24 @@ type CreateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunResponseValidationError) Field() string { return e.field }
+func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
+func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunResponseValidationError) ErrorName() string {
-	return "CreateJobRunResponseValidationError"
+func (e GetJobStatusesResponseValidationError) ErrorName() string {
+	return "GetJobStatusesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunResponseValidationError) Error() string {
+func (e GetJobStatusesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10879,14 +14472,14 @@ func (e CreateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunResponse.%s: %s%s",
+		"invalid %sGetJobStatusesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunResponseValidationError{}
+var _ error = GetJobStatusesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10894,48 +14487,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunResponseValidationError{}
+} = GetJobStatusesResponseValidationError{}
 
-// Validate checks the field values on CancelJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunRequest) Validate() error {
+// Validate checks the field values on ActivityFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityFailure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunRequest with the rules
+// ValidateAll checks the field values on ActivityFailure with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunRequestMultiError, or nil if none found.
-func (m *CancelJobRunRequest) ValidateAll() error {
+// ActivityFailureMultiError, or nil if none found.
+func (m *ActivityFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunRequest) validate(all bool) error {
+func (m *ActivityFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return CancelJobRunRequestMultiError(errors)
+		return ActivityFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CancelJobRunRequestMultiError []error
+// ActivityFailureMultiError is an error wrapping multiple validation errors
+// returned by ActivityFailure.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunRequestMultiError) Error() string {
+func (m ActivityFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10944,11 +14535,11 @@ func (m CancelJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
+func (m ActivityFailureMultiError) AllErrors() []error { return m }
 
-// CancelJobRunRequestValidationError is the validation error returned by
-// CancelJobRunRequest.Validate if the designated constraints aren't met.
-type CancelJobRunRequestValidationError struct {
+// ActivityFailureValidationError is the validation error returned by
+// ActivityFailure.Validate if the designated constraints aren't met.
+type ActivityFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10956,24 +14547,22 @@ type CancelJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunRequestValidationError) Field() string { return e.field }
+func (e ActivityFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
+func (e ActivityFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
+func (e ActivityFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
+func (e ActivityFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunRequestValidationError) ErrorName() string {
-	return "CancelJobRunRequestValidationError"
-}
+func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunRequestValidationError) Error() string {
+func (e ActivityFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10985,14 +14574,14 @@ func (e CancelJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunRequest.%s: %s%s",
+		"invalid %sActivityFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunRequestValidationError{}
+var _ error = ActivityFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -11000,44 +14589,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunRequestValidationError{}
+} = ActivityFailureValidationError{}
 
-// Validate checks the field values on CancelJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunResponse) Validate() error {
+// Validate checks the field values on PendingActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingActivity) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunResponse with the rules
+// ValidateAll checks the field values on PendingActivity with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunResponseMultiError, or nil if none found.
-func (m *CancelJobRunResponse) ValidateAll() error {
+// PendingActivityMultiError, or nil if none found.
+func (m *PendingActivity) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunResponse) validate(all bool) error {
+func (m *PendingActivity) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Status
+
+	// no validation rules for ActivityName
+
+	if m.LastFailure != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastFailure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PendingActivityValidationError{
+					field:  "LastFailure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CancelJobRunResponseMultiError(errors)
+		return PendingActivityMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CancelJobRunResponseMultiError []error
+// PendingActivityMultiError is an error wrapping multiple validation errors
+// returned by PendingActivity.ValidateAll() if the designated constraints
+// aren't met.
+type PendingActivityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunResponseMultiError) Error() string {
+func (m PendingActivityMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11046,11 +14672,11 @@ func (m CancelJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
+func (m PendingActivityMultiError) AllErrors() []error { return m }
 
-// CancelJobRunResponseValidationError is the validation error returned by
-// CancelJobRunResponse.Validate if the designated constraints aren't met.
-type CancelJobRunResponseValidationError struct {
+// PendingActivityValidationError is the validation error returned by
+// PendingActivity.Validate if the designated constraints aren't met.
+type PendingActivityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11058,24 +14684,22 @@ type CancelJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunResponseValidationError) Field() string { return e.field }
+func (e PendingActivityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
+func (e PendingActivityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
+func (e PendingActivityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
+func (e PendingActivityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunResponseValidationError) ErrorName() string {
-	return "CancelJobRunResponseValidationError"
-}
+func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunResponseValidationError) Error() string {
+func (e PendingActivityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11087,14 +14711,14 @@ func (e CancelJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunResponse.%s: %s%s",
+		"invalid %sPendingActivity.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunResponseValidationError{}
+var _ error = PendingActivityValidationError{}
 
 var _ interface {
 	Field() string
@@ -11102,141 +14726,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunResponseValidationError{}
-
-// Validate checks the field values on Job with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Job) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on Job with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobMultiError, or nil if none found.
-func (m *Job) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *Job) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Id
-
-	// no validation rules for CreatedByUserId
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for UpdatedByUserId
+} = PendingActivityValidationError{}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Validate checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
+func (m *JobRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRun) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
 	// no validation rules for Name
 
+	// no validation rules for Status
+
 	if all {
-		switch v := interface{}(m.GetSource()).(type) {
+		switch v := interface{}(m.GetStartedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "Source",
+			return JobRunValidationError{
+				field:  "StartedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetDestinations() {
+	for idx, item := range m.GetPendingActivities() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -11244,8 +14810,8 @@ func (m *Job) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
+				return JobRunValidationError{
+					field:  fmt.Sprintf("PendingActivities[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -11254,23 +14820,23 @@ func (m *Job) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetMappings() {
+	for idx, item := range m.GetTables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -11278,8 +14844,8 @@ func (m *Job) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
+				return JobRunValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -11288,92 +14854,31 @@ func (m *Job) validate(all bool) error {
 
 	}
 
-	// no validation rules for AccountId
-
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetVirtualForeignKeys() {
-		_, _ = idx, item
+	if m.CompletedAt != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+				return JobRunValidationError{
+					field:  "CompletedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -11382,153 +14887,60 @@ func (m *Job) validate(all bool) error {
 
 	}
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
-	}
-
-	if len(errors) > 0 {
-		return JobMultiError(errors)
-	}
-
-	return nil
-}
-
-// JobMultiError is an error wrapping multiple validation errors returned by
-// Job.ValidateAll() if the designated constraints aren't met.
-type JobMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m JobMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m JobMultiError) AllErrors() []error { return m }
-
-// JobValidationError is the validation error returned by Job.Validate if the
-// designated constraints aren't met.
-type JobValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e JobValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e JobValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e JobValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e JobValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e JobValidationError) ErrorName() string { return "JobValidationError" }
-
-// Error satisfies the builtin error interface
-func (e JobValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
+	if m.ErrorMessage != nil {
+		// no validation rules for ErrorMessage
 	}
 
-	return fmt.Sprintf(
-		"invalid %sJob.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = JobValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = JobValidationError{}
-
-// Validate checks the field values on JobRecentRun with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRecentRun) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on JobRecentRun with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRecentRunMultiError, or
-// nil if none found.
-func (m *JobRecentRun) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *JobRecentRun) validate(all bool) error {
-	if m == nil {
-		return nil
+	if m.TriggeredBy != nil {
+		// no validation rules for TriggeredBy
 	}
 
-	var errors []error
+	if m.Overrides != nil {
 
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetOverrides()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "Overrides",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "Overrides",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetOverrides()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
+				return JobRunValidationError{
+					field:  "Overrides",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRecentRunValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for JobRunId
+	}
 
 	if len(errors) > 0 {
-		return JobRecentRunMultiError(errors)
+		return JobRunMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRecentRunMultiError is an error wrapping multiple validation errors
-// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
-type JobRecentRunMultiError []error
+// JobRunMultiError is an error wrapping multiple validation errors returned by
+// JobRun.ValidateAll() if the designated constraints aren't met.
+type JobRunMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRecentRunMultiError) Error() string {
+func (m JobRunMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11537,11 +14949,11 @@ func (m JobRecentRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRecentRunMultiError) AllErrors() []error { return m }
+func (m JobRunMultiError) AllErrors() []error { return m }
 
-// JobRecentRunValidationError is the validation error returned by
-// JobRecentRun.Validate if the designated constraints aren't met.
-type JobRecentRunValidationError struct {
+// JobRunValidationError is the validation error returned by JobRun.Validate if
+// the designated constraints aren't met.
+type JobRunValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11549,22 +14961,22 @@ type JobRecentRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRecentRunValidationError) Field() string { return e.field }
+func (e JobRunValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRecentRunValidationError) Reason() string { return e.reason }
+func (e JobRunValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRecentRunValidationError) Cause() error { return e.cause }
+func (e JobRunValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRecentRunValidationError) Key() bool { return e.key }
+func (e JobRunValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
+func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobRecentRunValidationError) Error() string {
+func (e JobRunValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11576,14 +14988,14 @@ func (e JobRecentRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRecentRun.%s: %s%s",
+		"invalid %sJobRun.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRecentRunValidationError{}
+var _ error = JobRunValidationError{}
 
 var _ interface {
 	Field() string
@@ -11591,46 +15003,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRecentRunValidationError{}
+} = JobRunValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsRequest with the rules
+// Validate checks the field values on JobRunTableSummary with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsRequest) Validate() error {
+func (m *JobRunTableSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobRunTableSummary with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsRequestMultiError, or nil if none found.
-func (m *GetJobRecentRunsRequest) ValidateAll() error {
+// JobRunTableSummaryMultiError, or nil if none found.
+func (m *JobRunTableSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsRequest) validate(all bool) error {
+func (m *JobRunTableSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for RowsRead
+
+	// no validation rules for RowsWritten
+
+	// no validation rules for RowsErrored
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsRequestMultiError(errors)
+		return JobRunTableSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobRecentRunsRequestMultiError []error
+// JobRunTableSummaryMultiError is an error wrapping multiple validation errors
+// returned by JobRunTableSummary.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunTableSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsRequestMultiError) Error() string {
+func (m JobRunTableSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11639,11 +15059,11 @@ func (m GetJobRecentRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
+func (m JobRunTableSummaryMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsRequestValidationError is the validation error returned by
-// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRecentRunsRequestValidationError struct {
+// JobRunTableSummaryValidationError is the validation error returned by
+// JobRunTableSummary.Validate if the designated constraints aren't met.
+type JobRunTableSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11651,24 +15071,24 @@ type GetJobRecentRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
+func (e JobRunTableSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
+func (e JobRunTableSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
+func (e JobRunTableSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
+func (e JobRunTableSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
-	return "GetJobRecentRunsRequestValidationError"
+func (e JobRunTableSummaryValidationError) ErrorName() string {
+	return "JobRunTableSummaryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsRequestValidationError) Error() string {
+func (e JobRunTableSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11680,14 +15100,14 @@ func (e GetJobRecentRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
+		"invalid %sJobRunTableSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsRequestValidationError{}
+var _ error = JobRunTableSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -11695,78 +15115,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsRequestValidationError{}
+} = JobRunTableSummaryValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// Validate checks the field values on JobRunEventTaskError with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsResponse) Validate() error {
+func (m *JobRunEventTaskError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobRunEventTaskError with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsResponseMultiError, or nil if none found.
-func (m *GetJobRecentRunsResponse) ValidateAll() error {
+// JobRunEventTaskErrorMultiError, or nil if none found.
+func (m *JobRunEventTaskError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsResponse) validate(all bool) error {
+func (m *JobRunEventTaskError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecentRuns() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRecentRunsResponseValidationError{
-					field:  fmt.Sprintf("RecentRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Message
 
-	}
+	// no validation rules for RetryState
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsResponseMultiError(errors)
+		return JobRunEventTaskErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
+// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventTaskError.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRecentRunsResponseMultiError []error
+type JobRunEventTaskErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsResponseMultiError) Error() string {
+func (m JobRunEventTaskErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11775,11 +15165,11 @@ func (m GetJobRecentRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
+func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsResponseValidationError is the validation error returned by
-// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRecentRunsResponseValidationError struct {
+// JobRunEventTaskErrorValidationError is the validation error returned by
+// JobRunEventTaskError.Validate if the designated constraints aren't met.
+type JobRunEventTaskErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11787,24 +15177,24 @@ type GetJobRecentRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
+func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
+func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
+func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
+func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
-	return "GetJobRecentRunsResponseValidationError"
+func (e JobRunEventTaskErrorValidationError) ErrorName() string {
+	return "JobRunEventTaskErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsResponseValidationError) Error() string {
+func (e JobRunEventTaskErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11816,14 +15206,14 @@ func (e GetJobRecentRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
+		"invalid %sJobRunEventTaskError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsResponseValidationError{}
+var _ error = JobRunEventTaskErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -11831,77 +15221,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsResponseValidationError{}
+} = JobRunEventTaskErrorValidationError{}
 
-// Validate checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobNextRuns) Validate() error {
+// Validate checks the field values on JobRunEventTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobRunEventTask) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobNextRunsMultiError, or
-// nil if none found.
-func (m *JobNextRuns) ValidateAll() error {
+// ValidateAll checks the field values on JobRunEventTask with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunEventTaskMultiError, or nil if none found.
+func (m *JobRunEventTask) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobNextRuns) validate(all bool) error {
+func (m *JobRunEventTask) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetNextRunTimes() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return JobNextRunsValidationError{
-					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return JobNextRunsMultiError(errors)
+		return JobRunEventTaskMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobNextRunsMultiError is an error wrapping multiple validation errors
-// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
-type JobNextRunsMultiError []error
+// JobRunEventTaskMultiError is an error wrapping multiple validation errors
+// returned by JobRunEventTask.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunEventTaskMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobNextRunsMultiError) Error() string {
+func (m JobRunEventTaskMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11910,11 +15329,11 @@ func (m JobNextRunsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobNextRunsMultiError) AllErrors() []error { return m }
+func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
 
-// JobNextRunsValidationError is the validation error returned by
-// JobNextRuns.Validate if the designated constraints aren't met.
-type JobNextRunsValidationError struct {
+// JobRunEventTaskValidationError is the validation error returned by
+// JobRunEventTask.Validate if the designated constraints aren't met.
+type JobRunEventTaskValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11922,22 +15341,22 @@ type JobNextRunsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobNextRunsValidationError) Field() string { return e.field }
+func (e JobRunEventTaskValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobNextRunsValidationError) Reason() string { return e.reason }
+func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobNextRunsValidationError) Cause() error { return e.cause }
+func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobNextRunsValidationError) Key() bool { return e.key }
+func (e JobRunEventTaskValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
+func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobNextRunsValidationError) Error() string {
+func (e JobRunEventTaskValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11949,14 +15368,14 @@ func (e JobNextRunsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobNextRuns.%s: %s%s",
+		"invalid %sJobRunEventTask.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobNextRunsValidationError{}
+var _ error = JobRunEventTaskValidationError{}
 
 var _ interface {
 	Field() string
@@ -11964,46 +15383,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobNextRunsValidationError{}
+} = JobRunEventTaskValidationError{}
 
-// Validate checks the field values on GetJobNextRunsRequest with the rules
+// Validate checks the field values on JobRunSyncMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsRequest) Validate() error {
+func (m *JobRunSyncMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
+// ValidateAll checks the field values on JobRunSyncMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsRequestMultiError, or nil if none found.
-func (m *GetJobNextRunsRequest) ValidateAll() error {
+// JobRunSyncMetadataMultiError, or nil if none found.
+func (m *JobRunSyncMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsRequest) validate(all bool) error {
+func (m *JobRunSyncMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Schema
+
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return GetJobNextRunsRequestMultiError(errors)
+		return JobRunSyncMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobNextRunsRequestMultiError []error
+// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
+// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunSyncMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsRequestMultiError) Error() string {
+func (m JobRunSyncMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12012,11 +15433,11 @@ func (m GetJobNextRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
+func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsRequestValidationError is the validation error returned by
-// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
-type GetJobNextRunsRequestValidationError struct {
+// JobRunSyncMetadataValidationError is the validation error returned by
+// JobRunSyncMetadata.Validate if the designated constraints aren't met.
+type JobRunSyncMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12024,24 +15445,24 @@ type GetJobNextRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
+func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
+func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
+func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
+func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsRequestValidationError) ErrorName() string {
-	return "GetJobNextRunsRequestValidationError"
+func (e JobRunSyncMetadataValidationError) ErrorName() string {
+	return "JobRunSyncMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsRequestValidationError) Error() string {
+func (e JobRunSyncMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12053,14 +15474,14 @@ func (e GetJobNextRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsRequest.%s: %s%s",
+		"invalid %sJobRunSyncMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsRequestValidationError{}
+var _ error = JobRunSyncMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -12068,73 +15489,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsRequestValidationError{}
+} = JobRunSyncMetadataValidationError{}
 
-// Validate checks the field values on GetJobNextRunsResponse with the rules
+// Validate checks the field values on JobRunEventMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsResponse) Validate() error {
+func (m *JobRunEventMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
+// ValidateAll checks the field values on JobRunEventMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsResponseMultiError, or nil if none found.
-func (m *GetJobNextRunsResponse) ValidateAll() error {
+// JobRunEventMetadataMultiError, or nil if none found.
+func (m *JobRunEventMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsResponse) validate(all bool) error {
+func (m *JobRunEventMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNextRuns()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	switch v := m.Metadata.(type) {
+	case *JobRunEventMetadata_SyncMetadata:
+		if v == nil {
+			err := JobRunEventMetadataValidationError{
+				field:  "Metadata",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSyncMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
+				return JobRunEventMetadataValidationError{
+					field:  "SyncMetadata",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetJobNextRunsResponseValidationError{
-				field:  "NextRuns",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetJobNextRunsResponseMultiError(errors)
+		return JobRunEventMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
+// JobRunEventMetadataMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventMetadata.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobNextRunsResponseMultiError []error
+type JobRunEventMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsResponseMultiError) Error() string {
+func (m JobRunEventMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12143,11 +15581,11 @@ func (m GetJobNextRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
+func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsResponseValidationError is the validation error returned by
-// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
-type GetJobNextRunsResponseValidationError struct {
+// JobRunEventMetadataValidationError is the validation error returned by
+// JobRunEventMetadata.Validate if the designated constraints aren't met.
+type JobRunEventMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12155,24 +15593,24 @@ type GetJobNextRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
+func (e JobRunEventMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
+func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
+func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
+func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsResponseValidationError) ErrorName() string {
-	return "GetJobNextRunsResponseValidationError"
+func (e JobRunEventMetadataValidationError) ErrorName() string {
+	return "JobRunEventMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsResponseValidationError) Error() string {
+func (e JobRunEventMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12184,14 +15622,14 @@ func (e GetJobNextRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsResponse.%s: %s%s",
+		"invalid %sJobRunEventMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsResponseValidationError{}
+var _ error = JobRunEventMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -12199,46 +15637,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsResponseValidationError{}
+} = JobRunEventMetadataValidationError{}
 
-// Validate checks the field values on GetJobStatusRequest with the rules
+// Validate checks the field values on JobRunEventProgress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusRequest) Validate() error {
+func (m *JobRunEventProgress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusRequest with the rules
+// ValidateAll checks the field values on JobRunEventProgress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusRequestMultiError, or nil if none found.
-func (m *GetJobStatusRequest) ValidateAll() error {
+// JobRunEventProgressMultiError, or nil if none found.
+func (m *JobRunEventProgress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusRequest) validate(all bool) error {
+func (m *JobRunEventProgress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for RowsRead
+
+	// no validation rules for RowsWritten
+
+	// no validation rules for RowsErrored
+
+	// no validation rules for BytesProcessed
+
+	// no validation rules for RowsPerSecond
+
+	if all {
+		switch v := interface{}(m.GetEstimatedCompletionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventProgressValidationError{
+					field:  "EstimatedCompletionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventProgressValidationError{
+					field:  "EstimatedCompletionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEstimatedCompletionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventProgressValidationError{
+				field:  "EstimatedCompletionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventProgressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventProgressValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventProgressValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for JobId
+	if m.TotalRows != nil {
+		// no validation rules for TotalRows
+	}
 
 	if len(errors) > 0 {
-		return GetJobStatusRequestMultiError(errors)
+		return JobRunEventProgressMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusRequest.ValidateAll() if the designated
+// JobRunEventProgressMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventProgress.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusRequestMultiError []error
+type JobRunEventProgressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusRequestMultiError) Error() string {
+func (m JobRunEventProgressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12247,11 +15755,11 @@ func (m GetJobStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
+func (m JobRunEventProgressMultiError) AllErrors() []error { return m }
 
-// GetJobStatusRequestValidationError is the validation error returned by
-// GetJobStatusRequest.Validate if the designated constraints aren't met.
-type GetJobStatusRequestValidationError struct {
+// JobRunEventProgressValidationError is the validation error returned by
+// JobRunEventProgress.Validate if the designated constraints aren't met.
+type JobRunEventProgressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12259,24 +15767,24 @@ type GetJobStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusRequestValidationError) Field() string { return e.field }
+func (e JobRunEventProgressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
+func (e JobRunEventProgressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
+func (e JobRunEventProgressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
+func (e JobRunEventProgressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusRequestValidationError) ErrorName() string {
-	return "GetJobStatusRequestValidationError"
+func (e JobRunEventProgressValidationError) ErrorName() string {
+	return "JobRunEventProgressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusRequestValidationError) Error() string {
+func (e JobRunEventProgressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12288,14 +15796,14 @@ func (e GetJobStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusRequest.%s: %s%s",
+		"invalid %sJobRunEventProgress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusRequestValidationError{}
+var _ error = JobRunEventProgressValidationError{}
 
 var _ interface {
 	Field() string
@@ -12303,46 +15811,197 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusRequestValidationError{}
+} = JobRunEventProgressValidationError{}
 
-// Validate checks the field values on GetJobStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusResponse) Validate() error {
+// Validate checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRunEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobStatusResponseMultiError, or nil if none found.
-func (m *GetJobStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunEventMultiError, or
+// nil if none found.
+func (m *JobRunEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusResponse) validate(all bool) error {
+func (m *JobRunEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "CloseTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunEventValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetJobStatusResponseMultiError(errors)
+		return JobRunEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobStatusResponseMultiError []error
+// JobRunEventMultiError is an error wrapping multiple validation errors
+// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
+type JobRunEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusResponseMultiError) Error() string {
+func (m JobRunEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12351,11 +16010,11 @@ func (m GetJobStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
+func (m JobRunEventMultiError) AllErrors() []error { return m }
 
-// GetJobStatusResponseValidationError is the validation error returned by
-// GetJobStatusResponse.Validate if the designated constraints aren't met.
-type GetJobStatusResponseValidationError struct {
+// JobRunEventValidationError is the validation error returned by
+// JobRunEvent.Validate if the designated constraints aren't met.
+type JobRunEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12363,24 +16022,22 @@ type GetJobStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusResponseValidationError) Field() string { return e.field }
+func (e JobRunEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
+func (e JobRunEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
+func (e JobRunEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
+func (e JobRunEventValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e GetJobStatusResponseValidationError) ErrorName() string {
-	return "GetJobStatusResponseValidationError"
-}
+// ErrorName returns error name.
+func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusResponseValidationError) Error() string {
+func (e JobRunEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12392,14 +16049,14 @@ func (e GetJobStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusResponse.%s: %s%s",
+		"invalid %sJobRunEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusResponseValidationError{}
+var _ error = JobRunEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -12407,48 +16064,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusResponseValidationError{}
+} = JobRunEventValidationError{}
 
-// Validate checks the field values on JobStatusRecord with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobStatusRecord) Validate() error {
+// Validate checks the field values on GetJobRunEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobStatusRecord with the rules
+// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobStatusRecordMultiError, or nil if none found.
-func (m *JobStatusRecord) ValidateAll() error {
+// GetJobRunEventsRequestMultiError, or nil if none found.
+func (m *GetJobRunEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobStatusRecord) validate(all bool) error {
+func (m *GetJobRunEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for JobRunId
 
-	// no validation rules for Status
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return JobStatusRecordMultiError(errors)
+		return GetJobRunEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobStatusRecordMultiError is an error wrapping multiple validation errors
-// returned by JobStatusRecord.ValidateAll() if the designated constraints
-// aren't met.
-type JobStatusRecordMultiError []error
+// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobStatusRecordMultiError) Error() string {
+func (m GetJobRunEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12457,11 +16114,11 @@ func (m JobStatusRecordMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobStatusRecordMultiError) AllErrors() []error { return m }
+func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
 
-// JobStatusRecordValidationError is the validation error returned by
-// JobStatusRecord.Validate if the designated constraints aren't met.
-type JobStatusRecordValidationError struct {
+// GetJobRunEventsRequestValidationError is the validation error returned by
+// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
+type GetJobRunEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12469,22 +16126,24 @@ type JobStatusRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobStatusRecordValidationError) Field() string { return e.field }
+func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobStatusRecordValidationError) Reason() string { return e.reason }
+func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobStatusRecordValidationError) Cause() error { return e.cause }
+func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobStatusRecordValidationError) Key() bool { return e.key }
+func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
+func (e GetJobRunEventsRequestValidationError) ErrorName() string {
+	return "GetJobRunEventsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobStatusRecordValidationError) Error() string {
+func (e GetJobRunEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12496,14 +16155,14 @@ func (e JobStatusRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobStatusRecord.%s: %s%s",
+		"invalid %sGetJobRunEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobStatusRecordValidationError{}
+var _ error = GetJobRunEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12511,46 +16170,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobStatusRecordValidationError{}
+} = GetJobRunEventsRequestValidationError{}
 
-// Validate checks the field values on GetJobStatusesRequest with the rules
+// Validate checks the field values on GetJobRunEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesRequest) Validate() error {
+func (m *GetJobRunEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRunEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusesRequestMultiError, or nil if none found.
-func (m *GetJobStatusesRequest) ValidateAll() error {
+// GetJobRunEventsResponseMultiError, or nil if none found.
+func (m *GetJobRunEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesRequest) validate(all bool) error {
+func (m *GetJobRunEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsRunComplete
 
 	if len(errors) > 0 {
-		return GetJobStatusesRequestMultiError(errors)
+		return GetJobRunEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
+// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusesRequestMultiError []error
+type GetJobRunEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesRequestMultiError) Error() string {
+func (m GetJobRunEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12559,11 +16252,11 @@ func (m GetJobStatusesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesRequestValidationError is the validation error returned by
-// GetJobStatusesRequest.Validate if the designated constraints aren't met.
-type GetJobStatusesRequestValidationError struct {
+// GetJobRunEventsResponseValidationError is the validation error returned by
+// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
+type GetJobRunEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12571,24 +16264,24 @@ type GetJobStatusesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
+func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
+func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesRequestValidationError) ErrorName() string {
-	return "GetJobStatusesRequestValidationError"
+func (e GetJobRunEventsResponseValidationError) ErrorName() string {
+	return "GetJobRunEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesRequestValidationError) Error() string {
+func (e GetJobRunEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12600,14 +16293,14 @@ func (e GetJobStatusesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesRequest.%s: %s%s",
+		"invalid %sGetJobRunEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesRequestValidationError{}
+var _ error = GetJobRunEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12615,78 +16308,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesRequestValidationError{}
+} = GetJobRunEventsResponseValidationError{}
 
-// Validate checks the field values on GetJobStatusesResponse with the rules
+// Validate checks the field values on WatchJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesResponse) Validate() error {
+func (m *WatchJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesResponse with the rules
+// ValidateAll checks the field values on WatchJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusesResponseMultiError, or nil if none found.
-func (m *GetJobStatusesResponse) ValidateAll() error {
+// WatchJobRunRequestMultiError, or nil if none found.
+func (m *WatchJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesResponse) validate(all bool) error {
+func (m *WatchJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStatuses() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobStatusesResponseValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for JobRunId
 
-	}
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetJobStatusesResponseMultiError(errors)
+		return WatchJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobStatusesResponseMultiError []error
+// WatchJobRunRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchJobRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesResponseMultiError) Error() string {
+func (m WatchJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12695,11 +16358,11 @@ func (m GetJobStatusesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
+func (m WatchJobRunRequestMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesResponseValidationError is the validation error returned by
-// GetJobStatusesResponse.Validate if the designated constraints aren't met.
-type GetJobStatusesResponseValidationError struct {
+// WatchJobRunRequestValidationError is the validation error returned by
+// WatchJobRunRequest.Validate if the designated constraints aren't met.
+type WatchJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12707,24 +16370,24 @@ type GetJobStatusesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
+func (e WatchJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
+func (e WatchJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
+func (e WatchJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
+func (e WatchJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesResponseValidationError) ErrorName() string {
-	return "GetJobStatusesResponseValidationError"
+func (e WatchJobRunRequestValidationError) ErrorName() string {
+	return "WatchJobRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesResponseValidationError) Error() string {
+func (e WatchJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12736,14 +16399,14 @@ func (e GetJobStatusesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesResponse.%s: %s%s",
+		"invalid %sWatchJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesResponseValidationError{}
+var _ error = WatchJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12751,46 +16414,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesResponseValidationError{}
+} = WatchJobRunRequestValidationError{}
 
-// Validate checks the field values on ActivityFailure with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityFailure) Validate() error {
+// Validate checks the field values on WatchJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityFailure with the rules
+// ValidateAll checks the field values on WatchJobRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActivityFailureMultiError, or nil if none found.
-func (m *ActivityFailure) ValidateAll() error {
+// WatchJobRunResponseMultiError, or nil if none found.
+func (m *WatchJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityFailure) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *WatchJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchJobRunResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchJobRunResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchJobRunResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for Message
+	// no validation rules for IsRunComplete
 
 	if len(errors) > 0 {
-		return ActivityFailureMultiError(errors)
+		return WatchJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityFailureMultiError is an error wrapping multiple validation errors
-// returned by ActivityFailure.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityFailureMultiError []error
+// WatchJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityFailureMultiError) Error() string {
+func (m WatchJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12799,11 +16496,11 @@ func (m ActivityFailureMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityFailureMultiError) AllErrors() []error { return m }
+func (m WatchJobRunResponseMultiError) AllErrors() []error { return m }
 
-// ActivityFailureValidationError is the validation error returned by
-// ActivityFailure.Validate if the designated constraints aren't met.
-type ActivityFailureValidationError struct {
+// WatchJobRunResponseValidationError is the validation error returned by
+// WatchJobRunResponse.Validate if the designated constraints aren't met.
+type WatchJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12811,22 +16508,24 @@ type ActivityFailureValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityFailureValidationError) Field() string { return e.field }
+func (e WatchJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityFailureValidationError) Reason() string { return e.reason }
+func (e WatchJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityFailureValidationError) Cause() error { return e.cause }
+func (e WatchJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityFailureValidationError) Key() bool { return e.key }
+func (e WatchJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
+func (e WatchJobRunResponseValidationError) ErrorName() string {
+	return "WatchJobRunResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityFailureValidationError) Error() string {
+func (e WatchJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12838,14 +16537,14 @@ func (e ActivityFailureValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityFailure.%s: %s%s",
+		"invalid %sWatchJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityFailureValidationError{}
+var _ error = WatchJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12853,81 +16552,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityFailureValidationError{}
+} = WatchJobRunResponseValidationError{}
 
-// Validate checks the field values on PendingActivity with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PendingActivity) Validate() error {
+// Validate checks the field values on DeleteJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PendingActivity with the rules
+// ValidateAll checks the field values on DeleteJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PendingActivityMultiError, or nil if none found.
-func (m *PendingActivity) ValidateAll() error {
+// DeleteJobRunRequestMultiError, or nil if none found.
+func (m *DeleteJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PendingActivity) validate(all bool) error {
+func (m *DeleteJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
-
-	// no validation rules for ActivityName
-
-	if m.LastFailure != nil {
-
-		if all {
-			switch v := interface{}(m.GetLastFailure()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PendingActivityValidationError{
-					field:  "LastFailure",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for JobRunId
 
-	}
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return PendingActivityMultiError(errors)
+		return DeleteJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PendingActivityMultiError is an error wrapping multiple validation errors
-// returned by PendingActivity.ValidateAll() if the designated constraints
-// aren't met.
-type PendingActivityMultiError []error
+// DeleteJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PendingActivityMultiError) Error() string {
+func (m DeleteJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12936,11 +16602,11 @@ func (m PendingActivityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PendingActivityMultiError) AllErrors() []error { return m }
+func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
 
-// PendingActivityValidationError is the validation error returned by
-// PendingActivity.Validate if the designated constraints aren't met.
-type PendingActivityValidationError struct {
+// DeleteJobRunRequestValidationError is the validation error returned by
+// DeleteJobRunRequest.Validate if the designated constraints aren't met.
+type DeleteJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12948,22 +16614,24 @@ type PendingActivityValidationError struct {
 }
 
 // Field function returns field value.
-func (e PendingActivityValidationError) Field() string { return e.field }
+func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PendingActivityValidationError) Reason() string { return e.reason }
+func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PendingActivityValidationError) Cause() error { return e.cause }
+func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PendingActivityValidationError) Key() bool { return e.key }
+func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
+func (e DeleteJobRunRequestValidationError) ErrorName() string {
+	return "DeleteJobRunRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PendingActivityValidationError) Error() string {
+func (e DeleteJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12975,14 +16643,14 @@ func (e PendingActivityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPendingActivity.%s: %s%s",
+		"invalid %sDeleteJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PendingActivityValidationError{}
+var _ error = DeleteJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12990,146 +16658,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PendingActivityValidationError{}
+} = DeleteJobRunRequestValidationError{}
 
-// Validate checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRun) Validate() error {
+// Validate checks the field values on DeleteJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
-func (m *JobRun) ValidateAll() error {
+// ValidateAll checks the field values on DeleteJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRunResponseMultiError, or nil if none found.
+func (m *DeleteJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRun) validate(all bool) error {
+func (m *DeleteJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for JobId
-
-	// no validation rules for Name
-
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetStartedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunValidationError{
-				field:  "StartedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetPendingActivities() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  fmt.Sprintf("PendingActivities[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.CompletedAt != nil {
-
-		if all {
-			switch v := interface{}(m.GetCompletedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  "CompletedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return JobRunMultiError(errors)
+		return DeleteJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunMultiError is an error wrapping multiple validation errors returned by
-// JobRun.ValidateAll() if the designated constraints aren't met.
-type JobRunMultiError []error
+// DeleteJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunMultiError) Error() string {
+func (m DeleteJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13138,11 +16704,11 @@ func (m JobRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunMultiError) AllErrors() []error { return m }
+func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
 
-// JobRunValidationError is the validation error returned by JobRun.Validate if
-// the designated constraints aren't met.
-type JobRunValidationError struct {
+// DeleteJobRunResponseValidationError is the validation error returned by
+// DeleteJobRunResponse.Validate if the designated constraints aren't met.
+type DeleteJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13150,22 +16716,24 @@ type JobRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunValidationError) Field() string { return e.field }
+func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunValidationError) Reason() string { return e.reason }
+func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunValidationError) Cause() error { return e.cause }
+func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunValidationError) Key() bool { return e.key }
+func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+func (e DeleteJobRunResponseValidationError) ErrorName() string {
+	return "DeleteJobRunResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunValidationError) Error() string {
+func (e DeleteJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13177,14 +16745,14 @@ func (e JobRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRun.%s: %s%s",
+		"invalid %sDeleteJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunValidationError{}
+var _ error = DeleteJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13192,48 +16760,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunValidationError{}
+} = DeleteJobRunResponseValidationError{}
 
-// Validate checks the field values on JobRunEventTaskError with the rules
+// Validate checks the field values on TerminateJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTaskError) Validate() error {
+func (m *TerminateJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTaskError with the rules
+// ValidateAll checks the field values on TerminateJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskErrorMultiError, or nil if none found.
-func (m *JobRunEventTaskError) ValidateAll() error {
+// TerminateJobRunRequestMultiError, or nil if none found.
+func (m *TerminateJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTaskError) validate(all bool) error {
+func (m *TerminateJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for JobRunId
 
-	// no validation rules for RetryState
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return JobRunEventTaskErrorMultiError(errors)
+		return TerminateJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventTaskError.ValidateAll() if the designated
+// TerminateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
 // constraints aren't met.
-type JobRunEventTaskErrorMultiError []error
+type TerminateJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskErrorMultiError) Error() string {
+func (m TerminateJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13242,11 +16810,11 @@ func (m JobRunEventTaskErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
+func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
 
-// JobRunEventTaskErrorValidationError is the validation error returned by
-// JobRunEventTaskError.Validate if the designated constraints aren't met.
-type JobRunEventTaskErrorValidationError struct {
+// TerminateJobRunRequestValidationError is the validation error returned by
+// TerminateJobRunRequest.Validate if the designated constraints aren't met.
+type TerminateJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13254,24 +16822,24 @@ type JobRunEventTaskErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
+func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
+func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
+func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
+func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskErrorValidationError) ErrorName() string {
-	return "JobRunEventTaskErrorValidationError"
+func (e TerminateJobRunRequestValidationError) ErrorName() string {
+	return "TerminateJobRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskErrorValidationError) Error() string {
+func (e TerminateJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13283,14 +16851,14 @@ func (e JobRunEventTaskErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTaskError.%s: %s%s",
+		"invalid %sTerminateJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskErrorValidationError{}
+var _ error = TerminateJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13298,106 +16866,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskErrorValidationError{}
+} = TerminateJobRunRequestValidationError{}
 
-// Validate checks the field values on JobRunEventTask with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTask) Validate() error {
+// Validate checks the field values on TerminateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTask with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TerminateJobRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskMultiError, or nil if none found.
-func (m *JobRunEventTask) ValidateAll() error {
+// TerminateJobRunResponseMultiError, or nil if none found.
+func (m *TerminateJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTask) validate(all bool) error {
+func (m *TerminateJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Type
-
-	if all {
-		switch v := interface{}(m.GetEventTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "EventTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetError()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "Error",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return JobRunEventTaskMultiError(errors)
+		return TerminateJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskMultiError is an error wrapping multiple validation errors
-// returned by JobRunEventTask.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunEventTaskMultiError []error
+// TerminateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskMultiError) Error() string {
+func (m TerminateJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13406,11 +16912,11 @@ func (m JobRunEventTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
+func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventTaskValidationError is the validation error returned by
-// JobRunEventTask.Validate if the designated constraints aren't met.
-type JobRunEventTaskValidationError struct {
+// TerminateJobRunResponseValidationError is the validation error returned by
+// TerminateJobRunResponse.Validate if the designated constraints aren't met.
+type TerminateJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13418,22 +16924,24 @@ type JobRunEventTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskValidationError) Field() string { return e.field }
+func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
+func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
+func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskValidationError) Key() bool { return e.key }
+func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
+func (e TerminateJobRunResponseValidationError) ErrorName() string {
+	return "TerminateJobRunResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskValidationError) Error() string {
+func (e TerminateJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13445,14 +16953,14 @@ func (e JobRunEventTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTask.%s: %s%s",
+		"invalid %sTerminateJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskValidationError{}
+var _ error = TerminateJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13460,48 +16968,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskValidationError{}
+} = TerminateJobRunResponseValidationError{}
 
-// Validate checks the field values on JobRunSyncMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunSyncMetadata) Validate() error {
+func (m *GetJobRunLogsStreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunSyncMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunSyncMetadataMultiError, or nil if none found.
-func (m *JobRunSyncMetadata) ValidateAll() error {
+// GetJobRunLogsStreamRequestMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunSyncMetadata) validate(all bool) error {
+func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
+	// no validation rules for JobRunId
 
-	// no validation rules for Table
+	// no validation rules for AccountId
+
+	// no validation rules for Window
+
+	// no validation rules for ShouldTail
+
+	if m.MaxLogLines != nil {
+		// no validation rules for MaxLogLines
+	}
 
 	if len(errors) > 0 {
-		return JobRunSyncMetadataMultiError(errors)
+		return GetJobRunLogsStreamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
-// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunSyncMetadataMultiError []error
+// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunSyncMetadataMultiError) Error() string {
+func (m GetJobRunLogsStreamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13510,11 +17026,11 @@ func (m JobRunSyncMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
+func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
 
-// JobRunSyncMetadataValidationError is the validation error returned by
-// JobRunSyncMetadata.Validate if the designated constraints aren't met.
-type JobRunSyncMetadataValidationError struct {
+// GetJobRunLogsStreamRequestValidationError is the validation error returned
+// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
+type GetJobRunLogsStreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13522,24 +17038,24 @@ type JobRunSyncMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
+func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
+func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
+func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
+func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunSyncMetadataValidationError) ErrorName() string {
-	return "JobRunSyncMetadataValidationError"
+func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunSyncMetadataValidationError) Error() string {
+func (e GetJobRunLogsStreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13551,14 +17067,14 @@ func (e JobRunSyncMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunSyncMetadata.%s: %s%s",
+		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunSyncMetadataValidationError{}
+var _ error = GetJobRunLogsStreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13566,90 +17082,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunSyncMetadataValidationError{}
+} = GetJobRunLogsStreamRequestValidationError{}
 
-// Validate checks the field values on JobRunEventMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventMetadata) Validate() error {
+func (m *GetJobRunLogsStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventMetadataMultiError, or nil if none found.
-func (m *JobRunEventMetadata) ValidateAll() error {
+// GetJobRunLogsStreamResponseMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventMetadata) validate(all bool) error {
+func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Metadata.(type) {
-	case *JobRunEventMetadata_SyncMetadata:
-		if v == nil {
-			err := JobRunEventMetadataValidationError{
-				field:  "Metadata",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for LogLine
+
+	if m.Timestamp != nil {
 
 		if all {
-			switch v := interface{}(m.GetSyncMetadata()).(type) {
+			switch v := interface{}(m.GetTimestamp()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
+					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
+						field:  "Timestamp",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
+					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
+						field:  "Timestamp",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobRunEventMetadataValidationError{
-					field:  "SyncMetadata",
+				return GetJobRunLogsStreamResponseValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventMetadataMultiError(errors)
+		return GetJobRunLogsStreamResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMetadataMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type JobRunEventMetadataMultiError []error
+// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMetadataMultiError) Error() string {
+func (m GetJobRunLogsStreamResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13658,11 +17163,12 @@ func (m JobRunEventMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
+func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventMetadataValidationError is the validation error returned by
-// JobRunEventMetadata.Validate if the designated constraints aren't met.
-type JobRunEventMetadataValidationError struct {
+// GetJobRunLogsStreamResponseValidationError is the validation error returned
+// by GetJobRunLogsStreamResponse.Validate if the designated constraints
+// aren't met.
+type GetJobRunLogsStreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13670,24 +17176,24 @@ type JobRunEventMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventMetadataValidationError) Field() string { return e.field }
+func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
+func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
+func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
+func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventMetadataValidationError) ErrorName() string {
-	return "JobRunEventMetadataValidationError"
+func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventMetadataValidationError) Error() string {
+func (e GetJobRunLogsStreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13699,14 +17205,14 @@ func (e JobRunEventMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventMetadata.%s: %s%s",
+		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventMetadataValidationError{}
+var _ error = GetJobRunLogsStreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13714,138 +17220,198 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventMetadataValidationError{}
+} = GetJobRunLogsStreamResponseValidationError{}
 
-// Validate checks the field values on JobRunEvent with the rules defined in
+// Validate checks the field values on JobRunLogEntry with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *JobRunEvent) Validate() error {
+func (m *JobRunLogEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRunEventMultiError, or
-// nil if none found.
-func (m *JobRunEvent) ValidateAll() error {
+// ValidateAll checks the field values on JobRunLogEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunLogEntryMultiError,
+// or nil if none found.
+func (m *JobRunLogEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEvent) validate(all bool) error {
+func (m *JobRunLogEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Type
-
 	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
+		switch v := interface{}(m.GetTimestamp()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
+				errors = append(errors, JobRunLogEntryValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
+				errors = append(errors, JobRunLogEntryValidationError{
+					field:  "Timestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "StartTime",
+			return JobRunLogEntryValidationError{
+				field:  "Timestamp",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetCloseTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "CloseTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Level
+
+	// no validation rules for Message
+
+	// no validation rules for Attributes
+
+	if m.Activity != nil {
+		// no validation rules for Activity
 	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.Schema != nil {
+		// no validation rules for Schema
+	}
+
+	if m.Table != nil {
+		// no validation rules for Table
+	}
+
+	if len(errors) > 0 {
+		return JobRunLogEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunLogEntryMultiError is an error wrapping multiple validation errors
+// returned by JobRunLogEntry.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunLogEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunLogEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunLogEntryMultiError) AllErrors() []error { return m }
+
+// JobRunLogEntryValidationError is the validation error returned by
+// JobRunLogEntry.Validate if the designated constraints aren't met.
+type JobRunLogEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunLogEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunLogEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunLogEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunLogEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunLogEntryValidationError) ErrorName() string { return "JobRunLogEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunLogEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunLogEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunLogEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunLogEntryValidationError{}
+
+// Validate checks the field values on IngestJobRunLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IngestJobRunLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestJobRunLogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestJobRunLogsRequestMultiError, or nil if none found.
+func (m *IngestJobRunLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestJobRunLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetTasks() {
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobRunId
+
+	for idx, item := range m.GetEntries() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
+					errors = append(errors, IngestJobRunLogsRequestValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
+					errors = append(errors, IngestJobRunLogsRequestValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13853,8 +17419,8 @@ func (m *JobRunEvent) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobRunEventValidationError{
-					field:  fmt.Sprintf("Tasks[%v]", idx),
+				return IngestJobRunLogsRequestValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13864,18 +17430,19 @@ func (m *JobRunEvent) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventMultiError(errors)
+		return IngestJobRunLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMultiError is an error wrapping multiple validation errors
-// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
-type JobRunEventMultiError []error
+// IngestJobRunLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by IngestJobRunLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IngestJobRunLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMultiError) Error() string {
+func (m IngestJobRunLogsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13884,11 +17451,11 @@ func (m JobRunEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMultiError) AllErrors() []error { return m }
+func (m IngestJobRunLogsRequestMultiError) AllErrors() []error { return m }
 
-// JobRunEventValidationError is the validation error returned by
-// JobRunEvent.Validate if the designated constraints aren't met.
-type JobRunEventValidationError struct {
+// IngestJobRunLogsRequestValidationError is the validation error returned by
+// IngestJobRunLogsRequest.Validate if the designated constraints aren't met.
+type IngestJobRunLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13896,22 +17463,24 @@ type JobRunEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventValidationError) Field() string { return e.field }
+func (e IngestJobRunLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventValidationError) Reason() string { return e.reason }
+func (e IngestJobRunLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventValidationError) Cause() error { return e.cause }
+func (e IngestJobRunLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventValidationError) Key() bool { return e.key }
+func (e IngestJobRunLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
+func (e IngestJobRunLogsRequestValidationError) ErrorName() string {
+	return "IngestJobRunLogsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventValidationError) Error() string {
+func (e IngestJobRunLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13923,14 +17492,14 @@ func (e JobRunEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEvent.%s: %s%s",
+		"invalid %sIngestJobRunLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventValidationError{}
+var _ error = IngestJobRunLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13938,48 +17507,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventValidationError{}
+} = IngestJobRunLogsRequestValidationError{}
 
-// Validate checks the field values on GetJobRunEventsRequest with the rules
+// Validate checks the field values on IngestJobRunLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsRequest) Validate() error {
+func (m *IngestJobRunLogsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IngestJobRunLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsRequestMultiError, or nil if none found.
-func (m *GetJobRunEventsRequest) ValidateAll() error {
+// IngestJobRunLogsResponseMultiError, or nil if none found.
+func (m *IngestJobRunLogsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsRequest) validate(all bool) error {
+func (m *IngestJobRunLogsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
-
 	if len(errors) > 0 {
-		return GetJobRunEventsRequestMultiError(errors)
+		return IngestJobRunLogsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
+// IngestJobRunLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by IngestJobRunLogsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRunEventsRequestMultiError []error
+type IngestJobRunLogsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsRequestMultiError) Error() string {
+func (m IngestJobRunLogsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13988,11 +17553,11 @@ func (m GetJobRunEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
+func (m IngestJobRunLogsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsRequestValidationError is the validation error returned by
-// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
-type GetJobRunEventsRequestValidationError struct {
+// IngestJobRunLogsResponseValidationError is the validation error returned by
+// IngestJobRunLogsResponse.Validate if the designated constraints aren't met.
+type IngestJobRunLogsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14000,24 +17565,24 @@ type GetJobRunEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
+func (e IngestJobRunLogsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
+func (e IngestJobRunLogsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
+func (e IngestJobRunLogsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
+func (e IngestJobRunLogsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsRequestValidationError) ErrorName() string {
-	return "GetJobRunEventsRequestValidationError"
+func (e IngestJobRunLogsResponseValidationError) ErrorName() string {
+	return "IngestJobRunLogsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsRequestValidationError) Error() string {
+func (e IngestJobRunLogsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14029,14 +17594,14 @@ func (e GetJobRunEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsRequest.%s: %s%s",
+		"invalid %sIngestJobRunLogsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsRequestValidationError{}
+var _ error = IngestJobRunLogsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14044,47 +17609,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsRequestValidationError{}
+} = IngestJobRunLogsResponseValidationError{}
 
-// Validate checks the field values on GetJobRunEventsResponse with the rules
+// Validate checks the field values on RecordJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsResponse) Validate() error {
+func (m *RecordJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RecordJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsResponseMultiError, or nil if none found.
-func (m *GetJobRunEventsResponse) ValidateAll() error {
+// RecordJobRunRequestMultiError, or nil if none found.
+func (m *RecordJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsResponse) validate(all bool) error {
+func (m *RecordJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
+	// no validation rules for JobRunId
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordJobRunRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordJobRunRequestValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordJobRunRequestValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, RecordJobRunRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, RecordJobRunRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -14092,8 +17692,8 @@ func (m *GetJobRunEventsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetJobRunEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
+				return RecordJobRunRequestValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -14102,22 +17702,57 @@ func (m *GetJobRunEventsResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for IsRunComplete
+	if m.CompletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecordJobRunRequestValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecordJobRunRequestValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordJobRunRequestValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ErrorMessage != nil {
+		// no validation rules for ErrorMessage
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunEventsResponseMultiError(errors)
+		return RecordJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
+// RecordJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordJobRunRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRunEventsResponseMultiError []error
+type RecordJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsResponseMultiError) Error() string {
+func (m RecordJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14126,11 +17761,11 @@ func (m GetJobRunEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
+func (m RecordJobRunRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsResponseValidationError is the validation error returned by
-// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
-type GetJobRunEventsResponseValidationError struct {
+// RecordJobRunRequestValidationError is the validation error returned by
+// RecordJobRunRequest.Validate if the designated constraints aren't met.
+type RecordJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14138,24 +17773,24 @@ type GetJobRunEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
+func (e RecordJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
+func (e RecordJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
+func (e RecordJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
+func (e RecordJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsResponseValidationError) ErrorName() string {
-	return "GetJobRunEventsResponseValidationError"
+func (e RecordJobRunRequestValidationError) ErrorName() string {
+	return "RecordJobRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsResponseValidationError) Error() string {
+func (e RecordJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14167,14 +17802,14 @@ func (e GetJobRunEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsResponse.%s: %s%s",
+		"invalid %sRecordJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsResponseValidationError{}
+var _ error = RecordJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14182,48 +17817,177 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsResponseValidationError{}
+} = RecordJobRunRequestValidationError{}
 
-// Validate checks the field values on DeleteJobRunRequest with the rules
+// Validate checks the field values on RecordJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordJobRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordJobRunResponseMultiError, or nil if none found.
+func (m *RecordJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecordJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by RecordJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecordJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordJobRunResponseMultiError) AllErrors() []error { return m }
+
+// RecordJobRunResponseValidationError is the validation error returned by
+// RecordJobRunResponse.Validate if the designated constraints aren't met.
+type RecordJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordJobRunResponseValidationError) ErrorName() string {
+	return "RecordJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordJobRunResponseValidationError{}
+
+// Validate checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunRequest) Validate() error {
+func (m *SetJobWorkflowOptionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobRunRequestMultiError, or nil if none found.
-func (m *DeleteJobRunRequest) ValidateAll() error {
+// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunRequest) validate(all bool) error {
+func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for Id
 
-	// no validation rules for AccountId
+	if all {
+		switch v := interface{}(m.GetWorfklowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobWorkflowOptionsRequestValidationError{
+				field:  "WorfklowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteJobRunRequestMultiError(errors)
+		return SetJobWorkflowOptionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunRequestMultiError []error
+// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobWorkflowOptionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunRequestMultiError) Error() string {
+func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14232,11 +17996,12 @@ func (m DeleteJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
+func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunRequestValidationError is the validation error returned by
-// DeleteJobRunRequest.Validate if the designated constraints aren't met.
-type DeleteJobRunRequestValidationError struct {
+// SetJobWorkflowOptionsRequestValidationError is the validation error returned
+// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
+// aren't met.
+type SetJobWorkflowOptionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14244,24 +18009,24 @@ type DeleteJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
+func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
+func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
+func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
+func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunRequestValidationError) ErrorName() string {
-	return "DeleteJobRunRequestValidationError"
+func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunRequestValidationError) Error() string {
+func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14273,14 +18038,14 @@ func (e DeleteJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunRequest.%s: %s%s",
+		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunRequestValidationError{}
+var _ error = SetJobWorkflowOptionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14288,44 +18053,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunRequestValidationError{}
+} = SetJobWorkflowOptionsRequestValidationError{}
 
-// Validate checks the field values on DeleteJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetJobWorkflowOptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunResponse) Validate() error {
+func (m *SetJobWorkflowOptionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobRunResponseMultiError, or nil if none found.
-func (m *DeleteJobRunResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunResponse) validate(all bool) error {
+func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobWorkflowOptionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobRunResponseMultiError(errors)
+		return SetJobWorkflowOptionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunResponseMultiError []error
+// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetJobWorkflowOptionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunResponseMultiError) Error() string {
+func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14334,11 +18128,12 @@ func (m DeleteJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
+func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunResponseValidationError is the validation error returned by
-// DeleteJobRunResponse.Validate if the designated constraints aren't met.
-type DeleteJobRunResponseValidationError struct {
+// SetJobWorkflowOptionsResponseValidationError is the validation error
+// returned by SetJobWorkflowOptionsResponse.Validate if the designated
+// constraints aren't met.
+type SetJobWorkflowOptionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14346,24 +18141,24 @@ type DeleteJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
+func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
+func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
+func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
+func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunResponseValidationError) ErrorName() string {
-	return "DeleteJobRunResponseValidationError"
+func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunResponseValidationError) Error() string {
+func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14375,14 +18170,14 @@ func (e DeleteJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunResponse.%s: %s%s",
+		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunResponseValidationError{}
+var _ error = SetJobWorkflowOptionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14390,48 +18185,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunResponseValidationError{}
+} = SetJobWorkflowOptionsResponseValidationError{}
 
-// Validate checks the field values on TerminateJobRunRequest with the rules
+// Validate checks the field values on SetJobSyncOptionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunRequest) Validate() error {
+func (m *SetJobSyncOptionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TerminateJobRunRequestMultiError, or nil if none found.
-func (m *TerminateJobRunRequest) ValidateAll() error {
+// SetJobSyncOptionsRequestMultiError, or nil if none found.
+func (m *SetJobSyncOptionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunRequest) validate(all bool) error {
+func (m *SetJobSyncOptionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for Id
 
-	// no validation rules for AccountId
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TerminateJobRunRequestMultiError(errors)
+		return SetJobSyncOptionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
+// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TerminateJobRunRequestMultiError []error
+type SetJobSyncOptionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunRequestMultiError) Error() string {
+func (m SetJobSyncOptionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14440,11 +18262,11 @@ func (m TerminateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunRequestValidationError is the validation error returned by
-// TerminateJobRunRequest.Validate if the designated constraints aren't met.
-type TerminateJobRunRequestValidationError struct {
+// SetJobSyncOptionsRequestValidationError is the validation error returned by
+// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14452,24 +18274,24 @@ type TerminateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
+func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
+func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunRequestValidationError) ErrorName() string {
-	return "TerminateJobRunRequestValidationError"
+func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
+	return "SetJobSyncOptionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunRequestValidationError) Error() string {
+func (e SetJobSyncOptionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14481,14 +18303,14 @@ func (e TerminateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunRequest.%s: %s%s",
+		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunRequestValidationError{}
+var _ error = SetJobSyncOptionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14496,44 +18318,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunRequestValidationError{}
+} = SetJobSyncOptionsRequestValidationError{}
 
-// Validate checks the field values on TerminateJobRunResponse with the rules
+// Validate checks the field values on SetJobSyncOptionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunResponse) Validate() error {
+func (m *SetJobSyncOptionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunResponse with the
+// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TerminateJobRunResponseMultiError, or nil if none found.
-func (m *TerminateJobRunResponse) ValidateAll() error {
+// SetJobSyncOptionsResponseMultiError, or nil if none found.
+func (m *SetJobSyncOptionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunResponse) validate(all bool) error {
+func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TerminateJobRunResponseMultiError(errors)
+		return SetJobSyncOptionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TerminateJobRunResponseMultiError []error
+// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetJobSyncOptionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunResponseMultiError) Error() string {
+func (m SetJobSyncOptionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14542,11 +18393,11 @@ func (m TerminateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunResponseValidationError is the validation error returned by
-// TerminateJobRunResponse.Validate if the designated constraints aren't met.
-type TerminateJobRunResponseValidationError struct {
+// SetJobSyncOptionsResponseValidationError is the validation error returned by
+// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14554,24 +18405,24 @@ type TerminateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
+func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
+func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunResponseValidationError) ErrorName() string {
-	return "TerminateJobRunResponseValidationError"
+func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
+	return "SetJobSyncOptionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunResponseValidationError) Error() string {
+func (e SetJobSyncOptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14583,14 +18434,14 @@ func (e TerminateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunResponse.%s: %s%s",
+		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunResponseValidationError{}
+var _ error = SetJobSyncOptionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14598,56 +18449,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunResponseValidationError{}
+} = SetJobSyncOptionsResponseValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamRequest with the
+// Validate checks the field values on ValidateJobMappingsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamRequest) Validate() error {
+func (m *ValidateJobMappingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
+// ValidateAll checks the field values on ValidateJobMappingsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamRequestMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
+// ValidateJobMappingsRequestMultiError, or nil if none found.
+func (m *ValidateJobMappingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
+func (m *ValidateJobMappingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
 	// no validation rules for AccountId
 
-	// no validation rules for Window
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
 
-	// no validation rules for ShouldTail
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ConnectionId
+
+	for idx, item := range m.GetVirtualForeignKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsRequestValidationError{
+					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.MaxLogLines != nil {
-		// no validation rules for MaxLogLines
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamRequestMultiError(errors)
+		return ValidateJobMappingsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
+// ValidateJobMappingsRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateJobMappingsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetJobRunLogsStreamRequestMultiError []error
+type ValidateJobMappingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamRequestMultiError) Error() string {
+func (m ValidateJobMappingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14656,11 +18567,11 @@ func (m GetJobRunLogsStreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
+func (m ValidateJobMappingsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamRequestValidationError is the validation error returned
-// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
-type GetJobRunLogsStreamRequestValidationError struct {
+// ValidateJobMappingsRequestValidationError is the validation error returned
+// by ValidateJobMappingsRequest.Validate if the designated constraints aren't met.
+type ValidateJobMappingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14668,24 +18579,24 @@ type GetJobRunLogsStreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
+func (e ValidateJobMappingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
+func (e ValidateJobMappingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
+func (e ValidateJobMappingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
+func (e ValidateJobMappingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamRequestValidationError"
+func (e ValidateJobMappingsRequestValidationError) ErrorName() string {
+	return "ValidateJobMappingsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamRequestValidationError) Error() string {
+func (e ValidateJobMappingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14697,14 +18608,14 @@ func (e GetJobRunLogsStreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
+		"invalid %sValidateJobMappingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamRequestValidationError{}
+var _ error = ValidateJobMappingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14712,79 +18623,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamRequestValidationError{}
+} = ValidateJobMappingsRequestValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamResponse) Validate() error {
+// Validate checks the field values on ColumnError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamResponseMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
+// ValidateAll checks the field values on ColumnError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnErrorMultiError, or
+// nil if none found.
+func (m *ColumnError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
+func (m *ColumnError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LogLine
-
-	if m.Timestamp != nil {
-
-		if all {
-			switch v := interface{}(m.GetTimestamp()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
-						field:  "Timestamp",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
-						field:  "Timestamp",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunLogsStreamResponseValidationError{
-					field:  "Timestamp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Schema
 
-	}
+	// no validation rules for Table
+
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamResponseMultiError(errors)
+		return ColumnErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetJobRunLogsStreamResponseMultiError []error
+// ColumnErrorMultiError is an error wrapping multiple validation errors
+// returned by ColumnError.ValidateAll() if the designated constraints aren't met.
+type ColumnErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamResponseMultiError) Error() string {
+func (m ColumnErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14793,12 +18674,11 @@ func (m GetJobRunLogsStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
+func (m ColumnErrorMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamResponseValidationError is the validation error returned
-// by GetJobRunLogsStreamResponse.Validate if the designated constraints
-// aren't met.
-type GetJobRunLogsStreamResponseValidationError struct {
+// ColumnErrorValidationError is the validation error returned by
+// ColumnError.Validate if the designated constraints aren't met.
+type ColumnErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14806,24 +18686,22 @@ type GetJobRunLogsStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
+func (e ColumnErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
+func (e ColumnErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
+func (e ColumnErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
+func (e ColumnErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamResponseValidationError"
-}
+func (e ColumnErrorValidationError) ErrorName() string { return "ColumnErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamResponseValidationError) Error() string {
+func (e ColumnErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14835,14 +18713,14 @@ func (e GetJobRunLogsStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
+		"invalid %sColumnError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamResponseValidationError{}
+var _ error = ColumnErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -14850,75 +18728,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamResponseValidationError{}
+} = ColumnErrorValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsRequest) Validate() error {
+// Validate checks the field values on DatabaseError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatabaseError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on DatabaseError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseErrorMultiError, or
+// nil if none found.
+func (m *DatabaseError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
+func (m *DatabaseError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetWorfklowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsRequestValidationError{
-				field:  "WorfklowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsRequestMultiError(errors)
+		return DatabaseErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetJobWorkflowOptionsRequestMultiError []error
+// DatabaseErrorMultiError is an error wrapping multiple validation errors
+// returned by DatabaseError.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
+func (m DatabaseErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14927,12 +18774,11 @@ func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
+func (m DatabaseErrorMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsRequestValidationError is the validation error returned
-// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
-// aren't met.
-type SetJobWorkflowOptionsRequestValidationError struct {
+// DatabaseErrorValidationError is the validation error returned by
+// DatabaseError.Validate if the designated constraints aren't met.
+type DatabaseErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14940,24 +18786,22 @@ type SetJobWorkflowOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
+func (e DatabaseErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
+func (e DatabaseErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
+func (e DatabaseErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
+func (e DatabaseErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsRequestValidationError"
-}
+func (e DatabaseErrorValidationError) ErrorName() string { return "DatabaseErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
+func (e DatabaseErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14969,14 +18813,14 @@ func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
+		"invalid %sDatabaseError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsRequestValidationError{}
+var _ error = DatabaseErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -14984,53 +18828,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsRequestValidationError{}
+} = DatabaseErrorValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsResponse with the
+// Validate checks the field values on ValidateJobMappingsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsResponse) Validate() error {
+func (m *ValidateJobMappingsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ValidateJobMappingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateJobMappingsResponseMultiError, or nil if none found.
+func (m *ValidateJobMappingsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
+func (m *ValidateJobMappingsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetColumnErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsResponseValidationError{
+					field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetDatabaseErrors()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, ValidateJobMappingsResponseValidationError{
+					field:  "DatabaseErrors",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, ValidateJobMappingsResponseValidationError{
+					field:  "DatabaseErrors",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDatabaseErrors()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsResponseValidationError{
-				field:  "Job",
+			return ValidateJobMappingsResponseValidationError{
+				field:  "DatabaseErrors",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15038,19 +18916,19 @@ func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsResponseMultiError(errors)
+		return ValidateJobMappingsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type SetJobWorkflowOptionsResponseMultiError []error
+// ValidateJobMappingsResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateJobMappingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateJobMappingsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
+func (m ValidateJobMappingsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15059,12 +18937,12 @@ func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
+func (m ValidateJobMappingsResponseMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsResponseValidationError is the validation error
-// returned by SetJobWorkflowOptionsResponse.Validate if the designated
-// constraints aren't met.
-type SetJobWorkflowOptionsResponseValidationError struct {
+// ValidateJobMappingsResponseValidationError is the validation error returned
+// by ValidateJobMappingsResponse.Validate if the designated constraints
+// aren't met.
+type ValidateJobMappingsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15072,24 +18950,24 @@ type SetJobWorkflowOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
+func (e ValidateJobMappingsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
+func (e ValidateJobMappingsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
+func (e ValidateJobMappingsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
+func (e ValidateJobMappingsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsResponseValidationError"
+func (e ValidateJobMappingsResponseValidationError) ErrorName() string {
+	return "ValidateJobMappingsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
+func (e ValidateJobMappingsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15101,14 +18979,14 @@ func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
+		"invalid %sValidateJobMappingsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsResponseValidationError{}
+var _ error = ValidateJobMappingsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15116,75 +18994,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsResponseValidationError{}
+} = ValidateJobMappingsResponseValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsRequest) Validate() error {
+// Validate checks the field values on VirtualForeignKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VirtualForeignKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on VirtualForeignKey with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsRequestMultiError, or nil if none found.
-func (m *SetJobSyncOptionsRequest) ValidateAll() error {
+// VirtualForeignKeyMultiError, or nil if none found.
+func (m *VirtualForeignKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsRequest) validate(all bool) error {
+func (m *VirtualForeignKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Schema
 
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return SetJobSyncOptionsRequestMultiError(errors)
+		return VirtualForeignKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSyncOptionsRequestMultiError []error
+// VirtualForeignKeyMultiError is an error wrapping multiple validation errors
+// returned by VirtualForeignKey.ValidateAll() if the designated constraints
+// aren't met.
+type VirtualForeignKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsRequestMultiError) Error() string {
+func (m VirtualForeignKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15193,11 +19044,11 @@ func (m SetJobSyncOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
+func (m VirtualForeignKeyMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsRequestValidationError is the validation error returned by
-// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsRequestValidationError struct {
+// VirtualForeignKeyValidationError is the validation error returned by
+// VirtualForeignKey.Validate if the designated constraints aren't met.
+type VirtualForeignKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15205,24 +19056,24 @@ type SetJobSyncOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
+func (e VirtualForeignKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
+func (e VirtualForeignKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
+func (e VirtualForeignKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
+func (e VirtualForeignKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
-	return "SetJobSyncOptionsRequestValidationError"
+func (e VirtualForeignKeyValidationError) ErrorName() string {
+	return "VirtualForeignKeyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsRequestValidationError) Error() string {
+func (e VirtualForeignKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15234,14 +19085,14 @@ func (e SetJobSyncOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
+		"invalid %sVirtualForeignKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsRequestValidationError{}
+var _ error = VirtualForeignKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15249,53 +19100,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsRequestValidationError{}
+} = VirtualForeignKeyValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsResponse with the rules
+// Validate checks the field values on VirtualForeignConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsResponse) Validate() error {
+func (m *VirtualForeignConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
+// ValidateAll checks the field values on VirtualForeignConstraint with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsResponseMultiError, or nil if none found.
-func (m *SetJobSyncOptionsResponse) ValidateAll() error {
+// VirtualForeignConstraintMultiError, or nil if none found.
+func (m *VirtualForeignConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsResponse) validate(all bool) error {
+func (m *VirtualForeignConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetForeignKey()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, VirtualForeignConstraintValidationError{
+					field:  "ForeignKey",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, VirtualForeignConstraintValidationError{
+					field:  "ForeignKey",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsResponseValidationError{
-				field:  "Job",
+			return VirtualForeignConstraintValidationError{
+				field:  "ForeignKey",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15303,19 +19158,19 @@ func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetJobSyncOptionsResponseMultiError(errors)
+		return VirtualForeignConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type SetJobSyncOptionsResponseMultiError []error
+// VirtualForeignConstraintMultiError is an error wrapping multiple validation
+// errors returned by VirtualForeignConstraint.ValidateAll() if the designated
+// constraints aren't met.
+type VirtualForeignConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsResponseMultiError) Error() string {
+func (m VirtualForeignConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15324,11 +19179,11 @@ func (m SetJobSyncOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
+func (m VirtualForeignConstraintMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsResponseValidationError is the validation error returned by
-// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsResponseValidationError struct {
+// VirtualForeignConstraintValidationError is the validation error returned by
+// VirtualForeignConstraint.Validate if the designated constraints aren't met.
+type VirtualForeignConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15336,24 +19191,24 @@ type SetJobSyncOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
+func (e VirtualForeignConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
+func (e VirtualForeignConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
+func (e VirtualForeignConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
+func (e VirtualForeignConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
-	return "SetJobSyncOptionsResponseValidationError"
+func (e VirtualForeignConstraintValidationError) ErrorName() string {
+	return "VirtualForeignConstraintValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsResponseValidationError) Error() string {
+func (e VirtualForeignConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15365,14 +19220,14 @@ func (e SetJobSyncOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
+		"invalid %sVirtualForeignConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsResponseValidationError{}
+var _ error = VirtualForeignConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -15380,85 +19235,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsResponseValidationError{}
+} = VirtualForeignConstraintValidationError{}
 
-// Validate checks the field values on ValidateJobMappingsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobMappingPolicyConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateJobMappingsRequest) Validate() error {
+func (m *JobMappingPolicyConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateJobMappingsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingPolicyConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ValidateJobMappingsRequestMultiError, or nil if none found.
-func (m *ValidateJobMappingsRequest) ValidateAll() error {
+// JobMappingPolicyConfigMultiError, or nil if none found.
+func (m *JobMappingPolicyConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateJobMappingsRequest) validate(all bool) error {
+func (m *JobMappingPolicyConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ValidateJobMappingsRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for ConnectionId
-
-	for idx, item := range m.GetVirtualForeignKeys() {
+	for idx, item := range m.GetPolicies() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, JobMappingPolicyConfigValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+					errors = append(errors, JobMappingPolicyConfigValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -15466,8 +19283,8 @@ func (m *ValidateJobMappingsRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ValidateJobMappingsRequestValidationError{
-					field:  fmt.Sprintf("VirtualForeignKeys[%v]", idx),
+				return JobMappingPolicyConfigValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -15477,19 +19294,19 @@ func (m *ValidateJobMappingsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ValidateJobMappingsRequestMultiError(errors)
+		return JobMappingPolicyConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateJobMappingsRequestMultiError is an error wrapping multiple
-// validation errors returned by ValidateJobMappingsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateJobMappingsRequestMultiError []error
+// JobMappingPolicyConfigMultiError is an error wrapping multiple validation
+// errors returned by JobMappingPolicyConfig.ValidateAll() if the designated
+// constraints aren't met.
+type JobMappingPolicyConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateJobMappingsRequestMultiError) Error() string {
+func (m JobMappingPolicyConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15498,11 +19315,11 @@ func (m ValidateJobMappingsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateJobMappingsRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingPolicyConfigMultiError) AllErrors() []error { return m }
 
-// ValidateJobMappingsRequestValidationError is the validation error returned
-// by ValidateJobMappingsRequest.Validate if the designated constraints aren't met.
-type ValidateJobMappingsRequestValidationError struct {
+// JobMappingPolicyConfigValidationError is the validation error returned by
+// JobMappingPolicyConfig.Validate if the designated constraints aren't met.
+type JobMappingPolicyConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15510,24 +19327,24 @@ type ValidateJobMappingsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateJobMappingsRequestValidationError) Field() string { return e.field }
+func (e JobMappingPolicyConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateJobMappingsRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingPolicyConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateJobMappingsRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingPolicyConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateJobMappingsRequestValidationError) Key() bool { return e.key }
+func (e JobMappingPolicyConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateJobMappingsRequestValidationError) ErrorName() string {
-	return "ValidateJobMappingsRequestValidationError"
+func (e JobMappingPolicyConfigValidationError) ErrorName() string {
+	return "JobMappingPolicyConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateJobMappingsRequestValidationError) Error() string {
+func (e JobMappingPolicyConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15539,14 +19356,14 @@ func (e ValidateJobMappingsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateJobMappingsRequest.%s: %s%s",
+		"invalid %sJobMappingPolicyConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateJobMappingsRequestValidationError{}
+var _ error = JobMappingPolicyConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -15554,49 +19371,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateJobMappingsRequestValidationError{}
+} = JobMappingPolicyConfigValidationError{}
 
-// Validate checks the field values on ColumnError with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ColumnError) Validate() error {
+// Validate checks the field values on JobMappingPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnError with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ColumnErrorMultiError, or
-// nil if none found.
-func (m *ColumnError) ValidateAll() error {
+// ValidateAll checks the field values on JobMappingPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobMappingPolicyMultiError, or nil if none found.
+func (m *JobMappingPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnError) validate(all bool) error {
+func (m *JobMappingPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
+	// no validation rules for Name
 
-	// no validation rules for Column
+	// no validation rules for DestinationScope
 
 	if len(errors) > 0 {
-		return ColumnErrorMultiError(errors)
+		return JobMappingPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnErrorMultiError is an error wrapping multiple validation errors
-// returned by ColumnError.ValidateAll() if the designated constraints aren't met.
-type ColumnErrorMultiError []error
+// JobMappingPolicyMultiError is an error wrapping multiple validation errors
+// returned by JobMappingPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type JobMappingPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnErrorMultiError) Error() string {
+func (m JobMappingPolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15604,12 +19420,12 @@ func (m ColumnErrorMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m ColumnErrorMultiError) AllErrors() []error { return m }
-
-// ColumnErrorValidationError is the validation error returned by
-// ColumnError.Validate if the designated constraints aren't met.
-type ColumnErrorValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m JobMappingPolicyMultiError) AllErrors() []error { return m }
+
+// JobMappingPolicyValidationError is the validation error returned by
+// JobMappingPolicy.Validate if the designated constraints aren't met.
+type JobMappingPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15617,22 +19433,22 @@ type ColumnErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnErrorValidationError) Field() string { return e.field }
+func (e JobMappingPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnErrorValidationError) Reason() string { return e.reason }
+func (e JobMappingPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnErrorValidationError) Cause() error { return e.cause }
+func (e JobMappingPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnErrorValidationError) Key() bool { return e.key }
+func (e JobMappingPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnErrorValidationError) ErrorName() string { return "ColumnErrorValidationError" }
+func (e JobMappingPolicyValidationError) ErrorName() string { return "JobMappingPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnErrorValidationError) Error() string {
+func (e JobMappingPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15644,14 +19460,14 @@ func (e ColumnErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnError.%s: %s%s",
+		"invalid %sJobMappingPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnErrorValidationError{}
+var _ error = JobMappingPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15659,44 +19475,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnErrorValidationError{}
+} = JobMappingPolicyValidationError{}
 
-// Validate checks the field values on DatabaseError with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DatabaseError) Validate() error {
+// Validate checks the field values on GetJobMappingPolicyConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobMappingPolicyConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DatabaseError with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseErrorMultiError, or
-// nil if none found.
-func (m *DatabaseError) ValidateAll() error {
+// ValidateAll checks the field values on GetJobMappingPolicyConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJobMappingPolicyConfigRequestMultiError, or nil if none found.
+func (m *GetJobMappingPolicyConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DatabaseError) validate(all bool) error {
+func (m *GetJobMappingPolicyConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AccountId
+
 	if len(errors) > 0 {
-		return DatabaseErrorMultiError(errors)
+		return GetJobMappingPolicyConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseErrorMultiError is an error wrapping multiple validation errors
-// returned by DatabaseError.ValidateAll() if the designated constraints
-// aren't met.
-type DatabaseErrorMultiError []error
+// GetJobMappingPolicyConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobMappingPolicyConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobMappingPolicyConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseErrorMultiError) Error() string {
+func (m GetJobMappingPolicyConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15705,11 +19525,12 @@ func (m DatabaseErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseErrorMultiError) AllErrors() []error { return m }
+func (m GetJobMappingPolicyConfigRequestMultiError) AllErrors() []error { return m }
 
-// DatabaseErrorValidationError is the validation error returned by
-// DatabaseError.Validate if the designated constraints aren't met.
-type DatabaseErrorValidationError struct {
+// GetJobMappingPolicyConfigRequestValidationError is the validation error
+// returned by GetJobMappingPolicyConfigRequest.Validate if the designated
+// constraints aren't met.
+type GetJobMappingPolicyConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15717,22 +19538,24 @@ type DatabaseErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseErrorValidationError) Field() string { return e.field }
+func (e GetJobMappingPolicyConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseErrorValidationError) Reason() string { return e.reason }
+func (e GetJobMappingPolicyConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseErrorValidationError) Cause() error { return e.cause }
+func (e GetJobMappingPolicyConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseErrorValidationError) Key() bool { return e.key }
+func (e GetJobMappingPolicyConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseErrorValidationError) ErrorName() string { return "DatabaseErrorValidationError" }
+func (e GetJobMappingPolicyConfigRequestValidationError) ErrorName() string {
+	return "GetJobMappingPolicyConfigRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseErrorValidationError) Error() string {
+func (e GetJobMappingPolicyConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15744,14 +19567,14 @@ func (e DatabaseErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabaseError.%s: %s%s",
+		"invalid %sGetJobMappingPolicyConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseErrorValidationError{}
+var _ error = GetJobMappingPolicyConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15759,87 +19582,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseErrorValidationError{}
+} = GetJobMappingPolicyConfigRequestValidationError{}
 
-// Validate checks the field values on ValidateJobMappingsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateJobMappingsResponse) Validate() error {
+// Validate checks the field values on GetJobMappingPolicyConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobMappingPolicyConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateJobMappingsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ValidateJobMappingsResponseMultiError, or nil if none found.
-func (m *ValidateJobMappingsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobMappingPolicyConfigResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetJobMappingPolicyConfigResponseMultiError, or nil if none found.
+func (m *GetJobMappingPolicyConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateJobMappingsResponse) validate(all bool) error {
+func (m *GetJobMappingPolicyConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetColumnErrors() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ValidateJobMappingsResponseValidationError{
-						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ValidateJobMappingsResponseValidationError{
-						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ValidateJobMappingsResponseValidationError{
-					field:  fmt.Sprintf("ColumnErrors[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if all {
-		switch v := interface{}(m.GetDatabaseErrors()).(type) {
+		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ValidateJobMappingsResponseValidationError{
-					field:  "DatabaseErrors",
+				errors = append(errors, GetJobMappingPolicyConfigResponseValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ValidateJobMappingsResponseValidationError{
-					field:  "DatabaseErrors",
+				errors = append(errors, GetJobMappingPolicyConfigResponseValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDatabaseErrors()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ValidateJobMappingsResponseValidationError{
-				field:  "DatabaseErrors",
+			return GetJobMappingPolicyConfigResponseValidationError{
+				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15847,19 +19637,20 @@ func (m *ValidateJobMappingsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ValidateJobMappingsResponseMultiError(errors)
+		return GetJobMappingPolicyConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateJobMappingsResponseMultiError is an error wrapping multiple
-// validation errors returned by ValidateJobMappingsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateJobMappingsResponseMultiError []error
+// GetJobMappingPolicyConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobMappingPolicyConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobMappingPolicyConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateJobMappingsResponseMultiError) Error() string {
+func (m GetJobMappingPolicyConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15868,12 +19659,12 @@ func (m ValidateJobMappingsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateJobMappingsResponseMultiError) AllErrors() []error { return m }
+func (m GetJobMappingPolicyConfigResponseMultiError) AllErrors() []error { return m }
 
-// ValidateJobMappingsResponseValidationError is the validation error returned
-// by ValidateJobMappingsResponse.Validate if the designated constraints
-// aren't met.
-type ValidateJobMappingsResponseValidationError struct {
+// GetJobMappingPolicyConfigResponseValidationError is the validation error
+// returned by GetJobMappingPolicyConfigResponse.Validate if the designated
+// constraints aren't met.
+type GetJobMappingPolicyConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15881,24 +19672,24 @@ type ValidateJobMappingsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateJobMappingsResponseValidationError) Field() string { return e.field }
+func (e GetJobMappingPolicyConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateJobMappingsResponseValidationError) Reason() string { return e.reason }
+func (e GetJobMappingPolicyConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateJobMappingsResponseValidationError) Cause() error { return e.cause }
+func (e GetJobMappingPolicyConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateJobMappingsResponseValidationError) Key() bool { return e.key }
+func (e GetJobMappingPolicyConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateJobMappingsResponseValidationError) ErrorName() string {
-	return "ValidateJobMappingsResponseValidationError"
+func (e GetJobMappingPolicyConfigResponseValidationError) ErrorName() string {
+	return "GetJobMappingPolicyConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateJobMappingsResponseValidationError) Error() string {
+func (e GetJobMappingPolicyConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15910,14 +19701,14 @@ func (e ValidateJobMappingsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateJobMappingsResponse.%s: %s%s",
+		"invalid %sGetJobMappingPolicyConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateJobMappingsResponseValidationError{}
+var _ error = GetJobMappingPolicyConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15925,48 +19716,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateJobMappingsResponseValidationError{}
+} = GetJobMappingPolicyConfigResponseValidationError{}
 
-// Validate checks the field values on VirtualForeignKey with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *VirtualForeignKey) Validate() error {
+// Validate checks the field values on SetJobMappingPolicyConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobMappingPolicyConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VirtualForeignKey with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// VirtualForeignKeyMultiError, or nil if none found.
-func (m *VirtualForeignKey) ValidateAll() error {
+// ValidateAll checks the field values on SetJobMappingPolicyConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobMappingPolicyConfigRequestMultiError, or nil if none found.
+func (m *SetJobMappingPolicyConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VirtualForeignKey) validate(all bool) error {
+func (m *SetJobMappingPolicyConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
+	// no validation rules for AccountId
 
-	// no validation rules for Table
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobMappingPolicyConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobMappingPolicyConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobMappingPolicyConfigRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return VirtualForeignKeyMultiError(errors)
+		return SetJobMappingPolicyConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VirtualForeignKeyMultiError is an error wrapping multiple validation errors
-// returned by VirtualForeignKey.ValidateAll() if the designated constraints
-// aren't met.
-type VirtualForeignKeyMultiError []error
+// SetJobMappingPolicyConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobMappingPolicyConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobMappingPolicyConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VirtualForeignKeyMultiError) Error() string {
+func (m SetJobMappingPolicyConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15975,11 +19795,12 @@ func (m VirtualForeignKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VirtualForeignKeyMultiError) AllErrors() []error { return m }
+func (m SetJobMappingPolicyConfigRequestMultiError) AllErrors() []error { return m }
 
-// VirtualForeignKeyValidationError is the validation error returned by
-// VirtualForeignKey.Validate if the designated constraints aren't met.
-type VirtualForeignKeyValidationError struct {
+// SetJobMappingPolicyConfigRequestValidationError is the validation error
+// returned by SetJobMappingPolicyConfigRequest.Validate if the designated
+// constraints aren't met.
+type SetJobMappingPolicyConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15987,24 +19808,24 @@ type VirtualForeignKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e VirtualForeignKeyValidationError) Field() string { return e.field }
+func (e SetJobMappingPolicyConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VirtualForeignKeyValidationError) Reason() string { return e.reason }
+func (e SetJobMappingPolicyConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VirtualForeignKeyValidationError) Cause() error { return e.cause }
+func (e SetJobMappingPolicyConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VirtualForeignKeyValidationError) Key() bool { return e.key }
+func (e SetJobMappingPolicyConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VirtualForeignKeyValidationError) ErrorName() string {
-	return "VirtualForeignKeyValidationError"
+func (e SetJobMappingPolicyConfigRequestValidationError) ErrorName() string {
+	return "SetJobMappingPolicyConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VirtualForeignKeyValidationError) Error() string {
+func (e SetJobMappingPolicyConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16016,14 +19837,14 @@ func (e VirtualForeignKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVirtualForeignKey.%s: %s%s",
+		"invalid %sSetJobMappingPolicyConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VirtualForeignKeyValidationError{}
+var _ error = SetJobMappingPolicyConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16031,57 +19852,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VirtualForeignKeyValidationError{}
+} = SetJobMappingPolicyConfigRequestValidationError{}
 
-// Validate checks the field values on VirtualForeignConstraint with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VirtualForeignConstraint) Validate() error {
+// Validate checks the field values on SetJobMappingPolicyConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobMappingPolicyConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VirtualForeignConstraint with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// VirtualForeignConstraintMultiError, or nil if none found.
-func (m *VirtualForeignConstraint) ValidateAll() error {
+// ValidateAll checks the field values on SetJobMappingPolicyConfigResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetJobMappingPolicyConfigResponseMultiError, or nil if none found.
+func (m *SetJobMappingPolicyConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VirtualForeignConstraint) validate(all bool) error {
+func (m *SetJobMappingPolicyConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
 	if all {
-		switch v := interface{}(m.GetForeignKey()).(type) {
+		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, VirtualForeignConstraintValidationError{
-					field:  "ForeignKey",
+				errors = append(errors, SetJobMappingPolicyConfigResponseValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, VirtualForeignConstraintValidationError{
-					field:  "ForeignKey",
+				errors = append(errors, SetJobMappingPolicyConfigResponseValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return VirtualForeignConstraintValidationError{
-				field:  "ForeignKey",
+			return SetJobMappingPolicyConfigResponseValidationError{
+				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -16089,19 +19907,20 @@ func (m *VirtualForeignConstraint) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return VirtualForeignConstraintMultiError(errors)
+		return SetJobMappingPolicyConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VirtualForeignConstraintMultiError is an error wrapping multiple validation
-// errors returned by VirtualForeignConstraint.ValidateAll() if the designated
+// SetJobMappingPolicyConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobMappingPolicyConfigResponse.ValidateAll() if the designated
 // constraints aren't met.
-type VirtualForeignConstraintMultiError []error
+type SetJobMappingPolicyConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VirtualForeignConstraintMultiError) Error() string {
+func (m SetJobMappingPolicyConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16110,11 +19929,12 @@ func (m VirtualForeignConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VirtualForeignConstraintMultiError) AllErrors() []error { return m }
+func (m SetJobMappingPolicyConfigResponseMultiError) AllErrors() []error { return m }
 
-// VirtualForeignConstraintValidationError is the validation error returned by
-// VirtualForeignConstraint.Validate if the designated constraints aren't met.
-type VirtualForeignConstraintValidationError struct {
+// SetJobMappingPolicyConfigResponseValidationError is the validation error
+// returned by SetJobMappingPolicyConfigResponse.Validate if the designated
+// constraints aren't met.
+type SetJobMappingPolicyConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16122,24 +19942,24 @@ type VirtualForeignConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e VirtualForeignConstraintValidationError) Field() string { return e.field }
+func (e SetJobMappingPolicyConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VirtualForeignConstraintValidationError) Reason() string { return e.reason }
+func (e SetJobMappingPolicyConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VirtualForeignConstraintValidationError) Cause() error { return e.cause }
+func (e SetJobMappingPolicyConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VirtualForeignConstraintValidationError) Key() bool { return e.key }
+func (e SetJobMappingPolicyConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VirtualForeignConstraintValidationError) ErrorName() string {
-	return "VirtualForeignConstraintValidationError"
+func (e SetJobMappingPolicyConfigResponseValidationError) ErrorName() string {
+	return "SetJobMappingPolicyConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VirtualForeignConstraintValidationError) Error() string {
+func (e SetJobMappingPolicyConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16151,14 +19971,14 @@ func (e VirtualForeignConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVirtualForeignConstraint.%s: %s%s",
+		"invalid %sSetJobMappingPolicyConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VirtualForeignConstraintValidationError{}
+var _ error = SetJobMappingPolicyConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16166,7 +19986,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VirtualForeignConstraintValidationError{}
+} = SetJobMappingPolicyConfigResponseValidationError{}
 
 // Validate checks the field values on RunContextKey with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -39,6 +39,9 @@ const (
 	// ConnectionServiceGetConnectionProcedure is the fully-qualified name of the ConnectionService's
 	// GetConnection RPC.
 	ConnectionServiceGetConnectionProcedure = "/mgmt.v1alpha1.ConnectionService/GetConnection"
+	// ConnectionServiceGetConnectionUnmaskedProcedure is the fully-qualified name of the
+	// ConnectionService's GetConnectionUnmasked RPC.
+	ConnectionServiceGetConnectionUnmaskedProcedure = "/mgmt.v1alpha1.ConnectionService/GetConnectionUnmasked"
 	// ConnectionServiceCreateConnectionProcedure is the fully-qualified name of the ConnectionService's
 	// CreateConnection RPC.
 	ConnectionServiceCreateConnectionProcedure = "/mgmt.v1alpha1.ConnectionService/CreateConnection"
@@ -57,6 +60,12 @@ const (
 	// ConnectionServiceCheckSqlQueryProcedure is the fully-qualified name of the ConnectionService's
 	// CheckSqlQuery RPC.
 	ConnectionServiceCheckSqlQueryProcedure = "/mgmt.v1alpha1.ConnectionService/CheckSqlQuery"
+	// ConnectionServiceGetConnectionColumnTagsProcedure is the fully-qualified name of the
+	// ConnectionService's GetConnectionColumnTags RPC.
+	ConnectionServiceGetConnectionColumnTagsProcedure = "/mgmt.v1alpha1.ConnectionService/GetConnectionColumnTags"
+	// ConnectionServiceSetConnectionColumnTagsProcedure is the fully-qualified name of the
+	// ConnectionService's SetConnectionColumnTags RPC.
+	ConnectionServiceSetConnectionColumnTagsProcedure = "/mgmt.v1alpha1.ConnectionService/SetConnectionColumnTags"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -64,23 +73,31 @@ var (
 	connectionServiceServiceDescriptor                         = v1alpha1.File_mgmt_v1alpha1_connection_proto.Services().ByName("ConnectionService")
 	connectionServiceGetConnectionsMethodDescriptor            = connectionServiceServiceDescriptor.Methods().ByName("GetConnections")
 	connectionServiceGetConnectionMethodDescriptor             = connectionServiceServiceDescriptor.Methods().ByName("GetConnection")
+	connectionServiceGetConnectionUnmaskedMethodDescriptor     = connectionServiceServiceDescriptor.Methods().ByName("GetConnectionUnmasked")
 	connectionServiceCreateConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("CreateConnection")
 	connectionServiceUpdateConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("UpdateConnection")
 	connectionServiceDeleteConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("DeleteConnection")
 	connectionServiceIsConnectionNameAvailableMethodDescriptor = connectionServiceServiceDescriptor.Methods().ByName("IsConnectionNameAvailable")
 	connectionServiceCheckConnectionConfigMethodDescriptor     = connectionServiceServiceDescriptor.Methods().ByName("CheckConnectionConfig")
 	connectionServiceCheckSqlQueryMethodDescriptor             = connectionServiceServiceDescriptor.Methods().ByName("CheckSqlQuery")
+	connectionServiceGetConnectionColumnTagsMethodDescriptor   = connectionServiceServiceDescriptor.Methods().ByName("GetConnectionColumnTags")
+	connectionServiceSetConnectionColumnTagsMethodDescriptor   = connectionServiceServiceDescriptor.Methods().ByName("SetConnectionColumnTags")
 )
 
 // ConnectionServiceClient is a client for the mgmt.v1alpha1.ConnectionService service.
 type ConnectionServiceClient interface {
 	// Returns a list of connections associated with the account
 	GetConnections(context.Context, *connect.Request[v1alpha1.GetConnectionsRequest]) (*connect.Response[v1alpha1.GetConnectionsResponse], error)
-	// Returns a single connection
+	// Returns a single connection.
+	// Secret fields (passwords, private keys, access keys, connection urls) are masked in the responses of every method except GetConnectionUnmasked.
 	GetConnection(context.Context, *connect.Request[v1alpha1.GetConnectionRequest]) (*connect.Response[v1alpha1.GetConnectionResponse], error)
+	// Returns a single connection including its secrets.
+	// Intended for the worker and other clients that must connect to the underlying system, this requires the connections:secrets api key scope.
+	GetConnectionUnmasked(context.Context, *connect.Request[v1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[v1alpha1.GetConnectionUnmaskedResponse], error)
 	// Creates a new connection
 	CreateConnection(context.Context, *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error)
-	// Updates an existing connection
+	// Updates an existing connection.
+	// Secret fields that are sent back with the masked placeholder keep their stored value.
 	UpdateConnection(context.Context, *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error)
 	// Removes a connection from the system.
 	DeleteConnection(context.Context, *connect.Request[v1alpha1.DeleteConnectionRequest]) (*connect.Response[v1alpha1.DeleteConnectionResponse], error)
@@ -92,6 +109,11 @@ type ConnectionServiceClient interface {
 	// Checks a constructed SQL query against a sql-based connection to see if it's valid based on that connection's data schema
 	// This is useful when constructing subsets to see if the WHERE clause is correct
 	CheckSqlQuery(context.Context, *connect.Request[v1alpha1.CheckSqlQueryRequest]) (*connect.Response[v1alpha1.CheckSqlQueryResponse], error)
+	// Returns the classification tags of the columns of a connection
+	GetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.GetConnectionColumnTagsResponse], error)
+	// Sets the classification tags of columns of a connection.
+	// Tags may be used by job mapping policies, so this is restricted to account admins.
+	SetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.SetConnectionColumnTagsResponse], error)
 }
 
 // NewConnectionServiceClient constructs a client for the mgmt.v1alpha1.ConnectionService service.
@@ -116,6 +138,12 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(connectionServiceGetConnectionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getConnectionUnmasked: connect.NewClient[v1alpha1.GetConnectionUnmaskedRequest, v1alpha1.GetConnectionUnmaskedResponse](
+			httpClient,
+			baseURL+ConnectionServiceGetConnectionUnmaskedProcedure,
+			connect.WithSchema(connectionServiceGetConnectionUnmaskedMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createConnection: connect.NewClient[v1alpha1.CreateConnectionRequest, v1alpha1.CreateConnectionResponse](
 			httpClient,
 			baseURL+ConnectionServiceCreateConnectionProcedure,
@@ -152,6 +180,18 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(connectionServiceCheckSqlQueryMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getConnectionColumnTags: connect.NewClient[v1alpha1.GetConnectionColumnTagsRequest, v1alpha1.GetConnectionColumnTagsResponse](
+			httpClient,
+			baseURL+ConnectionServiceGetConnectionColumnTagsProcedure,
+			connect.WithSchema(connectionServiceGetConnectionColumnTagsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setConnectionColumnTags: connect.NewClient[v1alpha1.SetConnectionColumnTagsRequest, v1alpha1.SetConnectionColumnTagsResponse](
+			httpClient,
+			baseURL+ConnectionServiceSetConnectionColumnTagsProcedure,
+			connect.WithSchema(connectionServiceSetConnectionColumnTagsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -159,12 +199,15 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 type connectionServiceClient struct {
 	getConnections            *connect.Client[v1alpha1.GetConnectionsRequest, v1alpha1.GetConnectionsResponse]
 	getConnection             *connect.Client[v1alpha1.GetConnectionRequest, v1alpha1.GetConnectionResponse]
+	getConnectionUnmasked     *connect.Client[v1alpha1.GetConnectionUnmaskedRequest, v1alpha1.GetConnectionUnmaskedResponse]
 	createConnection          *connect.Client[v1alpha1.CreateConnectionRequest, v1alpha1.CreateConnectionResponse]
 	updateConnection          *connect.Client[v1alpha1.UpdateConnectionRequest, v1alpha1.UpdateConnectionResponse]
 	deleteConnection          *connect.Client[v1alpha1.DeleteConnectionRequest, v1alpha1.DeleteConnectionResponse]
 	isConnectionNameAvailable *connect.Client[v1alpha1.IsConnectionNameAvailableRequest, v1alpha1.IsConnectionNameAvailableResponse]
 	checkConnectionConfig     *connect.Client[v1alpha1.CheckConnectionConfigRequest, v1alpha1.CheckConnectionConfigResponse]
 	checkSqlQuery             *connect.Client[v1alpha1.CheckSqlQueryRequest, v1alpha1.CheckSqlQueryResponse]
+	getConnectionColumnTags   *connect.Client[v1alpha1.GetConnectionColumnTagsRequest, v1alpha1.GetConnectionColumnTagsResponse]
+	setConnectionColumnTags   *connect.Client[v1alpha1.SetConnectionColumnTagsRequest, v1alpha1.SetConnectionColumnTagsResponse]
 }
 
 // GetConnections calls mgmt.v1alpha1.ConnectionService.GetConnections.
@@ -177,6 +220,11 @@ func (c *connectionServiceClient) GetConnection(ctx context.Context, req *connec
 	return c.getConnection.CallUnary(ctx, req)
 }
 
+// GetConnectionUnmasked calls mgmt.v1alpha1.ConnectionService.GetConnectionUnmasked.
+func (c *connectionServiceClient) GetConnectionUnmasked(ctx context.Context, req *connect.Request[v1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[v1alpha1.GetConnectionUnmaskedResponse], error) {
+	return c.getConnectionUnmasked.CallUnary(ctx, req)
+}
+
 // CreateConnection calls mgmt.v1alpha1.ConnectionService.CreateConnection.
 func (c *connectionServiceClient) CreateConnection(ctx context.Context, req *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error) {
 	return c.createConnection.CallUnary(ctx, req)
@@ -207,15 +255,30 @@ func (c *connectionServiceClient) CheckSqlQuery(ctx context.Context, req *connec
 	return c.checkSqlQuery.CallUnary(ctx, req)
 }
 
+// GetConnectionColumnTags calls mgmt.v1alpha1.ConnectionService.GetConnectionColumnTags.
+func (c *connectionServiceClient) GetConnectionColumnTags(ctx context.Context, req *connect.Request[v1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.GetConnectionColumnTagsResponse], error) {
+	return c.getConnectionColumnTags.CallUnary(ctx, req)
+}
+
+// SetConnectionColumnTags calls mgmt.v1alpha1.ConnectionService.SetConnectionColumnTags.
+func (c *connectionServiceClient) SetConnectionColumnTags(ctx context.Context, req *connect.Request[v1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.SetConnectionColumnTagsResponse], error) {
+	return c.setConnectionColumnTags.CallUnary(ctx, req)
+}
+
 // ConnectionServiceHandler is an implementation of the mgmt.v1alpha1.ConnectionService service.
 type ConnectionServiceHandler interface {
 	// Returns a list of connections associated with the account
 	GetConnections(context.Context, *connect.Request[v1alpha1.GetConnectionsRequest]) (*connect.Response[v1alpha1.GetConnectionsResponse], error)
-	// Returns a single connection
+	// Returns a single connection.
+	// Secret fields (passwords, private keys, access keys, connection urls) are masked in the responses of every method except GetConnectionUnmasked.
 	GetConnection(context.Context, *connect.Request[v1alpha1.GetConnectionRequest]) (*connect.Response[v1alpha1.GetConnectionResponse], error)
+	// Returns a single connection including its secrets.
+	// Intended for the worker and other clients that must connect to the underlying system, this requires the connections:secrets api key scope.
+	GetConnectionUnmasked(context.Context, *connect.Request[v1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[v1alpha1.GetConnectionUnmaskedResponse], error)
 	// Creates a new connection
 	CreateConnection(context.Context, *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error)
-	// Updates an existing connection
+	// Updates an existing connection.
+	// Secret fields that are sent back with the masked placeholder keep their stored value.
 	UpdateConnection(context.Context, *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error)
 	// Removes a connection from the system.
 	DeleteConnection(context.Context, *connect.Request[v1alpha1.DeleteConnectionRequest]) (*connect.Response[v1alpha1.DeleteConnectionResponse], error)
@@ -227,6 +290,11 @@ type ConnectionServiceHandler interface {
 	// Checks a constructed SQL query against a sql-based connection to see if it's valid based on that connection's data schema
 	// This is useful when constructing subsets to see if the WHERE clause is correct
 	CheckSqlQuery(context.Context, *connect.Request[v1alpha1.CheckSqlQueryRequest]) (*connect.Response[v1alpha1.CheckSqlQueryResponse], error)
+	// Returns the classification tags of the columns of a connection
+	GetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.GetConnectionColumnTagsResponse], error)
+	// Sets the classification tags of columns of a connection.
+	// Tags may be used by job mapping policies, so this is restricted to account admins.
+	SetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.SetConnectionColumnTagsResponse], error)
 }
 
 // NewConnectionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -247,6 +315,12 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 		connect.WithSchema(connectionServiceGetConnectionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionServiceGetConnectionUnmaskedHandler := connect.NewUnaryHandler(
+		ConnectionServiceGetConnectionUnmaskedProcedure,
+		svc.GetConnectionUnmasked,
+		connect.WithSchema(connectionServiceGetConnectionUnmaskedMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	connectionServiceCreateConnectionHandler := connect.NewUnaryHandler(
 		ConnectionServiceCreateConnectionProcedure,
 		svc.CreateConnection,
@@ -283,12 +357,26 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 		connect.WithSchema(connectionServiceCheckSqlQueryMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionServiceGetConnectionColumnTagsHandler := connect.NewUnaryHandler(
+		ConnectionServiceGetConnectionColumnTagsProcedure,
+		svc.GetConnectionColumnTags,
+		connect.WithSchema(connectionServiceGetConnectionColumnTagsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceSetConnectionColumnTagsHandler := connect.NewUnaryHandler(
+		ConnectionServiceSetConnectionColumnTagsProcedure,
+		svc.SetConnectionColumnTags,
+		connect.WithSchema(connectionServiceSetConnectionColumnTagsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.ConnectionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConnectionServiceGetConnectionsProcedure:
 			connectionServiceGetConnectionsHandler.ServeHTTP(w, r)
 		case ConnectionServiceGetConnectionProcedure:
 			connectionServiceGetConnectionHandler.ServeHTTP(w, r)
+		case ConnectionServiceGetConnectionUnmaskedProcedure:
+			connectionServiceGetConnectionUnmaskedHandler.ServeHTTP(w, r)
 		case ConnectionServiceCreateConnectionProcedure:
 			connectionServiceCreateConnectionHandler.ServeHTTP(w, r)
 		case ConnectionServiceUpdateConnectionProcedure:
@@ -301,6 +389,10 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 			connectionServiceCheckConnectionConfigHandler.ServeHTTP(w, r)
 		case ConnectionServiceCheckSqlQueryProcedure:
 			connectionServiceCheckSqlQueryHandler.ServeHTTP(w, r)
+		case ConnectionServiceGetConnectionColumnTagsProcedure:
+			connectionServiceGetConnectionColumnTagsHandler.ServeHTTP(w, r)
+		case ConnectionServiceSetConnectionColumnTagsProcedure:
+			connectionServiceSetConnectionColumnTagsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -318,6 +410,10 @@ func (UnimplementedConnectionServiceHandler) GetConnection(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.GetConnection is not implemented"))
 }
 
+func (UnimplementedConnectionServiceHandler) GetConnectionUnmasked(context.Context, *connect.Request[v1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[v1alpha1.GetConnectionUnmaskedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.GetConnectionUnmasked is not implemented"))
+}
+
 func (UnimplementedConnectionServiceHandler) CreateConnection(context.Context, *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CreateConnection is not implemented"))
 }
@@ -341,3 +437,11 @@ func (UnimplementedConnectionServiceHandler) CheckConnectionConfig(context.Conte
 func (UnimplementedConnectionServiceHandler) CheckSqlQuery(context.Context, *connect.Request[v1alpha1.CheckSqlQueryRequest]) (*connect.Response[v1alpha1.CheckSqlQueryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CheckSqlQuery is not implemented"))
 }
+
+func (UnimplementedConnectionServiceHandler) GetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.GetConnectionColumnTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.GetConnectionColumnTags is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) SetConnectionColumnTags(context.Context, *connect.Request[v1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[v1alpha1.SetConnectionColumnTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.SetConnectionColumnTags is not implemented"))
+}
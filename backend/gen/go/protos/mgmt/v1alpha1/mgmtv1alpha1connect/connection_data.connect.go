@@ -66,6 +66,12 @@ const (
 	// ConnectionDataServiceGetTableRowCountProcedure is the fully-qualified name of the
 	// ConnectionDataService's GetTableRowCount RPC.
 	ConnectionDataServiceGetTableRowCountProcedure = "/mgmt.v1alpha1.ConnectionDataService/GetTableRowCount"
+	// ConnectionDataServiceCompareConnectionSchemasProcedure is the fully-qualified name of the
+	// ConnectionDataService's CompareConnectionSchemas RPC.
+	ConnectionDataServiceCompareConnectionSchemasProcedure = "/mgmt.v1alpha1.ConnectionDataService/CompareConnectionSchemas"
+	// ConnectionDataServiceScanConnectionForPiiProcedure is the fully-qualified name of the
+	// ConnectionDataService's ScanConnectionForPii RPC.
+	ConnectionDataServiceScanConnectionForPiiProcedure = "/mgmt.v1alpha1.ConnectionDataService/ScanConnectionForPii"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -82,6 +88,8 @@ var (
 	connectionDataServiceGetConnectionUniqueConstraintsMethodDescriptor  = connectionDataServiceServiceDescriptor.Methods().ByName("GetConnectionUniqueConstraints")
 	connectionDataServiceGetAiGeneratedDataMethodDescriptor              = connectionDataServiceServiceDescriptor.Methods().ByName("GetAiGeneratedData")
 	connectionDataServiceGetTableRowCountMethodDescriptor                = connectionDataServiceServiceDescriptor.Methods().ByName("GetTableRowCount")
+	connectionDataServiceCompareConnectionSchemasMethodDescriptor        = connectionDataServiceServiceDescriptor.Methods().ByName("CompareConnectionSchemas")
+	connectionDataServiceScanConnectionForPiiMethodDescriptor            = connectionDataServiceServiceDescriptor.Methods().ByName("ScanConnectionForPii")
 )
 
 // ConnectionDataServiceClient is a client for the mgmt.v1alpha1.ConnectionDataService service.
@@ -112,6 +120,12 @@ type ConnectionDataServiceClient interface {
 	GetAiGeneratedData(context.Context, *connect.Request[v1alpha1.GetAiGeneratedDataRequest]) (*connect.Response[v1alpha1.GetAiGeneratedDataResponse], error)
 	// Query table with subset to get row count
 	GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error)
+	// Compares the tables, columns, constraints and triggers of two connections. Mostly useful for SQL-based connections.
+	// Used primarily by the CLI connections diff command.
+	CompareConnectionSchemas(context.Context, *connect.Request[v1alpha1.CompareConnectionSchemasRequest]) (*connect.Response[v1alpha1.CompareConnectionSchemasResponse], error)
+	// Samples the rows of each table and classifies the columns that hold PII, with a recommended transformer for each column.
+	// Used to pre-populate job mappings so that columns holding PII are not passed through.
+	ScanConnectionForPii(context.Context, *connect.Request[v1alpha1.ScanConnectionForPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionForPiiResponse], error)
 }
 
 // NewConnectionDataServiceClient constructs a client for the mgmt.v1alpha1.ConnectionDataService
@@ -190,6 +204,18 @@ func NewConnectionDataServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(connectionDataServiceGetTableRowCountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		compareConnectionSchemas: connect.NewClient[v1alpha1.CompareConnectionSchemasRequest, v1alpha1.CompareConnectionSchemasResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceCompareConnectionSchemasProcedure,
+			connect.WithSchema(connectionDataServiceCompareConnectionSchemasMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		scanConnectionForPii: connect.NewClient[v1alpha1.ScanConnectionForPiiRequest, v1alpha1.ScanConnectionForPiiResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceScanConnectionForPiiProcedure,
+			connect.WithSchema(connectionDataServiceScanConnectionForPiiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -206,6 +232,8 @@ type connectionDataServiceClient struct {
 	getConnectionUniqueConstraints  *connect.Client[v1alpha1.GetConnectionUniqueConstraintsRequest, v1alpha1.GetConnectionUniqueConstraintsResponse]
 	getAiGeneratedData              *connect.Client[v1alpha1.GetAiGeneratedDataRequest, v1alpha1.GetAiGeneratedDataResponse]
 	getTableRowCount                *connect.Client[v1alpha1.GetTableRowCountRequest, v1alpha1.GetTableRowCountResponse]
+	compareConnectionSchemas        *connect.Client[v1alpha1.CompareConnectionSchemasRequest, v1alpha1.CompareConnectionSchemasResponse]
+	scanConnectionForPii            *connect.Client[v1alpha1.ScanConnectionForPiiRequest, v1alpha1.ScanConnectionForPiiResponse]
 }
 
 // GetConnectionDataStream calls mgmt.v1alpha1.ConnectionDataService.GetConnectionDataStream.
@@ -268,6 +296,16 @@ func (c *connectionDataServiceClient) GetTableRowCount(ctx context.Context, req
 	return c.getTableRowCount.CallUnary(ctx, req)
 }
 
+// CompareConnectionSchemas calls mgmt.v1alpha1.ConnectionDataService.CompareConnectionSchemas.
+func (c *connectionDataServiceClient) CompareConnectionSchemas(ctx context.Context, req *connect.Request[v1alpha1.CompareConnectionSchemasRequest]) (*connect.Response[v1alpha1.CompareConnectionSchemasResponse], error) {
+	return c.compareConnectionSchemas.CallUnary(ctx, req)
+}
+
+// ScanConnectionForPii calls mgmt.v1alpha1.ConnectionDataService.ScanConnectionForPii.
+func (c *connectionDataServiceClient) ScanConnectionForPii(ctx context.Context, req *connect.Request[v1alpha1.ScanConnectionForPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionForPiiResponse], error) {
+	return c.scanConnectionForPii.CallUnary(ctx, req)
+}
+
 // ConnectionDataServiceHandler is an implementation of the mgmt.v1alpha1.ConnectionDataService
 // service.
 type ConnectionDataServiceHandler interface {
@@ -297,6 +335,12 @@ type ConnectionDataServiceHandler interface {
 	GetAiGeneratedData(context.Context, *connect.Request[v1alpha1.GetAiGeneratedDataRequest]) (*connect.Response[v1alpha1.GetAiGeneratedDataResponse], error)
 	// Query table with subset to get row count
 	GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error)
+	// Compares the tables, columns, constraints and triggers of two connections. Mostly useful for SQL-based connections.
+	// Used primarily by the CLI connections diff command.
+	CompareConnectionSchemas(context.Context, *connect.Request[v1alpha1.CompareConnectionSchemasRequest]) (*connect.Response[v1alpha1.CompareConnectionSchemasResponse], error)
+	// Samples the rows of each table and classifies the columns that hold PII, with a recommended transformer for each column.
+	// Used to pre-populate job mappings so that columns holding PII are not passed through.
+	ScanConnectionForPii(context.Context, *connect.Request[v1alpha1.ScanConnectionForPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionForPiiResponse], error)
 }
 
 // NewConnectionDataServiceHandler builds an HTTP handler from the service implementation. It
@@ -371,6 +415,18 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 		connect.WithSchema(connectionDataServiceGetTableRowCountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionDataServiceCompareConnectionSchemasHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceCompareConnectionSchemasProcedure,
+		svc.CompareConnectionSchemas,
+		connect.WithSchema(connectionDataServiceCompareConnectionSchemasMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionDataServiceScanConnectionForPiiHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceScanConnectionForPiiProcedure,
+		svc.ScanConnectionForPii,
+		connect.WithSchema(connectionDataServiceScanConnectionForPiiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.ConnectionDataService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConnectionDataServiceGetConnectionDataStreamProcedure:
@@ -395,6 +451,10 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 			connectionDataServiceGetAiGeneratedDataHandler.ServeHTTP(w, r)
 		case ConnectionDataServiceGetTableRowCountProcedure:
 			connectionDataServiceGetTableRowCountHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceCompareConnectionSchemasProcedure:
+			connectionDataServiceCompareConnectionSchemasHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceScanConnectionForPiiProcedure:
+			connectionDataServiceScanConnectionForPiiHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -447,3 +507,11 @@ func (UnimplementedConnectionDataServiceHandler) GetAiGeneratedData(context.Cont
 func (UnimplementedConnectionDataServiceHandler) GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.GetTableRowCount is not implemented"))
 }
+
+func (UnimplementedConnectionDataServiceHandler) CompareConnectionSchemas(context.Context, *connect.Request[v1alpha1.CompareConnectionSchemasRequest]) (*connect.Response[v1alpha1.CompareConnectionSchemasResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.CompareConnectionSchemas is not implemented"))
+}
+
+func (UnimplementedConnectionDataServiceHandler) ScanConnectionForPii(context.Context, *connect.Request[v1alpha1.ScanConnectionForPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionForPiiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.ScanConnectionForPii is not implemented"))
+}
@@ -80,6 +80,8 @@ const (
 	// JobServiceGetJobRunEventsProcedure is the fully-qualified name of the JobService's
 	// GetJobRunEvents RPC.
 	JobServiceGetJobRunEventsProcedure = "/mgmt.v1alpha1.JobService/GetJobRunEvents"
+	// JobServiceWatchJobRunProcedure is the fully-qualified name of the JobService's WatchJobRun RPC.
+	JobServiceWatchJobRunProcedure = "/mgmt.v1alpha1.JobService/WatchJobRun"
 	// JobServiceGetJobRunProcedure is the fully-qualified name of the JobService's GetJobRun RPC.
 	JobServiceGetJobRunProcedure = "/mgmt.v1alpha1.JobService/GetJobRun"
 	// JobServiceDeleteJobRunProcedure is the fully-qualified name of the JobService's DeleteJobRun RPC.
@@ -94,6 +96,11 @@ const (
 	// JobServiceGetJobRunLogsStreamProcedure is the fully-qualified name of the JobService's
 	// GetJobRunLogsStream RPC.
 	JobServiceGetJobRunLogsStreamProcedure = "/mgmt.v1alpha1.JobService/GetJobRunLogsStream"
+	// JobServiceIngestJobRunLogsProcedure is the fully-qualified name of the JobService's
+	// IngestJobRunLogs RPC.
+	JobServiceIngestJobRunLogsProcedure = "/mgmt.v1alpha1.JobService/IngestJobRunLogs"
+	// JobServiceRecordJobRunProcedure is the fully-qualified name of the JobService's RecordJobRun RPC.
+	JobServiceRecordJobRunProcedure = "/mgmt.v1alpha1.JobService/RecordJobRun"
 	// JobServiceSetJobWorkflowOptionsProcedure is the fully-qualified name of the JobService's
 	// SetJobWorkflowOptions RPC.
 	JobServiceSetJobWorkflowOptionsProcedure = "/mgmt.v1alpha1.JobService/SetJobWorkflowOptions"
@@ -103,6 +110,12 @@ const (
 	// JobServiceValidateJobMappingsProcedure is the fully-qualified name of the JobService's
 	// ValidateJobMappings RPC.
 	JobServiceValidateJobMappingsProcedure = "/mgmt.v1alpha1.JobService/ValidateJobMappings"
+	// JobServiceGetJobMappingPolicyConfigProcedure is the fully-qualified name of the JobService's
+	// GetJobMappingPolicyConfig RPC.
+	JobServiceGetJobMappingPolicyConfigProcedure = "/mgmt.v1alpha1.JobService/GetJobMappingPolicyConfig"
+	// JobServiceSetJobMappingPolicyConfigProcedure is the fully-qualified name of the JobService's
+	// SetJobMappingPolicyConfig RPC.
+	JobServiceSetJobMappingPolicyConfigProcedure = "/mgmt.v1alpha1.JobService/SetJobMappingPolicyConfig"
 	// JobServiceGetRunContextProcedure is the fully-qualified name of the JobService's GetRunContext
 	// RPC.
 	JobServiceGetRunContextProcedure = "/mgmt.v1alpha1.JobService/GetRunContext"
@@ -135,15 +148,20 @@ var (
 	jobServiceGetJobStatusesMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("GetJobStatuses")
 	jobServiceGetJobRunsMethodDescriptor                       = jobServiceServiceDescriptor.Methods().ByName("GetJobRuns")
 	jobServiceGetJobRunEventsMethodDescriptor                  = jobServiceServiceDescriptor.Methods().ByName("GetJobRunEvents")
+	jobServiceWatchJobRunMethodDescriptor                      = jobServiceServiceDescriptor.Methods().ByName("WatchJobRun")
 	jobServiceGetJobRunMethodDescriptor                        = jobServiceServiceDescriptor.Methods().ByName("GetJobRun")
 	jobServiceDeleteJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("DeleteJobRun")
 	jobServiceCreateJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("CreateJobRun")
 	jobServiceCancelJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("CancelJobRun")
 	jobServiceTerminateJobRunMethodDescriptor                  = jobServiceServiceDescriptor.Methods().ByName("TerminateJobRun")
 	jobServiceGetJobRunLogsStreamMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("GetJobRunLogsStream")
+	jobServiceIngestJobRunLogsMethodDescriptor                 = jobServiceServiceDescriptor.Methods().ByName("IngestJobRunLogs")
+	jobServiceRecordJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("RecordJobRun")
 	jobServiceSetJobWorkflowOptionsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetJobWorkflowOptions")
 	jobServiceSetJobSyncOptionsMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("SetJobSyncOptions")
 	jobServiceValidateJobMappingsMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("ValidateJobMappings")
+	jobServiceGetJobMappingPolicyConfigMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("GetJobMappingPolicyConfig")
+	jobServiceSetJobMappingPolicyConfigMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("SetJobMappingPolicyConfig")
 	jobServiceGetRunContextMethodDescriptor                    = jobServiceServiceDescriptor.Methods().ByName("GetRunContext")
 	jobServiceSetRunContextMethodDescriptor                    = jobServiceServiceDescriptor.Methods().ByName("SetRunContext")
 	jobServiceSetRunContextsMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("SetRunContexts")
@@ -169,9 +187,11 @@ type JobServiceClient interface {
 	GetJobNextRuns(context.Context, *connect.Request[v1alpha1.GetJobNextRunsRequest]) (*connect.Response[v1alpha1.GetJobNextRunsResponse], error)
 	GetJobStatus(context.Context, *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error)
 	GetJobStatuses(context.Context, *connect.Request[v1alpha1.GetJobStatusesRequest]) (*connect.Response[v1alpha1.GetJobStatusesResponse], error)
-	// Returns a list of job runs by either account or job
+	// Returns a page of job runs by either account or job. Runs are read from the job run history stored in Neosync
 	GetJobRuns(context.Context, *connect.Request[v1alpha1.GetJobRunsRequest]) (*connect.Response[v1alpha1.GetJobRunsResponse], error)
 	GetJobRunEvents(context.Context, *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error)
+	// Streams the events and per table progress of a job run until the run completes
+	WatchJobRun(context.Context, *connect.Request[v1alpha1.WatchJobRunRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchJobRunResponse], error)
 	// Returns a specific job run, along with any of its pending activities
 	GetJobRun(context.Context, *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error)
 	DeleteJobRun(context.Context, *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error)
@@ -180,12 +200,20 @@ type JobServiceClient interface {
 	TerminateJobRun(context.Context, *connect.Request[v1alpha1.TerminateJobRunRequest]) (*connect.Response[v1alpha1.TerminateJobRunResponse], error)
 	// Returns a stream of logs from the worker nodes that pertain to a specific job run
 	GetJobRunLogsStream(context.Context, *connect.Request[v1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[v1alpha1.GetJobRunLogsStreamResponse], error)
+	// Stores logs that have been shipped by the worker for a specific job run. Only used when run logs are stored in the Neosync database.
+	IngestJobRunLogs(context.Context, *connect.Request[v1alpha1.IngestJobRunLogsRequest]) (*connect.Response[v1alpha1.IngestJobRunLogsResponse], error)
+	// Persists the status and outcome of a job run in the Neosync database so that run history outlives Temporal retention. Called by the worker.
+	RecordJobRun(context.Context, *connect.Request[v1alpha1.RecordJobRunRequest]) (*connect.Response[v1alpha1.RecordJobRunResponse], error)
 	// Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the account's job mapping policies
+	GetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.GetJobMappingPolicyConfigResponse], error)
+	// Sets the account's job mapping policies. Must provide the entire object as it will fully override the previous configuration
+	SetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.SetJobMappingPolicyConfigResponse], error)
 	// Gets a run context to be used by a workflow run
 	GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error)
 	// Sets a run context to be used by a workflow run
@@ -312,6 +340,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceGetJobRunEventsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		watchJobRun: connect.NewClient[v1alpha1.WatchJobRunRequest, v1alpha1.WatchJobRunResponse](
+			httpClient,
+			baseURL+JobServiceWatchJobRunProcedure,
+			connect.WithSchema(jobServiceWatchJobRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getJobRun: connect.NewClient[v1alpha1.GetJobRunRequest, v1alpha1.GetJobRunResponse](
 			httpClient,
 			baseURL+JobServiceGetJobRunProcedure,
@@ -348,6 +382,18 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceGetJobRunLogsStreamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		ingestJobRunLogs: connect.NewClient[v1alpha1.IngestJobRunLogsRequest, v1alpha1.IngestJobRunLogsResponse](
+			httpClient,
+			baseURL+JobServiceIngestJobRunLogsProcedure,
+			connect.WithSchema(jobServiceIngestJobRunLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		recordJobRun: connect.NewClient[v1alpha1.RecordJobRunRequest, v1alpha1.RecordJobRunResponse](
+			httpClient,
+			baseURL+JobServiceRecordJobRunProcedure,
+			connect.WithSchema(jobServiceRecordJobRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		setJobWorkflowOptions: connect.NewClient[v1alpha1.SetJobWorkflowOptionsRequest, v1alpha1.SetJobWorkflowOptionsResponse](
 			httpClient,
 			baseURL+JobServiceSetJobWorkflowOptionsProcedure,
@@ -366,6 +412,18 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJobMappingPolicyConfig: connect.NewClient[v1alpha1.GetJobMappingPolicyConfigRequest, v1alpha1.GetJobMappingPolicyConfigResponse](
+			httpClient,
+			baseURL+JobServiceGetJobMappingPolicyConfigProcedure,
+			connect.WithSchema(jobServiceGetJobMappingPolicyConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJobMappingPolicyConfig: connect.NewClient[v1alpha1.SetJobMappingPolicyConfigRequest, v1alpha1.SetJobMappingPolicyConfigResponse](
+			httpClient,
+			baseURL+JobServiceSetJobMappingPolicyConfigProcedure,
+			connect.WithSchema(jobServiceSetJobMappingPolicyConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getRunContext: connect.NewClient[v1alpha1.GetRunContextRequest, v1alpha1.GetRunContextResponse](
 			httpClient,
 			baseURL+JobServiceGetRunContextProcedure,
@@ -407,15 +465,20 @@ type jobServiceClient struct {
 	getJobStatuses                   *connect.Client[v1alpha1.GetJobStatusesRequest, v1alpha1.GetJobStatusesResponse]
 	getJobRuns                       *connect.Client[v1alpha1.GetJobRunsRequest, v1alpha1.GetJobRunsResponse]
 	getJobRunEvents                  *connect.Client[v1alpha1.GetJobRunEventsRequest, v1alpha1.GetJobRunEventsResponse]
+	watchJobRun                      *connect.Client[v1alpha1.WatchJobRunRequest, v1alpha1.WatchJobRunResponse]
 	getJobRun                        *connect.Client[v1alpha1.GetJobRunRequest, v1alpha1.GetJobRunResponse]
 	deleteJobRun                     *connect.Client[v1alpha1.DeleteJobRunRequest, v1alpha1.DeleteJobRunResponse]
 	createJobRun                     *connect.Client[v1alpha1.CreateJobRunRequest, v1alpha1.CreateJobRunResponse]
 	cancelJobRun                     *connect.Client[v1alpha1.CancelJobRunRequest, v1alpha1.CancelJobRunResponse]
 	terminateJobRun                  *connect.Client[v1alpha1.TerminateJobRunRequest, v1alpha1.TerminateJobRunResponse]
 	getJobRunLogsStream              *connect.Client[v1alpha1.GetJobRunLogsStreamRequest, v1alpha1.GetJobRunLogsStreamResponse]
+	ingestJobRunLogs                 *connect.Client[v1alpha1.IngestJobRunLogsRequest, v1alpha1.IngestJobRunLogsResponse]
+	recordJobRun                     *connect.Client[v1alpha1.RecordJobRunRequest, v1alpha1.RecordJobRunResponse]
 	setJobWorkflowOptions            *connect.Client[v1alpha1.SetJobWorkflowOptionsRequest, v1alpha1.SetJobWorkflowOptionsResponse]
 	setJobSyncOptions                *connect.Client[v1alpha1.SetJobSyncOptionsRequest, v1alpha1.SetJobSyncOptionsResponse]
 	validateJobMappings              *connect.Client[v1alpha1.ValidateJobMappingsRequest, v1alpha1.ValidateJobMappingsResponse]
+	getJobMappingPolicyConfig        *connect.Client[v1alpha1.GetJobMappingPolicyConfigRequest, v1alpha1.GetJobMappingPolicyConfigResponse]
+	setJobMappingPolicyConfig        *connect.Client[v1alpha1.SetJobMappingPolicyConfigRequest, v1alpha1.SetJobMappingPolicyConfigResponse]
 	getRunContext                    *connect.Client[v1alpha1.GetRunContextRequest, v1alpha1.GetRunContextResponse]
 	setRunContext                    *connect.Client[v1alpha1.SetRunContextRequest, v1alpha1.SetRunContextResponse]
 	setRunContexts                   *connect.Client[v1alpha1.SetRunContextsRequest, v1alpha1.SetRunContextsResponse]
@@ -511,6 +574,11 @@ func (c *jobServiceClient) GetJobRunEvents(ctx context.Context, req *connect.Req
 	return c.getJobRunEvents.CallUnary(ctx, req)
 }
 
+// WatchJobRun calls mgmt.v1alpha1.JobService.WatchJobRun.
+func (c *jobServiceClient) WatchJobRun(ctx context.Context, req *connect.Request[v1alpha1.WatchJobRunRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchJobRunResponse], error) {
+	return c.watchJobRun.CallServerStream(ctx, req)
+}
+
 // GetJobRun calls mgmt.v1alpha1.JobService.GetJobRun.
 func (c *jobServiceClient) GetJobRun(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error) {
 	return c.getJobRun.CallUnary(ctx, req)
@@ -541,6 +609,16 @@ func (c *jobServiceClient) GetJobRunLogsStream(ctx context.Context, req *connect
 	return c.getJobRunLogsStream.CallServerStream(ctx, req)
 }
 
+// IngestJobRunLogs calls mgmt.v1alpha1.JobService.IngestJobRunLogs.
+func (c *jobServiceClient) IngestJobRunLogs(ctx context.Context, req *connect.Request[v1alpha1.IngestJobRunLogsRequest]) (*connect.Response[v1alpha1.IngestJobRunLogsResponse], error) {
+	return c.ingestJobRunLogs.CallUnary(ctx, req)
+}
+
+// RecordJobRun calls mgmt.v1alpha1.JobService.RecordJobRun.
+func (c *jobServiceClient) RecordJobRun(ctx context.Context, req *connect.Request[v1alpha1.RecordJobRunRequest]) (*connect.Response[v1alpha1.RecordJobRunResponse], error) {
+	return c.recordJobRun.CallUnary(ctx, req)
+}
+
 // SetJobWorkflowOptions calls mgmt.v1alpha1.JobService.SetJobWorkflowOptions.
 func (c *jobServiceClient) SetJobWorkflowOptions(ctx context.Context, req *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error) {
 	return c.setJobWorkflowOptions.CallUnary(ctx, req)
@@ -556,6 +634,16 @@ func (c *jobServiceClient) ValidateJobMappings(ctx context.Context, req *connect
 	return c.validateJobMappings.CallUnary(ctx, req)
 }
 
+// GetJobMappingPolicyConfig calls mgmt.v1alpha1.JobService.GetJobMappingPolicyConfig.
+func (c *jobServiceClient) GetJobMappingPolicyConfig(ctx context.Context, req *connect.Request[v1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.GetJobMappingPolicyConfigResponse], error) {
+	return c.getJobMappingPolicyConfig.CallUnary(ctx, req)
+}
+
+// SetJobMappingPolicyConfig calls mgmt.v1alpha1.JobService.SetJobMappingPolicyConfig.
+func (c *jobServiceClient) SetJobMappingPolicyConfig(ctx context.Context, req *connect.Request[v1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.SetJobMappingPolicyConfigResponse], error) {
+	return c.setJobMappingPolicyConfig.CallUnary(ctx, req)
+}
+
 // GetRunContext calls mgmt.v1alpha1.JobService.GetRunContext.
 func (c *jobServiceClient) GetRunContext(ctx context.Context, req *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error) {
 	return c.getRunContext.CallUnary(ctx, req)
@@ -591,9 +679,11 @@ type JobServiceHandler interface {
 	GetJobNextRuns(context.Context, *connect.Request[v1alpha1.GetJobNextRunsRequest]) (*connect.Response[v1alpha1.GetJobNextRunsResponse], error)
 	GetJobStatus(context.Context, *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error)
 	GetJobStatuses(context.Context, *connect.Request[v1alpha1.GetJobStatusesRequest]) (*connect.Response[v1alpha1.GetJobStatusesResponse], error)
-	// Returns a list of job runs by either account or job
+	// Returns a page of job runs by either account or job. Runs are read from the job run history stored in Neosync
 	GetJobRuns(context.Context, *connect.Request[v1alpha1.GetJobRunsRequest]) (*connect.Response[v1alpha1.GetJobRunsResponse], error)
 	GetJobRunEvents(context.Context, *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error)
+	// Streams the events and per table progress of a job run until the run completes
+	WatchJobRun(context.Context, *connect.Request[v1alpha1.WatchJobRunRequest], *connect.ServerStream[v1alpha1.WatchJobRunResponse]) error
 	// Returns a specific job run, along with any of its pending activities
 	GetJobRun(context.Context, *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error)
 	DeleteJobRun(context.Context, *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error)
@@ -602,12 +692,20 @@ type JobServiceHandler interface {
 	TerminateJobRun(context.Context, *connect.Request[v1alpha1.TerminateJobRunRequest]) (*connect.Response[v1alpha1.TerminateJobRunResponse], error)
 	// Returns a stream of logs from the worker nodes that pertain to a specific job run
 	GetJobRunLogsStream(context.Context, *connect.Request[v1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[v1alpha1.GetJobRunLogsStreamResponse]) error
+	// Stores logs that have been shipped by the worker for a specific job run. Only used when run logs are stored in the Neosync database.
+	IngestJobRunLogs(context.Context, *connect.Request[v1alpha1.IngestJobRunLogsRequest]) (*connect.Response[v1alpha1.IngestJobRunLogsResponse], error)
+	// Persists the status and outcome of a job run in the Neosync database so that run history outlives Temporal retention. Called by the worker.
+	RecordJobRun(context.Context, *connect.Request[v1alpha1.RecordJobRunRequest]) (*connect.Response[v1alpha1.RecordJobRunResponse], error)
 	// Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the account's job mapping policies
+	GetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.GetJobMappingPolicyConfigResponse], error)
+	// Sets the account's job mapping policies. Must provide the entire object as it will fully override the previous configuration
+	SetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.SetJobMappingPolicyConfigResponse], error)
 	// Gets a run context to be used by a workflow run
 	GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error)
 	// Sets a run context to be used by a workflow run
@@ -730,6 +828,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceGetJobRunEventsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceWatchJobRunHandler := connect.NewServerStreamHandler(
+		JobServiceWatchJobRunProcedure,
+		svc.WatchJobRun,
+		connect.WithSchema(jobServiceWatchJobRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetJobRunHandler := connect.NewUnaryHandler(
 		JobServiceGetJobRunProcedure,
 		svc.GetJobRun,
@@ -766,6 +870,18 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceGetJobRunLogsStreamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceIngestJobRunLogsHandler := connect.NewUnaryHandler(
+		JobServiceIngestJobRunLogsProcedure,
+		svc.IngestJobRunLogs,
+		connect.WithSchema(jobServiceIngestJobRunLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceRecordJobRunHandler := connect.NewUnaryHandler(
+		JobServiceRecordJobRunProcedure,
+		svc.RecordJobRun,
+		connect.WithSchema(jobServiceRecordJobRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceSetJobWorkflowOptionsHandler := connect.NewUnaryHandler(
 		JobServiceSetJobWorkflowOptionsProcedure,
 		svc.SetJobWorkflowOptions,
@@ -784,6 +900,18 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetJobMappingPolicyConfigHandler := connect.NewUnaryHandler(
+		JobServiceGetJobMappingPolicyConfigProcedure,
+		svc.GetJobMappingPolicyConfig,
+		connect.WithSchema(jobServiceGetJobMappingPolicyConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetJobMappingPolicyConfigHandler := connect.NewUnaryHandler(
+		JobServiceSetJobMappingPolicyConfigProcedure,
+		svc.SetJobMappingPolicyConfig,
+		connect.WithSchema(jobServiceSetJobMappingPolicyConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetRunContextHandler := connect.NewUnaryHandler(
 		JobServiceGetRunContextProcedure,
 		svc.GetRunContext,
@@ -840,6 +968,8 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceGetJobRunsHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunEventsProcedure:
 			jobServiceGetJobRunEventsHandler.ServeHTTP(w, r)
+		case JobServiceWatchJobRunProcedure:
+			jobServiceWatchJobRunHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunProcedure:
 			jobServiceGetJobRunHandler.ServeHTTP(w, r)
 		case JobServiceDeleteJobRunProcedure:
@@ -852,12 +982,20 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceTerminateJobRunHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunLogsStreamProcedure:
 			jobServiceGetJobRunLogsStreamHandler.ServeHTTP(w, r)
+		case JobServiceIngestJobRunLogsProcedure:
+			jobServiceIngestJobRunLogsHandler.ServeHTTP(w, r)
+		case JobServiceRecordJobRunProcedure:
+			jobServiceRecordJobRunHandler.ServeHTTP(w, r)
 		case JobServiceSetJobWorkflowOptionsProcedure:
 			jobServiceSetJobWorkflowOptionsHandler.ServeHTTP(w, r)
 		case JobServiceSetJobSyncOptionsProcedure:
 			jobServiceSetJobSyncOptionsHandler.ServeHTTP(w, r)
 		case JobServiceValidateJobMappingsProcedure:
 			jobServiceValidateJobMappingsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobMappingPolicyConfigProcedure:
+			jobServiceGetJobMappingPolicyConfigHandler.ServeHTTP(w, r)
+		case JobServiceSetJobMappingPolicyConfigProcedure:
+			jobServiceSetJobMappingPolicyConfigHandler.ServeHTTP(w, r)
 		case JobServiceGetRunContextProcedure:
 			jobServiceGetRunContextHandler.ServeHTTP(w, r)
 		case JobServiceSetRunContextProcedure:
@@ -945,6 +1083,10 @@ func (UnimplementedJobServiceHandler) GetJobRunEvents(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunEvents is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) WatchJobRun(context.Context, *connect.Request[v1alpha1.WatchJobRunRequest], *connect.ServerStream[v1alpha1.WatchJobRunResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.WatchJobRun is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetJobRun(context.Context, *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRun is not implemented"))
 }
@@ -969,6 +1111,14 @@ func (UnimplementedJobServiceHandler) GetJobRunLogsStream(context.Context, *conn
 	return connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunLogsStream is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) IngestJobRunLogs(context.Context, *connect.Request[v1alpha1.IngestJobRunLogsRequest]) (*connect.Response[v1alpha1.IngestJobRunLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.IngestJobRunLogs is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) RecordJobRun(context.Context, *connect.Request[v1alpha1.RecordJobRunRequest]) (*connect.Response[v1alpha1.RecordJobRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.RecordJobRun is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobWorkflowOptions is not implemented"))
 }
@@ -981,6 +1131,14 @@ func (UnimplementedJobServiceHandler) ValidateJobMappings(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ValidateJobMappings is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) GetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.GetJobMappingPolicyConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobMappingPolicyConfig is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetJobMappingPolicyConfig(context.Context, *connect.Request[v1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[v1alpha1.SetJobMappingPolicyConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobMappingPolicyConfig is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetRunContext is not implemented"))
 }
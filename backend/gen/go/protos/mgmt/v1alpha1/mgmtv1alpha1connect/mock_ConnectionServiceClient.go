@@ -319,6 +319,124 @@ func (_c *MockConnectionServiceClient_GetConnection_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetConnectionColumnTags provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) GetConnectionColumnTags(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionColumnTags")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_GetConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionColumnTags'
+type MockConnectionServiceClient_GetConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// GetConnectionColumnTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]
+func (_e *MockConnectionServiceClient_Expecter) GetConnectionColumnTags(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_GetConnectionColumnTags_Call {
+	return &MockConnectionServiceClient_GetConnectionColumnTags_Call{Call: _e.mock.On("GetConnectionColumnTags", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionColumnTags_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest])) *MockConnectionServiceClient_GetConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionColumnTags_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], _a1 error) *MockConnectionServiceClient_GetConnectionColumnTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionColumnTags_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error)) *MockConnectionServiceClient_GetConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionUnmasked provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) GetConnectionUnmasked(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionUnmasked")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_GetConnectionUnmasked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionUnmasked'
+type MockConnectionServiceClient_GetConnectionUnmasked_Call struct {
+	*mock.Call
+}
+
+// GetConnectionUnmasked is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]
+func (_e *MockConnectionServiceClient_Expecter) GetConnectionUnmasked(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_GetConnectionUnmasked_Call {
+	return &MockConnectionServiceClient_GetConnectionUnmasked_Call{Call: _e.mock.On("GetConnectionUnmasked", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionUnmasked_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest])) *MockConnectionServiceClient_GetConnectionUnmasked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionUnmasked_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], _a1 error) *MockConnectionServiceClient_GetConnectionUnmasked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionUnmasked_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error)) *MockConnectionServiceClient_GetConnectionUnmasked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnections provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) GetConnections(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -437,6 +555,65 @@ func (_c *MockConnectionServiceClient_IsConnectionNameAvailable_Call) RunAndRetu
 	return _c
 }
 
+// SetConnectionColumnTags provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) SetConnectionColumnTags(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConnectionColumnTags")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_SetConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConnectionColumnTags'
+type MockConnectionServiceClient_SetConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// SetConnectionColumnTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]
+func (_e *MockConnectionServiceClient_Expecter) SetConnectionColumnTags(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_SetConnectionColumnTags_Call {
+	return &MockConnectionServiceClient_SetConnectionColumnTags_Call{Call: _e.mock.On("SetConnectionColumnTags", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionColumnTags_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest])) *MockConnectionServiceClient_SetConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionColumnTags_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], _a1 error) *MockConnectionServiceClient_SetConnectionColumnTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionColumnTags_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error)) *MockConnectionServiceClient_SetConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) UpdateConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -299,6 +299,61 @@ func (_c *MockConnectionServiceHandler_GetConnection_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetConnectionColumnTags provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceHandler) GetConnectionColumnTags(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceHandler_GetConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionColumnTags'
+type MockConnectionServiceHandler_GetConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// GetConnectionColumnTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]
+func (_e *MockConnectionServiceHandler_Expecter) GetConnectionColumnTags(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_GetConnectionColumnTags_Call {
+	return &MockConnectionServiceHandler_GetConnectionColumnTags_Call{Call: _e.mock.On("GetConnectionColumnTags", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionColumnTags_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest])) *MockConnectionServiceHandler_GetConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionColumnTags_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], _a1 error) *MockConnectionServiceHandler_GetConnectionColumnTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionColumnTags_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error)) *MockConnectionServiceHandler_GetConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionDataStream provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockConnectionServiceHandler) GetConnectionDataStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -508,6 +563,61 @@ func (_c *MockConnectionServiceHandler_GetConnectionSchema_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetConnectionUnmasked provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceHandler) GetConnectionUnmasked(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceHandler_GetConnectionUnmasked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionUnmasked'
+type MockConnectionServiceHandler_GetConnectionUnmasked_Call struct {
+	*mock.Call
+}
+
+// GetConnectionUnmasked is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]
+func (_e *MockConnectionServiceHandler_Expecter) GetConnectionUnmasked(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_GetConnectionUnmasked_Call {
+	return &MockConnectionServiceHandler_GetConnectionUnmasked_Call{Call: _e.mock.On("GetConnectionUnmasked", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionUnmasked_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest])) *MockConnectionServiceHandler_GetConnectionUnmasked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionUnmasked_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], _a1 error) *MockConnectionServiceHandler_GetConnectionUnmasked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_GetConnectionUnmasked_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error)) *MockConnectionServiceHandler_GetConnectionUnmasked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnections provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceHandler) GetConnections(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -618,6 +728,61 @@ func (_c *MockConnectionServiceHandler_IsConnectionNameAvailable_Call) RunAndRet
 	return _c
 }
 
+// SetConnectionColumnTags provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceHandler) SetConnectionColumnTags(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceHandler_SetConnectionColumnTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConnectionColumnTags'
+type MockConnectionServiceHandler_SetConnectionColumnTags_Call struct {
+	*mock.Call
+}
+
+// SetConnectionColumnTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]
+func (_e *MockConnectionServiceHandler_Expecter) SetConnectionColumnTags(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_SetConnectionColumnTags_Call {
+	return &MockConnectionServiceHandler_SetConnectionColumnTags_Call{Call: _e.mock.On("SetConnectionColumnTags", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceHandler_SetConnectionColumnTags_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest])) *MockConnectionServiceHandler_SetConnectionColumnTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_SetConnectionColumnTags_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], _a1 error) *MockConnectionServiceHandler_SetConnectionColumnTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceHandler_SetConnectionColumnTags_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error)) *MockConnectionServiceHandler_SetConnectionColumnTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceHandler) UpdateConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
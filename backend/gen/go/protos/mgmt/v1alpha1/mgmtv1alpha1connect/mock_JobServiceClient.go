@@ -496,6 +496,65 @@ func (_c *MockJobServiceClient_GetJob_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetJobMappingPolicyConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobMappingPolicyConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobMappingPolicyConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobMappingPolicyConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobMappingPolicyConfig'
+type MockJobServiceClient_GetJobMappingPolicyConfig_Call struct {
+	*mock.Call
+}
+
+// GetJobMappingPolicyConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobMappingPolicyConfig(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobMappingPolicyConfig_Call {
+	return &MockJobServiceClient_GetJobMappingPolicyConfig_Call{Call: _e.mock.On("GetJobMappingPolicyConfig", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobMappingPolicyConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest])) *MockJobServiceClient_GetJobMappingPolicyConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobMappingPolicyConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], _a1 error) *MockJobServiceClient_GetJobMappingPolicyConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobMappingPolicyConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error)) *MockJobServiceClient_GetJobMappingPolicyConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobNextRuns provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1086,6 +1145,65 @@ func (_c *MockJobServiceClient_GetRunContext_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// IngestJobRunLogs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) IngestJobRunLogs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IngestJobRunLogs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_IngestJobRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestJobRunLogs'
+type MockJobServiceClient_IngestJobRunLogs_Call struct {
+	*mock.Call
+}
+
+// IngestJobRunLogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]
+func (_e *MockJobServiceClient_Expecter) IngestJobRunLogs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_IngestJobRunLogs_Call {
+	return &MockJobServiceClient_IngestJobRunLogs_Call{Call: _e.mock.On("IngestJobRunLogs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_IngestJobRunLogs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest])) *MockJobServiceClient_IngestJobRunLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_IngestJobRunLogs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], _a1 error) *MockJobServiceClient_IngestJobRunLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_IngestJobRunLogs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error)) *MockJobServiceClient_IngestJobRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsJobNameAvailable provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1204,6 +1322,124 @@ func (_c *MockJobServiceClient_PauseJob_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// RecordJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) RecordJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordJobRun")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.RecordJobRunResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) *connect.Response[mgmtv1alpha1.RecordJobRunResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.RecordJobRunResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_RecordJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordJobRun'
+type MockJobServiceClient_RecordJobRun_Call struct {
+	*mock.Call
+}
+
+// RecordJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest]
+func (_e *MockJobServiceClient_Expecter) RecordJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_RecordJobRun_Call {
+	return &MockJobServiceClient_RecordJobRun_Call{Call: _e.mock.On("RecordJobRun", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_RecordJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest])) *MockJobServiceClient_RecordJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.RecordJobRunRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_RecordJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.RecordJobRunResponse], _a1 error) *MockJobServiceClient_RecordJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_RecordJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error)) *MockJobServiceClient_RecordJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobMappingPolicyConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobMappingPolicyConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobMappingPolicyConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobMappingPolicyConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobMappingPolicyConfig'
+type MockJobServiceClient_SetJobMappingPolicyConfig_Call struct {
+	*mock.Call
+}
+
+// SetJobMappingPolicyConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobMappingPolicyConfig(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobMappingPolicyConfig_Call {
+	return &MockJobServiceClient_SetJobMappingPolicyConfig_Call{Call: _e.mock.On("SetJobMappingPolicyConfig", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobMappingPolicyConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest])) *MockJobServiceClient_SetJobMappingPolicyConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobMappingPolicyConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], _a1 error) *MockJobServiceClient_SetJobMappingPolicyConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobMappingPolicyConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error)) *MockJobServiceClient_SetJobMappingPolicyConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1783,6 +2019,65 @@ func (_c *MockJobServiceClient_ValidateJobMappings_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// WatchJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) WatchJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchJobRun")
+	}
+
+	var r0 *connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest]) *connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_WatchJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchJobRun'
+type MockJobServiceClient_WatchJobRun_Call struct {
+	*mock.Call
+}
+
+// WatchJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest]
+func (_e *MockJobServiceClient_Expecter) WatchJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_WatchJobRun_Call {
+	return &MockJobServiceClient_WatchJobRun_Call{Call: _e.mock.On("WatchJobRun", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_WatchJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest])) *MockJobServiceClient_WatchJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.WatchJobRunRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_WatchJobRun_Call) Return(_a0 *connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse], _a1 error) *MockJobServiceClient_WatchJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_WatchJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.WatchJobRunResponse], error)) *MockJobServiceClient_WatchJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockJobServiceClient creates a new instance of MockJobServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockJobServiceClient(t interface {
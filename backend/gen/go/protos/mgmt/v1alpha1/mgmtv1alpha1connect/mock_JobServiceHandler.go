@@ -496,6 +496,65 @@ func (_c *MockJobServiceHandler_GetJob_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetJobMappingPolicyConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobMappingPolicyConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobMappingPolicyConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobMappingPolicyConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobMappingPolicyConfig'
+type MockJobServiceHandler_GetJobMappingPolicyConfig_Call struct {
+	*mock.Call
+}
+
+// GetJobMappingPolicyConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobMappingPolicyConfig(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobMappingPolicyConfig_Call {
+	return &MockJobServiceHandler_GetJobMappingPolicyConfig_Call{Call: _e.mock.On("GetJobMappingPolicyConfig", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobMappingPolicyConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest])) *MockJobServiceHandler_GetJobMappingPolicyConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobMappingPolicyConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], _a1 error) *MockJobServiceHandler_GetJobMappingPolicyConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobMappingPolicyConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error)) *MockJobServiceHandler_GetJobMappingPolicyConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobNextRuns provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1075,6 +1134,65 @@ func (_c *MockJobServiceHandler_GetRunContext_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// IngestJobRunLogs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) IngestJobRunLogs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IngestJobRunLogs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_IngestJobRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestJobRunLogs'
+type MockJobServiceHandler_IngestJobRunLogs_Call struct {
+	*mock.Call
+}
+
+// IngestJobRunLogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]
+func (_e *MockJobServiceHandler_Expecter) IngestJobRunLogs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_IngestJobRunLogs_Call {
+	return &MockJobServiceHandler_IngestJobRunLogs_Call{Call: _e.mock.On("IngestJobRunLogs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_IngestJobRunLogs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest])) *MockJobServiceHandler_IngestJobRunLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_IngestJobRunLogs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], _a1 error) *MockJobServiceHandler_IngestJobRunLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_IngestJobRunLogs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error)) *MockJobServiceHandler_IngestJobRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsJobNameAvailable provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1193,6 +1311,124 @@ func (_c *MockJobServiceHandler_PauseJob_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RecordJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) RecordJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordJobRun")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.RecordJobRunResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) *connect.Response[mgmtv1alpha1.RecordJobRunResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.RecordJobRunResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_RecordJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordJobRun'
+type MockJobServiceHandler_RecordJobRun_Call struct {
+	*mock.Call
+}
+
+// RecordJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest]
+func (_e *MockJobServiceHandler_Expecter) RecordJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_RecordJobRun_Call {
+	return &MockJobServiceHandler_RecordJobRun_Call{Call: _e.mock.On("RecordJobRun", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_RecordJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RecordJobRunRequest])) *MockJobServiceHandler_RecordJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.RecordJobRunRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_RecordJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.RecordJobRunResponse], _a1 error) *MockJobServiceHandler_RecordJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_RecordJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error)) *MockJobServiceHandler_RecordJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobMappingPolicyConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobMappingPolicyConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobMappingPolicyConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobMappingPolicyConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobMappingPolicyConfig'
+type MockJobServiceHandler_SetJobMappingPolicyConfig_Call struct {
+	*mock.Call
+}
+
+// SetJobMappingPolicyConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobMappingPolicyConfig(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobMappingPolicyConfig_Call {
+	return &MockJobServiceHandler_SetJobMappingPolicyConfig_Call{Call: _e.mock.On("SetJobMappingPolicyConfig", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobMappingPolicyConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest])) *MockJobServiceHandler_SetJobMappingPolicyConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobMappingPolicyConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], _a1 error) *MockJobServiceHandler_SetJobMappingPolicyConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobMappingPolicyConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error)) *MockJobServiceHandler_SetJobMappingPolicyConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1783,6 +2019,54 @@ func (_c *MockJobServiceHandler_ValidateJobMappings_Call) RunAndReturn(run func(
 	return _c
 }
 
+// WatchJobRun provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockJobServiceHandler) WatchJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest], _a2 *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse]) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchJobRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest], *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse]) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJobServiceHandler_WatchJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchJobRun'
+type MockJobServiceHandler_WatchJobRun_Call struct {
+	*mock.Call
+}
+
+// WatchJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest]
+//   - _a2 *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse]
+func (_e *MockJobServiceHandler_Expecter) WatchJobRun(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockJobServiceHandler_WatchJobRun_Call {
+	return &MockJobServiceHandler_WatchJobRun_Call{Call: _e.mock.On("WatchJobRun", _a0, _a1, _a2)}
+}
+
+func (_c *MockJobServiceHandler_WatchJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.WatchJobRunRequest], _a2 *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse])) *MockJobServiceHandler_WatchJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.WatchJobRunRequest]), args[2].(*connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_WatchJobRun_Call) Return(_a0 error) *MockJobServiceHandler_WatchJobRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_WatchJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.WatchJobRunRequest], *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse]) error) *MockJobServiceHandler_WatchJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockJobServiceHandler creates a new instance of MockJobServiceHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockJobServiceHandler(t interface {
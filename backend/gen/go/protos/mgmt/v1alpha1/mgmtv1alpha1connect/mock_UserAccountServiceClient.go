@@ -1027,6 +1027,65 @@ func (_c *MockUserAccountServiceClient_SetPersonalAccount_Call) RunAndReturn(run
 	return _c
 }
 
+// SetTeamMemberRole provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) SetTeamMemberRole(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamMemberRole")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]) *connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_SetTeamMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamMemberRole'
+type MockUserAccountServiceClient_SetTeamMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetTeamMemberRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]
+func (_e *MockUserAccountServiceClient_Expecter) SetTeamMemberRole(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_SetTeamMemberRole_Call {
+	return &MockUserAccountServiceClient_SetTeamMemberRole_Call{Call: _e.mock.On("SetTeamMemberRole", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamMemberRole_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest])) *MockUserAccountServiceClient_SetTeamMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamMemberRole_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse], _a1 error) *MockUserAccountServiceClient_SetTeamMemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamMemberRole_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse], error)) *MockUserAccountServiceClient_SetTeamMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetUser provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) SetUser(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetUserRequest]) (*connect.Response[mgmtv1alpha1.SetUserResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -66,6 +66,9 @@ const (
 	// UserAccountServiceRemoveTeamAccountMemberProcedure is the fully-qualified name of the
 	// UserAccountService's RemoveTeamAccountMember RPC.
 	UserAccountServiceRemoveTeamAccountMemberProcedure = "/mgmt.v1alpha1.UserAccountService/RemoveTeamAccountMember"
+	// UserAccountServiceSetTeamMemberRoleProcedure is the fully-qualified name of the
+	// UserAccountService's SetTeamMemberRole RPC.
+	UserAccountServiceSetTeamMemberRoleProcedure = "/mgmt.v1alpha1.UserAccountService/SetTeamMemberRole"
 	// UserAccountServiceInviteUserToTeamAccountProcedure is the fully-qualified name of the
 	// UserAccountService's InviteUserToTeamAccount RPC.
 	UserAccountServiceInviteUserToTeamAccountProcedure = "/mgmt.v1alpha1.UserAccountService/InviteUserToTeamAccount"
@@ -103,6 +106,7 @@ var (
 	userAccountServiceSetAccountTemporalConfigMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("SetAccountTemporalConfig")
 	userAccountServiceGetTeamAccountMembersMethodDescriptor        = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountMembers")
 	userAccountServiceRemoveTeamAccountMemberMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountMember")
+	userAccountServiceSetTeamMemberRoleMethodDescriptor            = userAccountServiceServiceDescriptor.Methods().ByName("SetTeamMemberRole")
 	userAccountServiceInviteUserToTeamAccountMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("InviteUserToTeamAccount")
 	userAccountServiceGetTeamAccountInvitesMethodDescriptor        = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountInvites")
 	userAccountServiceRemoveTeamAccountInviteMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountInvite")
@@ -125,6 +129,8 @@ type UserAccountServiceClient interface {
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	// Updates the role of a member of a team account. Only account admins may change roles.
+	SetTeamMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamMemberRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -210,6 +216,12 @@ func NewUserAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setTeamMemberRole: connect.NewClient[v1alpha1.SetTeamMemberRoleRequest, v1alpha1.SetTeamMemberRoleResponse](
+			httpClient,
+			baseURL+UserAccountServiceSetTeamMemberRoleProcedure,
+			connect.WithSchema(userAccountServiceSetTeamMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		inviteUserToTeamAccount: connect.NewClient[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse](
 			httpClient,
 			baseURL+UserAccountServiceInviteUserToTeamAccountProcedure,
@@ -268,6 +280,7 @@ type userAccountServiceClient struct {
 	setAccountTemporalConfig     *connect.Client[v1alpha1.SetAccountTemporalConfigRequest, v1alpha1.SetAccountTemporalConfigResponse]
 	getTeamAccountMembers        *connect.Client[v1alpha1.GetTeamAccountMembersRequest, v1alpha1.GetTeamAccountMembersResponse]
 	removeTeamAccountMember      *connect.Client[v1alpha1.RemoveTeamAccountMemberRequest, v1alpha1.RemoveTeamAccountMemberResponse]
+	setTeamMemberRole            *connect.Client[v1alpha1.SetTeamMemberRoleRequest, v1alpha1.SetTeamMemberRoleResponse]
 	inviteUserToTeamAccount      *connect.Client[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse]
 	getTeamAccountInvites        *connect.Client[v1alpha1.GetTeamAccountInvitesRequest, v1alpha1.GetTeamAccountInvitesResponse]
 	removeTeamAccountInvite      *connect.Client[v1alpha1.RemoveTeamAccountInviteRequest, v1alpha1.RemoveTeamAccountInviteResponse]
@@ -332,6 +345,11 @@ func (c *userAccountServiceClient) RemoveTeamAccountMember(ctx context.Context,
 	return c.removeTeamAccountMember.CallUnary(ctx, req)
 }
 
+// SetTeamMemberRole calls mgmt.v1alpha1.UserAccountService.SetTeamMemberRole.
+func (c *userAccountServiceClient) SetTeamMemberRole(ctx context.Context, req *connect.Request[v1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamMemberRoleResponse], error) {
+	return c.setTeamMemberRole.CallUnary(ctx, req)
+}
+
 // InviteUserToTeamAccount calls mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount.
 func (c *userAccountServiceClient) InviteUserToTeamAccount(ctx context.Context, req *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return c.inviteUserToTeamAccount.CallUnary(ctx, req)
@@ -380,6 +398,8 @@ type UserAccountServiceHandler interface {
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	// Updates the role of a member of a team account. Only account admins may change roles.
+	SetTeamMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamMemberRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -461,6 +481,12 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 		connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAccountServiceSetTeamMemberRoleHandler := connect.NewUnaryHandler(
+		UserAccountServiceSetTeamMemberRoleProcedure,
+		svc.SetTeamMemberRole,
+		connect.WithSchema(userAccountServiceSetTeamMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userAccountServiceInviteUserToTeamAccountHandler := connect.NewUnaryHandler(
 		UserAccountServiceInviteUserToTeamAccountProcedure,
 		svc.InviteUserToTeamAccount,
@@ -527,6 +553,8 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 			userAccountServiceGetTeamAccountMembersHandler.ServeHTTP(w, r)
 		case UserAccountServiceRemoveTeamAccountMemberProcedure:
 			userAccountServiceRemoveTeamAccountMemberHandler.ServeHTTP(w, r)
+		case UserAccountServiceSetTeamMemberRoleProcedure:
+			userAccountServiceSetTeamMemberRoleHandler.ServeHTTP(w, r)
 		case UserAccountServiceInviteUserToTeamAccountProcedure:
 			userAccountServiceInviteUserToTeamAccountHandler.ServeHTTP(w, r)
 		case UserAccountServiceGetTeamAccountInvitesProcedure:
@@ -594,6 +622,10 @@ func (UnimplementedUserAccountServiceHandler) RemoveTeamAccountMember(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.RemoveTeamAccountMember is not implemented"))
 }
 
+func (UnimplementedUserAccountServiceHandler) SetTeamMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.SetTeamMemberRole is not implemented"))
+}
+
 func (UnimplementedUserAccountServiceHandler) InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount is not implemented"))
 }
@@ -2259,6 +2259,8 @@ func (m *AccountUser) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for Role
+
 	if len(errors) > 0 {
 		return AccountUserMultiError(errors)
 	}
@@ -2814,6 +2816,10 @@ func (m *InviteUserToTeamAccountRequest) validate(all bool) error {
 
 	// no validation rules for Email
 
+	if m.Role != nil {
+		// no validation rules for Role
+	}
+
 	if len(errors) > 0 {
 		return InviteUserToTeamAccountRequestMultiError(errors)
 	}
@@ -3016,6 +3022,8 @@ func (m *AccountInvite) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Role
+
 	if len(errors) > 0 {
 		return AccountInviteMultiError(errors)
 	}
@@ -3094,6 +3102,216 @@ var _ interface {
 	ErrorName() string
 } = AccountInviteValidationError{}
 
+// Validate checks the field values on SetTeamMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTeamMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTeamMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTeamMemberRoleRequestMultiError, or nil if none found.
+func (m *SetTeamMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTeamMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return SetTeamMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTeamMemberRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetTeamMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetTeamMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTeamMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTeamMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetTeamMemberRoleRequestValidationError is the validation error returned by
+// SetTeamMemberRoleRequest.Validate if the designated constraints aren't met.
+type SetTeamMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTeamMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTeamMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTeamMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTeamMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTeamMemberRoleRequestValidationError) ErrorName() string {
+	return "SetTeamMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTeamMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTeamMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTeamMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTeamMemberRoleRequestValidationError{}
+
+// Validate checks the field values on SetTeamMemberRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTeamMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTeamMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTeamMemberRoleResponseMultiError, or nil if none found.
+func (m *SetTeamMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTeamMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetTeamMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTeamMemberRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by SetTeamMemberRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetTeamMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTeamMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTeamMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetTeamMemberRoleResponseValidationError is the validation error returned by
+// SetTeamMemberRoleResponse.Validate if the designated constraints aren't met.
+type SetTeamMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTeamMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTeamMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTeamMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTeamMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTeamMemberRoleResponseValidationError) ErrorName() string {
+	return "SetTeamMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTeamMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTeamMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTeamMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTeamMemberRoleResponseValidationError{}
+
 // Validate checks the field values on InviteUserToTeamAccountResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
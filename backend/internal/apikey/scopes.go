@@ -0,0 +1,171 @@
+package apikey
+
+import (
+	"slices"
+
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+type Scope string
+
+const (
+	JobsReadScope         Scope = "jobs:read"
+	JobsWriteScope        Scope = "jobs:write"
+	JobsTriggerScope      Scope = "jobs:trigger"
+	RunsLogsScope         Scope = "runs:logs"
+	ConnectionsReadScope  Scope = "connections:read"
+	ConnectionsWriteScope Scope = "connections:write"
+	// Allows reading the unmasked secrets of connections
+	ConnectionsSecretsScope Scope = "connections:secrets"
+	TransformersReadScope   Scope = "transformers:read"
+	TransformersWriteScope  Scope = "transformers:write"
+	MetricsReadScope        Scope = "metrics:read"
+	// Allows managing the account's notification channels
+	NotificationsReadScope  Scope = "notifications:read"
+	NotificationsWriteScope Scope = "notifications:write"
+)
+
+var (
+	// Procedures that any account api key may call regardless of its scopes.
+	// These only return information about the caller and are needed by clients to resolve the account.
+	unscopedProcedures = map[string]struct{}{
+		mgmtv1alpha1connect.UserAccountServiceGetUserProcedure:              {},
+		mgmtv1alpha1connect.UserAccountServiceGetUserAccountsProcedure:      {},
+		mgmtv1alpha1connect.UserAccountServiceIsUserInAccountProcedure:      {},
+		mgmtv1alpha1connect.UserAccountServiceGetSystemInformationProcedure: {},
+	}
+
+	procedureScopes = map[string]Scope{
+		mgmtv1alpha1connect.JobServiceGetJobsProcedure:                                  JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobProcedure:                                   JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                             JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobStatusesProcedure:                           JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                         JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                           JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                               JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobRunProcedure:                                JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure:                          JobsReadScope,
+		mgmtv1alpha1connect.JobServiceWatchJobRunProcedure:                              JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetRunContextProcedure:                            JobsReadScope,
+		mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure:                       JobsReadScope,
+		mgmtv1alpha1connect.JobServiceGetJobMappingPolicyConfigProcedure:                JobsReadScope,
+		mgmtv1alpha1connect.NotificationServiceGetJobNotificationSubscriptionsProcedure: JobsReadScope,
+
+		mgmtv1alpha1connect.JobServiceCreateJobProcedure:                                JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                                JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                        JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:                JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure:         JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:           JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:           JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:          JobsWriteScope,
+		mgmtv1alpha1connect.JobServicePauseJobProcedure:                                 JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:                    JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                        JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceValidateJobMappingsProcedure:                      JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceSetRunContextProcedure:                            JobsWriteScope,
+		mgmtv1alpha1connect.JobServiceSetRunContextsProcedure:                           JobsWriteScope,
+		mgmtv1alpha1connect.NotificationServiceSetJobNotificationSubscriptionsProcedure: JobsWriteScope,
+
+		mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:    JobsTriggerScope,
+		mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:    JobsTriggerScope,
+		mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure: JobsTriggerScope,
+		mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:    JobsTriggerScope,
+
+		mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure: RunsLogsScope,
+
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure:                      ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure:                       ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionServiceIsConnectionNameAvailableProcedure:           ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionColumnTagsProcedure:             ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure:             ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapProcedure:          ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapsProcedure:         ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure: ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure: ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:  ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionTableConstraintsProcedure:   ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure:     ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetTableRowCountProcedure:                ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceCompareConnectionSchemasProcedure:        ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure:         ConnectionsReadScope,
+		mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure:            ConnectionsReadScope,
+
+		mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure:        ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure:        ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure:        ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigProcedure:   ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionServiceCheckSqlQueryProcedure:           ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionServiceSetConnectionColumnTagsProcedure: ConnectionsWriteScope,
+		mgmtv1alpha1connect.ConnectionDataServiceGetAiGeneratedDataProcedure:  ConnectionsWriteScope,
+
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure: ConnectionsSecretsScope,
+
+		mgmtv1alpha1connect.TransformersServiceGetSystemTransformersProcedure:         TransformersReadScope,
+		mgmtv1alpha1connect.TransformersServiceGetSystemTransformerBySourceProcedure:  TransformersReadScope,
+		mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure:    TransformersReadScope,
+		mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure: TransformersReadScope,
+		mgmtv1alpha1connect.TransformersServiceIsTransformerNameAvailableProcedure:    TransformersReadScope,
+
+		mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure: TransformersWriteScope,
+		mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure: TransformersWriteScope,
+		mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure: TransformersWriteScope,
+		mgmtv1alpha1connect.TransformersServiceValidateUserJavascriptCodeProcedure:   TransformersWriteScope,
+		mgmtv1alpha1connect.TransformersServiceValidateUserRegexCodeProcedure:        TransformersWriteScope,
+
+		mgmtv1alpha1connect.MetricsServiceGetMetricCountProcedure:      MetricsReadScope,
+		mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure: MetricsReadScope,
+
+		mgmtv1alpha1connect.NotificationServiceGetNotificationChannelsProcedure: NotificationsReadScope,
+		mgmtv1alpha1connect.NotificationServiceGetNotificationChannelProcedure:  NotificationsReadScope,
+
+		mgmtv1alpha1connect.NotificationServiceCreateNotificationChannelProcedure: NotificationsWriteScope,
+		mgmtv1alpha1connect.NotificationServiceUpdateNotificationChannelProcedure: NotificationsWriteScope,
+		mgmtv1alpha1connect.NotificationServiceDeleteNotificationChannelProcedure: NotificationsWriteScope,
+		mgmtv1alpha1connect.NotificationServiceSendTestNotificationProcedure:      NotificationsWriteScope,
+	}
+
+	// Scopes that act on jobs and are subject to an api key's job restrictions
+	jobScopes = map[Scope]struct{}{
+		JobsReadScope:    {},
+		JobsWriteScope:   {},
+		JobsTriggerScope: {},
+		RunsLogsScope:    {},
+	}
+)
+
+// Returns true if an api key with the given scopes may call the procedure.
+// Keys without any scopes are unrestricted. Procedures that are not mapped to a scope may only be called by unrestricted keys.
+func IsProcedureInScope(scopes []string, procedure string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	if _, ok := unscopedProcedures[procedure]; ok {
+		return true
+	}
+	required, ok := procedureScopes[procedure]
+	if !ok {
+		return false
+	}
+	for _, scope := range scopes {
+		if Scope(scope) == required {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if an api key with the given scopes has been granted the scope. Keys without any scopes are unrestricted.
+func HasScope(scopes []string, scope Scope) bool {
+	return len(scopes) == 0 || slices.Contains(scopes, string(scope))
+}
+
+// Returns true if the procedure acts on jobs and is subject to an api key's job restrictions
+func IsJobProcedure(procedure string) bool {
+	scope, ok := procedureScopes[procedure]
+	if !ok {
+		return false
+	}
+	_, ok = jobScopes[scope]
+	return ok
+}
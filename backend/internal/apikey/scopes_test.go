@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsProcedureInScope(t *testing.T) {
+	assert.True(t, IsProcedureInScope(nil, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure), "unscoped keys have full access")
+
+	scopes := []string{string(JobsTriggerScope), string(JobsReadScope)}
+	assert.True(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	assert.True(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	assert.True(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.UserAccountServiceGetUserProcedure))
+	assert.False(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure))
+	assert.False(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure))
+	assert.False(t, IsProcedureInScope(scopes, mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure), "unmapped procedures require an unscoped key")
+}
+
+func Test_IsProcedureInScope_ConnectionSecrets(t *testing.T) {
+	readScopes := []string{string(ConnectionsReadScope)}
+	assert.True(t, IsProcedureInScope(readScopes, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure))
+	assert.False(t, IsProcedureInScope(readScopes, mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure), "secrets require a separate scope")
+	assert.True(t, IsProcedureInScope([]string{string(ConnectionsSecretsScope)}, mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure))
+}
+
+func Test_HasScope(t *testing.T) {
+	assert.True(t, HasScope(nil, JobsWriteScope), "unscoped keys have full access")
+	assert.True(t, HasScope([]string{string(JobsTriggerScope), string(JobsWriteScope)}, JobsWriteScope))
+	assert.False(t, HasScope([]string{string(JobsTriggerScope)}, JobsWriteScope))
+}
+
+func Test_IsJobProcedure(t *testing.T) {
+	assert.True(t, IsJobProcedure(mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	assert.True(t, IsJobProcedure(mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure))
+	assert.False(t, IsJobProcedure(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure))
+	assert.False(t, IsJobProcedure(mgmtv1alpha1connect.UserAccountServiceGetUserProcedure))
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
 	pkg_utils "github.com/nucleuscloud/neosync/backend/pkg/utils"
 )
@@ -69,6 +74,9 @@ func (c *Client) InjectTokenCtx(ctx context.Context, header http.Header, spec co
 		if time.Now().After(apiKey.ExpiresAt.Time) {
 			return nil, ApiKeyExpiredErr
 		}
+		if !apikey.IsProcedureInScope(apiKey.Scopes, spec.Procedure) {
+			return nil, nucleuserrors.NewForbidden(fmt.Sprintf("api key is not scoped to call %s", spec.Procedure))
+		}
 
 		newctx := context.WithValue(ctx, TokenContextKey{}, &TokenContextData{
 			RawToken:   token,
@@ -89,6 +97,101 @@ func (c *Client) InjectTokenCtx(ctx context.Context, header http.Header, spec co
 	return nil, InvalidApiKeyErr
 }
 
+type jobIdRequest interface {
+	GetJobId() string
+}
+type idRequest interface {
+	GetId() string
+}
+type jobRunIdRequest interface {
+	GetJobRunId() string
+}
+
+// Procedures whose request identifies the job by its id field
+var jobIdKeyedProcedures = map[string]struct{}{
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                           {},
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        {},
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                {},
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        {},
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: {},
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         {},
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            {},
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                {},
+}
+
+// Verifies the request only references jobs that the account api key has been restricted to.
+// Requests that reference a job run are verified once the run has been resolved to its job.
+func (c *Client) AuthorizeRequest(ctx context.Context, spec connect.Spec, msg any) error {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil || len(data.ApiKey.JobIds) == 0 {
+		return nil
+	}
+	if !apikey.IsJobProcedure(spec.Procedure) {
+		return nil
+	}
+
+	if req, ok := msg.(jobIdRequest); ok && req.GetJobId() != "" {
+		return verifyJobAllowed(data, req.GetJobId())
+	}
+	if _, ok := jobIdKeyedProcedures[spec.Procedure]; ok {
+		if req, ok := msg.(idRequest); ok {
+			return verifyJobAllowed(data, req.GetId())
+		}
+	}
+	if req, ok := msg.(jobRunIdRequest); ok && req.GetJobRunId() != "" {
+		return nil
+	}
+	return nucleuserrors.NewForbidden("api key is restricted to specific jobs and may not call this procedure")
+}
+
+// Returns true if the api key in the context is allowed to act on the job.
+// Callers that are not using an account api key, or keys without job restrictions, are always allowed.
+func IsJobAllowed(ctx context.Context, jobId string) bool {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil {
+		return true
+	}
+	return isJobInRestrictions(data.ApiKey.JobIds, jobId)
+}
+
+// Returns true if the api key in the context is an account api key that has been restricted to specific jobs
+func HasJobRestrictions(ctx context.Context) bool {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil {
+		return false
+	}
+	return len(data.ApiKey.JobIds) > 0
+}
+
+// Returns true if the api key in the context has been granted the scope.
+// Callers that are not using an account api key, or keys without scopes, are always allowed.
+func HasScope(ctx context.Context, scope apikey.Scope) bool {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil {
+		return true
+	}
+	return apikey.HasScope(data.ApiKey.Scopes, scope)
+}
+
+func verifyJobAllowed(data *TokenContextData, jobId string) error {
+	if !isJobInRestrictions(data.ApiKey.JobIds, jobId) {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("api key is not allowed to access job %s", jobId))
+	}
+	return nil
+}
+
+func isJobInRestrictions(allowedJobIds []pgtype.UUID, jobId string) bool {
+	if len(allowedJobIds) == 0 {
+		return true
+	}
+	for _, allowedJobId := range allowedJobIds {
+		if strings.EqualFold(nucleusdb.UUIDString(allowedJobId), jobId) {
+			return true
+		}
+	}
+	return false
+}
+
 func GetTokenDataFromCtx(ctx context.Context) (*TokenContextData, error) {
 	data, ok := ctx.Value(TokenContextKey{}).(*TokenContextData)
 	if !ok {
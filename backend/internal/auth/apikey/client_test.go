@@ -12,6 +12,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	pkg_utils "github.com/nucleuscloud/neosync/backend/pkg/utils"
@@ -92,6 +94,75 @@ func Test_Client_InjectTokenCtx_Account_Expired(t *testing.T) {
 	assert.Nil(t, newctx)
 }
 
+func Test_Client_InjectTokenCtx_Account_OutOfScope(t *testing.T) {
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbTx := db_queries.NewMockDBTX(t)
+
+	client := New(mockQuerier, mockDbTx, []string{}, []string{})
+
+	fakeToken := apikey.NewV1AccountKey()
+	hashedFakeToken := pkg_utils.ToSha256(
+		fakeToken,
+	)
+	expiresAt, err := nucleusdb.ToTimestamp(time.Now().Add(5 * time.Minute))
+	assert.NoError(t, err)
+	apiKeyRecord := db_queries.NeosyncApiAccountApiKey{
+		ID:        pgtype.UUID{Valid: true},
+		ExpiresAt: expiresAt,
+		Scopes:    []string{string(apikey.JobsTriggerScope)},
+	}
+	mockQuerier.On("GetAccountApiKeyByKeyValue", mock.Anything, mock.Anything, hashedFakeToken).
+		Return(apiKeyRecord, nil)
+
+	header := http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", fakeToken)},
+	}
+	_, err = client.InjectTokenCtx(context.Background(), header, connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceCreateJobRunProcedure})
+	assert.NoError(t, err)
+
+	newctx, err := client.InjectTokenCtx(context.Background(), header, connect.Spec{Procedure: mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure})
+	assert.Error(t, err)
+	assert.Nil(t, newctx)
+}
+
+func Test_Client_AuthorizeRequest_JobRestrictions(t *testing.T) {
+	client := New(db_queries.NewMockQuerier(t), db_queries.NewMockDBTX(t), []string{}, []string{})
+
+	allowedJobId := "3b9c1a4e-0d5b-4f8e-9f47-1a2b3c4d5e6f"
+	allowedJobUuid, err := nucleusdb.ToUuid(allowedJobId)
+	assert.NoError(t, err)
+	otherJobId := "7f0e9d8c-6b5a-4c3d-8e2f-1a0b9c8d7e6f"
+
+	ctx := context.WithValue(context.Background(), TokenContextKey{}, &TokenContextData{
+		ApiKey:     &db_queries.NeosyncApiAccountApiKey{JobIds: []pgtype.UUID{allowedJobUuid}},
+		ApiKeyType: apikey.AccountApiKey,
+	})
+
+	createRunSpec := connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceCreateJobRunProcedure}
+	assert.NoError(t, client.AuthorizeRequest(ctx, createRunSpec, &mgmtv1alpha1.CreateJobRunRequest{JobId: allowedJobId}))
+	assert.Error(t, client.AuthorizeRequest(ctx, createRunSpec, &mgmtv1alpha1.CreateJobRunRequest{JobId: otherJobId}))
+
+	getJobSpec := connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceGetJobProcedure}
+	assert.NoError(t, client.AuthorizeRequest(ctx, getJobSpec, &mgmtv1alpha1.GetJobRequest{Id: allowedJobId}))
+	assert.Error(t, client.AuthorizeRequest(ctx, getJobSpec, &mgmtv1alpha1.GetJobRequest{Id: otherJobId}))
+
+	// runs are verified once resolved to their job
+	assert.NoError(t, client.AuthorizeRequest(ctx, connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceCancelJobRunProcedure}, &mgmtv1alpha1.CancelJobRunRequest{JobRunId: "run"}))
+
+	// account wide job procedures are not allowed
+	assert.Error(t, client.AuthorizeRequest(ctx, connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceGetJobsProcedure}, &mgmtv1alpha1.GetJobsRequest{}))
+
+	// non-job procedures are left to scopes
+	assert.NoError(t, client.AuthorizeRequest(ctx, connect.Spec{Procedure: mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure}, &mgmtv1alpha1.GetConnectionsRequest{}))
+
+	assert.True(t, IsJobAllowed(ctx, allowedJobId))
+	assert.False(t, IsJobAllowed(ctx, otherJobId))
+	assert.True(t, IsJobAllowed(context.Background(), otherJobId))
+
+	assert.True(t, HasJobRestrictions(ctx))
+	assert.False(t, HasJobRestrictions(context.Background()))
+}
+
 func Test_Client_InjectTokenCtx_InvalidHeader(t *testing.T) {
 	client := &Client{}
 	_, err := client.InjectTokenCtx(context.Background(), http.Header{"Authorization": []string{}}, connect.Spec{})
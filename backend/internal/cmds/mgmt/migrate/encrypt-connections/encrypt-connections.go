@@ -0,0 +1,138 @@
+package encryptconnections_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt-connections",
+		Short: "Encrypts all connection configs that are stored in plaintext with the configured encryption key provider",
+		Long: "Encrypts all connection configs that are stored in plaintext with the configured encryption key provider.\n" +
+			"Use --rotate after changing the primary key or provider to re-encrypt configs that were encrypted with a previous key.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbUrl, err := cmd.Flags().GetString("database")
+			if err != nil {
+				return err
+			}
+			if dbUrl == "" {
+				dbUrl, err = getDbUrl()
+				if err != nil {
+					return err
+				}
+			}
+			rotate, err := cmd.Flags().GetBool("rotate")
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			return EncryptConnections(
+				cmd.Context(),
+				dbUrl,
+				encryption.GetConfigFromEnv(),
+				rotate,
+				slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			)
+		},
+	}
+	cmd.Flags().StringP("database", "d", "", "optionally set the database url, otherwise it will pull from the environment")
+	cmd.Flags().Bool("rotate", false, "re-encrypt configs that were not encrypted with the current primary key")
+	return cmd
+}
+
+func EncryptConnections(
+	ctx context.Context,
+	connStr string,
+	cfg *encryption.Config,
+	rotate bool,
+	logger *slog.Logger,
+) error {
+	encryptor, err := encryption.NewEncryptorFromConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if encryptor == nil {
+		return errors.New("must provide CONNECTION_ENCRYPTION_PROVIDER in environment")
+	}
+
+	pool, err := pgxpool.New(ctx, connStr)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	db := nucleusdb.New(pool, nil)
+	db.SetConnectionEncryptor(encryptor)
+
+	result, err := db.EncryptConnections(ctx, rotate)
+	if result != nil {
+		logger.Info(
+			"encrypt connections finished",
+			"encrypted", result.Encrypted,
+			"rotated", result.Rotated,
+			"skipped", result.Skipped,
+			"conflicted", result.Conflicted,
+		)
+		if result.Conflicted > 0 {
+			logger.Warn("some connections were updated while being encrypted and were left as is, run this command again to encrypt them", "conflicted", result.Conflicted)
+		}
+	}
+	return err
+}
+
+func getDbUrl() (string, error) {
+	dburl := viper.GetString("DB_URL")
+	if dburl != "" {
+		return dburl, nil
+	}
+
+	dbHost := viper.GetString("DB_HOST")
+	if dbHost == "" {
+		return "", fmt.Errorf("must provide DB_HOST in environment")
+	}
+
+	dbPort := viper.GetInt("DB_PORT")
+	if dbPort == 0 {
+		return "", fmt.Errorf("must provide DB_PORT in environment")
+	}
+
+	dbName := viper.GetString("DB_NAME")
+	if dbName == "" {
+		return "", fmt.Errorf("must provide DB_NAME in environment")
+	}
+
+	dbUser := viper.GetString("DB_USER")
+	if dbUser == "" {
+		return "", fmt.Errorf("must provide DB_USER in environment")
+	}
+
+	dbPass := viper.GetString("DB_PASS")
+	if dbPass == "" {
+		return "", fmt.Errorf("must provide DB_PASS in environment")
+	}
+
+	sslMode := "require"
+	if viper.IsSet("DB_SSL_DISABLE") && viper.GetBool("DB_SSL_DISABLE") {
+		sslMode = "disable"
+	}
+
+	return nucleusdb.GetDbUrl(&nucleusdb.ConnectConfig{
+		Host:     dbHost,
+		Port:     dbPort,
+		Database: dbName,
+		User:     dbUser,
+		Pass:     dbPass,
+		SslMode:  &sslMode,
+	}), nil
+}
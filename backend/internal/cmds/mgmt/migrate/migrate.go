@@ -2,6 +2,7 @@ package migrate_cmd
 
 import (
 	down_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/down"
+	encryptconnections_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/encrypt-connections"
 	up_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/up"
 	"github.com/spf13/cobra"
 )
@@ -17,5 +18,6 @@ func NewCmd() *cobra.Command {
 
 	cmd.AddCommand(up_cmd.NewCmd())
 	cmd.AddCommand(down_cmd.NewCmd())
+	cmd.AddCommand(encryptconnections_cmd.NewCmd())
 	return cmd
 }
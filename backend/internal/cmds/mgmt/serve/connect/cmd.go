@@ -17,6 +17,7 @@ import (
 	"connectrpc.com/otelconnect"
 	"connectrpc.com/validate"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
@@ -30,24 +31,31 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/auth0"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/keycloak"
 	up_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/up"
+	audit_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/audit"
 	auth_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth"
 	authlogging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth_logging"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	logging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logging"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	"github.com/nucleuscloud/neosync/backend/internal/notifications"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
+	"github.com/nucleuscloud/neosync/backend/pkg/secretresolver"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	v1alpha1_apikeyservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/api-key-service"
+	v1alpha1_auditservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/audit-service"
 	v1alpha1_authservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/auth-service"
 	v1alpha1_connectiondataservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-data-service"
 	v1alpha1_connectionservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-service"
 	v1alpha1_jobservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/job-service"
 	v1alpha1_metricsservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/metrics-service"
+	v1alpha1_notificationservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/notification-service"
 	v1alpha1_transformerservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/transformers-service"
 	v1alpha1_useraccountservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/user-account-service"
 	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
@@ -102,6 +110,8 @@ func serve(ctx context.Context) error {
 		mgmtv1alpha1connect.TransformersServiceName,
 		mgmtv1alpha1connect.ApiKeyServiceName,
 		mgmtv1alpha1connect.ConnectionDataServiceName,
+		mgmtv1alpha1connect.AuditServiceName,
+		mgmtv1alpha1connect.NotificationServiceName,
 	}
 
 	if shouldServiceMetrics() {
@@ -132,6 +142,11 @@ func serve(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	connectionEncryptor, err := encryption.NewEncryptorFromConfig(ctx, encryption.GetConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("unable to initialize connection encryption: %w", err)
+	}
+	db.SetConnectionEncryptor(connectionEncryptor)
 
 	if viper.GetBool("DB_AUTO_MIGRATE") {
 		schemaDir := viper.GetString("DB_SCHEMA_DIR")
@@ -163,12 +178,14 @@ func serve(ctx context.Context) error {
 	}
 	loggerInterceptor := logger_interceptor.NewInterceptor(slogger)
 	loggingInterceptor := logging_interceptor.NewInterceptor()
+	rbacInterceptor := rbac_interceptor.NewInterceptor()
 
 	stdInterceptors := []connect.Interceptor{
 		otelInterceptor,
 		loggerInterceptor,
 		validateInterceptor,
 		loggingInterceptor,
+		rbacInterceptor,
 	}
 
 	// standard auth interceptors that should be applied to most services
@@ -195,7 +212,11 @@ func serve(ctx context.Context) error {
 			mgmtv1alpha1connect.JobServiceGetRunContextProcedure,
 			mgmtv1alpha1connect.JobServiceSetRunContextProcedure,
 			mgmtv1alpha1connect.JobServiceSetRunContextsProcedure,
+			mgmtv1alpha1connect.JobServiceIngestJobRunLogsProcedure,
+			mgmtv1alpha1connect.JobServiceRecordJobRunProcedure,
+			mgmtv1alpha1connect.NotificationServiceSendJobRunNotificationProcedure,
 			mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
+			mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
 			mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure,
 			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure,
 			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure,
@@ -203,11 +224,12 @@ func serve(ctx context.Context) error {
 		})
 		stdAuthInterceptors = append(
 			stdAuthInterceptors,
-			auth_interceptor.NewInterceptor(
+			auth_interceptor.NewInterceptorWithRequestAuth(
 				authmw.New(
 					jwtclient,
 					apikeyClient,
 				).InjectTokenCtx,
+				apikeyClient.AuthorizeRequest,
 			),
 			authlogging_interceptor.NewInterceptor(db),
 		)
@@ -234,6 +256,11 @@ func serve(ctx context.Context) error {
 		)
 	}
 
+	// the audit interceptor must come after auth so that the caller is known
+	auditInterceptor := audit_interceptor.NewInterceptor(db)
+	stdAuthInterceptors = append(stdAuthInterceptors, auditInterceptor)
+	jwtOnlyAuthInterceptors = append(jwtOnlyAuthInterceptors, auditInterceptor)
+
 	api := http.NewServeMux()
 
 	authBaseUrl := getAuthBaseUrl()
@@ -316,8 +343,14 @@ func serve(ctx context.Context) error {
 	mssqlquerier := mssql_queries.New()
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlConnector)
 	mongoconnector := mongoconnect.NewConnector()
+	secretResolver, err := secretresolver.NewFromConfig(ctx, secretresolver.GetConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("unable to configure secret resolver: %w", err)
+	}
 	connectionService := v1alpha1_connectionservice.New(&v1alpha1_connectionservice.Config{}, db, useraccountService, sqlConnector, pgquerier,
-		mysqlquerier, mssqlquerier, mongoconnector, awsManager)
+		mysqlquerier, mssqlquerier, mongoconnector, awsManager, secretResolver)
+	// the job and connection data services retrieve the connections they connect to with this client, which resolves their secret references
+	resolvedConnectionService := secretresolver.NewConnectionServiceClient(connectionService, secretResolver)
 	api.Handle(
 		mgmtv1alpha1connect.NewConnectionServiceHandler(
 			connectionService,
@@ -331,6 +364,9 @@ func serve(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err := validateWorkerApiKeys(isAuthEnabled, runLogConfig, getAllowedWorkerApiKeys(getIsNeosyncCloud()), slogger); err != nil {
+		return err
+	}
 
 	jobServiceConfig := &v1alpha1_jobservice.Config{
 		IsAuthEnabled:  isAuthEnabled,
@@ -341,10 +377,13 @@ func serve(ctx context.Context) error {
 		jobServiceConfig,
 		db,
 		tfwfmgr,
-		connectionService,
+		resolvedConnectionService,
 		useraccountService,
 		sqlmanager,
 	)
+	if runLogConfig.IsEnabled && runLogConfig.DatabaseRunLogConfig != nil && runLogConfig.DatabaseRunLogConfig.Retention > 0 {
+		go pruneJobRunLogs(ctx, jobService, slogger)
+	}
 	api.Handle(
 		mgmtv1alpha1connect.NewJobServiceHandler(
 			jobService,
@@ -368,7 +407,7 @@ func serve(ctx context.Context) error {
 	connectionDataService := v1alpha1_connectiondataservice.New(
 		&v1alpha1_connectiondataservice.Config{},
 		useraccountService,
-		connectionService,
+		resolvedConnectionService,
 		jobService,
 		awsManager,
 		sqlConnector,
@@ -387,6 +426,32 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	auditService := v1alpha1_auditservice.New(&v1alpha1_auditservice.Config{}, db, useraccountService)
+	api.Handle(
+		mgmtv1alpha1connect.NewAuditServiceHandler(
+			auditService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
+	notificationService := v1alpha1_notificationservice.New(
+		&v1alpha1_notificationservice.Config{IsAuthEnabled: isAuthEnabled, IsNeosyncCloud: getIsNeosyncCloud()},
+		db,
+		useraccountService,
+		notifications.New(notifications.NewHttpClient(30*time.Second)),
+	)
+	go reconcileJobRuns(ctx, jobService, notificationService, slogger)
+	api.Handle(
+		mgmtv1alpha1connect.NewNotificationServiceHandler(
+			notificationService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
 	if shouldServiceMetrics() {
 		roundTripper := promapi.DefaultRoundTripper
 		promApiKey := getPromApiKey()
@@ -690,7 +755,26 @@ func getAllowedWorkerApiKeys(isNeosyncCloud bool) []string {
 	if isNeosyncCloud {
 		return viper.GetStringSlice("NEOSYNC_CLOUD_ALLOWED_WORKER_API_KEYS")
 	}
-	return []string{}
+	// the worker must authenticate with one of these keys to report run logs, run history and notifications when auth is enabled
+	return viper.GetStringSlice("ALLOWED_WORKER_API_KEYS")
+}
+
+// The worker is the only caller allowed to ingest run logs and record runs when auth is enabled.
+// Without worker keys database run logs would be dropped, so that configuration is refused.
+func validateWorkerApiKeys(
+	isAuthEnabled bool,
+	runLogConfig *v1alpha1_jobservice.RunLogConfig,
+	workerApiKeys []string,
+	logger *slog.Logger,
+) error {
+	if !isAuthEnabled || len(workerApiKeys) > 0 {
+		return nil
+	}
+	if runLogConfig != nil && runLogConfig.IsEnabled && runLogConfig.RunLogType != nil && *runLogConfig.RunLogType == v1alpha1_jobservice.DatabaseRunLogType {
+		return errors.New("ALLOWED_WORKER_API_KEYS must be set when AUTH_ENABLED is true and RUN_LOGS_TYPE is database, otherwise the worker is unable to ship run logs")
+	}
+	logger.Warn("AUTH_ENABLED is true but ALLOWED_WORKER_API_KEYS is empty, the worker will be unable to record job runs or send run notifications")
+	return nil
 }
 
 func getAuthAdminClient(ctx context.Context, authclient auth_client.Interface, logger *slog.Logger) (authmgmt.Interface, error) {
@@ -808,11 +892,81 @@ func getRunLogConfig() (*v1alpha1_jobservice.RunLogConfig, error) {
 				KeepLabels:  keepLabels,
 			},
 		}, nil
+	case v1alpha1_jobservice.DatabaseRunLogType:
+		retention := 7 * 24 * time.Hour
+		if viper.IsSet("RUN_LOGS_DATABASECONFIG_RETENTION") {
+			retention = viper.GetDuration("RUN_LOGS_DATABASECONFIG_RETENTION")
+		}
+		return &v1alpha1_jobservice.RunLogConfig{
+			IsEnabled:  true,
+			RunLogType: runlogtype,
+			DatabaseRunLogConfig: &v1alpha1_jobservice.DatabaseRunLogConfig{
+				Retention: retention,
+			},
+		}, nil
 	default:
 		return nil, errors.New("unsupported or no run log type configured, but run logs are enabled.")
 	}
 }
 
+// Periodically removes run logs that are older than the configured retention
+func pruneJobRunLogs(ctx context.Context, jobService *v1alpha1_jobservice.Service, logger *slog.Logger) {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+	for {
+		count, err := jobService.DeleteExpiredJobRunLogs(ctx)
+		if err != nil {
+			logger.Error(fmt.Sprintf("unable to prune job run logs: %s", err.Error()))
+		} else if count > 0 {
+			logger.Debug(fmt.Sprintf("pruned %d expired job run logs", count))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Periodically closes job runs whose workflow closed without the worker recording them, and notifies of runs that timed out
+func reconcileJobRuns(
+	ctx context.Context,
+	jobService *v1alpha1_jobservice.Service,
+	notificationService *v1alpha1_notificationservice.Service,
+	logger *slog.Logger,
+) {
+	ticker := time.NewTicker(5 * time.Minute)
+	defer ticker.Stop()
+	for {
+		timedOut, err := jobService.ReconcileJobRuns(ctx, logger)
+		if err != nil {
+			logger.Error(fmt.Sprintf("unable to reconcile job runs: %s", err.Error()))
+		}
+		for _, run := range timedOut {
+			var errorMessage *string
+			if run.ErrorMessage.Valid {
+				errorMessage = &run.ErrorMessage.String
+			}
+			err := notificationService.NotifyJobRun(
+				ctx,
+				logger,
+				nucleusdb.UUIDString(run.JobID),
+				run.ID,
+				mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT,
+				errorMessage,
+			)
+			if err != nil {
+				logger.Error(fmt.Sprintf("unable to send job run timed out notification: %s", err.Error()), "jobRunId", run.ID)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func getRunLogType() *v1alpha1_jobservice.RunLogType {
 	logtype := viper.GetString("RUN_LOGS_TYPE")
 	switch logtype {
@@ -822,6 +976,9 @@ func getRunLogType() *v1alpha1_jobservice.RunLogType {
 	case string(v1alpha1_jobservice.LokiRunLogType):
 		rt := v1alpha1_jobservice.LokiRunLogType
 		return &rt
+	case string(v1alpha1_jobservice.DatabaseRunLogType):
+		rt := v1alpha1_jobservice.DatabaseRunLogType
+		return &rt
 	default:
 		return nil
 	}
@@ -0,0 +1,48 @@
+package columntags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Well known column classification tags. Any other tag that matches the tag pattern is a custom tag.
+const (
+	Pii        = "pii"
+	Phi        = "phi"
+	Pci        = "pci"
+	FreeText   = "free_text"
+	InternalId = "internal_id"
+)
+
+var tagRegex = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// Lowercases, sorts and removes duplicate tags.
+// Returns an error if a tag is not made up of lowercase letters, numbers, underscores or dashes.
+func Normalize(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !tagRegex.MatchString(tag) {
+			return nil, fmt.Errorf("invalid column tag %q: tags must be 1 to 64 lowercase letters, numbers, underscores or dashes", tag)
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// Returns the sorted set of tags that are in either of the lists
+func Merge(a, b []string) []string {
+	merged := make([]string, 0, len(a)+len(b))
+	merged = append(merged, a...)
+	merged = append(merged, b...)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+// Returns the key that column tags are looked up by
+func ColumnKey(schema, table, column string) string {
+	return fmt.Sprintf("%s.%s.%s", schema, table, column)
+}
@@ -0,0 +1,27 @@
+package columntags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Normalize(t *testing.T) {
+	actual, err := Normalize([]string{"PII", " free_text ", "pii", "team-a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"free_text", "pii", "team-a"}, actual)
+
+	actual, err = Normalize(nil)
+	require.NoError(t, err)
+	require.Empty(t, actual)
+
+	_, err = Normalize([]string{"free text"})
+	require.Error(t, err)
+	_, err = Normalize([]string{""})
+	require.Error(t, err)
+}
+
+func Test_Merge(t *testing.T) {
+	require.Equal(t, []string{"pci", "phi", "pii"}, Merge([]string{"pii", "phi"}, []string{"pci", "pii"}))
+	require.Empty(t, Merge(nil, nil))
+}
@@ -0,0 +1,25 @@
+package audit_interceptor
+
+import (
+	"context"
+)
+
+type auditContextKey struct{}
+type auditContextData struct {
+	accountId string
+}
+
+func setAuditContext(ctx context.Context) (context.Context, *auditContextData) {
+	data := &auditContextData{}
+	return context.WithValue(ctx, auditContextKey{}, data), data
+}
+
+// Records the account that the current procedure is acting upon.
+// Only the first account recorded is kept. This is a no-op if the procedure is not being audited.
+func SetAccountIdContext(ctx context.Context, accountId string) {
+	data, ok := ctx.Value(auditContextKey{}).(*auditContextData)
+	if !ok || data.accountId != "" {
+		return
+	}
+	data.accountId = accountId
+}
@@ -0,0 +1,160 @@
+package audit_interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Loads the stored resource that an update procedure acts upon
+type resourceLoader func(ctx context.Context, db *nucleusdb.NucleusDb, id pgtype.UUID) (proto.Message, error)
+
+var (
+	// Procedures that update a stored resource. These record the fields that were changed instead of the request.
+	changeTrackedProcedures = map[string]resourceLoader{
+		mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure:               loadConnection,
+		mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                     loadJob,
+		mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:             loadJob,
+		mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:        loadJob,
+		mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure:      loadJob,
+		mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:                 loadJob,
+		mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                     loadJob,
+		mgmtv1alpha1connect.NotificationServiceUpdateNotificationChannelProcedure:    loadNotificationChannel,
+		mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure: loadUserDefinedTransformer,
+	}
+
+	// Fields that change on every update and would only add noise to the recorded changes
+	ignoredChangeFields = map[string]struct{}{
+		"updatedAt":       {},
+		"updatedByUserId": {},
+	}
+)
+
+type fieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Returns the stored resource that the procedure updates, or nil if the procedure does not update a stored resource
+func (i *Interceptor) getStoredResource(ctx context.Context, procedure string, request any) (proto.Message, error) {
+	loader, ok := changeTrackedProcedures[procedure]
+	if !ok {
+		return nil, nil
+	}
+	resourceUuid, err := nucleusdb.ToUuid(getResourceId(request, nil))
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine the resource being updated: %w", err)
+	}
+	return loader(ctx, i.db, resourceUuid)
+}
+
+// Returns the JSON encoded fields that differ between the two versions of the resource, keyed by field path.
+// Sensitive fields are redacted before they are compared.
+func getChanges(before, after proto.Message) ([]byte, error) {
+	beforeValues, err := toRedactedMap(before)
+	if err != nil {
+		return nil, err
+	}
+	afterValues, err := toRedactedMap(after)
+	if err != nil {
+		return nil, err
+	}
+	for field := range ignoredChangeFields {
+		delete(beforeValues, field)
+		delete(afterValues, field)
+	}
+	changes := map[string]*fieldChange{}
+	addChanges(changes, "", beforeValues, afterValues)
+	return json.Marshal(changes)
+}
+
+func toRedactedMap(msg proto.Message) (map[string]any, error) {
+	bits, err := protojson.Marshal(redact.Message(msg))
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if err := json.Unmarshal(bits, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Objects are compared field by field, any other values such as lists are compared as a whole
+func addChanges(changes map[string]*fieldChange, path string, before, after any) {
+	beforeObj, beforeIsObj := before.(map[string]any)
+	afterObj, afterIsObj := after.(map[string]any)
+	if beforeIsObj && afterIsObj {
+		for key, value := range beforeObj {
+			addChanges(changes, joinFieldPath(path, key), value, afterObj[key])
+		}
+		for key, value := range afterObj {
+			if _, ok := beforeObj[key]; !ok {
+				addChanges(changes, joinFieldPath(path, key), nil, value)
+			}
+		}
+		return
+	}
+	if !reflect.DeepEqual(before, after) {
+		changes[path] = &fieldChange{Before: before, After: after}
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func loadConnection(ctx context.Context, db *nucleusdb.NucleusDb, id pgtype.UUID) (proto.Message, error) {
+	connection, err := db.GetConnectionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToConnectionDto(&connection)
+}
+
+func loadJob(ctx context.Context, db *nucleusdb.NucleusDb, id pgtype.UUID) (proto.Message, error) {
+	job, err := db.Q.GetJobById(ctx, db.Db, id)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := db.Q.GetJobConnectionDestinations(ctx, db.Db, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToJobDto(&job, destinations), nil
+}
+
+func loadNotificationChannel(ctx context.Context, db *nucleusdb.NucleusDb, id pgtype.UUID) (proto.Message, error) {
+	channel, err := db.Q.GetNotificationChannelById(ctx, db.Db, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToNotificationChannelDto(&channel)
+}
+
+// Only the user editable fields are loaded as the full dto requires the system transformers
+func loadUserDefinedTransformer(ctx context.Context, db *nucleusdb.NucleusDb, id pgtype.UUID) (proto.Message, error) {
+	transformer, err := db.Q.GetUserDefinedTransformerById(ctx, db.Db, id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmtv1alpha1.UserDefinedTransformer{
+		Id:          nucleusdb.UUIDString(transformer.ID),
+		Name:        transformer.Name,
+		Description: transformer.Description,
+		Config:      transformer.TransformerConfig.ToTransformerConfigDto(),
+	}, nil
+}
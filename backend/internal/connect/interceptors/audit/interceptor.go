@@ -0,0 +1,227 @@
+package audit_interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/internal/auth/tokenctx"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// Request fields that identify the resource being mutated, in order of precedence
+	resourceIdFields = []protoreflect.Name{
+		"id",
+		"job_id",
+		"job_run_id",
+		"connection_id",
+		"transformer_id",
+		"user_id",
+	}
+)
+
+// Records an audit event for every mutating procedure.
+// Successful updates of a stored resource record the fields that were changed, all other calls record the redacted request.
+// Must be placed after the auth interceptors so that the caller is known.
+type Interceptor struct {
+	db *nucleusdb.NucleusDb
+}
+
+func NewInterceptor(db *nucleusdb.NucleusDb) connect.Interceptor {
+	return &Interceptor{db: db}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if !IsAuditedProcedure(request.Spec().Procedure) {
+			return next(ctx, request)
+		}
+		logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+		procedure := request.Spec().Procedure
+		before, loadErr := i.getStoredResource(ctx, procedure, request.Any())
+		if loadErr != nil {
+			logger.Warn("unable to load resource for audit event", "error", loadErr, "procedure", procedure)
+		}
+
+		auditCtx, data := setAuditContext(ctx)
+		response, err := next(auditCtx, request)
+
+		var responseMsg any
+		if err == nil && response != nil {
+			responseMsg = response.Any()
+		}
+		var changes []byte
+		if err == nil && before != nil {
+			changes = i.getResourceChanges(ctx, procedure, request.Any(), before)
+		}
+		i.recordEvent(ctx, procedure, data, request.Any(), responseMsg, changes, err)
+		return response, err
+	}
+}
+
+// Returns the changes that the update made to the stored resource, or nil if they could not be determined
+func (i *Interceptor) getResourceChanges(ctx context.Context, procedure string, request any, before proto.Message) []byte {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	after, err := i.getStoredResource(ctx, procedure, request)
+	if err != nil {
+		logger.Warn("unable to load updated resource for audit event", "error", err, "procedure", procedure)
+		return nil
+	}
+	changes, err := getChanges(before, after)
+	if err != nil {
+		logger.Warn("unable to determine resource changes for audit event", "error", err, "procedure", procedure)
+		return nil
+	}
+	return changes
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// Streams are recorded once the stream ends with the first message received from the client,
+// which identifies the account and resource being mutated.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !IsAuditedProcedure(conn.Spec().Procedure) {
+			return next(ctx, conn)
+		}
+		auditCtx, data := setAuditContext(ctx)
+		auditConn := &auditStreamingHandlerConn{StreamingHandlerConn: conn}
+		err := next(auditCtx, auditConn)
+		i.recordEvent(ctx, conn.Spec().Procedure, data, auditConn.request, nil, nil, err)
+		return err
+	}
+}
+
+type auditStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	request any
+}
+
+func (c *auditStreamingHandlerConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil && c.request == nil {
+		c.request = msg
+	}
+	return err
+}
+
+// Failing to record an audit event is logged but never fails the request
+func (i *Interceptor) recordEvent(
+	ctx context.Context,
+	procedure string,
+	data *auditContextData,
+	request, response any,
+	changes []byte,
+	callErr error,
+) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+
+	params := db_queries.CreateAuditEventParams{
+		Procedure: procedure,
+		Request:   []byte("{}"),
+	}
+
+	accountId := data.accountId
+	if accountId == "" {
+		accountId = getStringField(request, "account_id")
+	}
+	if accountId != "" {
+		accountUuid, err := nucleusdb.ToUuid(accountId)
+		if err == nil {
+			params.AccountID = accountUuid
+		}
+	}
+
+	params.ActorUserID, params.ActorApiKeyID = i.getActor(ctx)
+
+	resourceId := getResourceId(request, response)
+	if resourceId != "" {
+		params.ResourceID = pgtype.Text{String: resourceId, Valid: true}
+	}
+
+	if changes != nil {
+		params.Changes = changes
+	} else if msg, ok := request.(proto.Message); ok {
+		bits, err := protojson.Marshal(redact.Message(msg))
+		if err != nil {
+			logger.Warn("unable to marshal request for audit event", "error", err)
+		} else {
+			params.Request = bits
+		}
+	}
+
+	if callErr != nil {
+		params.ErrorCode = pgtype.Text{String: connect.CodeOf(callErr).String(), Valid: true}
+	}
+
+	err := i.db.Q.CreateAuditEvent(ctx, i.db.Db, params)
+	if err != nil {
+		logger.Error("unable to record audit event", "error", err, "procedure", procedure)
+	}
+}
+
+func (i *Interceptor) getActor(ctx context.Context) (userId, apiKeyId pgtype.UUID) {
+	tokenCtxResp, err := tokenctx.GetTokenCtx(ctx)
+	if err != nil {
+		return userId, apiKeyId
+	}
+	if tokenCtxResp.JwtContextData != nil {
+		user, err := i.db.Q.GetUserByProviderSub(ctx, i.db.Db, tokenCtxResp.JwtContextData.AuthUserId)
+		if err != nil {
+			logger_interceptor.GetLoggerFromContextOrDefault(ctx).Warn("unable to resolve user for audit event", "error", err)
+		} else {
+			userId = user.ID
+		}
+	} else if tokenCtxResp.ApiKeyContextData != nil && tokenCtxResp.ApiKeyContextData.ApiKey != nil {
+		userId = tokenCtxResp.ApiKeyContextData.ApiKey.UserID
+		apiKeyId = tokenCtxResp.ApiKeyContextData.ApiKey.ID
+	}
+	return userId, apiKeyId
+}
+
+// Prefers the resource identified by the request, falling back to the id of the resource returned in the response.
+func getResourceId(request, response any) string {
+	for _, field := range resourceIdFields {
+		if value := getStringField(request, field); value != "" {
+			return value
+		}
+	}
+
+	msg, ok := response.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+	var resourceId string
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		resourceId = getStringField(value.Message().Interface(), "id")
+		return resourceId == ""
+	})
+	return resourceId
+}
+
+func getStringField(input any, name protoreflect.Name) string {
+	msg, ok := input.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+	reflected := msg.ProtoReflect()
+	fd := reflected.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return reflected.Get(fd).String()
+}
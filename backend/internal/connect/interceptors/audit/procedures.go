@@ -0,0 +1,45 @@
+package audit_interceptor
+
+import (
+	"strings"
+)
+
+var (
+	mutatingMethodPrefixes = []string{
+		"Create",
+		"Update",
+		"Delete",
+		"Set",
+		"Remove",
+		"Invite",
+		"Accept",
+		"Regenerate",
+		"Cancel",
+		"Terminate",
+		"Pause",
+		"Convert",
+	}
+
+	// Mutating methods that are called as part of normal operation and would only add noise to the audit log
+	ignoredMethods = map[string]struct{}{
+		"SetUser":                    {},
+		"SetPersonalAccount":         {},
+		"SetRunContext":              {},
+		"SetRunContexts":             {},
+		"SetAccountOnboardingConfig": {},
+	}
+)
+
+// Returns true if the procedure mutates state and should be recorded in the audit log
+func IsAuditedProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	if _, ok := ignoredMethods[method]; ok {
+		return false
+	}
+	for _, prefix := range mutatingMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
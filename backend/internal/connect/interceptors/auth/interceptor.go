@@ -9,15 +9,23 @@ import (
 
 type Interceptor struct {
 	authFunc           AuthFunc
+	requestAuthFunc    RequestAuthFunc
 	excludedProcedures map[string]struct{}
 }
 
 type AuthFunc func(ctx context.Context, header http.Header, spec connect.Spec) (context.Context, error)
 
+// Authorizes an individual request message after the caller has been authenticated
+type RequestAuthFunc func(ctx context.Context, spec connect.Spec, msg any) error
+
 func NewInterceptor(authFunc AuthFunc) connect.Interceptor {
 	return &Interceptor{authFunc: authFunc}
 }
 
+func NewInterceptorWithRequestAuth(authFunc AuthFunc, requestAuthFunc RequestAuthFunc) connect.Interceptor {
+	return &Interceptor{authFunc: authFunc, requestAuthFunc: requestAuthFunc}
+}
+
 func NewInterceptorWithExclude(authFunc AuthFunc, excludedProcedures []string) connect.Interceptor {
 	excludedMap := map[string]struct{}{}
 	for _, proc := range excludedProcedures {
@@ -36,6 +44,11 @@ func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		if err != nil {
 			return nil, err
 		}
+		if i.requestAuthFunc != nil {
+			if err := i.requestAuthFunc(newCtx, request.Spec(), request.Any()); err != nil {
+				return nil, err
+			}
+		}
 		return next(newCtx, request)
 	}
 }
@@ -55,6 +68,23 @@ func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) co
 		if err != nil {
 			return err
 		}
+		if i.requestAuthFunc != nil {
+			conn = &requestAuthStreamingHandlerConn{StreamingHandlerConn: conn, ctx: newCtx, requestAuthFunc: i.requestAuthFunc}
+		}
 		return next(newCtx, conn)
 	}
 }
+
+// Authorizes each message as it is received from the client
+type requestAuthStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx             context.Context
+	requestAuthFunc RequestAuthFunc
+}
+
+func (c *requestAuthStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.requestAuthFunc(c.ctx, c.Spec(), msg)
+}
@@ -101,3 +101,38 @@ func Test_Interceptor_WrapUnary_WithExclude(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp2)
 }
+
+func Test_Interceptor_WrapUnary_WithRequestAuth(t *testing.T) {
+	interceptor := NewInterceptorWithRequestAuth(
+		func(ctx context.Context, header http.Header, spec connect.Spec) (context.Context, error) {
+			return ctx, nil
+		},
+		func(ctx context.Context, spec connect.Spec, msg any) error {
+			req, ok := msg.(*mgmtv1alpha1.CreateJobRunRequest)
+			if !ok || req.GetJobId() != "allowed" {
+				return errors.New("job not allowed")
+			}
+			return nil
+		},
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceCreateJobRunProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.CreateJobRunRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+			return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+		},
+		connect.WithInterceptors(interceptor),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
+	resp, err := client.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{JobId: "allowed"}))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	resp, err = client.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{JobId: "other"}))
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "job not allowed")
+	assert.Nil(t, resp)
+}
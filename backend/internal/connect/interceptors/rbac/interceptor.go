@@ -0,0 +1,47 @@
+package rbac_interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+// Injects the account role required by the requested procedure into the context.
+// The role is enforced once the account being acted upon is known, which is when the user's account membership is verified.
+type Interceptor struct {
+	procedureRoles map[string]mgmtv1alpha1.AccountRole
+}
+
+func NewInterceptor() connect.Interceptor {
+	return &Interceptor{procedureRoles: procedureRoles}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		newCtx := SetRequiredRoleContext(ctx, i.getRequiredRole(request.Spec().Procedure))
+		return next(newCtx, request)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		newCtx := SetRequiredRoleContext(ctx, i.getRequiredRole(conn.Spec().Procedure))
+		return next(newCtx, conn)
+	}
+}
+
+// Procedures that have not been explicitly assigned a role require an admin
+func (i *Interceptor) getRequiredRole(procedure string) mgmtv1alpha1.AccountRole {
+	role, ok := i.procedureRoles[procedure]
+	if !ok {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN
+	}
+	return role
+}
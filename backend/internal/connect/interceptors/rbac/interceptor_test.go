@@ -0,0 +1,104 @@
+package rbac_interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Interceptor_WrapUnary_InjectRequiredRole(t *testing.T) {
+	interceptor := NewInterceptor()
+
+	var ctxRole mgmtv1alpha1.AccountRole
+	var ctxOk bool
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceCreateJobRunProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.CreateJobRunRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+			ctxRole, ctxOk = GetRequiredRoleFromContext(ctx)
+			return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+		},
+		connect.WithInterceptors(interceptor),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
+	_, err := client.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{}))
+	assert.NoError(t, err)
+	assert.True(t, ctxOk)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR, ctxRole)
+}
+
+func Test_Interceptor_WrapUnary_DefaultsToAdmin(t *testing.T) {
+	interceptor := NewInterceptor()
+
+	var ctxRole mgmtv1alpha1.AccountRole
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.CreateConnectionRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error) {
+			ctxRole, _ = GetRequiredRoleFromContext(ctx)
+			return connect.NewResponse(&mgmtv1alpha1.CreateConnectionResponse{}), nil
+		},
+		connect.WithInterceptors(interceptor),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := mgmtv1alpha1connect.NewConnectionServiceClient(srv.Client(), srv.URL)
+	_, err := client.CreateConnection(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateConnectionRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, ctxRole)
+}
+
+func Test_GetRequiredRoleFromContext_NotSet(t *testing.T) {
+	role, ok := GetRequiredRoleFromContext(context.Background())
+	assert.False(t, ok)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, role)
+}
+
+func Test_RequireRoleContext(t *testing.T) {
+	ctx := RequireRoleContext(SetRequiredRoleContext(context.Background(), operatorRole), jobEditorRole)
+	role, ok := GetRequiredRoleFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, jobEditorRole, role)
+
+	ctx = RequireRoleContext(SetRequiredRoleContext(context.Background(), adminRole), jobEditorRole)
+	role, _ = GetRequiredRoleFromContext(ctx)
+	assert.Equal(t, adminRole, role, "the required role is never lowered")
+
+	_, ok = GetRequiredRoleFromContext(RequireRoleContext(context.Background(), jobEditorRole))
+	assert.False(t, ok, "contexts without a required role are left alone")
+}
+
+func Test_IsRoleAllowed(t *testing.T) {
+	assert.True(t, IsRoleAllowed(adminRole, viewerRole))
+	assert.True(t, IsRoleAllowed(adminRole, adminRole))
+	assert.True(t, IsRoleAllowed(jobEditorRole, operatorRole))
+	assert.True(t, IsRoleAllowed(operatorRole, operatorRole))
+	assert.False(t, IsRoleAllowed(operatorRole, jobEditorRole))
+	assert.False(t, IsRoleAllowed(viewerRole, operatorRole))
+	assert.False(t, IsRoleAllowed(jobEditorRole, adminRole))
+	assert.False(t, IsRoleAllowed(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, viewerRole))
+}
+
+func startHTTPServer(tb testing.TB, h http.Handler) *httptest.Server {
+	tb.Helper()
+	srv := httptest.NewUnstartedServer(h)
+	srv.EnableHTTP2 = true
+	srv.Start()
+	tb.Cleanup(srv.Close)
+	return srv
+}
+
+func Test_ProcedureRoles_OnboardingConfigRequiresJobEditor(t *testing.T) {
+	assert.Equal(t, viewerRole, procedureRoles[mgmtv1alpha1connect.UserAccountServiceGetAccountOnboardingConfigProcedure])
+	assert.Equal(t, jobEditorRole, procedureRoles[mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure])
+}
@@ -0,0 +1,36 @@
+package rbac_interceptor
+
+import (
+	"context"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type rbacContextKey struct{}
+type rbacContextData struct {
+	requiredRole mgmtv1alpha1.AccountRole
+}
+
+// Returns the account role required by the current procedure.
+// Returns false if the context was not populated by the rbac interceptor.
+func GetRequiredRoleFromContext(ctx context.Context) (mgmtv1alpha1.AccountRole, bool) {
+	data, ok := ctx.Value(rbacContextKey{}).(*rbacContextData)
+	if !ok {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, false
+	}
+	return data.requiredRole, true
+}
+
+func SetRequiredRoleContext(ctx context.Context, role mgmtv1alpha1.AccountRole) context.Context {
+	return context.WithValue(ctx, rbacContextKey{}, &rbacContextData{requiredRole: role})
+}
+
+// Raises the account role required by the current procedure.
+// Used by requests whose arguments call for more privileges than the procedure requires by default. The required role is never lowered.
+func RequireRoleContext(ctx context.Context, role mgmtv1alpha1.AccountRole) context.Context {
+	current, ok := GetRequiredRoleFromContext(ctx)
+	if !ok || IsRoleAllowed(current, role) {
+		return ctx
+	}
+	return SetRequiredRoleContext(ctx, role)
+}
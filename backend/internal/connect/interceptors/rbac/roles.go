@@ -0,0 +1,104 @@
+package rbac_interceptor
+
+import (
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+const (
+	adminRole     = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN
+	jobEditorRole = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR
+	operatorRole  = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR
+	viewerRole    = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER
+)
+
+// The minimum role required to call each procedure that acts on an account.
+// Connection, notification channel, api key, account setting and team member management are left to admins.
+// Procedures that are only called by the worker are not listed, so they require admin when called by a user.
+var procedureRoles = map[string]mgmtv1alpha1.AccountRole{
+	// viewer
+	mgmtv1alpha1connect.UserAccountServiceIsUserInAccountProcedure:                    viewerRole,
+	mgmtv1alpha1connect.UserAccountServiceGetTeamAccountMembersProcedure:              viewerRole,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountTemporalConfigProcedure:           viewerRole,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountOnboardingConfigProcedure:         viewerRole,
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure:                      viewerRole,
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure:                       viewerRole,
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionColumnTagsProcedure:             viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure:             viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapProcedure:          viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapsProcedure:         viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure: viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure: viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:  viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionTableConstraintsProcedure:   viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure:     viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetTableRowCountProcedure:                viewerRole,
+	mgmtv1alpha1connect.ConnectionDataServiceCompareConnectionSchemasProcedure:        viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobsProcedure:                                    viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                                     viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                               viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobStatusesProcedure:                             viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                           viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                             viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                                 viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobRunProcedure:                                  viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure:                            viewerRole,
+	mgmtv1alpha1connect.JobServiceWatchJobRunProcedure:                                viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure:                        viewerRole,
+	mgmtv1alpha1connect.JobServiceGetRunContextProcedure:                              viewerRole,
+	mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure:                         viewerRole,
+	mgmtv1alpha1connect.JobServiceGetJobMappingPolicyConfigProcedure:                  viewerRole,
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformersProcedure:             viewerRole,
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformerBySourceProcedure:      viewerRole,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure:        viewerRole,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure:     viewerRole,
+	mgmtv1alpha1connect.TransformersServiceIsTransformerNameAvailableProcedure:        viewerRole,
+	mgmtv1alpha1connect.MetricsServiceGetMetricCountProcedure:                         viewerRole,
+	mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure:                    viewerRole,
+	mgmtv1alpha1connect.NotificationServiceGetNotificationChannelsProcedure:           viewerRole,
+	mgmtv1alpha1connect.NotificationServiceGetNotificationChannelProcedure:            viewerRole,
+	mgmtv1alpha1connect.NotificationServiceGetJobNotificationSubscriptionsProcedure:   viewerRole,
+
+	// operator
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:    operatorRole,
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:    operatorRole,
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure: operatorRole,
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:    operatorRole,
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:        operatorRole,
+
+	// job editor
+	mgmtv1alpha1connect.JobServiceCreateJobProcedure:                                jobEditorRole,
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                                jobEditorRole,
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                        jobEditorRole,
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:                jobEditorRole,
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure:         jobEditorRole,
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:           jobEditorRole,
+	mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:           jobEditorRole,
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:          jobEditorRole,
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:                    jobEditorRole,
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                        jobEditorRole,
+	mgmtv1alpha1connect.JobServiceValidateJobMappingsProcedure:                      jobEditorRole,
+	mgmtv1alpha1connect.JobServiceSetRunContextProcedure:                            jobEditorRole,
+	mgmtv1alpha1connect.JobServiceSetRunContextsProcedure:                           jobEditorRole,
+	mgmtv1alpha1connect.ConnectionServiceCheckSqlQueryProcedure:                     jobEditorRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure:       jobEditorRole,
+	mgmtv1alpha1connect.ConnectionDataServiceGetAiGeneratedDataProcedure:            jobEditorRole,
+	mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure:          jobEditorRole,
+	mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure:    jobEditorRole,
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure:    jobEditorRole,
+	mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure:    jobEditorRole,
+	mgmtv1alpha1connect.TransformersServiceValidateUserJavascriptCodeProcedure:      jobEditorRole,
+	mgmtv1alpha1connect.TransformersServiceValidateUserRegexCodeProcedure:           jobEditorRole,
+	mgmtv1alpha1connect.NotificationServiceSetJobNotificationSubscriptionsProcedure: jobEditorRole,
+	mgmtv1alpha1connect.NotificationServiceSendTestNotificationProcedure:            jobEditorRole,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure:       jobEditorRole,
+}
+
+// Returns true if the given role satisfies the required role.
+// Roles are ordered from admin (most privileged) to viewer (least privileged).
+func IsRoleAllowed(role, requiredRole mgmtv1alpha1.AccountRole) bool {
+	if role == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED || requiredRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return false
+	}
+	return role <= requiredRole
+}
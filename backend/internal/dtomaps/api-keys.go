@@ -22,5 +22,7 @@ func ToAccountApiKeyDto(
 		KeyValue:    cleartextKeyValue,
 		UserId:      nucleusdb.UUIDString(input.UserID),
 		ExpiresAt:   timestamppb.New(input.ExpiresAt.Time),
+		Scopes:      input.Scopes,
+		JobIds:      nucleusdb.UUIDStrings(input.JobIds),
 	}
 }
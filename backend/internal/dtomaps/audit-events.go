@@ -0,0 +1,39 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToAuditEventDto(
+	input *db_queries.NeosyncApiAuditEvent,
+) *mgmtv1alpha1.AuditEvent {
+	dto := &mgmtv1alpha1.AuditEvent{
+		Id:          nucleusdb.UUIDString(input.ID),
+		AccountId:   nucleusdb.UUIDString(input.AccountID),
+		Procedure:   input.Procedure,
+		RequestJson: string(input.Request),
+		CreatedAt:   timestamppb.New(input.CreatedAt.Time),
+	}
+	if input.ActorUserID.Valid {
+		userId := nucleusdb.UUIDString(input.ActorUserID)
+		dto.ActorUserId = &userId
+	}
+	if input.ActorApiKeyID.Valid {
+		apiKeyId := nucleusdb.UUIDString(input.ActorApiKeyID)
+		dto.ActorApiKeyId = &apiKeyId
+	}
+	if input.ResourceID.Valid {
+		dto.ResourceId = &input.ResourceID.String
+	}
+	if input.ErrorCode.Valid {
+		dto.ErrorCode = &input.ErrorCode.String
+	}
+	if input.Changes != nil {
+		changes := string(input.Changes)
+		dto.ChangesJson = &changes
+	}
+	return dto
+}
@@ -25,3 +25,14 @@ func ToConnectionDto(
 		AccountId:        nucleusdb.UUIDString(input.AccountID),
 	}, nil
 }
+
+func ToColumnTagsDto(
+	input *db_queries.NeosyncApiConnectionColumnTag,
+) *mgmtv1alpha1.ColumnTags {
+	return &mgmtv1alpha1.ColumnTags{
+		Schema: input.SchemaName,
+		Table:  input.TableName,
+		Column: input.ColumnName,
+		Tags:   input.Tags,
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log/slog"
 
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	temporalfailure "go.temporal.io/api/failure/v1"
@@ -41,6 +43,34 @@ func ToJobRunDtoFromWorkflowExecutionInfo(workflow *workflowpb.WorkflowExecution
 	}
 }
 
+// returns a job run from the run history stored in the Neosync database
+func ToJobRunDtoFromDb(input *db_queries.NeosyncApiJobRun, name string) *mgmtv1alpha1.JobRun {
+	dto := &mgmtv1alpha1.JobRun{
+		Id:        input.ID,
+		JobId:     nucleusdb.UUIDString(input.JobID),
+		Name:      name,
+		Status:    mgmtv1alpha1.JobRunStatus(input.Status),
+		StartedAt: timestamppb.New(input.StartedAt.Time),
+		Tables:    make([]*mgmtv1alpha1.JobRunTableSummary, 0, len(input.Tables)),
+	}
+	if input.CompletedAt.Valid {
+		dto.CompletedAt = timestamppb.New(input.CompletedAt.Time)
+	}
+	for _, table := range input.Tables {
+		dto.Tables = append(dto.Tables, table.ToDto())
+	}
+	if input.ErrorMessage.Valid {
+		dto.ErrorMessage = &input.ErrorMessage.String
+	}
+	if input.TriggeredBy.Valid {
+		dto.TriggeredBy = &input.TriggeredBy.String
+	}
+	if input.Overrides != nil {
+		dto.Overrides = input.Overrides.ToDto()
+	}
+	return dto
+}
+
 func GetJobIdFromWorkflow(logger *slog.Logger, searchAttributes *commonpb.SearchAttributes) string {
 	scheduledByIDPayload := searchAttributes.IndexedFields["TemporalScheduledById"]
 	var scheduledByID string
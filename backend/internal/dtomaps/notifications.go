@@ -0,0 +1,40 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToNotificationChannelDto(
+	input *db_queries.NeosyncApiNotificationChannel,
+) (*mgmtv1alpha1.NotificationChannel, error) {
+	config, err := input.Config.ToDto()
+	if err != nil {
+		return nil, err
+	}
+	return &mgmtv1alpha1.NotificationChannel{
+		Id:              nucleusdb.UUIDString(input.ID),
+		AccountId:       nucleusdb.UUIDString(input.AccountID),
+		Name:            input.Name,
+		Config:          config,
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+		UpdatedByUserId: nucleusdb.UUIDString(input.UpdatedByID),
+		UpdatedAt:       timestamppb.New(input.UpdatedAt.Time),
+	}, nil
+}
+
+func ToJobNotificationSubscriptionDto(
+	input *db_queries.NeosyncApiJobNotificationSubscription,
+) *mgmtv1alpha1.JobNotificationSubscription {
+	events := make([]mgmtv1alpha1.NotificationEventType, 0, len(input.Events))
+	for _, event := range input.Events {
+		events = append(events, mgmtv1alpha1.NotificationEventType(event))
+	}
+	return &mgmtv1alpha1.JobNotificationSubscription{
+		ChannelId: nucleusdb.UUIDString(input.ChannelID),
+		Events:    events,
+	}
+}
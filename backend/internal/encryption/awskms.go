@@ -0,0 +1,74 @@
+package encryption
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+const (
+	AwsKmsProviderName = "awskms"
+)
+
+type awsKmsClient interface {
+	Encrypt(ctx context.Context, params *kms.EncryptInput, optFns ...func(*kms.Options)) (*kms.EncryptOutput, error)
+	Decrypt(ctx context.Context, params *kms.DecryptInput, optFns ...func(*kms.Options)) (*kms.DecryptOutput, error)
+}
+
+// Wraps data keys with an AWS KMS symmetric key
+type AwsKmsKeyProvider struct {
+	client awsKmsClient
+	keyId  string
+}
+
+var _ KeyProvider = (*AwsKmsKeyProvider)(nil)
+
+func NewAwsKmsKeyProvider(client awsKmsClient, keyId string) *AwsKmsKeyProvider {
+	return &AwsKmsKeyProvider{client: client, keyId: keyId}
+}
+
+// Creates a provider that authenticates with the default AWS credential chain
+func NewAwsKmsKeyProviderFromEnv(ctx context.Context, keyId string, region *string) (*AwsKmsKeyProvider, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := kms.NewFromConfig(cfg, func(o *kms.Options) {
+		if region != nil && *region != "" {
+			o.Region = *region
+		}
+	})
+	return NewAwsKmsKeyProvider(client, keyId), nil
+}
+
+func (p *AwsKmsKeyProvider) Name() string {
+	return AwsKmsProviderName
+}
+
+func (p *AwsKmsKeyProvider) KeyId() string {
+	return p.keyId
+}
+
+func (p *AwsKmsKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	output, err := p.client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(p.keyId),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.CiphertextBlob, nil
+}
+
+func (p *AwsKmsKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	output, err := p.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(keyId),
+		CiphertextBlob: wrappedKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Plaintext, nil
+}
@@ -0,0 +1,98 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/viper"
+)
+
+type Config struct {
+	// The provider that wraps new data keys. Encryption at rest is disabled if empty.
+	Provider string
+
+	LocalKeyringPath *string
+
+	AwsKmsKeyId  *string
+	AwsKmsRegion *string
+
+	GcpKmsKeyName *string
+
+	Vault *VaultTransitConfig
+}
+
+// Reads the encryption configuration from the environment
+func GetConfigFromEnv() *Config {
+	cfg := &Config{
+		Provider: viper.GetString("CONNECTION_ENCRYPTION_PROVIDER"),
+	}
+	if path := viper.GetString("CONNECTION_ENCRYPTION_LOCAL_KEYRING_PATH"); path != "" {
+		cfg.LocalKeyringPath = &path
+	}
+	if keyId := viper.GetString("CONNECTION_ENCRYPTION_AWS_KMS_KEY_ID"); keyId != "" {
+		cfg.AwsKmsKeyId = &keyId
+		region := viper.GetString("CONNECTION_ENCRYPTION_AWS_KMS_REGION")
+		cfg.AwsKmsRegion = &region
+	}
+	if keyName := viper.GetString("CONNECTION_ENCRYPTION_GCP_KMS_KEY_NAME"); keyName != "" {
+		cfg.GcpKmsKeyName = &keyName
+	}
+	if keyName := viper.GetString("CONNECTION_ENCRYPTION_VAULT_KEY_NAME"); keyName != "" {
+		cfg.Vault = &VaultTransitConfig{
+			Address:   viper.GetString("CONNECTION_ENCRYPTION_VAULT_ADDR"),
+			Token:     viper.GetString("CONNECTION_ENCRYPTION_VAULT_TOKEN"),
+			MountPath: viper.GetString("CONNECTION_ENCRYPTION_VAULT_TRANSIT_MOUNT"),
+			KeyName:   keyName,
+		}
+	}
+	return cfg
+}
+
+// Builds the encryptor from the configuration. Returns nil if no provider has been configured.
+// Every provider that has been configured is available for decryption, which allows switching providers
+// and then re-encrypting existing data with the new provider.
+func NewEncryptorFromConfig(ctx context.Context, cfg *Config) (*Encryptor, error) {
+	if cfg.Provider == "" {
+		return nil, nil
+	}
+
+	providers := map[string]KeyProvider{}
+	if cfg.LocalKeyringPath != nil {
+		provider, err := NewLocalKeyProviderFromFile(*cfg.LocalKeyringPath)
+		if err != nil {
+			return nil, err
+		}
+		providers[provider.Name()] = provider
+	}
+	if cfg.AwsKmsKeyId != nil {
+		provider, err := NewAwsKmsKeyProviderFromEnv(ctx, *cfg.AwsKmsKeyId, cfg.AwsKmsRegion)
+		if err != nil {
+			return nil, err
+		}
+		providers[provider.Name()] = provider
+	}
+	if cfg.GcpKmsKeyName != nil {
+		provider, err := NewGcpKmsKeyProviderFromEnv(ctx, *cfg.GcpKmsKeyName)
+		if err != nil {
+			return nil, err
+		}
+		providers[provider.Name()] = provider
+	}
+	if cfg.Vault != nil {
+		provider := NewVaultTransitKeyProvider(http.DefaultClient, cfg.Vault)
+		providers[provider.Name()] = provider
+	}
+
+	primary, ok := providers[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("encryption provider %q was selected but has not been configured", cfg.Provider)
+	}
+	decryptOnly := make([]KeyProvider, 0, len(providers))
+	for name, provider := range providers {
+		if name != cfg.Provider {
+			decryptOnly = append(decryptOnly, provider)
+		}
+	}
+	return New(primary, decryptOnly...), nil
+}
@@ -0,0 +1,130 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+const (
+	dataKeySize = 32
+)
+
+// A KeyProvider wraps and unwraps the data keys that are used to encrypt data at rest.
+// The key encryption key never leaves the provider.
+type KeyProvider interface {
+	// The unique name of the provider. This is stored alongside encrypted data so that it can later be decrypted.
+	Name() string
+	// The identifier of the key that new data keys are wrapped with
+	KeyId() string
+	// Wraps the data key with the provider's current key
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwraps a data key that was previously wrapped with the given key
+	UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+// An envelope holds data that has been encrypted with a random data key, along with the wrapped data key
+type Envelope struct {
+	Provider   string `json:"provider"`
+	KeyId      string `json:"keyId"`
+	DataKey    []byte `json:"dataKey"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Performs envelope encryption with AES-256-GCM data keys that are wrapped by the primary key provider.
+// Additional providers may be registered to decrypt data that was encrypted before the primary provider changed.
+type Encryptor struct {
+	primary   KeyProvider
+	providers map[string]KeyProvider
+}
+
+func New(primary KeyProvider, decryptOnly ...KeyProvider) *Encryptor {
+	providers := map[string]KeyProvider{}
+	for _, provider := range decryptOnly {
+		providers[provider.Name()] = provider
+	}
+	providers[primary.Name()] = primary
+	return &Encryptor{primary: primary, providers: providers}
+}
+
+func (e *Encryptor) Encrypt(ctx context.Context, plaintext []byte) (*Envelope, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("unable to generate data key: %w", err)
+	}
+	nonce, ciphertext, err := seal(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := e.primary.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key with %s provider: %w", e.primary.Name(), err)
+	}
+	return &Envelope{
+		Provider:   e.primary.Name(),
+		KeyId:      e.primary.KeyId(),
+		DataKey:    wrappedKey,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func (e *Encryptor) Decrypt(ctx context.Context, envelope *Envelope) ([]byte, error) {
+	if envelope == nil {
+		return nil, errors.New("envelope must not be nil")
+	}
+	provider, ok := e.providers[envelope.Provider]
+	if !ok {
+		return nil, fmt.Errorf("no key provider has been configured for %q", envelope.Provider)
+	}
+	dataKey, err := provider.UnwrapKey(ctx, envelope.KeyId, envelope.DataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key with %s provider: %w", envelope.Provider, err)
+	}
+	return open(dataKey, envelope.Nonce, envelope.Ciphertext)
+}
+
+// Returns true if the envelope's data key was wrapped by the primary provider's current key.
+// Envelopes that are not current should be re-encrypted when rotating keys.
+func (e *Encryptor) IsCurrent(envelope *Envelope) bool {
+	return envelope != nil && envelope.Provider == e.primary.Name() && envelope.KeyId == e.primary.KeyId()
+}
+
+func seal(key, plaintext []byte) (nonce, ciphertext []byte, err error) {
+	aead, err := newGcm(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return nonce, aead.Seal(nil, nonce, plaintext, nil), nil
+}
+
+func open(key, nonce, ciphertext []byte) ([]byte, error) {
+	aead, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt ciphertext: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,109 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Encryptor_RoundTrip(t *testing.T) {
+	provider := newTestLocalProvider(t, "k1", "k1")
+	encryptor := New(provider)
+
+	envelope, err := encryptor.Encrypt(context.Background(), []byte("super secret"))
+	require.NoError(t, err)
+	require.Equal(t, LocalProviderName, envelope.Provider)
+	require.Equal(t, "k1", envelope.KeyId)
+	require.NotContains(t, string(envelope.Ciphertext), "super secret")
+	require.True(t, encryptor.IsCurrent(envelope))
+
+	plaintext, err := encryptor.Decrypt(context.Background(), envelope)
+	require.NoError(t, err)
+	require.Equal(t, "super secret", string(plaintext))
+}
+
+func Test_Encryptor_Decrypt_Tampered(t *testing.T) {
+	encryptor := New(newTestLocalProvider(t, "k1", "k1"))
+
+	envelope, err := encryptor.Encrypt(context.Background(), []byte("super secret"))
+	require.NoError(t, err)
+	envelope.Ciphertext[0] ^= 0xff
+
+	_, err = encryptor.Decrypt(context.Background(), envelope)
+	require.Error(t, err)
+}
+
+func Test_Encryptor_Rotation(t *testing.T) {
+	keyring := &LocalKeyring{
+		PrimaryKeyId: "k1",
+		Keys:         []*LocalKeyringKey{{Id: "k1", Key: newTestKey(t)}},
+	}
+	oldProvider, err := NewLocalKeyProvider(keyring)
+	require.NoError(t, err)
+	envelope, err := New(oldProvider).Encrypt(context.Background(), []byte("super secret"))
+	require.NoError(t, err)
+
+	keyring.Keys = append(keyring.Keys, &LocalKeyringKey{Id: "k2", Key: newTestKey(t)})
+	keyring.PrimaryKeyId = "k2"
+	newProvider, err := NewLocalKeyProvider(keyring)
+	require.NoError(t, err)
+	encryptor := New(newProvider)
+
+	require.False(t, encryptor.IsCurrent(envelope))
+	plaintext, err := encryptor.Decrypt(context.Background(), envelope)
+	require.NoError(t, err)
+	require.Equal(t, "super secret", string(plaintext))
+}
+
+func Test_Encryptor_DecryptOnlyProvider(t *testing.T) {
+	oldProvider := newTestLocalProvider(t, "k1", "k1")
+	envelope, err := New(oldProvider).Encrypt(context.Background(), []byte("super secret"))
+	require.NoError(t, err)
+
+	primary := NewVaultTransitKeyProvider(nil, &VaultTransitConfig{KeyName: "neosync"})
+
+	_, err = New(primary).Decrypt(context.Background(), envelope)
+	require.Error(t, err)
+
+	encryptor := New(primary, oldProvider)
+	require.False(t, encryptor.IsCurrent(envelope))
+	plaintext, err := encryptor.Decrypt(context.Background(), envelope)
+	require.NoError(t, err)
+	require.Equal(t, "super secret", string(plaintext))
+}
+
+func Test_NewLocalKeyProvider_Invalid(t *testing.T) {
+	_, err := NewLocalKeyProvider(&LocalKeyring{
+		PrimaryKeyId: "missing",
+		Keys:         []*LocalKeyringKey{{Id: "k1", Key: newTestKey(t)}},
+	})
+	require.Error(t, err)
+
+	_, err = NewLocalKeyProvider(&LocalKeyring{
+		PrimaryKeyId: "k1",
+		Keys:         []*LocalKeyringKey{{Id: "k1", Key: base64.StdEncoding.EncodeToString([]byte("short"))}},
+	})
+	require.Error(t, err)
+}
+
+func newTestLocalProvider(t *testing.T, primaryKeyId string, keyIds ...string) *LocalKeyProvider {
+	t.Helper()
+	keyring := &LocalKeyring{PrimaryKeyId: primaryKeyId}
+	for _, keyId := range keyIds {
+		keyring.Keys = append(keyring.Keys, &LocalKeyringKey{Id: keyId, Key: newTestKey(t)})
+	}
+	provider, err := NewLocalKeyProvider(keyring)
+	require.NoError(t, err)
+	return provider
+}
+
+func newTestKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
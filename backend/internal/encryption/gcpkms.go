@@ -0,0 +1,71 @@
+package encryption
+
+import (
+	"context"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/googleapis/gax-go/v2"
+)
+
+const (
+	GcpKmsProviderName = "gcpkms"
+)
+
+type gcpKmsClient interface {
+	Encrypt(ctx context.Context, req *kmspb.EncryptRequest, opts ...gax.CallOption) (*kmspb.EncryptResponse, error)
+	Decrypt(ctx context.Context, req *kmspb.DecryptRequest, opts ...gax.CallOption) (*kmspb.DecryptResponse, error)
+}
+
+// Wraps data keys with a GCP Cloud KMS symmetric key.
+// The key name is the full resource name: projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>
+type GcpKmsKeyProvider struct {
+	client  gcpKmsClient
+	keyName string
+}
+
+var _ KeyProvider = (*GcpKmsKeyProvider)(nil)
+
+func NewGcpKmsKeyProvider(client gcpKmsClient, keyName string) *GcpKmsKeyProvider {
+	return &GcpKmsKeyProvider{client: client, keyName: keyName}
+}
+
+// Creates a provider that authenticates with the application default credentials
+func NewGcpKmsKeyProviderFromEnv(ctx context.Context, keyName string) (*GcpKmsKeyProvider, error) {
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewGcpKmsKeyProvider(client, keyName), nil
+}
+
+func (p *GcpKmsKeyProvider) Name() string {
+	return GcpKmsProviderName
+}
+
+func (p *GcpKmsKeyProvider) KeyId() string {
+	return p.keyName
+}
+
+func (p *GcpKmsKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	resp, err := p.client.Encrypt(ctx, &kmspb.EncryptRequest{
+		Name:      p.keyName,
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCiphertext(), nil
+}
+
+// Cloud KMS determines the key version from the ciphertext, so only the key name is needed
+func (p *GcpKmsKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	resp, err := p.client.Decrypt(ctx, &kmspb.DecryptRequest{
+		Name:       keyId,
+		Ciphertext: wrappedKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPlaintext(), nil
+}
@@ -0,0 +1,97 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	LocalProviderName = "local"
+)
+
+// The format of the local keyring file.
+// Keys are base64 encoded 32 byte AES-256 keys. Old keys are kept in the keyring so that data wrapped with them can still be decrypted.
+//
+//	{"primaryKeyId": "2024-09", "keys": [{"id": "2024-08", "key": "..."}, {"id": "2024-09", "key": "..."}]}
+type LocalKeyring struct {
+	PrimaryKeyId string             `json:"primaryKeyId"`
+	Keys         []*LocalKeyringKey `json:"keys"`
+}
+
+type LocalKeyringKey struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// Wraps data keys with AES-256-GCM keys that are read from a keyring file on disk
+type LocalKeyProvider struct {
+	primaryKeyId string
+	keys         map[string][]byte
+}
+
+var _ KeyProvider = (*LocalKeyProvider)(nil)
+
+func NewLocalKeyProviderFromFile(path string) (*LocalKeyProvider, error) {
+	bits, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring file: %w", err)
+	}
+	var keyring LocalKeyring
+	if err := json.Unmarshal(bits, &keyring); err != nil {
+		return nil, fmt.Errorf("unable to parse keyring file: %w", err)
+	}
+	return NewLocalKeyProvider(&keyring)
+}
+
+func NewLocalKeyProvider(keyring *LocalKeyring) (*LocalKeyProvider, error) {
+	keys := map[string][]byte{}
+	for _, key := range keyring.Keys {
+		decoded, err := base64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", key.Id, err)
+		}
+		if len(decoded) != dataKeySize {
+			return nil, fmt.Errorf("key %q must be %d bytes, got %d", key.Id, dataKeySize, len(decoded))
+		}
+		keys[key.Id] = decoded
+	}
+	if _, ok := keys[keyring.PrimaryKeyId]; !ok {
+		return nil, fmt.Errorf("primary key %q was not found in the keyring", keyring.PrimaryKeyId)
+	}
+	return &LocalKeyProvider{primaryKeyId: keyring.PrimaryKeyId, keys: keys}, nil
+}
+
+func (p *LocalKeyProvider) Name() string {
+	return LocalProviderName
+}
+
+func (p *LocalKeyProvider) KeyId() string {
+	return p.primaryKeyId
+}
+
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	nonce, ciphertext, err := seal(p.keys[p.primaryKeyId], dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce, ciphertext...), nil
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("key %q was not found in the keyring", keyId)
+	}
+	aead, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	return open(key, wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():])
+}
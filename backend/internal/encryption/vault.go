@@ -0,0 +1,120 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	VaultTransitProviderName = "vault"
+
+	defaultVaultTransitMount = "transit"
+)
+
+// Wraps data keys with a key managed by the HashiCorp Vault transit secrets engine
+type VaultTransitKeyProvider struct {
+	httpClient *http.Client
+	address    string
+	token      string
+	mountPath  string
+	keyName    string
+}
+
+var _ KeyProvider = (*VaultTransitKeyProvider)(nil)
+
+type VaultTransitConfig struct {
+	// The address of the vault server. Ex: https://vault.example.com:8200
+	Address string
+	Token   string
+	// The path the transit secrets engine is mounted at. Defaults to transit
+	MountPath string
+	KeyName   string
+}
+
+func NewVaultTransitKeyProvider(httpClient *http.Client, cfg *VaultTransitConfig) *VaultTransitKeyProvider {
+	mountPath := strings.Trim(cfg.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultVaultTransitMount
+	}
+	return &VaultTransitKeyProvider{
+		httpClient: httpClient,
+		address:    strings.TrimSuffix(cfg.Address, "/"),
+		token:      cfg.Token,
+		mountPath:  mountPath,
+		keyName:    cfg.KeyName,
+	}
+}
+
+func (p *VaultTransitKeyProvider) Name() string {
+	return VaultTransitProviderName
+}
+
+func (p *VaultTransitKeyProvider) KeyId() string {
+	return p.keyName
+}
+
+// The transit engine returns a versioned ciphertext (vault:v1:...) which is stored as is
+func (p *VaultTransitKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	err := p.post(ctx, "encrypt", p.keyName, map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+func (p *VaultTransitKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	err := p.post(ctx, "decrypt", keyId, map[string]string{
+		"ciphertext": string(wrappedKey),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+}
+
+func (p *VaultTransitKeyProvider) post(ctx context.Context, operation, keyName string, body, out any) error {
+	bits, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/v1/%s/%s/%s", p.address, p.mountPath, operation, url.PathEscape(keyName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bits))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault transit %s returned status %d: %s", operation, resp.StatusCode, string(respBody))
+	}
+	return json.Unmarshal(respBody, out)
+}
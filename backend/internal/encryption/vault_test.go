@@ -0,0 +1,60 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VaultTransitKeyProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/transit/encrypt/neosync", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		writeJson(t, w, map[string]any{"data": map[string]string{"ciphertext": "vault:v1:" + body["plaintext"]}})
+	})
+	mux.HandleFunc("/v1/transit/decrypt/neosync", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		writeJson(t, w, map[string]any{"data": map[string]string{"plaintext": body["ciphertext"][len("vault:v1:"):]}})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	provider := NewVaultTransitKeyProvider(srv.Client(), &VaultTransitConfig{
+		Address: srv.URL,
+		Token:   "test-token",
+		KeyName: "neosync",
+	})
+
+	wrapped, err := provider.WrapKey(context.Background(), []byte("data-key"))
+	require.NoError(t, err)
+	require.Equal(t, "vault:v1:"+base64.StdEncoding.EncodeToString([]byte("data-key")), string(wrapped))
+
+	unwrapped, err := provider.UnwrapKey(context.Background(), provider.KeyId(), wrapped)
+	require.NoError(t, err)
+	require.Equal(t, "data-key", string(unwrapped))
+}
+
+func Test_VaultTransitKeyProvider_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := NewVaultTransitKeyProvider(srv.Client(), &VaultTransitConfig{Address: srv.URL, KeyName: "neosync"})
+	_, err := provider.WrapKey(context.Background(), []byte("data-key"))
+	require.Error(t, err)
+}
+
+func writeJson(t *testing.T, w http.ResponseWriter, body any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
@@ -0,0 +1,331 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	// Header that holds the hex encoded HMAC-SHA256 of the webhook request body
+	SignatureHeader = "X-Neosync-Signature"
+	// Header that holds the type of the event that was sent
+	EventTypeHeader = "X-Neosync-Event"
+
+	signaturePrefix = "sha256="
+
+	// Upper bound for the whole smtp conversation of a single notification email
+	smtpTimeout = 30 * time.Second
+)
+
+// The payload that is delivered to notification channels
+type Event struct {
+	Id           string    `json:"id"`
+	Type         string    `json:"type"`
+	AccountId    string    `json:"accountId"`
+	JobId        string    `json:"jobId,omitempty"`
+	JobName      string    `json:"jobName,omitempty"`
+	JobRunId     string    `json:"jobRunId,omitempty"`
+	Message      string    `json:"message"`
+	ErrorMessage *string   `json:"errorMessage,omitempty"`
+	OccurredAt   time.Time `json:"occurredAt"`
+	// Set when the event was sent to verify the configuration of a channel
+	IsTest bool `json:"isTest,omitempty"`
+}
+
+var eventTypeNames = map[mgmtv1alpha1.NotificationEventType]string{
+	mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED:       "job_run.started",
+	mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED:     "job_run.succeeded",
+	mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED:        "job_run.failed",
+	mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT:     "job_run.timed_out",
+	mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED: "job.schema_drift_detected",
+}
+
+// Returns the name of the event type that is sent in notification payloads
+func EventTypeName(eventType mgmtv1alpha1.NotificationEventType) string {
+	if name, ok := eventTypeNames[eventType]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Delivers events to a notification channel
+type Sender interface {
+	Send(ctx context.Context, config *mgmtv1alpha1.NotificationChannelConfig, event *Event) error
+}
+
+type sendMailFunc func(ctx context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// Delivers events to notification channels
+type Notifier struct {
+	httpclient *http.Client
+	sendMail   sendMailFunc
+}
+
+var _ Sender = (*Notifier)(nil)
+
+func New(httpclient *http.Client) *Notifier {
+	return &Notifier{httpclient: httpclient, sendMail: sendMail}
+}
+
+var cgnatNet = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// Returns an http client for delivering webhook and slack notifications.
+// Channel urls are user provided, so the client refuses to connect to loopback, private and link-local addresses.
+// Proxies are not used as they would connect on the client's behalf.
+func NewHttpClient(timeout time.Duration) *http.Client {
+	dialer := newRestrictedDialer()
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func newRestrictedDialer() *net.Dialer {
+	return &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: restrictedDialControl,
+	}
+}
+
+// Runs after the address has been resolved, so hostnames that resolve to internal addresses are refused as well
+func restrictedDialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return errors.New("notification endpoint must resolve to a public address")
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!cgnatNet.Contains(ip)
+}
+
+// Delivers the event to the channel described by the config
+func (n *Notifier) Send(
+	ctx context.Context,
+	config *mgmtv1alpha1.NotificationChannelConfig,
+	event *Event,
+) error {
+	switch cfg := config.GetConfig().(type) {
+	case *mgmtv1alpha1.NotificationChannelConfig_Webhook:
+		return n.sendWebhook(ctx, cfg.Webhook, event)
+	case *mgmtv1alpha1.NotificationChannelConfig_Slack:
+		return n.sendSlack(ctx, cfg.Slack, event)
+	case *mgmtv1alpha1.NotificationChannelConfig_Email:
+		return n.sendEmail(ctx, cfg.Email, event)
+	default:
+		return fmt.Errorf("unsupported notification channel config: %T", cfg)
+	}
+}
+
+func (n *Notifier) sendWebhook(
+	ctx context.Context,
+	config *mgmtv1alpha1.WebhookNotificationConfig,
+	event *Event,
+) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to marshal notification event: %w", err)
+	}
+	headers := map[string]string{
+		EventTypeHeader: event.Type,
+		SignatureHeader: Sign([]byte(config.GetSigningSecret()), body),
+	}
+	return n.post(ctx, config.GetUrl(), body, headers)
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (n *Notifier) sendSlack(
+	ctx context.Context,
+	config *mgmtv1alpha1.SlackNotificationConfig,
+	event *Event,
+) error {
+	body, err := json.Marshal(&slackMessage{Text: formatText(event)})
+	if err != nil {
+		return fmt.Errorf("unable to marshal slack message: %w", err)
+	}
+	return n.post(ctx, config.GetUrl(), body, nil)
+}
+
+func (n *Notifier) post(ctx context.Context, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to build notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := n.httpclient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+	// the response body is not included in the error as it is returned to the caller
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (n *Notifier) sendEmail(
+	ctx context.Context,
+	config *mgmtv1alpha1.EmailNotificationConfig,
+	event *Event,
+) error {
+	var auth smtp.Auth
+	if config.GetUsername() != "" {
+		auth = smtp.PlainAuth("", config.GetUsername(), config.GetPassword(), config.GetHost())
+	}
+	addr := net.JoinHostPort(config.GetHost(), strconv.FormatUint(uint64(config.GetPort()), 10))
+	if err := n.sendMail(ctx, addr, auth, config.GetFrom(), config.GetTo(), buildEmail(config, event)); err != nil {
+		return fmt.Errorf("unable to send notification email: %w", err)
+	}
+	return nil
+}
+
+// Mirrors smtp.SendMail, but connects through the restricted dialer and bounds the conversation by smtpTimeout.
+// SMTP hosts are user provided, so they are held to the same rules as webhook urls.
+func sendMail(ctx context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	conn, err := newRestrictedDialer().DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if a != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support authentication")
+		}
+		if err := client.Auth(a); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func buildEmail(config *mgmtv1alpha1.EmailNotificationConfig, event *Event) []byte {
+	msg := strings.Builder{}
+	msg.WriteString(fmt.Sprintf("From: %s\r\n", config.GetFrom()))
+	msg.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(config.GetTo(), ", ")))
+	msg.WriteString(fmt.Sprintf("Subject: %s\r\n", formatSubject(event)))
+	msg.WriteString(fmt.Sprintf("Date: %s\r\n", event.OccurredAt.Format(time.RFC1123Z)))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(formatText(event), "\n", "\r\n"))
+	msg.WriteString("\r\n")
+	return []byte(msg.String())
+}
+
+func formatSubject(event *Event) string {
+	if event.IsTest {
+		return "[Neosync] Test notification"
+	}
+	if event.JobName != "" {
+		return fmt.Sprintf("[Neosync] %s: %s", event.JobName, event.Type)
+	}
+	return fmt.Sprintf("[Neosync] %s", event.Type)
+}
+
+func formatText(event *Event) string {
+	lines := []string{event.Message}
+	if event.ErrorMessage != nil && *event.ErrorMessage != "" {
+		lines = append(lines, fmt.Sprintf("Error: %s", *event.ErrorMessage))
+	}
+	if event.JobRunId != "" {
+		lines = append(lines, fmt.Sprintf("Job run: %s", event.JobRunId))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Computes the value of the signature header for the given request body
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Returns true if the signature header value matches the request body
+func Verify(secret, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,188 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Notifier_Send_Webhook(t *testing.T) {
+	secret := "super-secret-signing-key"
+	var received Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.True(t, Verify([]byte(secret), body, r.Header.Get(SignatureHeader)))
+		require.Equal(t, "job_run.failed", r.Header.Get(EventTypeHeader))
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	notifier := New(srv.Client())
+	err := notifier.Send(context.Background(), &mgmtv1alpha1.NotificationChannelConfig{
+		Config: &mgmtv1alpha1.NotificationChannelConfig_Webhook{
+			Webhook: &mgmtv1alpha1.WebhookNotificationConfig{Url: srv.URL, SigningSecret: secret},
+		},
+	}, newTestEvent())
+	require.NoError(t, err)
+	require.Equal(t, "job-run-id", received.JobRunId)
+	require.Equal(t, "job_run.failed", received.Type)
+}
+
+func Test_Notifier_Send_Webhook_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	notifier := New(srv.Client())
+	err := notifier.Send(context.Background(), &mgmtv1alpha1.NotificationChannelConfig{
+		Config: &mgmtv1alpha1.NotificationChannelConfig_Webhook{
+			Webhook: &mgmtv1alpha1.WebhookNotificationConfig{Url: srv.URL, SigningSecret: "super-secret-signing-key"},
+		},
+	}, newTestEvent())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "502")
+	require.NotContains(t, err.Error(), "nope")
+}
+
+func Test_NewHttpClient_RefusesInternalAddresses(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	notifier := New(NewHttpClient(5 * time.Second))
+	err := notifier.Send(context.Background(), &mgmtv1alpha1.NotificationChannelConfig{
+		Config: &mgmtv1alpha1.NotificationChannelConfig_Slack{
+			Slack: &mgmtv1alpha1.SlackNotificationConfig{Url: srv.URL},
+		},
+	}, newTestEvent())
+	require.Error(t, err)
+	require.False(t, called)
+}
+
+func Test_isPublicIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "::1", "10.0.0.5", "172.16.3.4", "192.168.1.1", "169.254.169.254", "fe80::1", "fd00::1", "0.0.0.0", "100.64.0.1", "::ffff:127.0.0.1"} {
+		require.False(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "1.1.1.1", "2606:4700:4700::1111"} {
+		require.True(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func Test_Notifier_Send_Slack(t *testing.T) {
+	var received slackMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	notifier := New(srv.Client())
+	err := notifier.Send(context.Background(), &mgmtv1alpha1.NotificationChannelConfig{
+		Config: &mgmtv1alpha1.NotificationChannelConfig_Slack{
+			Slack: &mgmtv1alpha1.SlackNotificationConfig{Url: srv.URL},
+		},
+	}, newTestEvent())
+	require.NoError(t, err)
+	require.Equal(t, "Job nightly-anonymize failed\nError: connection refused\nJob run: job-run-id", received.Text)
+}
+
+func Test_Notifier_Send_Email(t *testing.T) {
+	var sentAddr, sentFrom string
+	var sentTo []string
+	var sentMsg []byte
+	var sentAuth smtp.Auth
+	notifier := New(http.DefaultClient)
+	notifier.sendMail = func(_ context.Context, addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentAddr, sentAuth, sentFrom, sentTo, sentMsg = addr, a, from, to, msg
+		return nil
+	}
+
+	username := "neosync"
+	password := "password"
+	err := notifier.Send(context.Background(), &mgmtv1alpha1.NotificationChannelConfig{
+		Config: &mgmtv1alpha1.NotificationChannelConfig_Email{
+			Email: &mgmtv1alpha1.EmailNotificationConfig{
+				Host:     "smtp.example.com",
+				Port:     587,
+				Username: &username,
+				Password: &password,
+				From:     "neosync@example.com",
+				To:       []string{"oncall@example.com", "data@example.com"},
+			},
+		},
+	}, newTestEvent())
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com:587", sentAddr)
+	require.NotNil(t, sentAuth)
+	require.Equal(t, "neosync@example.com", sentFrom)
+	require.Equal(t, []string{"oncall@example.com", "data@example.com"}, sentTo)
+	require.True(t, strings.Contains(string(sentMsg), "Subject: [Neosync] nightly-anonymize: job_run.failed\r\n"))
+	require.True(t, strings.Contains(string(sentMsg), "To: oncall@example.com, data@example.com\r\n"))
+}
+
+func Test_sendMail_RefusesInternalAddresses(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	accepted := make(chan struct{}, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- struct{}{}
+			conn.Close()
+		}
+	}()
+
+	err = sendMail(context.Background(), listener.Addr().String(), nil, "neosync@example.com", []string{"oncall@example.com"}, []byte("hello"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "public address")
+	select {
+	case <-accepted:
+		t.Fatal("smtp connection to an internal address was accepted")
+	default:
+	}
+}
+
+func Test_Verify(t *testing.T) {
+	body := []byte(`{"id":"123"}`)
+	signature := Sign([]byte("secret"), body)
+	require.True(t, Verify([]byte("secret"), body, signature))
+	require.False(t, Verify([]byte("other"), body, signature))
+	require.False(t, Verify([]byte("secret"), []byte(`{"id":"456"}`), signature))
+	require.False(t, Verify([]byte("secret"), body, strings.TrimPrefix(signature, signaturePrefix)))
+}
+
+func Test_EventTypeName(t *testing.T) {
+	require.Equal(t, "job_run.timed_out", EventTypeName(mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT))
+	require.Equal(t, "unknown", EventTypeName(mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED))
+}
+
+func newTestEvent() *Event {
+	errMsg := "connection refused"
+	return &Event{
+		Id:           "event-id",
+		Type:         "job_run.failed",
+		AccountId:    "account-id",
+		JobId:        "job-id",
+		JobName:      "nightly-anonymize",
+		JobRunId:     "job-run-id",
+		Message:      "Job nightly-anonymize failed",
+		ErrorMessage: &errMsg,
+		OccurredAt:   time.Date(2024, 9, 5, 2, 0, 0, 0, time.UTC),
+	}
+}
@@ -13,6 +13,8 @@ type CreateAccountApiKeyRequest struct {
 	AccountUuid       pgtype.UUID
 	CreatedByUserUuid pgtype.UUID
 	ExpiresAt         pgtype.Timestamp
+	Scopes            []string
+	JobUuids          []pgtype.UUID
 }
 
 func (d *NucleusDb) CreateAccountApikey(
@@ -37,6 +39,8 @@ func (d *NucleusDb) CreateAccountApikey(
 				CreatedByID: req.CreatedByUserUuid,
 				UpdatedByID: req.CreatedByUserUuid,
 				UserID:      user.ID,
+				Scopes:      req.Scopes,
+				JobIds:      req.JobUuids,
 			},
 		)
 		if err != nil {
@@ -0,0 +1,47 @@
+package nucleusdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+// Replaces the tags of each of the given columns in a single transaction.
+// Columns without tags are removed.
+func (d *NucleusDb) SetConnectionColumnTags(
+	ctx context.Context,
+	connectionId pgtype.UUID,
+	columns []*mgmtv1alpha1.ColumnTags,
+	userUuid pgtype.UUID,
+) error {
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		for _, column := range columns {
+			if len(column.GetTags()) == 0 {
+				err := d.Q.RemoveConnectionColumnTags(ctx, dbtx, db_queries.RemoveConnectionColumnTagsParams{
+					ConnectionID: connectionId,
+					SchemaName:   column.GetSchema(),
+					TableName:    column.GetTable(),
+					ColumnName:   column.GetColumn(),
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			err := d.Q.UpsertConnectionColumnTags(ctx, dbtx, db_queries.UpsertConnectionColumnTagsParams{
+				ConnectionId: connectionId,
+				SchemaName:   column.GetSchema(),
+				TableName:    column.GetTable(),
+				ColumnName:   column.GetColumn(),
+				Tags:         column.GetTags(),
+				UserId:       userUuid,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
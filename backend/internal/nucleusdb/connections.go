@@ -0,0 +1,214 @@
+package nucleusdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+// The connection methods below must be used in place of their querier equivalents
+// so that connection configs are encrypted at rest when an encryptor has been configured.
+
+func (d *NucleusDb) CreateConnection(
+	ctx context.Context,
+	params db_queries.CreateConnectionParams,
+) (db_queries.NeosyncApiConnection, error) {
+	plaintext := params.ConnectionConfig
+	encrypted, err := d.EncryptConnectionConfig(ctx, plaintext)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	params.ConnectionConfig = encrypted
+	connection, err := d.Q.CreateConnection(ctx, d.Db, params)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	connection.ConnectionConfig = plaintext
+	return connection, nil
+}
+
+func (d *NucleusDb) UpdateConnection(
+	ctx context.Context,
+	params db_queries.UpdateConnectionParams,
+) (db_queries.NeosyncApiConnection, error) {
+	plaintext := params.ConnectionConfig
+	encrypted, err := d.EncryptConnectionConfig(ctx, plaintext)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	params.ConnectionConfig = encrypted
+	connection, err := d.Q.UpdateConnection(ctx, d.Db, params)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	connection.ConnectionConfig = plaintext
+	return connection, nil
+}
+
+func (d *NucleusDb) GetConnectionById(
+	ctx context.Context,
+	id pgtype.UUID,
+) (db_queries.NeosyncApiConnection, error) {
+	connection, err := d.Q.GetConnectionById(ctx, d.Db, id)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	if err := d.decryptConnection(ctx, &connection); err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	return connection, nil
+}
+
+func (d *NucleusDb) GetConnectionsByAccount(
+	ctx context.Context,
+	accountId pgtype.UUID,
+) ([]db_queries.NeosyncApiConnection, error) {
+	connections, err := d.Q.GetConnectionsByAccount(ctx, d.Db, accountId)
+	if err != nil {
+		return nil, err
+	}
+	return d.decryptConnections(ctx, connections)
+}
+
+func (d *NucleusDb) GetConnectionsByIds(
+	ctx context.Context,
+	ids []pgtype.UUID,
+) ([]db_queries.NeosyncApiConnection, error) {
+	connections, err := d.Q.GetConnectionsByIds(ctx, d.Db, ids)
+	if err != nil {
+		return nil, err
+	}
+	return d.decryptConnections(ctx, connections)
+}
+
+// Encrypts the connection config with the configured encryptor.
+// The config is returned as is if encryption at rest has not been configured.
+func (d *NucleusDb) EncryptConnectionConfig(
+	ctx context.Context,
+	cc *pg_models.ConnectionConfig,
+) (*pg_models.ConnectionConfig, error) {
+	if d.encryptor == nil || cc == nil {
+		return cc, nil
+	}
+	if cc.Encrypted != nil {
+		return nil, errors.New("connection config has already been encrypted")
+	}
+	bits, err := json.Marshal(cc)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := d.encryptor.Encrypt(ctx, bits)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt connection config: %w", err)
+	}
+	return &pg_models.ConnectionConfig{Encrypted: envelope}, nil
+}
+
+// Decrypts the connection config if it was encrypted at rest
+func (d *NucleusDb) DecryptConnectionConfig(
+	ctx context.Context,
+	cc *pg_models.ConnectionConfig,
+) (*pg_models.ConnectionConfig, error) {
+	if cc == nil || cc.Encrypted == nil {
+		return cc, nil
+	}
+	if d.encryptor == nil {
+		return nil, errors.New("connection config is encrypted but no encryption key provider has been configured")
+	}
+	bits, err := d.encryptor.Decrypt(ctx, cc.Encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt connection config: %w", err)
+	}
+	decrypted := &pg_models.ConnectionConfig{}
+	if err := json.Unmarshal(bits, decrypted); err != nil {
+		return nil, err
+	}
+	return decrypted, nil
+}
+
+type EncryptConnectionsResult struct {
+	Encrypted int
+	Rotated   int
+	Skipped   int
+	// connections that were changed by someone else while being encrypted and were left untouched
+	Conflicted int
+}
+
+// Encrypts every connection config that is still stored in plaintext.
+// If rotate is true, configs that were encrypted with anything other than the current key are re-encrypted.
+// A config is only overwritten if it has not changed since it was read. Conflicting rows are counted and can be picked up by running this again.
+func (d *NucleusDb) EncryptConnections(
+	ctx context.Context,
+	rotate bool,
+) (*EncryptConnectionsResult, error) {
+	if d.encryptor == nil {
+		return nil, errors.New("no encryption key provider has been configured")
+	}
+	connections, err := d.Q.GetAllConnections(ctx, d.Db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EncryptConnectionsResult{}
+	for idx := range connections {
+		connection := connections[idx]
+		isEncrypted := connection.ConnectionConfig != nil && connection.ConnectionConfig.Encrypted != nil
+		if connection.ConnectionConfig == nil || (isEncrypted && (!rotate || d.encryptor.IsCurrent(connection.ConnectionConfig.Encrypted))) {
+			result.Skipped++
+			continue
+		}
+
+		plaintext, err := d.DecryptConnectionConfig(ctx, connection.ConnectionConfig)
+		if err != nil {
+			return result, fmt.Errorf("unable to decrypt connection %s: %w", UUIDString(connection.ID), err)
+		}
+		encrypted, err := d.EncryptConnectionConfig(ctx, plaintext)
+		if err != nil {
+			return result, fmt.Errorf("unable to encrypt connection %s: %w", UUIDString(connection.ID), err)
+		}
+		updated, err := d.Q.UpdateConnectionConfig(ctx, d.Db, db_queries.UpdateConnectionConfigParams{
+			ConnectionConfig:         encrypted,
+			ID:                       connection.ID,
+			PreviousConnectionConfig: connection.ConnectionConfig,
+		})
+		if err != nil {
+			return result, fmt.Errorf("unable to update connection %s: %w", UUIDString(connection.ID), err)
+		}
+		if updated == 0 {
+			result.Conflicted++
+			continue
+		}
+		if isEncrypted {
+			result.Rotated++
+		} else {
+			result.Encrypted++
+		}
+	}
+	return result, nil
+}
+
+func (d *NucleusDb) decryptConnection(ctx context.Context, connection *db_queries.NeosyncApiConnection) error {
+	decrypted, err := d.DecryptConnectionConfig(ctx, connection.ConnectionConfig)
+	if err != nil {
+		return fmt.Errorf("connection %s: %w", UUIDString(connection.ID), err)
+	}
+	connection.ConnectionConfig = decrypted
+	return nil
+}
+
+func (d *NucleusDb) decryptConnections(
+	ctx context.Context,
+	connections []db_queries.NeosyncApiConnection,
+) ([]db_queries.NeosyncApiConnection, error) {
+	for idx := range connections {
+		if err := d.decryptConnection(ctx, &connections[idx]); err != nil {
+			return nil, err
+		}
+	}
+	return connections, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/tracelog"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
 	pgxslog "github.com/nucleuscloud/neosync/backend/internal/pgx-slog"
 )
 
@@ -31,6 +32,9 @@ type BaseDBTX interface {
 type NucleusDb struct {
 	Db DBTX
 	Q  db_queries.Querier
+
+	// encrypts connection configs at rest. Connection configs are stored in plaintext if not set.
+	encryptor *encryption.Encryptor
 }
 
 type ConnectConfig struct {
@@ -58,6 +62,11 @@ func New(db DBTX, q db_queries.Querier) *NucleusDb {
 	}
 }
 
+// Configures the encryptor that is used to encrypt connection configs at rest
+func (d *NucleusDb) SetConnectionEncryptor(encryptor *encryption.Encryptor) {
+	d.encryptor = encryptor
+}
+
 func NewFromConfig(config *ConnectConfig) (*NucleusDb, error) {
 	pgxconfig, err := pgxpool.ParseConfig(GetDbUrl(config))
 	if err != nil {
@@ -84,6 +84,11 @@ func (d *NucleusDb) SetSourceSubsets(
 				dbjob.ConnectionOptions.DynamoDBOptions = &pg_models.DynamoDBSourceOptions{}
 			}
 			dbjob.ConnectionOptions.DynamoDBOptions.Tables = pg_models.FromDtoDynamoDBSourceTableOptions(s.DynamodbSubset.GetTables())
+		case *mgmtv1alpha1.JobSourceSqlSubetSchemas_MongodbSubset:
+			if dbjob.ConnectionOptions.MongoDbOptions == nil {
+				dbjob.ConnectionOptions.MongoDbOptions = &pg_models.MongoDbSourceOptions{}
+			}
+			dbjob.ConnectionOptions.MongoDbOptions.Collections = pg_models.FromDtoMongoDbSourceCollectionOptions(s.MongodbSubset.GetCollections())
 		default:
 			return fmt.Errorf("this connection config is not currently supported: %T", s)
 		}
@@ -0,0 +1,28 @@
+package nucleusdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+)
+
+// Replaces all of the notification subscriptions of the job with the given subscriptions
+func (d *NucleusDb) SetJobNotificationSubscriptions(
+	ctx context.Context,
+	jobId pgtype.UUID,
+	subscriptions []db_queries.CreateJobNotificationSubscriptionParams,
+) error {
+	return d.WithTx(ctx, nil, func(tx BaseDBTX) error {
+		if err := d.Q.RemoveJobNotificationSubscriptions(ctx, tx, jobId); err != nil {
+			return err
+		}
+		for _, subscription := range subscriptions {
+			subscription.JobID = jobId
+			if err := d.Q.CreateJobNotificationSubscription(ctx, tx, subscription); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
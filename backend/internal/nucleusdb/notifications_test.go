@@ -0,0 +1,68 @@
+package nucleusdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/zeebo/assert"
+)
+
+const (
+	mockChannelId = "0d1e5a3c-6e7f-4b7a-9f5e-3c1f7a2b8d4e"
+)
+
+// SetJobNotificationSubscriptions
+func Test_SetJobNotificationSubscriptions(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	jobUuid, _ := ToUuid(mockJobId)
+	channelUuid, _ := ToUuid(mockChannelId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("RemoveJobNotificationSubscriptions", ctx, mockTx, jobUuid).Return(nil)
+	querierMock.On("CreateJobNotificationSubscription", ctx, mockTx, db_queries.CreateJobNotificationSubscriptionParams{
+		JobID:     jobUuid,
+		ChannelID: channelUuid,
+		Events:    []int16{1, 3},
+	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.SetJobNotificationSubscriptions(ctx, jobUuid, []db_queries.CreateJobNotificationSubscriptionParams{
+		{ChannelID: channelUuid, Events: []int16{1, 3}},
+	})
+
+	assert.NoError(t, err)
+}
+
+func Test_SetJobNotificationSubscriptions_Rollback(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	jobUuid, _ := ToUuid(mockJobId)
+	channelUuid, _ := ToUuid(mockChannelId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("RemoveJobNotificationSubscriptions", ctx, mockTx, jobUuid).Return(nil)
+	querierMock.On("CreateJobNotificationSubscription", ctx, mockTx, mock.Anything).Return(errors.New("boom"))
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.SetJobNotificationSubscriptions(ctx, jobUuid, []db_queries.CreateJobNotificationSubscriptionParams{
+		{ChannelID: channelUuid, Events: []int16{2}},
+	})
+
+	assert.Error(t, err)
+	mockTx.AssertNotCalled(t, "Commit", ctx)
+}
@@ -8,9 +8,13 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 )
 
+// Personal account owners and team account creators are always admins of the account
+const adminRole = int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
 func (d *NucleusDb) SetUserByAuthSub(
 	ctx context.Context,
 	authSub string,
@@ -78,6 +82,7 @@ func (d *NucleusDb) SetPersonalAccount(
 			_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 				AccountID: account.ID,
 				UserID:    userId,
+				Role:      adminRole,
 			})
 			if err != nil {
 				return err
@@ -94,6 +99,7 @@ func (d *NucleusDb) SetPersonalAccount(
 				_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 					AccountID: account.ID,
 					UserID:    userId,
+					Role:      adminRole,
 				})
 				if err != nil {
 					return err
@@ -133,6 +139,7 @@ func (d *NucleusDb) CreateTeamAccount(
 		_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 			AccountID: account.ID,
 			UserID:    userId,
+			Role:      adminRole,
 		})
 		if err != nil {
 			return err
@@ -150,6 +157,7 @@ func (d *NucleusDb) CreateTeamAccountInvite(
 	userId pgtype.UUID,
 	email string,
 	expiresAt pgtype.Timestamp,
+	role mgmtv1alpha1.AccountRole,
 ) (*db_queries.NeosyncApiAccountInvite, error) {
 	var accountInvite *db_queries.NeosyncApiAccountInvite
 	if err := d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
@@ -175,6 +183,7 @@ func (d *NucleusDb) CreateTeamAccountInvite(
 			SenderUserID: userId,
 			Email:        email,
 			ExpiresAt:    expiresAt,
+			Role:         int16(role),
 		})
 		if err != nil {
 			return err
@@ -230,6 +239,7 @@ func (d *NucleusDb) ValidateInviteAddUserToAccount(
 			_, err := d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 				AccountID: invite.AccountID,
 				UserID:    userId,
+				Role:      invite.Role,
 			})
 			if err != nil {
 				return err
@@ -243,3 +253,84 @@ func (d *NucleusDb) ValidateInviteAddUserToAccount(
 	}
 	return accountId, nil
 }
+
+// Removes a member from the account. Removing the last admin of the account is not allowed.
+func (d *NucleusDb) RemoveAccountUser(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	userId pgtype.UUID,
+) error {
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		association, err := d.Q.GetAccountUserAssociation(ctx, dbtx, db_queries.GetAccountUserAssociationParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil && !IsNoRows(err) {
+			return err
+		} else if err != nil && IsNoRows(err) {
+			return nil
+		}
+
+		if association.Role == adminRole {
+			adminCount, err := d.Q.GetAccountUserRoleCount(ctx, dbtx, db_queries.GetAccountUserRoleCountParams{
+				AccountId: accountId,
+				Role:      adminRole,
+			})
+			if err != nil {
+				return err
+			}
+			if adminCount <= 1 {
+				return nucleuserrors.NewBadRequest("unable to remove member: account must have at least one admin")
+			}
+		}
+
+		err = d.Q.RemoveAccountUser(ctx, dbtx, db_queries.RemoveAccountUserParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil && !IsNoRows(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// Updates the role of an account member. Demoting the last admin of the account is not allowed.
+func (d *NucleusDb) SetAccountUserRole(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	userId pgtype.UUID,
+	role mgmtv1alpha1.AccountRole,
+) error {
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		association, err := d.Q.GetAccountUserAssociation(ctx, dbtx, db_queries.GetAccountUserAssociationParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil && !IsNoRows(err) {
+			return err
+		} else if err != nil && IsNoRows(err) {
+			return nucleuserrors.NewNotFound("user is not a member of the account")
+		}
+
+		if association.Role == adminRole && int16(role) != adminRole {
+			adminCount, err := d.Q.GetAccountUserRoleCount(ctx, dbtx, db_queries.GetAccountUserRoleCountParams{
+				AccountId: accountId,
+				Role:      adminRole,
+			})
+			if err != nil {
+				return err
+			}
+			if adminCount <= 1 {
+				return nucleuserrors.NewBadRequest("unable to change role: account must have at least one admin")
+			}
+		}
+
+		_, err = d.Q.UpdateAccountUserRole(ctx, dbtx, db_queries.UpdateAccountUserRoleParams{
+			Role:      int16(role),
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		return err
+	})
+}
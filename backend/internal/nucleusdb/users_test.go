@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/stretchr/testify/mock"
 	"github.com/zeebo/assert"
 )
@@ -210,6 +211,7 @@ func Test_SetPersonalAccount_CreateUserAssociation(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -239,6 +241,7 @@ func Test_SetPersonalAccount_CreateAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{AccountID: accountUuid, UserID: userUuid}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -268,6 +271,7 @@ func Test_SetPersonalAccount_Rollback(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{AccountID: accountUuid, UserID: userUuid}, errors.New("boo"))
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -296,6 +300,7 @@ func Test_CreateTeamAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -350,6 +355,7 @@ func Test_CreateTeamAccount_NoRows(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -380,6 +386,7 @@ func Test_CreateTeamAccount_Rollback(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      adminRole,
 	}).Return(nilAssociation, errors.New("sad"))
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -417,6 +424,7 @@ func Test_CreateTeamAccountInvite(t *testing.T) {
 		SenderUserID: userUuid,
 		Email:        mockEmail,
 		ExpiresAt:    expiresAt,
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR),
 	}).Return(db_queries.NeosyncApiAccountInvite{
 		AccountID:    accountUuid,
 		SenderUserID: userUuid,
@@ -426,7 +434,7 @@ func Test_CreateTeamAccountInvite(t *testing.T) {
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt)
+	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -451,7 +459,7 @@ func Test_CreateTeamAccountInvite_NotTeamAccount(t *testing.T) {
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt)
+	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR)
 
 	querierMock.AssertNotCalled(t, "UpdateActiveAccountInvitesToExpired", mock.Anything, mock.Anything, mock.Anything)
 	querierMock.AssertNotCalled(t, "CreateAccountInvite", mock.Anything, mock.Anything, mock.Anything)
@@ -629,6 +637,7 @@ func Test_ValidateInviteAddUserToAccount(t *testing.T) {
 		ExpiresAt:    expiresAt,
 		ID:           inviteUuid,
 		Accepted:     pgtype.Bool{Bool: false},
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}, nil)
 	querierMock.On("UpdateAccountInviteToAccepted", ctx, mockTx, inviteUuid).Return(db_queries.NeosyncApiAccountInvite{}, nil)
 	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
@@ -638,6 +647,7 @@ func Test_ValidateInviteAddUserToAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
@@ -648,3 +658,126 @@ func Test_ValidateInviteAddUserToAccount(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, accountUuid, resp)
 }
+
+// SetAccountUserRole
+
+func Test_RemoveAccountUser(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	userUuid, _ := ToUuid(mockUserId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: adminRole}, nil)
+	querierMock.On("GetAccountUserRoleCount", ctx, mockTx, db_queries.GetAccountUserRoleCountParams{
+		AccountId: accountUuid,
+		Role:      adminRole,
+	}).Return(int64(2), nil)
+	querierMock.On("RemoveAccountUser", ctx, mockTx, db_queries.RemoveAccountUserParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+
+	err := service.RemoveAccountUser(ctx, accountUuid, userUuid)
+
+	assert.NoError(t, err)
+}
+
+func Test_RemoveAccountUser_LastAdmin(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	userUuid, _ := ToUuid(mockUserId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: adminRole}, nil)
+	querierMock.On("GetAccountUserRoleCount", ctx, mockTx, db_queries.GetAccountUserRoleCountParams{
+		AccountId: accountUuid,
+		Role:      adminRole,
+	}).Return(int64(1), nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.RemoveAccountUser(ctx, accountUuid, userUuid)
+
+	querierMock.AssertNotCalled(t, "RemoveAccountUser", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+}
+
+func Test_SetAccountUserRole(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	userUuid, _ := ToUuid(mockUserId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: adminRole}, nil)
+	querierMock.On("GetAccountUserRoleCount", ctx, mockTx, db_queries.GetAccountUserRoleCountParams{
+		AccountId: accountUuid,
+		Role:      adminRole,
+	}).Return(int64(2), nil)
+	querierMock.On("UpdateAccountUserRole", ctx, mockTx, db_queries.UpdateAccountUserRoleParams{
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+
+	err := service.SetAccountUserRole(ctx, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+
+	assert.NoError(t, err)
+}
+
+func Test_SetAccountUserRole_LastAdmin(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	userUuid, _ := ToUuid(mockUserId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: adminRole}, nil)
+	querierMock.On("GetAccountUserRoleCount", ctx, mockTx, db_queries.GetAccountUserRoleCountParams{
+		AccountId: accountUuid,
+		Role:      adminRole,
+	}).Return(int64(1), nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.SetAccountUserRole(ctx, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR)
+
+	querierMock.AssertNotCalled(t, "UpdateAccountUserRole", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+}
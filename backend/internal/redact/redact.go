@@ -0,0 +1,180 @@
+package redact
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// The value that sensitive fields are replaced with
+	RedactedValue = "********"
+)
+
+var (
+	sensitiveFieldNames = map[string]struct{}{
+		"password":                    {},
+		"pass":                        {},
+		"passphrase":                  {},
+		"private_key":                 {},
+		"client_key":                  {},
+		"secret_access_key":           {},
+		"session_token":               {},
+		"api_key":                     {},
+		"key_value":                   {},
+		"token":                       {},
+		"access_token":                {},
+		"refresh_token":               {},
+		"id_token":                    {},
+		"service_account_credentials": {},
+		// connection urls regularly contain credentials in their userinfo
+		"url": {},
+	}
+	sensitiveFieldSuffixes = []string{"_password", "_secret", "_token"}
+
+	// fields whose name alone does not identify them as sensitive
+	sensitiveFullFieldNames = map[protoreflect.FullName]struct{}{
+		"mgmt.v1alpha1.SSHPassphrase.value": {},
+		"mgmt.v1alpha1.SSHPrivateKey.value": {},
+	}
+)
+
+// Returns true if the proto field name is known to hold sensitive data
+func IsSensitiveField(name string) bool {
+	if _, ok := sensitiveFieldNames[name]; ok {
+		return true
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the proto field is known to hold sensitive data
+func IsSensitiveFieldDescriptor(fd protoreflect.FieldDescriptor) bool {
+	if _, ok := sensitiveFullFieldNames[fd.FullName()]; ok {
+		return true
+	}
+	return IsSensitiveField(string(fd.Name()))
+}
+
+// Returns a copy of the message with every non-empty sensitive string field replaced by the RedactedValue.
+// The input message is not modified.
+func Message[T proto.Message](msg T) T {
+	cloned := proto.Clone(msg).(T)
+	redactMessage(cloned.ProtoReflect())
+	return cloned
+}
+
+func redactMessage(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			redactList(fd, value.List())
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactMessage(v.Message())
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			redactMessage(value.Message())
+		case fd.Kind() == protoreflect.StringKind && IsSensitiveFieldDescriptor(fd):
+			if value.String() != "" {
+				msg.Set(fd, protoreflect.ValueOfString(RedactedValue))
+			}
+		}
+		return true
+	})
+}
+
+func redactList(fd protoreflect.FieldDescriptor, list protoreflect.List) {
+	switch {
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		for idx := 0; idx < list.Len(); idx++ {
+			redactMessage(list.Get(idx).Message())
+		}
+	case fd.Kind() == protoreflect.StringKind && IsSensitiveFieldDescriptor(fd):
+		for idx := 0; idx < list.Len(); idx++ {
+			if list.Get(idx).String() != "" {
+				list.Set(idx, protoreflect.ValueOfString(RedactedValue))
+			}
+		}
+	}
+}
+
+// Returns a copy of the message where every sensitive field that holds the RedactedValue is replaced by the
+// value of the same field in the stored message. This allows clients to send back a previously redacted message
+// without having to know the secrets it holds.
+// Returns an error if a redacted field has no counterpart in the stored message.
+func Restore[T proto.Message](msg, stored T) (T, error) {
+	cloned := proto.Clone(msg).(T)
+	if err := restoreMessage(cloned.ProtoReflect(), stored.ProtoReflect()); err != nil {
+		var zero T
+		return zero, err
+	}
+	return cloned, nil
+}
+
+// Returns true if any sensitive field of the message holds the RedactedValue
+func ContainsRedactedValue[T proto.Message](msg T) bool {
+	err := restoreMessage(proto.Clone(msg).ProtoReflect(), msg.ProtoReflect().Type().Zero())
+	return err != nil
+}
+
+func restoreMessage(msg, stored protoreflect.Message) error {
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			return true
+		case fd.IsList():
+			err = restoreList(fd, value.List(), stored)
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if stored.IsValid() && stored.Has(fd) {
+				err = restoreMessage(value.Message(), stored.Get(fd).Message())
+			} else {
+				err = restoreMessage(value.Message(), value.Message().Type().Zero())
+			}
+		case fd.Kind() == protoreflect.StringKind && value.String() == RedactedValue && IsSensitiveFieldDescriptor(fd):
+			if !stored.IsValid() || !stored.Has(fd) {
+				err = fmt.Errorf("field %s holds the redacted placeholder but has no stored value", fd.FullName())
+				return false
+			}
+			msg.Set(fd, stored.Get(fd))
+		}
+		return err == nil
+	})
+	return err
+}
+
+func restoreList(fd protoreflect.FieldDescriptor, list protoreflect.List, stored protoreflect.Message) error {
+	var storedList protoreflect.List
+	if stored.IsValid() && stored.Has(fd) {
+		storedList = stored.Get(fd).List()
+	}
+	for idx := 0; idx < list.Len(); idx++ {
+		hasStored := storedList != nil && idx < storedList.Len()
+		switch {
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			storedMsg := list.Get(idx).Message().Type().Zero()
+			if hasStored {
+				storedMsg = storedList.Get(idx).Message()
+			}
+			if err := restoreMessage(list.Get(idx).Message(), storedMsg); err != nil {
+				return err
+			}
+		case fd.Kind() == protoreflect.StringKind && list.Get(idx).String() == RedactedValue && IsSensitiveFieldDescriptor(fd):
+			if !hasStored {
+				return fmt.Errorf("field %s holds the redacted placeholder but has no stored value", fd.FullName())
+			}
+			list.Set(idx, storedList.Get(idx))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package redact
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsSensitiveField(t *testing.T) {
+	require.True(t, IsSensitiveField("password"))
+	require.True(t, IsSensitiveField("url"))
+	require.True(t, IsSensitiveField("webhook_secret"))
+	require.False(t, IsSensitiveField("name"))
+	require.False(t, IsSensitiveField("token_type"))
+}
+
+func Test_Message(t *testing.T) {
+	input := &mgmtv1alpha1.CreateConnectionRequest{
+		AccountId: "account-id",
+		Name:      "prod",
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+				PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+					ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Connection{
+						Connection: &mgmtv1alpha1.PostgresConnection{
+							Host: "localhost",
+							User: "postgres",
+							Pass: "supersecret",
+							Name: "db",
+							Port: 5432,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	output := Message(input)
+
+	require.Equal(t, "prod", output.GetName())
+	conn := output.GetConnectionConfig().GetPgConfig().GetConnection()
+	require.Equal(t, RedactedValue, conn.GetPass())
+	require.Equal(t, "postgres", conn.GetUser())
+	require.Equal(t, "supersecret", input.GetConnectionConfig().GetPgConfig().GetConnection().GetPass(), "input must not be modified")
+}
+
+func Test_Message_EmptyValuesUntouched(t *testing.T) {
+	output := Message(&mgmtv1alpha1.PostgresConnection{Host: "localhost"})
+	require.Empty(t, output.GetPass())
+}
+
+func Test_Message_SshKeys(t *testing.T) {
+	output := Message(&mgmtv1alpha1.SSHAuthentication{
+		AuthConfig: &mgmtv1alpha1.SSHAuthentication_PrivateKey{
+			PrivateKey: &mgmtv1alpha1.SSHPrivateKey{Value: "private-key", Passphrase: ptr("passphrase")},
+		},
+	})
+	require.Equal(t, RedactedValue, output.GetPrivateKey().GetValue())
+	require.Equal(t, RedactedValue, output.GetPrivateKey().GetPassphrase())
+}
+
+func Test_Restore(t *testing.T) {
+	stored := getPgConnectionConfig("stored-secret", "stored-key")
+	input := getPgConnectionConfig(RedactedValue, "new-key")
+	input.GetPgConfig().GetConnection().Host = "new-host"
+
+	output, err := Restore(input, stored)
+	require.NoError(t, err)
+	require.Equal(t, "stored-secret", output.GetPgConfig().GetConnection().GetPass())
+	require.Equal(t, "new-key", output.GetPgConfig().GetTunnel().GetAuthentication().GetPrivateKey().GetValue())
+	require.Equal(t, "new-host", output.GetPgConfig().GetConnection().GetHost())
+	require.Equal(t, RedactedValue, input.GetPgConfig().GetConnection().GetPass(), "input must not be modified")
+}
+
+func Test_Restore_MissingStoredValue(t *testing.T) {
+	stored := &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+			PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+				ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{Url: "postgres://localhost"},
+			},
+		},
+	}
+	_, err := Restore(getPgConnectionConfig(RedactedValue, "key"), stored)
+	require.Error(t, err)
+}
+
+func Test_ContainsRedactedValue(t *testing.T) {
+	require.False(t, ContainsRedactedValue(getPgConnectionConfig("secret", "key")))
+	require.True(t, ContainsRedactedValue(getPgConnectionConfig(RedactedValue, "key")))
+	require.True(t, ContainsRedactedValue(getPgConnectionConfig("secret", RedactedValue)))
+	require.True(t, ContainsRedactedValue(Message(getPgConnectionConfig("secret", "key"))))
+
+	input := getPgConnectionConfig("secret", "key")
+	input.GetPgConfig().GetConnection().Host = RedactedValue
+	require.False(t, ContainsRedactedValue(input), "only sensitive fields can hold the redacted placeholder")
+}
+
+func getPgConnectionConfig(pass, privateKey string) *mgmtv1alpha1.ConnectionConfig {
+	return &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+			PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+				ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Connection{
+					Connection: &mgmtv1alpha1.PostgresConnection{
+						Host: "localhost",
+						User: "postgres",
+						Pass: pass,
+					},
+				},
+				Tunnel: &mgmtv1alpha1.SSHTunnel{
+					Host: "bastion",
+					Authentication: &mgmtv1alpha1.SSHAuthentication{
+						AuthConfig: &mgmtv1alpha1.SSHAuthentication_PrivateKey{
+							PrivateKey: &mgmtv1alpha1.SSHPrivateKey{Value: privateKey},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
@@ -0,0 +1,66 @@
+package dbconnectconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+func NewFromClickhouseConnection(config *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig, connectionTimeout *uint32) (*GeneralDbConnectConfig, error) {
+	switch cc := config.ClickhouseConfig.ConnectionConfig.(type) {
+	case *mgmtv1alpha1.ClickhouseConnectionConfig_Url:
+		u, err := url.Parse(cc.Url)
+		if err != nil {
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				return nil, fmt.Errorf("unable to parse clickhouse url [%s]: %w", urlErr.Op, urlErr.Err)
+			}
+			return nil, fmt.Errorf("unable to parse clickhouse url: %w", err)
+		}
+		user := u.User.Username()
+		pass, _ := u.User.Password()
+
+		host, portStr := u.Hostname(), u.Port()
+
+		query := u.Query()
+
+		var port *int32
+		if portStr != "" {
+			parsedPort, err := strconv.ParseInt(portStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port when processing clickhouse connection url: %w", err)
+			}
+			port = shared.Ptr(int32(parsedPort)) //nolint:gosec // Ignoring for now
+		}
+
+		var database *string
+		if u.Path != "" {
+			trimmed := strings.TrimPrefix(u.Path, "/")
+			if trimmed != "" {
+				database = &trimmed
+			}
+		}
+
+		if connectionTimeout != nil {
+			query.Add("dial_timeout", fmt.Sprintf("%ds", *connectionTimeout))
+		}
+
+		return &GeneralDbConnectConfig{
+			driver:      clickhouseDriver,
+			host:        host,
+			port:        port,
+			database:    database,
+			user:        user,
+			pass:        pass,
+			queryParams: query,
+		}, nil
+	default:
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid clickhouse connection: %T", cc))
+	}
+}
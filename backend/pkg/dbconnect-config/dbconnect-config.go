@@ -6,9 +6,10 @@ import (
 )
 
 const (
-	mysqlDriver    = "mysql"
-	postgresDriver = "postgres"
-	mssqlDriver    = "sqlserver"
+	mysqlDriver      = "mysql"
+	postgresDriver   = "postgres"
+	mssqlDriver      = "sqlserver"
+	clickhouseDriver = "clickhouse"
 )
 
 type GeneralDbConnectConfig struct {
@@ -107,6 +108,21 @@ func (g *GeneralDbConnectConfig) String() string {
 		u.RawQuery = g.queryParams.Encode()
 		return u.String()
 	}
+	if g.driver == clickhouseDriver {
+		u := url.URL{
+			Scheme: clickhouseDriver,
+			Host:   buildDbUrlHost(g.host, g.port),
+		}
+		if g.database != nil {
+			u.Path = *g.database
+		}
+		// Add user info
+		if g.user != "" || g.pass != "" {
+			u.User = url.UserPassword(g.user, g.pass)
+		}
+		u.RawQuery = g.queryParams.Encode()
+		return u.String()
+	}
 	return ""
 }
 
@@ -0,0 +1,91 @@
+package kafkaconnect
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const (
+	SaslMechanismPlain       = "PLAIN"
+	SaslMechanismScramSha256 = "SCRAM-SHA-256"
+	SaslMechanismScramSha512 = "SCRAM-SHA-512"
+)
+
+// Returns a kafka client configured with the brokers, SASL and TLS settings of the connection config.
+// The client connects lazily, so callers should Ping to verify the brokers are reachable.
+func NewClient(config *mgmtv1alpha1.KafkaConnectionConfig, opts ...kgo.Opt) (*kgo.Client, error) {
+	if config == nil {
+		return nil, errors.New("kafka connection config was nil")
+	}
+	if len(config.GetBrokers()) == 0 {
+		return nil, errors.New("kafka connection config must contain at least one broker")
+	}
+
+	clientOpts := []kgo.Opt{kgo.SeedBrokers(config.GetBrokers()...)}
+	if config.GetSasl() != nil {
+		mechanism, err := GetSaslMechanism(config.GetSasl())
+		if err != nil {
+			return nil, err
+		}
+		clientOpts = append(clientOpts, kgo.SASL(mechanism))
+	}
+	if config.GetTls() != nil {
+		tlsConfig, err := GetTlsConfig(config.GetTls())
+		if err != nil {
+			return nil, err
+		}
+		clientOpts = append(clientOpts, kgo.DialTLSConfig(tlsConfig))
+	}
+	clientOpts = append(clientOpts, opts...)
+
+	client, err := kgo.NewClient(clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create kafka client: %w", err)
+	}
+	return client, nil
+}
+
+func GetSaslMechanism(config *mgmtv1alpha1.KafkaSaslConfig) (sasl.Mechanism, error) {
+	switch config.GetMechanism() {
+	case SaslMechanismPlain:
+		return plain.Auth{User: config.GetUsername(), Pass: config.GetPassword()}.AsMechanism(), nil
+	case SaslMechanismScramSha256:
+		return scram.Auth{User: config.GetUsername(), Pass: config.GetPassword()}.AsSha256Mechanism(), nil
+	case SaslMechanismScramSha512:
+		return scram.Auth{User: config.GetUsername(), Pass: config.GetPassword()}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unsupported kafka sasl mechanism: %q", config.GetMechanism())
+	}
+}
+
+func GetTlsConfig(config *mgmtv1alpha1.KafkaTlsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.GetSkipCertVerify(), //nolint:gosec
+	}
+
+	clientTls := config.GetClientTls()
+	if clientTls.GetRootCert() != "" {
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM([]byte(clientTls.GetRootCert())); !ok {
+			return nil, errors.New("unable to parse kafka root certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if clientTls.GetClientCert() != "" || clientTls.GetClientKey() != "" {
+		cert, err := tls.X509KeyPair([]byte(clientTls.GetClientCert()), []byte(clientTls.GetClientKey()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
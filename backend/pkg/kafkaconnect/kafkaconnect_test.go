@@ -0,0 +1,54 @@
+package kafkaconnect
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewClient(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		_, err := NewClient(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("no brokers", func(t *testing.T) {
+		_, err := NewClient(&mgmtv1alpha1.KafkaConnectionConfig{})
+		require.Error(t, err)
+	})
+
+	t.Run("sasl and tls", func(t *testing.T) {
+		client, err := NewClient(&mgmtv1alpha1.KafkaConnectionConfig{
+			Brokers: []string{"localhost:9092"},
+			Sasl:    &mgmtv1alpha1.KafkaSaslConfig{Mechanism: SaslMechanismScramSha512, Username: "user", Password: "pass"},
+			Tls:     &mgmtv1alpha1.KafkaTlsConfig{SkipCertVerify: true},
+		})
+		require.NoError(t, err)
+		client.Close()
+	})
+}
+
+func Test_GetSaslMechanism(t *testing.T) {
+	for _, mechanism := range []string{SaslMechanismPlain, SaslMechanismScramSha256, SaslMechanismScramSha512} {
+		t.Run(mechanism, func(t *testing.T) {
+			actual, err := GetSaslMechanism(&mgmtv1alpha1.KafkaSaslConfig{Mechanism: mechanism, Username: "user", Password: "pass"})
+			require.NoError(t, err)
+			require.Equal(t, mechanism, actual.Name())
+		})
+	}
+
+	_, err := GetSaslMechanism(&mgmtv1alpha1.KafkaSaslConfig{Mechanism: "OAUTHBEARER"})
+	require.Error(t, err)
+}
+
+func Test_GetTlsConfig(t *testing.T) {
+	actual, err := GetTlsConfig(&mgmtv1alpha1.KafkaTlsConfig{SkipCertVerify: true})
+	require.NoError(t, err)
+	require.True(t, actual.InsecureSkipVerify)
+	require.Nil(t, actual.RootCAs)
+
+	rootCert := "not a cert"
+	_, err = GetTlsConfig(&mgmtv1alpha1.KafkaTlsConfig{ClientTls: &mgmtv1alpha1.ClientTlsConfig{RootCert: &rootCert}})
+	require.Error(t, err)
+}
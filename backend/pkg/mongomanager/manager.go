@@ -1 +1,139 @@
 package mongomanager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultIdIndexName = "_id_"
+)
+
+var (
+	// Collection options that are copied from the source collection when initializing a destination collection
+	validationOptionKeys = []string{"validator", "validationLevel", "validationAction"}
+	// Index spec keys that are managed by the server and may not be provided when creating an index
+	serverManagedIndexKeys = []string{"v", "ns"}
+)
+
+// Removes every document from the collection while leaving its indexes and validators in place
+func TruncateCollection(ctx context.Context, db *mongo.Database, collection string) error {
+	_, err := db.Collection(collection).DeleteMany(ctx, bson.D{})
+	if err != nil {
+		return fmt.Errorf("unable to truncate collection %s.%s: %w", db.Name(), collection, err)
+	}
+	return nil
+}
+
+// Creates the destination collection if it does not exist and copies the validators and indexes from the source collection.
+// Indexes that already exist on the destination with an identical definition are left untouched.
+func InitCollection(ctx context.Context, sourceDb, destDb *mongo.Database, collection string) error {
+	sourceSpec, err := getCollectionSpecification(ctx, sourceDb, collection)
+	if err != nil {
+		return err
+	}
+	if sourceSpec == nil {
+		return fmt.Errorf("source collection %s.%s does not exist", sourceDb.Name(), collection)
+	}
+	validationOpts, err := GetValidationOptions(sourceSpec.Options)
+	if err != nil {
+		return fmt.Errorf("unable to read validation options for collection %s.%s: %w", sourceDb.Name(), collection, err)
+	}
+
+	destSpec, err := getCollectionSpecification(ctx, destDb, collection)
+	if err != nil {
+		return err
+	}
+	if destSpec == nil {
+		cmd := append(bson.D{{Key: "create", Value: collection}}, validationOpts...)
+		if err := destDb.RunCommand(ctx, cmd).Err(); err != nil {
+			return fmt.Errorf("unable to create collection %s.%s: %w", destDb.Name(), collection, err)
+		}
+	} else if len(validationOpts) > 0 {
+		cmd := append(bson.D{{Key: "collMod", Value: collection}}, validationOpts...)
+		if err := destDb.RunCommand(ctx, cmd).Err(); err != nil {
+			return fmt.Errorf("unable to update validators for collection %s.%s: %w", destDb.Name(), collection, err)
+		}
+	}
+
+	cursor, err := sourceDb.Collection(collection).Indexes().List(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list indexes for collection %s.%s: %w", sourceDb.Name(), collection, err)
+	}
+	var indexSpecs []bson.D
+	if err := cursor.All(ctx, &indexSpecs); err != nil {
+		return fmt.Errorf("unable to read indexes for collection %s.%s: %w", sourceDb.Name(), collection, err)
+	}
+	indexes := GetCreatableIndexSpecs(indexSpecs)
+	if len(indexes) == 0 {
+		return nil
+	}
+	cmd := bson.D{{Key: "createIndexes", Value: collection}, {Key: "indexes", Value: indexes}}
+	if err := destDb.RunCommand(ctx, cmd).Err(); err != nil {
+		return fmt.Errorf("unable to create indexes for collection %s.%s: %w", destDb.Name(), collection, err)
+	}
+	return nil
+}
+
+func getCollectionSpecification(ctx context.Context, db *mongo.Database, collection string) (*mongo.CollectionSpecification, error) {
+	specs, err := db.ListCollectionSpecifications(ctx, bson.D{{Key: "name", Value: collection}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve collection specification for %s.%s: %w", db.Name(), collection, err)
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	return specs[0], nil
+}
+
+// Returns the validator, validationLevel and validationAction collection options if they are present
+func GetValidationOptions(collectionOptions bson.Raw) (bson.D, error) {
+	output := bson.D{}
+	if len(collectionOptions) == 0 {
+		return output, nil
+	}
+	for _, key := range validationOptionKeys {
+		value, err := collectionOptions.LookupErr(key)
+		if err != nil {
+			continue
+		}
+		var decoded any
+		if err := value.Unmarshal(&decoded); err != nil {
+			return nil, err
+		}
+		output = append(output, bson.E{Key: key, Value: decoded})
+	}
+	return output, nil
+}
+
+// Removes the default _id index and any server managed keys so that the specs may be passed to createIndexes
+func GetCreatableIndexSpecs(specs []bson.D) []bson.D {
+	output := []bson.D{}
+	for _, spec := range specs {
+		if isDefaultIdIndex(spec) {
+			continue
+		}
+		index := bson.D{}
+		for _, elem := range spec {
+			if slices.Contains(serverManagedIndexKeys, elem.Key) {
+				continue
+			}
+			index = append(index, elem)
+		}
+		output = append(output, index)
+	}
+	return output
+}
+
+func isDefaultIdIndex(spec bson.D) bool {
+	for _, elem := range spec {
+		if elem.Key == "name" {
+			return elem.Value == defaultIdIndexName
+		}
+	}
+	return false
+}
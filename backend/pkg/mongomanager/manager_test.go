@@ -0,0 +1,38 @@
+package mongomanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_GetValidationOptions(t *testing.T) {
+	actual, err := GetValidationOptions(nil)
+	require.NoError(t, err)
+	require.Empty(t, actual)
+
+	raw, err := bson.Marshal(bson.D{
+		{Key: "capped", Value: false},
+		{Key: "validator", Value: bson.D{{Key: "name", Value: bson.D{{Key: "$type", Value: "string"}}}}},
+		{Key: "validationLevel", Value: "strict"},
+	})
+	require.NoError(t, err)
+
+	actual, err = GetValidationOptions(raw)
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+	require.Equal(t, "validator", actual[0].Key)
+	require.Equal(t, bson.D{{Key: "name", Value: bson.D{{Key: "$type", Value: "string"}}}}, actual[0].Value)
+	require.Equal(t, bson.E{Key: "validationLevel", Value: "strict"}, actual[1])
+}
+
+func Test_GetCreatableIndexSpecs(t *testing.T) {
+	actual := GetCreatableIndexSpecs([]bson.D{
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "_id", Value: int32(1)}}}, {Key: "name", Value: "_id_"}},
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "email", Value: int32(1)}}}, {Key: "name", Value: "email_1"}, {Key: "unique", Value: true}},
+	})
+	require.Equal(t, []bson.D{
+		{{Key: "key", Value: bson.D{{Key: "email", Value: int32(1)}}}, {Key: "name", Value: "email_1"}, {Key: "unique", Value: true}},
+	}, actual)
+}
@@ -0,0 +1,170 @@
+package piidetect
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
+)
+
+var (
+	emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[A-Za-z]{2,}$`)
+	ssnRegex   = regexp.MustCompile(`^(\d{3})-(\d{2})-(\d{4})$`)
+	phoneRegex = regexp.MustCompile(`^\+?[\d\s\-().]+$`)
+
+	dateLayouts = []string{
+		time.DateOnly,
+		time.RFC3339,
+		time.DateTime,
+		"01/02/2006",
+		"02.01.2006",
+	}
+
+	// names shorter than this are skipped as the data sets contain many short names that are also common words or initials
+	minNameLength = 3
+
+	loadNamesOnce sync.Once
+	firstNames    map[string]struct{}
+	lastNames     map[string]struct{}
+)
+
+func isEmail(value string) bool {
+	return emailRegex.MatchString(value)
+}
+
+// Matches SSNs in the xxx-xx-xxxx format that have a valid area, group and serial number
+func isSsn(value string) bool {
+	matches := ssnRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return false
+	}
+	area, group, serial := matches[1], matches[2], matches[3]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// Matches card numbers of 13 to 19 digits, optionally separated by spaces or dashes, that pass the Luhn check
+func isCreditCard(value string) bool {
+	digits := make([]int, 0, len(value))
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	return isLuhnValid(digits)
+}
+
+func isLuhnValid(digits []int) bool {
+	sum := 0
+	for idx := len(digits) - 1; idx >= 0; idx-- {
+		digit := digits[idx]
+		if (len(digits)-idx)%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func isIpAddress(value string) bool {
+	return net.ParseIP(value) != nil
+}
+
+// Matches formatted phone numbers of 10 to 15 digits.
+// Unformatted numbers are not matched as they can not be told apart from other numeric identifiers.
+func isPhoneNumber(value string) bool {
+	if !phoneRegex.MatchString(value) || !strings.ContainsAny(value, "+-(). ") {
+		return false
+	}
+	digitCount := 0
+	for _, r := range value {
+		if unicode.IsDigit(r) {
+			digitCount++
+		}
+	}
+	return digitCount >= 10 && digitCount <= 15
+}
+
+// Matches dates of people that are alive, i.e. dates within the last 120 years that are not in the future
+func isDateOfBirth(value string) bool {
+	now := time.Now()
+	for _, layout := range dateLayouts {
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		return date.Before(now) && date.After(now.AddDate(-120, 0, 0))
+	}
+	return false
+}
+
+func isFirstName(value string) bool {
+	fields := strings.Fields(value)
+	return len(fields) == 1 && isKnownName(fields[0], getFirstNames())
+}
+
+func isLastName(value string) bool {
+	fields := strings.Fields(value)
+	return len(fields) == 1 && isKnownName(fields[0], getLastNames())
+}
+
+// Matches values of two or three words that start with a known first name and end with a known last name
+func isFullName(value string) bool {
+	fields := strings.Fields(value)
+	if len(fields) < 2 || len(fields) > 3 {
+		return false
+	}
+	return isKnownName(fields[0], getFirstNames()) && isKnownName(fields[len(fields)-1], getLastNames())
+}
+
+func isKnownName(value string, names map[string]struct{}) bool {
+	if len(value) < minNameLength {
+		return false
+	}
+	for _, r := range value {
+		if !unicode.IsLetter(r) && r != '\'' && r != '-' {
+			return false
+		}
+	}
+	_, ok := names[strings.ToLower(value)]
+	return ok
+}
+
+func getFirstNames() map[string]struct{} {
+	loadNamesOnce.Do(loadNames)
+	return firstNames
+}
+
+func getLastNames() map[string]struct{} {
+	loadNamesOnce.Do(loadNames)
+	return lastNames
+}
+
+// Loads the name data sets that are embedded in the worker transformers
+func loadNames() {
+	firstNames = toLowerSet(transformers_dataset.FirstNames)
+	lastNames = toLowerSet(transformers_dataset.LastNames)
+}
+
+func toLowerSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if len(value) >= minNameLength {
+			set[strings.ToLower(value)] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,145 @@
+package piidetect
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+type PiiType string
+
+const (
+	EmailPiiType         PiiType = "email"
+	PhonePiiType         PiiType = "phone"
+	SsnPiiType           PiiType = "ssn"
+	CreditCardPiiType    PiiType = "credit_card"
+	IpAddressPiiType     PiiType = "ip_address"
+	FirstNamePiiType     PiiType = "first_name"
+	LastNamePiiType      PiiType = "last_name"
+	FullNamePiiType      PiiType = "full_name"
+	DateOfBirthPiiType   PiiType = "date_of_birth"
+	StreetAddressPiiType PiiType = "street_address"
+	ZipcodePiiType       PiiType = "zipcode"
+)
+
+const (
+	// The confidence of a column whose name matches a PII type when there are no sampled values to confirm it
+	nameMatchConfidence = 0.6
+	// The confidence of a column whose name matches a PII type but whose sampled values do not
+	nameMismatchConfidence = 0.3
+	// The share of sampled values that must match a detector for the values alone to classify the column
+	minValueMatchRate = 0.5
+)
+
+type Classification struct {
+	Type PiiType
+	// From 0 to 1
+	Confidence float64
+	// True if the column name matched the PII type
+	NameMatch bool
+	// The number of non-empty values that were checked
+	SampledCount int
+	// The number of sampled values that matched the PII type
+	MatchedCount int
+}
+
+type piiRule struct {
+	piiType PiiType
+	// matches if the normalized column name equals one of these
+	exact []string
+	// matches if the normalized column name contains one of these
+	contains []string
+	// matches a single value. Nil if the type can only be detected by name.
+	detector func(value string) bool
+	// the values alone are not enough to classify the column, e.g. any date column holds values that look like a date of birth
+	// and the name data sets contain common words such as Open or Active
+	requiresNameMatch bool
+}
+
+// Rules are evaluated in order, so more specific rules must come first
+var piiRules = []*piiRule{
+	{piiType: EmailPiiType, contains: []string{"email"}, detector: isEmail},
+	{piiType: SsnPiiType, exact: []string{"ssn"}, contains: []string{"socialsecurity"}, detector: isSsn},
+	{piiType: CreditCardPiiType, contains: []string{"creditcard", "cardnumber", "ccnumber"}, detector: isCreditCard},
+	{piiType: IpAddressPiiType, exact: []string{"ip"}, contains: []string{"ipaddress", "ipaddr"}, detector: isIpAddress},
+	{piiType: PhonePiiType, contains: []string{"phone", "mobile", "telephone"}, detector: isPhoneNumber},
+	{piiType: DateOfBirthPiiType, exact: []string{"dob", "birthday"}, contains: []string{"dateofbirth", "birthdate"}, detector: isDateOfBirth, requiresNameMatch: true},
+	{piiType: FullNamePiiType, contains: []string{"fullname", "displayname"}, detector: isFullName},
+	{piiType: FirstNamePiiType, exact: []string{"fname"}, contains: []string{"firstname", "givenname"}, detector: isFirstName, requiresNameMatch: true},
+	{piiType: LastNamePiiType, exact: []string{"lname", "surname"}, contains: []string{"lastname", "familyname"}, detector: isLastName, requiresNameMatch: true},
+	{piiType: StreetAddressPiiType, exact: []string{"address", "street"}, contains: []string{"streetaddress", "addressline"}},
+	{piiType: ZipcodePiiType, exact: []string{"zip", "postcode"}, contains: []string{"zipcode", "postalcode"}},
+}
+
+// Classifies a column by its name and a sample of its values.
+// Returns nil if neither the name nor the values indicate that the column holds PII.
+func ClassifyColumn(column string, values []string) *Classification {
+	name := normalizeColumnName(column)
+	samples := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			samples = append(samples, value)
+		}
+	}
+
+	var best *Classification
+	for _, rule := range piiRules {
+		classification := rule.classify(name, samples)
+		if classification != nil && (best == nil || classification.Confidence > best.Confidence) {
+			best = classification
+		}
+	}
+	return best
+}
+
+func (r *piiRule) classify(name string, samples []string) *Classification {
+	nameMatch := r.matchesName(name)
+	if r.detector == nil || len(samples) == 0 {
+		if !nameMatch {
+			return nil
+		}
+		return &Classification{Type: r.piiType, Confidence: nameMatchConfidence, NameMatch: true, SampledCount: len(samples)}
+	}
+
+	matched := 0
+	for _, sample := range samples {
+		if r.detector(sample) {
+			matched++
+		}
+	}
+	rate := float64(matched) / float64(len(samples))
+	classification := &Classification{Type: r.piiType, NameMatch: nameMatch, SampledCount: len(samples), MatchedCount: matched}
+	switch {
+	case nameMatch && rate >= minValueMatchRate:
+		classification.Confidence = nameMatchConfidence + (1-nameMatchConfidence)*rate
+	case nameMatch:
+		classification.Confidence = nameMismatchConfidence
+	case !r.requiresNameMatch && rate >= minValueMatchRate:
+		classification.Confidence = 0.9 * rate
+	default:
+		return nil
+	}
+	return classification
+}
+
+func (r *piiRule) matchesName(name string) bool {
+	if slices.Contains(r.exact, name) {
+		return true
+	}
+	for _, c := range r.contains {
+		if strings.Contains(name, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lowercases the column name and strips separators so that first_name, firstName and "First Name" are all firstname
+func normalizeColumnName(column string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, column)
+}
@@ -0,0 +1,98 @@
+package piidetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ClassifyColumn(t *testing.T) {
+	t.Run("name and values", func(t *testing.T) {
+		actual := ClassifyColumn("email", []string{"nick@neosync.dev", "evis@neosync.dev", ""})
+		require.NotNil(t, actual)
+		require.Equal(t, EmailPiiType, actual.Type)
+		require.True(t, actual.NameMatch)
+		require.Equal(t, 2, actual.SampledCount)
+		require.Equal(t, 2, actual.MatchedCount)
+		require.Equal(t, 1.0, actual.Confidence)
+	})
+
+	t.Run("values only", func(t *testing.T) {
+		actual := ClassifyColumn("contact", []string{"nick@neosync.dev", "evis@neosync.dev", "n/a", "unknown"})
+		require.NotNil(t, actual)
+		require.Equal(t, EmailPiiType, actual.Type)
+		require.False(t, actual.NameMatch)
+		require.InDelta(t, 0.45, actual.Confidence, 0.0001)
+	})
+
+	t.Run("name only", func(t *testing.T) {
+		actual := ClassifyColumn("zip_code", []string{"90210"})
+		require.NotNil(t, actual)
+		require.Equal(t, ZipcodePiiType, actual.Type)
+		require.Equal(t, nameMatchConfidence, actual.Confidence)
+
+		actual = ClassifyColumn("ssn", nil)
+		require.NotNil(t, actual)
+		require.Equal(t, SsnPiiType, actual.Type)
+		require.Equal(t, nameMatchConfidence, actual.Confidence)
+	})
+
+	t.Run("name that the values disagree with", func(t *testing.T) {
+		actual := ClassifyColumn("email_verified", []string{"true", "false"})
+		require.NotNil(t, actual)
+		require.Equal(t, EmailPiiType, actual.Type)
+		require.Equal(t, nameMismatchConfidence, actual.Confidence)
+	})
+
+	t.Run("values that require a name match", func(t *testing.T) {
+		require.Nil(t, ClassifyColumn("created_at", []string{"1990-05-12", "1985-01-30"}))
+		require.Nil(t, ClassifyColumn("status", []string{"Active", "Open"}))
+
+		actual := ClassifyColumn("dateOfBirth", []string{"1990-05-12", "1985-01-30"})
+		require.NotNil(t, actual)
+		require.Equal(t, DateOfBirthPiiType, actual.Type)
+		require.Equal(t, 1.0, actual.Confidence)
+	})
+
+	t.Run("no pii", func(t *testing.T) {
+		require.Nil(t, ClassifyColumn("id", []string{"1", "2", "3"}))
+		require.Nil(t, ClassifyColumn("description", nil))
+	})
+}
+
+func Test_detectors(t *testing.T) {
+	require.True(t, isEmail("nick@neosync.dev"))
+	require.False(t, isEmail("nick@neosync"))
+
+	require.True(t, isSsn("123-45-6789"))
+	require.False(t, isSsn("000-45-6789"))
+	require.False(t, isSsn("923-45-6789"))
+	require.False(t, isSsn("123456789"))
+
+	require.True(t, isCreditCard("4111 1111 1111 1111"))
+	require.True(t, isCreditCard("5500-0000-0000-0004"))
+	require.False(t, isCreditCard("4111 1111 1111 1112"))
+	require.False(t, isCreditCard("4111"))
+
+	require.True(t, isIpAddress("192.168.0.1"))
+	require.True(t, isIpAddress("2001:db8::1"))
+	require.False(t, isIpAddress("192.168.0"))
+
+	require.True(t, isPhoneNumber("+1 (555) 123-4567"))
+	require.True(t, isPhoneNumber("555.123.4567"))
+	require.False(t, isPhoneNumber("5551234567"))
+	require.False(t, isPhoneNumber("555-1234"))
+
+	require.True(t, isDateOfBirth("1990-05-12"))
+	require.True(t, isDateOfBirth("05/12/1990"))
+	require.False(t, isDateOfBirth("1850-05-12"))
+	require.False(t, isDateOfBirth("2990-05-12"))
+
+	require.True(t, isFirstName("John"))
+	require.False(t, isFirstName("John Smith"))
+	require.True(t, isLastName("smith"))
+	require.True(t, isFullName("John Smith"))
+	require.True(t, isFullName("Mary Ann Smith"))
+	require.False(t, isFullName("John"))
+	require.False(t, isFullName("John 123"))
+}
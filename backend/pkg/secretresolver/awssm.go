@@ -0,0 +1,62 @@
+package secretresolver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+const AwsSecretsManagerScheme = "awssm"
+
+type awsSecretsManagerClient interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// Resolves awssm://<secret-id>[#key] references from AWS Secrets Manager.
+// The secret id may be a secret name or ARN.
+type AwsSecretsManagerResolver struct {
+	client awsSecretsManagerClient
+}
+
+var _ Resolver = (*AwsSecretsManagerResolver)(nil)
+
+func NewAwsSecretsManagerResolver(client awsSecretsManagerClient) *AwsSecretsManagerResolver {
+	return &AwsSecretsManagerResolver{client: client}
+}
+
+// Creates a resolver that authenticates with the default AWS credential chain
+func NewAwsSecretsManagerResolverFromEnv(ctx context.Context, region *string) (*AwsSecretsManagerResolver, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := secretsmanager.NewFromConfig(cfg, func(o *secretsmanager.Options) {
+		if region != nil && *region != "" {
+			o.Region = *region
+		}
+	})
+	return NewAwsSecretsManagerResolver(client), nil
+}
+
+func (r *AwsSecretsManagerResolver) Scheme() string {
+	return AwsSecretsManagerScheme
+}
+
+func (r *AwsSecretsManagerResolver) Resolve(ctx context.Context, ref *Reference) (string, error) {
+	output, err := r.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ref.Path),
+	})
+	if err != nil {
+		return "", err
+	}
+	if output.SecretString != nil {
+		return *output.SecretString, nil
+	}
+	if output.SecretBinary != nil {
+		return string(output.SecretBinary), nil
+	}
+	return "", errors.New("secret has no value")
+}
@@ -0,0 +1,71 @@
+package secretresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+type Config struct {
+	// The reference schemes that are resolved. Secret references are not resolved if empty.
+	Schemes []string
+
+	Vault *VaultConfig
+
+	AwsRegion *string
+}
+
+// Reads the secret resolver configuration from the environment
+func GetConfigFromEnv() *Config {
+	cfg := &Config{}
+	for _, scheme := range strings.Split(viper.GetString("SECRET_REFERENCE_SCHEMES"), ",") {
+		scheme = strings.TrimSpace(scheme)
+		if scheme != "" {
+			cfg.Schemes = append(cfg.Schemes, scheme)
+		}
+	}
+	if addr := viper.GetString("SECRET_REFERENCE_VAULT_ADDR"); addr != "" {
+		cfg.Vault = &VaultConfig{
+			Address: addr,
+			Token:   viper.GetString("SECRET_REFERENCE_VAULT_TOKEN"),
+		}
+	}
+	if region := viper.GetString("SECRET_REFERENCE_AWS_REGION"); region != "" {
+		cfg.AwsRegion = &region
+	}
+	return cfg
+}
+
+// Builds a secret resolver for the configured schemes. Returns nil if no schemes have been enabled.
+// Resolution is opt-in as env:// and file:// references expose the environment and file system of the process to anyone that can edit connections.
+func NewFromConfig(ctx context.Context, cfg *Config) (*SecretResolver, error) {
+	if len(cfg.Schemes) == 0 {
+		return nil, nil
+	}
+	resolvers := make([]Resolver, 0, len(cfg.Schemes))
+	for _, scheme := range cfg.Schemes {
+		switch scheme {
+		case EnvScheme:
+			resolvers = append(resolvers, NewEnvResolver())
+		case FileScheme:
+			resolvers = append(resolvers, NewFileResolver())
+		case VaultScheme:
+			if cfg.Vault == nil {
+				return nil, fmt.Errorf("the %s secret reference scheme requires a vault address", VaultScheme)
+			}
+			resolvers = append(resolvers, NewVaultResolver(http.DefaultClient, cfg.Vault))
+		case AwsSecretsManagerScheme:
+			resolver, err := NewAwsSecretsManagerResolverFromEnv(ctx, cfg.AwsRegion)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create aws secrets manager resolver: %w", err)
+			}
+			resolvers = append(resolvers, resolver)
+		default:
+			return nil, fmt.Errorf("unsupported secret reference scheme: %s", scheme)
+		}
+	}
+	return New(resolvers...), nil
+}
@@ -0,0 +1,49 @@
+package secretresolver
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// Wraps a connection service client so that the unmasked connections it returns have every secret reference resolved.
+// This is the single place where connections are resolved before they are used to connect,
+// regardless of the connector that ends up using them.
+type ConnectionServiceClient struct {
+	mgmtv1alpha1connect.ConnectionServiceClient
+
+	resolver *SecretResolver
+}
+
+var _ mgmtv1alpha1connect.ConnectionServiceClient = &ConnectionServiceClient{}
+
+func NewConnectionServiceClient(
+	client mgmtv1alpha1connect.ConnectionServiceClient,
+	resolver *SecretResolver,
+) *ConnectionServiceClient {
+	return &ConnectionServiceClient{ConnectionServiceClient: client, resolver: resolver}
+}
+
+func (c *ConnectionServiceClient) GetConnectionUnmasked(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
+	resp, err := c.ConnectionServiceClient.GetConnectionUnmasked(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	connectionConfig, err := c.resolver.ResolveConnectionConfig(ctx, resp.Msg.GetConnection().GetConnectionConfig())
+	if err != nil {
+		return nil, err
+	}
+	if connectionConfig == resp.Msg.GetConnection().GetConnectionConfig() {
+		return resp, nil
+	}
+	connection := proto.Clone(resp.Msg.GetConnection()).(*mgmtv1alpha1.Connection)
+	connection.ConnectionConfig = connectionConfig
+	resp.Msg.Connection = connection
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package secretresolver
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+const EnvScheme = "env"
+
+// Resolves env://NAME references from the environment of the running process
+type EnvResolver struct{}
+
+var _ Resolver = (*EnvResolver)(nil)
+
+func NewEnvResolver() *EnvResolver {
+	return &EnvResolver{}
+}
+
+func (r *EnvResolver) Scheme() string {
+	return EnvScheme
+}
+
+func (r *EnvResolver) Resolve(ctx context.Context, ref *Reference) (string, error) {
+	value, ok := os.LookupEnv(ref.Path)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", ref.Path)
+	}
+	return value, nil
+}
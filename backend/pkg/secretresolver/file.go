@@ -0,0 +1,32 @@
+package secretresolver
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+const FileScheme = "file"
+
+// Resolves file:///path/to/secret references by reading the file from disk.
+// A single trailing newline is trimmed, which is how most secret mounts are written.
+type FileResolver struct{}
+
+var _ Resolver = (*FileResolver)(nil)
+
+func NewFileResolver() *FileResolver {
+	return &FileResolver{}
+}
+
+func (r *FileResolver) Scheme() string {
+	return FileScheme
+}
+
+func (r *FileResolver) Resolve(ctx context.Context, ref *Reference) (string, error) {
+	bits, err := os.ReadFile(ref.Path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimSuffix(string(bits), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
@@ -0,0 +1,202 @@
+package secretresolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Resolves secret references for a single scheme. Ex: env://PGPASSWORD
+type Resolver interface {
+	Scheme() string
+	Resolve(ctx context.Context, ref *Reference) (string, error)
+}
+
+// A parsed secret reference in the form <scheme>://<path>[#<key>]
+type Reference struct {
+	Scheme string
+	Path   string
+	// Optional key that selects a single value out of a JSON object secret
+	Key string
+}
+
+func (r *Reference) String() string {
+	if r.Key == "" {
+		return fmt.Sprintf("%s://%s", r.Scheme, r.Path)
+	}
+	return fmt.Sprintf("%s://%s#%s", r.Scheme, r.Path, r.Key)
+}
+
+// Resolves secret references with the resolvers that have been registered.
+// Only values whose scheme has a registered resolver are treated as references, which means
+// a nil or empty SecretResolver leaves every value untouched.
+type SecretResolver struct {
+	resolvers map[string]Resolver
+}
+
+func New(resolvers ...Resolver) *SecretResolver {
+	resolverMap := make(map[string]Resolver, len(resolvers))
+	for _, resolver := range resolvers {
+		resolverMap[resolver.Scheme()] = resolver
+	}
+	return &SecretResolver{resolvers: resolverMap}
+}
+
+// Returns the schemes that can be resolved
+func (s *SecretResolver) Schemes() []string {
+	if s == nil {
+		return nil
+	}
+	schemes := make([]string, 0, len(s.resolvers))
+	for scheme := range s.resolvers {
+		schemes = append(schemes, scheme)
+	}
+	return schemes
+}
+
+// Returns the parsed reference if the value is a reference to a secret that can be resolved
+func (s *SecretResolver) ParseReference(value string) (*Reference, bool) {
+	if s == nil || len(s.resolvers) == 0 {
+		return nil, false
+	}
+	scheme, rest, ok := strings.Cut(value, "://")
+	if !ok {
+		return nil, false
+	}
+	if _, ok := s.resolvers[scheme]; !ok {
+		return nil, false
+	}
+	ref := &Reference{Scheme: scheme, Path: rest}
+	if idx := strings.LastIndex(rest, "#"); idx >= 0 {
+		ref.Path = rest[:idx]
+		ref.Key = rest[idx+1:]
+	}
+	return ref, true
+}
+
+func (s *SecretResolver) IsReference(value string) bool {
+	_, ok := s.ParseReference(value)
+	return ok
+}
+
+// Resolves the value if it is a secret reference, otherwise returns it unchanged
+func (s *SecretResolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, ok := s.ParseReference(value)
+	if !ok {
+		return value, nil
+	}
+	secret, err := s.resolvers[ref.Scheme].Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve secret reference %s: %w", ref.String(), err)
+	}
+	if ref.Key == "" {
+		return secret, nil
+	}
+	value, err = getJsonKey(secret, ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve secret reference %s: %w", ref.String(), err)
+	}
+	return value, nil
+}
+
+// Returns a copy of the connection config with every secret reference replaced by its resolved value.
+// The input config is never modified so that resolved secrets are not accidentally persisted or returned.
+func (s *SecretResolver) ResolveConnectionConfig(
+	ctx context.Context,
+	cc *mgmtv1alpha1.ConnectionConfig,
+) (*mgmtv1alpha1.ConnectionConfig, error) {
+	if cc == nil || s == nil || len(s.resolvers) == 0 {
+		return cc, nil
+	}
+	cloned := proto.Clone(cc).(*mgmtv1alpha1.ConnectionConfig)
+	err := s.resolveMessage(ctx, cloned.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	return cloned, nil
+}
+
+func (s *SecretResolver) resolveMessage(ctx context.Context, msg protoreflect.Message) error {
+	type update struct {
+		fd    protoreflect.FieldDescriptor
+		value string
+	}
+	updates := []update{}
+	var rangeErr error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			return true
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					resolved, err := s.Resolve(ctx, list.Get(i).String())
+					if err != nil {
+						rangeErr = err
+						return false
+					}
+					list.Set(i, protoreflect.ValueOfString(resolved))
+				case protoreflect.MessageKind, protoreflect.GroupKind:
+					if err := s.resolveMessage(ctx, list.Get(i).Message()); err != nil {
+						rangeErr = err
+						return false
+					}
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			if !s.IsReference(v.String()) {
+				return true
+			}
+			resolved, err := s.Resolve(ctx, v.String())
+			if err != nil {
+				rangeErr = err
+				return false
+			}
+			updates = append(updates, update{fd: fd, value: resolved})
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if err := s.resolveMessage(ctx, v.Message()); err != nil {
+				rangeErr = err
+				return false
+			}
+		}
+		return true
+	})
+	if rangeErr != nil {
+		return rangeErr
+	}
+	for _, u := range updates {
+		msg.Set(u.fd, protoreflect.ValueOfString(u.value))
+	}
+	return nil
+}
+
+func getJsonKey(secret, key string) (string, error) {
+	var values map[string]any
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", errors.New("secret is not a json object, unable to select a key from it")
+	}
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %q does not exist in secret", key)
+	}
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case nil:
+		return "", nil
+	default:
+		bits, err := json.Marshal(typed)
+		if err != nil {
+			return "", err
+		}
+		return string(bits), nil
+	}
+}
@@ -0,0 +1,86 @@
+package secretresolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const VaultScheme = "vault"
+
+// Resolves vault://<mount>/<path>#<key> references from a HashiCorp Vault KV secrets engine.
+// Both KV v1 (vault://secret/prod-db#password) and KV v2 (vault://kv/data/prod-db#password) are supported.
+type VaultResolver struct {
+	httpClient *http.Client
+	address    string
+	token      string
+}
+
+var _ Resolver = (*VaultResolver)(nil)
+
+type VaultConfig struct {
+	// The address of the vault server. Ex: https://vault.example.com:8200
+	Address string
+	Token   string
+}
+
+func NewVaultResolver(httpClient *http.Client, cfg *VaultConfig) *VaultResolver {
+	return &VaultResolver{
+		httpClient: httpClient,
+		address:    strings.TrimSuffix(cfg.Address, "/"),
+		token:      cfg.Token,
+	}
+}
+
+func (r *VaultResolver) Scheme() string {
+	return VaultScheme
+}
+
+// Returns the secret data as a json object so that the key can be selected from it
+func (r *VaultResolver) Resolve(ctx context.Context, ref *Reference) (string, error) {
+	if ref.Key == "" {
+		return "", errors.New("vault secret references must select a key. Ex: vault://kv/data/prod-db#password")
+	}
+	endpoint := fmt.Sprintf("%s/v1/%s", r.address, strings.TrimPrefix(ref.Path, "/"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", r.token)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &body); err != nil {
+		return "", fmt.Errorf("unable to decode vault response: %w", err)
+	}
+	data := body.Data
+	// KV v2 nests the secret under data.data alongside the version metadata
+	if nested, ok := data["data"]; ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			return string(nested), nil
+		}
+	}
+	bits, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(bits), nil
+}
@@ -0,0 +1,48 @@
+package secretresolver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VaultResolver(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/kv/data/prod-db", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+		writeJson(t, w, map[string]any{"data": map[string]any{
+			"data":     map[string]string{"password": "kv2-secret"},
+			"metadata": map[string]any{"version": 3},
+		}})
+	})
+	mux.HandleFunc("/v1/secret/prod-db", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(t, w, map[string]any{"data": map[string]string{"password": "kv1-secret"}})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	resolver := New(NewVaultResolver(srv.Client(), &VaultConfig{Address: srv.URL, Token: "test-token"}))
+
+	value, err := resolver.Resolve(context.Background(), "vault://kv/data/prod-db#password")
+	require.NoError(t, err)
+	require.Equal(t, "kv2-secret", value)
+
+	value, err = resolver.Resolve(context.Background(), "vault://secret/prod-db#password")
+	require.NoError(t, err)
+	require.Equal(t, "kv1-secret", value)
+
+	_, err = resolver.Resolve(context.Background(), "vault://secret/prod-db")
+	require.Error(t, err, "vault references must select a key")
+	_, err = resolver.Resolve(context.Background(), "vault://secret/missing#password")
+	require.Error(t, err)
+}
+
+func writeJson(t *testing.T, w http.ResponseWriter, body any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
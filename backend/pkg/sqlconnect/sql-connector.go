@@ -266,6 +266,58 @@ func GetConnectionDetails(
 			GeneralDbConnectConfig: *connDetails,
 			MaxConnectionLimit:     maxConnLimit,
 		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		var maxConnLimit *int32
+		if config.ClickhouseConfig.GetConnectionOptions() != nil {
+			maxConnLimit = config.ClickhouseConfig.GetConnectionOptions().MaxConnectionLimit
+		}
+		if config.ClickhouseConfig.GetTunnel() != nil {
+			destination, err := getEndpointFromClickhouseConnectionConfig(config)
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve tunnel endpoint for clickhouse: %w", err)
+			}
+			authmethod, err := sshtunnel.GetTunnelAuthMethodFromSshConfig(config.ClickhouseConfig.GetTunnel().GetAuthentication())
+			if err != nil {
+				return nil, fmt.Errorf("unable to compile auth method for ssh tunneling for clickhouse: %w", err)
+			}
+			var publickey ssh.PublicKey
+			if config.ClickhouseConfig.GetTunnel().GetKnownHostPublicKey() != "" {
+				publickey, err = sshtunnel.ParseSshKey(config.ClickhouseConfig.GetTunnel().GetKnownHostPublicKey())
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse provided known host public key for clickhouse tunnel: %w", err)
+				}
+			}
+			tunnel := sshtunnel.New(
+				sshtunnel.NewEndpointWithUser(config.ClickhouseConfig.GetTunnel().GetHost(), int(config.ClickhouseConfig.GetTunnel().GetPort()), config.ClickhouseConfig.GetTunnel().GetUser()),
+				authmethod,
+				destination,
+				sshtunnel.NewEndpoint(localhost, randomPort),
+				1,
+				publickey,
+			)
+
+			connDetails, err := dbconnectconfig.NewFromClickhouseConnection(config, connectionTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compile connection details for clickhouse tunnel connection: %w", err)
+			}
+
+			portValue := int32(randomPort)
+			connDetails.SetHost(localhost)
+			connDetails.SetPort(portValue)
+			return &ConnectionDetails{
+				Tunnel:                 tunnel,
+				GeneralDbConnectConfig: *connDetails,
+				MaxConnectionLimit:     maxConnLimit,
+			}, nil
+		}
+		connDetails, err := dbconnectconfig.NewFromClickhouseConnection(config, connectionTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compile connection details for clickhouse connection: %w", err)
+		}
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *connDetails,
+			MaxConnectionLimit:     maxConnLimit,
+		}, nil
 	default:
 		return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("this connection config (%T) is not currently supported", config))
 	}
@@ -325,3 +377,20 @@ func getEndpointFromMssqlConnectionConfig(config *mgmtv1alpha1.ConnectionConfig_
 		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid mssql connection: %T", cc))
 	}
 }
+
+func getEndpointFromClickhouseConnectionConfig(config *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig) (*sshtunnel.Endpoint, error) {
+	switch cc := config.ClickhouseConfig.GetConnectionConfig().(type) {
+	case *mgmtv1alpha1.ClickhouseConnectionConfig_Url:
+		details, err := dbconnectconfig.NewFromClickhouseConnection(config, nil)
+		if err != nil {
+			return nil, err
+		}
+		port := 0
+		if details.GetPort() != nil {
+			port = int(*details.GetPort())
+		}
+		return sshtunnel.NewEndpointWithUser(details.GetHost(), port, details.GetUser()), nil
+	default:
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid clickhouse connection: %T", cc))
+	}
+}
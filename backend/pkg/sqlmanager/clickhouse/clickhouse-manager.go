@@ -0,0 +1,481 @@
+package sqlmanager_clickhouse
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+type Manager struct {
+	db    mysql_queries.DBTX
+	close func()
+}
+
+func NewManager(db mysql_queries.DBTX, closer func()) *Manager {
+	return &Manager{db: db, close: closer}
+}
+
+const (
+	DefaultTableEngine = "MergeTree"
+
+	getDatabaseSchemaQuery = `
+SELECT database, table, name, type, default_expression, position, numeric_precision, numeric_scale, character_octet_length
+FROM system.columns
+WHERE database NOT IN ('system', 'INFORMATION_SCHEMA', 'information_schema')
+ORDER BY database, table, position
+`
+
+	getPrimaryKeysQuery = `
+SELECT database, name, primary_key
+FROM system.tables
+WHERE has(?, database) AND primary_key != ''
+`
+
+	getVisibleTablesQuery = `
+SELECT database, name
+FROM system.tables
+WHERE database NOT IN ('system', 'INFORMATION_SCHEMA', 'information_schema')
+`
+
+	getCurrentUserGrantsQuery = `
+SELECT access_type, database, table
+FROM system.grants
+WHERE user_name = currentUser()
+	OR role_name IN (SELECT granted_role_name FROM system.role_grants WHERE user_name = currentUser())
+`
+
+	getCreateTableQuery = `
+SELECT create_table_query
+FROM system.tables
+WHERE database = ? AND name = ?
+`
+)
+
+func (m *Manager) GetDatabaseSchema(ctx context.Context) ([]*sqlmanager_shared.DatabaseSchemaRow, error) {
+	rows, err := m.db.QueryContext(ctx, getDatabaseSchemaQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query clickhouse database schema: %w", err)
+	}
+	defer rows.Close()
+
+	output := []*sqlmanager_shared.DatabaseSchemaRow{}
+	for rows.Next() {
+		var (
+			database, table, column, dataType, columnDefault string
+			position                                         uint64
+			numericPrecision, numericScale, charMaxLength    *uint64
+		)
+		if err := rows.Scan(&database, &table, &column, &dataType, &columnDefault, &position, &numericPrecision, &numericScale, &charMaxLength); err != nil {
+			return nil, fmt.Errorf("unable to scan clickhouse database schema row: %w", err)
+		}
+		isNullable := "NO"
+		if strings.HasPrefix(dataType, "Nullable(") {
+			isNullable = "YES"
+		}
+		ordPosition := int16(-1)
+		if position <= math.MaxInt16 {
+			ordPosition = int16(position) //nolint:gosec
+		}
+		output = append(output, &sqlmanager_shared.DatabaseSchemaRow{
+			TableSchema:            database,
+			TableName:              table,
+			ColumnName:             column,
+			DataType:               dataType,
+			ColumnDefault:          columnDefault,
+			IsNullable:             isNullable,
+			OrdinalPosition:        ordPosition,
+			CharacterMaximumLength: toInt32OrDefault(charMaxLength),
+			NumericPrecision:       toInt32OrDefault(numericPrecision),
+			NumericScale:           toInt32OrDefault(numericScale),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read clickhouse database schema rows: %w", err)
+	}
+	return output, nil
+}
+
+func toInt32OrDefault(val *uint64) int32 {
+	if val == nil || *val > math.MaxInt32 {
+		return -1
+	}
+	return int32(*val) //nolint:gosec
+}
+
+func (m *Manager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*sqlmanager_shared.ColumnInfo, error) {
+	dbSchemas, err := m.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := sqlmanager_shared.GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+// ClickHouse does not support foreign key or unique constraints, so only primary keys are returned.
+func (m *Manager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*sqlmanager_shared.TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &sqlmanager_shared.TableConstraints{}, nil
+	}
+	rows, err := m.db.QueryContext(ctx, getPrimaryKeysQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query clickhouse primary keys: %w", err)
+	}
+	defer rows.Close()
+
+	primaryKeyMap := map[string][]string{}
+	for rows.Next() {
+		var database, table, primaryKey string
+		if err := rows.Scan(&database, &table, &primaryKey); err != nil {
+			return nil, fmt.Errorf("unable to scan clickhouse primary key row: %w", err)
+		}
+		cols := []string{}
+		for _, col := range strings.Split(primaryKey, ",") {
+			if trimmed := strings.TrimSpace(col); trimmed != "" {
+				cols = append(cols, trimmed)
+			}
+		}
+		primaryKeyMap[sqlmanager_shared.BuildTable(database, table)] = sqlmanager_shared.DedupeSlice(cols)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read clickhouse primary key rows: %w", err)
+	}
+
+	return &sqlmanager_shared.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{},
+		PrimaryKeyConstraints: primaryKeyMap,
+		UniqueConstraints:     map[string][][]string{},
+	}, nil
+}
+
+// Grants in ClickHouse may be scoped to all databases or all tables in a database (represented by NULL),
+// so these are expanded out to each table that is visible to the current user.
+func (m *Manager) GetRolePermissionsMap(ctx context.Context) (map[string][]string, error) {
+	tableRows, err := m.db.QueryContext(ctx, getVisibleTablesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query clickhouse tables: %w", err)
+	}
+	defer tableRows.Close()
+
+	tables := []*sqlmanager_shared.SchemaTable{}
+	for tableRows.Next() {
+		var database, table string
+		if err := tableRows.Scan(&database, &table); err != nil {
+			return nil, fmt.Errorf("unable to scan clickhouse table row: %w", err)
+		}
+		tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: database, Table: table})
+	}
+	if err := tableRows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read clickhouse table rows: %w", err)
+	}
+
+	grantRows, err := m.db.QueryContext(ctx, getCurrentUserGrantsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query clickhouse grants: %w", err)
+	}
+	defer grantRows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for grantRows.Next() {
+		var (
+			accessType      string
+			database, table *string
+		)
+		if err := grantRows.Scan(&accessType, &database, &table); err != nil {
+			return nil, fmt.Errorf("unable to scan clickhouse grant row: %w", err)
+		}
+		for _, t := range tables {
+			if database != nil && *database != t.Schema {
+				continue
+			}
+			if table != nil && *table != t.Table {
+				continue
+			}
+			key := sqlmanager_shared.BuildTable(t.Schema, t.Table)
+			if !slices.Contains(schemaTablePrivsMap[key], accessType) {
+				schemaTablePrivsMap[key] = append(schemaTablePrivsMap[key], accessType)
+			}
+		}
+	}
+	if err := grantRows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read clickhouse grant rows: %w", err)
+	}
+	return schemaTablePrivsMap, nil
+}
+
+func (m *Manager) GetTableInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableInitStatement, error) {
+	return []*sqlmanager_shared.TableInitStatement{}, nil
+}
+
+func (m *Manager) GetSchemaTableDataTypes(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) (*sqlmanager_shared.SchemaTableDataTypeResponse, error) {
+	return &sqlmanager_shared.SchemaTableDataTypeResponse{
+		Sequences:  []*sqlmanager_shared.DataType{},
+		Functions:  []*sqlmanager_shared.DataType{},
+		Composites: []*sqlmanager_shared.DataType{},
+		Enums:      []*sqlmanager_shared.DataType{},
+		Domains:    []*sqlmanager_shared.DataType{},
+	}, nil
+}
+
+func (m *Manager) GetSchemaTableTriggers(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableTrigger, error) {
+	return []*sqlmanager_shared.TableTrigger{}, nil
+}
+
+func (m *Manager) GetSchemaInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.InitSchemaStatements, error) {
+	return []*sqlmanager_shared.InitSchemaStatements{}, nil
+}
+
+func (m *Manager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	var stmt string
+	err := m.db.QueryRowContext(ctx, getCreateTableQuery, schema, table).Scan(&stmt)
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve clickhouse create table statement: %w", err)
+	}
+	return stmt, nil
+}
+
+func (m *Manager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *sqlmanager_shared.BatchExecOpts) error {
+	// clickhouse does not support multi-statement queries
+	total := len(statements)
+	for idx, stmt := range statements {
+		err := m.Exec(ctx, stmt)
+		if err != nil {
+			return fmt.Errorf("failed to execute batch statement %d/%d: %w", idx+1, total, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	query := fmt.Sprintf("SELECT count() FROM %s", BuildTableName(schema, table))
+	if whereClause != nil && *whereClause != "" {
+		query = fmt.Sprintf("%s WHERE %s", query, *whereClause)
+	}
+	var count uint64
+	err := m.db.QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query table row count for clickhouse: %w", err)
+	}
+	if count > math.MaxInt64 {
+		return math.MaxInt64, nil
+	}
+	return int64(count), nil //nolint:gosec
+}
+
+func (m *Manager) Exec(ctx context.Context, statement string) error {
+	_, err := m.db.ExecContext(ctx, statement)
+	return err
+}
+
+func (m *Manager) Close() {
+	if m.db != nil && m.close != nil {
+		m.close()
+	}
+}
+
+type CreateTableOptions struct {
+	// Defaults to MergeTree if not provided
+	Engine string
+	// Columns or expressions to sort by. Falls back to the provided primary keys if empty.
+	OrderBy     []string
+	PartitionBy *string
+}
+
+func BuildCreateDatabaseStatement(database string) string {
+	return fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", EscapeClickhouseIdentifier(database))
+}
+
+// Builds a ClickHouse CREATE TABLE statement from the column metadata of a source table.
+// Source data types are mapped to their closest ClickHouse equivalent.
+func BuildCreateTableStatement(
+	schema, table string,
+	columns map[string]*sqlmanager_shared.ColumnInfo,
+	primaryKeys []string,
+	opts *CreateTableOptions,
+) (string, error) {
+	if len(columns) == 0 {
+		return "", fmt.Errorf("unable to build clickhouse create table statement for %s.%s: no columns found", schema, table)
+	}
+	if opts == nil {
+		opts = &CreateTableOptions{}
+	}
+	engine := opts.Engine
+	if engine == "" {
+		engine = DefaultTableEngine
+	}
+
+	colNames := make([]string, 0, len(columns))
+	for name := range columns {
+		colNames = append(colNames, name)
+	}
+	slices.SortFunc(colNames, func(a, b string) int {
+		if columns[a].OrdinalPosition != columns[b].OrdinalPosition {
+			return int(columns[a].OrdinalPosition - columns[b].OrdinalPosition)
+		}
+		return strings.Compare(a, b)
+	})
+
+	orderBy := opts.OrderBy
+	if len(orderBy) == 0 {
+		orderBy = primaryKeys
+	}
+
+	colDefs := make([]string, 0, len(colNames))
+	for _, name := range colNames {
+		colDefs = append(colDefs, fmt.Sprintf("%s %s", EscapeClickhouseIdentifier(name), toClickhouseColumnType(columns[name])))
+	}
+
+	stmt := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (%s) ENGINE = %s",
+		BuildTableName(schema, table),
+		strings.Join(colDefs, ", "),
+		engine,
+	)
+
+	if !strings.Contains(engine, "MergeTree") {
+		return stmt, nil
+	}
+
+	hasNullableKey := false
+	orderByExprs := make([]string, 0, len(orderBy))
+	for _, expr := range orderBy {
+		if col, ok := columns[expr]; ok {
+			orderByExprs = append(orderByExprs, EscapeClickhouseIdentifier(expr))
+			if col.IsNullable {
+				hasNullableKey = true
+			}
+			continue
+		}
+		orderByExprs = append(orderByExprs, expr)
+	}
+	if len(orderByExprs) == 0 {
+		stmt = fmt.Sprintf("%s ORDER BY tuple()", stmt)
+	} else {
+		stmt = fmt.Sprintf("%s ORDER BY (%s)", stmt, strings.Join(orderByExprs, ", "))
+	}
+	if opts.PartitionBy != nil && *opts.PartitionBy != "" {
+		stmt = fmt.Sprintf("%s PARTITION BY %s", stmt, *opts.PartitionBy)
+	}
+	if hasNullableKey {
+		stmt = fmt.Sprintf("%s SETTINGS allow_nullable_key = 1", stmt)
+	}
+	return stmt, nil
+}
+
+func BuildTruncateStatement(schema, table string) string {
+	return fmt.Sprintf("TRUNCATE TABLE IF EXISTS %s", BuildTableName(schema, table))
+}
+
+func BuildTableName(schema, table string) string {
+	if schema == "" {
+		return EscapeClickhouseIdentifier(table)
+	}
+	return fmt.Sprintf("%s.%s", EscapeClickhouseIdentifier(schema), EscapeClickhouseIdentifier(table))
+}
+
+func EscapeClickhouseIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+func EscapeClickhouseColumns(cols []string) []string {
+	outcols := make([]string, len(cols))
+	for idx := range cols {
+		outcols[idx] = EscapeClickhouseIdentifier(cols[idx])
+	}
+	return outcols
+}
+
+func toClickhouseColumnType(col *sqlmanager_shared.ColumnInfo) string {
+	chType := toClickhouseType(col)
+	if col.IsNullable && !strings.HasPrefix(chType, "Nullable(") {
+		return fmt.Sprintf("Nullable(%s)", chType)
+	}
+	return chType
+}
+
+// Maps a postgres, mysql, mssql, or clickhouse data type to the closest ClickHouse type.
+// Anything that can't be mapped cleanly falls back to String.
+func toClickhouseType(col *sqlmanager_shared.ColumnInfo) string {
+	dataType := strings.ToLower(strings.TrimSpace(col.DataType))
+	if strings.HasSuffix(dataType, "[]") || dataType == "array" {
+		return "String"
+	}
+	if isClickhouseType(col.DataType) {
+		return col.DataType
+	}
+	if idx := strings.Index(dataType, "("); idx != -1 {
+		dataType = strings.TrimSpace(dataType[:idx])
+	}
+	isUnsigned := strings.HasSuffix(dataType, " unsigned")
+	dataType = strings.TrimSuffix(dataType, " unsigned")
+
+	switch dataType {
+	case "tinyint":
+		if isUnsigned {
+			return "UInt8"
+		}
+		return "Int8"
+	case "smallint", "int2", "smallserial", "year":
+		if isUnsigned {
+			return "UInt16"
+		}
+		return "Int16"
+	case "integer", "int", "int4", "mediumint", "serial":
+		if isUnsigned {
+			return "UInt32"
+		}
+		return "Int32"
+	case "bigint", "int8", "bigserial":
+		if isUnsigned {
+			return "UInt64"
+		}
+		return "Int64"
+	case "real", "float4":
+		return "Float32"
+	case "double precision", "double", "float8", "float":
+		return "Float64"
+	case "numeric", "decimal", "money", "smallmoney":
+		if col.NumericPrecision != nil && *col.NumericPrecision > 0 && *col.NumericPrecision <= 76 {
+			scale := int32(0)
+			if col.NumericScale != nil && *col.NumericScale > 0 {
+				scale = *col.NumericScale
+			}
+			return fmt.Sprintf("Decimal(%d, %d)", *col.NumericPrecision, scale)
+		}
+		return "Decimal(38, 10)"
+	case "boolean", "bool":
+		return "Bool"
+	case "bit":
+		// postgres bit(n) values are strings of n zeros and ones, mysql bit(n) values are integers of up to 64 bits
+		if col.CharacterMaximumLength != nil && *col.CharacterMaximumLength > 0 {
+			return fmt.Sprintf("FixedString(%d)", *col.CharacterMaximumLength)
+		}
+		return "UInt64"
+	case "date":
+		return "Date32"
+	case "timestamp", "timestamp without time zone", "timestamp with time zone", "timestamptz",
+		"datetime", "datetime2", "smalldatetime", "datetimeoffset":
+		return "DateTime64(6)"
+	case "uuid", "uniqueidentifier":
+		return "UUID"
+	default:
+		return "String"
+	}
+}
+
+// ClickHouse types are CamelCase (Int64, Nullable(String)) whereas the other supported databases
+// report their types in lower or upper case.
+func isClickhouseType(dataType string) bool {
+	if dataType == "" || dataType[0] < 'A' || dataType[0] > 'Z' || strings.Contains(dataType, " ") {
+		return false
+	}
+	return dataType != strings.ToUpper(dataType)
+}
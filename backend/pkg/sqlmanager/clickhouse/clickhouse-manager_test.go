@@ -0,0 +1,107 @@
+package sqlmanager_clickhouse
+
+import (
+	"testing"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildCreateTableStatement(t *testing.T) {
+	columns := map[string]*sqlmanager_shared.ColumnInfo{
+		"id":         {OrdinalPosition: 1, DataType: "uuid"},
+		"name":       {OrdinalPosition: 2, DataType: "character varying", IsNullable: true},
+		"age":        {OrdinalPosition: 3, DataType: "integer"},
+		"created_at": {OrdinalPosition: 4, DataType: "timestamp with time zone", IsNullable: true},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		actual, err := BuildCreateTableStatement("public", "users", columns, []string{"id"}, nil)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` UUID, `name` Nullable(String), `age` Int32, `created_at` Nullable(DateTime64(6))) ENGINE = MergeTree ORDER BY (`id`)",
+			actual,
+		)
+	})
+
+	t.Run("no primary key", func(t *testing.T) {
+		actual, err := BuildCreateTableStatement("public", "users", columns, nil, nil)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` UUID, `name` Nullable(String), `age` Int32, `created_at` Nullable(DateTime64(6))) ENGINE = MergeTree ORDER BY tuple()",
+			actual,
+		)
+	})
+
+	t.Run("custom options", func(t *testing.T) {
+		partitionBy := "toYYYYMM(created_at)"
+		actual, err := BuildCreateTableStatement("public", "users", columns, []string{"id"}, &CreateTableOptions{
+			Engine:      "ReplacingMergeTree",
+			OrderBy:     []string{"created_at", "id"},
+			PartitionBy: &partitionBy,
+		})
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` UUID, `name` Nullable(String), `age` Int32, `created_at` Nullable(DateTime64(6))) ENGINE = ReplacingMergeTree ORDER BY (`created_at`, `id`) PARTITION BY toYYYYMM(created_at) SETTINGS allow_nullable_key = 1",
+			actual,
+		)
+	})
+
+	t.Run("non merge tree engine", func(t *testing.T) {
+		actual, err := BuildCreateTableStatement("public", "users", columns, []string{"id"}, &CreateTableOptions{Engine: "Log"})
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` UUID, `name` Nullable(String), `age` Int32, `created_at` Nullable(DateTime64(6))) ENGINE = Log",
+			actual,
+		)
+	})
+
+	t.Run("no columns", func(t *testing.T) {
+		_, err := BuildCreateTableStatement("public", "users", nil, nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func Test_toClickhouseType(t *testing.T) {
+	tests := []struct {
+		col      *sqlmanager_shared.ColumnInfo
+		expected string
+	}{
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "bigint"}, expected: "Int64"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "int unsigned"}, expected: "UInt32"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "tinyint"}, expected: "Int8"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "tinyint unsigned"}, expected: "UInt8"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "bit(8)"}, expected: "UInt64"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "bit", CharacterMaximumLength: sqlmanager_shared.Ptr(int32(4))}, expected: "FixedString(4)"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "double precision"}, expected: "Float64"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "numeric", NumericPrecision: sqlmanager_shared.Ptr(int32(10)), NumericScale: sqlmanager_shared.Ptr(int32(2))}, expected: "Decimal(10, 2)"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "numeric", NumericPrecision: sqlmanager_shared.Ptr(int32(-1))}, expected: "Decimal(38, 10)"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "boolean"}, expected: "Bool"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "date"}, expected: "Date32"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "datetime2"}, expected: "DateTime64(6)"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "uniqueidentifier"}, expected: "UUID"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "jsonb"}, expected: "String"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "ARRAY"}, expected: "String"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "text[]"}, expected: "String"},
+		{col: &sqlmanager_shared.ColumnInfo{DataType: "LowCardinality(String)"}, expected: "LowCardinality(String)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.col.DataType, func(t *testing.T) {
+			require.Equal(t, tt.expected, toClickhouseType(tt.col))
+		})
+	}
+}
+
+func Test_BuildTruncateStatement(t *testing.T) {
+	require.Equal(t, "TRUNCATE TABLE IF EXISTS `public`.`users`", BuildTruncateStatement("public", "users"))
+}
+
+func Test_EscapeClickhouseIdentifier(t *testing.T) {
+	require.Equal(t, "`foo`", EscapeClickhouseIdentifier("foo"))
+	require.Equal(t, "`fo``o`", EscapeClickhouseIdentifier("fo`o"))
+}
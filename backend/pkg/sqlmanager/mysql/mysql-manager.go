@@ -769,3 +769,52 @@ func EscapeMysqlColumns(cols []string) []string {
 func EscapeMysqlColumn(col string) string {
 	return fmt.Sprintf("`%s`", col)
 }
+
+// Builds the mysql statements used to migrate a table when comparing schemas
+type SchemaDiffStatementBuilder struct{}
+
+var _ sqlmanager_shared.SchemaDiffStatementBuilder = (*SchemaDiffStatementBuilder)(nil)
+
+func (b *SchemaDiffStatementBuilder) AddColumn(schema, table, column string, info *sqlmanager_shared.ColumnInfo) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s ADD COLUMN %s;", EscapeMysqlColumn(schema), EscapeMysqlColumn(table), buildSchemaDiffColumn(column, info))
+}
+
+// Mysql redefines the entire column, so a single statement covers the type, nullability and default
+func (b *SchemaDiffStatementBuilder) AlterColumn(schema, table string, diff *sqlmanager_shared.ColumnSchemaDiff) []string {
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s.%s MODIFY COLUMN %s;", EscapeMysqlColumn(schema), EscapeMysqlColumn(table), buildSchemaDiffColumn(diff.Column, diff.Source)),
+	}
+}
+
+func (b *SchemaDiffStatementBuilder) DropColumn(schema, table, column string) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s DROP COLUMN %s;", EscapeMysqlColumn(schema), EscapeMysqlColumn(table), EscapeMysqlColumn(column))
+}
+
+func (b *SchemaDiffStatementBuilder) AddConstraint(schema, table string, constraint *sqlmanager_shared.ConstraintSchemaDiff) string {
+	columns := strings.Join(EscapeMysqlColumns(constraint.Columns), ", ")
+	switch constraint.ConstraintType {
+	case sqlmanager_shared.PrimaryConstraintType:
+		return fmt.Sprintf("ALTER TABLE %s.%s ADD PRIMARY KEY (%s);", EscapeMysqlColumn(schema), EscapeMysqlColumn(table), columns)
+	case sqlmanager_shared.ForeignConstraintType:
+		fkSchema, fkTable := sqlmanager_shared.SplitTableKey(constraint.ForeignKey.Table)
+		return fmt.Sprintf(
+			"ALTER TABLE %s.%s ADD FOREIGN KEY (%s) REFERENCES %s.%s (%s);",
+			EscapeMysqlColumn(schema), EscapeMysqlColumn(table), columns,
+			EscapeMysqlColumn(fkSchema), EscapeMysqlColumn(fkTable), strings.Join(EscapeMysqlColumns(constraint.ForeignKey.Columns), ", "),
+		)
+	default:
+		return fmt.Sprintf("ALTER TABLE %s.%s ADD UNIQUE (%s);", EscapeMysqlColumn(schema), EscapeMysqlColumn(table), columns)
+	}
+}
+
+func (b *SchemaDiffStatementBuilder) DropTrigger(trigger *sqlmanager_shared.TableTrigger) string {
+	return fmt.Sprintf("DROP TRIGGER IF EXISTS %s.%s;", EscapeMysqlColumn(trigger.Schema), EscapeMysqlColumn(trigger.TriggerName))
+}
+
+func buildSchemaDiffColumn(column string, info *sqlmanager_shared.ColumnInfo) string {
+	pieces := []string{EscapeMysqlColumn(column), sqlmanager_shared.BuildColumnDataType(sqlmanager_shared.MysqlDriver, info), buildNullableText(info.IsNullable)}
+	if info.ColumnDefault != "" {
+		pieces = append(pieces, fmt.Sprintf("DEFAULT (%s)", info.ColumnDefault))
+	}
+	return strings.Join(pieces, " ")
+}
@@ -3,6 +3,7 @@ package sqlmanager_mysql
 import (
 	"testing"
 
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +25,21 @@ func Test_BuildMysqlTruncateStatement(t *testing.T) {
 		actual,
 	)
 }
+
+func Test_SchemaDiffStatementBuilder(t *testing.T) {
+	builder := &SchemaDiffStatementBuilder{}
+	name := &sqlmanager_shared.ColumnInfo{DataType: "varchar", CharacterMaximumLength: sqlmanager_shared.Ptr(int32(255)), ColumnDefault: "'unknown'"}
+
+	require.Equal(t, "ALTER TABLE `public`.`users` ADD COLUMN `name` varchar(255) NOT NULL DEFAULT ('unknown');", builder.AddColumn("public", "users", "name", name))
+	require.Equal(t, []string{
+		"ALTER TABLE `public`.`users` MODIFY COLUMN `name` varchar(255) NOT NULL DEFAULT ('unknown');",
+	}, builder.AlterColumn("public", "users", &sqlmanager_shared.ColumnSchemaDiff{Column: "name", Source: name, IsNullableChanged: true}))
+	require.Equal(t, "ALTER TABLE `public`.`users` DROP COLUMN `legacy`;", builder.DropColumn("public", "users", "legacy"))
+	require.Equal(t, "ALTER TABLE `public`.`users` ADD PRIMARY KEY (`id`);", builder.AddConstraint("public", "users", &sqlmanager_shared.ConstraintSchemaDiff{
+		ConstraintType: sqlmanager_shared.PrimaryConstraintType, Columns: []string{"id"},
+	}))
+	require.Equal(t, "ALTER TABLE `public`.`orders` ADD FOREIGN KEY (`user_id`) REFERENCES `public`.`users` (`id`);", builder.AddConstraint("public", "orders", &sqlmanager_shared.ConstraintSchemaDiff{
+		ConstraintType: sqlmanager_shared.ForeignConstraintType, Columns: []string{"user_id"}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}},
+	}))
+	require.Equal(t, "DROP TRIGGER IF EXISTS `public`.`audit`;", builder.DropTrigger(&sqlmanager_shared.TableTrigger{Schema: "public", Table: "users", TriggerName: "audit"}))
+}
@@ -901,3 +901,63 @@ func EscapePgColumns(cols []string) []string {
 func EscapePgColumn(col string) string {
 	return fmt.Sprintf("%q", col)
 }
+
+// Builds the postgres statements used to migrate a table when comparing schemas
+type SchemaDiffStatementBuilder struct{}
+
+var _ sqlmanager_shared.SchemaDiffStatementBuilder = (*SchemaDiffStatementBuilder)(nil)
+
+func (b *SchemaDiffStatementBuilder) AddColumn(schema, table, column string, info *sqlmanager_shared.ColumnInfo) string {
+	pieces := []string{EscapePgColumn(column), info.DataType, buildNullableText(info.IsNullable)}
+	if info.ColumnDefault != "" && info.ColumnDefault != "NULL" {
+		pieces = append(pieces, "DEFAULT", info.ColumnDefault)
+	}
+	return fmt.Sprintf("ALTER TABLE %q.%q ADD COLUMN %s;", schema, table, strings.Join(pieces, " "))
+}
+
+func (b *SchemaDiffStatementBuilder) AlterColumn(schema, table string, diff *sqlmanager_shared.ColumnSchemaDiff) []string {
+	prefix := fmt.Sprintf("ALTER TABLE %q.%q ALTER COLUMN %s", schema, table, EscapePgColumn(diff.Column))
+	output := []string{}
+	if diff.IsDataTypeChanged {
+		output = append(output, fmt.Sprintf("%s TYPE %s;", prefix, diff.Source.DataType))
+	}
+	if diff.IsNullableChanged {
+		if diff.Source.IsNullable {
+			output = append(output, fmt.Sprintf("%s DROP NOT NULL;", prefix))
+		} else {
+			output = append(output, fmt.Sprintf("%s SET NOT NULL;", prefix))
+		}
+	}
+	if diff.IsDefaultChanged {
+		if diff.Source.ColumnDefault == "" {
+			output = append(output, fmt.Sprintf("%s DROP DEFAULT;", prefix))
+		} else {
+			output = append(output, fmt.Sprintf("%s SET DEFAULT %s;", prefix, diff.Source.ColumnDefault))
+		}
+	}
+	return output
+}
+
+func (b *SchemaDiffStatementBuilder) DropColumn(schema, table, column string) string {
+	return fmt.Sprintf("ALTER TABLE %q.%q DROP COLUMN %s;", schema, table, EscapePgColumn(column))
+}
+
+func (b *SchemaDiffStatementBuilder) AddConstraint(schema, table string, constraint *sqlmanager_shared.ConstraintSchemaDiff) string {
+	columns := strings.Join(EscapePgColumns(constraint.Columns), ", ")
+	switch constraint.ConstraintType {
+	case sqlmanager_shared.PrimaryConstraintType:
+		return fmt.Sprintf("ALTER TABLE %q.%q ADD PRIMARY KEY (%s);", schema, table, columns)
+	case sqlmanager_shared.ForeignConstraintType:
+		fkSchema, fkTable := sqlmanager_shared.SplitTableKey(constraint.ForeignKey.Table)
+		return fmt.Sprintf(
+			"ALTER TABLE %q.%q ADD FOREIGN KEY (%s) REFERENCES %q.%q (%s);",
+			schema, table, columns, fkSchema, fkTable, strings.Join(EscapePgColumns(constraint.ForeignKey.Columns), ", "),
+		)
+	default:
+		return fmt.Sprintf("ALTER TABLE %q.%q ADD UNIQUE (%s);", schema, table, columns)
+	}
+}
+
+func (b *SchemaDiffStatementBuilder) DropTrigger(trigger *sqlmanager_shared.TableTrigger) string {
+	return fmt.Sprintf("DROP TRIGGER IF EXISTS %q ON %q.%q;", trigger.TriggerName, trigger.Schema, trigger.Table)
+}
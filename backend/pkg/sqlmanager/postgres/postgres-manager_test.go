@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"github.com/stretchr/testify/require"
 )
 
@@ -152,3 +153,41 @@ func Test_SequenceConfiguration(t *testing.T) {
 		s.ToGeneratedAlwaysIdentity(),
 	)
 }
+
+func Test_SchemaDiffStatementBuilder(t *testing.T) {
+	builder := &SchemaDiffStatementBuilder{}
+
+	require.Equal(t, `ALTER TABLE "public"."users" ADD COLUMN "name" character varying(255) NOT NULL DEFAULT 'unknown'::character varying;`, builder.AddColumn("public", "users", "name", &sqlmanager_shared.ColumnInfo{
+		DataType: "character varying(255)", ColumnDefault: "'unknown'::character varying",
+	}))
+	require.Equal(t, []string{
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE text;`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" DROP NOT NULL;`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" DROP DEFAULT;`,
+	}, builder.AlterColumn("public", "users", &sqlmanager_shared.ColumnSchemaDiff{
+		Column:            "name",
+		Source:            &sqlmanager_shared.ColumnInfo{DataType: "text", IsNullable: true},
+		Destination:       &sqlmanager_shared.ColumnInfo{DataType: "character varying(100)", ColumnDefault: "'n/a'::character varying"},
+		IsDataTypeChanged: true,
+		IsNullableChanged: true,
+		IsDefaultChanged:  true,
+	}))
+	require.Equal(t, []string{
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" SET NOT NULL;`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" SET DEFAULT 'n/a';`,
+	}, builder.AlterColumn("public", "users", &sqlmanager_shared.ColumnSchemaDiff{
+		Column:            "name",
+		Source:            &sqlmanager_shared.ColumnInfo{DataType: "text", ColumnDefault: "'n/a'"},
+		Destination:       &sqlmanager_shared.ColumnInfo{DataType: "text", IsNullable: true},
+		IsNullableChanged: true,
+		IsDefaultChanged:  true,
+	}))
+	require.Equal(t, `ALTER TABLE "public"."users" DROP COLUMN "legacy";`, builder.DropColumn("public", "users", "legacy"))
+	require.Equal(t, `ALTER TABLE "public"."users" ADD UNIQUE ("email", "tenant_id");`, builder.AddConstraint("public", "users", &sqlmanager_shared.ConstraintSchemaDiff{
+		ConstraintType: sqlmanager_shared.UniqueConstraintType, Columns: []string{"email", "tenant_id"},
+	}))
+	require.Equal(t, `ALTER TABLE "public"."orders" ADD FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id");`, builder.AddConstraint("public", "orders", &sqlmanager_shared.ConstraintSchemaDiff{
+		ConstraintType: sqlmanager_shared.ForeignConstraintType, Columns: []string{"user_id"}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}},
+	}))
+	require.Equal(t, `DROP TRIGGER IF EXISTS "audit" ON "public"."users";`, builder.DropTrigger(&sqlmanager_shared.TableTrigger{Schema: "public", Table: "users", TriggerName: "audit"}))
+}
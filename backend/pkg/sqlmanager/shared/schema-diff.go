@@ -0,0 +1,360 @@
+package sqlmanager_shared
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// The schema of a database as retrieved by GetSchemaColumnMap, GetTableConstraintsBySchema and GetSchemaTableTriggers
+type DatabaseSchema struct {
+	Driver      string
+	Columns     map[string]map[string]*ColumnInfo // ex: {public.users: { id: ColumnInfo{}}}
+	Constraints *TableConstraints
+	Triggers    []*TableTrigger
+}
+
+type SchemaDiffType int
+
+const (
+	// The object only exists in the source schema
+	MissingInDestinationDiffType SchemaDiffType = iota
+	// The object only exists in the destination schema
+	MissingInSourceDiffType
+	// The object exists in both schemas, but its definition differs
+	ChangedDiffType
+)
+
+type TableSchemaDiff struct {
+	Schema string
+	Table  string
+	Type   SchemaDiffType
+	// Only set when the table exists in both schemas
+	Columns []*ColumnSchemaDiff
+	// Only set when the table exists in both schemas
+	Constraints []*ConstraintSchemaDiff
+	Triggers    []*TriggerSchemaDiff
+}
+
+type ColumnSchemaDiff struct {
+	Column      string
+	Type        SchemaDiffType
+	Source      *ColumnInfo
+	Destination *ColumnInfo
+
+	IsDataTypeChanged bool
+	IsNullableChanged bool
+	IsDefaultChanged  bool
+}
+
+type ConstraintSchemaDiff struct {
+	ConstraintType ConstraintType
+	Type           SchemaDiffType
+	Columns        []string
+	// Only set for foreign key constraints
+	ForeignKey *ForeignKey
+}
+
+type TriggerSchemaDiff struct {
+	TriggerName string
+	Type        SchemaDiffType
+	Source      *TableTrigger
+	Destination *TableTrigger
+}
+
+// Compares the source and destination schemas and returns the tables that differ, sorted by schema and table.
+// Tables that only exist in one of the schemas are not compared further, aside from the triggers of source only tables.
+func CompareSchemas(source, destination *DatabaseSchema) []*TableSchemaDiff {
+	tableKeys := map[string]struct{}{}
+	for key := range source.Columns {
+		tableKeys[key] = struct{}{}
+	}
+	for key := range destination.Columns {
+		tableKeys[key] = struct{}{}
+	}
+	sortedKeys := make([]string, 0, len(tableKeys))
+	for key := range tableKeys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	sourceTriggers := groupTriggersByTable(source.Triggers)
+	destinationTriggers := groupTriggersByTable(destination.Triggers)
+
+	output := []*TableSchemaDiff{}
+	for _, key := range sortedKeys {
+		schema, table := SplitTableKey(key)
+		sourceCols, inSource := source.Columns[key]
+		destinationCols, inDestination := destination.Columns[key]
+		switch {
+		case !inDestination:
+			output = append(output, &TableSchemaDiff{
+				Schema:   schema,
+				Table:    table,
+				Type:     MissingInDestinationDiffType,
+				Triggers: compareTriggers(sourceTriggers[key], nil),
+			})
+		case !inSource:
+			output = append(output, &TableSchemaDiff{
+				Schema: schema,
+				Table:  table,
+				Type:   MissingInSourceDiffType,
+			})
+		default:
+			diff := &TableSchemaDiff{
+				Schema:      schema,
+				Table:       table,
+				Type:        ChangedDiffType,
+				Columns:     compareColumns(source.Driver, sourceCols, destination.Driver, destinationCols),
+				Constraints: compareConstraints(key, source.Constraints, destination.Constraints),
+				Triggers:    compareTriggers(sourceTriggers[key], destinationTriggers[key]),
+			}
+			if len(diff.Columns) > 0 || len(diff.Constraints) > 0 || len(diff.Triggers) > 0 {
+				output = append(output, diff)
+			}
+		}
+	}
+	return output
+}
+
+func compareColumns(
+	sourceDriver string,
+	sourceCols map[string]*ColumnInfo,
+	destinationDriver string,
+	destinationCols map[string]*ColumnInfo,
+) []*ColumnSchemaDiff {
+	output := []*ColumnSchemaDiff{}
+	for name, sourceCol := range sourceCols {
+		destinationCol, ok := destinationCols[name]
+		if !ok {
+			output = append(output, &ColumnSchemaDiff{Column: name, Type: MissingInDestinationDiffType, Source: sourceCol})
+			continue
+		}
+		diff := &ColumnSchemaDiff{
+			Column:            name,
+			Type:              ChangedDiffType,
+			Source:            sourceCol,
+			Destination:       destinationCol,
+			IsDataTypeChanged: BuildColumnDataType(sourceDriver, sourceCol) != BuildColumnDataType(destinationDriver, destinationCol),
+			IsNullableChanged: sourceCol.IsNullable != destinationCol.IsNullable,
+			IsDefaultChanged:  sourceCol.ColumnDefault != destinationCol.ColumnDefault,
+		}
+		if diff.IsDataTypeChanged || diff.IsNullableChanged || diff.IsDefaultChanged {
+			output = append(output, diff)
+		}
+	}
+	for name, destinationCol := range destinationCols {
+		if _, ok := sourceCols[name]; !ok {
+			output = append(output, &ColumnSchemaDiff{Column: name, Type: MissingInSourceDiffType, Destination: destinationCol})
+		}
+	}
+	// columns in the source are ordered by their source position, followed by the columns that only exist in the destination
+	sort.Slice(output, func(i, j int) bool {
+		if onlyI, onlyJ := output[i].Type == MissingInSourceDiffType, output[j].Type == MissingInSourceDiffType; onlyI != onlyJ {
+			return onlyJ
+		}
+		if posI, posJ := getColumnPosition(output[i]), getColumnPosition(output[j]); posI != posJ {
+			return posI < posJ
+		}
+		return output[i].Column < output[j].Column
+	})
+	return output
+}
+
+func getColumnPosition(diff *ColumnSchemaDiff) int32 {
+	if diff.Source != nil {
+		return diff.Source.OrdinalPosition
+	}
+	return diff.Destination.OrdinalPosition
+}
+
+func compareConstraints(tableKey string, source, destination *TableConstraints) []*ConstraintSchemaDiff {
+	sourceConstraints := getTableConstraints(tableKey, source)
+	destinationConstraints := getTableConstraints(tableKey, destination)
+
+	output := []*ConstraintSchemaDiff{}
+	for _, constraint := range sourceConstraints {
+		if !slices.ContainsFunc(destinationConstraints, constraint.isEqual) {
+			constraint.Type = MissingInDestinationDiffType
+			output = append(output, constraint)
+		}
+	}
+	for _, constraint := range destinationConstraints {
+		if !slices.ContainsFunc(sourceConstraints, constraint.isEqual) {
+			constraint.Type = MissingInSourceDiffType
+			output = append(output, constraint)
+		}
+	}
+	return output
+}
+
+// Returns the primary key, unique and foreign key constraints of the table, in that order
+func getTableConstraints(tableKey string, constraints *TableConstraints) []*ConstraintSchemaDiff {
+	if constraints == nil {
+		return nil
+	}
+	output := []*ConstraintSchemaDiff{}
+	if pk, ok := constraints.PrimaryKeyConstraints[tableKey]; ok && len(pk) > 0 {
+		output = append(output, &ConstraintSchemaDiff{ConstraintType: PrimaryConstraintType, Columns: pk})
+	}
+	for _, uc := range constraints.UniqueConstraints[tableKey] {
+		output = append(output, &ConstraintSchemaDiff{ConstraintType: UniqueConstraintType, Columns: uc})
+	}
+	for _, fk := range constraints.ForeignKeyConstraints[tableKey] {
+		output = append(output, &ConstraintSchemaDiff{ConstraintType: ForeignConstraintType, Columns: fk.Columns, ForeignKey: fk.ForeignKey})
+	}
+	return output
+}
+
+func (c *ConstraintSchemaDiff) isEqual(other *ConstraintSchemaDiff) bool {
+	if c.ConstraintType != other.ConstraintType || !slices.Equal(c.Columns, other.Columns) {
+		return false
+	}
+	if c.ForeignKey == nil || other.ForeignKey == nil {
+		return c.ForeignKey == nil && other.ForeignKey == nil
+	}
+	return c.ForeignKey.Table == other.ForeignKey.Table && slices.Equal(c.ForeignKey.Columns, other.ForeignKey.Columns)
+}
+
+func groupTriggersByTable(triggers []*TableTrigger) map[string][]*TableTrigger {
+	output := map[string][]*TableTrigger{}
+	for _, trigger := range triggers {
+		key := BuildTable(trigger.Schema, trigger.Table)
+		output[key] = append(output[key], trigger)
+	}
+	return output
+}
+
+func compareTriggers(source, destination []*TableTrigger) []*TriggerSchemaDiff {
+	output := []*TriggerSchemaDiff{}
+	for _, sourceTrigger := range source {
+		idx := slices.IndexFunc(destination, func(t *TableTrigger) bool { return t.TriggerName == sourceTrigger.TriggerName })
+		if idx == -1 {
+			output = append(output, &TriggerSchemaDiff{TriggerName: sourceTrigger.TriggerName, Type: MissingInDestinationDiffType, Source: sourceTrigger})
+		} else if destination[idx].Definition != sourceTrigger.Definition {
+			output = append(output, &TriggerSchemaDiff{TriggerName: sourceTrigger.TriggerName, Type: ChangedDiffType, Source: sourceTrigger, Destination: destination[idx]})
+		}
+	}
+	for _, destinationTrigger := range destination {
+		if !slices.ContainsFunc(source, func(t *TableTrigger) bool { return t.TriggerName == destinationTrigger.TriggerName }) {
+			output = append(output, &TriggerSchemaDiff{TriggerName: destinationTrigger.TriggerName, Type: MissingInSourceDiffType, Destination: destinationTrigger})
+		}
+	}
+	sort.SliceStable(output, func(i, j int) bool { return output[i].TriggerName < output[j].TriggerName })
+	return output
+}
+
+// Returns the data type of the column including its length, precision and scale, e.g. varchar(255)
+func BuildColumnDataType(driver string, info *ColumnInfo) string {
+	switch driver {
+	case MysqlDriver, MssqlDriver:
+		switch strings.ToLower(info.DataType) {
+		case "char", "varchar", "binary", "varbinary", "nchar", "nvarchar":
+			if info.CharacterMaximumLength != nil && *info.CharacterMaximumLength > 0 {
+				return fmt.Sprintf("%s(%d)", info.DataType, *info.CharacterMaximumLength)
+			}
+			if driver == MssqlDriver && info.CharacterMaximumLength != nil && *info.CharacterMaximumLength == -1 {
+				return fmt.Sprintf("%s(max)", info.DataType)
+			}
+		case "decimal", "numeric":
+			if info.NumericPrecision != nil && *info.NumericPrecision > 0 {
+				if info.NumericScale != nil && *info.NumericScale >= 0 {
+					return fmt.Sprintf("%s(%d,%d)", info.DataType, *info.NumericPrecision, *info.NumericScale)
+				}
+				return fmt.Sprintf("%s(%d)", info.DataType, *info.NumericPrecision)
+			}
+		}
+		return info.DataType
+	default:
+		// postgres data types already include their type modifiers
+		return info.DataType
+	}
+}
+
+// Builds the driver specific statements that are used to migrate a table
+type SchemaDiffStatementBuilder interface {
+	AddColumn(schema, table, column string, info *ColumnInfo) string
+	// Returns the statements that change the destination column to match the source column
+	AlterColumn(schema, table string, diff *ColumnSchemaDiff) []string
+	DropColumn(schema, table, column string) string
+	AddConstraint(schema, table string, constraint *ConstraintSchemaDiff) string
+	DropTrigger(trigger *TableTrigger) string
+}
+
+// Builds the statements that bring the destination schema in line with the source schema.
+// Tables that only exist in the source are created from their init statements, keyed by <schema>.<table>.
+// Tables and constraints that only exist in the destination are left as is.
+func BuildSchemaDiffStatements(
+	diffs []*TableSchemaDiff,
+	initStatements map[string]*TableInitStatement,
+	builder SchemaDiffStatementBuilder,
+) []string {
+	createStmts := []string{}
+	columnStmts := []string{}
+	constraintStmts := []string{}
+	foreignKeyStmts := []string{}
+	indexStmts := []string{}
+	triggerStmts := []string{}
+
+	for _, diff := range diffs {
+		switch diff.Type {
+		case MissingInDestinationDiffType:
+			initStmt, ok := initStatements[BuildTable(diff.Schema, diff.Table)]
+			if !ok {
+				continue
+			}
+			createStmts = append(createStmts, initStmt.CreateTableStatement)
+			for _, alter := range initStmt.AlterTableStatements {
+				if alter.ConstraintType == ForeignConstraintType {
+					foreignKeyStmts = append(foreignKeyStmts, alter.Statement)
+				} else {
+					constraintStmts = append(constraintStmts, alter.Statement)
+				}
+			}
+			indexStmts = append(indexStmts, initStmt.IndexStatements...)
+		case ChangedDiffType:
+			for _, col := range diff.Columns {
+				switch col.Type {
+				case MissingInDestinationDiffType:
+					columnStmts = append(columnStmts, builder.AddColumn(diff.Schema, diff.Table, col.Column, col.Source))
+				case MissingInSourceDiffType:
+					columnStmts = append(columnStmts, builder.DropColumn(diff.Schema, diff.Table, col.Column))
+				case ChangedDiffType:
+					columnStmts = append(columnStmts, builder.AlterColumn(diff.Schema, diff.Table, col)...)
+				}
+			}
+			for _, constraint := range diff.Constraints {
+				if constraint.Type != MissingInDestinationDiffType {
+					continue
+				}
+				if constraint.ConstraintType == ForeignConstraintType {
+					foreignKeyStmts = append(foreignKeyStmts, builder.AddConstraint(diff.Schema, diff.Table, constraint))
+				} else {
+					constraintStmts = append(constraintStmts, builder.AddConstraint(diff.Schema, diff.Table, constraint))
+				}
+			}
+		default:
+			continue
+		}
+		for _, trigger := range diff.Triggers {
+			switch trigger.Type {
+			case MissingInDestinationDiffType:
+				triggerStmts = append(triggerStmts, trigger.Source.Definition)
+			case MissingInSourceDiffType:
+				triggerStmts = append(triggerStmts, builder.DropTrigger(trigger.Destination))
+			case ChangedDiffType:
+				triggerStmts = append(triggerStmts, builder.DropTrigger(trigger.Destination), trigger.Source.Definition)
+			}
+		}
+	}
+
+	output := make([]string, 0, len(createStmts)+len(columnStmts)+len(constraintStmts)+len(foreignKeyStmts)+len(indexStmts)+len(triggerStmts))
+	output = append(output, createStmts...)
+	output = append(output, columnStmts...)
+	output = append(output, constraintStmts...)
+	output = append(output, foreignKeyStmts...)
+	output = append(output, indexStmts...)
+	output = append(output, triggerStmts...)
+	return output
+}
@@ -0,0 +1,187 @@
+package sqlmanager_shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareSchemas(t *testing.T) {
+	source := &DatabaseSchema{
+		Driver: PostgresDriver,
+		Columns: map[string]map[string]*ColumnInfo{
+			"public.users": {
+				"id":    {OrdinalPosition: 1, DataType: "integer"},
+				"name":  {OrdinalPosition: 2, DataType: "character varying(255)", IsNullable: false},
+				"email": {OrdinalPosition: 3, DataType: "text", IsNullable: true},
+			},
+			"public.orders": {
+				"id":      {OrdinalPosition: 1, DataType: "integer"},
+				"user_id": {OrdinalPosition: 2, DataType: "integer"},
+			},
+			"public.same": {
+				"id": {OrdinalPosition: 1, DataType: "integer"},
+			},
+		},
+		Constraints: &TableConstraints{
+			PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}, "public.orders": {"id"}},
+			ForeignKeyConstraints: map[string][]*ForeignConstraint{
+				"public.orders": {{Columns: []string{"user_id"}, NotNullable: []bool{true}, ForeignKey: &ForeignKey{Table: "public.users", Columns: []string{"id"}}}},
+			},
+			UniqueConstraints: map[string][][]string{},
+		},
+		Triggers: []*TableTrigger{
+			{Schema: "public", Table: "users", TriggerName: "audit", Definition: "create trigger audit v2"},
+			{Schema: "public", Table: "orders", TriggerName: "notify", Definition: "create trigger notify"},
+		},
+	}
+	destination := &DatabaseSchema{
+		Driver: PostgresDriver,
+		Columns: map[string]map[string]*ColumnInfo{
+			"public.users": {
+				"id":      {OrdinalPosition: 1, DataType: "integer"},
+				"name":    {OrdinalPosition: 2, DataType: "character varying(100)", IsNullable: true},
+				"legacy":  {OrdinalPosition: 3, DataType: "text", IsNullable: true},
+				"created": {OrdinalPosition: 4, DataType: "timestamp", ColumnDefault: "now()"},
+			},
+			"public.same": {
+				"id": {OrdinalPosition: 1, DataType: "integer"},
+			},
+			"public.archive": {
+				"id": {OrdinalPosition: 1, DataType: "integer"},
+			},
+		},
+		Constraints: &TableConstraints{
+			UniqueConstraints: map[string][][]string{"public.users": {{"name"}}},
+		},
+		Triggers: []*TableTrigger{
+			{Schema: "public", Table: "users", TriggerName: "audit", Definition: "create trigger audit v1"},
+		},
+	}
+
+	actual := CompareSchemas(source, destination)
+	require.Len(t, actual, 3)
+
+	require.Equal(t, "archive", actual[0].Table)
+	require.Equal(t, MissingInSourceDiffType, actual[0].Type)
+
+	require.Equal(t, "orders", actual[1].Table)
+	require.Equal(t, MissingInDestinationDiffType, actual[1].Type)
+	require.Empty(t, actual[1].Columns)
+	require.Len(t, actual[1].Triggers, 1)
+	require.Equal(t, MissingInDestinationDiffType, actual[1].Triggers[0].Type)
+
+	users := actual[2]
+	require.Equal(t, ChangedDiffType, users.Type)
+	require.Len(t, users.Columns, 4)
+	require.Equal(t, "name", users.Columns[0].Column)
+	require.Equal(t, ChangedDiffType, users.Columns[0].Type)
+	require.True(t, users.Columns[0].IsDataTypeChanged)
+	require.True(t, users.Columns[0].IsNullableChanged)
+	require.False(t, users.Columns[0].IsDefaultChanged)
+	require.Equal(t, "email", users.Columns[1].Column)
+	require.Equal(t, MissingInDestinationDiffType, users.Columns[1].Type)
+	require.Equal(t, "legacy", users.Columns[2].Column)
+	require.Equal(t, MissingInSourceDiffType, users.Columns[2].Type)
+	require.Equal(t, "created", users.Columns[3].Column)
+
+	require.Equal(t, []*ConstraintSchemaDiff{
+		{ConstraintType: PrimaryConstraintType, Type: MissingInDestinationDiffType, Columns: []string{"id"}},
+		{ConstraintType: UniqueConstraintType, Type: MissingInSourceDiffType, Columns: []string{"name"}},
+	}, users.Constraints)
+
+	require.Len(t, users.Triggers, 1)
+	require.Equal(t, ChangedDiffType, users.Triggers[0].Type)
+}
+
+func Test_CompareSchemas_Equal(t *testing.T) {
+	schema := &DatabaseSchema{
+		Driver: MysqlDriver,
+		Columns: map[string]map[string]*ColumnInfo{
+			"public.users": {"id": {OrdinalPosition: 1, DataType: "int"}},
+		},
+		Constraints: &TableConstraints{PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}}},
+	}
+	require.Empty(t, CompareSchemas(schema, schema))
+}
+
+func Test_BuildColumnDataType(t *testing.T) {
+	require.Equal(t, "character varying(255)", BuildColumnDataType(PostgresDriver, &ColumnInfo{DataType: "character varying(255)", CharacterMaximumLength: Ptr(int32(255))}))
+	require.Equal(t, "varchar(255)", BuildColumnDataType(MysqlDriver, &ColumnInfo{DataType: "varchar", CharacterMaximumLength: Ptr(int32(255))}))
+	require.Equal(t, "decimal(10,2)", BuildColumnDataType(MysqlDriver, &ColumnInfo{DataType: "decimal", NumericPrecision: Ptr(int32(10)), NumericScale: Ptr(int32(2))}))
+	require.Equal(t, "int", BuildColumnDataType(MysqlDriver, &ColumnInfo{DataType: "int", NumericPrecision: Ptr(int32(10)), NumericScale: Ptr(int32(0))}))
+	require.Equal(t, "nvarchar(max)", BuildColumnDataType(MssqlDriver, &ColumnInfo{DataType: "nvarchar", CharacterMaximumLength: Ptr(int32(-1))}))
+}
+
+type testStatementBuilder struct{}
+
+func (b *testStatementBuilder) AddColumn(schema, table, column string, info *ColumnInfo) string {
+	return "add " + column
+}
+func (b *testStatementBuilder) AlterColumn(schema, table string, diff *ColumnSchemaDiff) []string {
+	return []string{"alter " + diff.Column}
+}
+func (b *testStatementBuilder) DropColumn(schema, table, column string) string {
+	return "drop " + column
+}
+func (b *testStatementBuilder) AddConstraint(schema, table string, constraint *ConstraintSchemaDiff) string {
+	if constraint.ConstraintType == ForeignConstraintType {
+		return "add fk " + table
+	}
+	return "add constraint " + table
+}
+func (b *testStatementBuilder) DropTrigger(trigger *TableTrigger) string {
+	return "drop trigger " + trigger.TriggerName
+}
+
+func Test_BuildSchemaDiffStatements(t *testing.T) {
+	diffs := []*TableSchemaDiff{
+		{Schema: "public", Table: "archive", Type: MissingInSourceDiffType},
+		{
+			Schema: "public", Table: "orders", Type: MissingInDestinationDiffType,
+			Triggers: []*TriggerSchemaDiff{{TriggerName: "notify", Type: MissingInDestinationDiffType, Source: &TableTrigger{Definition: "create trigger notify"}}},
+		},
+		{
+			Schema: "public", Table: "users", Type: ChangedDiffType,
+			Columns: []*ColumnSchemaDiff{
+				{Column: "email", Type: MissingInDestinationDiffType, Source: &ColumnInfo{}},
+				{Column: "name", Type: ChangedDiffType, Source: &ColumnInfo{}, Destination: &ColumnInfo{}},
+				{Column: "legacy", Type: MissingInSourceDiffType, Destination: &ColumnInfo{}},
+			},
+			Constraints: []*ConstraintSchemaDiff{
+				{ConstraintType: ForeignConstraintType, Type: MissingInDestinationDiffType},
+				{ConstraintType: PrimaryConstraintType, Type: MissingInDestinationDiffType},
+				{ConstraintType: UniqueConstraintType, Type: MissingInSourceDiffType},
+			},
+			Triggers: []*TriggerSchemaDiff{
+				{TriggerName: "audit", Type: ChangedDiffType, Source: &TableTrigger{Definition: "create trigger audit"}, Destination: &TableTrigger{TriggerName: "audit"}},
+			},
+		},
+	}
+	initStatements := map[string]*TableInitStatement{
+		"public.orders": {
+			CreateTableStatement: "create orders",
+			AlterTableStatements: []*AlterTableStatement{
+				{Statement: "orders fk", ConstraintType: ForeignConstraintType},
+				{Statement: "orders pk", ConstraintType: PrimaryConstraintType},
+			},
+			IndexStatements: []string{"orders index"},
+		},
+	}
+
+	actual := BuildSchemaDiffStatements(diffs, initStatements, &testStatementBuilder{})
+	require.Equal(t, []string{
+		"create orders",
+		"add email",
+		"alter name",
+		"drop legacy",
+		"orders pk",
+		"add constraint users",
+		"orders fk",
+		"add fk users",
+		"orders index",
+		"create trigger notify",
+		"drop trigger audit",
+		"create trigger audit",
+	}, actual)
+}
@@ -91,9 +91,10 @@ func ToConstraintType(constraintType string) (ConstraintType, error) {
 }
 
 const (
-	PostgresDriver = "postgres"
-	MysqlDriver    = "mysql"
-	MssqlDriver    = "sqlserver"
+	PostgresDriver   = "postgres"
+	MysqlDriver      = "mysql"
+	MssqlDriver      = "sqlserver"
+	ClickhouseDriver = "clickhouse"
 )
 
 type BatchExecOpts struct {
@@ -17,11 +17,13 @@ import (
 	pgxslog "github.com/nucleuscloud/neosync/backend/internal/pgx-slog"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	sqlmanager_clickhouse "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/clickhouse"
 	sqlmanager_mssql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mssql"
 	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 
+	_ "github.com/ClickHouse/clickhouse-go/v2"
 	_ "github.com/go-sql-driver/mysql"
 	// _ "github.com/microsoft/go-mssqldb" // This is commented out because one of our dependencies is importing this already and it panics if called more than once.
 )
@@ -204,6 +206,9 @@ func (s *SqlManager) NewPooledSqlDb(
 
 		db = sqlmanager_mssql.NewManager(s.mssqlquerier, pool, closer)
 		driver = sqlmanager_shared.MssqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		// clickhouse connections are not pooled as they are only used for a handful of schema statements
+		return s.NewSqlDbFromConnectionConfig(ctx, slogger, connection.GetConnectionConfig(), nil)
 	default:
 		return nil, fmt.Errorf("unsupported sql database connection: %T", connection.ConnectionConfig.Config)
 	}
@@ -293,6 +298,24 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 			}
 		})
 		driver = sqlmanager_shared.MssqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connTimeout, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new clickhouse client from connection config: %w", err)
+		}
+		pool, err := conn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open clickhouse connection: %w", err)
+		}
+		db = sqlmanager_clickhouse.NewManager(pool, func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		})
+		driver = sqlmanager_shared.ClickhouseDriver
 	default:
 		return nil, fmt.Errorf("unsupported sql database connection: %T", connectionConfig.Config)
 	}
@@ -357,6 +380,17 @@ func (s *SqlManager) NewSqlDbFromUrl(
 			}
 		})
 		driver = sqlmanager_shared.MssqlDriver
+	case sqlmanager_shared.ClickhouseDriver:
+		conn, err := sql.Open(sqlmanager_shared.ClickhouseDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = sqlmanager_clickhouse.NewManager(conn, func() {
+			if conn != nil {
+				conn.Close()
+			}
+		})
+		driver = sqlmanager_shared.ClickhouseDriver
 	default:
 		return nil, fmt.Errorf("unsupported sql driver: %s", driver)
 	}
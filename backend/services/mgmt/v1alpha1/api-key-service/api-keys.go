@@ -2,8 +2,10 @@ package v1alpha1_apikeyservice
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
@@ -82,6 +84,11 @@ func (s *Service) CreateAccountApiKey(
 		return nil, err
 	}
 
+	jobUuids, err := s.getAccountJobUuids(ctx, *accountUuid, req.Msg.GetJobIds())
+	if err != nil {
+		return nil, err
+	}
+
 	clearKeyValue := apikey.NewV1AccountKey()
 	hashedKeyValue := pkg_utils.ToSha256(
 		clearKeyValue,
@@ -93,6 +100,8 @@ func (s *Service) CreateAccountApiKey(
 		AccountUuid:       *accountUuid,
 		CreatedByUserUuid: *userUuid,
 		ExpiresAt:         expiresAt,
+		Scopes:            req.Msg.GetScopes(),
+		JobUuids:          jobUuids,
 	})
 	if err != nil {
 		return nil, err
@@ -175,3 +184,26 @@ func (s *Service) DeleteAccountApiKey(
 
 	return connect.NewResponse(&mgmtv1alpha1.DeleteAccountApiKeyResponse{}), nil
 }
+
+// Verifies that every job the api key is being restricted to belongs to the account
+func (s *Service) getAccountJobUuids(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	jobIds []string,
+) ([]pgtype.UUID, error) {
+	jobUuids := make([]pgtype.UUID, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		jobUuid, err := nucleusdb.ToUuid(jobId)
+		if err != nil {
+			return nil, err
+		}
+		job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if (err != nil && nucleusdb.IsNoRows(err)) || job.AccountID != accountUuid {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job %s does not exist in the account", jobId))
+		}
+		jobUuids = append(jobUuids, jobUuid)
+	}
+	return jobUuids, nil
+}
@@ -0,0 +1,101 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+const (
+	defaultPageSize = 50
+	pageTokenSep    = "|"
+)
+
+func (s *Service) GetAuditEvents(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAuditEventsRequest],
+) (*connect.Response[mgmtv1alpha1.GetAuditEventsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.Msg.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	params := db_queries.GetAuditEventsByAccountParams{
+		AccountId: *accountUuid,
+		// fetch one extra to know if there is another page
+		PageLimit: int32(pageSize) + 1, //nolint:gosec // bounded by request validation
+	}
+	if req.Msg.ResourceId != nil {
+		params.ResourceId = pgtype.Text{String: req.Msg.GetResourceId(), Valid: true}
+	}
+	if req.Msg.GetPageToken() != "" {
+		createdAt, id, err := decodePageToken(req.Msg.GetPageToken())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid page token: %s", err.Error()))
+		}
+		params.CursorCreatedAt = createdAt
+		params.CursorId = id
+	}
+
+	events, err := s.db.Q.GetAuditEventsByAccount(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken *string
+	if len(events) > int(pageSize) {
+		events = events[:pageSize]
+		token := encodePageToken(&events[len(events)-1])
+		nextPageToken = &token
+	}
+
+	dtos := make([]*mgmtv1alpha1.AuditEvent, 0, len(events))
+	for idx := range events {
+		dtos = append(dtos, dtomaps.ToAuditEventDto(&events[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// The page token is an opaque cursor that points at the last event of the previous page
+func encodePageToken(event *db_queries.NeosyncApiAuditEvent) string {
+	raw := event.CreatedAt.Time.Format(time.RFC3339Nano) + pageTokenSep + nucleusdb.UUIDString(event.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (pgtype.Timestamp, pgtype.UUID, error) {
+	bits, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	createdAtStr, idStr, ok := strings.Cut(string(bits), pageTokenSep)
+	if !ok {
+		return pgtype.Timestamp{}, pgtype.UUID{}, fmt.Errorf("malformed page token")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	id, err := nucleusdb.ToUuid(idStr)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	return pgtype.Timestamp{Time: createdAt, Valid: true}, id, nil
+}
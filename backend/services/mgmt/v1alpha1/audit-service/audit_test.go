@@ -0,0 +1,124 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Service_GetAuditEvents_Paginates(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	now := time.Now().UTC()
+	rawData := []db_queries.NeosyncApiAuditEvent{
+		newAuditEvent(t, now),
+		newAuditEvent(t, now.Add(-time.Minute)),
+		newAuditEvent(t, now.Add(-2*time.Minute)),
+	}
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetAuditEventsByAccount", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetAuditEventsByAccountParams) bool {
+		return params.PageLimit == 3 && !params.CursorCreatedAt.Valid && params.ResourceId.String == "job-id"
+	})).Return(rawData, nil)
+
+	resourceId := "job-id"
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId:  uuid.NewString(),
+		PageSize:   2,
+		ResourceId: &resourceId,
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetEvents(), 2)
+	assert.Equal(t, nucleusdb.UUIDString(rawData[0].ID), resp.Msg.GetEvents()[0].GetId())
+	assert.NotEmpty(t, resp.Msg.GetNextPageToken())
+
+	createdAt, id, err := decodePageToken(resp.Msg.GetNextPageToken())
+	assert.NoError(t, err)
+	assert.Equal(t, rawData[1].ID, id)
+	assert.True(t, rawData[1].CreatedAt.Time.Equal(createdAt.Time))
+}
+
+func Test_Service_GetAuditEvents_LastPage(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	cursor := newAuditEvent(t, time.Now().UTC())
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetAuditEventsByAccount", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetAuditEventsByAccountParams) bool {
+		return params.PageLimit == defaultPageSize+1 && params.CursorId == cursor.ID
+	})).Return([]db_queries.NeosyncApiAuditEvent{newAuditEvent(t, time.Now().UTC())}, nil)
+
+	token := encodePageToken(&cursor)
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: uuid.NewString(),
+		PageToken: &token,
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetEvents(), 1)
+	assert.Nil(t, resp.Msg.NextPageToken)
+}
+
+func Test_Service_GetAuditEvents_InvalidPageToken(t *testing.T) {
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	svc := New(&Config{}, nucleusdb.New(nucleusdb.NewMockDBTX(t), db_queries.NewMockQuerier(t)), mockUserAccountService)
+	mockIsUserInAccount(mockUserAccountService, true)
+
+	token := "not-a-token"
+	_, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: uuid.NewString(),
+		PageToken: &token,
+	}))
+	assert.Error(t, err)
+}
+
+func Test_Service_GetAuditEvents_ForbiddenAccount(t *testing.T) {
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	svc := New(&Config{}, nucleusdb.New(nucleusdb.NewMockDBTX(t), db_queries.NewMockQuerier(t)), mockUserAccountService)
+	mockIsUserInAccount(mockUserAccountService, false)
+
+	_, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: uuid.NewString(),
+	}))
+	assert.Error(t, err)
+}
+
+func newAuditEvent(t *testing.T, createdAt time.Time) db_queries.NeosyncApiAuditEvent {
+	t.Helper()
+	return db_queries.NeosyncApiAuditEvent{
+		ID:        newPgUuid(t),
+		AccountID: newPgUuid(t),
+		Procedure: mgmtv1alpha1connect.JobServiceCreateJobProcedure,
+		Request:   []byte("{}"),
+		CreatedAt: pgtype.Timestamp{Time: createdAt, Valid: true},
+	}
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := nucleusdb.ToUuid(newuuid)
+	assert.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/gofrs/uuid"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	columntags "github.com/nucleuscloud/neosync/backend/internal/column-tags"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
@@ -66,7 +67,7 @@ func (s *Service) GetConnectionDataStream(
 ) error {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	logger = logger.With("connectionId", req.Msg.ConnectionId)
-	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -78,158 +79,15 @@ func (s *Service) GetConnectionDataStream(
 		return err
 	}
 
-	connectionTimeout := uint32(5)
-
 	switch config := connection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
 		if err != nil {
 			return err
 		}
-
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
-		if err != nil {
-			return err
-		}
-		defer conn.Close()
-		db, err := conn.Open()
-		if err != nil {
-			return err
-		}
-
-		table := sqlmanager_shared.BuildTable(req.Msg.Schema, req.Msg.Table)
-		// used to get column names
-		query, err := querybuilder.BuildSelectLimitQuery("mysql", table, 1)
-		if err != nil {
-			return err
-		}
-		r, err := db.QueryContext(ctx, query)
-		if err != nil && !nucleusdb.IsNoRows(err) {
-			return err
-		}
-
-		columnNames, err := r.Columns()
-		if err != nil {
-			return err
-		}
-
-		selectQuery, err := querybuilder.BuildSelectQuery("mysql", table, columnNames, nil)
-		if err != nil {
-			return err
-		}
-		rows, err := db.QueryContext(ctx, selectQuery)
-		if err != nil && !nucleusdb.IsNoRows(err) {
-			return err
-		}
-
-		for rows.Next() {
-			values := make([][]byte, len(columnNames))
-			valuesWrapped := make([]any, 0, len(columnNames))
-			for i := range values {
-				valuesWrapped = append(valuesWrapped, &values[i])
-			}
-			if err := rows.Scan(valuesWrapped...); err != nil {
-				return err
-			}
-			row := map[string][]byte{}
-			for i, v := range values {
-				col := columnNames[i]
-				row[col] = v
-			}
-
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
-				return err
-			}
-		}
-
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
-		if err != nil {
-			return err
-		}
-
-		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
-		if err != nil {
-			return err
-		}
-		db, err := conn.Open(ctx)
-		if err != nil {
-			return err
-		}
-		defer conn.Close()
-
-		table := sqlmanager_shared.BuildTable(req.Msg.Schema, req.Msg.Table)
-		// used to get column names
-		query, err := querybuilder.BuildSelectLimitQuery("postgres", table, 1)
-		if err != nil {
-			return err
-		}
-		r, err := db.Query(ctx, query)
-		if err != nil && !nucleusdb.IsNoRows(err) {
-			return err
-		}
-		defer r.Close()
-
-		columnNames := []string{}
-		for _, col := range r.FieldDescriptions() {
-			columnNames = append(columnNames, col.Name)
-		}
-
-		selectQuery, err := querybuilder.BuildSelectQuery("postgres", table, columnNames, nil)
-		if err != nil {
-			return err
-		}
-		rows, err := db.Query(ctx, selectQuery)
-		if err != nil && !nucleusdb.IsNoRows(err) {
-			return err
-		}
-		defer rows.Close()
-
-		for rows.Next() {
-			values := make([][]byte, len(columnNames))
-			valuesWrapped := make([]any, 0, len(columnNames))
-
-			for i, col := range r.FieldDescriptions() {
-				if col.DataTypeOID == 1082 { // OID for date
-					var t time.Time
-					ds := DateScanner{val: &t}
-					valuesWrapped = append(valuesWrapped, &ds)
-				} else {
-					valuesWrapped = append(valuesWrapped, &values[i])
-				}
-			}
-
-			if err := rows.Scan(valuesWrapped...); err != nil {
-				return err
-			}
-			row := map[string][]byte{}
-			for i, v := range values {
-				col := columnNames[i]
-				if r.FieldDescriptions()[i].DataTypeOID == 1082 { // OID for date
-					// Convert time.Time value to []byte
-					if ds, ok := valuesWrapped[i].(*DateScanner); ok && ds.val != nil {
-						row[col] = []byte(ds.val.Format(time.RFC3339))
-					} else {
-						row[col] = v
-					}
-				} else if r.FieldDescriptions()[i].DataTypeOID == 2950 { // OID for UUID
-					// Convert the byte slice to a uuid.UUID type
-					uuidValue, err := uuid.FromBytes(v)
-					if err == nil {
-						row[col] = []byte(uuidValue.String())
-					} else {
-						row[col] = v
-					}
-				} else {
-					row[col] = v
-				}
-			}
-
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
-				return err
-			}
-		}
-		return nil
+		return s.streamSqlTableRows(ctx, logger, connection, req.Msg.Schema, req.Msg.Table, nil, func(row map[string][]byte) error {
+			return stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row})
+		})
 
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		awsS3StreamCfg := req.Msg.StreamConfig.GetAwsS3Config()
@@ -428,6 +286,172 @@ func (s *Service) GetConnectionDataStream(
 	return nil
 }
 
+// Streams the rows of a mysql or postgres table to onRow. All rows are streamed if limit is nil.
+func (s *Service) streamSqlTableRows(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	schema, tableName string,
+	limit *uint,
+	onRow func(row map[string][]byte) error,
+) error {
+	connectionTimeout := uint32(5)
+	table := sqlmanager_shared.BuildTable(schema, tableName)
+
+	switch config := connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return err
+		}
+
+		// used to get column names
+		query, err := querybuilder.BuildSelectLimitQuery("mysql", table, 1)
+		if err != nil {
+			return err
+		}
+		r, err := db.QueryContext(ctx, query)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+		defer r.Close()
+
+		columnNames, err := r.Columns()
+		if err != nil {
+			return err
+		}
+
+		selectQuery, err := buildSelectTableRowsQuery("mysql", table, columnNames, limit)
+		if err != nil {
+			return err
+		}
+		rows, err := db.QueryContext(ctx, selectQuery)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			values := make([][]byte, len(columnNames))
+			valuesWrapped := make([]any, 0, len(columnNames))
+			for i := range values {
+				valuesWrapped = append(valuesWrapped, &values[i])
+			}
+			if err := rows.Scan(valuesWrapped...); err != nil {
+				return err
+			}
+			row := map[string][]byte{}
+			for i, v := range values {
+				col := columnNames[i]
+				row[col] = v
+			}
+
+			if err := onRow(row); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
+		if err != nil {
+			return err
+		}
+		db, err := conn.Open(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		// used to get column names
+		query, err := querybuilder.BuildSelectLimitQuery("postgres", table, 1)
+		if err != nil {
+			return err
+		}
+		r, err := db.Query(ctx, query)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+		defer r.Close()
+
+		columnNames := []string{}
+		for _, col := range r.FieldDescriptions() {
+			columnNames = append(columnNames, col.Name)
+		}
+
+		selectQuery, err := buildSelectTableRowsQuery("postgres", table, columnNames, limit)
+		if err != nil {
+			return err
+		}
+		rows, err := db.Query(ctx, selectQuery)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			values := make([][]byte, len(columnNames))
+			valuesWrapped := make([]any, 0, len(columnNames))
+
+			for i, col := range r.FieldDescriptions() {
+				if col.DataTypeOID == 1082 { // OID for date
+					var t time.Time
+					ds := DateScanner{val: &t}
+					valuesWrapped = append(valuesWrapped, &ds)
+				} else {
+					valuesWrapped = append(valuesWrapped, &values[i])
+				}
+			}
+
+			if err := rows.Scan(valuesWrapped...); err != nil {
+				return err
+			}
+			row := map[string][]byte{}
+			for i, v := range values {
+				col := columnNames[i]
+				if r.FieldDescriptions()[i].DataTypeOID == 1082 { // OID for date
+					// Convert time.Time value to []byte
+					if ds, ok := valuesWrapped[i].(*DateScanner); ok && ds.val != nil {
+						row[col] = []byte(ds.val.Format(time.RFC3339))
+					} else {
+						row[col] = v
+					}
+				} else if r.FieldDescriptions()[i].DataTypeOID == 2950 { // OID for UUID
+					// Convert the byte slice to a uuid.UUID type
+					uuidValue, err := uuid.FromBytes(v)
+					if err == nil {
+						row[col] = []byte(uuidValue.String())
+					} else {
+						row[col] = v
+					}
+				} else {
+					row[col] = v
+				}
+			}
+
+			if err := onRow(row); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return nucleuserrors.NewNotImplemented(fmt.Sprintf("this connection config is not currently supported: %T", config))
+	}
+}
+
+func buildSelectTableRowsQuery(driver, table string, columnNames []string, limit *uint) (string, error) {
+	if limit != nil {
+		return querybuilder.BuildSelectLimitQuery(driver, table, *limit)
+	}
+	return querybuilder.BuildSelectQuery(driver, table, columnNames, nil)
+}
+
 func (s *Service) GetConnectionSchemaMaps(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetConnectionSchemaMapsRequest],
@@ -495,10 +519,25 @@ func (s *Service) GetConnectionSchemaMap(
 func (s *Service) GetConnectionSchema(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error) {
+	resp, err := s.getConnectionSchemaResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setDatabaseColumnTags(ctx, req.Msg.GetConnectionId(), resp.Msg.GetSchemas()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Returns the schema of the connection without the column tags
+func (s *Service) getConnectionSchemaResponse(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	logger = logger.With("connectionId", req.Msg.ConnectionId)
-	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -511,7 +550,7 @@ func (s *Service) GetConnectionSchema(
 	}
 
 	switch config := connection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
 		if err != nil {
@@ -766,7 +805,7 @@ func (s *Service) GetConnectionForeignConstraints(
 	req *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -830,7 +869,7 @@ func (s *Service) GetConnectionPrimaryConstraints(
 	req *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -885,7 +924,7 @@ func (s *Service) GetConnectionInitStatements(
 	req *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -975,6 +1014,27 @@ func (s *Service) GetConnectionInitStatements(
 	}), nil
 }
 
+// Sets the classification tags that have been stored for the connection on each of the columns
+func (s *Service) setDatabaseColumnTags(ctx context.Context, connectionId string, columns []*mgmtv1alpha1.DatabaseColumn) error {
+	tagsResp, err := s.connectionService.GetConnectionColumnTags(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionColumnTagsRequest{
+		ConnectionId: connectionId,
+	}))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve connection column tags: %w", err)
+	}
+	if len(tagsResp.Msg.GetColumns()) == 0 {
+		return nil
+	}
+	tagsByColumn := map[string][]string{}
+	for _, column := range tagsResp.Msg.GetColumns() {
+		tagsByColumn[columntags.ColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())] = column.GetTags()
+	}
+	for _, column := range columns {
+		column.Tags = tagsByColumn[columntags.ColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())]
+	}
+	return nil
+}
+
 type schemaOpts struct {
 	JobId    *string
 	JobRunId *string
@@ -1037,10 +1097,16 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 				MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{},
 			},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_ClickhouseConfig{
+				ClickhouseConfig: &mgmtv1alpha1.ClickhouseSchemaConfig{},
+			},
+		}
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
-	schemaResp, err := s.GetConnectionSchema(ctx, connect.NewRequest(schemaReq))
+	schemaResp, err := s.getConnectionSchemaResponse(ctx, connect.NewRequest(schemaReq))
 	if err != nil {
 		return nil, err
 	}
@@ -1220,7 +1286,7 @@ func (s *Service) GetConnectionUniqueConstraints(
 	req *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -1285,7 +1351,7 @@ func (s *Service) GetAiGeneratedData(
 ) (*connect.Response[mgmtv1alpha1.GetAiGeneratedDataResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	_ = logger
-	aiconnectionResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	aiconnectionResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.GetAiConnectionId(),
 	}))
 	if err != nil {
@@ -1297,7 +1363,7 @@ func (s *Service) GetAiGeneratedData(
 		return nil, err
 	}
 
-	dbconnectionResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	dbconnectionResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.GetDataConnectionId(),
 	}))
 	if err != nil {
@@ -1380,7 +1446,7 @@ func (s *Service) GetConnectionTableConstraints(
 	req *connect.Request[mgmtv1alpha1.GetConnectionTableConstraintsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionTableConstraintsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -1407,7 +1473,7 @@ func (s *Service) GetConnectionTableConstraints(
 	}
 
 	switch connection.Msg.GetConnection().GetConnectionConfig().GetConfig().(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
 		if err != nil {
@@ -1467,7 +1533,7 @@ func (s *Service) GetTableRowCount(
 	req *connect.Request[mgmtv1alpha1.GetTableRowCountRequest],
 ) (*connect.Response[mgmtv1alpha1.GetTableRowCountResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -1480,7 +1546,7 @@ func (s *Service) GetTableRowCount(
 	}
 
 	switch connection.Msg.GetConnection().GetConnectionConfig().Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
 		if err != nil {
@@ -61,7 +61,8 @@ func Test_GetConnectionSchema_AwsS3(t *testing.T) {
 	path := fmt.Sprintf("workflows/%s/activities/", mockJobRunId)
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AwsS3Mock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	mockGetConnectionColumnTags(m.ConnectionServiceMock, nil)
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.AwsManagerMock.On("NewS3Client", mock.Anything, mock.Anything).Return(nil, nil)
@@ -137,7 +138,10 @@ func Test_GetConnectionSchema_Postgres(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	mockGetConnectionColumnTags(m.ConnectionServiceMock, []*mgmtv1alpha1.ColumnTags{
+		{Schema: "public", Table: "users", Column: "name", Tags: []string{"pii"}},
+	})
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return(mockColumns, nil)
@@ -157,6 +161,7 @@ func Test_GetConnectionSchema_Postgres(t *testing.T) {
 			DataType: col.DataType,
 		})
 	}
+	expected[1].Tags = []string{"pii"}
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
@@ -185,7 +190,8 @@ func Test_GetConnectionSchema_Mysql(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	mockGetConnectionColumnTags(m.ConnectionServiceMock, nil)
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
@@ -213,7 +219,8 @@ func Test_GetConnectionSchema_NoRows(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	mockGetConnectionColumnTags(m.ConnectionServiceMock, nil)
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
@@ -238,7 +245,7 @@ func Test_GetConnectionSchema_Error(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
@@ -262,7 +269,7 @@ func Test_GetConnectionForeignConstraints_Mysql(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
@@ -309,7 +316,7 @@ func Test_GetConnectionForeignConstraints_Postgres(t *testing.T) {
 	m.DbMock.On("Close").Return(nil)
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return([]*sqlmanager_shared.DatabaseSchemaRow{
@@ -354,7 +361,7 @@ func Test_GetConnectionPrimaryConstraints_Mysql(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
@@ -395,7 +402,7 @@ func Test_GetConnectionPrimaryConstraints_Postgres(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 
@@ -437,7 +444,7 @@ func Test_GetConnectionInitStatements_Mysql_Create(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.MysqlDriver}, nil)
@@ -488,7 +495,7 @@ func Test_GetConnectionInitStatements_Mysql_Truncate(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.MysqlDriver}, nil)
@@ -531,7 +538,7 @@ func Test_GetConnectionInitStatements_Postgres_Create(t *testing.T) {
 	m.DbMock.On("Close").Return(nil)
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.DbMock.On("GetCreateTableStatement", mock.Anything, "public", "users").Return("CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" uuid NOT NULL DEFAULT gen_random_uuid(), \"name\" varchar(40) NULL, CONSTRAINT users_pkey PRIMARY KEY (id));", nil)
@@ -583,7 +590,7 @@ func Test_GetConnectionInitStatements_Postgres_Truncate(t *testing.T) {
 	m.DbMock.On("Close").Return(nil)
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return([]*sqlmanager_shared.DatabaseSchemaRow{
@@ -689,6 +696,12 @@ func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAcc
 	}), nil)
 }
 
+func mockGetConnectionColumnTags(connectionServiceMock *mgmtv1alpha1connect.MockConnectionServiceClient, columns []*mgmtv1alpha1.ColumnTags) { //nolint
+	connectionServiceMock.On("GetConnectionColumnTags", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionColumnTagsResponse{
+		Columns: columns,
+	}), nil)
+}
+
 //nolint:all
 func getConnectionMock(accountId, name string, id string, connType ConnTypeMock) *mgmtv1alpha1.Connection {
 	timestamp := timestamppb.New(time.Now())
@@ -875,7 +888,7 @@ func Test_GetConnectionUniqueConstraints_Mysql(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.MysqlDriver}, nil)
@@ -918,7 +931,7 @@ func Test_GetConnectionUniqueConstraints_Postgres(t *testing.T) {
 
 	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: connection,
 	}), nil)
 
@@ -0,0 +1,367 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	columntags "github.com/nucleuscloud/neosync/backend/internal/column-tags"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	piidetect "github.com/nucleuscloud/neosync/backend/pkg/pii-detect"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultPiiScanSampleSize = 100
+	// the number of tables that are sampled at the same time
+	piiScanTableConcurrency = 3
+	// columns classified with a lower confidence are recommended to be passed through
+	minPiiRecommendationConfidence = 0.5
+)
+
+func (s *Service) ScanConnectionForPii(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ScanConnectionForPiiRequest],
+) (*connect.Response[mgmtv1alpha1.ScanConnectionForPiiResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId())
+	connResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
+		Id: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connResp.Msg.GetConnection()
+	_, err = s.verifyUserInAccount(ctx, connection.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	default:
+		return nil, nucleuserrors.NewBadRequest("only postgres and mysql connections can be scanned for pii")
+	}
+
+	columns, err := s.getConnectionSchema(ctx, connection, &schemaOpts{})
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.GetSchemas()) > 0 {
+		columns = slices.DeleteFunc(columns, func(column *mgmtv1alpha1.DatabaseColumn) bool {
+			return !slices.Contains(req.Msg.GetSchemas(), column.GetSchema())
+		})
+	}
+
+	sampleSize := uint(defaultPiiScanSampleSize)
+	if req.Msg.SampleSize != nil {
+		sampleSize = uint(req.Msg.GetSampleSize())
+	}
+
+	tables := []*sqlmanager_shared.SchemaTable{}
+	tableColumns := map[string][]*mgmtv1alpha1.DatabaseColumn{}
+	for _, column := range columns {
+		key := sqlmanager_shared.BuildTable(column.GetSchema(), column.GetTable())
+		if _, ok := tableColumns[key]; !ok {
+			tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: column.GetSchema(), Table: column.GetTable()})
+		}
+		tableColumns[key] = append(tableColumns[key], column)
+	}
+
+	// column name to the sampled values, per table
+	tableSamples := make([]map[string][]string, len(tables))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	errgrp.SetLimit(piiScanTableConcurrency)
+	for idx, table := range tables {
+		idx := idx
+		table := table
+		errgrp.Go(func() error {
+			samples := map[string][]string{}
+			err := s.streamSqlTableRows(errctx, logger, connection, table.Schema, table.Table, &sampleSize, func(row map[string][]byte) error {
+				for column, value := range row {
+					if value != nil {
+						samples[column] = append(samples[column], string(value))
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("unable to sample table %s: %w", table.String(), err)
+			}
+			tableSamples[idx] = samples
+			return nil
+		})
+	}
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	results := []*mgmtv1alpha1.ColumnPiiScanResult{}
+	for idx, table := range tables {
+		for _, column := range tableColumns[sqlmanager_shared.BuildTable(table.Schema, table.Table)] {
+			classification := piidetect.ClassifyColumn(column.GetColumn(), tableSamples[idx][column.GetColumn()])
+			result := &mgmtv1alpha1.ColumnPiiScanResult{
+				Schema:                 column.GetSchema(),
+				Table:                  column.GetTable(),
+				Column:                 column.GetColumn(),
+				DataType:               column.GetDataType(),
+				PiiType:                mgmtv1alpha1.PiiType_PII_TYPE_UNSPECIFIED,
+				RecommendedTransformer: getRecommendedPiiTransformer(classification, column.GetDataType()),
+			}
+			if classification != nil {
+				result.PiiType = toPiiTypeDto(classification.Type)
+				result.Confidence = classification.Confidence
+				result.NameMatch = classification.NameMatch
+				result.SampledCount = uint32(classification.SampledCount)
+				result.MatchedCount = uint32(classification.MatchedCount)
+			}
+			results = append(results, result)
+		}
+	}
+
+	if req.Msg.GetUpdateColumnTags() {
+		if err := s.addPiiColumnTags(ctx, connection.GetId(), results); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ScanConnectionForPiiResponse{
+		Columns: results,
+	}), nil
+}
+
+// Adds the tags of the detected pii to the connection's column tags, keeping the tags the columns already have
+func (s *Service) addPiiColumnTags(ctx context.Context, connectionId string, results []*mgmtv1alpha1.ColumnPiiScanResult) error {
+	tagsResp, err := s.connectionService.GetConnectionColumnTags(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionColumnTagsRequest{
+		ConnectionId: connectionId,
+	}))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve connection column tags: %w", err)
+	}
+	existing := map[string][]string{}
+	for _, column := range tagsResp.Msg.GetColumns() {
+		existing[columntags.ColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())] = column.GetTags()
+	}
+
+	updates := []*mgmtv1alpha1.ColumnTags{}
+	for _, result := range results {
+		piiTags := getPiiColumnTags(result)
+		if len(piiTags) == 0 {
+			continue
+		}
+		current := existing[columntags.ColumnKey(result.GetSchema(), result.GetTable(), result.GetColumn())]
+		merged := columntags.Merge(current, piiTags)
+		if slices.Equal(merged, current) {
+			continue
+		}
+		updates = append(updates, &mgmtv1alpha1.ColumnTags{
+			Schema: result.GetSchema(),
+			Table:  result.GetTable(),
+			Column: result.GetColumn(),
+			Tags:   merged,
+		})
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err = s.connectionService.SetConnectionColumnTags(ctx, connect.NewRequest(&mgmtv1alpha1.SetConnectionColumnTagsRequest{
+		ConnectionId: connectionId,
+		Columns:      updates,
+	}))
+	if err != nil {
+		return fmt.Errorf("unable to update connection column tags: %w", err)
+	}
+	return nil
+}
+
+// Returns the tags of a column that was detected to hold pii with enough confidence
+func getPiiColumnTags(result *mgmtv1alpha1.ColumnPiiScanResult) []string {
+	if result.GetPiiType() == mgmtv1alpha1.PiiType_PII_TYPE_UNSPECIFIED || result.GetConfidence() < minPiiRecommendationConfidence {
+		return nil
+	}
+	if result.GetPiiType() == mgmtv1alpha1.PiiType_PII_TYPE_CREDIT_CARD {
+		return []string{columntags.Pci, columntags.Pii}
+	}
+	return []string{columntags.Pii}
+}
+
+var piiTypeDtos = map[piidetect.PiiType]mgmtv1alpha1.PiiType{
+	piidetect.EmailPiiType:         mgmtv1alpha1.PiiType_PII_TYPE_EMAIL,
+	piidetect.PhonePiiType:         mgmtv1alpha1.PiiType_PII_TYPE_PHONE,
+	piidetect.SsnPiiType:           mgmtv1alpha1.PiiType_PII_TYPE_SSN,
+	piidetect.CreditCardPiiType:    mgmtv1alpha1.PiiType_PII_TYPE_CREDIT_CARD,
+	piidetect.IpAddressPiiType:     mgmtv1alpha1.PiiType_PII_TYPE_IP_ADDRESS,
+	piidetect.FirstNamePiiType:     mgmtv1alpha1.PiiType_PII_TYPE_FIRST_NAME,
+	piidetect.LastNamePiiType:      mgmtv1alpha1.PiiType_PII_TYPE_LAST_NAME,
+	piidetect.FullNamePiiType:      mgmtv1alpha1.PiiType_PII_TYPE_FULL_NAME,
+	piidetect.DateOfBirthPiiType:   mgmtv1alpha1.PiiType_PII_TYPE_DATE_OF_BIRTH,
+	piidetect.StreetAddressPiiType: mgmtv1alpha1.PiiType_PII_TYPE_STREET_ADDRESS,
+	piidetect.ZipcodePiiType:       mgmtv1alpha1.PiiType_PII_TYPE_ZIPCODE,
+}
+
+func toPiiTypeDto(piiType piidetect.PiiType) mgmtv1alpha1.PiiType {
+	if dto, ok := piiTypeDtos[piiType]; ok {
+		return dto
+	}
+	return mgmtv1alpha1.PiiType_PII_TYPE_UNSPECIFIED
+}
+
+// Returns the transformer that anonymizes the classified column.
+// Falls back to passthrough if the classification is not confident enough or no transformer supports the column's data type.
+func getRecommendedPiiTransformer(classification *piidetect.Classification, dataType string) *mgmtv1alpha1.JobMappingTransformer {
+	if classification == nil || classification.Confidence < minPiiRecommendationConfidence {
+		return getPassthroughTransformer()
+	}
+	isString := isStringDataType(dataType)
+	isInteger := isIntegerDataType(dataType)
+
+	switch classification.Type {
+	case piidetect.EmailPiiType:
+		if isString {
+			emailType := mgmtv1alpha1.GenerateEmailType_GENERATE_EMAIL_TYPE_UUID_V4
+			// invalid emails are regenerated as rejecting them would fail the job run
+			invalidEmailAction := mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_GENERATE
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{
+						ExcludedDomains:    []string{},
+						EmailType:          &emailType,
+						InvalidEmailAction: &invalidEmailAction,
+					},
+				},
+			})
+		}
+	case piidetect.PhonePiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig{
+					TransformPhoneNumberConfig: &mgmtv1alpha1.TransformPhoneNumber{},
+				},
+			})
+		}
+		if isInteger {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig{
+					TransformInt64PhoneNumberConfig: &mgmtv1alpha1.TransformInt64PhoneNumber{},
+				},
+			})
+		}
+	case piidetect.SsnPiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateSsnConfig{
+					GenerateSsnConfig: &mgmtv1alpha1.GenerateSSN{},
+				},
+			})
+		}
+	case piidetect.CreditCardPiiType:
+		if isInteger {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateCardNumberConfig{
+					GenerateCardNumberConfig: &mgmtv1alpha1.GenerateCardNumber{ValidLuhn: true},
+				},
+			})
+		}
+		// keeps the length and separators of formatted card numbers
+		if isString {
+			return getCharacterScrambleTransformer()
+		}
+	case piidetect.IpAddressPiiType:
+		if isString {
+			return getCharacterScrambleTransformer()
+		}
+	case piidetect.FirstNamePiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+					TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{},
+				},
+			})
+		}
+	case piidetect.LastNamePiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
+					TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{},
+				},
+			})
+		}
+	case piidetect.FullNamePiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
+					TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{},
+				},
+			})
+		}
+	case piidetect.DateOfBirthPiiType:
+		if isDateDataType(dataType) {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateUtctimestampConfig{
+					GenerateUtctimestampConfig: &mgmtv1alpha1.GenerateUtcTimestamp{},
+				},
+			})
+		}
+	case piidetect.StreetAddressPiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{
+					GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{},
+				},
+			})
+		}
+	case piidetect.ZipcodePiiType:
+		if isString {
+			return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE, &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{
+					GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{},
+				},
+			})
+		}
+	}
+	return getPassthroughTransformer()
+}
+
+func getPassthroughTransformer() *mgmtv1alpha1.JobMappingTransformer {
+	return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{
+			PassthroughConfig: &mgmtv1alpha1.Passthrough{},
+		},
+	})
+}
+
+func getCharacterScrambleTransformer() *mgmtv1alpha1.JobMappingTransformer {
+	return newJobMappingTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE, &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
+			TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{},
+		},
+	})
+}
+
+func newJobMappingTransformer(source mgmtv1alpha1.TransformerSource, config *mgmtv1alpha1.TransformerConfig) *mgmtv1alpha1.JobMappingTransformer {
+	return &mgmtv1alpha1.JobMappingTransformer{
+		Source: source,
+		Config: config,
+	}
+}
+
+func isStringDataType(dataType string) bool {
+	dt := strings.ToLower(dataType)
+	return strings.Contains(dt, "char") || strings.Contains(dt, "text") || dt == "citext"
+}
+
+func isIntegerDataType(dataType string) bool {
+	dt := strings.ToLower(dataType)
+	return dt == "integer" || strings.HasSuffix(dt, "int") || strings.HasPrefix(dt, "int(") || strings.HasPrefix(dt, "bigint(")
+}
+
+func isDateDataType(dataType string) bool {
+	dt := strings.ToLower(dataType)
+	return strings.HasPrefix(dt, "date") || strings.HasPrefix(dt, "timestamp")
+}
@@ -0,0 +1,135 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	piidetect "github.com/nucleuscloud/neosync/backend/pkg/pii-detect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScanConnectionForPii_Mysql(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetConnectionUnmasked(m, connection)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.MysqlDriver}, nil)
+	m.DbMock.On("Close").Return(nil)
+	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return([]*sqlmanager_shared.DatabaseSchemaRow{
+		{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "int"},
+		{TableSchema: "public", TableName: "users", ColumnName: "contact", DataType: "varchar"},
+		{TableSchema: "public", TableName: "users", ColumnName: "phone", DataType: "varchar"},
+		{TableSchema: "other", TableName: "logs", ColumnName: "id", DataType: "int"},
+	}, nil)
+
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "contact", "phone"}))
+	m.SqlMock.ExpectQuery("SELECT").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "contact", "phone"}).
+			AddRow(1, "nick@neosync.dev", "hello").
+			AddRow(2, "evis@neosync.dev", nil),
+	)
+
+	resp, err := m.Service.ScanConnectionForPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: mockConnectionId,
+		Schemas:      []string{"public"},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, m.SqlMock.ExpectationsWereMet())
+
+	columns := resp.Msg.GetColumns()
+	require.Len(t, columns, 3)
+
+	require.Equal(t, "id", columns[0].GetColumn())
+	require.Equal(t, mgmtv1alpha1.PiiType_PII_TYPE_UNSPECIFIED, columns[0].GetPiiType())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, columns[0].GetRecommendedTransformer().GetSource())
+
+	require.Equal(t, "contact", columns[1].GetColumn())
+	require.Equal(t, mgmtv1alpha1.PiiType_PII_TYPE_EMAIL, columns[1].GetPiiType())
+	require.False(t, columns[1].GetNameMatch())
+	require.Equal(t, uint32(2), columns[1].GetSampledCount())
+	require.Equal(t, uint32(2), columns[1].GetMatchedCount())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, columns[1].GetRecommendedTransformer().GetSource())
+
+	require.Equal(t, "phone", columns[2].GetColumn())
+	require.Equal(t, mgmtv1alpha1.PiiType_PII_TYPE_PHONE, columns[2].GetPiiType())
+	require.True(t, columns[2].GetNameMatch())
+	require.Equal(t, uint32(1), columns[2].GetSampledCount())
+	require.Less(t, columns[2].GetConfidence(), 0.5)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, columns[2].GetRecommendedTransformer().GetSource())
+}
+
+func Test_ScanConnectionForPii_UnsupportedConnection(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AwsS3Mock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetConnectionUnmasked(m, connection)
+
+	_, err := m.Service.ScanConnectionForPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_addPiiColumnTags(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockGetConnectionColumnTags(m.ConnectionServiceMock, []*mgmtv1alpha1.ColumnTags{
+		{Schema: "public", Table: "users", Column: "contact", Tags: []string{"free_text"}},
+		{Schema: "public", Table: "users", Column: "email", Tags: []string{"pii"}},
+	})
+	m.ConnectionServiceMock.On("SetConnectionColumnTags", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetConnectionColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+		Columns: []*mgmtv1alpha1.ColumnTags{
+			{Schema: "public", Table: "users", Column: "contact", Tags: []string{"free_text", "pii"}},
+			{Schema: "public", Table: "users", Column: "card", Tags: []string{"pci", "pii"}},
+		},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetConnectionColumnTagsResponse{}), nil)
+
+	err := m.Service.addPiiColumnTags(context.Background(), mockConnectionId, []*mgmtv1alpha1.ColumnPiiScanResult{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "contact", PiiType: mgmtv1alpha1.PiiType_PII_TYPE_EMAIL, Confidence: 0.9},
+		{Schema: "public", Table: "users", Column: "email", PiiType: mgmtv1alpha1.PiiType_PII_TYPE_EMAIL, Confidence: 0.9},
+		{Schema: "public", Table: "users", Column: "phone", PiiType: mgmtv1alpha1.PiiType_PII_TYPE_PHONE, Confidence: 0.3},
+		{Schema: "public", Table: "users", Column: "card", PiiType: mgmtv1alpha1.PiiType_PII_TYPE_CREDIT_CARD, Confidence: 0.8},
+	})
+	require.NoError(t, err)
+	m.ConnectionServiceMock.AssertNumberOfCalls(t, "SetConnectionColumnTags", 1)
+}
+
+func Test_getRecommendedPiiTransformer(t *testing.T) {
+	tests := []struct {
+		name           string
+		classification *piidetect.Classification
+		dataType       string
+		expected       mgmtv1alpha1.TransformerSource
+	}{
+		{"unclassified", nil, "text", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{"low confidence", &piidetect.Classification{Type: piidetect.EmailPiiType, Confidence: 0.3}, "text", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{"email", &piidetect.Classification{Type: piidetect.EmailPiiType, Confidence: 0.9}, "character varying", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+		{"string phone", &piidetect.Classification{Type: piidetect.PhonePiiType, Confidence: 0.9}, "varchar", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER},
+		{"integer phone", &piidetect.Classification{Type: piidetect.PhonePiiType, Confidence: 0.9}, "bigint", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER},
+		{"integer card", &piidetect.Classification{Type: piidetect.CreditCardPiiType, Confidence: 0.9}, "bigint", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER},
+		{"string card", &piidetect.Classification{Type: piidetect.CreditCardPiiType, Confidence: 0.9}, "text", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE},
+		{"date of birth", &piidetect.Classification{Type: piidetect.DateOfBirthPiiType, Confidence: 0.9}, "date", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP},
+		{"incompatible type", &piidetect.Classification{Type: piidetect.IpAddressPiiType, Confidence: 0.9}, "inet", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := getRecommendedPiiTransformer(tt.classification, tt.dataType)
+			require.Equal(t, tt.expected, actual.GetSource())
+			require.NotNil(t, actual.GetConfig().GetConfig())
+		})
+	}
+}
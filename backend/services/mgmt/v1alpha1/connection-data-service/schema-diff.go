@@ -0,0 +1,297 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
+	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"golang.org/x/sync/errgroup"
+)
+
+func (s *Service) CompareConnectionSchemas(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CompareConnectionSchemasRequest],
+) (*connect.Response[mgmtv1alpha1.CompareConnectionSchemasResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	sourceResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
+		Id: req.Msg.GetSourceConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	source := sourceResp.Msg.GetConnection()
+	destinationResp, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
+		Id: req.Msg.GetDestinationConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	destination := destinationResp.Msg.GetConnection()
+
+	_, err = s.verifyUserInAccount(ctx, source.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if source.GetAccountId() != destination.GetAccountId() {
+		return nil, nucleuserrors.NewBadRequest("source and destination connections must be in the same account")
+	}
+	if !isSqlConnection(source) || !isSqlConnection(destination) {
+		return nil, nucleuserrors.NewBadRequest("schemas can only be compared between postgres, mysql and mssql connections")
+	}
+
+	var statementBuilder sqlmanager_shared.SchemaDiffStatementBuilder
+	if req.Msg.GetIncludeMigrationStatements() {
+		statementBuilder, err = getSchemaDiffStatementBuilder(source, destination)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	connectionTimeout := 5
+	sourceDb, err := s.sqlmanager.NewSqlDb(ctx, logger, source, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceDb.Db.Close()
+	destinationDb, err := s.sqlmanager.NewSqlDb(ctx, logger, destination, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer destinationDb.Db.Close()
+
+	var sourceSchema, destinationSchema *sqlmanager_shared.DatabaseSchema
+	errgrp, errctx := errgroup.WithContext(ctx)
+	errgrp.Go(func() error {
+		schema, err := getDatabaseSchema(errctx, sourceDb, req.Msg.GetSchemas())
+		if err != nil {
+			return fmt.Errorf("unable to retrieve source schema: %w", err)
+		}
+		sourceSchema = schema
+		return nil
+	})
+	errgrp.Go(func() error {
+		schema, err := getDatabaseSchema(errctx, destinationDb, req.Msg.GetSchemas())
+		if err != nil {
+			return fmt.Errorf("unable to retrieve destination schema: %w", err)
+		}
+		destinationSchema = schema
+		return nil
+	})
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	diffs := sqlmanager_shared.CompareSchemas(sourceSchema, destinationSchema)
+
+	migrationStmts := []string{}
+	if statementBuilder != nil {
+		migrationStmts, err = getSchemaDiffStatements(ctx, logger, sourceDb, diffs, statementBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dtos := make([]*mgmtv1alpha1.TableSchemaDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		dtos = append(dtos, toTableSchemaDiffDto(diff, sourceDb.Driver, destinationDb.Driver))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CompareConnectionSchemasResponse{
+		Tables:              dtos,
+		MigrationStatements: migrationStmts,
+	}), nil
+}
+
+func isSqlConnection(connection *mgmtv1alpha1.Connection) bool {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return true
+	default:
+		return false
+	}
+}
+
+func getSchemaDiffStatementBuilder(source, destination *mgmtv1alpha1.Connection) (sqlmanager_shared.SchemaDiffStatementBuilder, error) {
+	switch source.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		if destination.GetConnectionConfig().GetPgConfig() != nil {
+			return &sqlmanager_postgres.SchemaDiffStatementBuilder{}, nil
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if destination.GetConnectionConfig().GetMysqlConfig() != nil {
+			return &sqlmanager_mysql.SchemaDiffStatementBuilder{}, nil
+		}
+	}
+	return nil, nucleuserrors.NewBadRequest("migration statements are only supported when both connections are postgres or both connections are mysql")
+}
+
+// Retrieves the columns, constraints and triggers of the database. Only tables in the provided schemas are returned if any are provided.
+func getDatabaseSchema(ctx context.Context, db *sqlmanager.SqlConnection, schemas []string) (*sqlmanager_shared.DatabaseSchema, error) {
+	columnMap, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemaFilter := map[string]struct{}{}
+	for _, schema := range schemas {
+		schemaFilter[schema] = struct{}{}
+	}
+
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{}
+	tables := []*sqlmanager_shared.SchemaTable{}
+	tableSchemas := map[string]struct{}{}
+	for key, cols := range columnMap {
+		schema, table := sqlmanager_shared.SplitTableKey(key)
+		if _, ok := schemaFilter[schema]; len(schemaFilter) > 0 && !ok {
+			continue
+		}
+		columns[key] = cols
+		tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: schema, Table: table})
+		tableSchemas[schema] = struct{}{}
+	}
+
+	output := &sqlmanager_shared.DatabaseSchema{
+		Driver:      db.Driver,
+		Columns:     columns,
+		Constraints: &sqlmanager_shared.TableConstraints{},
+		Triggers:    []*sqlmanager_shared.TableTrigger{},
+	}
+	if len(tables) == 0 {
+		return output, nil
+	}
+
+	uniqueSchemas := make([]string, 0, len(tableSchemas))
+	for schema := range tableSchemas {
+		uniqueSchemas = append(uniqueSchemas, schema)
+	}
+	output.Constraints, err = db.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
+	if err != nil {
+		return nil, err
+	}
+	output.Triggers, err = db.Db.GetSchemaTableTriggers(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Builds the statements that migrate the destination, using the source database to create the tables that are missing in the destination
+func getSchemaDiffStatements(
+	ctx context.Context,
+	logger *slog.Logger,
+	sourceDb *sqlmanager.SqlConnection,
+	diffs []*sqlmanager_shared.TableSchemaDiff,
+	builder sqlmanager_shared.SchemaDiffStatementBuilder,
+) ([]string, error) {
+	initStatements := map[string]*sqlmanager_shared.TableInitStatement{}
+	for _, diff := range diffs {
+		if diff.Type != sqlmanager_shared.MissingInDestinationDiffType {
+			continue
+		}
+		// requested per table as tables that can not be found are left out of the output
+		table := &sqlmanager_shared.SchemaTable{Schema: diff.Schema, Table: diff.Table}
+		stmts, err := sourceDb.Db.GetTableInitStatements(ctx, []*sqlmanager_shared.SchemaTable{table})
+		if err != nil {
+			return nil, fmt.Errorf("unable to build create table statement for %s: %w", table.String(), err)
+		}
+		if len(stmts) == 0 {
+			logger.Warn(fmt.Sprintf("unable to build create table statement for %s", table.String()))
+			continue
+		}
+		initStatements[table.String()] = stmts[0]
+	}
+	return sqlmanager_shared.BuildSchemaDiffStatements(diffs, initStatements, builder), nil
+}
+
+func toTableSchemaDiffDto(diff *sqlmanager_shared.TableSchemaDiff, sourceDriver, destinationDriver string) *mgmtv1alpha1.TableSchemaDiff {
+	dto := &mgmtv1alpha1.TableSchemaDiff{
+		Schema:      diff.Schema,
+		Table:       diff.Table,
+		Type:        toSchemaDiffTypeDto(diff.Type),
+		Columns:     make([]*mgmtv1alpha1.ColumnSchemaDiff, 0, len(diff.Columns)),
+		Constraints: make([]*mgmtv1alpha1.ConstraintSchemaDiff, 0, len(diff.Constraints)),
+		Triggers:    make([]*mgmtv1alpha1.TriggerSchemaDiff, 0, len(diff.Triggers)),
+	}
+	for _, col := range diff.Columns {
+		dto.Columns = append(dto.Columns, &mgmtv1alpha1.ColumnSchemaDiff{
+			Column:      col.Column,
+			Type:        toSchemaDiffTypeDto(col.Type),
+			Source:      toColumnSchemaDefinitionDto(col.Source, sourceDriver),
+			Destination: toColumnSchemaDefinitionDto(col.Destination, destinationDriver),
+		})
+	}
+	for _, constraint := range diff.Constraints {
+		constraintDto := &mgmtv1alpha1.ConstraintSchemaDiff{
+			ConstraintType: toSchemaConstraintTypeDto(constraint.ConstraintType),
+			Type:           toSchemaDiffTypeDto(constraint.Type),
+			Columns:        constraint.Columns,
+		}
+		if constraint.ForeignKey != nil {
+			constraintDto.ForeignKey = &mgmtv1alpha1.ForeignKey{
+				Table:   constraint.ForeignKey.Table,
+				Columns: constraint.ForeignKey.Columns,
+			}
+		}
+		dto.Constraints = append(dto.Constraints, constraintDto)
+	}
+	for _, trigger := range diff.Triggers {
+		triggerDto := &mgmtv1alpha1.TriggerSchemaDiff{
+			Name: trigger.TriggerName,
+			Type: toSchemaDiffTypeDto(trigger.Type),
+		}
+		if trigger.Source != nil {
+			triggerDto.SourceDefinition = &trigger.Source.Definition
+		}
+		if trigger.Destination != nil {
+			triggerDto.DestinationDefinition = &trigger.Destination.Definition
+		}
+		dto.Triggers = append(dto.Triggers, triggerDto)
+	}
+	return dto
+}
+
+func toColumnSchemaDefinitionDto(info *sqlmanager_shared.ColumnInfo, driver string) *mgmtv1alpha1.ColumnSchemaDefinition {
+	if info == nil {
+		return nil
+	}
+	dto := &mgmtv1alpha1.ColumnSchemaDefinition{
+		DataType:   sqlmanager_shared.BuildColumnDataType(driver, info),
+		IsNullable: info.IsNullable,
+	}
+	if info.ColumnDefault != "" {
+		dto.ColumnDefault = &info.ColumnDefault
+	}
+	return dto
+}
+
+func toSchemaDiffTypeDto(diffType sqlmanager_shared.SchemaDiffType) mgmtv1alpha1.SchemaDiffType {
+	switch diffType {
+	case sqlmanager_shared.MissingInDestinationDiffType:
+		return mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION
+	case sqlmanager_shared.MissingInSourceDiffType:
+		return mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_SOURCE
+	case sqlmanager_shared.ChangedDiffType:
+		return mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED
+	default:
+		return mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_UNSPECIFIED
+	}
+}
+
+func toSchemaConstraintTypeDto(constraintType sqlmanager_shared.ConstraintType) mgmtv1alpha1.SchemaConstraintType {
+	switch constraintType {
+	case sqlmanager_shared.PrimaryConstraintType:
+		return mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_PRIMARY_KEY
+	case sqlmanager_shared.ForeignConstraintType:
+		return mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_FOREIGN_KEY
+	case sqlmanager_shared.UniqueConstraintType:
+		return mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_UNIQUE
+	default:
+		return mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_UNSPECIFIED
+	}
+}
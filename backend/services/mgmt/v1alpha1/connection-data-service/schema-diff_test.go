@@ -0,0 +1,131 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockDestinationConnectionId = "5d3b4c0e-53b8-4b5f-8a0e-9f1c3f0b6c2d"
+)
+
+func Test_CompareConnectionSchemas_Postgres(t *testing.T) {
+	m := createServiceMock(t)
+	destinationDbMock := sqlmanager.NewMockSqlDatabase(t)
+
+	source := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	destination := getConnectionMock(mockAccountId, "dest", mockDestinationConnectionId, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetConnectionUnmasked(m, source)
+	mockGetConnectionUnmasked(m, destination)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, source, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, destination, mock.Anything).Return(&sqlmanager.SqlConnection{Db: destinationDbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.DbMock.On("Close").Return(nil)
+	destinationDbMock.On("Close").Return(nil)
+
+	m.DbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":    {OrdinalPosition: 1, DataType: "uuid"},
+			"email": {OrdinalPosition: 2, DataType: "text", IsNullable: true},
+		},
+		"public.orders": {
+			"id": {OrdinalPosition: 1, DataType: "uuid"},
+		},
+	}, nil)
+	m.DbMock.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}, "public.orders": {"id"}},
+	}, nil)
+	m.DbMock.On("GetSchemaTableTriggers", mock.Anything, mock.Anything).Return([]*sqlmanager_shared.TableTrigger{}, nil)
+	m.DbMock.On("GetTableInitStatements", mock.Anything, []*sqlmanager_shared.SchemaTable{{Schema: "public", Table: "orders"}}).Return([]*sqlmanager_shared.TableInitStatement{
+		{CreateTableStatement: "create-orders", AlterTableStatements: []*sqlmanager_shared.AlterTableStatement{{Statement: "orders-pk", ConstraintType: sqlmanager_shared.PrimaryConstraintType}}},
+	}, nil)
+
+	destinationDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id": {OrdinalPosition: 1, DataType: "uuid"},
+		},
+		"other.logs": {
+			"id": {OrdinalPosition: 1, DataType: "bigint"},
+		},
+	}, nil)
+	destinationDbMock.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}},
+	}, nil)
+	destinationDbMock.On("GetSchemaTableTriggers", mock.Anything, mock.Anything).Return([]*sqlmanager_shared.TableTrigger{}, nil)
+
+	resp, err := m.Service.CompareConnectionSchemas(context.Background(), connect.NewRequest(&mgmtv1alpha1.CompareConnectionSchemasRequest{
+		SourceConnectionId:         mockConnectionId,
+		DestinationConnectionId:    mockDestinationConnectionId,
+		Schemas:                    []string{"public"},
+		IncludeMigrationStatements: true,
+	}))
+	require.NoError(t, err)
+
+	tables := resp.Msg.GetTables()
+	require.Len(t, tables, 2)
+	require.Equal(t, "orders", tables[0].GetTable())
+	require.Equal(t, mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION, tables[0].GetType())
+	require.Equal(t, "users", tables[1].GetTable())
+	require.Equal(t, mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED, tables[1].GetType())
+	require.Len(t, tables[1].GetColumns(), 1)
+	require.Equal(t, "email", tables[1].GetColumns()[0].GetColumn())
+	require.Equal(t, "text", tables[1].GetColumns()[0].GetSource().GetDataType())
+	require.Nil(t, tables[1].GetColumns()[0].Destination)
+
+	require.Equal(t, []string{
+		"create-orders",
+		`ALTER TABLE "public"."users" ADD COLUMN "email" text NULL;`,
+		"orders-pk",
+	}, resp.Msg.GetMigrationStatements())
+}
+
+func Test_CompareConnectionSchemas_MigrationUnsupported(t *testing.T) {
+	m := createServiceMock(t)
+
+	source := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	destination := getConnectionMock(mockAccountId, "dest", mockDestinationConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetConnectionUnmasked(m, source)
+	mockGetConnectionUnmasked(m, destination)
+
+	_, err := m.Service.CompareConnectionSchemas(context.Background(), connect.NewRequest(&mgmtv1alpha1.CompareConnectionSchemasRequest{
+		SourceConnectionId:         mockConnectionId,
+		DestinationConnectionId:    mockDestinationConnectionId,
+		IncludeMigrationStatements: true,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.SqlManagerMock.AssertNotCalled(t, "NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CompareConnectionSchemas_UnsupportedConnection(t *testing.T) {
+	m := createServiceMock(t)
+
+	source := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	destination := getConnectionMock(mockAccountId, "dest", mockDestinationConnectionId, AwsS3Mock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetConnectionUnmasked(m, source)
+	mockGetConnectionUnmasked(m, destination)
+
+	_, err := m.Service.CompareConnectionSchemas(context.Background(), connect.NewRequest(&mgmtv1alpha1.CompareConnectionSchemasRequest{
+		SourceConnectionId:      mockConnectionId,
+		DestinationConnectionId: mockDestinationConnectionId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func mockGetConnectionUnmasked(m *serviceMocks, connection *mgmtv1alpha1.Connection) {
+	m.ConnectionServiceMock.On("GetConnectionUnmasked", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) bool {
+		return req.Msg.GetId() == connection.GetId()
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
+		Connection: connection,
+	}), nil)
+}
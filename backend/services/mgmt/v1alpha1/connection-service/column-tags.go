@@ -0,0 +1,113 @@
+package v1alpha1_connectionservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	columntags "github.com/nucleuscloud/neosync/backend/internal/column-tags"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GetConnectionColumnTags(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionColumnTagsRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionColumnTagsResponse], error) {
+	connectionUuid, err := s.verifyConnectionInAccount(ctx, req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.getConnectionColumnTags(ctx, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetConnectionColumnTagsResponse{
+		Columns: columns,
+	}), nil
+}
+
+func (s *Service) SetConnectionColumnTags(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetConnectionColumnTagsRequest],
+) (*connect.Response[mgmtv1alpha1.SetConnectionColumnTagsResponse], error) {
+	connectionUuid, err := s.verifyConnectionInAccount(ctx, req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]*mgmtv1alpha1.ColumnTags, 0, len(req.Msg.GetColumns()))
+	seen := map[string]struct{}{}
+	for _, column := range req.Msg.GetColumns() {
+		if column.GetSchema() == "" || column.GetTable() == "" || column.GetColumn() == "" {
+			return nil, nucleuserrors.NewBadRequest("column tags must specify a schema, table and column")
+		}
+		key := columntags.ColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())
+		if _, ok := seen[key]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("column %s was provided more than once", key))
+		}
+		seen[key] = struct{}{}
+
+		tags, err := columntags.Normalize(column.GetTags())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(err.Error())
+		}
+		columns = append(columns, &mgmtv1alpha1.ColumnTags{
+			Schema: column.GetSchema(),
+			Table:  column.GetTable(),
+			Column: column.GetColumn(),
+			Tags:   tags,
+		})
+	}
+
+	if err := s.db.SetConnectionColumnTags(ctx, connectionUuid, columns, *userUuid); err != nil {
+		return nil, fmt.Errorf("unable to set connection column tags: %w", err)
+	}
+
+	updated, err := s.getConnectionColumnTags(ctx, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetConnectionColumnTagsResponse{
+		Columns: updated,
+	}), nil
+}
+
+// Returns the connection's uuid if the connection exists and the user is in its account
+func (s *Service) verifyConnectionInAccount(ctx context.Context, connectionId string) (pgtype.UUID, error) {
+	connectionUuid, err := nucleusdb.ToUuid(connectionId)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	connection, err := s.db.Q.GetConnectionById(ctx, s.db.Db, connectionUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return pgtype.UUID{}, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return pgtype.UUID{}, nucleuserrors.NewNotFound("unable to find connection by id")
+	}
+
+	if _, err := s.verifyUserInAccount(ctx, nucleusdb.UUIDString(connection.AccountID)); err != nil {
+		return pgtype.UUID{}, err
+	}
+	return connection.ID, nil
+}
+
+func (s *Service) getConnectionColumnTags(ctx context.Context, connectionUuid pgtype.UUID) ([]*mgmtv1alpha1.ColumnTags, error) {
+	rows, err := s.db.Q.GetConnectionColumnTags(ctx, s.db.Db, connectionUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve connection column tags: %w", err)
+	}
+	columns := make([]*mgmtv1alpha1.ColumnTags, 0, len(rows))
+	for idx := range rows {
+		columns = append(columns, dtomaps.ToColumnTagsDto(&rows[idx]))
+	}
+	return columns, nil
+}
@@ -0,0 +1,114 @@
+package v1alpha1_connectionservice
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_GetConnectionColumnTags(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("GetConnectionColumnTags", mock.Anything, mock.Anything, connectionUuid).Return([]db_queries.NeosyncApiConnectionColumnTag{
+		{ConnectionID: connectionUuid, SchemaName: "public", TableName: "users", ColumnName: "dob", Tags: []string{"phi", "pii"}},
+	}, nil)
+
+	resp, err := m.Service.GetConnectionColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*mgmtv1alpha1.ColumnTags{
+		{Schema: "public", Table: "users", Column: "dob", Tags: []string{"phi", "pii"}},
+	}, resp.Msg.GetColumns())
+}
+
+func Test_GetConnectionColumnTags_NotFound(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiConnection{}, sql.ErrNoRows)
+
+	_, err := m.Service.GetConnectionColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func Test_SetConnectionColumnTags(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockTx := new(nucleusdb.MockTx)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("UpsertConnectionColumnTags", mock.Anything, mockTx, db_queries.UpsertConnectionColumnTagsParams{
+		ConnectionId: connectionUuid,
+		SchemaName:   "public",
+		TableName:    "orders",
+		ColumnName:   "notes",
+		Tags:         []string{"free_text", "team-a"},
+		UserId:       userUuid,
+	}).Return(nil)
+	m.QuerierMock.On("RemoveConnectionColumnTags", mock.Anything, mockTx, db_queries.RemoveConnectionColumnTagsParams{
+		ConnectionID: connectionUuid,
+		SchemaName:   "public",
+		TableName:    "users",
+		ColumnName:   "id",
+	}).Return(nil)
+	m.QuerierMock.On("GetConnectionColumnTags", mock.Anything, mock.Anything, connectionUuid).Return([]db_queries.NeosyncApiConnectionColumnTag{
+		{ConnectionID: connectionUuid, SchemaName: "public", TableName: "orders", ColumnName: "notes", Tags: []string{"free_text", "team-a"}},
+	}, nil)
+
+	resp, err := m.Service.SetConnectionColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetConnectionColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+		Columns: []*mgmtv1alpha1.ColumnTags{
+			{Schema: "public", Table: "orders", Column: "notes", Tags: []string{"team-a", "FREE_TEXT", "free_text"}},
+			{Schema: "public", Table: "users", Column: "id"},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetColumns(), 1)
+	mockTx.AssertCalled(t, "Commit", mock.Anything)
+}
+
+func Test_SetConnectionColumnTags_InvalidTag(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+
+	_, err := m.Service.SetConnectionColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetConnectionColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+		Columns: []*mgmtv1alpha1.ColumnTags{
+			{Schema: "public", Table: "orders", Column: "notes", Tags: []string{"free text"}},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
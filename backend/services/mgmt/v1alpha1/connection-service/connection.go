@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -17,6 +18,8 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 	"golang.org/x/sync/errgroup"
 
@@ -29,14 +32,31 @@ func (s *Service) CheckConnectionConfig(
 ) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 
-	switch req.Msg.GetConnectionConfig().GetConfig().(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-		role, err := getDbRoleFromConnectionConfig(req.Msg.GetConnectionConfig())
+	connectionConfig := req.Msg.GetConnectionConfig()
+	if req.Msg.Id != nil {
+		storedConnection, err := s.getConnection(ctx, req.Msg.GetId())
+		if err != nil {
+			return nil, err
+		}
+		// secrets are masked in responses, check the connection with the stored secrets when the client sends the masked placeholder back
+		connectionConfig, err = redact.Restore(connectionConfig, storedConnection.GetConnectionConfig())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(err.Error())
+		}
+	}
+	connectionConfig, err := s.secretResolver.ResolveConnectionConfig(ctx, connectionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	switch connectionConfig.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		role, err := getDbRoleFromConnectionConfig(connectionConfig)
 		if err != nil {
 			return nil, err
 		}
 		connTimeout := 5
-		db, err := s.sqlmanager.NewSqlDbFromConnectionConfig(ctx, logger, req.Msg.GetConnectionConfig(), &connTimeout)
+		db, err := s.sqlmanager.NewSqlDbFromConnectionConfig(ctx, logger, connectionConfig, &connTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -69,7 +89,7 @@ func (s *Service) CheckConnectionConfig(
 		}), nil
 
 	case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
-		db, err := s.mongoconnector.NewFromConnectionConfig(req.Msg.GetConnectionConfig(), logger)
+		db, err := s.mongoconnector.NewFromConnectionConfig(connectionConfig, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -134,7 +154,7 @@ func (s *Service) CheckConnectionConfig(
 			Privileges:      privs,
 		}), nil
 	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
-		client, err := s.awsManager.NewDynamoDbClient(ctx, req.Msg.GetConnectionConfig().GetDynamodbConfig())
+		client, err := s.awsManager.NewDynamoDbClient(ctx, connectionConfig.GetDynamodbConfig())
 		if err != nil {
 			return nil, err
 		}
@@ -158,8 +178,30 @@ func (s *Service) CheckConnectionConfig(
 			ConnectionError: nil,
 			Privileges:      privs,
 		}), nil
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		client, err := kafkaconnect.NewClient(connectionConfig.GetKafkaConfig())
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		if err := client.Ping(pingCtx); err != nil {
+			errmsg := err.Error()
+			return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+				IsConnected:     false,
+				ConnectionError: &errmsg,
+				Privileges:      []*mgmtv1alpha1.ConnectionRolePrivilege{},
+			}), nil
+		}
+		return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+			IsConnected:     true,
+			ConnectionError: nil,
+			Privileges:      []*mgmtv1alpha1.ConnectionRolePrivilege{},
+		}), nil
 	default:
-		return nil, fmt.Errorf("this method does not support this connection type %T: %w", req.Msg.GetConnectionConfig().GetConfig(), errors.ErrUnsupported)
+		return nil, fmt.Errorf("this method does not support this connection type %T: %w", connectionConfig.GetConfig(), errors.ErrUnsupported)
 	}
 }
 
@@ -175,6 +217,8 @@ func getDbRoleFromConnectionConfig(cconfig *mgmtv1alpha1.ConnectionConfig) (stri
 		return getMysqlUserFromConnectionConfig(typedconfig.MysqlConfig)
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return getMssqlUserFromConnectionConfig(typedconfig.MssqlConfig)
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		return getClickhouseUserFromConnectionConfig(typedconfig.ClickhouseConfig)
 	default:
 		return "", fmt.Errorf("invalid database connection config (%T) for retrieving db role: %w", typedconfig, errors.ErrUnsupported)
 	}
@@ -235,6 +279,27 @@ func getMssqlUserFromConnectionConfig(ccfg *mgmtv1alpha1.MssqlConnectionConfig)
 	}
 }
 
+func getClickhouseUserFromConnectionConfig(ccfg *mgmtv1alpha1.ClickhouseConnectionConfig) (string, error) {
+	switch config := ccfg.ConnectionConfig.(type) {
+	case *mgmtv1alpha1.ClickhouseConnectionConfig_Url:
+		u, err := url.Parse(config.Url)
+		if err != nil {
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				return "", fmt.Errorf("unable to parse clickhouse url [%s]: %w", urlErr.Op, urlErr.Err)
+			}
+			return "", fmt.Errorf("unable to parse clickhouse url: %w", err)
+		}
+		if u.User.Username() == "" {
+			// clickhouse falls back to the default user when one is not provided
+			return "default", nil
+		}
+		return u.User.Username(), nil
+	default:
+		return "", fmt.Errorf("unable to parse connection url from clickhouse config: %T", config)
+	}
+}
+
 func (s *Service) IsConnectionNameAvailable(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.IsConnectionNameAvailableRequest],
@@ -266,7 +331,7 @@ func (s *Service) GetConnections(
 		return nil, err
 	}
 
-	connections, err := s.db.Q.GetConnectionsByAccount(ctx, s.db.Db, *accountUuid)
+	connections, err := s.db.GetConnectionsByAccount(ctx, *accountUuid)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +343,7 @@ func (s *Service) GetConnections(
 		if err != nil {
 			return nil, err
 		}
-		dtoConns = append(dtoConns, dto)
+		dtoConns = append(dtoConns, redact.Message(dto))
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionsResponse{
@@ -290,12 +355,39 @@ func (s *Service) GetConnection(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetConnectionRequest],
 ) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
-	idUuid, err := nucleusdb.ToUuid(req.Msg.Id)
+	connection, err := s.getConnection(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: redact.Message(connection),
+	}), nil
+}
+
+func (s *Service) GetConnectionUnmasked(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
+	connection, err := s.getConnection(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
+		Connection: connection,
+	}), nil
+}
+
+// Returns the connection including its secrets
+func (s *Service) getConnection(
+	ctx context.Context,
+	id string,
+) (*mgmtv1alpha1.Connection, error) {
+	idUuid, err := nucleusdb.ToUuid(id)
 	if err != nil {
 		return nil, err
 	}
 
-	connection, err := s.db.Q.GetConnectionById(ctx, s.db.Db, idUuid)
+	connection, err := s.db.GetConnectionById(ctx, idUuid)
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
@@ -306,19 +398,17 @@ func (s *Service) GetConnection(
 	if err != nil {
 		return nil, err
 	}
-	dto, err := dtomaps.ToConnectionDto(&connection)
-	if err != nil {
-		return nil, err
-	}
-	return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
-		Connection: dto,
-	}), nil
+	return dtomaps.ToConnectionDto(&connection)
 }
 
 func (s *Service) CreateConnection(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CreateConnectionRequest],
 ) (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error) {
+	// a new connection has no stored secrets that a masked placeholder could stand in for
+	if redact.ContainsRedactedValue(req.Msg.GetConnectionConfig()) {
+		return nil, nucleuserrors.NewBadRequest("connection config contains masked secrets, the secrets of a new connection must be provided")
+	}
 	cc := &pg_models.ConnectionConfig{}
 	if err := cc.FromDto(req.Msg.ConnectionConfig); err != nil {
 		return nil, err
@@ -334,7 +424,7 @@ func (s *Service) CreateConnection(
 		return nil, err
 	}
 
-	connection, err := s.db.Q.CreateConnection(ctx, s.db.Db, db_queries.CreateConnectionParams{
+	connection, err := s.db.CreateConnection(ctx, db_queries.CreateConnectionParams{
 		AccountID:        *accountUuid,
 		Name:             req.Msg.Name,
 		ConnectionConfig: cc,
@@ -349,7 +439,7 @@ func (s *Service) CreateConnection(
 		return nil, err
 	}
 	return connect.NewResponse(&mgmtv1alpha1.CreateConnectionResponse{
-		Connection: dto,
+		Connection: redact.Message(dto),
 	}), nil
 }
 
@@ -361,7 +451,7 @@ func (s *Service) UpdateConnection(
 	if err != nil {
 		return nil, err
 	}
-	connection, err := s.db.Q.GetConnectionById(ctx, s.db.Db, connectionUuid)
+	connection, err := s.db.GetConnectionById(ctx, connectionUuid)
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
@@ -378,12 +468,22 @@ func (s *Service) UpdateConnection(
 		return nil, err
 	}
 
+	storedConnection, err := dtomaps.ToConnectionDto(&connection)
+	if err != nil {
+		return nil, err
+	}
+	// secrets are masked in responses, keep the stored secret when the client sends the masked placeholder back
+	connectionConfig, err := redact.Restore(req.Msg.GetConnectionConfig(), storedConnection.GetConnectionConfig())
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
 	cc := &pg_models.ConnectionConfig{}
-	if err := cc.FromDto(req.Msg.ConnectionConfig); err != nil {
+	if err := cc.FromDto(connectionConfig); err != nil {
 		return nil, err
 	}
 
-	connection, err = s.db.Q.UpdateConnection(ctx, s.db.Db, db_queries.UpdateConnectionParams{
+	connection, err = s.db.UpdateConnection(ctx, db_queries.UpdateConnectionParams{
 		ID:               connection.ID,
 		ConnectionConfig: cc,
 		UpdatedByID:      *userUuid,
@@ -397,7 +497,7 @@ func (s *Service) UpdateConnection(
 		return nil, err
 	}
 	return connect.NewResponse(&mgmtv1alpha1.UpdateConnectionResponse{
-		Connection: dto,
+		Connection: redact.Message(dto),
 	}), nil
 }
 
@@ -410,7 +510,7 @@ func (s *Service) DeleteConnection(
 		return nil, err
 	}
 
-	connection, err := s.db.Q.GetConnectionById(ctx, s.db.Db, idUuid)
+	connection, err := s.db.GetConnectionById(ctx, idUuid)
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
@@ -435,12 +535,16 @@ func (s *Service) CheckSqlQuery(
 ) (*connect.Response[mgmtv1alpha1.CheckSqlQueryResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	logger = logger.With("connectionId", req.Msg.Id)
-	connection, err := s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.Id}))
+	connection, err := s.getConnection(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, nil, logger)
+	connectionConfig, err := s.secretResolver.ResolveConnectionConfig(ctx, connection.GetConnectionConfig())
+	if err != nil {
+		return nil, err
+	}
+	conn, err := s.sqlConnector.NewDbFromConnectionConfig(connectionConfig, nil, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -21,8 +21,10 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
+	"github.com/nucleuscloud/neosync/backend/pkg/secretresolver"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 	"github.com/stretchr/testify/assert"
@@ -141,6 +143,77 @@ func Test_CheckConnectionConfigs_Fail(t *testing.T) {
 	}
 }
 
+func Test_CheckConnectionConfig_ResolvesSecretReferences(t *testing.T) {
+	t.Setenv("NEOSYNC_TEST_MYSQL_PASSWORD", "resolved-secret")
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.MatchedBy(func(cc *mgmtv1alpha1.ConnectionConfig) bool {
+		return cc.GetMysqlConfig().GetConnection().GetPass() == "resolved-secret"
+	}), mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.MysqlQuerierMock.On("GetMysqlRolePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetMysqlRolePermissionsRow{}, nil)
+
+	cc := getMysqlConfigMock()
+	cc.GetMysqlConfig().GetConnection().Pass = "env://NEOSYNC_TEST_MYSQL_PASSWORD"
+	resp, err := m.Service.CheckConnectionConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.CheckConnectionConfigRequest{
+		ConnectionConfig: cc,
+	}))
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Msg.GetIsConnected())
+	assert.Equal(t, "env://NEOSYNC_TEST_MYSQL_PASSWORD", cc.GetMysqlConfig().GetConnection().GetPass())
+}
+
+func Test_CheckConnectionConfig_RestoresStoredSecrets(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionId := mockConnectionId
+	connectionUuid, _ := nucleusdb.ToUuid(connectionId)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, MysqlMock), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.MatchedBy(func(cc *mgmtv1alpha1.ConnectionConfig) bool {
+		return cc.GetMysqlConfig().GetConnection().GetPass() == "topsecret" && cc.GetMysqlConfig().GetConnection().GetHost() == "edited-host"
+	}), mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.MysqlQuerierMock.On("GetMysqlRolePermissions", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetMysqlRolePermissionsRow{}, nil)
+
+	cc := getMysqlConfigMock()
+	cc.GetMysqlConfig().GetConnection().Host = "edited-host"
+	cc.GetMysqlConfig().GetConnection().Pass = redact.RedactedValue
+	resp, err := m.Service.CheckConnectionConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.CheckConnectionConfigRequest{
+		ConnectionConfig: cc,
+		Id:               &connectionId,
+	}))
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Msg.GetIsConnected())
+}
+
+func Test_CheckConnectionConfig_RedactedSecretWithoutStoredValue(t *testing.T) {
+	m := createServiceMock(t)
+
+	connectionId := mockConnectionId
+	connectionUuid, _ := nucleusdb.ToUuid(connectionId)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock), nil)
+
+	cc := getMysqlConfigMock()
+	cc.GetMysqlConfig().GetConnection().Pass = redact.RedactedValue
+	_, err := m.Service.CheckConnectionConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.CheckConnectionConfigRequest{
+		ConnectionConfig: cc,
+		Id:               &connectionId,
+	}))
+
+	assert.Error(t, err)
+	m.SqlConnectorMock.AssertNotCalled(t, "NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_CheckConnectionConfig_NotImplemented(t *testing.T) {
 	m := createServiceMock(t)
 
@@ -263,6 +336,29 @@ func Test_GetConnection(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, mockAccountId, resp.Msg.Connection.AccountId)
 	assert.Equal(t, mockConnectionId, resp.Msg.Connection.Id)
+	assert.Equal(t, redact.RedactedValue, resp.Msg.Connection.GetConnectionConfig().GetPgConfig().GetConnection().GetPass())
+	assert.Equal(t, "user", resp.Msg.Connection.GetConnectionConfig().GetPgConfig().GetConnection().GetUser())
+}
+
+func Test_GetConnectionUnmasked(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", context.Background(), mock.Anything, connectionUuid).Return(connection, nil)
+
+	resp, err := m.Service.GetConnectionUnmasked(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionUnmaskedRequest{
+			Id: mockConnectionId,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, mockConnectionId, resp.Msg.Connection.Id)
+	assert.Equal(t, "topsecret", resp.Msg.Connection.GetConnectionConfig().GetPgConfig().GetConnection().GetPass())
 }
 
 func Test_GetConnection_Supports_WorkerApiKeys(t *testing.T) {
@@ -375,6 +471,21 @@ func Test_CreateConnection_Error(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func Test_CreateConnection_RejectsRedactedSecrets(t *testing.T) {
+	m := createServiceMock(t)
+
+	cc := getPostgresConfigMock()
+	cc.GetPgConfig().GetConnection().Pass = redact.RedactedValue
+	_, err := m.Service.CreateConnection(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateConnectionRequest{
+		AccountId:        mockAccountId,
+		Name:             mockConnectionName,
+		ConnectionConfig: cc,
+	}))
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "CreateConnection", mock.Anything, mock.Anything, mock.Anything)
+}
+
 // UpdateConnection
 func Test_UpdateConnection(t *testing.T) {
 	m := createServiceMock(t)
@@ -408,6 +519,39 @@ func Test_UpdateConnection(t *testing.T) {
 	assert.Equal(t, mockConnectionId, resp.Msg.Connection.Id)
 }
 
+func Test_UpdateConnection_KeepsRedactedSecrets(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockConnectionConfig := &pg_models.ConnectionConfig{}
+	_ = mockConnectionConfig.FromDto(getPostgresConfigMock())
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", context.Background(), mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("UpdateConnection", context.Background(), mock.Anything, db_queries.UpdateConnectionParams{
+		ID:               connectionUuid,
+		Name:             mockConnectionName,
+		ConnectionConfig: mockConnectionConfig,
+		UpdatedByID:      userUuid,
+	}).Return(connection, nil)
+
+	redactedConfig := getPostgresConfigMock()
+	redactedConfig.GetPgConfig().GetConnection().Pass = redact.RedactedValue
+	resp, err := m.Service.UpdateConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateConnectionRequest]{
+		Msg: &mgmtv1alpha1.UpdateConnectionRequest{
+			Id:               mockConnectionId,
+			Name:             mockConnectionName,
+			ConnectionConfig: redactedConfig,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, redact.RedactedValue, resp.Msg.Connection.GetConnectionConfig().GetPgConfig().GetConnection().GetPass())
+}
+
 func Test_UpdateConnection_UpdateError(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -698,7 +842,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 	}
 
 	service := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier),
-		mockUserAccountService, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockMssqlQuerier, mockMongoConnector, mockAwsManager)
+		mockUserAccountService, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockMssqlQuerier, mockMongoConnector, mockAwsManager, secretresolver.New(secretresolver.NewEnvResolver()))
 
 	return &serviceMocks{
 		Service:                service,
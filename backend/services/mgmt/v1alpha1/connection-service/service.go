@@ -9,6 +9,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
+	"github.com/nucleuscloud/neosync/backend/pkg/secretresolver"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
@@ -27,6 +28,9 @@ type Service struct {
 	mysqlquerier mysql_queries.Querier
 
 	awsManager awsmanager.NeosyncAwsManagerClient
+
+	// optional, resolves the secret references of the connections that are connected to by this service
+	secretResolver *secretresolver.SecretResolver
 }
 
 type Config struct {
@@ -42,6 +46,7 @@ func New(
 	mssqlquerier mssql_queries.Querier,
 	mongoconnector mongoconnect.Interface,
 	awsManager awsmanager.NeosyncAwsManagerClient,
+	secretResolver *secretresolver.SecretResolver,
 ) *Service {
 	pgpoolmap := &sync.Map{}
 	mysqlpoolmap := &sync.Map{}
@@ -57,5 +62,6 @@ func New(
 		sqlmanager:         sqlmanager,
 		mongoconnector:     mongoconnector,
 		awsManager:         awsManager,
+		secretResolver:     secretResolver,
 	}
 }
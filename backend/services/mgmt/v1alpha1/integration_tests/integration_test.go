@@ -163,6 +163,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		mssql_queries.New(),
 		mongoconnect.NewConnector(),
 		awsmanager.New(),
+		nil,
 	)
 	unauthdJobsService := v1alpha1_jobservice.New(
 		&v1alpha1_jobservice.Config{},
@@ -22,6 +22,7 @@ import (
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 
+	"go.mongodb.org/mongo-driver/bson"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
@@ -397,6 +398,14 @@ func (s *Service) CreateJob(
 		}
 	}
 
+	destinationConnectionIds := []string{}
+	for _, dest := range req.Msg.GetDestinations() {
+		destinationConnectionIds = append(destinationConnectionIds, dest.GetConnectionId())
+	}
+	if err := s.verifyJobMappingPolicies(ctx, *accountUuid, getMappingsSourceConnectionId(req.Msg.GetSource()), req.Msg.GetMappings(), destinationConnectionIds); err != nil {
+		return nil, err
+	}
+
 	cronStr := req.Msg.GetCronSchedule()
 	if cronStr == "" {
 		cronStr = defaultCronStr
@@ -688,6 +697,9 @@ func (s *Service) CreateJobDestinationConnections(
 	if !isInSameAccount {
 		return nil, nucleuserrors.NewBadRequest("connections ids are not unique")
 	}
+	if err := s.verifyJobMappingPolicies(ctx, *accountUuid, getMappingsSourceConnectionId(job.Msg.GetJob().GetSource()), job.Msg.GetJob().GetMappings(), connectionIds); err != nil {
+		return nil, err
+	}
 
 	logger.Info("creating job destination connections", "connectionIds", connectionIds)
 	connDestParams := []db_queries.CreateJobConnectionDestinationsParams{}
@@ -875,7 +887,7 @@ func (s *Service) UpdateJobSourceConnection(
 		return nil, nucleuserrors.NewNotFound("unable to find job by id")
 	}
 
-	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	accountUuid, err := s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
 	if err != nil {
 		return nil, err
 	}
@@ -971,6 +983,18 @@ func (s *Service) UpdateJobSourceConnection(
 		return nil, err
 	}
 
+	destinations, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job destinations: %w", err)
+	}
+	destinationConnectionIds := []string{}
+	for _, destination := range destinations {
+		destinationConnectionIds = append(destinationConnectionIds, nucleusdb.UUIDString(destination.ConnectionID))
+	}
+	if err := s.verifyJobMappingPolicies(ctx, *accountUuid, getMappingsSourceConnectionId(req.Msg.GetSource()), req.Msg.GetMappings(), destinationConnectionIds); err != nil {
+		return nil, err
+	}
+
 	mappings := []*pg_models.JobMapping{}
 	for _, mapping := range req.Msg.GetMappings() {
 		jm := &pg_models.JobMapping{}
@@ -1082,6 +1106,8 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 			connectionId = &job.ConnectionOptions.DynamoDBOptions.ConnectionId
 		} else if job.ConnectionOptions.MssqlOptions != nil {
 			connectionId = &job.ConnectionOptions.MssqlOptions.ConnectionId
+		} else if job.ConnectionOptions.MongoDbOptions != nil {
+			connectionId = &job.ConnectionOptions.MongoDbOptions.ConnectionId
 		} else {
 			return nil, nucleuserrors.NewBadRequest("only jobs with a valid source connection id may be subset")
 		}
@@ -1099,10 +1125,14 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	connection := connectionResp.Msg.Connection
 
 	if connection.ConnectionConfig == nil ||
-		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil && connection.ConnectionConfig.GetDynamodbConfig() == nil && connection.ConnectionConfig.GetMssqlConfig() == nil) {
+		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil && connection.ConnectionConfig.GetDynamodbConfig() == nil && connection.ConnectionConfig.GetMssqlConfig() == nil && connection.ConnectionConfig.GetMongoConfig() == nil) {
 		return nil, nucleuserrors.NewBadRequest("may only update subsets for select source connections")
 	}
 
+	if err := validateMongoDbCollectionFilters(req.Msg.GetSchemas().GetMongodbSubset().GetCollections()); err != nil {
+		return nil, err
+	}
+
 	if err := s.db.SetSourceSubsets(
 		ctx,
 		jobUuid,
@@ -1125,6 +1155,20 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	}), nil
 }
 
+// Ensures that any provided mongo filters are valid extended json documents before they are persisted
+func validateMongoDbCollectionFilters(collections []*mgmtv1alpha1.MongoDBSourceCollectionOption) error {
+	for _, collection := range collections {
+		if collection.GetFilter() == "" {
+			continue
+		}
+		var filter bson.D
+		if err := bson.UnmarshalExtJSON([]byte(collection.GetFilter()), false, &filter); err != nil {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("invalid filter for collection %s.%s: %s", collection.GetDatabase(), collection.GetCollection(), err.Error()))
+		}
+	}
+	return nil
+}
+
 func (s *Service) UpdateJobDestinationConnection(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest],
@@ -1147,7 +1191,7 @@ func (s *Service) UpdateJobDestinationConnection(
 		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
 		return nil, err
 	}
-	_, err = s.verifyUserInAccount(ctx, job.Msg.Job.AccountId)
+	accountUuid, err := s.verifyUserInAccount(ctx, job.Msg.Job.AccountId)
 	if err != nil {
 		return nil, err
 	}
@@ -1164,6 +1208,9 @@ func (s *Service) UpdateJobDestinationConnection(
 	if err := s.verifyConnectionInAccount(ctx, req.Msg.ConnectionId, job.Msg.Job.AccountId); err != nil {
 		return nil, err
 	}
+	if err := s.verifyJobMappingPolicies(ctx, *accountUuid, getMappingsSourceConnectionId(job.Msg.Job.GetSource()), job.Msg.Job.GetMappings(), []string{req.Msg.ConnectionId}); err != nil {
+		return nil, err
+	}
 	options := &pg_models.JobDestinationOptions{}
 	err = options.FromDto(req.Msg.Options)
 	if err != nil {
@@ -1342,7 +1389,7 @@ func getWorkflowExecutionsByJobIds(
 }
 
 func verifyConnectionsInAccount(ctx context.Context, db *nucleusdb.NucleusDb, connectionUuids []pgtype.UUID, accountUuid pgtype.UUID) (bool, error) {
-	conns, err := db.Q.GetConnectionsByIds(ctx, db.Db, connectionUuids)
+	conns, err := db.GetConnectionsByIds(ctx, connectionUuids)
 	if err != nil {
 		return false, err
 	}
@@ -1373,7 +1420,7 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *nucleusdb.NucleusDb
 	dests := make([]db_queries.NeosyncApiConnection, len(destinations))
 	group := new(errgroup.Group)
 	group.Go(func() error {
-		source, err := db.Q.GetConnectionById(ctx, db.Db, sourceConnId)
+		source, err := db.GetConnectionById(ctx, sourceConnId)
 		if err != nil {
 			return err
 		}
@@ -1384,7 +1431,7 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *nucleusdb.NucleusDb
 		i := i
 		d := destinations[i]
 		group.Go(func() error {
-			connection, err := db.Q.GetConnectionById(ctx, db.Db, d.ConnectionId)
+			connection, err := db.GetConnectionById(ctx, d.ConnectionId)
 			if err != nil {
 				return err
 			}
@@ -1400,8 +1447,13 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *nucleusdb.NucleusDb
 
 	for i := range dests {
 		d := dests[i]
-		// AWS S3 and GCP CloudStorage are always a valid destination regardless of source connection type
-		if d.ConnectionConfig.AwsS3Config != nil || d.ConnectionConfig.GcpCloudStorageConfig != nil {
+		// AWS S3, GCP CloudStorage and Kafka are always a valid destination regardless of source connection type
+		if d.ConnectionConfig.AwsS3Config != nil || d.ConnectionConfig.GcpCloudStorageConfig != nil || d.ConnectionConfig.KafkaConfig != nil {
+			continue
+		}
+		// ClickHouse is a valid destination for any sql source
+		if d.ConnectionConfig.ClickhouseConfig != nil && (sourceConnection.ConnectionConfig.PgConfig != nil ||
+			sourceConnection.ConnectionConfig.MysqlConfig != nil || sourceConnection.ConnectionConfig.MssqlConfig != nil) {
 			continue
 		}
 		if sourceConnection.ConnectionConfig.PgConfig != nil && d.ConnectionConfig.PgConfig == nil {
@@ -1575,12 +1627,17 @@ func (s *Service) ValidateJobMappings(
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	logger = logger.With("accountId", req.Msg.AccountId)
 
-	_, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyErrors, err := s.getJobMappingPolicyErrors(ctx, *accountUuid, req.Msg.GetConnectionId(), req.Msg.GetMappings(), req.Msg.GetDestinationConnectionIds())
 	if err != nil {
 		return nil, err
 	}
 
-	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connection, err := s.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: req.Msg.ConnectionId,
 	}))
 	if err != nil {
@@ -1593,7 +1650,9 @@ func (s *Service) ValidateJobMappings(
 	}
 
 	if connConfig.GetAwsS3Config() != nil || connConfig.GetMongoConfig() != nil || connConfig.GetDynamodbConfig() != nil {
-		return connect.NewResponse(&mgmtv1alpha1.ValidateJobMappingsResponse{}), nil
+		return connect.NewResponse(&mgmtv1alpha1.ValidateJobMappingsResponse{
+			ColumnErrors: policyErrors,
+		}), nil
 	}
 
 	connectionTimeout := 5
@@ -1790,6 +1849,15 @@ func (s *Service) ValidateJobMappings(
 		}
 	}
 
+	// verify that the mappings satisfy the account's job mapping policies
+	for _, policyErr := range policyErrors {
+		table := sqlmanager_shared.BuildTable(policyErr.GetSchema(), policyErr.GetTable())
+		if _, ok := colErrorsMap[table]; !ok {
+			colErrorsMap[table] = map[string][]string{}
+		}
+		colErrorsMap[table][policyErr.GetColumn()] = append(colErrorsMap[table][policyErr.GetColumn()], policyErr.GetErrors()...)
+	}
+
 	colErrors := []*mgmtv1alpha1.ColumnError{}
 	for tableName, colMap := range colErrorsMap {
 		for col, errors := range colMap {
@@ -1961,6 +2029,18 @@ func isVirtualForeignKeySourceUnique(
 	return false
 }
 
+// Returns the id of the connection the job's mappings are read from, or an empty string if the source does not have one
+func getMappingsSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) string {
+	if jobSource.GetOptions() == nil {
+		return ""
+	}
+	connectionId, err := getJobSourceConnectionId(jobSource)
+	if err != nil || connectionId == nil {
+		return ""
+	}
+	return *connectionId
+}
+
 func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (*string, error) {
 	var connectionIdToVerify *string
 	switch config := jobSource.Options.Config.(type) {
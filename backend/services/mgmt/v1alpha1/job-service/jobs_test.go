@@ -359,6 +359,7 @@ func Test_CreateJob(t *testing.T) {
 	}
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockDbTransaction(m.DbtxMock, mockTx)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
@@ -508,6 +509,7 @@ func Test_CreateJob_Schedule_Creation_Error(t *testing.T) {
 	}
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockDbTransaction(m.DbtxMock, mockTx)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
@@ -657,6 +659,7 @@ func Test_CreateJob_Schedule_Creation_Error_JobCleanup_Error(t *testing.T) {
 	}
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockDbTransaction(m.DbtxMock, mockTx)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
@@ -782,6 +785,7 @@ func Test_CreateJobDestinationConnections(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
 	destConn := getConnectionMock(mockAccountId, "test-1")
 	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
@@ -979,6 +983,7 @@ func Test_UpdateJobSourceConnection_Success(t *testing.T) {
 	whereClause := "where1"
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockDbTransaction(m.DbtxMock, mockTx)
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
 	m.QuerierMock.On("UpdateJobSource", mock.Anything, mockTx, db_queries.UpdateJobSourceParams{
@@ -1068,6 +1073,7 @@ func Test_UpdateJobSourceConnection_GenerateSuccess(t *testing.T) {
 	k, _ := nucleusdb.ToUuid(fkString)
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockDbTransaction(m.DbtxMock, mockTx)
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
 	m.QuerierMock.On("UpdateJobSource", mock.Anything, mockTx, db_queries.UpdateJobSourceParams{
@@ -1375,6 +1381,7 @@ func Test_UpdateJobDestinationConnection_Update(t *testing.T) {
 	}
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
 	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 
@@ -1432,6 +1439,7 @@ func Test_UpdateJobDestinationConnection_Create(t *testing.T) {
 	var nilDestConnAssociation db_queries.NeosyncApiJobDestinationConnectionAssociation
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
 	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 
@@ -1625,6 +1633,15 @@ func mockUserAccountCalls(userAccountServiceMock *mgmtv1alpha1connect.MockUserAc
 	}), nil)
 }
 
+//nolint:all
+func mockGetJobMappingPolicyConfig(querierMock *db_queries.MockQuerier, config *pg_models.JobMappingPolicyConfig) {
+	if config == nil {
+		querierMock.On("GetJobMappingPolicyConfigByAccount", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+		return
+	}
+	querierMock.On("GetJobMappingPolicyConfigByAccount", mock.Anything, mock.Anything, mock.Anything).Return(config, nil)
+}
+
 //nolint:all
 func mockJob(accountId, userId, srcConnId string, cronSchedule pgtype.Text) db_queries.NeosyncApiJob {
 	id, _ := nucleusdb.ToUuid(uuid.NewString())
@@ -1792,8 +1809,9 @@ func Test_ValidateJobMappings_NoValidationErrors(t *testing.T) {
 	connId := nucleusdb.UUIDString(conn.ID)
 
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 
-	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceClientMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id: connId,
 			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
@@ -1867,8 +1885,9 @@ func Test_ValidateJobMappings_ValidationErrors(t *testing.T) {
 	connId := nucleusdb.UUIDString(conn.ID)
 
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
 
-	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	m.ConnectionServiceClientMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id: connId,
 			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
@@ -0,0 +1,275 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	columntags "github.com/nucleuscloud/neosync/backend/internal/column-tags"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) GetJobMappingPolicyConfig(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobMappingPolicyConfigRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobMappingPolicyConfigResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.getJobMappingPolicyConfig(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobMappingPolicyConfigResponse{
+		Config: config,
+	}), nil
+}
+
+func (s *Service) SetJobMappingPolicyConfig(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobMappingPolicyConfigRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobMappingPolicyConfigResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dtoCfg := req.Msg.GetConfig()
+	if dtoCfg == nil {
+		dtoCfg = &mgmtv1alpha1.JobMappingPolicyConfig{}
+	}
+	if err := validateJobMappingPolicies(dtoCfg.GetPolicies()); err != nil {
+		return nil, err
+	}
+
+	connectionUuids := []pgtype.UUID{}
+	for _, id := range dtoCfg.GetNonProductionConnectionIds() {
+		connectionUuid, err := nucleusdb.ToUuid(id)
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid non-production connection id: %s", id))
+		}
+		connectionUuids = append(connectionUuids, connectionUuid)
+	}
+	if len(connectionUuids) > 0 {
+		isInAccount, err := verifyConnectionsInAccount(ctx, s.db, connectionUuids, *accountUuid)
+		if err != nil {
+			return nil, err
+		}
+		if !isInAccount {
+			return nil, nucleuserrors.NewForbidden("provided non-production connection id is not in account")
+		}
+	}
+
+	cfg := &pg_models.JobMappingPolicyConfig{}
+	cfg.FromDto(dtoCfg)
+	policies, err := s.db.Q.UpsertJobMappingPolicyConfig(ctx, s.db.Db, db_queries.UpsertJobMappingPolicyConfigParams{
+		AccountId: *accountUuid,
+		Config:    cfg,
+		UserId:    *userUuid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to set job mapping policies: %w", err)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.SetJobMappingPolicyConfigResponse{
+		Config: policies.Config.ToDto(),
+	}), nil
+}
+
+func validateJobMappingPolicies(policies []*mgmtv1alpha1.JobMappingPolicy) error {
+	names := map[string]struct{}{}
+	for _, policy := range policies {
+		if policy.GetName() == "" {
+			return nucleuserrors.NewBadRequest("job mapping policies must have a name")
+		}
+		if _, ok := names[policy.GetName()]; ok {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("job mapping policy names must be unique: %s", policy.GetName()))
+		}
+		names[policy.GetName()] = struct{}{}
+		for _, pattern := range policy.GetColumnPatterns() {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nucleuserrors.NewBadRequest(fmt.Sprintf("invalid column pattern %q in job mapping policy %s: %s", pattern, policy.GetName(), err.Error()))
+			}
+		}
+		if _, err := columntags.Normalize(policy.GetColumnTags()); err != nil {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("job mapping policy %s: %s", policy.GetName(), err.Error()))
+		}
+	}
+	return nil
+}
+
+// Returns the account's job mapping policies, or an empty config if none have been set
+func (s *Service) getJobMappingPolicyConfig(ctx context.Context, accountUuid pgtype.UUID) (*mgmtv1alpha1.JobMappingPolicyConfig, error) {
+	cfg, err := s.db.Q.GetJobMappingPolicyConfigByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve job mapping policies: %w", err)
+	} else if err != nil || cfg == nil {
+		return &mgmtv1alpha1.JobMappingPolicyConfig{}, nil
+	}
+	return cfg.ToDto(), nil
+}
+
+// Returns an invalid argument error if the mappings violate any of the account's job mapping policies.
+// The column errors are attached to the error as a ValidateJobMappingsResponse detail.
+func (s *Service) verifyJobMappingPolicies(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	sourceConnectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	destinationConnectionIds []string,
+) error {
+	violations, err := s.getJobMappingPolicyErrors(ctx, accountUuid, sourceConnectionId, mappings, destinationConnectionIds)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return newJobMappingPolicyError(violations)
+}
+
+// Returns a column error for every mapping that violates the account's job mapping policies.
+// The source connection id is used to look up column tags and may be empty if the mappings are not read from a connection.
+func (s *Service) getJobMappingPolicyErrors(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	sourceConnectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	destinationConnectionIds []string,
+) ([]*mgmtv1alpha1.ColumnError, error) {
+	config, err := s.getJobMappingPolicyConfig(ctx, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	columnTags, err := s.getPolicyColumnTags(ctx, config, sourceConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	return getJobMappingPolicyViolations(config, mappings, destinationConnectionIds, columnTags), nil
+}
+
+// Returns the tags of the source connection's columns keyed by schema.table.column.
+// The tags are only retrieved if a policy covers columns by tag.
+func (s *Service) getPolicyColumnTags(
+	ctx context.Context,
+	config *mgmtv1alpha1.JobMappingPolicyConfig,
+	sourceConnectionId string,
+) (map[string][]string, error) {
+	usesTags := slices.ContainsFunc(config.GetPolicies(), func(policy *mgmtv1alpha1.JobMappingPolicy) bool {
+		return len(policy.GetColumnTags()) > 0
+	})
+	if !usesTags || sourceConnectionId == "" {
+		return map[string][]string{}, nil
+	}
+	connectionUuid, err := nucleusdb.ToUuid(sourceConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Q.GetConnectionColumnTags(ctx, s.db.Db, connectionUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve connection column tags: %w", err)
+	}
+	columnTags := map[string][]string{}
+	for _, row := range rows {
+		columnTags[columntags.ColumnKey(row.SchemaName, row.TableName, row.ColumnName)] = row.Tags
+	}
+	return columnTags, nil
+}
+
+// Returns a column error for every mapping that passes through a column covered by a policy that applies to the destinations
+func getJobMappingPolicyViolations(
+	config *mgmtv1alpha1.JobMappingPolicyConfig,
+	mappings []*mgmtv1alpha1.JobMapping,
+	destinationConnectionIds []string,
+	columnTags map[string][]string,
+) []*mgmtv1alpha1.ColumnError {
+	hasNonProductionDestination := slices.ContainsFunc(destinationConnectionIds, func(id string) bool {
+		return slices.Contains(config.GetNonProductionConnectionIds(), id)
+	})
+
+	violations := []*mgmtv1alpha1.ColumnError{}
+	for _, mapping := range mappings {
+		if !isPassthroughTransformer(mapping.GetTransformer()) {
+			continue
+		}
+		errs := []string{}
+		for _, policy := range config.GetPolicies() {
+			if policy.GetDestinationScope() == mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION && !hasNonProductionDestination {
+				continue
+			}
+			tags := columnTags[columntags.ColumnKey(mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn())]
+			if !isColumnCoveredByPolicy(policy, mapping.GetColumn(), tags) {
+				continue
+			}
+			if policy.GetDestinationScope() == mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION {
+				errs = append(errs, fmt.Sprintf("Violates job mapping policy %s. Column may not be passed through to a non-production destination", policy.GetName()))
+			} else {
+				errs = append(errs, fmt.Sprintf("Violates job mapping policy %s. Column may not be passed through", policy.GetName()))
+			}
+		}
+		if len(errs) > 0 {
+			violations = append(violations, &mgmtv1alpha1.ColumnError{
+				Schema: mapping.GetSchema(),
+				Table:  mapping.GetTable(),
+				Column: mapping.GetColumn(),
+				Errors: errs,
+			})
+		}
+	}
+	return violations
+}
+
+func isColumnCoveredByPolicy(policy *mgmtv1alpha1.JobMappingPolicy, column string, tags []string) bool {
+	if len(policy.GetColumnPatterns()) == 0 && len(policy.GetColumnTags()) == 0 {
+		return true
+	}
+	column = strings.ToLower(column)
+	for _, pattern := range policy.GetColumnPatterns() {
+		if ok, err := path.Match(strings.ToLower(pattern), column); err == nil && ok {
+			return true
+		}
+	}
+	for _, tag := range policy.GetColumnTags() {
+		if slices.Contains(tags, strings.ToLower(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPassthroughTransformer(transformer *mgmtv1alpha1.JobMappingTransformer) bool {
+	switch transformer.GetSource() {
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH:
+		return true
+	default:
+		return false
+	}
+}
+
+func newJobMappingPolicyError(violations []*mgmtv1alpha1.ColumnError) error {
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, fmt.Sprintf("%s.%s.%s: %s", violation.GetSchema(), violation.GetTable(), violation.GetColumn(), strings.Join(violation.GetErrors(), ", ")))
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, errors.New("job mappings violate the account's job mapping policies: "+strings.Join(messages, "; ")))
+	if detail, err := connect.NewErrorDetail(&mgmtv1alpha1.ValidateJobMappingsResponse{ColumnErrors: violations}); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
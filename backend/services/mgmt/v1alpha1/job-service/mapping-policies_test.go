@@ -0,0 +1,179 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockNonProdConnectionId = "5d0b4a9a-5b6c-4a4e-9e0a-1f3a3c8b2d11"
+
+func Test_GetJobMappingPolicyConfig_Unset(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
+
+	resp, err := m.Service.GetJobMappingPolicyConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobMappingPolicyConfigRequest{
+		AccountId: mockAccountId,
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, resp.Msg.GetConfig())
+	require.Empty(t, resp.Msg.GetConfig().GetPolicies())
+}
+
+func Test_SetJobMappingPolicyConfig(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	config := &mgmtv1alpha1.JobMappingPolicyConfig{
+		Policies: []*mgmtv1alpha1.JobMappingPolicy{
+			{Name: "pii", ColumnPatterns: []string{"*email*", "*ssn*"}, DestinationScope: mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_ALL},
+		},
+	}
+	expected := &pg_models.JobMappingPolicyConfig{}
+	expected.FromDto(config)
+	m.QuerierMock.On("UpsertJobMappingPolicyConfig", mock.Anything, mock.Anything, db_queries.UpsertJobMappingPolicyConfigParams{
+		AccountId: accountUuid,
+		Config:    expected,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountJobMappingPolicy{AccountID: accountUuid, Config: expected}, nil)
+
+	resp, err := m.Service.SetJobMappingPolicyConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobMappingPolicyConfigRequest{
+		AccountId: mockAccountId,
+		Config:    config,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetConfig().GetPolicies(), 1)
+	require.Equal(t, []string{"*email*", "*ssn*"}, resp.Msg.GetConfig().GetPolicies()[0].GetColumnPatterns())
+}
+
+func Test_SetJobMappingPolicyConfig_InvalidPolicies(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+
+	_, err := m.Service.SetJobMappingPolicyConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobMappingPolicyConfigRequest{
+		AccountId: mockAccountId,
+		Config: &mgmtv1alpha1.JobMappingPolicyConfig{
+			Policies: []*mgmtv1alpha1.JobMappingPolicy{{Name: "pii", ColumnPatterns: []string{"[email"}}},
+		},
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_validateJobMappingPolicies(t *testing.T) {
+	require.NoError(t, validateJobMappingPolicies(nil))
+	require.NoError(t, validateJobMappingPolicies([]*mgmtv1alpha1.JobMappingPolicy{{Name: "a", ColumnPatterns: []string{"*email*"}}, {Name: "b"}}))
+	require.Error(t, validateJobMappingPolicies([]*mgmtv1alpha1.JobMappingPolicy{{Name: "a"}, {Name: "a"}}))
+	require.Error(t, validateJobMappingPolicies([]*mgmtv1alpha1.JobMappingPolicy{{Name: ""}}))
+	require.Error(t, validateJobMappingPolicies([]*mgmtv1alpha1.JobMappingPolicy{{Name: "a", ColumnPatterns: []string{"[ssn"}}}))
+}
+
+func Test_getJobMappingPolicyViolations(t *testing.T) {
+	config := &mgmtv1alpha1.JobMappingPolicyConfig{
+		Policies: []*mgmtv1alpha1.JobMappingPolicy{
+			{Name: "pii", ColumnPatterns: []string{"*email*", "*ssn*"}, DestinationScope: mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_ALL},
+			{Name: "non-prod", DestinationScope: mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION},
+		},
+		NonProductionConnectionIds: []string{mockNonProdConnectionId},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "Work_Email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "ssn"},
+		{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL}},
+	}
+
+	violations := getJobMappingPolicyViolations(config, mappings, []string{"prod-connection"}, nil)
+	require.Len(t, violations, 2)
+	require.Equal(t, "Work_Email", violations[0].GetColumn())
+	require.Equal(t, []string{"Violates job mapping policy pii. Column may not be passed through"}, violations[0].GetErrors())
+	require.Equal(t, "ssn", violations[1].GetColumn())
+
+	violations = getJobMappingPolicyViolations(config, mappings, []string{"prod-connection", mockNonProdConnectionId}, nil)
+	require.Len(t, violations, 3)
+	require.Equal(t, "id", violations[0].GetColumn())
+	require.Equal(t, []string{"Violates job mapping policy non-prod. Column may not be passed through to a non-production destination"}, violations[0].GetErrors())
+	require.Len(t, violations[1].GetErrors(), 2)
+
+	require.Empty(t, getJobMappingPolicyViolations(&mgmtv1alpha1.JobMappingPolicyConfig{}, mappings, nil, nil))
+}
+
+func Test_getJobMappingPolicyViolations_ColumnTags(t *testing.T) {
+	config := &mgmtv1alpha1.JobMappingPolicyConfig{
+		Policies: []*mgmtv1alpha1.JobMappingPolicy{
+			{Name: "tagged", ColumnTags: []string{"PII", "phi"}},
+		},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "dob"},
+		{Schema: "public", Table: "orders", Column: "notes"},
+	}
+	columnTags := map[string][]string{
+		"public.users.dob":    {"phi"},
+		"public.orders.notes": {"free_text"},
+	}
+
+	violations := getJobMappingPolicyViolations(config, mappings, nil, columnTags)
+	require.Len(t, violations, 1)
+	require.Equal(t, "dob", violations[0].GetColumn())
+}
+
+func Test_getJobMappingPolicyErrors_ColumnTags(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	sourceUuid, _ := nucleusdb.ToUuid(mockNonProdConnectionId)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, &pg_models.JobMappingPolicyConfig{
+		Policies: []*pg_models.JobMappingPolicy{{Name: "pii", ColumnTags: []string{"pii"}}},
+	})
+	m.QuerierMock.On("GetConnectionColumnTags", mock.Anything, mock.Anything, sourceUuid).Return([]db_queries.NeosyncApiConnectionColumnTag{
+		{SchemaName: "public", TableName: "users", ColumnName: "contact", Tags: []string{"pii"}},
+	}, nil)
+
+	violations, err := m.Service.getJobMappingPolicyErrors(context.Background(), accountUuid, mockNonProdConnectionId, []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "contact"},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, "contact", violations[0].GetColumn())
+}
+
+func Test_verifyJobMappingPolicies(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, &pg_models.JobMappingPolicyConfig{
+		Policies: []*pg_models.JobMappingPolicy{{Name: "pii", ColumnPatterns: []string{"*email*"}}},
+	})
+
+	err := m.Service.verifyJobMappingPolicies(context.Background(), accountUuid, "", []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+	}, nil)
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	resp, ok := detail.(*mgmtv1alpha1.ValidateJobMappingsResponse)
+	require.True(t, ok)
+	require.Len(t, resp.GetColumnErrors(), 1)
+	require.Equal(t, "email", resp.GetColumnErrors()[0].GetColumn())
+
+	err = m.Service.verifyJobMappingPolicies(context.Background(), accountUuid, "", []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL}},
+	}, nil)
+	require.NoError(t, err)
+}
@@ -3,30 +3,45 @@ package v1alpha1_jobservice
 import (
 	"bufio"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/loki"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	benthos_metrics "github.com/nucleuscloud/neosync/worker/pkg/benthos/metrics"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,17 +52,24 @@ import (
 	"k8s.io/client-go/rest"
 )
 
+const (
+	defaultJobRunsPageSize = 50
+	jobRunsPageTokenSep    = "|"
+	// Temporal registers workflows by their function name and every job run is executed by the datasync workflow
+	jobRunWorkflowName = "Workflow"
+)
+
 func (s *Service) GetJobRuns(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetJobRunsRequest],
 ) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
-
 	var accountId string
-	jobIds := []string{}
+	var jobUuid pgtype.UUID
 	switch id := req.Msg.Id.(type) {
 	case *mgmtv1alpha1.GetJobRunsRequest_JobId:
-		jobUuid, err := nucleusdb.ToUuid(id.JobId)
+		var err error
+		jobUuid, err = nucleusdb.ToUuid(id.JobId)
 		if err != nil {
 			return nil, err
 		}
@@ -56,52 +78,188 @@ func (s *Service) GetJobRuns(
 			return nil, err
 		}
 		accountId = nucleusdb.UUIDString(job.AccountID)
-		jobIds = append(jobIds, id.JobId)
 	case *mgmtv1alpha1.GetJobRunsRequest_AccountId:
 		accountId = id.AccountId
-		accountPgUuid, err := nucleusdb.ToUuid(accountId)
-		if err != nil {
-			return nil, err
-		}
-		jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountPgUuid)
-		if err != nil {
-			return nil, err
-		}
-		for i := range jobs {
-			job := jobs[i]
-			jobIds = append(jobIds, nucleusdb.UUIDString(job.ID))
-		}
 	default:
 		return nil, fmt.Errorf("must provide jobId or accountId")
 	}
 
-	_, err := s.verifyUserInAccount(ctx, accountId)
+	accountUuid, err := s.verifyUserInAccount(ctx, accountId)
 	if err != nil {
 		return nil, err
 	}
 
-	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	pageSize := req.Msg.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultJobRunsPageSize
+	}
+	params := db_queries.GetJobRunsParams{
+		AccountId: *accountUuid,
+		JobId:     jobUuid,
+		Statuses:  make([]int16, 0, len(req.Msg.GetStatuses())),
+		// fetch one extra to know if there is another page
+		PageLimit: int32(pageSize) + 1, //nolint:gosec // bounded by request validation
+	}
+	for _, status := range req.Msg.GetStatuses() {
+		params.Statuses = append(params.Statuses, int16(status)) //nolint:gosec // enum values are small
+	}
+	if req.Msg.StartedAfter != nil {
+		params.StartedAfter = pgtype.Timestamp{Time: req.Msg.GetStartedAfter().AsTime().UTC(), Valid: true}
+	}
+	if req.Msg.StartedBefore != nil {
+		params.StartedBefore = pgtype.Timestamp{Time: req.Msg.GetStartedBefore().AsTime().UTC(), Valid: true}
+	}
+	if req.Msg.GetPageToken() != "" {
+		startedAt, id, err := decodeJobRunsPageToken(req.Msg.GetPageToken())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid page token: %s", err.Error()))
+		}
+		params.CursorStartedAt = startedAt
+		params.CursorId = pgtype.Text{String: id, Valid: true}
+	}
+
+	s.backfillJobRuns(ctx, logger, accountId, *accountUuid)
+
+	runs, err := s.db.Q.GetJobRuns(ctx, s.db.Db, params)
 	if err != nil {
 		return nil, err
 	}
-	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+
+	var nextPageToken *string
+	if len(runs) > int(pageSize) {
+		runs = runs[:pageSize]
+		token := encodeJobRunsPageToken(&runs[len(runs)-1])
+		nextPageToken = &token
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobRun, 0, len(runs))
+	for idx := range runs {
+		dtos = append(dtos, dtomaps.ToJobRunDtoFromDb(&runs[idx], jobRunWorkflowName))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{
+		JobRuns:       dtos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// Records the runs of the account that are only known to temporal, these are the runs that were started before the run history was kept.
+// This is done once per account, failures are logged and retried by the next request as the recorded history is still usable.
+func (s *Service) backfillJobRuns(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	accountUuid pgtype.UUID,
+) {
+	if s.backfilledAccounts.IsBackfilled(accountId) {
+		return
+	}
+	err := s.backfillJobRunsFromTemporal(ctx, logger, accountId, accountUuid)
 	if err != nil {
-		return nil, err
+		logger.Warn(fmt.Sprintf("unable to backfill job runs from temporal: %s", err.Error()))
+		return
+	}
+	s.backfilledAccounts.SetBackfilled(accountId)
+}
+
+func (s *Service) backfillJobRunsFromTemporal(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	accountUuid pgtype.UUID,
+) error {
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	jobIds := make([]string, 0, len(jobs))
+	for idx := range jobs {
+		jobIds = append(jobIds, nucleusdb.UUIDString(jobs[idx].ID))
 	}
 
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return err
+	}
 	workflows, err := getWorkflowExecutionsByJobIds(ctx, tclient, tconfig.Namespace, jobIds)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	runs := make([]*mgmtv1alpha1.JobRun, len(workflows))
-	for idx, workflow := range workflows {
-		runs[idx] = dtomaps.ToJobRunDtoFromWorkflowExecutionInfo(workflow, logger)
+	for _, workflow := range workflows {
+		run := dtomaps.ToJobRunDtoFromWorkflowExecutionInfo(workflow, logger)
+		jobUuid, err := nucleusdb.ToUuid(run.GetJobId())
+		if err != nil {
+			logger.Warn(fmt.Sprintf("unable to backfill job run %s without a job id", run.GetId()))
+			continue
+		}
+		params := db_queries.BackfillJobRunParams{
+			ID:        run.GetId(),
+			AccountId: accountUuid,
+			JobId:     jobUuid,
+			Status:    int16(run.GetStatus()), //nolint:gosec // enum values are small
+			StartedAt: pgtype.Timestamp{Time: run.GetStartedAt().AsTime().UTC(), Valid: true},
+		}
+		if run.CompletedAt != nil {
+			params.CompletedAt = pgtype.Timestamp{Time: run.GetCompletedAt().AsTime().UTC(), Valid: true}
+		}
+		err = s.db.Q.BackfillJobRun(ctx, s.db.Db, params)
+		if err != nil {
+			return fmt.Errorf("unable to backfill job run %s: %w", run.GetId(), err)
+		}
 	}
+	return nil
+}
 
-	return connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{
-		JobRuns: runs,
-	}), nil
+// Keeps the accounts whose job runs have been backfilled by this instance of the API
+type backfilledJobRunAccounts struct {
+	mu       sync.Mutex
+	accounts map[string]struct{}
+}
+
+func newBackfilledJobRunAccounts() *backfilledJobRunAccounts {
+	return &backfilledJobRunAccounts{accounts: map[string]struct{}{}}
+}
+
+func (b *backfilledJobRunAccounts) IsBackfilled(accountId string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.accounts[accountId]
+	return ok
+}
+
+func (b *backfilledJobRunAccounts) SetBackfilled(accountId string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.accounts[accountId] = struct{}{}
+}
+
+// The page token is an opaque cursor that points at the last run of the previous page
+func encodeJobRunsPageToken(run *db_queries.NeosyncApiJobRun) string {
+	raw := run.StartedAt.Time.Format(time.RFC3339Nano) + jobRunsPageTokenSep + run.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeJobRunsPageToken(token string) (pgtype.Timestamp, string, error) {
+	bits, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pgtype.Timestamp{}, "", err
+	}
+	startedAtStr, id, ok := strings.Cut(string(bits), jobRunsPageTokenSep)
+	if !ok || id == "" {
+		return pgtype.Timestamp{}, "", fmt.Errorf("malformed page token")
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, startedAtStr)
+	if err != nil {
+		return pgtype.Timestamp{}, "", err
+	}
+	return pgtype.Timestamp{Time: startedAt, Valid: true}, id, nil
 }
 
 func (s *Service) GetJobRun(
@@ -112,7 +270,20 @@ func (s *Service) GetJobRun(
 	logger = logger.With("jobRunId", req.Msg.JobRunId)
 	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.JobRunId, req.Msg.AccountId)
 	if err != nil {
-		return nil, err
+		if !nucleuserrors.IsNotFound(err) {
+			return nil, err
+		}
+		// the run may no longer be retained by temporal, fall back to the recorded run history
+		storedRun, storedErr := s.getStoredJobRun(ctx, req.Msg.AccountId, req.Msg.JobRunId)
+		if storedErr != nil {
+			return nil, storedErr
+		}
+		if storedRun == nil || !auth_apikey.IsJobAllowed(ctx, nucleusdb.UUIDString(storedRun.JobID)) {
+			return nil, err
+		}
+		return connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
+			JobRun: dtomaps.ToJobRunDtoFromDb(storedRun, jobRunWorkflowName),
+		}), nil
 	}
 	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
 	if err != nil {
@@ -128,11 +299,43 @@ func (s *Service) GetJobRun(
 	}
 
 	dto := dtomaps.ToJobRunDto(logger, res)
+	storedRun, err := s.getStoredJobRun(ctx, verifResp.NeosyncAccountId, req.Msg.JobRunId)
+	if err != nil {
+		return nil, err
+	}
+	if storedRun != nil {
+		stored := dtomaps.ToJobRunDtoFromDb(storedRun, jobRunWorkflowName)
+		dto.Tables = stored.GetTables()
+		dto.ErrorMessage = stored.ErrorMessage
+		dto.TriggeredBy = stored.TriggeredBy
+		dto.Overrides = stored.Overrides
+		if dto.GetJobId() == "" {
+			dto.JobId = stored.GetJobId()
+		}
+	}
 	return connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
 		JobRun: dto,
 	}), nil
 }
 
+// Returns the run from the run history stored in the Neosync database, or nil if it has not been recorded
+func (s *Service) getStoredJobRun(ctx context.Context, accountId, runId string) (*db_queries.NeosyncApiJobRun, error) {
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return nil, err
+	}
+	run, err := s.db.Q.GetJobRunById(ctx, s.db.Db, db_queries.GetJobRunByIdParams{
+		ID:        runId,
+		AccountId: accountUuid,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve stored job run: %w", err)
+	} else if err != nil {
+		return nil, nil
+	}
+	return &run, nil
+}
+
 func (s *Service) GetJobRunEvents(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest],
@@ -149,9 +352,85 @@ func (s *Service) GetJobRunEvents(
 		return nil, err
 	}
 
+	rowCounter := s.rowCounters.Get(s, verifResp, req.Msg.GetJobRunId())
+	events, isRunComplete, err := s.getJobRunEvents(ctx, logger, tclient, verifResp, rowCounter)
+	if err != nil {
+		return nil, err
+	}
+	if isRunComplete {
+		s.rowCounters.Delete(verifResp.NeosyncAccountId, req.Msg.GetJobRunId())
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunEventsResponse{
+		Events:        events,
+		IsRunComplete: isRunComplete,
+	}), nil
+}
+
+const (
+	watchJobRunInterval = 5 * time.Second
+)
+
+func (s *Service) WatchJobRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.WatchJobRunRequest],
+	stream *connect.ServerStream[mgmtv1alpha1.WatchJobRunResponse],
+) error {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.JobRunId)
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.JobRunId, req.Msg.AccountId)
+	if err != nil {
+		return err
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
+	if err != nil {
+		return err
+	}
+
+	rowCounter := s.rowCounters.Get(s, verifResp, req.Msg.GetJobRunId())
+	ticker := time.NewTicker(watchJobRunInterval)
+	defer ticker.Stop()
+	for {
+		events, isRunComplete, err := s.getJobRunEvents(ctx, logger, tclient, verifResp, rowCounter)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		err = stream.Send(&mgmtv1alpha1.WatchJobRunResponse{
+			Events:        events,
+			IsRunComplete: isRunComplete,
+		})
+		if err != nil {
+			return err
+		}
+		if isRunComplete {
+			s.rowCounters.Delete(verifResp.NeosyncAccountId, req.Msg.GetJobRunId())
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Builds the events of a job run from its workflow history, along with the progress of any activities that report it
+func (s *Service) getJobRunEvents(
+	ctx context.Context,
+	logger *slog.Logger,
+	tclient temporalclient.Client,
+	verifResp *getVerifiedJobRunResponse,
+	rowCounter *sourceTableRowCounter,
+) ([]*mgmtv1alpha1.JobRunEvent, bool, error) {
 	isRunComplete := false
 	activityOrder := []int64{}
 	activityMap := map[int64]*mgmtv1alpha1.JobRunEvent{}
+	activityIdMap := map[string]int64{}
 	iter := tclient.GetWorkflowHistory(
 		ctx,
 		verifResp.WorkflowExecution.Execution.WorkflowId,
@@ -162,13 +441,14 @@ func (s *Service) GetJobRunEvents(
 	for iter.HasNext() {
 		event, err := iter.Next()
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		switch event.EventType {
 		case enums.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
 			activityOrder = append(activityOrder, event.EventId)
 			attributes := event.GetActivityTaskScheduledEventAttributes()
+			activityIdMap[attributes.ActivityId] = event.EventId
 			jobRunEvent := &mgmtv1alpha1.JobRunEvent{
 				Id:        event.EventId,
 				Type:      attributes.ActivityType.Name,
@@ -204,6 +484,20 @@ func (s *Service) GetJobRunEvents(
 			activity := activityMap[attributes.ScheduledEventId]
 			activity.CloseTime = event.EventTime
 			activity.Tasks = append(activity.Tasks, dtomaps.ToJobRunEventTaskDto(event, nil))
+			if activity.GetMetadata().GetSyncMetadata() != nil && len(attributes.GetResult().GetPayloads()) > 0 {
+				var result sync_activity.SyncResponse
+				err := converter.GetDefaultDataConverter().FromPayload(attributes.Result.Payloads[0], &result)
+				if err != nil {
+					logger.Error(fmt.Errorf("unable to convert sync activity result payload: %w", err).Error())
+				} else if result.Progress != nil {
+					activity.Progress = toJobRunEventProgressDto(
+						result.Progress,
+						activity.GetStartTime().AsTime(),
+						activity.GetCloseTime().AsTime(),
+						nil,
+					)
+				}
+			}
 
 		case enums.EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
 			attributes := event.GetActivityTaskCancelRequestedEventAttributes()
@@ -233,20 +527,285 @@ func (s *Service) GetJobRunEvents(
 			isRunComplete = true
 		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
 			isRunComplete = true
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+			isRunComplete = true
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+			isRunComplete = true
 		default:
 		}
 	}
 
+	if !isRunComplete {
+		err := s.setPendingActivityProgress(ctx, logger, tclient, verifResp, activityMap, activityIdMap, rowCounter)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	events := []*mgmtv1alpha1.JobRunEvent{}
 	for _, index := range activityOrder {
 		value := activityMap[index]
 		events = append(events, value)
 	}
+	return events, isRunComplete, nil
+}
 
-	return connect.NewResponse(&mgmtv1alpha1.GetJobRunEventsResponse{
-		Events:        events,
-		IsRunComplete: isRunComplete,
-	}), nil
+// Running activities report their progress as heartbeat details, which are only available on the pending activities of the workflow
+func (s *Service) setPendingActivityProgress(
+	ctx context.Context,
+	logger *slog.Logger,
+	tclient temporalclient.Client,
+	verifResp *getVerifiedJobRunResponse,
+	activityMap map[int64]*mgmtv1alpha1.JobRunEvent,
+	activityIdMap map[string]int64,
+	rowCounter *sourceTableRowCounter,
+) error {
+	res, err := tclient.DescribeWorkflowExecution(
+		ctx,
+		verifResp.WorkflowExecution.Execution.WorkflowId,
+		verifResp.WorkflowExecution.Execution.RunId,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, pending := range res.GetPendingActivities() {
+		eventId, ok := activityIdMap[pending.GetActivityId()]
+		if !ok {
+			continue
+		}
+		activity := activityMap[eventId]
+		syncMetadata := activity.GetMetadata().GetSyncMetadata()
+		if syncMetadata == nil || len(pending.GetHeartbeatDetails().GetPayloads()) == 0 {
+			continue
+		}
+		var progress benthos_metrics.Progress
+		err := converter.GetDefaultDataConverter().FromPayload(pending.HeartbeatDetails.Payloads[0], &progress)
+		if err != nil {
+			logger.Error(fmt.Errorf("unable to convert sync activity heartbeat payload: %w", err).Error())
+			continue
+		}
+		totalRows := rowCounter.GetRowCount(ctx, logger, syncMetadata.GetSchema(), syncMetadata.GetTable())
+		activity.Progress = toJobRunEventProgressDto(
+			&progress,
+			pending.GetLastStartedTime().AsTime(),
+			pending.GetLastHeartbeatTime().AsTime(),
+			totalRows,
+		)
+	}
+	return nil
+}
+
+// Converts the progress reported by the sync activity into its dto.
+// The estimated completion time is only set if the total rows are known, which is only the case for running activities.
+func toJobRunEventProgressDto(
+	progress *benthos_metrics.Progress,
+	startedAt time.Time,
+	updatedAt time.Time,
+	totalRows *int64,
+) *mgmtv1alpha1.JobRunEventProgress {
+	dto := &mgmtv1alpha1.JobRunEventProgress{
+		RowsRead:       progress.RowsRead,
+		RowsWritten:    progress.RowsWritten,
+		RowsErrored:    progress.RowsErrored,
+		BytesProcessed: progress.BytesProcessed,
+		TotalRows:      totalRows,
+		UpdatedAt:      timestamppb.New(updatedAt),
+	}
+	elapsed := updatedAt.Sub(startedAt).Seconds()
+	if elapsed <= 0 {
+		return dto
+	}
+	dto.RowsPerSecond = float64(progress.RowsWritten) / elapsed
+	if totalRows != nil && dto.RowsPerSecond > 0 {
+		remaining := max(*totalRows-progress.RowsWritten, 0)
+		secondsLeft := float64(remaining) / dto.RowsPerSecond
+		dto.EstimatedCompletionTime = timestamppb.New(updatedAt.Add(time.Duration(secondsLeft * float64(time.Second))))
+	}
+	return dto
+}
+
+// How long the row counts of a run are kept after they were last used.
+// Counts are dropped as soon as the run is seen to be complete, this only bounds runs that are no longer being watched.
+const sourceTableRowCounterTtl = 30 * time.Minute
+
+// Keeps the row counters of job runs so that the source tables of a run are only counted once,
+// no matter how many requests poll or watch the progress of the run.
+type sourceTableRowCounterCache struct {
+	mu       sync.Mutex
+	counters map[string]*cachedSourceTableRowCounter
+}
+
+type cachedSourceTableRowCounter struct {
+	counter  *sourceTableRowCounter
+	lastUsed time.Time
+}
+
+func newSourceTableRowCounterCache() *sourceTableRowCounterCache {
+	return &sourceTableRowCounterCache{counters: map[string]*cachedSourceTableRowCounter{}}
+}
+
+// Returns the row counter of the job run, creating it if the run has not been counted yet
+func (c *sourceTableRowCounterCache) Get(service *Service, verifResp *getVerifiedJobRunResponse, jobRunId string) *sourceTableRowCounter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, cached := range c.counters {
+		if now.Sub(cached.lastUsed) > sourceTableRowCounterTtl {
+			delete(c.counters, key)
+		}
+	}
+
+	key := getSourceTableRowCounterKey(verifResp.NeosyncAccountId, jobRunId)
+	cached, ok := c.counters[key]
+	if !ok {
+		cached = &cachedSourceTableRowCounter{counter: newSourceTableRowCounter(service, verifResp, jobRunId)}
+		c.counters[key] = cached
+	}
+	cached.lastUsed = now
+	return cached.counter
+}
+
+// Drops the row counts of the job run
+func (c *sourceTableRowCounterCache) Delete(accountId, jobRunId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.counters, getSourceTableRowCounterKey(accountId, jobRunId))
+}
+
+func getSourceTableRowCounterKey(accountId, jobRunId string) string {
+	return fmt.Sprintf("%s.%s", accountId, jobRunId)
+}
+
+// Counts the rows of the tables in the source connection of a job run.
+// Counts are cached as they are only used to estimate the progress of a run and counting large tables is expensive.
+type sourceTableRowCounter struct {
+	service   *Service
+	verifResp *getVerifiedJobRunResponse
+	jobRunId  string
+
+	mu        sync.Mutex
+	isLoaded  bool
+	source    *mgmtv1alpha1.JobSourceOptions
+	overrides *mgmtv1alpha1.JobRunOverrides
+	counts    map[string]*int64
+}
+
+func newSourceTableRowCounter(service *Service, verifResp *getVerifiedJobRunResponse, jobRunId string) *sourceTableRowCounter {
+	return &sourceTableRowCounter{
+		service:   service,
+		verifResp: verifResp,
+		jobRunId:  jobRunId,
+		counts:    map[string]*int64{},
+	}
+}
+
+// Returns the number of rows in the source table, or nil if it could not be determined
+func (r *sourceTableRowCounter) GetRowCount(ctx context.Context, logger *slog.Logger, schema, table string) *int64 {
+	// held while counting so that concurrent requests for the same run wait for the count instead of counting again
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := sqlmanager_shared.BuildTable(schema, table)
+	if count, ok := r.counts[key]; ok {
+		return count
+	}
+	count, err := r.countRows(ctx, logger, schema, table)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to count rows of source table %s: %s", key, err.Error()))
+	}
+	r.counts[key] = count
+	return count
+}
+
+func (r *sourceTableRowCounter) countRows(ctx context.Context, logger *slog.Logger, schema, table string) (*int64, error) {
+	if !r.isLoaded {
+		r.isLoaded = true
+		jobId := dtomaps.GetJobIdFromWorkflow(logger, r.verifResp.WorkflowExecution.GetSearchAttributes())
+		jobUuid, err := nucleusdb.ToUuid(jobId)
+		if err != nil {
+			return nil, err
+		}
+		job, err := r.service.db.Q.GetJobById(ctx, r.service.db.Db, jobUuid)
+		if err != nil {
+			return nil, err
+		}
+		if job.ConnectionOptions != nil {
+			r.source = job.ConnectionOptions.ToDto()
+		}
+		// runs started with overrides sync the tables with the where clauses of the overrides
+		storedRun, err := r.service.getStoredJobRun(ctx, r.verifResp.NeosyncAccountId, r.jobRunId)
+		if err != nil {
+			return nil, err
+		}
+		if storedRun != nil && storedRun.Overrides != nil {
+			r.overrides = storedRun.Overrides.ToDto()
+		}
+	}
+
+	var connectionId string
+	var whereClause *string
+	switch config := r.source.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		connectionId = config.Postgres.GetConnectionId()
+		for _, schemaOpt := range config.Postgres.GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				if schemaOpt.GetSchema() == schema && tableOpt.GetTable() == table {
+					whereClause = tableOpt.WhereClause
+				}
+			}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		connectionId = config.Mysql.GetConnectionId()
+		for _, schemaOpt := range config.Mysql.GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				if schemaOpt.GetSchema() == schema && tableOpt.GetTable() == table {
+					whereClause = tableOpt.WhereClause
+				}
+			}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		connectionId = config.Mssql.GetConnectionId()
+		for _, schemaOpt := range config.Mssql.GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				if schemaOpt.GetSchema() == schema && tableOpt.GetTable() == table {
+					whereClause = tableOpt.WhereClause
+				}
+			}
+		}
+	default:
+		// row counts are only supported for sql sources
+		return nil, nil
+	}
+	for _, tableOverride := range r.overrides.GetTables() {
+		if tableOverride.GetSchema() == schema && tableOverride.GetTable() == table && tableOverride.WhereClause != nil {
+			// an empty where clause override removes the where clause of the table
+			whereClause = nil
+			if tableOverride.GetWhereClause() != "" {
+				whereClause = tableOverride.WhereClause
+			}
+		}
+	}
+
+	connection, err := r.service.connectionService.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connectionTimeout := 5
+	db, err := r.service.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+
+	count, err := db.Db.GetTableRowCount(ctx, schema, table, whereClause)
+	if err != nil {
+		return nil, err
+	}
+	return &count, nil
 }
 
 func (s *Service) CreateJobRun(
@@ -263,24 +822,242 @@ func (s *Service) CreateJobRun(
 	if err != nil {
 		return nil, err
 	}
+	if req.Msg.GetOverrides() != nil {
+		// overrides change what the run syncs and where to, so they require the same permissions as editing the job
+		ctx = rbac_interceptor.RequireRoleContext(ctx, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR)
+		if !auth_apikey.HasScope(ctx, apikey.JobsWriteScope) {
+			return nil, nucleuserrors.NewForbidden(fmt.Sprintf("api key must be scoped to %s to create a job run with overrides", apikey.JobsWriteScope))
+		}
+	}
 	accountId := nucleusdb.UUIDString(job.AccountID)
 	_, err = s.verifyUserInAccount(ctx, accountId)
 	if err != nil {
 		return nil, err
 	}
 
-	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), logger)
+	if req.Msg.GetOverrides() != nil {
+		return s.createJobRunWithOverrides(ctx, logger, &job, req.Msg.GetOverrides())
+	}
+
+	// runs are started directly instead of triggering the schedule, as schedule triggers do not return the run they start.
+	// Triggers follow the schedule's default overlap policy, which skips a run while another is in progress.
+	inProgress, err := s.isJobRunInProgress(ctx, logger, &job)
 	if err != nil {
 		return nil, err
 	}
+	if inProgress {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("unable to create job run while another run of the job is in progress"))
+	}
+
+	startedAt := time.Now().UTC()
+	runId := newJobRunId(nucleusdb.UUIDString(job.ID), startedAt)
+	logger = logger.With("jobRunId", runId)
 	logger.Info("creating job run")
-	err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+	err = s.startJobRunWorkflow(ctx, logger, &job, runId, &datasync_workflow.WorkflowRequest{JobId: nucleusdb.UUIDString(job.ID)})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to create job run: %w", err).Error())
+		return nil, err
+	}
+	s.setTriggeredJobRunUser(ctx, logger, &job, runId, startedAt)
+
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{JobRunId: &runId}), nil
+}
+
+// Set by temporal schedules to the id of the schedule, which is the id of the job
+const scheduledByIdSearchAttribute = "TemporalScheduledById"
+
+// Returns the id of a job run that is started outside of the job's schedule.
+// The id is known before the run is started so that it can be returned to the caller.
+func newJobRunId(jobId string, startedAt time.Time) string {
+	return fmt.Sprintf("%s-%s", jobId, startedAt.Format(time.RFC3339Nano))
+}
+
+// Starts a run of the job outside of its schedule.
+// Runs are given the search attribute that the schedule sets on the runs it starts, as runs are looked up by their job with it.
+func (s *Service) startJobRunWorkflow(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	runId string,
+	wfReq *datasync_workflow.WorkflowRequest,
+) error {
+	accountId := nucleusdb.UUIDString(job.AccountID)
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return err
+	}
+
+	opts := temporalclient.StartWorkflowOptions{
+		ID:                    runId,
+		TaskQueue:             tconfig.SyncJobQueueName,
+		WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		TypedSearchAttributes: temporal.NewSearchAttributes(
+			temporal.NewSearchAttributeKeyKeyword(scheduledByIdSearchAttribute).ValueSet(nucleusdb.UUIDString(job.ID)),
+		),
+	}
+	if job.WorkflowOptions != nil && job.WorkflowOptions.RunTimeout != nil {
+		opts.WorkflowRunTimeout = time.Duration(*job.WorkflowOptions.RunTimeout)
+	}
+	_, err = tclient.ExecuteWorkflow(ctx, opts, datasync_workflow.Workflow, wfReq)
+	return err
+}
+
+// Schedule triggers always run the stored job definition, so runs with overrides are started directly.
+// The overrides are recorded with the run before it is started.
+func (s *Service) createJobRunWithOverrides(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	overrides *mgmtv1alpha1.JobRunOverrides,
+) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+	accountId := nucleusdb.UUIDString(job.AccountID)
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	destinations, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, destination := range overrides.GetDestinations() {
+		if destination.ConnectionId == nil {
+			continue
+		}
+		err := s.verifyConnectionInAccount(ctx, destination.GetConnectionId(), accountId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	overriddenJob, err := shared.ApplyJobRunOverrides(dtomaps.ToJobDto(job, destinations), overrides)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+	// the overrides may send the mappings to destinations that the account's policies treat differently
+	destinationConnectionIds := make([]string, 0, len(overriddenJob.GetDestinations()))
+	for _, destination := range overriddenJob.GetDestinations() {
+		destinationConnectionIds = append(destinationConnectionIds, destination.GetConnectionId())
+	}
+	err = s.verifyJobMappingPolicies(ctx, job.AccountID, getMappingsSourceConnectionId(overriddenJob.GetSource()), overriddenJob.GetMappings(), destinationConnectionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// runs with overrides follow the schedule's default overlap policy, which skips a run while another is in progress
+	inProgress, err := s.isJobRunInProgress(ctx, logger, job)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("unable to create job run with overrides while another run of the job is in progress"))
+	}
+
+	dbOverrides := &pg_models.JobRunOverrides{}
+	err = dbOverrides.FromDto(overrides)
+	if err != nil {
+		return nil, err
+	}
+	var triggeredBy pgtype.Text
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to determine user that triggered the job run: %s", err.Error()))
+	} else {
+		triggeredBy = pgtype.Text{String: nucleusdb.UUIDString(*userUuid), Valid: true}
+	}
+
+	startedAt := time.Now().UTC()
+	runId := newJobRunId(jobId, startedAt)
+	logger = logger.With("jobRunId", runId)
+	err = s.db.Q.SetJobRunOverrides(ctx, s.db.Db, db_queries.SetJobRunOverridesParams{
+		ID:          runId,
+		AccountId:   job.AccountID,
+		JobId:       job.ID,
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_PENDING),
+		StartedAt:   pgtype.Timestamp{Time: startedAt, Valid: true},
+		TriggeredBy: triggeredBy,
+		Overrides:   dbOverrides,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to record job run overrides: %w", err)
+	}
+
+	logger.Info("creating job run with overrides")
+	err = s.startJobRunWorkflow(ctx, logger, job, runId, &datasync_workflow.WorkflowRequest{
+		JobId:     jobId,
+		Overrides: &shared.JobRunOverrides{JobRunOverrides: overrides},
+	})
 	if err != nil {
 		logger.Error(fmt.Errorf("unable to create job run: %w", err).Error())
+		errMsg := fmt.Sprintf("unable to start job run: %s", err.Error())
+		updateErr := s.db.Q.UpdateJobRunStatus(ctx, s.db.Db, db_queries.UpdateJobRunStatusParams{
+			ID:           runId,
+			AccountId:    job.AccountID,
+			Status:       int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED),
+			CompletedAt:  pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+			ErrorMessage: pgtype.Text{String: errMsg, Valid: true},
+		})
+		if updateErr != nil {
+			logger.Warn(fmt.Sprintf("unable to record that the job run failed to start: %s", updateErr.Error()))
+		}
 		return nil, err
 	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{JobRunId: &runId}), nil
+}
+
+// Returns true if the job has a run in progress, either one started by its schedule or one recorded in the run history
+func (s *Service) isJobRunInProgress(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+) (bool, error) {
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), logger)
+	if err != nil {
+		return false, err
+	}
+	desc, err := scheduleHandle.Describe(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to describe job schedule: %w", err)
+	}
+	if len(desc.Info.RunningWorkflows) > 0 {
+		return true, nil
+	}
+
+	runs, err := s.db.Q.GetJobRuns(ctx, s.db.Db, db_queries.GetJobRunsParams{
+		AccountId: job.AccountID,
+		JobId:     job.ID,
+		Statuses:  openJobRunStatuses,
+		PageLimit: 1,
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve job runs: %w", err)
+	}
+	return len(runs) > 0, nil
+}
 
-	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+func (s *Service) setTriggeredJobRunUser(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	runId string,
+	startedAt time.Time,
+) {
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to determine user that triggered the job run: %s", err.Error()))
+		return
+	}
+	err = s.db.Q.SetJobRunTriggeredBy(ctx, s.db.Db, db_queries.SetJobRunTriggeredByParams{
+		ID:          runId,
+		AccountId:   job.AccountID,
+		JobId:       job.ID,
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_PENDING),
+		StartedAt:   pgtype.Timestamp{Time: startedAt, Valid: true},
+		TriggeredBy: pgtype.Text{String: nucleusdb.UUIDString(*userUuid), Valid: true},
+	})
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to record user that triggered the job run: %s", err.Error()))
+	}
 }
 
 func (s *Service) CancelJobRun(
@@ -335,6 +1112,21 @@ func (s *Service) TerminateJobRun(
 	if err != nil {
 		return nil, fmt.Errorf("unable to terminate job run: %w", err)
 	}
+
+	// terminated runs are not given a chance to record their outcome
+	accountUuid, err := nucleusdb.ToUuid(verifResp.NeosyncAccountId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.db.Q.UpdateJobRunStatus(ctx, s.db.Db, db_queries.UpdateJobRunStatusParams{
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED),
+		CompletedAt: pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+		ID:          req.Msg.JobRunId,
+		AccountId:   accountUuid,
+	})
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to record job run as terminated: %s", err.Error()))
+	}
 	return connect.NewResponse(&mgmtv1alpha1.TerminateJobRunResponse{}), nil
 }
 
@@ -425,6 +1217,23 @@ func (s *Service) getVerifiedJobRun(
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve job run: %w", err)
 	}
+	jobId := ""
+	if run.GetSearchAttributes() != nil {
+		jobId = dtomaps.GetJobIdFromWorkflow(logger, run.GetSearchAttributes())
+	}
+	if jobId == "" {
+		// runs with overrides are not started by the job schedule, so the job is found from the recorded run
+		storedRun, err := s.getStoredJobRun(ctx, accountId, runId)
+		if err != nil {
+			return nil, err
+		}
+		if storedRun != nil {
+			jobId = nucleusdb.UUIDString(storedRun.JobID)
+		}
+	}
+	if !auth_apikey.IsJobAllowed(ctx, jobId) {
+		return nil, nucleuserrors.NewForbidden("api key is not allowed to access this job run")
+	}
 	return &getVerifiedJobRunResponse{
 		WorkflowExecution: run,
 		NeosyncAccountId:  accountId,
@@ -455,6 +1264,8 @@ func (s *Service) GetJobRunLogsStream(
 		return s.streamK8sWorkerPodLogs(ctx, req, stream, logger)
 	case LokiRunLogType:
 		return s.streamLokiWorkerLogs(ctx, req, stream, logger)
+	case DatabaseRunLogType:
+		return s.streamDatabaseWorkerLogs(ctx, req, stream, logger)
 	default:
 		return nucleuserrors.NewNotImplemented("streaming log pods not implemented for this container type")
 	}
@@ -608,6 +1419,360 @@ func (s *Service) streamLokiWorkerLogs(
 	return nil
 }
 
+const (
+	databaseRunLogsPageSize     = 500
+	databaseRunLogsTailInterval = 1 * time.Second
+)
+
+func (s *Service) streamDatabaseWorkerLogs(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest],
+	stream *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse],
+	logger *slog.Logger,
+) error {
+	jobrunResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.GetJobRunId(), req.Msg.GetAccountId())
+	if err != nil {
+		return err
+	}
+	accountUuid, err := nucleusdb.ToUuid(jobrunResp.NeosyncAccountId)
+	if err != nil {
+		return err
+	}
+	workflowId := jobrunResp.WorkflowExecution.GetExecution().GetWorkflowId()
+	since := pgtype.Timestamp{Time: getLogFilterTime(req.Msg.GetWindow(), time.Now()).UTC(), Valid: true}
+	loglevels := getLogLevelFilters(req.Msg.GetLogLevels())
+
+	var lastId int64
+	if req.Msg.GetMaxLogLines() > 0 {
+		// grab the most recent lines and send them oldest first
+		rows, err := s.db.Q.GetLatestJobRunLogs(ctx, s.db.Db, db_queries.GetLatestJobRunLogsParams{
+			AccountId: accountUuid,
+			JobRunId:  workflowId,
+			Since:     since,
+			Levels:    loglevels,
+			PageLimit: toPageLimit(req.Msg.GetMaxLogLines()),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to retrieve job run logs: %w", err)
+		}
+		slices.Reverse(rows)
+		lastId, err = sendDatabaseRunLogs(stream, rows, lastId)
+		if err != nil {
+			return err
+		}
+	} else {
+		lastId, err = s.sendDatabaseRunLogsAfter(ctx, stream, accountUuid, workflowId, since, loglevels, lastId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !req.Msg.GetShouldTail() {
+		return nil
+	}
+
+	ticker := time.NewTicker(databaseRunLogsTailInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			lastId, err = s.sendDatabaseRunLogsAfter(ctx, stream, accountUuid, workflowId, since, loglevels, lastId)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// Pages through all of the stored logs after the provided id and returns the id of the last log line that was sent
+func (s *Service) sendDatabaseRunLogsAfter(
+	ctx context.Context,
+	stream *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse],
+	accountUuid pgtype.UUID,
+	workflowId string,
+	since pgtype.Timestamp,
+	loglevels []string,
+	afterId int64,
+) (int64, error) {
+	lastId := afterId
+	for {
+		rows, err := s.db.Q.GetJobRunLogs(ctx, s.db.Db, db_queries.GetJobRunLogsParams{
+			AccountId: accountUuid,
+			JobRunId:  workflowId,
+			AfterId:   lastId,
+			Since:     since,
+			Levels:    loglevels,
+			PageLimit: databaseRunLogsPageSize,
+		})
+		if err != nil {
+			return lastId, fmt.Errorf("unable to retrieve job run logs: %w", err)
+		}
+		lastId, err = sendDatabaseRunLogs(stream, rows, lastId)
+		if err != nil {
+			return lastId, err
+		}
+		if len(rows) < databaseRunLogsPageSize {
+			return lastId, nil
+		}
+	}
+}
+
+func sendDatabaseRunLogs(
+	stream *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse],
+	rows []db_queries.NeosyncApiJobRunLog,
+	lastId int64,
+) (int64, error) {
+	for idx := range rows {
+		row := rows[idx]
+		err := stream.Send(&mgmtv1alpha1.GetJobRunLogsStreamResponse{
+			LogLine:   fmt.Sprintf("[%s] - %s", row.Level, row.Message),
+			Timestamp: timestamppb.New(row.LogTime.Time),
+		})
+		if err != nil {
+			return lastId, err
+		}
+		lastId = row.ID
+	}
+	return lastId, nil
+}
+
+func toPageLimit(maxLines int64) int32 {
+	if maxLines > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(maxLines) //nolint:gosec // bounds checked above
+}
+
+func (s *Service) IngestJobRunLogs(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest],
+) (*connect.Response[mgmtv1alpha1.IngestJobRunLogsResponse], error) {
+	if s.cfg.RunLogConfig == nil || !s.cfg.RunLogConfig.IsEnabled || s.cfg.RunLogConfig.RunLogType == nil || *s.cfg.RunLogConfig.RunLogType != DatabaseRunLogType {
+		return nil, nucleuserrors.NewNotImplemented("job run log ingestion is only available when run logs are configured to be stored in the database")
+	}
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	// run logs may only be written by the worker
+	if s.cfg.IsAuthEnabled && !isWorkerApiKey(ctx) {
+		return nil, nucleuserrors.NewUnauthenticated("must provide valid authentication credentials for this endpoint")
+	}
+
+	entries := req.Msg.GetEntries()
+	params := db_queries.CreateJobRunLogsParams{
+		AccountId:   *accountUuid,
+		JobRunId:    req.Msg.GetJobRunId(),
+		LogTimes:    make([]pgtype.Timestamp, 0, len(entries)),
+		Levels:      make([]string, 0, len(entries)),
+		Messages:    make([]string, 0, len(entries)),
+		Activities:  make([]string, 0, len(entries)),
+		SchemaNames: make([]string, 0, len(entries)),
+		TableNames:  make([]string, 0, len(entries)),
+		Attributes:  make([]string, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		attributes := entry.GetAttributes()
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		attrBits, err := json.Marshal(attributes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal log attributes: %w", err)
+		}
+		level := logLevelToString(entry.GetLevel())
+		if level == "" {
+			level = "INFO"
+		}
+		params.LogTimes = append(params.LogTimes, pgtype.Timestamp{Time: entry.GetTimestamp().AsTime().UTC(), Valid: true})
+		params.Levels = append(params.Levels, level)
+		params.Messages = append(params.Messages, entry.GetMessage())
+		params.Activities = append(params.Activities, entry.GetActivity())
+		params.SchemaNames = append(params.SchemaNames, entry.GetSchema())
+		params.TableNames = append(params.TableNames, entry.GetTable())
+		params.Attributes = append(params.Attributes, string(attrBits))
+	}
+
+	err = s.db.Q.CreateJobRunLogs(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store job run logs: %w", err)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.IngestJobRunLogsResponse{}), nil
+}
+
+func (s *Service) RecordJobRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.RecordJobRunRequest],
+) (*connect.Response[mgmtv1alpha1.RecordJobRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.GetJobId(), "jobRunId", req.Msg.GetJobRunId())
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	// run history may only be recorded by the worker
+	if s.cfg.IsAuthEnabled && !isWorkerApiKey(ctx) {
+		return nil, nucleuserrors.NewUnauthenticated("must provide valid authentication credentials for this endpoint")
+	}
+
+	params := db_queries.UpsertJobRunParams{
+		ID:        req.Msg.GetJobRunId(),
+		AccountId: job.AccountID,
+		JobId:     job.ID,
+		Status:    int16(req.Msg.GetStatus()),
+		StartedAt: pgtype.Timestamp{Time: req.Msg.GetStartedAt().AsTime().UTC(), Valid: true},
+		Tables:    make([]*pg_models.JobRunTableSummary, 0, len(req.Msg.GetTables())),
+	}
+	if req.Msg.CompletedAt != nil {
+		params.CompletedAt = pgtype.Timestamp{Time: req.Msg.GetCompletedAt().AsTime().UTC(), Valid: true}
+	}
+	if req.Msg.ErrorMessage != nil {
+		params.ErrorMessage = pgtype.Text{String: req.Msg.GetErrorMessage(), Valid: true}
+	}
+	for _, table := range req.Msg.GetTables() {
+		summary := &pg_models.JobRunTableSummary{}
+		summary.FromDto(table)
+		params.Tables = append(params.Tables, summary)
+	}
+
+	logger.Debug("recording job run", "status", req.Msg.GetStatus().String())
+	err = s.db.Q.UpsertJobRun(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to record job run: %w", err)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.RecordJobRunResponse{}), nil
+}
+
+// Removes all stored run logs that are older than the configured retention
+func (s *Service) DeleteExpiredJobRunLogs(ctx context.Context) (int64, error) {
+	if s.cfg.RunLogConfig == nil || s.cfg.RunLogConfig.DatabaseRunLogConfig == nil || s.cfg.RunLogConfig.DatabaseRunLogConfig.Retention <= 0 {
+		return 0, nil
+	}
+	before := time.Now().Add(-s.cfg.RunLogConfig.DatabaseRunLogConfig.Retention).UTC()
+	count, err := s.db.Q.DeleteJobRunLogsOlderThan(ctx, s.db.Db, pgtype.Timestamp{Time: before, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("unable to delete expired job run logs: %w", err)
+	}
+	return count, nil
+}
+
+var openJobRunStatuses = []int16{
+	int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_PENDING),
+	int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING),
+}
+
+const (
+	reconcileJobRunsPageLimit = 500
+	// runs younger than this are left alone so that the worker has a chance to record them itself
+	reconcileJobRunsMinAge = 5 * time.Minute
+)
+
+// Closes stored job runs whose workflow has closed without the worker recording its outcome.
+// This is always the case for runs that hit their run timeout, as Temporal closes those without running any more workflow code.
+// Returns the runs that were closed because they timed out so that the caller may notify of them.
+func (s *Service) ReconcileJobRuns(ctx context.Context, logger *slog.Logger) ([]*db_queries.NeosyncApiJobRun, error) {
+	runs, err := s.db.Q.GetJobRunsByStatus(ctx, s.db.Db, db_queries.GetJobRunsByStatusParams{
+		Statuses:      openJobRunStatuses,
+		StartedBefore: pgtype.Timestamp{Time: time.Now().Add(-reconcileJobRunsMinAge).UTC(), Valid: true},
+		PageLimit:     reconcileJobRunsPageLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve open job runs: %w", err)
+	}
+
+	clients := map[string]temporalclient.Client{}
+	timedOut := []*db_queries.NeosyncApiJobRun{}
+	for idx := range runs {
+		run := runs[idx]
+		accountId := nucleusdb.UUIDString(run.AccountID)
+		runLogger := logger.With("accountId", accountId, "jobRunId", run.ID)
+
+		tclient, ok := clients[accountId]
+		if !ok {
+			tclient, err = s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+			if err != nil {
+				runLogger.Warn(fmt.Sprintf("unable to retrieve temporal client for account: %s", err.Error()))
+				tclient = nil
+			}
+			clients[accountId] = tclient
+		}
+		if tclient == nil {
+			continue
+		}
+
+		status, errorMessage, err := getClosedJobRunStatus(ctx, runLogger, tclient, run.ID)
+		if err != nil {
+			runLogger.Warn(fmt.Sprintf("unable to describe job run workflow: %s", err.Error()))
+			continue
+		}
+		if status == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED {
+			continue
+		}
+
+		count, err := s.db.Q.CloseJobRun(ctx, s.db.Db, db_queries.CloseJobRunParams{
+			Status:       int16(status),
+			CompletedAt:  pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+			ErrorMessage: errorMessage,
+			ID:           run.ID,
+			AccountId:    run.AccountID,
+			OpenStatuses: openJobRunStatuses,
+		})
+		if err != nil {
+			return timedOut, fmt.Errorf("unable to close job run: %w", err)
+		}
+		// another replica, or the worker, closed the run first
+		if count == 0 {
+			continue
+		}
+		runLogger.Debug("closed job run", "status", status.String())
+		if status == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT {
+			run.Status = int16(status)
+			run.ErrorMessage = errorMessage
+			timedOut = append(timedOut, &run)
+		}
+	}
+	return timedOut, nil
+}
+
+// Returns the status that a run should be closed with, or unspecified if its workflow is still running
+func getClosedJobRunStatus(
+	ctx context.Context,
+	logger *slog.Logger,
+	tclient temporalclient.Client,
+	workflowId string,
+) (mgmtv1alpha1.JobRunStatus, pgtype.Text, error) {
+	res, err := tclient.DescribeWorkflowExecution(ctx, workflowId, "")
+	if err != nil {
+		var notFoundErr *serviceerror.NotFound
+		if errors.As(err, &notFoundErr) {
+			return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, pgtype.Text{String: "job run workflow could not be found", Valid: true}, nil
+		}
+		return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED, pgtype.Text{}, err
+	}
+	switch res.GetWorkflowExecutionInfo().GetStatus() {
+	case enums.WORKFLOW_EXECUTION_STATUS_RUNNING, enums.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, enums.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED:
+		return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED, pgtype.Text{}, nil
+	case enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+		return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT, pgtype.Text{String: "job run exceeded its run timeout", Valid: true}, nil
+	}
+	return dtomaps.ToJobRunDtoFromWorkflowExecutionInfo(res.GetWorkflowExecutionInfo(), logger).GetStatus(), pgtype.Text{}, nil
+}
+
 func getLogLevelFilters(loglevels []mgmtv1alpha1.LogLevel) []string {
 	levels := []string{}
 
@@ -2,17 +2,31 @@ package v1alpha1_jobservice
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	benthos_metrics "github.com/nucleuscloud/neosync/worker/pkg/benthos/metrics"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,31 +37,27 @@ import (
 	temporalclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/converter"
 	temporalmocks "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GetJobRuns
 func Test_GetJobRuns_ByJobId(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
-	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
 	jobId := nucleusdb.UUIDString(job.ID)
-	workflowId := uuid.NewString()
-	workflowExecutionMock := getWorfklowExecutionInfoMock(jobId, workflowId)
+	runs := []db_queries.NeosyncApiJobRun{mockJobRun(job.AccountID, job.ID, time.Now().UTC())}
 
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
-	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
-	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
-		Namespace:        "default",
-		SyncJobQueueName: "sync-job",
-		Url:              "localhost:7233",
-	}, nil)
-
-	workflows := []*workflowpb.WorkflowExecutionInfo{workflowExecutionMock}
-	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
-		Executions: workflows,
-	}, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{}, nil)
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, db_queries.GetJobRunsParams{
+		AccountId: accountUuid,
+		JobId:     job.ID,
+		Statuses:  []int16{},
+		PageLimit: defaultJobRunsPageSize + 1,
+	}).Return(runs, nil)
 
 	resp, err := m.Service.GetJobRuns(context.Background(), &connect.Request[mgmtv1alpha1.GetJobRunsRequest]{
 		Msg: &mgmtv1alpha1.GetJobRunsRequest{
@@ -59,39 +69,148 @@ func Test_GetJobRuns_ByJobId(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, len(workflows), len(resp.Msg.JobRuns))
+	assert.Equal(t, len(runs), len(resp.Msg.JobRuns))
+	assert.Equal(t, jobId, resp.Msg.JobRuns[0].JobId)
+	assert.Nil(t, resp.Msg.NextPageToken)
 }
 
 func Test_GetJobRuns_ByAccountId(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
-	temporalClientMock := new(temporalmocks.Client)
 	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
-	jobId := nucleusdb.UUIDString(job.ID)
-	workflowId := uuid.NewString()
-	workflowExecutionMock := getWorfklowExecutionInfoMock(jobId, workflowId)
+	startedAfter := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	runs := []db_queries.NeosyncApiJobRun{mockJobRun(job.AccountID, job.ID, time.Now().UTC())}
 
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
-	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job}, nil)
-	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
-	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{}, nil)
-
-	workflows := []*workflowpb.WorkflowExecutionInfo{workflowExecutionMock}
-	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
-		Executions: workflows,
-	}, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{}, nil)
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, db_queries.GetJobRunsParams{
+		AccountId:    accountUuid,
+		Statuses:     []int16{int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED)},
+		StartedAfter: pgtype.Timestamp{Time: startedAfter, Valid: true},
+		PageLimit:    defaultJobRunsPageSize + 1,
+	}).Return(runs, nil)
 
 	resp, err := m.Service.GetJobRuns(context.Background(), &connect.Request[mgmtv1alpha1.GetJobRunsRequest]{
 		Msg: &mgmtv1alpha1.GetJobRunsRequest{
 			Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{
 				AccountId: mockAccountId,
 			},
+			Statuses:     []mgmtv1alpha1.JobRunStatus{mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED},
+			StartedAfter: timestamppb.New(startedAfter),
 		},
 	})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, len(workflows), len(resp.Msg.JobRuns))
+	assert.Equal(t, len(runs), len(resp.Msg.JobRuns))
+}
+
+func Test_GetJobRuns_Pagination(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	now := time.Now().UTC()
+	runs := []db_queries.NeosyncApiJobRun{
+		mockJobRun(job.AccountID, job.ID, now),
+		mockJobRun(job.AccountID, job.ID, now.Add(-time.Minute)),
+		mockJobRun(job.AccountID, job.ID, now.Add(-2*time.Minute)),
+	}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{}, nil).Once()
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetJobRunsParams) bool {
+		return params.PageLimit == 3 && !params.CursorStartedAt.Valid
+	})).Return(runs, nil).Once()
+
+	resp, err := m.Service.GetJobRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+		Id:       &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: mockAccountId},
+		PageSize: 2,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetJobRuns(), 2)
+	require.NotNil(t, resp.Msg.NextPageToken)
+
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetJobRunsParams) bool {
+		return params.CursorStartedAt.Time.Equal(runs[1].StartedAt.Time) && params.CursorId.String == runs[1].ID
+	})).Return(runs[2:], nil).Once()
+
+	resp, err = m.Service.GetJobRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+		Id:        &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: mockAccountId},
+		PageSize:  2,
+		PageToken: resp.Msg.NextPageToken,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetJobRuns(), 1)
+	require.Nil(t, resp.Msg.NextPageToken)
+}
+
+func Test_GetJobRuns_BackfillsFromTemporal(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(temporalmocks.Client)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+	workflowId := uuid.NewString()
+	workflow := getWorfklowExecutionInfoMock(jobId, workflowId)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{job}, nil).Once()
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{workflow},
+	}, nil).Once()
+	m.QuerierMock.On("BackfillJobRun", mock.Anything, mock.Anything, db_queries.BackfillJobRunParams{
+		ID:          workflowId,
+		AccountId:   job.AccountID,
+		JobId:       job.ID,
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE),
+		StartedAt:   pgtype.Timestamp{Time: workflow.GetStartTime().AsTime().UTC(), Valid: true},
+		CompletedAt: pgtype.Timestamp{Time: workflow.GetCloseTime().AsTime().UTC(), Valid: true},
+	}).Return(nil).Once()
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiJobRun{}, nil)
+
+	for range 2 {
+		_, err := m.Service.GetJobRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+			Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: mockAccountId},
+		}))
+		require.NoError(t, err)
+	}
+	m.QuerierMock.AssertExpectations(t)
+	temporalClientMock.AssertExpectations(t)
+}
+
+func Test_GetJobRuns_BackfillFailureIsRetried(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return(nil, errors.New("boom")).Once()
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{}, nil).Once()
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiJobRun{}, nil)
+
+	for range 3 {
+		_, err := m.Service.GetJobRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+			Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: mockAccountId},
+		}))
+		require.NoError(t, err)
+	}
+	m.QuerierMock.AssertNumberOfCalls(t, "GetJobsByAccount", 2)
+}
+
+func Test_GetJobRuns_InvalidPageToken(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	token := "not-a-token"
+	_, err := m.Service.GetJobRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+		Id:        &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: mockAccountId},
+		PageToken: &token,
+	}))
+	require.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "GetJobRuns", mock.Anything, mock.Anything, mock.Anything)
 }
 
 // GetJobRun
@@ -111,9 +230,15 @@ func Test_GetJobRun(t *testing.T) {
 		},
 	}}
 
+	storedRun := mockJobRun(accountUuid, job.ID, time.Now().UTC())
+
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
 	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, workflowId, runId).Return(workflowExecutionMock, nil)
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, db_queries.GetJobRunByIdParams{
+		ID:        runId,
+		AccountId: accountUuid,
+	}).Return(storedRun, nil)
 
 	resp, err := m.Service.GetJobRun(context.Background(), &connect.Request[mgmtv1alpha1.GetJobRunRequest]{
 		Msg: &mgmtv1alpha1.GetJobRunRequest{
@@ -124,6 +249,52 @@ func Test_GetJobRun(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
+	assert.Len(t, resp.Msg.GetJobRun().GetTables(), 1)
+	assert.Equal(t, mockUserId, resp.Msg.GetJobRun().GetTriggeredBy())
+}
+
+func Test_GetJobRun_FromRunHistory(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	storedRun := mockJobRun(accountUuid, job.ID, time.Now().UTC())
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{})
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, db_queries.GetJobRunByIdParams{
+		ID:        storedRun.ID,
+		AccountId: accountUuid,
+	}).Return(storedRun, nil)
+
+	resp, err := m.Service.GetJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  storedRun.ID,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, storedRun.ID, resp.Msg.GetJobRun().GetId())
+	require.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, resp.Msg.GetJobRun().GetStatus())
+	require.NotNil(t, resp.Msg.GetJobRun().GetCompletedAt())
+	temporalClientMock.AssertNotCalled(t, "DescribeWorkflowExecution", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetJobRun_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{})
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRun{}, pgx.ErrNoRows)
+
+	_, err := m.Service.GetJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  uuid.NewString(),
+		AccountId: mockAccountId,
+	}))
+
+	require.Error(t, err)
+	require.True(t, nucleuserrors.IsNotFound(err))
 }
 
 // GetJobRunEvents
@@ -131,22 +302,268 @@ func Test_GetJobRun(t *testing.T) {
 // CreateJobRun
 func Test_CreateJobRun(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
-	mockHandle := new(temporalmocks.ScheduleHandle)
+	temporalClientMock := new(temporalmocks.Client)
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 
-	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
 	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
-	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(job.ID), mock.Anything).Return(mockHandle, nil)
-	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+	mockJobRunInProgress(m, job, false)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	var startedRunId string
+	temporalClientMock.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(opts temporalclient.StartWorkflowOptions) bool {
+		startedRunId = opts.ID
+		scheduledById, ok := opts.TypedSearchAttributes.GetKeyword(temporal.NewSearchAttributeKeyKeyword("TemporalScheduledById"))
+		return strings.HasPrefix(opts.ID, jobId+"-") && opts.TaskQueue == "sync-job" && ok && scheduledById == jobId
+	}), mock.Anything, &datasync_workflow.WorkflowRequest{JobId: jobId}).Return(new(temporalmocks.WorkflowRun), nil)
+	m.QuerierMock.On("SetJobRunTriggeredBy", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.SetJobRunTriggeredByParams) bool {
+		return params.ID == startedRunId &&
+			params.AccountId == job.AccountID &&
+			params.JobId == job.ID &&
+			params.Status == int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_PENDING) &&
+			params.TriggeredBy == pgtype.Text{String: nucleusdb.UUIDString(userUuid), Valid: true}
+	})).Return(nil)
 
 	resp, err := m.Service.CreateJobRun(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRunRequest]{
 		Msg: &mgmtv1alpha1.CreateJobRunRequest{
-			JobId: nucleusdb.UUIDString(job.ID),
+			JobId: jobId,
 		},
 	})
 
-	assert.NoError(t, err)
-	assert.NotNil(t, resp)
+	require.NoError(t, err)
+	require.NotEmpty(t, startedRunId)
+	require.Equal(t, startedRunId, resp.Msg.GetJobRunId())
+	m.QuerierMock.AssertExpectations(t)
+	temporalClientMock.AssertExpectations(t)
+}
+
+func Test_CreateJobRun_RunInProgress(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	mockJobRunInProgress(m, job, true)
+
+	_, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	m.TemporalWfManagerMock.AssertNotCalled(t, "GetWorkflowClientByAccount", mock.Anything, mock.Anything, mock.Anything)
+	m.QuerierMock.AssertNotCalled(t, "SetJobRunTriggeredBy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_WithOverrides(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+	destConn := getConnectionMock(mockAccountId, "prod")
+	stagingConn := getConnectionMock(mockAccountId, "staging")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+	destinationId := nucleusdb.UUIDString(destConnAssociation.ID)
+	stagingConnId := nucleusdb.UUIDString(stagingConn.ID)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation}, nil)
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, db_queries.IsConnectionInAccountParams{
+		AccountId:    accountUuid,
+		ConnectionId: stagingConn.ID,
+	}).Return(int64(1), nil)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
+	mockJobRunInProgress(m, job, false)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	var recordedRunId string
+	m.QuerierMock.On("SetJobRunOverrides", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.SetJobRunOverridesParams) bool {
+		recordedRunId = params.ID
+		return params.AccountId == accountUuid &&
+			params.JobId == job.ID &&
+			params.Status == int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_PENDING) &&
+			params.TriggeredBy.Valid &&
+			len(params.Overrides.Destinations) == 1 &&
+			*params.Overrides.Destinations[0].ConnectionId == stagingConnId
+	})).Return(nil)
+	temporalClientMock.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(opts temporalclient.StartWorkflowOptions) bool {
+		scheduledById, ok := opts.TypedSearchAttributes.GetKeyword(temporal.NewSearchAttributeKeyKeyword("TemporalScheduledById"))
+		return opts.ID == recordedRunId && opts.TaskQueue == "sync-job" && ok && scheduledById == jobId
+	}), mock.Anything, mock.MatchedBy(func(req *datasync_workflow.WorkflowRequest) bool {
+		return req.JobId == jobId && req.Overrides.Get().GetDestinations()[0].GetConnectionId() == stagingConnId
+	})).Return(new(temporalmocks.WorkflowRun), nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: jobId,
+		Overrides: &mgmtv1alpha1.JobRunOverrides{
+			Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{
+				{DestinationId: destinationId, ConnectionId: &stagingConnId},
+			},
+		},
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, recordedRunId, resp.Msg.GetJobRunId())
+	m.QuerierMock.AssertExpectations(t)
+	temporalClientMock.AssertExpectations(t)
+}
+
+func Test_CreateJobRun_WithOverrides_Invalid(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+
+	_, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+		Overrides: &mgmtv1alpha1.JobRunOverrides{
+			Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{{DestinationId: uuid.NewString()}},
+		},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "SetJobRunOverrides", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_WithOverrides_ViolatesMappingPolicy(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: &pg_models.JobMappingTransformerModel{Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH)}},
+	}
+	destConn := getConnectionMock(mockAccountId, "prod")
+	stagingConn := getConnectionMock(mockAccountId, "staging")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+	destinationId := nucleusdb.UUIDString(destConnAssociation.ID)
+	stagingConnId := nucleusdb.UUIDString(stagingConn.ID)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation}, nil)
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, db_queries.IsConnectionInAccountParams{
+		AccountId:    accountUuid,
+		ConnectionId: stagingConn.ID,
+	}).Return(int64(1), nil)
+	policyConfig := &pg_models.JobMappingPolicyConfig{}
+	policyConfig.FromDto(&mgmtv1alpha1.JobMappingPolicyConfig{
+		Policies: []*mgmtv1alpha1.JobMappingPolicy{
+			{Name: "non-prod", DestinationScope: mgmtv1alpha1.JobMappingPolicyDestinationScope_JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION},
+		},
+		NonProductionConnectionIds: []string{stagingConnId},
+	})
+	mockGetJobMappingPolicyConfig(m.QuerierMock, policyConfig)
+
+	_, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+		Overrides: &mgmtv1alpha1.JobRunOverrides{
+			Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{
+				{DestinationId: destinationId, ConnectionId: &stagingConnId},
+			},
+		},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "SetJobRunOverrides", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_WithOverrides_RunInProgress(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	destConn := getConnectionMock(mockAccountId, "prod")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation}, nil)
+	mockGetJobMappingPolicyConfig(m.QuerierMock, nil)
+	mockJobRunInProgress(m, job, true)
+
+	_, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+		Overrides: &mgmtv1alpha1.JobRunOverrides{
+			Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{
+				{DestinationId: nucleusdb.UUIDString(destConnAssociation.ID)},
+			},
+		},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "SetJobRunOverrides", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_WithOverrides_RequiresJobEditor(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	var requiredRole mgmtv1alpha1.AccountRole
+	m.UserAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			requiredRole, _ = rbac_interceptor.GetRequiredRoleFromContext(args.Get(0).(context.Context))
+		}).
+		Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{Ok: false}), nil)
+
+	ctx := rbac_interceptor.SetRequiredRoleContext(context.Background(), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	_, err := m.Service.CreateJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:     nucleusdb.UUIDString(job.ID),
+		Overrides: &mgmtv1alpha1.JobRunOverrides{},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR, requiredRole)
+}
+
+func Test_CreateJobRun_WithOverrides_ApiKeyRequiresJobsWriteScope(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+		ApiKey:     &db_queries.NeosyncApiAccountApiKey{AccountID: job.AccountID, Scopes: []string{string(apikey.JobsTriggerScope)}},
+	})
+	_, err := m.Service.CreateJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:     nucleusdb.UUIDString(job.ID),
+		Overrides: &mgmtv1alpha1.JobRunOverrides{},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.UserAccountServiceMock.AssertNotCalled(t, "IsUserInAccount", mock.Anything, mock.Anything)
+}
+
+func mockJobRunInProgress(m *serviceMocks, job db_queries.NeosyncApiJob, inProgress bool) {
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Describe", mock.Anything).Return(&temporalclient.ScheduleDescription{}, nil)
+	runs := []db_queries.NeosyncApiJobRun{}
+	if inProgress {
+		run := mockJobRun(job.AccountID, job.ID, time.Now().UTC())
+		run.Status = int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING)
+		runs = append(runs, run)
+	}
+	m.QuerierMock.On("GetJobRuns", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetJobRunsParams) bool {
+		return params.JobId == job.ID && len(params.Statuses) == 2
+	})).Return(runs, nil)
 }
 
 // CancelJobRun
@@ -164,6 +581,7 @@ func Test_CancelJobRun(t *testing.T) {
 	}}
 
 	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRun{}, sql.ErrNoRows)
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	temporalClientMock.On("CancelWorkflow", mock.Anything, workflowId, runId).Return(nil)
 
@@ -178,6 +596,275 @@ func Test_CancelJobRun(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+// TerminateJobRun
+func Test_TerminateJobRun(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	runId := uuid.NewString()
+	workflowId := uuid.NewString()
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{
+			WorkflowId: workflowId,
+			RunId:      runId,
+		},
+	}}
+
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRun{}, sql.ErrNoRows)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, workflowId, runId, mock.Anything).Return(nil)
+	m.QuerierMock.On("UpdateJobRunStatus", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.UpdateJobRunStatusParams) bool {
+		return params.ID == runId &&
+			params.AccountId == accountUuid &&
+			params.Status == int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED) &&
+			params.CompletedAt.Valid
+	})).Return(nil)
+
+	resp, err := m.Service.TerminateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.TerminateJobRunRequest{
+		JobRunId:  runId,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+// RecordJobRun
+func Test_RecordJobRun(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	startedAt := time.Date(2024, 9, 4, 10, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(time.Minute)
+	errorMessage := "unable to connect"
+
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("UpsertJobRun", mock.Anything, mock.Anything, db_queries.UpsertJobRunParams{
+		ID:          "job-run-id",
+		AccountId:   job.AccountID,
+		JobId:       job.ID,
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED),
+		StartedAt:   pgtype.Timestamp{Time: startedAt, Valid: true},
+		CompletedAt: pgtype.Timestamp{Time: completedAt, Valid: true},
+		Tables: []*pg_models.JobRunTableSummary{
+			{Schema: "public", Table: "users", RowsRead: 10, RowsWritten: 8, RowsErrored: 2},
+		},
+		ErrorMessage: pgtype.Text{String: errorMessage, Valid: true},
+	}).Return(nil)
+
+	resp, err := m.Service.RecordJobRun(getWorkerApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.RecordJobRunRequest{
+		JobRunId:    "job-run-id",
+		JobId:       nucleusdb.UUIDString(job.ID),
+		Status:      mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED,
+		StartedAt:   timestamppb.New(startedAt),
+		CompletedAt: timestamppb.New(completedAt),
+		Tables: []*mgmtv1alpha1.JobRunTableSummary{
+			{Schema: "public", Table: "users", RowsRead: 10, RowsWritten: 8, RowsErrored: 2},
+		},
+		ErrorMessage: &errorMessage,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_RecordJobRun_RequiresWorkerApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	_, err := m.Service.RecordJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.RecordJobRunRequest{
+		JobRunId:  "job-run-id",
+		JobId:     nucleusdb.UUIDString(job.ID),
+		Status:    mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+		StartedAt: timestamppb.Now(),
+	}))
+
+	require.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "UpsertJobRun", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// IngestJobRunLogs
+func Test_IngestJobRunLogs(t *testing.T) {
+	runlogtype := DatabaseRunLogType
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, RunLogConfig: &RunLogConfig{
+		IsEnabled:            true,
+		RunLogType:           &runlogtype,
+		DatabaseRunLogConfig: &DatabaseRunLogConfig{},
+	}})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	logTime := time.Date(2024, 9, 3, 10, 0, 0, 0, time.UTC)
+	activity := "Sync"
+	schema := "public"
+	table := "users"
+
+	m.QuerierMock.On("CreateJobRunLogs", mock.Anything, mock.Anything, db_queries.CreateJobRunLogsParams{
+		AccountId:   accountUuid,
+		JobRunId:    "job-run-id",
+		LogTimes:    []pgtype.Timestamp{{Time: logTime, Valid: true}, {Time: logTime, Valid: true}},
+		Levels:      []string{"ERROR", "INFO"},
+		Messages:    []string{"failed", "started"},
+		Activities:  []string{activity, ""},
+		SchemaNames: []string{schema, ""},
+		TableNames:  []string{table, ""},
+		Attributes:  []string{`{"attempt":"2"}`, `{}`},
+	}).Return(nil)
+
+	resp, err := m.Service.IngestJobRunLogs(getWorkerApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.IngestJobRunLogsRequest{
+		AccountId: mockAccountId,
+		JobRunId:  "job-run-id",
+		Entries: []*mgmtv1alpha1.JobRunLogEntry{
+			{
+				Timestamp:  timestamppb.New(logTime),
+				Level:      mgmtv1alpha1.LogLevel_LOG_LEVEL_ERROR,
+				Message:    "failed",
+				Activity:   &activity,
+				Schema:     &schema,
+				Table:      &table,
+				Attributes: map[string]string{"attempt": "2"},
+			},
+			{
+				Timestamp: timestamppb.New(logTime),
+				Message:   "started",
+			},
+		},
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_IngestJobRunLogs_NotDatabaseRunLogs(t *testing.T) {
+	runlogtype := LokiRunLogType
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, RunLogConfig: &RunLogConfig{
+		IsEnabled:  true,
+		RunLogType: &runlogtype,
+	}})
+
+	_, err := m.Service.IngestJobRunLogs(context.Background(), connect.NewRequest(&mgmtv1alpha1.IngestJobRunLogsRequest{
+		AccountId: mockAccountId,
+		JobRunId:  "job-run-id",
+		Entries:   []*mgmtv1alpha1.JobRunLogEntry{{Timestamp: timestamppb.Now(), Message: "started"}},
+	}))
+
+	require.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "CreateJobRunLogs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_IngestJobRunLogs_RequiresWorkerApiKey(t *testing.T) {
+	runlogtype := DatabaseRunLogType
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, RunLogConfig: &RunLogConfig{
+		IsEnabled:            true,
+		RunLogType:           &runlogtype,
+		DatabaseRunLogConfig: &DatabaseRunLogConfig{},
+	}})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	_, err := m.Service.IngestJobRunLogs(context.Background(), connect.NewRequest(&mgmtv1alpha1.IngestJobRunLogsRequest{
+		AccountId: mockAccountId,
+		JobRunId:  "job-run-id",
+		Entries:   []*mgmtv1alpha1.JobRunLogEntry{{Timestamp: timestamppb.Now(), Message: "started"}},
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "CreateJobRunLogs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_DeleteExpiredJobRunLogs(t *testing.T) {
+	runlogtype := DatabaseRunLogType
+	m := createServiceMock(t, &Config{RunLogConfig: &RunLogConfig{
+		IsEnabled:            true,
+		RunLogType:           &runlogtype,
+		DatabaseRunLogConfig: &DatabaseRunLogConfig{Retention: 24 * time.Hour},
+	}})
+
+	m.QuerierMock.On("DeleteJobRunLogsOlderThan", mock.Anything, mock.Anything, mock.MatchedBy(func(before pgtype.Timestamp) bool {
+		return before.Valid && time.Since(before.Time) >= 24*time.Hour && time.Since(before.Time) < 25*time.Hour
+	})).Return(int64(3), nil)
+
+	count, err := m.Service.DeleteExpiredJobRunLogs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
+func Test_DeleteExpiredJobRunLogs_NoRetention(t *testing.T) {
+	runlogtype := DatabaseRunLogType
+	m := createServiceMock(t, &Config{RunLogConfig: &RunLogConfig{
+		IsEnabled:            true,
+		RunLogType:           &runlogtype,
+		DatabaseRunLogConfig: &DatabaseRunLogConfig{},
+	}})
+
+	count, err := m.Service.DeleteExpiredJobRunLogs(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func Test_ReconcileJobRuns(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	jobUuid, _ := nucleusdb.ToUuid(uuid.NewString())
+
+	timedOutRun := mockJobRun(accountUuid, jobUuid, time.Now().Add(-2*time.Hour).UTC())
+	timedOutRun.Status = int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING)
+	runningRun := mockJobRun(accountUuid, jobUuid, time.Now().Add(-time.Hour).UTC())
+	runningRun.Status = int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING)
+	closedElsewhereRun := mockJobRun(accountUuid, jobUuid, time.Now().Add(-time.Hour).UTC())
+	closedElsewhereRun.Status = int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING)
+
+	m.QuerierMock.On("GetJobRunsByStatus", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetJobRunsByStatusParams) bool {
+		return len(params.Statuses) == 2 && time.Since(params.StartedBefore.Time) >= reconcileJobRunsMinAge
+	})).Return([]db_queries.NeosyncApiJobRun{timedOutRun, runningRun, closedElsewhereRun}, nil)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil).Once()
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, timedOutRun.ID, "").
+		Return(getDescribeWorkflowExecutionResponseWithStatus(timedOutRun.ID, enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT), nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, runningRun.ID, "").
+		Return(getDescribeWorkflowExecutionResponseWithStatus(runningRun.ID, enums.WORKFLOW_EXECUTION_STATUS_RUNNING), nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, closedElsewhereRun.ID, "").
+		Return(getDescribeWorkflowExecutionResponseWithStatus(closedElsewhereRun.ID, enums.WORKFLOW_EXECUTION_STATUS_COMPLETED), nil)
+	m.QuerierMock.On("CloseJobRun", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CloseJobRunParams) bool {
+		return params.ID == timedOutRun.ID && params.Status == int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT)
+	})).Return(int64(1), nil)
+	m.QuerierMock.On("CloseJobRun", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CloseJobRunParams) bool {
+		return params.ID == closedElsewhereRun.ID && params.Status == int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE)
+	})).Return(int64(0), nil)
+
+	timedOut, err := m.Service.ReconcileJobRuns(context.Background(), slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{})))
+	require.NoError(t, err)
+	require.Len(t, timedOut, 1)
+	require.Equal(t, timedOutRun.ID, timedOut[0].ID)
+	require.Equal(t, int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT), timedOut[0].Status)
+	require.True(t, timedOut[0].ErrorMessage.Valid)
+	m.QuerierMock.AssertNotCalled(t, "CloseJobRun", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CloseJobRunParams) bool {
+		return params.ID == runningRun.ID
+	}))
+}
+
+func getDescribeWorkflowExecutionResponseWithStatus(workflowId string, status enums.WorkflowExecutionStatus) *workflowservice.DescribeWorkflowExecutionResponse {
+	resp := getDescribeWorkflowExecutionResponseMock(uuid.NewString(), workflowId)
+	resp.WorkflowExecutionInfo.Status = status
+	return resp
+}
+
+func mockJobRun(accountUuid, jobUuid pgtype.UUID, startedAt time.Time) db_queries.NeosyncApiJobRun {
+	return db_queries.NeosyncApiJobRun{
+		ID:          uuid.NewString(),
+		AccountID:   accountUuid,
+		JobID:       jobUuid,
+		Status:      int16(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE),
+		StartedAt:   pgtype.Timestamp{Time: startedAt, Valid: true},
+		CompletedAt: pgtype.Timestamp{Time: startedAt.Add(time.Minute), Valid: true},
+		Tables: []*pg_models.JobRunTableSummary{
+			{Schema: "public", Table: "users", RowsRead: 10, RowsWritten: 10},
+		},
+		TriggeredBy: pgtype.Text{String: mockUserId, Valid: true},
+	}
+}
+
 func mockGetVerifiedJobRun(
 	temporalWfManagerMock *clientmanager.MockTemporalClientManagerClient,
 	accountUuid pgtype.UUID,
@@ -292,3 +979,97 @@ func Test_getLogLevelFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_toJobRunEventProgressDto(t *testing.T) {
+	startedAt := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := startedAt.Add(10 * time.Second)
+	totalRows := int64(300)
+	progress := &benthos_metrics.Progress{RowsRead: 110, RowsWritten: 100, RowsErrored: 1, BytesProcessed: 2048}
+
+	actual := toJobRunEventProgressDto(progress, startedAt, updatedAt, &totalRows)
+	require.Equal(t, int64(110), actual.GetRowsRead())
+	require.Equal(t, int64(100), actual.GetRowsWritten())
+	require.Equal(t, int64(1), actual.GetRowsErrored())
+	require.Equal(t, int64(2048), actual.GetBytesProcessed())
+	require.Equal(t, totalRows, actual.GetTotalRows())
+	require.Equal(t, float64(10), actual.GetRowsPerSecond())
+	require.Equal(t, updatedAt.Add(20*time.Second), actual.GetEstimatedCompletionTime().AsTime())
+	require.Equal(t, updatedAt, actual.GetUpdatedAt().AsTime())
+}
+
+func Test_toJobRunEventProgressDto_UnknownTotal(t *testing.T) {
+	startedAt := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	progress := &benthos_metrics.Progress{RowsRead: 100, RowsWritten: 100}
+
+	actual := toJobRunEventProgressDto(progress, startedAt, startedAt.Add(20*time.Second), nil)
+	require.Equal(t, float64(5), actual.GetRowsPerSecond())
+	require.Nil(t, actual.TotalRows)
+	require.Nil(t, actual.GetEstimatedCompletionTime())
+
+	actual = toJobRunEventProgressDto(progress, startedAt, startedAt, nil)
+	require.Zero(t, actual.GetRowsPerSecond())
+}
+
+func Test_sourceTableRowCounter_CachedPerRunWithOverrides(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	jobUuid, _ := nucleusdb.ToUuid(uuid.NewString())
+	connectionId := uuid.NewString()
+	jobWhere := "id > 10"
+	overrideWhere := "id > 100"
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, jobUuid).Return(db_queries.NeosyncApiJob{
+		ID:        jobUuid,
+		AccountID: accountUuid,
+		ConnectionOptions: &pg_models.JobSourceOptions{
+			PostgresOptions: &pg_models.PostgresSourceOptions{
+				ConnectionId: connectionId,
+				Schemas: []*pg_models.PostgresSourceSchemaOption{
+					{Schema: "public", Tables: []*pg_models.PostgresSourceTableOption{
+						{Table: "users", WhereClause: &jobWhere},
+						{Table: "orders", WhereClause: &jobWhere},
+					}},
+				},
+			},
+		},
+	}, nil).Once()
+	m.QuerierMock.On("GetJobRunById", mock.Anything, mock.Anything, db_queries.GetJobRunByIdParams{ID: "job-run-id", AccountId: accountUuid}).
+		Return(db_queries.NeosyncApiJobRun{
+			ID: "job-run-id",
+			Overrides: &pg_models.JobRunOverrides{Tables: []*pg_models.JobRunTableOverride{
+				{Schema: "public", Table: "users", WhereClause: &overrideWhere},
+			}},
+		}, nil).Once()
+	connection := &mgmtv1alpha1.Connection{Id: connectionId}
+	m.ConnectionServiceClientMock.On("GetConnectionUnmasked", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{Connection: connection}), nil)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, connection, mock.Anything).
+		Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return()
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "public", "users", &overrideWhere).Return(int64(5), nil).Once()
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "public", "orders", &jobWhere).Return(int64(7), nil).Once()
+
+	verifResp := &getVerifiedJobRunResponse{
+		WorkflowExecution: getWorfklowExecutionInfoMock(nucleusdb.UUIDString(jobUuid), "job-run-id"),
+		NeosyncAccountId:  mockAccountId,
+	}
+	for i := 0; i < 3; i++ {
+		// every poll of the run shares the counter, so each table is only counted once
+		counter := m.Service.rowCounters.Get(m.Service, verifResp, "job-run-id")
+		require.Equal(t, int64(5), *counter.GetRowCount(ctx, logger, "public", "users"))
+		require.Equal(t, int64(7), *counter.GetRowCount(ctx, logger, "public", "orders"))
+	}
+
+	counter := m.Service.rowCounters.Get(m.Service, verifResp, "job-run-id")
+	m.Service.rowCounters.Delete(mockAccountId, "job-run-id")
+	require.Empty(t, m.Service.rowCounters.counters)
+	require.NotSame(t, counter, m.Service.rowCounters.Get(m.Service, verifResp, "job-run-id"))
+}
+
+func getWorkerApiKeyCtx() context.Context {
+	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.WorkerApiKey,
+	})
+}
@@ -1,6 +1,8 @@
 package v1alpha1_jobservice
 
 import (
+	"time"
+
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
@@ -15,6 +17,11 @@ type Service struct {
 	sqlmanager         sql_manager.SqlManagerClient
 
 	temporalWfManager clientmanager.TemporalClientManagerClient
+
+	// source table row counts of running jobs, shared by every request that reports the progress of a run
+	rowCounters *sourceTableRowCounterCache
+	// accounts whose runs started before the run history was kept have been recorded
+	backfilledAccounts *backfilledJobRunAccounts
 }
 
 type RunLogType string
@@ -22,6 +29,8 @@ type RunLogType string
 const (
 	KubePodRunLogType RunLogType = "k8s-pods"
 	LokiRunLogType    RunLogType = "loki"
+	// Run logs are shipped by the worker to the API and stored in the Neosync database
+	DatabaseRunLogType RunLogType = "database"
 )
 
 type KubePodRunLogConfig struct {
@@ -37,6 +46,11 @@ type LokiRunLogConfig struct {
 	KeepLabels []string
 }
 
+type DatabaseRunLogConfig struct {
+	// How long run logs are kept before they are pruned. Zero keeps them forever.
+	Retention time.Duration
+}
+
 type Config struct {
 	IsAuthEnabled  bool
 	IsNeosyncCloud bool
@@ -45,10 +59,11 @@ type Config struct {
 }
 
 type RunLogConfig struct {
-	IsEnabled        bool
-	RunLogType       *RunLogType
-	RunLogPodConfig  *KubePodRunLogConfig // required if RunLogType is k8s-pods
-	LokiRunLogConfig *LokiRunLogConfig    // required if RunLogType is loki
+	IsEnabled            bool
+	RunLogType           *RunLogType
+	RunLogPodConfig      *KubePodRunLogConfig  // required if RunLogType is k8s-pods
+	LokiRunLogConfig     *LokiRunLogConfig     // required if RunLogType is loki
+	DatabaseRunLogConfig *DatabaseRunLogConfig // required if RunLogType is database
 }
 
 func New(
@@ -66,5 +81,7 @@ func New(
 		connectionService:  connectionService,
 		useraccountService: useraccountService,
 		sqlmanager:         sqlmanager,
+		rowCounters:        newSourceTableRowCounterCache(),
+		backfilledAccounts: newBackfilledJobRunAccounts(),
 	}
 }
@@ -0,0 +1,228 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/notifications"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) GetNotificationChannels(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetNotificationChannelsRequest],
+) (*connect.Response[mgmtv1alpha1.GetNotificationChannelsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := s.db.Q.GetNotificationChannelsByAccount(ctx, s.db.Db, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.NotificationChannel, 0, len(channels))
+	for idx := range channels {
+		dto, err := dtomaps.ToNotificationChannelDto(&channels[idx])
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, redact.Message(dto))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetNotificationChannelsResponse{
+		Channels: dtos,
+	}), nil
+}
+
+func (s *Service) GetNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetNotificationChannelRequest],
+) (*connect.Response[mgmtv1alpha1.GetNotificationChannelResponse], error) {
+	channel, err := s.getNotificationChannel(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	dto, err := dtomaps.ToNotificationChannelDto(channel)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetNotificationChannelResponse{
+		Channel: redact.Message(dto),
+	}), nil
+}
+
+func (s *Service) CreateNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateNotificationChannelRequest],
+) (*connect.Response[mgmtv1alpha1.CreateNotificationChannelResponse], error) {
+	config := &pg_models.NotificationChannelConfig{}
+	if err := config.FromDto(req.Msg.GetConfig()); err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.db.Q.CreateNotificationChannel(ctx, s.db.Db, db_queries.CreateNotificationChannelParams{
+		AccountID:   *accountUuid,
+		Name:        req.Msg.GetName(),
+		Config:      config,
+		CreatedByID: *userUuid,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("a notification channel with the name %s already exists", req.Msg.GetName()))
+	} else if err != nil {
+		return nil, err
+	}
+
+	dto, err := dtomaps.ToNotificationChannelDto(&channel)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateNotificationChannelResponse{
+		Channel: redact.Message(dto),
+	}), nil
+}
+
+func (s *Service) UpdateNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.UpdateNotificationChannelRequest],
+) (*connect.Response[mgmtv1alpha1.UpdateNotificationChannelResponse], error) {
+	channel, err := s.getNotificationChannel(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	storedChannel, err := dtomaps.ToNotificationChannelDto(channel)
+	if err != nil {
+		return nil, err
+	}
+	// secrets are masked in responses, keep the stored secret when the client sends the masked placeholder back
+	configDto, err := redact.Restore(req.Msg.GetConfig(), storedChannel.GetConfig())
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+	config := &pg_models.NotificationChannelConfig{}
+	if err := config.FromDto(configDto); err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
+	updated, err := s.db.Q.UpdateNotificationChannel(ctx, s.db.Db, db_queries.UpdateNotificationChannelParams{
+		ID:          channel.ID,
+		Name:        req.Msg.GetName(),
+		Config:      config,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("a notification channel with the name %s already exists", req.Msg.GetName()))
+	} else if err != nil {
+		return nil, err
+	}
+
+	dto, err := dtomaps.ToNotificationChannelDto(&updated)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.UpdateNotificationChannelResponse{
+		Channel: redact.Message(dto),
+	}), nil
+}
+
+func (s *Service) DeleteNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteNotificationChannelRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteNotificationChannelResponse], error) {
+	idUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.db.Q.GetNotificationChannelById(ctx, s.db.Db, idUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return connect.NewResponse(&mgmtv1alpha1.DeleteNotificationChannelResponse{}), nil
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(channel.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Q.RemoveNotificationChannelById(ctx, s.db.Db, channel.ID); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteNotificationChannelResponse{}), nil
+}
+
+func (s *Service) SendTestNotification(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SendTestNotificationRequest],
+) (*connect.Response[mgmtv1alpha1.SendTestNotificationResponse], error) {
+	channel, err := s.getNotificationChannel(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	config, err := channel.Config.ToDto()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.notifier.Send(ctx, config, &notifications.Event{
+		Id:         uuid.NewString(),
+		Type:       "test",
+		AccountId:  nucleusdb.UUIDString(channel.AccountID),
+		Message:    fmt.Sprintf("This is a test notification sent to the %s notification channel", channel.Name),
+		OccurredAt: time.Now().UTC(),
+		IsTest:     true,
+	})
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SendTestNotificationResponse{}), nil
+}
+
+// Returns the notification channel including its secrets
+func (s *Service) getNotificationChannel(
+	ctx context.Context,
+	id string,
+) (*db_queries.NeosyncApiNotificationChannel, error) {
+	idUuid, err := nucleusdb.ToUuid(id)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.db.Q.GetNotificationChannelById(ctx, s.db.Db, idUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find notification channel by id")
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(channel.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
@@ -0,0 +1,336 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/notifications"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/redact"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockAccountId = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockUserId    = "d5e29f1f-b920-458c-8b86-f3a180e06d98"
+	mockJobId     = "9e9ec62a-e0f8-4f0b-bf04-7de327ab6f9c"
+	mockChannelId = "0d1e5a3c-6e7f-4b7a-9f5e-3c1f7a2b8d4e"
+)
+
+type serviceMocks struct {
+	Service                *Service
+	DbtxMock               *nucleusdb.MockDBTX
+	QuerierMock            *db_queries.MockQuerier
+	UserAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient
+	Notifier               *fakeNotifier
+}
+
+type sentNotification struct {
+	Config *mgmtv1alpha1.NotificationChannelConfig
+	Event  *notifications.Event
+}
+
+type fakeNotifier struct {
+	sent []*sentNotification
+	err  error
+}
+
+func (f *fakeNotifier) Send(_ context.Context, config *mgmtv1alpha1.NotificationChannelConfig, event *notifications.Event) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, &sentNotification{Config: config, Event: event})
+	return nil
+}
+
+func createServiceMock(t *testing.T, config *Config) *serviceMocks {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	notifier := &fakeNotifier{}
+
+	service := New(config, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService, notifier)
+	return &serviceMocks{
+		Service:                service,
+		DbtxMock:               mockDbtx,
+		QuerierMock:            mockQuerier,
+		UserAccountServiceMock: mockUserAccountService,
+		Notifier:               notifier,
+	}
+}
+
+func Test_GetNotificationChannel_MasksSecrets(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	channel := mockWebhookChannel(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+
+	resp, err := m.Service.GetNotificationChannel(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetNotificationChannelRequest{
+		Id: mockChannelId,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "alerts", resp.Msg.GetChannel().GetName())
+	require.Equal(t, redact.RedactedValue, resp.Msg.GetChannel().GetConfig().GetWebhook().GetSigningSecret())
+	require.Equal(t, redact.RedactedValue, resp.Msg.GetChannel().GetConfig().GetWebhook().GetUrl())
+}
+
+func Test_GetNotificationChannel_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiNotificationChannel{}, pgx.ErrNoRows)
+
+	_, err := m.Service.GetNotificationChannel(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetNotificationChannelRequest{
+		Id: mockChannelId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func Test_UpdateNotificationChannel_KeepsMaskedSecrets(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	channel := mockWebhookChannel(t)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+	m.QuerierMock.On("UpdateNotificationChannel", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.UpdateNotificationChannelParams) bool {
+		return params.Name == "renamed" &&
+			params.Config.Webhook.Url == "https://example.com/hook" &&
+			params.Config.Webhook.SigningSecret == "super-secret-signing-key"
+	})).Return(channel, nil)
+
+	_, err := m.Service.UpdateNotificationChannel(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateNotificationChannelRequest{
+		Id:   mockChannelId,
+		Name: "renamed",
+		Config: &mgmtv1alpha1.NotificationChannelConfig{
+			Config: &mgmtv1alpha1.NotificationChannelConfig_Webhook{
+				Webhook: &mgmtv1alpha1.WebhookNotificationConfig{Url: redact.RedactedValue, SigningSecret: redact.RedactedValue},
+			},
+		},
+	}))
+	require.NoError(t, err)
+}
+
+func Test_SendTestNotification(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	channel := mockWebhookChannel(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+
+	_, err := m.Service.SendTestNotification(context.Background(), connect.NewRequest(&mgmtv1alpha1.SendTestNotificationRequest{
+		Id: mockChannelId,
+	}))
+	require.NoError(t, err)
+	require.Len(t, m.Notifier.sent, 1)
+	require.True(t, m.Notifier.sent[0].Event.IsTest)
+	require.Equal(t, "super-secret-signing-key", m.Notifier.sent[0].Config.GetWebhook().GetSigningSecret())
+}
+
+func Test_SendTestNotification_DeliveryError(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.Notifier.err = errors.New("connection refused")
+	channel := mockWebhookChannel(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+
+	_, err := m.Service.SendTestNotification(context.Background(), connect.NewRequest(&mgmtv1alpha1.SendTestNotificationRequest{
+		Id: mockChannelId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_SetJobNotificationSubscriptions(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	job := mockJob(t)
+	channel := mockWebhookChannel(t)
+	mockTx := new(nucleusdb.MockTx)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	m.QuerierMock.On("RemoveJobNotificationSubscriptions", mock.Anything, mockTx, job.ID).Return(nil)
+	subscription := db_queries.CreateJobNotificationSubscriptionParams{
+		JobID:     job.ID,
+		ChannelID: channel.ID,
+		Events:    []int16{3, 4},
+	}
+	m.QuerierMock.On("CreateJobNotificationSubscription", mock.Anything, mockTx, subscription).Return(nil)
+	m.QuerierMock.On("GetJobNotificationSubscriptions", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobNotificationSubscription{
+		{JobID: job.ID, ChannelID: channel.ID, Events: []int16{3, 4}},
+	}, nil)
+
+	resp, err := m.Service.SetJobNotificationSubscriptions(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobNotificationSubscriptionsRequest{
+		JobId: mockJobId,
+		Subscriptions: []*mgmtv1alpha1.JobNotificationSubscription{
+			{
+				ChannelId: mockChannelId,
+				Events: []mgmtv1alpha1.NotificationEventType{
+					mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED,
+					mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT,
+					mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED,
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetSubscriptions(), 1)
+	require.Equal(t, mockChannelId, resp.Msg.GetSubscriptions()[0].GetChannelId())
+}
+
+func Test_SetJobNotificationSubscriptions_ChannelInOtherAccount(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	job := mockJob(t)
+	channel := mockWebhookChannel(t)
+	otherAccount, err := nucleusdb.ToUuid("7bc7ee6a-3a3c-4a8e-93a4-6b2c0e3e5d9f")
+	require.NoError(t, err)
+	channel.AccountID = otherAccount
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetNotificationChannelById", mock.Anything, mock.Anything, channel.ID).Return(channel, nil)
+
+	_, err = m.Service.SetJobNotificationSubscriptions(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobNotificationSubscriptionsRequest{
+		JobId: mockJobId,
+		Subscriptions: []*mgmtv1alpha1.JobNotificationSubscription{
+			{ChannelId: mockChannelId, Events: []mgmtv1alpha1.NotificationEventType{mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED}},
+		},
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_SendJobRunNotification(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	job := mockJob(t)
+	channel := mockWebhookChannel(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetNotificationChannelsByJobEvent", mock.Anything, mock.Anything, db_queries.GetNotificationChannelsByJobEventParams{
+		JobId:     job.ID,
+		EventType: int16(mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED),
+	}).Return([]db_queries.NeosyncApiNotificationChannel{channel}, nil)
+
+	errorMessage := "connection refused"
+	resp, err := m.Service.SendJobRunNotification(context.Background(), connect.NewRequest(&mgmtv1alpha1.SendJobRunNotificationRequest{
+		JobId:        mockJobId,
+		JobRunId:     "job-run-id",
+		EventType:    mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED,
+		ErrorMessage: &errorMessage,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.Msg.GetDeliveredCount())
+	require.Len(t, m.Notifier.sent, 1)
+	event := m.Notifier.sent[0].Event
+	require.Equal(t, "job_run.failed", event.Type)
+	require.Equal(t, "job-run-id", event.JobRunId)
+	require.Equal(t, "Job nightly-anonymize failed", event.Message)
+	require.Equal(t, errorMessage, *event.ErrorMessage)
+}
+
+func Test_SendJobRunNotification_DeliveryError(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.Notifier.err = errors.New("connection refused")
+	job := mockJob(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetNotificationChannelsByJobEvent", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiNotificationChannel{mockWebhookChannel(t)}, nil)
+
+	_, err := m.Service.SendJobRunNotification(context.Background(), connect.NewRequest(&mgmtv1alpha1.SendJobRunNotificationRequest{
+		JobId:     mockJobId,
+		JobRunId:  "job-run-id",
+		EventType: mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED,
+	}))
+	require.Error(t, err)
+}
+
+func Test_NotifyJobRun(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(t)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetNotificationChannelsByJobEvent", mock.Anything, mock.Anything, db_queries.GetNotificationChannelsByJobEventParams{
+		JobId:     job.ID,
+		EventType: int16(mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT),
+	}).Return([]db_queries.NeosyncApiNotificationChannel{mockWebhookChannel(t)}, nil)
+
+	err := m.Service.NotifyJobRun(
+		context.Background(),
+		slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{})),
+		mockJobId,
+		"job-run-id",
+		mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, m.Notifier.sent, 1)
+	require.Equal(t, "job_run.timed_out", m.Notifier.sent[0].Event.Type)
+	m.UserAccountServiceMock.AssertNotCalled(t, "IsUserInAccount", mock.Anything, mock.Anything)
+}
+
+func Test_SendJobRunNotification_RequiresWorkerApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	_, err := m.Service.SendJobRunNotification(context.Background(), connect.NewRequest(&mgmtv1alpha1.SendJobRunNotificationRequest{
+		JobId:     mockJobId,
+		JobRunId:  "job-run-id",
+		EventType: mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func mockJob(t *testing.T) db_queries.NeosyncApiJob {
+	t.Helper()
+	return db_queries.NeosyncApiJob{
+		ID:        mustUuid(t, mockJobId),
+		AccountID: mustUuid(t, mockAccountId),
+		Name:      "nightly-anonymize",
+	}
+}
+
+func mockWebhookChannel(t *testing.T) db_queries.NeosyncApiNotificationChannel {
+	t.Helper()
+	return db_queries.NeosyncApiNotificationChannel{
+		ID:        mustUuid(t, mockChannelId),
+		AccountID: mustUuid(t, mockAccountId),
+		Name:      "alerts",
+		Config: &pg_models.NotificationChannelConfig{
+			Webhook: &pg_models.WebhookNotificationConfig{
+				Url:           "https://example.com/hook",
+				SigningSecret: "super-secret-signing-key",
+			},
+		},
+		CreatedByID: mustUuid(t, mockUserId),
+		UpdatedByID: mustUuid(t, mockUserId),
+	}
+}
+
+func mustUuid(t *testing.T, id string) pgtype.UUID {
+	t.Helper()
+	value, err := nucleusdb.ToUuid(id)
+	require.NoError(t, err)
+	return value
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func mockUserAccountCalls(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	mockIsUserInAccount(userAccountServiceMock, isInAccount)
+	userAccountServiceMock.On("GetUser", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetUserResponse{
+		UserId: mockUserId,
+	}), nil)
+}
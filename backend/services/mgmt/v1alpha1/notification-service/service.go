@@ -0,0 +1,33 @@
+package v1alpha1_notificationservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/notifications"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+type Service struct {
+	cfg                *Config
+	db                 *nucleusdb.NucleusDb
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+	notifier           notifications.Sender
+}
+
+type Config struct {
+	IsAuthEnabled  bool
+	IsNeosyncCloud bool
+}
+
+func New(
+	cfg *Config,
+	db *nucleusdb.NucleusDb,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	notifier notifications.Sender,
+) *Service {
+	return &Service{
+		cfg:                cfg,
+		db:                 db,
+		useraccountService: useraccountService,
+		notifier:           notifier,
+	}
+}
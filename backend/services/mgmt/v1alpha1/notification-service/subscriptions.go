@@ -0,0 +1,245 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/notifications"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GetJobNotificationSubscriptions(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobNotificationSubscriptionsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobNotificationSubscriptionsResponse], error) {
+	job, err := s.getJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.db.Q.GetJobNotificationSubscriptions(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobNotificationSubscription, 0, len(subscriptions))
+	for idx := range subscriptions {
+		dtos = append(dtos, dtomaps.ToJobNotificationSubscriptionDto(&subscriptions[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobNotificationSubscriptionsResponse{
+		Subscriptions: dtos,
+	}), nil
+}
+
+func (s *Service) SetJobNotificationSubscriptions(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobNotificationSubscriptionsRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobNotificationSubscriptionsResponse], error) {
+	job, err := s.getJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]db_queries.CreateJobNotificationSubscriptionParams, 0, len(req.Msg.GetSubscriptions()))
+	seenChannels := map[string]struct{}{}
+	for _, subscription := range req.Msg.GetSubscriptions() {
+		if _, ok := seenChannels[subscription.GetChannelId()]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("notification channel %s may only be subscribed to once", subscription.GetChannelId()))
+		}
+		seenChannels[subscription.GetChannelId()] = struct{}{}
+
+		channelUuid, err := nucleusdb.ToUuid(subscription.GetChannelId())
+		if err != nil {
+			return nil, err
+		}
+		channel, err := s.db.Q.GetNotificationChannelById(ctx, s.db.Db, channelUuid)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if (err != nil && nucleusdb.IsNoRows(err)) || channel.AccountID != job.AccountID {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to find notification channel %s in the job's account", subscription.GetChannelId()))
+		}
+
+		params = append(params, db_queries.CreateJobNotificationSubscriptionParams{
+			JobID:     job.ID,
+			ChannelID: channelUuid,
+			Events:    toEventValues(subscription.GetEvents()),
+		})
+	}
+
+	if err := s.db.SetJobNotificationSubscriptions(ctx, job.ID, params); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.db.Q.GetJobNotificationSubscriptions(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*mgmtv1alpha1.JobNotificationSubscription, 0, len(subscriptions))
+	for idx := range subscriptions {
+		dtos = append(dtos, dtomaps.ToJobNotificationSubscriptionDto(&subscriptions[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobNotificationSubscriptionsResponse{
+		Subscriptions: dtos,
+	}), nil
+}
+
+func (s *Service) SendJobRunNotification(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SendJobRunNotificationRequest],
+) (*connect.Response[mgmtv1alpha1.SendJobRunNotificationResponse], error) {
+	// job run notifications may only be sent by the worker
+	if s.cfg.IsAuthEnabled && !isWorkerApiKey(ctx) {
+		return nil, nucleuserrors.NewUnauthenticated("must provide valid authentication credentials for this endpoint")
+	}
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx).With("jobId", req.Msg.GetJobId(), "jobRunId", req.Msg.GetJobRunId())
+
+	job, err := s.getJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveredCount, err := s.notifyJobRun(ctx, logger, job, req.Msg.GetJobRunId(), req.Msg.GetEventType(), req.Msg.ErrorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SendJobRunNotificationResponse{
+		DeliveredCount: deliveredCount,
+	}), nil
+}
+
+// Sends a job run notification on behalf of the backend itself.
+// Used for events that the worker is unable to emit, such as a run hitting its run timeout.
+func (s *Service) NotifyJobRun(
+	ctx context.Context,
+	logger *slog.Logger,
+	jobId string,
+	jobRunId string,
+	eventType mgmtv1alpha1.NotificationEventType,
+	errorMessage *string,
+) error {
+	jobUuid, err := nucleusdb.ToUuid(jobId)
+	if err != nil {
+		return err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil {
+		return err
+	}
+	_, err = s.notifyJobRun(ctx, logger.With("jobId", jobId, "jobRunId", jobRunId), &job, jobRunId, eventType, errorMessage)
+	return err
+}
+
+func (s *Service) notifyJobRun(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	jobRunId string,
+	eventType mgmtv1alpha1.NotificationEventType,
+	errorMessage *string,
+) (uint32, error) {
+	channels, err := s.db.Q.GetNotificationChannelsByJobEvent(ctx, s.db.Db, db_queries.GetNotificationChannelsByJobEventParams{
+		JobId:     job.ID,
+		EventType: int16(eventType),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(channels) == 0 {
+		return 0, nil
+	}
+
+	event := &notifications.Event{
+		Id:           uuid.NewString(),
+		Type:         notifications.EventTypeName(eventType),
+		AccountId:    nucleusdb.UUIDString(job.AccountID),
+		JobId:        nucleusdb.UUIDString(job.ID),
+		JobName:      job.Name,
+		JobRunId:     jobRunId,
+		Message:      getEventMessage(eventType, job.Name),
+		ErrorMessage: errorMessage,
+		OccurredAt:   time.Now().UTC(),
+	}
+
+	var deliveredCount uint32
+	sendErrs := []error{}
+	for idx := range channels {
+		channel := channels[idx]
+		config, err := channel.Config.ToDto()
+		if err == nil {
+			err = s.notifier.Send(ctx, config, event)
+		}
+		if err != nil {
+			logger.Warn(fmt.Sprintf("unable to deliver notification to channel: %s", err.Error()), "channelId", nucleusdb.UUIDString(channel.ID))
+			sendErrs = append(sendErrs, fmt.Errorf("channel %s: %w", channel.Name, err))
+			continue
+		}
+		deliveredCount++
+	}
+	if len(sendErrs) > 0 {
+		return deliveredCount, fmt.Errorf("unable to deliver notification to %d of %d channels: %w", len(sendErrs), len(channels), errors.Join(sendErrs...))
+	}
+	logger.Debug("delivered job run notification", "event", event.Type, "channels", deliveredCount)
+	return deliveredCount, nil
+}
+
+func (s *Service) getJob(
+	ctx context.Context,
+	jobId string,
+) (*db_queries.NeosyncApiJob, error) {
+	jobUuid, err := nucleusdb.ToUuid(jobId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func toEventValues(events []mgmtv1alpha1.NotificationEventType) []int16 {
+	values := make([]int16, 0, len(events))
+	seen := map[mgmtv1alpha1.NotificationEventType]struct{}{}
+	for _, event := range events {
+		if _, ok := seen[event]; ok {
+			continue
+		}
+		seen[event] = struct{}{}
+		values = append(values, int16(event))
+	}
+	return values
+}
+
+func getEventMessage(eventType mgmtv1alpha1.NotificationEventType, jobName string) string {
+	switch eventType {
+	case mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED:
+		return fmt.Sprintf("Job %s started", jobName)
+	case mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED:
+		return fmt.Sprintf("Job %s completed successfully", jobName)
+	case mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED:
+		return fmt.Sprintf("Job %s failed", jobName)
+	case mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT:
+		return fmt.Sprintf("Job %s timed out", jobName)
+	case mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED:
+		return fmt.Sprintf("The source schema of job %s no longer matches its mappings", jobName)
+	default:
+		return fmt.Sprintf("Job %s: %s", jobName, eventType.String())
+	}
+}
@@ -13,7 +13,9 @@ import (
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	authjwt "github.com/nucleuscloud/neosync/backend/internal/auth/jwt"
 	"github.com/nucleuscloud/neosync/backend/internal/auth/tokenctx"
+	audit_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/audit"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
@@ -199,6 +201,7 @@ func (s *Service) IsUserInAccount(
 	if err != nil {
 		return nil, err
 	}
+	audit_interceptor.SetAccountIdContext(ctx, req.Msg.AccountId)
 	apiKeyCount, err := s.db.Q.IsUserInAccountApiKey(ctx, s.db.Db, db_queries.IsUserInAccountApiKeyParams{
 		AccountId: accountId,
 		UserId:    userId,
@@ -218,6 +221,11 @@ func (s *Service) IsUserInAccount(
 	if err != nil {
 		return nil, err
 	}
+	if count > 0 {
+		if err := s.verifyAccountRole(ctx, accountId, userId); err != nil {
+			return nil, err
+		}
+	}
 	return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
 		Ok: count > 0,
 	}), nil
@@ -275,7 +283,8 @@ func (s *Service) GetTeamAccountMembers(
 		user := userIdentities[i]
 		group.Go(func() error {
 			dtoUsers[i] = &mgmtv1alpha1.AccountUser{
-				Id: nucleusdb.UUIDString(user.UserID),
+				Id:   nucleusdb.UUIDString(user.UserID),
+				Role: mgmtv1alpha1.AccountRole(user.Role),
 			}
 			if user.ProviderSub == "" {
 				logger.Warn(fmt.Sprintf("unable to find provider sub associated with user id: %q", nucleusdb.UUIDString(user.UserID)))
@@ -319,17 +328,37 @@ func (s *Service) RemoveTeamAccountMember(
 	if err != nil {
 		return nil, err
 	}
-	err = s.db.Q.RemoveAccountUser(ctx, s.db.Db, db_queries.RemoveAccountUserParams{
-		AccountId: *accountId,
-		UserId:    memberUserId,
-	})
-	if err != nil && !nucleusdb.IsNoRows(err) {
+	err = s.db.RemoveAccountUser(ctx, *accountId, memberUserId)
+	if err != nil {
 		return nil, err
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.RemoveTeamAccountMemberResponse{}), nil
 }
 
+func (s *Service) SetTeamMemberRole(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetTeamMemberRoleRequest],
+) (*connect.Response[mgmtv1alpha1.SetTeamMemberRoleResponse], error) {
+	accountId, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyTeamAccount(ctx, *accountId); err != nil {
+		return nil, err
+	}
+	memberUserId, err := nucleusdb.ToUuid(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SetAccountUserRole(ctx, *accountId, memberUserId, req.Msg.Role)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetTeamMemberRoleResponse{}), nil
+}
+
 func (s *Service) InviteUserToTeamAccount(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest],
@@ -358,7 +387,12 @@ func (s *Service) InviteUserToTeamAccount(
 		return nil, err
 	}
 
-	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt)
+	role := req.Msg.GetRole()
+	if role == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		role = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER
+	}
+
+	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt, role)
 	if err != nil {
 		return nil, err
 	}
@@ -521,6 +555,31 @@ func (s *Service) verifyUserInAccount(
 	return &accountUuid, nil
 }
 
+// Verifies the user's role in the account satisfies the role required by the requested procedure.
+// Account api keys are not bound to a role and are not checked here.
+func (s *Service) verifyAccountRole(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	userId pgtype.UUID,
+) error {
+	requiredRole, ok := rbac_interceptor.GetRequiredRoleFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	association, err := s.db.Q.GetAccountUserAssociation(ctx, s.db.Db, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountId,
+		UserId:    userId,
+	})
+	if err != nil {
+		return err
+	}
+	role := mgmtv1alpha1.AccountRole(association.Role)
+	if !rbac_interceptor.IsRoleAllowed(role, requiredRole) {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("account role %s is not permitted to perform this action, requires at least %s", role, requiredRole))
+	}
+	return nil
+}
+
 func (s *Service) GetSystemInformation(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetSystemInformationRequest]) (*connect.Response[mgmtv1alpha1.GetSystemInformationResponse], error) {
 	versionInfo := version.Get()
 	builtDate, err := time.Parse(time.RFC3339, versionInfo.BuildDate)
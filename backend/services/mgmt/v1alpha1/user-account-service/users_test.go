@@ -17,6 +17,7 @@ import (
 	auth_client "github.com/nucleuscloud/neosync/backend/internal/auth/client"
 	authjwt "github.com/nucleuscloud/neosync/backend/internal/auth/jwt"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	"github.com/stretchr/testify/assert"
@@ -315,6 +316,61 @@ func Test_IsUserInAccount_False(t *testing.T) {
 	assert.Equal(t, false, resp.Msg.Ok)
 }
 
+func Test_IsUserInAccount_RoleAllowed(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	ctx := rbac_interceptor.SetRequiredRoleContext(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	userAssociation := getUserIdentityProviderAssociationMock(mockUserId, mockAuthProvider)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	m.QuerierMock.On("GetUserAssociationByProviderSub", ctx, mock.Anything, mockAuthProvider).Return(userAssociation, nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mock.Anything, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR)}, nil)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, true, resp.Msg.Ok)
+}
+
+func Test_IsUserInAccount_RoleForbidden(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	ctx := rbac_interceptor.SetRequiredRoleContext(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	userAssociation := getUserIdentityProviderAssociationMock(mockUserId, mockAuthProvider)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	m.QuerierMock.On("GetUserAssociationByProviderSub", ctx, mock.Anything, mockAuthProvider).Return(userAssociation, nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mock.Anything, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)}, nil)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
 func Test_IsUserInAccount_ApiKey(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 
@@ -351,6 +407,7 @@ func Test_CreateTeamAccount(t *testing.T) {
 	m.QuerierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -373,16 +430,24 @@ func Test_CreateTeamAccount_NoAuth(t *testing.T) {
 // RemoveTeamAccountMember
 func Test_RemoveTeamAccountMember(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
 	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
 
 	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
 	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
-	m.QuerierMock.On("RemoveAccountUser", ctx, mock.Anything, db_queries.RemoveAccountUserParams{
+	m.DbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)}, nil)
+	m.QuerierMock.On("RemoveAccountUser", ctx, mockTx, db_queries.RemoveAccountUserParams{
 		AccountId: accountUuid,
 		UserId:    userUuid,
 	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
 
 	resp, err := m.Service.RemoveTeamAccountMember(ctx, &connect.Request[mgmtv1alpha1.RemoveTeamAccountMemberRequest]{Msg: &mgmtv1alpha1.RemoveTeamAccountMemberRequest{AccountId: mockAccountId, UserId: mockUserId}})
 
@@ -392,23 +457,55 @@ func Test_RemoveTeamAccountMember(t *testing.T) {
 
 func Test_RemoveTeamAccountMember_NoRows(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
 	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
 
 	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
 	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
-	m.QuerierMock.On("RemoveAccountUser", ctx, mock.Anything, db_queries.RemoveAccountUserParams{
+	m.DbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
 		AccountId: accountUuid,
 		UserId:    userUuid,
-	}).Return(sql.ErrNoRows)
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, sql.ErrNoRows)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
 
 	resp, err := m.Service.RemoveTeamAccountMember(ctx, &connect.Request[mgmtv1alpha1.RemoveTeamAccountMemberRequest]{Msg: &mgmtv1alpha1.RemoveTeamAccountMemberRequest{AccountId: mockAccountId, UserId: mockUserId}})
 
+	m.QuerierMock.AssertNotCalled(t, "RemoveAccountUser", mock.Anything, mock.Anything, mock.Anything)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
 
+func Test_RemoveTeamAccountMember_LastAdmin(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	m.DbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)}, nil)
+	m.QuerierMock.On("GetAccountUserRoleCount", ctx, mockTx, db_queries.GetAccountUserRoleCountParams{
+		AccountId: accountUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN),
+	}).Return(int64(1), nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	resp, err := m.Service.RemoveTeamAccountMember(ctx, &connect.Request[mgmtv1alpha1.RemoveTeamAccountMemberRequest]{Msg: &mgmtv1alpha1.RemoveTeamAccountMemberRequest{AccountId: mockAccountId, UserId: mockUserId}})
+
+	m.QuerierMock.AssertNotCalled(t, "RemoveAccountUser", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
 // InviteUserToTeamAccount
 func Test_InviteUserToTeamAccount_Unauthorized(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -445,6 +542,29 @@ func Test_InviteUserToTeamAccount_NotTeamAccount(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func Test_InviteUserToTeamAccount_DefaultsToViewer(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	m.DbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	m.QuerierMock.On("UpdateActiveAccountInvitesToExpired", ctx, mockTx, mock.Anything).Return(db_queries.NeosyncApiAccountInvite{}, nil)
+	m.QuerierMock.On("CreateAccountInvite", ctx, mockTx, mock.MatchedBy(func(params db_queries.CreateAccountInviteParams) bool {
+		return params.Role == int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+	})).Return(db_queries.NeosyncApiAccountInvite{AccountID: accountUuid, SenderUserID: userUuid, Email: "test-email"}, nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	resp, err := m.Service.InviteUserToTeamAccount(ctx, &connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest]{Msg: &mgmtv1alpha1.InviteUserToTeamAccountRequest{AccountId: mockAccountId, Email: "test-email"}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
 // GetTeamAccountInvites
 func Test_GetTeamAccountInvites(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -550,6 +670,7 @@ func Test_AcceptTeamAccountInvite(t *testing.T) {
 		ExpiresAt:    expiresAt,
 		ID:           inviteUuid,
 		Accepted:     pgtype.Bool{Bool: false},
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR),
 	}, nil)
 	m.AuthClientMock.On("GetUserInfo", ctx, mock.Anything).Return(&auth_client.UserInfo{Email: email}, nil)
 	m.QuerierMock.On("UpdateAccountInviteToAccepted", ctx, mockTx, inviteUuid).Return(db_queries.NeosyncApiAccountInvite{}, nil)
@@ -560,6 +681,7 @@ func Test_AcceptTeamAccountInvite(t *testing.T) {
 	m.QuerierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EDITOR),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
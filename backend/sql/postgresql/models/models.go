@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
 )
 
 type ConnectionConfig struct {
@@ -18,6 +19,11 @@ type ConnectionConfig struct {
 	GcpCloudStorageConfig *GcpCloudStorageConfig          `json:"gcpCloudStorageConfig,omitempty"`
 	DynamoDBConfig        *DynamoDBConfig                 `json:"dynamoDBConfig,omitempty"`
 	MssqlConfig           *MssqlConfig                    `json:"mssqlConfig,omitempty"`
+	ClickhouseConfig      *ClickhouseConfig               `json:"clickhouseConfig,omitempty"`
+	KafkaConfig           *KafkaConfig                    `json:"kafkaConfig,omitempty"`
+
+	// Set when the config has been encrypted at rest. All other fields are empty when this is set.
+	Encrypted *encryption.Envelope `json:"encrypted,omitempty"`
 }
 
 func (c *ConnectionConfig) ToDto() (*mgmtv1alpha1.ConnectionConfig, error) {
@@ -167,6 +173,22 @@ func (c *ConnectionConfig) ToDto() (*mgmtv1alpha1.ConnectionConfig, error) {
 				MssqlConfig: mdto,
 			},
 		}, nil
+	} else if c.ClickhouseConfig != nil {
+		cdto, err := c.ClickhouseConfig.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_ClickhouseConfig{
+				ClickhouseConfig: cdto,
+			},
+		}, nil
+	} else if c.KafkaConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+				KafkaConfig: c.KafkaConfig.ToDto(),
+			},
+		}, nil
 	}
 	return nil, errors.ErrUnsupported
 }
@@ -266,6 +288,18 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 		if err != nil {
 			return err
 		}
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		c.ClickhouseConfig = &ClickhouseConfig{}
+		err := c.ClickhouseConfig.FromDto(config.ClickhouseConfig)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		c.KafkaConfig = &KafkaConfig{}
+		err := c.KafkaConfig.FromDto(config.KafkaConfig)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unable to convert to ConnectionConfig from DTO ConnectionConfig, type not supported: %T", config)
 	}
@@ -394,6 +428,146 @@ func (d *MssqlConfig) FromDto(dto *mgmtv1alpha1.MssqlConnectionConfig) error {
 	return nil
 }
 
+type ClickhouseConfig struct {
+	Url               *string            `json:"url,omitempty"`
+	ConnectionOptions *ConnectionOptions `json:"connectionOptions,omitempty"`
+	SSHTunnel         *SSHTunnel         `json:"sshTunnel,omitempty"`
+}
+
+func (c *ClickhouseConfig) ToDto() (*mgmtv1alpha1.ClickhouseConnectionConfig, error) {
+	if c.Url == nil {
+		return nil, errors.New("clickhouse connection does not contain url")
+	}
+	var connectionOptions *mgmtv1alpha1.SqlConnectionOptions
+	if c.ConnectionOptions != nil {
+		connectionOptions = c.ConnectionOptions.ToDto()
+	}
+	var tunnel *mgmtv1alpha1.SSHTunnel
+	if c.SSHTunnel != nil {
+		tunnel = c.SSHTunnel.ToDto()
+	}
+	return &mgmtv1alpha1.ClickhouseConnectionConfig{
+		ConnectionConfig: &mgmtv1alpha1.ClickhouseConnectionConfig_Url{
+			Url: *c.Url,
+		},
+		ConnectionOptions: connectionOptions,
+		Tunnel:            tunnel,
+	}, nil
+}
+
+func (c *ClickhouseConfig) FromDto(dto *mgmtv1alpha1.ClickhouseConnectionConfig) error {
+	if dto == nil {
+		dto = &mgmtv1alpha1.ClickhouseConnectionConfig{}
+	}
+
+	if dto.GetUrl() == "" {
+		return errors.New("clickhouse connection config dto url was empty")
+	}
+
+	url := dto.GetUrl()
+	c.Url = &url
+
+	if dto.GetConnectionOptions() != nil {
+		c.ConnectionOptions = &ConnectionOptions{}
+		c.ConnectionOptions.FromDto(dto.GetConnectionOptions())
+	}
+
+	if dto.GetTunnel() != nil {
+		c.SSHTunnel = &SSHTunnel{}
+		c.SSHTunnel.FromDto(dto.GetTunnel())
+	}
+
+	return nil
+}
+
+type KafkaConfig struct {
+	Brokers []string         `json:"brokers"`
+	Sasl    *KafkaSaslConfig `json:"sasl,omitempty"`
+	Tls     *KafkaTlsConfig  `json:"tls,omitempty"`
+}
+
+func (k *KafkaConfig) ToDto() *mgmtv1alpha1.KafkaConnectionConfig {
+	dto := &mgmtv1alpha1.KafkaConnectionConfig{
+		Brokers: k.Brokers,
+	}
+	if k.Sasl != nil {
+		dto.Sasl = k.Sasl.ToDto()
+	}
+	if k.Tls != nil {
+		dto.Tls = k.Tls.ToDto()
+	}
+	return dto
+}
+
+func (k *KafkaConfig) FromDto(dto *mgmtv1alpha1.KafkaConnectionConfig) error {
+	if dto == nil {
+		return errors.New("kafka connection config dto was nil")
+	}
+	if len(dto.GetBrokers()) == 0 {
+		return errors.New("kafka connection config dto must contain at least one broker")
+	}
+	k.Brokers = dto.GetBrokers()
+	if dto.GetSasl() != nil {
+		k.Sasl = &KafkaSaslConfig{}
+		k.Sasl.FromDto(dto.GetSasl())
+	}
+	if dto.GetTls() != nil {
+		k.Tls = &KafkaTlsConfig{}
+		k.Tls.FromDto(dto.GetTls())
+	}
+	return nil
+}
+
+type KafkaSaslConfig struct {
+	Mechanism string `json:"mechanism"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+}
+
+func (k *KafkaSaslConfig) ToDto() *mgmtv1alpha1.KafkaSaslConfig {
+	return &mgmtv1alpha1.KafkaSaslConfig{
+		Mechanism: k.Mechanism,
+		Username:  k.Username,
+		Password:  k.Password,
+	}
+}
+
+func (k *KafkaSaslConfig) FromDto(dto *mgmtv1alpha1.KafkaSaslConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.KafkaSaslConfig{}
+	}
+	k.Mechanism = dto.GetMechanism()
+	k.Username = dto.GetUsername()
+	k.Password = dto.GetPassword()
+}
+
+type KafkaTlsConfig struct {
+	SkipCertVerify bool       `json:"skipCertVerify"`
+	ClientTls      *ClientTls `json:"clientTls,omitempty"`
+}
+
+func (k *KafkaTlsConfig) ToDto() *mgmtv1alpha1.KafkaTlsConfig {
+	var clientTls *mgmtv1alpha1.ClientTlsConfig
+	if k.ClientTls != nil {
+		clientTls = k.ClientTls.ToDto()
+	}
+	return &mgmtv1alpha1.KafkaTlsConfig{
+		SkipCertVerify: k.SkipCertVerify,
+		ClientTls:      clientTls,
+	}
+}
+
+func (k *KafkaTlsConfig) FromDto(dto *mgmtv1alpha1.KafkaTlsConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.KafkaTlsConfig{}
+	}
+	k.SkipCertVerify = dto.GetSkipCertVerify()
+	if dto.GetClientTls() != nil {
+		k.ClientTls = &ClientTls{}
+		k.ClientTls.FromDto(dto.GetClientTls())
+	}
+}
+
 type DynamoDBConfig struct {
 	Credentials *AwsS3Credentials `json:"Credentials,omitempty"`
 	Region      *string           `json:"Region,omitempty"`
@@ -982,12 +1156,18 @@ func (s *DynamoDBSourceOptions) FromDto(dto *mgmtv1alpha1.DynamoDBSourceConnecti
 }
 
 type MongoDbSourceOptions struct {
-	ConnectionId string `json:"connectionId"`
+	ConnectionId string                           `json:"connectionId"`
+	Collections  []*MongoDbSourceCollectionOption `json:"collections,omitempty"`
 }
 
 func (s *MongoDbSourceOptions) ToDto() *mgmtv1alpha1.MongoDBSourceConnectionOptions {
+	collections := make([]*mgmtv1alpha1.MongoDBSourceCollectionOption, len(s.Collections))
+	for i, c := range s.Collections {
+		collections[i] = c.ToDto()
+	}
 	return &mgmtv1alpha1.MongoDBSourceConnectionOptions{
 		ConnectionId: s.ConnectionId,
+		Collections:  collections,
 	}
 }
 
@@ -996,6 +1176,75 @@ func (s *MongoDbSourceOptions) FromDto(dto *mgmtv1alpha1.MongoDBSourceConnection
 		dto = &mgmtv1alpha1.MongoDBSourceConnectionOptions{}
 	}
 	s.ConnectionId = dto.GetConnectionId()
+	s.Collections = FromDtoMongoDbSourceCollectionOptions(dto.GetCollections())
+}
+
+type MongoDbSourceCollectionOption struct {
+	Database   string              `json:"database"`
+	Collection string              `json:"collection"`
+	Filter     *string             `json:"filter,omitempty"`
+	Projection map[string]int32    `json:"projection,omitempty"`
+	Sort       []*MongoDbSortField `json:"sort,omitempty"`
+	Limit      *int64              `json:"limit,omitempty"`
+}
+
+func (s *MongoDbSourceCollectionOption) ToDto() *mgmtv1alpha1.MongoDBSourceCollectionOption {
+	sort := make([]*mgmtv1alpha1.MongoDBSortField, len(s.Sort))
+	for i, field := range s.Sort {
+		sort[i] = field.ToDto()
+	}
+	return &mgmtv1alpha1.MongoDBSourceCollectionOption{
+		Database:   s.Database,
+		Collection: s.Collection,
+		Filter:     s.Filter,
+		Projection: s.Projection,
+		Sort:       sort,
+		Limit:      s.Limit,
+	}
+}
+
+func (s *MongoDbSourceCollectionOption) FromDto(dto *mgmtv1alpha1.MongoDBSourceCollectionOption) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.MongoDBSourceCollectionOption{}
+	}
+	s.Database = dto.GetDatabase()
+	s.Collection = dto.GetCollection()
+	s.Filter = dto.Filter
+	s.Projection = dto.GetProjection()
+	s.Sort = make([]*MongoDbSortField, len(dto.GetSort()))
+	for i, fieldDto := range dto.GetSort() {
+		field := &MongoDbSortField{}
+		field.FromDto(fieldDto)
+		s.Sort[i] = field
+	}
+	s.Limit = dto.Limit
+}
+
+type MongoDbSortField struct {
+	Field     string `json:"field"`
+	Direction int32  `json:"direction"`
+}
+
+func (s *MongoDbSortField) ToDto() *mgmtv1alpha1.MongoDBSortField {
+	return &mgmtv1alpha1.MongoDBSortField{
+		Field:     s.Field,
+		Direction: s.Direction,
+	}
+}
+
+func (s *MongoDbSortField) FromDto(dto *mgmtv1alpha1.MongoDBSortField) {
+	s.Field = dto.GetField()
+	s.Direction = dto.GetDirection()
+}
+
+func FromDtoMongoDbSourceCollectionOptions(dtos []*mgmtv1alpha1.MongoDBSourceCollectionOption) []*MongoDbSourceCollectionOption {
+	collections := make([]*MongoDbSourceCollectionOption, len(dtos))
+	for i, dto := range dtos {
+		c := &MongoDbSourceCollectionOption{}
+		c.FromDto(dto)
+		collections[i] = c
+	}
+	return collections
 }
 
 type MysqlSourceOptions struct {
@@ -1382,10 +1631,15 @@ type JobDestinationOptions struct {
 	GcpCloudStorageOptions *GcpCloudStorageDestinationOptions `json:"gcpCloudStorageOptions,omitempty"`
 	DynamoDBOptions        *DynamoDBDestinationOptions        `json:"dynamoDBOptions,omitempty"`
 	MssqlOptions           *MssqlDestinationOptions           `json:"mssqlOptions,omitempty"`
+	ClickhouseOptions      *ClickhouseDestinationOptions      `json:"clickhouseOptions,omitempty"`
+	KafkaOptions           *KafkaDestinationOptions           `json:"kafkaOptions,omitempty"`
 }
 
 type DynamoDBDestinationOptions struct {
-	TableMappings []*DynamoDBDestinationTableMapping `json:"tableMappings"`
+	TableMappings        []*DynamoDBDestinationTableMapping `json:"tableMappings"`
+	WriteMode            int32                              `json:"writeMode,omitempty"`
+	TruncateBeforeInsert bool                               `json:"truncateBeforeInsert,omitempty"`
+	InitTable            bool                               `json:"initTable,omitempty"`
 }
 
 func (d *DynamoDBDestinationOptions) ToDto() *mgmtv1alpha1.DynamoDBDestinationConnectionOptions {
@@ -1394,7 +1648,10 @@ func (d *DynamoDBDestinationOptions) ToDto() *mgmtv1alpha1.DynamoDBDestinationCo
 		tableMappings = append(tableMappings, tm.ToDto())
 	}
 	return &mgmtv1alpha1.DynamoDBDestinationConnectionOptions{
-		TableMappings: tableMappings,
+		TableMappings:        tableMappings,
+		WriteMode:            mgmtv1alpha1.DynamoDBDestinationWriteMode(d.WriteMode),
+		TruncateBeforeInsert: d.TruncateBeforeInsert,
+		InitTable:            d.InitTable,
 	}
 }
 func (d *DynamoDBDestinationOptions) FromDto(dto *mgmtv1alpha1.DynamoDBDestinationConnectionOptions) {
@@ -1405,6 +1662,9 @@ func (d *DynamoDBDestinationOptions) FromDto(dto *mgmtv1alpha1.DynamoDBDestinati
 		tm.FromDto(dtotm)
 		d.TableMappings = append(d.TableMappings, tm)
 	}
+	d.WriteMode = int32(dto.GetWriteMode())
+	d.TruncateBeforeInsert = dto.GetTruncateBeforeInsert()
+	d.InitTable = dto.GetInitTable()
 }
 
 type DynamoDBDestinationTableMapping struct {
@@ -1425,7 +1685,54 @@ func (d *DynamoDBDestinationTableMapping) FromDto(dto *mgmtv1alpha1.DynamoDBDest
 
 type GcpCloudStorageDestinationOptions struct{}
 
-type MongoDestinationOptions struct{}
+type MongoDestinationOptions struct {
+	TruncateBeforeInsert bool                         `json:"truncateBeforeInsert"`
+	InitCollection       bool                         `json:"initCollection"`
+	UpsertKeys           []*MongoDestinationUpsertKey `json:"upsertKeys,omitempty"`
+}
+
+func (m *MongoDestinationOptions) ToDto() *mgmtv1alpha1.MongoDBDestinationConnectionOptions {
+	upsertKeys := make([]*mgmtv1alpha1.MongoDBDestinationUpsertKey, len(m.UpsertKeys))
+	for i, key := range m.UpsertKeys {
+		upsertKeys[i] = key.ToDto()
+	}
+	return &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+		TruncateBeforeInsert: m.TruncateBeforeInsert,
+		InitCollection:       m.InitCollection,
+		UpsertKeys:           upsertKeys,
+	}
+}
+
+func (m *MongoDestinationOptions) FromDto(dto *mgmtv1alpha1.MongoDBDestinationConnectionOptions) {
+	m.TruncateBeforeInsert = dto.GetTruncateBeforeInsert()
+	m.InitCollection = dto.GetInitCollection()
+	m.UpsertKeys = make([]*MongoDestinationUpsertKey, len(dto.GetUpsertKeys()))
+	for i, keyDto := range dto.GetUpsertKeys() {
+		key := &MongoDestinationUpsertKey{}
+		key.FromDto(keyDto)
+		m.UpsertKeys[i] = key
+	}
+}
+
+type MongoDestinationUpsertKey struct {
+	Database   string   `json:"database"`
+	Collection string   `json:"collection"`
+	Fields     []string `json:"fields"`
+}
+
+func (m *MongoDestinationUpsertKey) ToDto() *mgmtv1alpha1.MongoDBDestinationUpsertKey {
+	return &mgmtv1alpha1.MongoDBDestinationUpsertKey{
+		Database:   m.Database,
+		Collection: m.Collection,
+		Fields:     m.Fields,
+	}
+}
+
+func (m *MongoDestinationUpsertKey) FromDto(dto *mgmtv1alpha1.MongoDBDestinationUpsertKey) {
+	m.Database = dto.GetDatabase()
+	m.Collection = dto.GetCollection()
+	m.Fields = dto.GetFields()
+}
 
 type AwsS3DestinationOptions struct{}
 type PostgresDestinationOptions struct {
@@ -1536,6 +1843,111 @@ func (m *MssqlDestinationOptions) FromDto(dto *mgmtv1alpha1.MssqlDestinationConn
 	}
 }
 
+type ClickhouseDestinationOptions struct {
+	TruncateTableConfig *ClickhouseTruncateTableConfig `json:"truncateTableConfig,omitempty"`
+	InitTableSchema     bool                           `json:"initTableSchema"`
+	TableEngine         *string                        `json:"tableEngine,omitempty"`
+	OrderBy             []string                       `json:"orderBy,omitempty"`
+	PartitionBy         *string                        `json:"partitionBy,omitempty"`
+}
+
+func (c *ClickhouseDestinationOptions) ToDto() *mgmtv1alpha1.ClickhouseDestinationConnectionOptions {
+	var truncateTableConfig *mgmtv1alpha1.ClickhouseTruncateTableConfig
+	if c.TruncateTableConfig != nil {
+		truncateTableConfig = c.TruncateTableConfig.ToDto()
+	}
+	return &mgmtv1alpha1.ClickhouseDestinationConnectionOptions{
+		TruncateTable:   truncateTableConfig,
+		InitTableSchema: c.InitTableSchema,
+		TableEngine:     c.TableEngine,
+		OrderBy:         c.OrderBy,
+		PartitionBy:     c.PartitionBy,
+	}
+}
+
+func (c *ClickhouseDestinationOptions) FromDto(dto *mgmtv1alpha1.ClickhouseDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.ClickhouseDestinationConnectionOptions{}
+	}
+	c.InitTableSchema = dto.GetInitTableSchema()
+	c.TableEngine = dto.TableEngine
+	c.OrderBy = dto.GetOrderBy()
+	c.PartitionBy = dto.PartitionBy
+	if dto.GetTruncateTable() != nil {
+		c.TruncateTableConfig = &ClickhouseTruncateTableConfig{}
+		c.TruncateTableConfig.FromDto(dto.GetTruncateTable())
+	}
+}
+
+type ClickhouseTruncateTableConfig struct {
+	TruncateBeforeInsert bool `json:"truncateBeforeInsert"`
+}
+
+func (t *ClickhouseTruncateTableConfig) ToDto() *mgmtv1alpha1.ClickhouseTruncateTableConfig {
+	return &mgmtv1alpha1.ClickhouseTruncateTableConfig{
+		TruncateBeforeInsert: t.TruncateBeforeInsert,
+	}
+}
+
+func (t *ClickhouseTruncateTableConfig) FromDto(dto *mgmtv1alpha1.ClickhouseTruncateTableConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.ClickhouseTruncateTableConfig{}
+	}
+	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
+}
+
+type KafkaDestinationOptions struct {
+	Topic      *string                 `json:"topic,omitempty"`
+	KeyColumns []*KafkaTableKeyColumns `json:"keyColumns,omitempty"`
+	Format     int32                   `json:"format"`
+}
+
+func (k *KafkaDestinationOptions) ToDto() *mgmtv1alpha1.KafkaDestinationConnectionOptions {
+	keyColumns := make([]*mgmtv1alpha1.KafkaTableKeyColumns, 0, len(k.KeyColumns))
+	for _, kc := range k.KeyColumns {
+		keyColumns = append(keyColumns, kc.ToDto())
+	}
+	return &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+		Topic:      k.Topic,
+		KeyColumns: keyColumns,
+		Format:     mgmtv1alpha1.KafkaSerializationFormat(k.Format),
+	}
+}
+
+func (k *KafkaDestinationOptions) FromDto(dto *mgmtv1alpha1.KafkaDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.KafkaDestinationConnectionOptions{}
+	}
+	k.Topic = dto.Topic
+	k.Format = int32(dto.GetFormat())
+	k.KeyColumns = make([]*KafkaTableKeyColumns, 0, len(dto.GetKeyColumns()))
+	for _, kc := range dto.GetKeyColumns() {
+		keyColumns := &KafkaTableKeyColumns{}
+		keyColumns.FromDto(kc)
+		k.KeyColumns = append(k.KeyColumns, keyColumns)
+	}
+}
+
+type KafkaTableKeyColumns struct {
+	Schema  string   `json:"schema"`
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+}
+
+func (k *KafkaTableKeyColumns) ToDto() *mgmtv1alpha1.KafkaTableKeyColumns {
+	return &mgmtv1alpha1.KafkaTableKeyColumns{
+		Schema:  k.Schema,
+		Table:   k.Table,
+		Columns: k.Columns,
+	}
+}
+
+func (k *KafkaTableKeyColumns) FromDto(dto *mgmtv1alpha1.KafkaTableKeyColumns) {
+	k.Schema = dto.GetSchema()
+	k.Table = dto.GetTable()
+	k.Columns = dto.GetColumns()
+}
+
 type MssqlOnConflictConfig struct {
 	DoNothing bool `json:"doNothing"`
 }
@@ -1615,7 +2027,7 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 	if j.MongoOptions != nil {
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
-				MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{},
+				MongodbOptions: j.MongoOptions.ToDto(),
 			},
 		}
 	}
@@ -1640,6 +2052,20 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 			},
 		}
 	}
+	if j.ClickhouseOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_ClickhouseOptions{
+				ClickhouseOptions: j.ClickhouseOptions.ToDto(),
+			},
+		}
+	}
+	if j.KafkaOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+				KafkaOptions: j.KafkaOptions.ToDto(),
+			},
+		}
+	}
 
 	return nil
 }
@@ -1677,6 +2103,7 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 		j.AwsS3Options = &AwsS3DestinationOptions{}
 	case *mgmtv1alpha1.JobDestinationOptions_MongodbOptions:
 		j.MongoOptions = &MongoDestinationOptions{}
+		j.MongoOptions.FromDto(config.MongodbOptions)
 	case *mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions:
 		j.GcpCloudStorageOptions = &GcpCloudStorageDestinationOptions{}
 	case *mgmtv1alpha1.JobDestinationOptions_DynamodbOptions:
@@ -1685,6 +2112,12 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
 		j.MssqlOptions = &MssqlDestinationOptions{}
 		j.MssqlOptions.FromDto(config.MssqlOptions)
+	case *mgmtv1alpha1.JobDestinationOptions_ClickhouseOptions:
+		j.ClickhouseOptions = &ClickhouseDestinationOptions{}
+		j.ClickhouseOptions.FromDto(config.ClickhouseOptions)
+	case *mgmtv1alpha1.JobDestinationOptions_KafkaOptions:
+		j.KafkaOptions = &KafkaDestinationOptions{}
+		j.KafkaOptions.FromDto(config.KafkaOptions)
 	default:
 		return fmt.Errorf("invalid job destination options config: %T", config)
 	}
@@ -1788,3 +2221,229 @@ func (t *AccountOnboardingConfig) FromDto(dto *mgmtv1alpha1.AccountOnboardingCon
 	t.HasCreatedJob = dto.GetHasCreatedJob()
 	t.HasInvitedMembers = dto.GetHasInvitedMembers()
 }
+
+type JobRunTableSummary struct {
+	Schema      string `json:"schema"`
+	Table       string `json:"table"`
+	RowsRead    int64  `json:"rowsRead"`
+	RowsWritten int64  `json:"rowsWritten"`
+	RowsErrored int64  `json:"rowsErrored"`
+}
+
+func (j *JobRunTableSummary) ToDto() *mgmtv1alpha1.JobRunTableSummary {
+	return &mgmtv1alpha1.JobRunTableSummary{
+		Schema:      j.Schema,
+		Table:       j.Table,
+		RowsRead:    j.RowsRead,
+		RowsWritten: j.RowsWritten,
+		RowsErrored: j.RowsErrored,
+	}
+}
+
+func (j *JobRunTableSummary) FromDto(dto *mgmtv1alpha1.JobRunTableSummary) {
+	j.Schema = dto.GetSchema()
+	j.Table = dto.GetTable()
+	j.RowsRead = dto.GetRowsRead()
+	j.RowsWritten = dto.GetRowsWritten()
+	j.RowsErrored = dto.GetRowsErrored()
+}
+
+type JobRunOverrides struct {
+	Tables       []*JobRunTableOverride       `json:"tables,omitempty"`
+	Destinations []*JobRunDestinationOverride `json:"destinations,omitempty"`
+}
+
+type JobRunTableOverride struct {
+	Schema      string  `json:"schema"`
+	Table       string  `json:"table"`
+	WhereClause *string `json:"whereClause,omitempty"`
+	RowCount    *int64  `json:"rowCount,omitempty"`
+}
+
+type JobRunDestinationOverride struct {
+	DestinationId string                 `json:"destinationId"`
+	ConnectionId  *string                `json:"connectionId,omitempty"`
+	Options       *JobDestinationOptions `json:"options,omitempty"`
+}
+
+func (j *JobRunOverrides) ToDto() *mgmtv1alpha1.JobRunOverrides {
+	tables := make([]*mgmtv1alpha1.JobRunTableOverride, 0, len(j.Tables))
+	for _, table := range j.Tables {
+		tables = append(tables, &mgmtv1alpha1.JobRunTableOverride{
+			Schema:      table.Schema,
+			Table:       table.Table,
+			WhereClause: table.WhereClause,
+			RowCount:    table.RowCount,
+		})
+	}
+	destinations := make([]*mgmtv1alpha1.JobRunDestinationOverride, 0, len(j.Destinations))
+	for _, destination := range j.Destinations {
+		dto := &mgmtv1alpha1.JobRunDestinationOverride{
+			DestinationId: destination.DestinationId,
+			ConnectionId:  destination.ConnectionId,
+		}
+		if destination.Options != nil {
+			dto.Options = destination.Options.ToDto()
+		}
+		destinations = append(destinations, dto)
+	}
+	return &mgmtv1alpha1.JobRunOverrides{
+		Tables:       tables,
+		Destinations: destinations,
+	}
+}
+
+func (j *JobRunOverrides) FromDto(dto *mgmtv1alpha1.JobRunOverrides) error {
+	j.Tables = make([]*JobRunTableOverride, 0, len(dto.GetTables()))
+	for _, table := range dto.GetTables() {
+		j.Tables = append(j.Tables, &JobRunTableOverride{
+			Schema:      table.GetSchema(),
+			Table:       table.GetTable(),
+			WhereClause: table.WhereClause,
+			RowCount:    table.RowCount,
+		})
+	}
+	j.Destinations = make([]*JobRunDestinationOverride, 0, len(dto.GetDestinations()))
+	for _, destination := range dto.GetDestinations() {
+		override := &JobRunDestinationOverride{
+			DestinationId: destination.GetDestinationId(),
+			ConnectionId:  destination.ConnectionId,
+		}
+		if destination.GetOptions() != nil {
+			override.Options = &JobDestinationOptions{}
+			if err := override.Options.FromDto(destination.GetOptions()); err != nil {
+				return err
+			}
+		}
+		j.Destinations = append(j.Destinations, override)
+	}
+	return nil
+}
+
+type NotificationChannelConfig struct {
+	Webhook *WebhookNotificationConfig `json:"webhook,omitempty"`
+	Slack   *SlackNotificationConfig   `json:"slack,omitempty"`
+	Email   *EmailNotificationConfig   `json:"email,omitempty"`
+}
+
+type WebhookNotificationConfig struct {
+	Url           string `json:"url"`
+	SigningSecret string `json:"signingSecret"`
+}
+
+type SlackNotificationConfig struct {
+	Url string `json:"url"`
+}
+
+type EmailNotificationConfig struct {
+	Host     string   `json:"host"`
+	Port     uint32   `json:"port"`
+	Username *string  `json:"username,omitempty"`
+	Password *string  `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+}
+
+func (n *NotificationChannelConfig) ToDto() (*mgmtv1alpha1.NotificationChannelConfig, error) {
+	switch {
+	case n.Webhook != nil:
+		return &mgmtv1alpha1.NotificationChannelConfig{
+			Config: &mgmtv1alpha1.NotificationChannelConfig_Webhook{
+				Webhook: &mgmtv1alpha1.WebhookNotificationConfig{
+					Url:           n.Webhook.Url,
+					SigningSecret: n.Webhook.SigningSecret,
+				},
+			},
+		}, nil
+	case n.Slack != nil:
+		return &mgmtv1alpha1.NotificationChannelConfig{
+			Config: &mgmtv1alpha1.NotificationChannelConfig_Slack{
+				Slack: &mgmtv1alpha1.SlackNotificationConfig{
+					Url: n.Slack.Url,
+				},
+			},
+		}, nil
+	case n.Email != nil:
+		return &mgmtv1alpha1.NotificationChannelConfig{
+			Config: &mgmtv1alpha1.NotificationChannelConfig_Email{
+				Email: &mgmtv1alpha1.EmailNotificationConfig{
+					Host:     n.Email.Host,
+					Port:     n.Email.Port,
+					Username: n.Email.Username,
+					Password: n.Email.Password,
+					From:     n.Email.From,
+					To:       n.Email.To,
+				},
+			},
+		}, nil
+	}
+	return nil, errors.New("unable to convert notification channel config to dto: no config was set")
+}
+
+func (n *NotificationChannelConfig) FromDto(dto *mgmtv1alpha1.NotificationChannelConfig) error {
+	switch config := dto.GetConfig().(type) {
+	case *mgmtv1alpha1.NotificationChannelConfig_Webhook:
+		n.Webhook = &WebhookNotificationConfig{
+			Url:           config.Webhook.GetUrl(),
+			SigningSecret: config.Webhook.GetSigningSecret(),
+		}
+	case *mgmtv1alpha1.NotificationChannelConfig_Slack:
+		n.Slack = &SlackNotificationConfig{
+			Url: config.Slack.GetUrl(),
+		}
+	case *mgmtv1alpha1.NotificationChannelConfig_Email:
+		n.Email = &EmailNotificationConfig{
+			Host:     config.Email.GetHost(),
+			Port:     config.Email.GetPort(),
+			Username: config.Email.Username,
+			Password: config.Email.Password,
+			From:     config.Email.GetFrom(),
+			To:       config.Email.GetTo(),
+		}
+	default:
+		return fmt.Errorf("invalid notification channel config: %T", config)
+	}
+	return nil
+}
+
+type JobMappingPolicyConfig struct {
+	Policies                   []*JobMappingPolicy `json:"policies,omitempty"`
+	NonProductionConnectionIds []string            `json:"nonProductionConnectionIds,omitempty"`
+}
+
+type JobMappingPolicy struct {
+	Name           string   `json:"name"`
+	ColumnPatterns []string `json:"columnPatterns,omitempty"`
+	// mgmtv1alpha1.JobMappingPolicyDestinationScope
+	DestinationScope int32    `json:"destinationScope"`
+	ColumnTags       []string `json:"columnTags,omitempty"`
+}
+
+func (j *JobMappingPolicyConfig) ToDto() *mgmtv1alpha1.JobMappingPolicyConfig {
+	policies := make([]*mgmtv1alpha1.JobMappingPolicy, 0, len(j.Policies))
+	for _, policy := range j.Policies {
+		policies = append(policies, &mgmtv1alpha1.JobMappingPolicy{
+			Name:             policy.Name,
+			ColumnPatterns:   policy.ColumnPatterns,
+			DestinationScope: mgmtv1alpha1.JobMappingPolicyDestinationScope(policy.DestinationScope),
+			ColumnTags:       policy.ColumnTags,
+		})
+	}
+	return &mgmtv1alpha1.JobMappingPolicyConfig{
+		Policies:                   policies,
+		NonProductionConnectionIds: j.NonProductionConnectionIds,
+	}
+}
+
+func (j *JobMappingPolicyConfig) FromDto(dto *mgmtv1alpha1.JobMappingPolicyConfig) {
+	j.Policies = make([]*JobMappingPolicy, 0, len(dto.GetPolicies()))
+	for _, policy := range dto.GetPolicies() {
+		j.Policies = append(j.Policies, &JobMappingPolicy{
+			Name:             policy.GetName(),
+			ColumnPatterns:   policy.GetColumnPatterns(),
+			DestinationScope: int32(policy.GetDestinationScope()),
+			ColumnTags:       policy.GetColumnTags(),
+		})
+	}
+	j.NonProductionConnectionIds = dto.GetNonProductionConnectionIds()
+}
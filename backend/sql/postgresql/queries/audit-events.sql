@@ -0,0 +1,17 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO neosync_api.audit_events (
+  account_id, actor_user_id, actor_api_key_id, procedure, resource_id, request, changes, error_code
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetAuditEventsByAccount :many
+SELECT * FROM neosync_api.audit_events
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('resourceId')::text IS NULL OR resource_id = sqlc.narg('resourceId')::text)
+  AND (
+    sqlc.narg('cursorCreatedAt')::timestamp IS NULL
+    OR (created_at, id) < (sqlc.narg('cursorCreatedAt')::timestamp, sqlc.narg('cursorId')::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('pageLimit');
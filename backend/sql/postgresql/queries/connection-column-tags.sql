@@ -0,0 +1,17 @@
+-- name: GetConnectionColumnTags :many
+SELECT * from neosync_api.connection_column_tags
+WHERE connection_id = $1
+ORDER BY schema_name, table_name, column_name;
+
+-- name: UpsertConnectionColumnTags :exec
+INSERT INTO neosync_api.connection_column_tags (
+  connection_id, schema_name, table_name, column_name, tags, created_by_id, updated_by_id
+) VALUES (
+  sqlc.arg('connectionId'), sqlc.arg('schemaName'), sqlc.arg('tableName'), sqlc.arg('columnName'), sqlc.arg('tags'), sqlc.arg('userId'), sqlc.arg('userId')
+)
+ON CONFLICT (connection_id, schema_name, table_name, column_name)
+DO UPDATE SET tags = EXCLUDED.tags, updated_by_id = EXCLUDED.updated_by_id;
+
+-- name: RemoveConnectionColumnTags :exec
+DELETE FROM neosync_api.connection_column_tags
+WHERE connection_id = $1 AND schema_name = $2 AND table_name = $3 AND column_name = $4;
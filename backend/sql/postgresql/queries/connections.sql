@@ -1,6 +1,9 @@
 -- name: GetConnectionById :one
 SELECT * from neosync_api.connections WHERE id = $1;
 
+-- name: GetAllConnections :many
+SELECT * from neosync_api.connections ORDER BY created_at;
+
 -- name: GetConnectionsByIds :many
 SELECT * from neosync_api.connections WHERE id = ANY($1::uuid[]);
 
@@ -36,6 +39,11 @@ updated_by_id = $3
 WHERE id = $4
 RETURNING *;
 
+-- name: UpdateConnectionConfig :execrows
+UPDATE neosync_api.connections
+SET connection_config = sqlc.arg('connectionConfig')
+WHERE id = sqlc.arg('id') AND connection_config = sqlc.arg('previousConnectionConfig');
+
 -- name: IsConnectionNameAvailable :one
 SELECT count(c.id) from neosync_api.connections c
 INNER JOIN neosync_api.accounts a ON a.id = c.account_id
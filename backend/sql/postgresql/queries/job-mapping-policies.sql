@@ -0,0 +1,13 @@
+-- name: GetJobMappingPolicyConfigByAccount :one
+SELECT config from neosync_api.account_job_mapping_policies
+WHERE account_id = $1;
+
+-- name: UpsertJobMappingPolicyConfig :one
+INSERT INTO neosync_api.account_job_mapping_policies (
+  account_id, config, created_by_id, updated_by_id
+) VALUES (
+  sqlc.arg('accountId'), sqlc.arg('config'), sqlc.arg('userId'), sqlc.arg('userId')
+)
+ON CONFLICT (account_id)
+DO UPDATE SET config = EXCLUDED.config, updated_by_id = EXCLUDED.updated_by_id
+RETURNING *;
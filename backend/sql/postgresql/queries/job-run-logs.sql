@@ -0,0 +1,46 @@
+-- name: CreateJobRunLogs :exec
+INSERT INTO neosync_api.job_run_logs (
+  account_id, job_run_id, log_time, level, message, activity, schema_name, table_name, attributes
+)
+SELECT
+  sqlc.arg('accountId')::uuid,
+  sqlc.arg('jobRunId')::text,
+  t.log_time,
+  t.level,
+  t.message,
+  NULLIF(t.activity, ''),
+  NULLIF(t.schema_name, ''),
+  NULLIF(t.table_name, ''),
+  t.attributes::jsonb
+FROM unnest(
+  sqlc.arg('logTimes')::timestamp[],
+  sqlc.arg('levels')::text[],
+  sqlc.arg('messages')::text[],
+  sqlc.arg('activities')::text[],
+  sqlc.arg('schemaNames')::text[],
+  sqlc.arg('tableNames')::text[],
+  sqlc.arg('attributes')::text[]
+) AS t(log_time, level, message, activity, schema_name, table_name, attributes);
+
+-- name: GetJobRunLogs :many
+SELECT * FROM neosync_api.job_run_logs
+WHERE account_id = sqlc.arg('accountId')
+  AND job_run_id = sqlc.arg('jobRunId')
+  AND id > sqlc.arg('afterId')::bigint
+  AND log_time >= sqlc.arg('since')::timestamp
+  AND (cardinality(sqlc.arg('levels')::text[]) = 0 OR level = ANY(sqlc.arg('levels')::text[]))
+ORDER BY id ASC
+LIMIT sqlc.arg('pageLimit');
+
+-- name: GetLatestJobRunLogs :many
+SELECT * FROM neosync_api.job_run_logs
+WHERE account_id = sqlc.arg('accountId')
+  AND job_run_id = sqlc.arg('jobRunId')
+  AND log_time >= sqlc.arg('since')::timestamp
+  AND (cardinality(sqlc.arg('levels')::text[]) = 0 OR level = ANY(sqlc.arg('levels')::text[]))
+ORDER BY id DESC
+LIMIT sqlc.arg('pageLimit');
+
+-- name: DeleteJobRunLogsOlderThan :execrows
+DELETE FROM neosync_api.job_run_logs
+WHERE created_at < sqlc.arg('before')::timestamp;
@@ -0,0 +1,88 @@
+-- name: UpsertJobRun :exec
+INSERT INTO neosync_api.job_runs (
+  id, account_id, job_id, status, started_at, completed_at, tables, error_message
+) VALUES (
+  sqlc.arg('id'), sqlc.arg('accountId'), sqlc.arg('jobId'), sqlc.arg('status'), sqlc.arg('startedAt'),
+  sqlc.narg('completedAt'), sqlc.arg('tables'), sqlc.narg('errorMessage')
+)
+ON CONFLICT (id)
+DO UPDATE SET
+  status = EXCLUDED.status,
+  started_at = EXCLUDED.started_at,
+  completed_at = EXCLUDED.completed_at,
+  tables = EXCLUDED.tables,
+  error_message = EXCLUDED.error_message
+WHERE neosync_api.job_runs.account_id = EXCLUDED.account_id AND neosync_api.job_runs.job_id = EXCLUDED.job_id;
+
+-- name: BackfillJobRun :exec
+-- Records a run that was started before the run history was kept, runs that are already recorded are left as is
+INSERT INTO neosync_api.job_runs (
+  id, account_id, job_id, status, started_at, completed_at
+) VALUES (
+  sqlc.arg('id'), sqlc.arg('accountId'), sqlc.arg('jobId'), sqlc.arg('status'), sqlc.arg('startedAt'), sqlc.narg('completedAt')
+)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: SetJobRunTriggeredBy :exec
+INSERT INTO neosync_api.job_runs (
+  id, account_id, job_id, status, started_at, triggered_by
+) VALUES (
+  sqlc.arg('id'), sqlc.arg('accountId'), sqlc.arg('jobId'), sqlc.arg('status'), sqlc.arg('startedAt'), sqlc.arg('triggeredBy')
+)
+ON CONFLICT (id)
+DO UPDATE SET
+  triggered_by = EXCLUDED.triggered_by
+WHERE neosync_api.job_runs.account_id = EXCLUDED.account_id AND neosync_api.job_runs.job_id = EXCLUDED.job_id;
+
+-- name: SetJobRunOverrides :exec
+INSERT INTO neosync_api.job_runs (
+  id, account_id, job_id, status, started_at, triggered_by, overrides
+) VALUES (
+  sqlc.arg('id'), sqlc.arg('accountId'), sqlc.arg('jobId'), sqlc.arg('status'), sqlc.arg('startedAt'),
+  sqlc.narg('triggeredBy'), sqlc.arg('overrides')
+)
+ON CONFLICT (id)
+DO UPDATE SET
+  triggered_by = COALESCE(EXCLUDED.triggered_by, neosync_api.job_runs.triggered_by),
+  overrides = EXCLUDED.overrides
+WHERE neosync_api.job_runs.account_id = EXCLUDED.account_id AND neosync_api.job_runs.job_id = EXCLUDED.job_id;
+
+-- name: UpdateJobRunStatus :exec
+UPDATE neosync_api.job_runs
+SET status = sqlc.arg('status'),
+    completed_at = COALESCE(completed_at, sqlc.narg('completedAt')::timestamp),
+    error_message = COALESCE(error_message, sqlc.narg('errorMessage')::text)
+WHERE id = sqlc.arg('id') AND account_id = sqlc.arg('accountId');
+
+-- name: GetJobRunById :one
+SELECT * FROM neosync_api.job_runs
+WHERE id = sqlc.arg('id') AND account_id = sqlc.arg('accountId');
+
+-- name: GetJobRuns :many
+SELECT * FROM neosync_api.job_runs
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('jobId')::uuid IS NULL OR job_id = sqlc.narg('jobId')::uuid)
+  AND (cardinality(sqlc.arg('statuses')::smallint[]) = 0 OR status = ANY(sqlc.arg('statuses')::smallint[]))
+  AND (sqlc.narg('startedAfter')::timestamp IS NULL OR started_at >= sqlc.narg('startedAfter')::timestamp)
+  AND (sqlc.narg('startedBefore')::timestamp IS NULL OR started_at < sqlc.narg('startedBefore')::timestamp)
+  AND (
+    sqlc.narg('cursorStartedAt')::timestamp IS NULL
+    OR (started_at, id) < (sqlc.narg('cursorStartedAt')::timestamp, sqlc.narg('cursorId')::text)
+  )
+ORDER BY started_at DESC, id DESC
+LIMIT sqlc.arg('pageLimit');
+
+-- name: GetJobRunsByStatus :many
+SELECT * FROM neosync_api.job_runs
+WHERE status = ANY(sqlc.arg('statuses')::smallint[])
+  AND started_at < sqlc.arg('startedBefore')::timestamp
+ORDER BY started_at ASC, id ASC
+LIMIT sqlc.arg('pageLimit');
+
+-- name: CloseJobRun :execrows
+UPDATE neosync_api.job_runs
+SET status = sqlc.arg('status'),
+    completed_at = COALESCE(completed_at, sqlc.narg('completedAt')::timestamp),
+    error_message = COALESCE(error_message, sqlc.narg('errorMessage')::text)
+WHERE id = sqlc.arg('id') AND account_id = sqlc.arg('accountId')
+  AND status = ANY(sqlc.arg('openStatuses')::smallint[]);
@@ -0,0 +1,47 @@
+-- name: GetNotificationChannelById :one
+SELECT * from neosync_api.notification_channels WHERE id = $1;
+
+-- name: GetNotificationChannelsByAccount :many
+SELECT nc.* from neosync_api.notification_channels nc
+INNER JOIN neosync_api.accounts a ON a.id = nc.account_id
+WHERE a.id = sqlc.arg('accountId')
+ORDER BY nc.created_at DESC;
+
+-- name: CreateNotificationChannel :one
+INSERT INTO neosync_api.notification_channels (
+  account_id, name, config, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: UpdateNotificationChannel :one
+UPDATE neosync_api.notification_channels
+SET name = $1, config = $2,
+updated_by_id = $3
+WHERE id = $4
+RETURNING *;
+
+-- name: RemoveNotificationChannelById :exec
+DELETE FROM neosync_api.notification_channels WHERE id = $1;
+
+-- name: GetJobNotificationSubscriptions :many
+SELECT * from neosync_api.job_notification_subscriptions
+WHERE job_id = $1
+ORDER BY created_at;
+
+-- name: RemoveJobNotificationSubscriptions :exec
+DELETE FROM neosync_api.job_notification_subscriptions WHERE job_id = $1;
+
+-- name: CreateJobNotificationSubscription :exec
+INSERT INTO neosync_api.job_notification_subscriptions (
+  job_id, channel_id, events
+) VALUES (
+  $1, $2, $3
+);
+
+-- name: GetNotificationChannelsByJobEvent :many
+SELECT nc.* from neosync_api.notification_channels nc
+INNER JOIN neosync_api.job_notification_subscriptions jns ON jns.channel_id = nc.id
+WHERE jns.job_id = sqlc.arg('jobId') AND sqlc.arg('eventType')::smallint = ANY(jns.events)
+ORDER BY nc.created_at;
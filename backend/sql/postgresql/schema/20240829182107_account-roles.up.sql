@@ -0,0 +1,8 @@
+-- 1 = admin, 2 = job editor, 3 = operator, 4 = viewer
+-- existing members are admins to preserve their current access
+ALTER TABLE neosync_api.account_user_associations
+ADD COLUMN IF NOT EXISTS role smallint NOT NULL DEFAULT 1;
+
+-- invites are least privileged unless a role is chosen when inviting
+ALTER TABLE neosync_api.account_invites
+ADD COLUMN IF NOT EXISTS role smallint NOT NULL DEFAULT 4;
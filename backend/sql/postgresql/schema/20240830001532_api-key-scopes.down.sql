@@ -0,0 +1,3 @@
+ALTER TABLE neosync_api.account_api_keys
+DROP COLUMN IF EXISTS job_ids,
+DROP COLUMN IF EXISTS scopes;
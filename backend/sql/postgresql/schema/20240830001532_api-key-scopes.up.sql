@@ -0,0 +1,4 @@
+-- keys without any scopes retain full access to their account
+ALTER TABLE neosync_api.account_api_keys
+ADD COLUMN IF NOT EXISTS scopes text[] NOT NULL DEFAULT '{}',
+ADD COLUMN IF NOT EXISTS job_ids uuid[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS neosync_api.idx_audit_events_account_id_created_at;
+DROP TABLE IF EXISTS neosync_api.audit_events;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS neosync_api.audit_events (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NULL,
+
+  actor_user_id uuid NULL,
+  actor_api_key_id uuid NULL,
+
+  procedure text NOT NULL,
+  resource_id text NULL,
+  request jsonb NOT NULL DEFAULT '{}',
+  error_code text NULL,
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT audit_events_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_audit_events_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_account_id_created_at
+ON neosync_api.audit_events (account_id, created_at DESC, id DESC);
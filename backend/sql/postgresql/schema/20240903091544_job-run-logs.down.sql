@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS neosync_api.idx_job_run_logs_created_at;
+DROP INDEX IF EXISTS neosync_api.idx_job_run_logs_account_id_job_run_id;
+DROP TABLE IF EXISTS neosync_api.job_run_logs;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_logs (
+  id bigserial NOT NULL,
+  account_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+
+  log_time timestamp NOT NULL,
+  level text NOT NULL,
+  message text NOT NULL,
+  activity text NULL,
+  schema_name text NULL,
+  table_name text NULL,
+  attributes jsonb NOT NULL DEFAULT '{}',
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT job_run_logs_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_job_run_logs_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_logs_account_id_job_run_id
+ON neosync_api.job_run_logs (account_id, job_run_id, id);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_logs_created_at
+ON neosync_api.job_run_logs (created_at);
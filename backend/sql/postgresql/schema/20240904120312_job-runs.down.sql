@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_neosync_api_job_runs_updated_at ON neosync_api.job_runs;
+DROP INDEX IF EXISTS neosync_api.idx_job_runs_job_id_started_at;
+DROP INDEX IF EXISTS neosync_api.idx_job_runs_account_id_started_at;
+DROP TABLE IF EXISTS neosync_api.job_runs;
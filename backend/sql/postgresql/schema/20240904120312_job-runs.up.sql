@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_runs (
+  id text NOT NULL,
+  account_id uuid NOT NULL,
+  job_id uuid NOT NULL,
+
+  status smallint NOT NULL,
+  started_at timestamp NOT NULL,
+  completed_at timestamp NULL,
+  tables jsonb NOT NULL DEFAULT '[]',
+  error_message text NULL,
+  triggered_by text NULL,
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT job_runs_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_job_runs_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_runs_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_account_id_started_at
+ON neosync_api.job_runs (account_id, started_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_id_started_at
+ON neosync_api.job_runs (job_id, started_at DESC, id DESC);
+
+CREATE TRIGGER update_neosync_api_job_runs_updated_at
+BEFORE UPDATE ON neosync_api.job_runs
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
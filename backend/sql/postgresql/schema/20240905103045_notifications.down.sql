@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_neosync_api_job_notification_subscriptions_updated_at ON neosync_api.job_notification_subscriptions;
+DROP INDEX IF EXISTS neosync_api.idx_job_notification_subscriptions_channel_id;
+DROP TABLE IF EXISTS neosync_api.job_notification_subscriptions;
+DROP TRIGGER IF EXISTS update_neosync_api_notification_channels_updated_at ON neosync_api.notification_channels;
+DROP TABLE IF EXISTS neosync_api.notification_channels;
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS neosync_api.notification_channels (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  name text NOT NULL,
+  config jsonb NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+
+  CONSTRAINT notification_channels_pkey PRIMARY KEY (id),
+  CONSTRAINT notification_channels_name_account_id UNIQUE (name, account_id),
+  CONSTRAINT fk_notification_channels_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_channels_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_notification_channels_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+
+CREATE TRIGGER update_neosync_api_notification_channels_updated_at
+BEFORE UPDATE ON neosync_api.notification_channels
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS neosync_api.job_notification_subscriptions (
+  job_id uuid NOT NULL,
+  channel_id uuid NOT NULL,
+  -- the mgmt.v1alpha1.NotificationEventType values the job is subscribed to
+  events smallint[] NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT job_notification_subscriptions_pkey PRIMARY KEY (job_id, channel_id),
+  CONSTRAINT fk_job_notification_subscriptions_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_notification_subscriptions_channels_id FOREIGN KEY (channel_id) REFERENCES neosync_api.notification_channels(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_notification_subscriptions_channel_id
+ON neosync_api.job_notification_subscriptions (channel_id);
+
+CREATE TRIGGER update_neosync_api_job_notification_subscriptions_updated_at
+BEFORE UPDATE ON neosync_api.job_notification_subscriptions
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1 @@
+ALTER TABLE neosync_api.job_runs DROP COLUMN IF EXISTS overrides;
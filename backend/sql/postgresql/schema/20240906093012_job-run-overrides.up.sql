@@ -0,0 +1 @@
+ALTER TABLE neosync_api.job_runs ADD COLUMN IF NOT EXISTS overrides jsonb NULL;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_neosync_api_account_job_mapping_policies_updated_at ON neosync_api.account_job_mapping_policies;
+DROP TABLE IF EXISTS neosync_api.account_job_mapping_policies;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS neosync_api.account_job_mapping_policies (
+  account_id uuid NOT NULL,
+  config jsonb NOT NULL DEFAULT '{}'::jsonb,
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+
+  CONSTRAINT account_job_mapping_policies_pkey PRIMARY KEY (account_id),
+  CONSTRAINT fk_account_job_mapping_policies_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_account_job_mapping_policies_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_account_job_mapping_policies_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+
+CREATE TRIGGER update_neosync_api_account_job_mapping_policies_updated_at
+BEFORE UPDATE ON neosync_api.account_job_mapping_policies
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_neosync_api_connection_column_tags_updated_at ON neosync_api.connection_column_tags;
+DROP TABLE IF EXISTS neosync_api.connection_column_tags;
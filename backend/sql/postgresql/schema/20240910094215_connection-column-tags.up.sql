@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS neosync_api.connection_column_tags (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  connection_id uuid NOT NULL,
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+  column_name text NOT NULL,
+  tags text[] NOT NULL DEFAULT '{}',
+
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+
+  CONSTRAINT connection_column_tags_pkey PRIMARY KEY (id),
+  CONSTRAINT connection_column_tags_connection_id_schema_table_column UNIQUE (connection_id, schema_name, table_name, column_name),
+  CONSTRAINT fk_connection_column_tags_connections_id FOREIGN KEY (connection_id) REFERENCES neosync_api.connections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_connection_column_tags_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_connection_column_tags_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+
+CREATE TRIGGER update_neosync_api_connection_column_tags_updated_at
+BEFORE UPDATE ON neosync_api.connection_column_tags
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS neosync_api.idx_job_runs_open_started_at;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_job_runs_open_started_at
+ON neosync_api.job_runs (started_at, id)
+WHERE status IN (1, 2);
@@ -0,0 +1 @@
+ALTER TABLE neosync_api.audit_events DROP COLUMN IF EXISTS changes;
@@ -0,0 +1,2 @@
+-- the fields that an update changed on the stored resource
+ALTER TABLE neosync_api.audit_events ADD COLUMN IF NOT EXISTS changes jsonb NULL;
@@ -35,6 +35,13 @@ type InputConfig struct {
 
 type Inputs struct {
 	NeosyncConnectionData *NeosyncConnectionData `json:"neosync_connection_data,omitempty" yaml:"neosync_connection_data,omitempty"`
+	PooledSqlRaw          *InputPooledSqlRaw     `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
+}
+
+type InputPooledSqlRaw struct {
+	Driver string `json:"driver" yaml:"driver"`
+	Dsn    string `json:"dsn" yaml:"dsn"`
+	Query  string `json:"query" yaml:"query"`
 }
 
 type NeosyncConnectionData struct {
@@ -56,6 +63,7 @@ type PipelineConfig struct {
 }
 
 type ProcessorConfig struct {
+	Mutation *string `json:"mutation,omitempty" yaml:"mutation,omitempty"`
 }
 
 type BranchConfig struct {
@@ -0,0 +1,147 @@
+package audit_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+type listAuditEventsConfig struct {
+	accountId  string
+	resourceId string
+	pageSize   uint32
+	pageToken  string
+}
+
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list audit events, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			cfg := &listAuditEventsConfig{}
+			cfg.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			cfg.resourceId, err = cmd.Flags().GetString("resource-id")
+			if err != nil {
+				return err
+			}
+			cfg.pageSize, err = cmd.Flags().GetUint32("page-size")
+			if err != nil {
+				return err
+			}
+			cfg.pageToken, err = cmd.Flags().GetString("page-token")
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return listAuditEvents(cmd.Context(), &apiKey, cfg)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list audit events for. Defaults to account id in cli context")
+	cmd.Flags().String("resource-id", "", "Only list audit events for the given resource id")
+	cmd.Flags().Uint32("page-size", 50, "The maximum number of events to list")
+	cmd.Flags().String("page-token", "", "The page token printed by a previous list to retrieve the next page of events")
+	return cmd
+}
+
+func listAuditEvents(
+	ctx context.Context,
+	apiKey *string,
+	cfg *listAuditEventsConfig,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountId := cfg.accountId
+	if accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = aId
+	}
+
+	if accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	auditclient := mgmtv1alpha1connect.NewAuditServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	)
+	req := &mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: accountId,
+		PageSize:  cfg.pageSize,
+	}
+	if cfg.resourceId != "" {
+		req.ResourceId = &cfg.resourceId
+	}
+	if cfg.pageToken != "" {
+		req.PageToken = &cfg.pageToken
+	}
+	res, err := auditclient.GetAuditEvents(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printAuditEventTable(res.Msg.GetEvents())
+	fmt.Println() //nolint:forbidigo
+	if res.Msg.GetNextPageToken() != "" {
+		fmt.Printf("More events are available. Use --page-token %s to view the next page.\n\n", res.Msg.GetNextPageToken()) //nolint:forbidigo
+	}
+	return nil
+}
+
+func printAuditEventTable(
+	events []*mgmtv1alpha1.AuditEvent,
+) {
+	tbl := table.
+		New("Created At", "Procedure", "Resource Id", "Actor", "Api Key Id", "Error").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, event := range events {
+		tbl.AddRow(
+			event.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			event.GetProcedure(),
+			event.GetResourceId(),
+			event.GetActorUserId(),
+			event.GetActorApiKeyId(),
+			event.GetErrorCode(),
+		)
+	}
+	tbl.Print()
+}
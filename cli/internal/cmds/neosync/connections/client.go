@@ -0,0 +1,25 @@
+package connections_cmd
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+)
+
+func newConnectionDataClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.ConnectionDataServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewConnectionDataServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
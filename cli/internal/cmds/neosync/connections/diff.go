@@ -0,0 +1,200 @@
+package connections_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [source-id] [destination-id]",
+		Short: "compare the schemas of two connections",
+		Long: `Compares the tables, columns, constraints and triggers of the source connection against the destination connection.
+With --ddl the statements that bring the destination schema in line with the source schema are printed. The statements are not run.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourceId, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid source connection id: %w", err)
+			}
+			destinationId, err := uuid.Parse(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid destination connection id: %w", err)
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			schemas, err := cmd.Flags().GetStringSlice("schema")
+			if err != nil {
+				return err
+			}
+			ddl, err := cmd.Flags().GetBool("ddl")
+			if err != nil {
+				return err
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return diffConnections(cmd.Context(), &apiKey, &mgmtv1alpha1.CompareConnectionSchemasRequest{
+				SourceConnectionId:         sourceId.String(),
+				DestinationConnectionId:    destinationId.String(),
+				Schemas:                    schemas,
+				IncludeMigrationStatements: ddl,
+			}, format)
+		},
+	}
+	cmd.Flags().StringSlice("schema", nil, "Only compare the tables in these schemas. Can be repeated or comma separated. Defaults to all schemas")
+	cmd.Flags().Bool("ddl", false, "Print the statements that bring the destination schema in line with the source schema. Only supported between two postgres or two mysql connections")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func diffConnections(
+	ctx context.Context,
+	apiKey *string,
+	req *mgmtv1alpha1.CompareConnectionSchemasRequest,
+	format output.Format,
+) error {
+	client, err := newConnectionDataClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	res, err := client.CompareConnectionSchemas(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if format != output.TableFormat {
+		return output.WriteMessage(os.Stdout, format, res.Msg)
+	}
+
+	if len(res.Msg.GetTables()) == 0 {
+		fmt.Println("The source and destination schemas are in sync") //nolint:forbidigo
+		return nil
+	}
+	fmt.Println() //nolint:forbidigo
+	printSchemaDiff(res.Msg.GetTables())
+	fmt.Println() //nolint:forbidigo
+	if req.GetIncludeMigrationStatements() {
+		if len(res.Msg.GetMigrationStatements()) == 0 {
+			fmt.Println("-- no migration statements are required") //nolint:forbidigo
+			return nil
+		}
+		fmt.Println(strings.Join(res.Msg.GetMigrationStatements(), "\n")) //nolint:forbidigo
+	}
+	return nil
+}
+
+func printSchemaDiff(tables []*mgmtv1alpha1.TableSchemaDiff) {
+	tbl := table.
+		New("Table", "Object", "Name", "Difference", "Source", "Destination").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+	for _, row := range getSchemaDiffRows(tables) {
+		tbl.AddRow(row...)
+	}
+	tbl.Print()
+}
+
+// Returns a row for every difference: table, object, name, difference, source, destination
+func getSchemaDiffRows(tables []*mgmtv1alpha1.TableSchemaDiff) [][]any {
+	rows := [][]any{}
+	for _, diff := range tables {
+		tableName := fmt.Sprintf("%s.%s", diff.GetSchema(), diff.GetTable())
+		if diff.GetType() != mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED {
+			rows = append(rows, []any{tableName, "table", "", formatSchemaDiffType(diff.GetType()), "", ""})
+		}
+		for _, col := range diff.GetColumns() {
+			rows = append(rows, []any{
+				tableName,
+				"column",
+				col.GetColumn(),
+				formatSchemaDiffType(col.GetType()),
+				formatColumnDefinition(col.Source),
+				formatColumnDefinition(col.Destination),
+			})
+		}
+		for _, constraint := range diff.GetConstraints() {
+			rows = append(rows, []any{
+				tableName,
+				formatConstraintType(constraint.GetConstraintType()),
+				formatConstraint(constraint),
+				formatSchemaDiffType(constraint.GetType()),
+				"",
+				"",
+			})
+		}
+		for _, trigger := range diff.GetTriggers() {
+			rows = append(rows, []any{tableName, "trigger", trigger.GetName(), formatSchemaDiffType(trigger.GetType()), "", ""})
+		}
+	}
+	return rows
+}
+
+func formatSchemaDiffType(diffType mgmtv1alpha1.SchemaDiffType) string {
+	switch diffType {
+	case mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION:
+		return "missing in destination"
+	case mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_SOURCE:
+		return "missing in source"
+	case mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+func formatConstraintType(constraintType mgmtv1alpha1.SchemaConstraintType) string {
+	switch constraintType {
+	case mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_PRIMARY_KEY:
+		return "primary key"
+	case mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_FOREIGN_KEY:
+		return "foreign key"
+	case mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_UNIQUE:
+		return "unique"
+	default:
+		return "constraint"
+	}
+}
+
+// Formats the constraint columns, e.g. (user_id) -> public.users (id)
+func formatConstraint(constraint *mgmtv1alpha1.ConstraintSchemaDiff) string {
+	columns := fmt.Sprintf("(%s)", strings.Join(constraint.GetColumns(), ", "))
+	if constraint.GetForeignKey() == nil {
+		return columns
+	}
+	return fmt.Sprintf("%s -> %s (%s)", columns, constraint.GetForeignKey().GetTable(), strings.Join(constraint.GetForeignKey().GetColumns(), ", "))
+}
+
+// Formats the column definition, e.g. varchar(255) NOT NULL DEFAULT 'x'
+func formatColumnDefinition(definition *mgmtv1alpha1.ColumnSchemaDefinition) string {
+	if definition == nil {
+		return ""
+	}
+	pieces := []string{definition.GetDataType()}
+	if definition.GetIsNullable() {
+		pieces = append(pieces, "NULL")
+	} else {
+		pieces = append(pieces, "NOT NULL")
+	}
+	if definition.ColumnDefault != nil {
+		pieces = append(pieces, "DEFAULT", definition.GetColumnDefault())
+	}
+	return strings.Join(pieces, " ")
+}
@@ -0,0 +1,46 @@
+package connections_cmd
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getSchemaDiffRows(t *testing.T) {
+	columnDefault := "now()"
+	tables := []*mgmtv1alpha1.TableSchemaDiff{
+		{Schema: "public", Table: "orders", Type: mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION},
+		{
+			Schema: "public",
+			Table:  "users",
+			Type:   mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED,
+			Columns: []*mgmtv1alpha1.ColumnSchemaDiff{
+				{
+					Column:      "created",
+					Type:        mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED,
+					Source:      &mgmtv1alpha1.ColumnSchemaDefinition{DataType: "timestamp", ColumnDefault: &columnDefault},
+					Destination: &mgmtv1alpha1.ColumnSchemaDefinition{DataType: "timestamp", IsNullable: true},
+				},
+			},
+			Constraints: []*mgmtv1alpha1.ConstraintSchemaDiff{
+				{
+					ConstraintType: mgmtv1alpha1.SchemaConstraintType_SCHEMA_CONSTRAINT_TYPE_FOREIGN_KEY,
+					Type:           mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_MISSING_IN_SOURCE,
+					Columns:        []string{"account_id"},
+					ForeignKey:     &mgmtv1alpha1.ForeignKey{Table: "public.accounts", Columns: []string{"id"}},
+				},
+			},
+			Triggers: []*mgmtv1alpha1.TriggerSchemaDiff{
+				{Name: "audit", Type: mgmtv1alpha1.SchemaDiffType_SCHEMA_DIFF_TYPE_CHANGED},
+			},
+		},
+	}
+
+	require.Equal(t, [][]any{
+		{"public.orders", "table", "", "missing in destination", "", ""},
+		{"public.users", "column", "created", "changed", "timestamp NOT NULL DEFAULT now()", "timestamp NULL"},
+		{"public.users", "foreign key", "(account_id) -> public.accounts (id)", "missing in source", "", ""},
+		{"public.users", "trigger", "audit", "changed", "", ""},
+	}, getSchemaDiffRows(tables))
+}
@@ -0,0 +1,110 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+)
+
+func newJobClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.JobServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewJobServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
+
+func newConnectionClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.ConnectionServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewConnectionServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
+
+func newConnectionDataClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.ConnectionDataServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewConnectionDataServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
+
+func newTransformersClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.TransformersServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewTransformersServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
+
+func getAccountId(accountIdFlag string) (string, error) {
+	if accountIdFlag != "" {
+		return accountIdFlag, nil
+	}
+	accountId, err := userconfig.GetAccountId()
+	if err != nil {
+		fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+		return "", err
+	}
+	if accountId == "" {
+		return "", errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+	return accountId, nil
+}
+
+func parseJobIdArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("must provide job uuid as argument")
+	}
+	jobUuid, err := uuid.Parse(args[0])
+	if err != nil {
+		return "", err
+	}
+	return jobUuid.String(), nil
+}
+
+// Retrieves the job and verifies that it is in the given account
+func getAccountJob(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	jobId, accountId string,
+) (*mgmtv1alpha1.Job, error) {
+	job, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if job.Msg.GetJob().GetAccountId() != accountId {
+		return nil, fmt.Errorf("Job not found. AccountId: %s", accountId)
+	}
+	return job.Msg.GetJob(), nil
+}
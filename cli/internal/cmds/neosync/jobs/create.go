@@ -0,0 +1,136 @@
+package jobs_cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+func newCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a job from a yaml or json file",
+		Long: `Creates a job from a yaml or json file that contains a CreateJobRequest, e.g.
+
+jobName: nightly-sync
+cronSchedule: 0 0 * * *
+source:
+  options:
+    postgres:
+      connectionId: 3b1f8a2e-2c4b-4b8e-9a53-0e6d9f7c1a01
+destinations:
+  - connectionId: 7c2e9d4f-1a3b-4c5d-8e6f-0a1b2c3d4e02
+    options:
+      postgresOptions: {}
+mappings:
+  - schema: public
+    table: users
+    column: email
+    transformer:
+      source: TRANSFORMER_SOURCE_TRANSFORM_EMAIL
+      config:
+        transformEmailConfig: {}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return createJob(cmd.Context(), file, name, &apiKey, accountId, format)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to create the job in. Defaults to account id in cli context")
+	cmd.Flags().StringP("file", "f", "", "Path to the yaml or json file that describes the job")
+	cmd.Flags().String("name", "", "Name of the job. Takes precedence over the jobName in the file")
+	_ = cmd.MarkFlagRequired("file")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func createJob(
+	ctx context.Context,
+	file, name string,
+	apiKey *string,
+	accountIdFlag string,
+	format output.Format,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	req, err := readCreateJobRequest(file)
+	if err != nil {
+		return err
+	}
+	req.AccountId = accountId
+	if name != "" {
+		req.JobName = name
+	}
+	if req.GetJobName() == "" {
+		return errors.New("must provide a job name with jobName or --name")
+	}
+
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	resp, err := jobclient.CreateJob(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	job := resp.Msg.GetJob()
+	if format != output.TableFormat {
+		return output.WriteMessage(os.Stdout, format, job)
+	}
+	fmt.Println() //nolint:forbidigo
+	printJobDetails(job, mgmtv1alpha1.JobStatus_JOB_STATUS_ENABLED)
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+// Reads a CreateJobRequest from a yaml or json file. Yaml is a superset of json, so both are decoded as yaml.
+func readCreateJobRequest(path string) (*mgmtv1alpha1.CreateJobRequest, error) {
+	bits, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read job file: %w", err)
+	}
+	var raw map[string]any
+	if err := yaml.Unmarshal(bits, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse job file: %w", err)
+	}
+	jsonBits, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	req := &mgmtv1alpha1.CreateJobRequest{}
+	if err := protojson.Unmarshal(jsonBits, req); err != nil {
+		return nil, fmt.Errorf("invalid job file: %w", err)
+	}
+	return req, nil
+}
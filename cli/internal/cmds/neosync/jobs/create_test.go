@@ -0,0 +1,40 @@
+package jobs_cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readCreateJobRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.yaml")
+	err := os.WriteFile(path, []byte(`
+jobName: nightly-sync
+cronSchedule: 0 0 * * *
+source:
+  options:
+    postgres:
+      connectionId: 3b1f8a2e-2c4b-4b8e-9a53-0e6d9f7c1a01
+destinations:
+  - connectionId: 7c2e9d4f-1a3b-4c5d-8e6f-0a1b2c3d4e02
+    options:
+      postgresOptions: {}
+`), 0600)
+	require.NoError(t, err)
+
+	req, err := readCreateJobRequest(path)
+	require.NoError(t, err)
+	require.Equal(t, "nightly-sync", req.GetJobName())
+	require.Equal(t, "0 0 * * *", req.GetCronSchedule())
+	require.Equal(t, "3b1f8a2e-2c4b-4b8e-9a53-0e6d9f7c1a01", req.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Len(t, req.GetDestinations(), 1)
+	require.NotNil(t, req.GetDestinations()[0].GetOptions().GetPostgresOptions())
+
+	jsonPath := filepath.Join(t.TempDir(), "job.json")
+	err = os.WriteFile(jsonPath, []byte(`{"jobName": "nightly-sync", "unknownField": true}`), 0600)
+	require.NoError(t, err)
+	_, err = readCreateJobRequest(jsonPath)
+	require.ErrorContains(t, err, "invalid job file")
+}
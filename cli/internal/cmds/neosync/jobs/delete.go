@@ -0,0 +1,63 @@
+package jobs_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [id]",
+		Short: "delete a job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId, err := parseJobIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return deleteJob(cmd.Context(), jobId, &apiKey, accountId)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	return cmd
+}
+
+func deleteJob(
+	ctx context.Context,
+	jobId string,
+	apiKey *string,
+	accountIdFlag string,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	job, err := getAccountJob(ctx, jobclient, jobId, accountId)
+	if err != nil {
+		return err
+	}
+	_, err = jobclient.DeleteJob(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Deleted job %s (%s)\n", job.GetName(), jobId) //nolint:forbidigo
+	return nil
+}
@@ -0,0 +1,113 @@
+package jobs_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func newGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [id]",
+		Short: "get a job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId, err := parseJobIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return getJob(cmd.Context(), jobId, &apiKey, accountId, format)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func getJob(
+	ctx context.Context,
+	jobId string,
+	apiKey *string,
+	accountIdFlag string,
+	format output.Format,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	job, err := getAccountJob(ctx, jobclient, jobId, accountId)
+	if err != nil {
+		return err
+	}
+	if format != output.TableFormat {
+		return output.WriteMessage(os.Stdout, format, job)
+	}
+
+	status, err := jobclient.GetJobStatus(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobStatusRequest{
+		JobId: jobId,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Println() //nolint:forbidigo
+	printJobDetails(job, status.Msg.GetStatus())
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printJobDetails(job *mgmtv1alpha1.Job, status mgmtv1alpha1.JobStatus) {
+	tbl := table.
+		New("Id", "Name", "Status", "Source", "Schedule", "Destinations", "Mappings", "Created At", "Updated At").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+	tbl.AddRow(
+		job.GetId(),
+		job.GetName(),
+		status.String(),
+		getJobSourceType(job),
+		job.GetCronSchedule(),
+		len(job.GetDestinations()),
+		len(job.GetMappings()),
+		job.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+		job.GetUpdatedAt().AsTime().Local().Format(time.RFC3339),
+	)
+	tbl.Print()
+}
+
+// Returns the name of the configured source, e.g. postgres or generate
+func getJobSourceType(job *mgmtv1alpha1.Job) string {
+	options := job.GetSource().GetOptions().ProtoReflect()
+	field := options.WhichOneof(options.Descriptor().Oneofs().ByName("config"))
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
@@ -0,0 +1,420 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/manifest"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+type initJobOptions struct {
+	name                     string
+	sourceConnectionId       string
+	destinationConnectionIds []string
+	schemas                  []string
+	cronSchedule             string
+	manifestPath             string
+	yes                      bool
+	scanPii                  bool
+}
+
+func newInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "interactively build the mappings of a new sync job",
+		Long: `Reads the schema of the source connection, suggests a transformer for every column based on its name and data type,
+and opens a table to review and edit the suggestions. Once saved, the job is created, or written to a manifest with --manifest.
+With --scan-pii, the source tables are sampled to detect PII and the transformers recommended for the detected columns are suggested instead.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			opts := &initJobOptions{}
+			opts.name, err = cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			opts.sourceConnectionId, err = cmd.Flags().GetString("source-connection-id")
+			if err != nil {
+				return err
+			}
+			opts.destinationConnectionIds, err = cmd.Flags().GetStringSlice("destination-connection-id")
+			if err != nil {
+				return err
+			}
+			opts.schemas, err = cmd.Flags().GetStringSlice("schema")
+			if err != nil {
+				return err
+			}
+			opts.cronSchedule, err = cmd.Flags().GetString("cron-schedule")
+			if err != nil {
+				return err
+			}
+			opts.manifestPath, err = cmd.Flags().GetString("manifest")
+			if err != nil {
+				return err
+			}
+			opts.yes, err = cmd.Flags().GetBool("yes")
+			if err != nil {
+				return err
+			}
+			opts.scanPii, err = cmd.Flags().GetBool("scan-pii")
+			if err != nil {
+				return err
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := uuid.Parse(opts.sourceConnectionId); err != nil {
+				return fmt.Errorf("invalid source connection id: %w", err)
+			}
+			for _, id := range opts.destinationConnectionIds {
+				if _, err := uuid.Parse(id); err != nil {
+					return fmt.Errorf("invalid destination connection id %q: %w", id, err)
+				}
+			}
+			cmd.SilenceUsage = true
+			return initJob(cmd.Context(), &apiKey, accountId, opts, format)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to create the job in. Defaults to account id in cli context")
+	cmd.Flags().String("name", "", "Name of the job")
+	cmd.Flags().String("source-connection-id", "", "The postgres, mysql or mssql connection to sync from")
+	cmd.Flags().StringSlice("destination-connection-id", nil, "Connection to sync to. Can be repeated or comma separated")
+	cmd.Flags().StringSlice("schema", nil, "Only include the tables in these schemas. Can be repeated or comma separated. Defaults to all schemas")
+	cmd.Flags().String("cron-schedule", "", "The cron schedule of the job. Defaults to no schedule")
+	cmd.Flags().String("manifest", "", "Write the job to this manifest file instead of creating it. Use - to write to stdout")
+	cmd.Flags().BoolP("yes", "y", false, "Accept the suggested transformers without opening the interactive table")
+	cmd.Flags().Bool("scan-pii", false, "Sample the source tables to detect PII and suggest transformers from the detected values. Only supported for postgres and mysql sources")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("source-connection-id")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func initJob(
+	ctx context.Context,
+	apiKey *string,
+	accountIdFlag string,
+	opts *initJobOptions,
+	format output.Format,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	connectionclient, err := newConnectionClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	connectiondataclient, err := newConnectionDataClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	transformerclient, err := newTransformersClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+
+	source, err := getAccountConnection(ctx, connectionclient, opts.sourceConnectionId, accountId)
+	if err != nil {
+		return err
+	}
+	jobSource, schemaConfig, err := getInitJobSource(source)
+	if err != nil {
+		return err
+	}
+	destinations := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(opts.destinationConnectionIds))
+	for _, id := range opts.destinationConnectionIds {
+		connection, err := getAccountConnection(ctx, connectionclient, id, accountId)
+		if err != nil {
+			return err
+		}
+		destination, err := getInitJobDestination(connection)
+		if err != nil {
+			return err
+		}
+		destinations = append(destinations, destination)
+	}
+
+	schemaResp, err := connectiondataclient.GetConnectionSchema(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
+		ConnectionId: source.GetId(),
+		SchemaConfig: schemaConfig,
+	}))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve source connection schema: %w", err)
+	}
+	columns := filterColumnsBySchema(schemaResp.Msg.GetSchemas(), opts.schemas)
+	if len(columns) == 0 {
+		return errors.New("no tables were found in the source connection")
+	}
+	transformerResp, err := transformerclient.GetSystemTransformers(ctx, connect.NewRequest(&mgmtv1alpha1.GetSystemTransformersRequest{}))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve system transformers: %w", err)
+	}
+	transformers := transformerResp.Msg.GetTransformers()
+
+	var recommendations map[string]*mgmtv1alpha1.JobMappingTransformer
+	if opts.scanPii {
+		scanResp, err := connectiondataclient.ScanConnectionForPii(ctx, connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+			ConnectionId: source.GetId(),
+			Schemas:      opts.schemas,
+		}))
+		if err != nil {
+			return fmt.Errorf("unable to scan the source connection for pii: %w", err)
+		}
+		recommendations = getPiiRecommendations(scanResp.Msg.GetColumns())
+	}
+
+	rows, err := getSuggestedMappingRows(columns, transformers, recommendations)
+	if err != nil {
+		return err
+	}
+
+	if !opts.yes {
+		model := newMappingsModel(rows, transformers)
+		if _, err := tea.NewProgram(model, tea.WithAltScreen()).Run(); err != nil {
+			return fmt.Errorf("unable to run the mappings table: %w", err)
+		}
+		if !model.saved {
+			return errors.New("job init was canceled, no job was created")
+		}
+	}
+
+	req := &mgmtv1alpha1.CreateJobRequest{
+		AccountId:    accountId,
+		JobName:      opts.name,
+		Source:       jobSource,
+		Destinations: destinations,
+		Mappings:     toJobMappings(rows),
+	}
+	if opts.cronSchedule != "" {
+		req.CronSchedule = &opts.cronSchedule
+	}
+
+	if opts.manifestPath != "" {
+		state, err := manifest.LoadState(ctx, &manifest.Clients{
+			Connections:  connectionclient,
+			Transformers: transformerclient,
+			Jobs:         jobclient,
+		}, accountId)
+		if err != nil {
+			return err
+		}
+		return writeJobManifest(state, req, opts.manifestPath)
+	}
+
+	resp, err := jobclient.CreateJob(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	job := resp.Msg.GetJob()
+	if format != output.TableFormat {
+		return output.WriteMessage(os.Stdout, format, job)
+	}
+	fmt.Println() //nolint:forbidigo
+	printJobDetails(job, mgmtv1alpha1.JobStatus_JOB_STATUS_ENABLED)
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+// Retrieves the connection and verifies that it is in the given account
+func getAccountConnection(
+	ctx context.Context,
+	connectionclient mgmtv1alpha1connect.ConnectionServiceClient,
+	connectionId, accountId string,
+) (*mgmtv1alpha1.Connection, error) {
+	resp, err := connectionclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Msg.GetConnection().GetAccountId() != accountId {
+		return nil, fmt.Errorf("Connection not found. AccountId: %s", accountId)
+	}
+	return resp.Msg.GetConnection(), nil
+}
+
+func getInitJobSource(connection *mgmtv1alpha1.Connection) (*mgmtv1alpha1.JobSource, *mgmtv1alpha1.ConnectionSchemaConfig, error) {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+				Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: connection.GetId()},
+			}}},
+			&mgmtv1alpha1.ConnectionSchemaConfig{Config: &mgmtv1alpha1.ConnectionSchemaConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresSchemaConfig{}}},
+			nil
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{Config: &mgmtv1alpha1.JobSourceOptions_Mysql{
+				Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{ConnectionId: connection.GetId()},
+			}}},
+			&mgmtv1alpha1.ConnectionSchemaConfig{Config: &mgmtv1alpha1.ConnectionSchemaConfig_MysqlConfig{MysqlConfig: &mgmtv1alpha1.MysqlSchemaConfig{}}},
+			nil
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{Config: &mgmtv1alpha1.JobSourceOptions_Mssql{
+				Mssql: &mgmtv1alpha1.MssqlSourceConnectionOptions{ConnectionId: connection.GetId()},
+			}}},
+			&mgmtv1alpha1.ConnectionSchemaConfig{Config: &mgmtv1alpha1.ConnectionSchemaConfig_MssqlConfig{MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{}}},
+			nil
+	default:
+		return nil, nil, fmt.Errorf("connection %s is not a supported source, must be a postgres, mysql or mssql connection", connection.GetName())
+	}
+}
+
+func getInitJobDestination(connection *mgmtv1alpha1.Connection) (*mgmtv1alpha1.CreateJobDestination, error) {
+	options := &mgmtv1alpha1.JobDestinationOptions{}
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		options.Config = &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{}}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		options.Config = &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{}}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		options.Config = &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{}}
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		options.Config = &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{}}
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		options.Config = &mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions{GcpCloudstorageOptions: &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{}}
+	default:
+		return nil, fmt.Errorf("connection %s is not a supported destination, must be a postgres, mysql, mssql, aws s3 or gcp cloud storage connection", connection.GetName())
+	}
+	return &mgmtv1alpha1.CreateJobDestination{ConnectionId: connection.GetId(), Options: options}, nil
+}
+
+// Returns the columns of the given schemas sorted by schema and table. The column order of each table is kept.
+func filterColumnsBySchema(columns []*mgmtv1alpha1.DatabaseColumn, schemas []string) []*mgmtv1alpha1.DatabaseColumn {
+	filtered := []*mgmtv1alpha1.DatabaseColumn{}
+	for _, column := range columns {
+		if len(schemas) > 0 && !slices.Contains(schemas, column.GetSchema()) {
+			continue
+		}
+		filtered = append(filtered, column)
+	}
+	slices.SortStableFunc(filtered, func(a, b *mgmtv1alpha1.DatabaseColumn) int {
+		if a.GetSchema() != b.GetSchema() {
+			return strings.Compare(a.GetSchema(), b.GetSchema())
+		}
+		return strings.Compare(a.GetTable(), b.GetTable())
+	})
+	return filtered
+}
+
+// Builds a mapping row for every column with the transformer that is suggested for it.
+// Transformers recommended by the pii scan, keyed by schema.table.column, take precedence over the suggestions from the column name.
+func getSuggestedMappingRows(
+	columns []*mgmtv1alpha1.DatabaseColumn,
+	transformers []*mgmtv1alpha1.SystemTransformer,
+	recommendations map[string]*mgmtv1alpha1.JobMappingTransformer,
+) ([]*mappingRow, error) {
+	bySource := map[mgmtv1alpha1.TransformerSource]*mgmtv1alpha1.SystemTransformer{}
+	for _, transformer := range transformers {
+		bySource[transformer.GetSource()] = transformer
+	}
+	passthrough, ok := bySource[mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH]
+	if !ok {
+		return nil, errors.New("the passthrough transformer was not returned by the server")
+	}
+
+	rows := make([]*mappingRow, 0, len(columns))
+	for _, column := range columns {
+		suggested, ok := bySource[suggestTransformerSource(column)]
+		if !ok {
+			suggested = passthrough
+		}
+		recommended, ok := recommendations[getColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())]
+		if ok && column.GeneratedType == nil {
+			if transformer, ok := bySource[recommended.GetSource()]; ok {
+				// keeps the name and description of the system transformer with the configuration recommended by the scan
+				suggested = proto.Clone(transformer).(*mgmtv1alpha1.SystemTransformer)
+				suggested.Config = recommended.GetConfig()
+			}
+		}
+		rows = append(rows, &mappingRow{column: column, suggested: suggested, transformer: suggested})
+	}
+	return rows, nil
+}
+
+// Returns the transformers recommended for the columns that the pii scan detected PII in, keyed by schema.table.column
+func getPiiRecommendations(results []*mgmtv1alpha1.ColumnPiiScanResult) map[string]*mgmtv1alpha1.JobMappingTransformer {
+	recommendations := map[string]*mgmtv1alpha1.JobMappingTransformer{}
+	for _, result := range results {
+		transformer := result.GetRecommendedTransformer()
+		if transformer == nil || transformer.GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH {
+			continue
+		}
+		recommendations[getColumnKey(result.GetSchema(), result.GetTable(), result.GetColumn())] = transformer
+	}
+	return recommendations
+}
+
+func getColumnKey(schema, table, column string) string {
+	return fmt.Sprintf("%s.%s.%s", schema, table, column)
+}
+
+func toJobMappings(rows []*mappingRow) []*mgmtv1alpha1.JobMapping {
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(rows))
+	for _, row := range rows {
+		mappings = append(mappings, &mgmtv1alpha1.JobMapping{
+			Schema: row.column.GetSchema(),
+			Table:  row.column.GetTable(),
+			Column: row.column.GetColumn(),
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: row.transformer.GetSource(),
+				Config: proto.Clone(row.transformer.GetConfig()).(*mgmtv1alpha1.TransformerConfig),
+			},
+		})
+	}
+	return mappings
+}
+
+// Writes the job as a manifest. The manifest is json if the file has a .json extension and yaml otherwise.
+func writeJobManifest(state *manifest.State, req *mgmtv1alpha1.CreateJobRequest, path string) error {
+	resource, err := state.NewJobResource(req.GetJobName(), req)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = manifest.EncodeJson(w, []*manifest.Resource{resource})
+	} else {
+		err = manifest.EncodeYaml(w, []*manifest.Resource{resource})
+	}
+	if err != nil {
+		return err
+	}
+	if path != "-" {
+		fmt.Printf("Wrote job %s to %s\n", req.GetJobName(), path) //nolint:forbidigo
+	}
+	return nil
+}
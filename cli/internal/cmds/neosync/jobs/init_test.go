@@ -0,0 +1,149 @@
+package jobs_cmd
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_filterColumnsBySchema(t *testing.T) {
+	columns := []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "audit", Table: "logs", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email"},
+	}
+
+	actual := filterColumnsBySchema(columns, []string{"public"})
+	require.Len(t, actual, 3)
+	require.Equal(t, "orders", actual[0].GetTable())
+	require.Equal(t, "id", actual[1].GetColumn())
+	require.Equal(t, "email", actual[2].GetColumn())
+
+	require.Len(t, filterColumnsBySchema(columns, nil), 4)
+}
+
+func Test_getSuggestedMappingRows(t *testing.T) {
+	columns := []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "users", Column: "id", DataType: "uuid"},
+		{Schema: "public", Table: "users", Column: "email", DataType: "text"},
+		{Schema: "public", Table: "users", Column: "first_name", DataType: "text"},
+	}
+	rows, err := getSuggestedMappingRows(columns, getTestSystemTransformers(), nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, rows[0].transformer.GetSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, rows[1].transformer.GetSource())
+	// the first name transformer was not returned by the server
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, rows[2].transformer.GetSource())
+
+	mappings := toJobMappings(rows)
+	require.Len(t, mappings, 3)
+	require.Equal(t, "email", mappings[1].GetColumn())
+	require.NotNil(t, mappings[1].GetTransformer().GetConfig().GetTransformEmailConfig())
+
+	_, err = getSuggestedMappingRows(columns, nil, nil)
+	require.Error(t, err)
+}
+
+func Test_getSuggestedMappingRows_PiiRecommendations(t *testing.T) {
+	columns := []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "users", Column: "id", DataType: "uuid"},
+		{Schema: "public", Table: "users", Column: "contact", DataType: "text"},
+	}
+	invalidEmailAction := mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_GENERATE
+	recommendations := getPiiRecommendations([]*mgmtv1alpha1.ColumnPiiScanResult{
+		{
+			Schema: "public", Table: "users", Column: "id",
+			RecommendedTransformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		},
+		{
+			Schema: "public", Table: "users", Column: "contact",
+			PiiType: mgmtv1alpha1.PiiType_PII_TYPE_EMAIL,
+			RecommendedTransformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{InvalidEmailAction: &invalidEmailAction},
+				}},
+			},
+		},
+	})
+	require.Len(t, recommendations, 1)
+
+	transformers := getTestSystemTransformers()
+	rows, err := getSuggestedMappingRows(columns, transformers, recommendations)
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, rows[0].transformer.GetSource())
+	require.Equal(t, "Transform Email", rows[1].transformer.GetName())
+	require.Equal(t, invalidEmailAction, rows[1].transformer.GetConfig().GetTransformEmailConfig().GetInvalidEmailAction())
+	// the system transformer is not modified
+	require.Nil(t, transformers[1].GetConfig().GetTransformEmailConfig().InvalidEmailAction)
+}
+
+func Test_getInitJobSource(t *testing.T) {
+	source, schemaConfig, err := getInitJobSource(&mgmtv1alpha1.Connection{
+		Id:               "conn-id",
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "conn-id", source.GetOptions().GetMysql().GetConnectionId())
+	require.NotNil(t, schemaConfig.GetMysqlConfig())
+
+	_, _, err = getInitJobSource(&mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{}},
+	})
+	require.Error(t, err)
+}
+
+func Test_mappingsModel(t *testing.T) {
+	columns := []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "users", Column: "email", DataType: "text", IsNullable: "YES"},
+		{Schema: "public", Table: "users", Column: "id", DataType: "uuid", IsNullable: "NO"},
+	}
+	transformers := getTestSystemTransformers()
+	rows, err := getSuggestedMappingRows(columns, transformers, nil)
+	require.NoError(t, err)
+	m := newMappingsModel(rows, transformers)
+
+	// passthrough, then reset to the suggestion
+	m.Update(keyMsg("p"))
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, rows[0].transformer.GetSource())
+	require.Equal(t, 0, m.getTransformedCount())
+	m.Update(keyMsg("r"))
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, rows[0].transformer.GetSource())
+
+	// picks the null transformer from the compatible transformers of the column
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, m.picker)
+	require.Equal(t, []string{"Passthrough", "Transform Email", "Null"}, getTransformerNames(m.pickerOptions))
+	require.Equal(t, 1, m.picker.Cursor())
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, m.picker)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL, rows[0].transformer.GetSource())
+	require.Contains(t, m.View(), "Null *")
+
+	_, cmd := m.Update(keyMsg("s"))
+	require.True(t, m.saved)
+	require.NotNil(t, cmd)
+}
+
+func Test_mappingsModel_Quit(t *testing.T) {
+	transformers := getTestSystemTransformers()
+	rows, err := getSuggestedMappingRows([]*mgmtv1alpha1.DatabaseColumn{{Schema: "public", Table: "users", Column: "id"}}, transformers, nil)
+	require.NoError(t, err)
+	m := newMappingsModel(rows, transformers)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, m.picker)
+	_, cmd := m.Update(keyMsg("q"))
+	require.NotNil(t, cmd)
+	require.False(t, m.saved)
+}
+
+func keyMsg(key string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
@@ -0,0 +1,255 @@
+package jobs_cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+var (
+	mappingsTitleStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFFDF5")).
+				Background(lipgloss.Color("#25A065")).
+				Padding(0, 1)
+	mappingsInfoStyle = lipgloss.NewStyle().Faint(true)
+	mappingsHelpStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	mappingsViewStyle = lipgloss.NewStyle().Margin(1, 2)
+)
+
+const (
+	// the number of lines used by the title, info and help of the view
+	mappingsChromeHeight = 8
+	defaultTableHeight   = 15
+)
+
+type mappingRow struct {
+	column      *mgmtv1alpha1.DatabaseColumn
+	suggested   *mgmtv1alpha1.SystemTransformer
+	transformer *mgmtv1alpha1.SystemTransformer
+}
+
+// Interactive table to review and edit the transformer of every column before the job is created
+type mappingsModel struct {
+	rows         []*mappingRow
+	transformers []*mgmtv1alpha1.SystemTransformer
+
+	table table.Model
+
+	// the transformer picker of the selected row. Only set while a transformer is being picked.
+	picker        *table.Model
+	pickerOptions []*mgmtv1alpha1.SystemTransformer
+
+	height int
+	saved  bool
+}
+
+func newMappingsModel(rows []*mappingRow, transformers []*mgmtv1alpha1.SystemTransformer) *mappingsModel {
+	m := &mappingsModel{
+		rows:         rows,
+		transformers: transformers,
+		height:       defaultTableHeight + mappingsChromeHeight,
+	}
+	m.table = table.New(
+		table.WithColumns(m.getColumns()),
+		table.WithRows(m.getRows()),
+		table.WithFocused(true),
+		table.WithHeight(defaultTableHeight),
+	)
+	return m
+}
+
+func (m *mappingsModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *mappingsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		m.table.SetHeight(m.tableHeight())
+		if m.picker != nil {
+			m.picker.SetHeight(m.tableHeight())
+		}
+		return m, nil
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.picker != nil {
+			return m.updatePicker(msg)
+		}
+		switch msg.String() {
+		case "q", "esc":
+			return m, tea.Quit
+		case "s":
+			m.saved = true
+			return m, tea.Quit
+		case "enter", "e":
+			m.openPicker()
+			return m, nil
+		case "p":
+			m.setTransformer(m.table.Cursor(), m.getTransformer(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH))
+			return m, nil
+		case "r":
+			if row := m.selectedRow(); row != nil {
+				m.setTransformer(m.table.Cursor(), row.suggested)
+			}
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m *mappingsModel) updatePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		m.closePicker()
+		return m, nil
+	case "enter":
+		if idx := m.picker.Cursor(); idx >= 0 && idx < len(m.pickerOptions) {
+			m.setTransformer(m.table.Cursor(), m.pickerOptions[idx])
+		}
+		m.closePicker()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	*m.picker, cmd = m.picker.Update(msg)
+	return m, cmd
+}
+
+func (m *mappingsModel) View() string {
+	if m.picker != nil {
+		row := m.selectedRow()
+		return mappingsViewStyle.Render(strings.Join([]string{
+			mappingsTitleStyle.Render("Select a transformer"),
+			mappingsInfoStyle.Render(fmt.Sprintf("%s.%s.%s (%s)", row.column.GetSchema(), row.column.GetTable(), row.column.GetColumn(), row.column.GetDataType())),
+			m.picker.View(),
+			mappingsHelpStyle.Render("↑/↓ move • enter select • esc back"),
+		}, "\n\n"))
+	}
+	return mappingsViewStyle.Render(strings.Join([]string{
+		mappingsTitleStyle.Render("Review the job mappings"),
+		mappingsInfoStyle.Render(fmt.Sprintf("%d columns, %d transformed", len(m.rows), m.getTransformedCount())),
+		m.table.View(),
+		mappingsHelpStyle.Render("↑/↓ move • enter change transformer • p passthrough • r reset to suggestion • s save • q quit"),
+	}, "\n\n"))
+}
+
+func (m *mappingsModel) openPicker() {
+	row := m.selectedRow()
+	if row == nil {
+		return
+	}
+	m.pickerOptions = getCompatibleTransformers(row.column, m.transformers)
+	if len(m.pickerOptions) == 0 {
+		m.pickerOptions = nil
+		return
+	}
+	rows := make([]table.Row, 0, len(m.pickerOptions))
+	cursor := 0
+	for idx, transformer := range m.pickerOptions {
+		rows = append(rows, table.Row{transformer.GetName(), transformer.GetDescription()})
+		if transformer.GetSource() == row.transformer.GetSource() {
+			cursor = idx
+		}
+	}
+	picker := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Transformer", Width: getColumnWidth("Transformer", rows, 0)},
+			{Title: "Description", Width: min(getColumnWidth("Description", rows, 1), 80)},
+		}),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(m.tableHeight()),
+	)
+	picker.SetCursor(cursor)
+	m.picker = &picker
+}
+
+func (m *mappingsModel) closePicker() {
+	m.picker = nil
+	m.pickerOptions = nil
+}
+
+func (m *mappingsModel) selectedRow() *mappingRow {
+	idx := m.table.Cursor()
+	if idx < 0 || idx >= len(m.rows) {
+		return nil
+	}
+	return m.rows[idx]
+}
+
+func (m *mappingsModel) setTransformer(idx int, transformer *mgmtv1alpha1.SystemTransformer) {
+	if transformer == nil || idx < 0 || idx >= len(m.rows) {
+		return
+	}
+	m.rows[idx].transformer = transformer
+	m.table.SetRows(m.getRows())
+}
+
+func (m *mappingsModel) getTransformer(source mgmtv1alpha1.TransformerSource) *mgmtv1alpha1.SystemTransformer {
+	idx := slices.IndexFunc(m.transformers, func(t *mgmtv1alpha1.SystemTransformer) bool { return t.GetSource() == source })
+	if idx < 0 {
+		return nil
+	}
+	return m.transformers[idx]
+}
+
+func (m *mappingsModel) getTransformedCount() int {
+	count := 0
+	for _, row := range m.rows {
+		if row.transformer.GetSource() != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *mappingsModel) tableHeight() int {
+	return max(m.height-mappingsChromeHeight, 3)
+}
+
+func (m *mappingsModel) getRows() []table.Row {
+	rows := make([]table.Row, 0, len(m.rows))
+	for _, row := range m.rows {
+		transformer := row.transformer.GetName()
+		if row.transformer.GetSource() != row.suggested.GetSource() {
+			transformer += " *"
+		}
+		rows = append(rows, table.Row{
+			row.column.GetSchema(),
+			row.column.GetTable(),
+			row.column.GetColumn(),
+			row.column.GetDataType(),
+			transformer,
+		})
+	}
+	return rows
+}
+
+func (m *mappingsModel) getColumns() []table.Column {
+	rows := m.getRows()
+	titles := []string{"Schema", "Table", "Column", "Data Type", "Transformer"}
+	columns := make([]table.Column, 0, len(titles))
+	for idx, title := range titles {
+		columns = append(columns, table.Column{Title: title, Width: min(getColumnWidth(title, rows, idx), 40)})
+	}
+	// leaves room for the marker of changed transformers
+	columns[len(columns)-1].Width += 2
+	return columns
+}
+
+func getColumnWidth(title string, rows []table.Row, idx int) int {
+	width := lipgloss.Width(title)
+	for _, row := range rows {
+		width = max(width, lipgloss.Width(row[idx]))
+	}
+	return width
+}
@@ -14,6 +14,13 @@ func NewCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newListCmd())
+	cmd.AddCommand(newGetCmd())
+	cmd.AddCommand(newCreateCmd())
+	cmd.AddCommand(newInitCmd())
+	cmd.AddCommand(newDeleteCmd())
+	cmd.AddCommand(newPauseCmd())
+	cmd.AddCommand(newResumeCmd())
+	cmd.AddCommand(newSetScheduleCmd())
 	cmd.AddCommand(newTriggerCmd())
 	return cmd
 }
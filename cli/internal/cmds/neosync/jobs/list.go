@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"connectrpc.com/connect"
@@ -12,6 +13,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/cli/internal/auth"
 	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
 	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
 	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
 	"github.com/nucleuscloud/neosync/cli/internal/version"
@@ -36,17 +38,23 @@ func newListCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
 			cmd.SilenceUsage = true
-			return listJobs(cmd.Context(), &apiKey, &accountId)
+			return listJobs(cmd.Context(), &apiKey, &accountId, format)
 		},
 	}
 	cmd.Flags().String("account-id", "", "Account to list jobs for. Defaults to account id in cli context")
+	output.AttachFormatFlag(cmd)
 	return cmd
 }
 
 func listJobs(
 	ctx context.Context,
 	apiKey, accountIdFlag *string,
+	format output.Format,
 ) error {
 	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
 	if err != nil {
@@ -80,6 +88,9 @@ func listJobs(
 	if err != nil {
 		return err
 	}
+	if format != output.TableFormat {
+		return output.WriteMessageList(os.Stdout, format, res.Msg.GetJobs())
+	}
 
 	jobstatuses := make([]*mgmtv1alpha1.JobStatus, len(res.Msg.Jobs))
 	errgrp, errctx := errgroup.WithContext(ctx)
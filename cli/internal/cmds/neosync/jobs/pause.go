@@ -0,0 +1,96 @@
+package jobs_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func newPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [id]",
+		Short: "pause the schedule of a job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPauseCmd(cmd, args, true)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("note", "", "A note describing why the job was paused")
+	return cmd
+}
+
+func newResumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume [id]",
+		Short: "resume the schedule of a paused job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPauseCmd(cmd, args, false)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("note", "", "A note describing why the job was resumed")
+	return cmd
+}
+
+func runPauseCmd(cmd *cobra.Command, args []string, pause bool) error {
+	jobId, err := parseJobIdArg(args)
+	if err != nil {
+		return err
+	}
+	apiKey, err := cmd.Flags().GetString("api-key")
+	if err != nil {
+		return err
+	}
+	accountId, err := cmd.Flags().GetString("account-id")
+	if err != nil {
+		return err
+	}
+	note, err := cmd.Flags().GetString("note")
+	if err != nil {
+		return err
+	}
+	cmd.SilenceUsage = true
+	return pauseJob(cmd.Context(), jobId, &apiKey, accountId, pause, note)
+}
+
+func pauseJob(
+	ctx context.Context,
+	jobId string,
+	apiKey *string,
+	accountIdFlag string,
+	pause bool,
+	note string,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	_, err = getAccountJob(ctx, jobclient, jobId, accountId)
+	if err != nil {
+		return err
+	}
+	req := &mgmtv1alpha1.PauseJobRequest{
+		Id:    jobId,
+		Pause: pause,
+	}
+	if note != "" {
+		req.Note = &note
+	}
+	_, err = jobclient.PauseJob(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if pause {
+		fmt.Printf("Paused job %s\n", jobId) //nolint:forbidigo
+	} else {
+		fmt.Printf("Resumed job %s\n", jobId) //nolint:forbidigo
+	}
+	return nil
+}
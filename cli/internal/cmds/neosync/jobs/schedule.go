@@ -0,0 +1,79 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func newSetScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-schedule [id]",
+		Short: "set the cron schedule of a job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobId, err := parseJobIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("cron") {
+				return errors.New("must provide --cron. Provide an empty string to remove the schedule")
+			}
+			cron, err := cmd.Flags().GetString("cron")
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return setJobSchedule(cmd.Context(), jobId, &apiKey, accountId, cron)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("cron", "", "The cron schedule of the job, e.g. \"0 0 * * *\". Provide an empty string to remove the schedule")
+	return cmd
+}
+
+func setJobSchedule(
+	ctx context.Context,
+	jobId string,
+	apiKey *string,
+	accountIdFlag string,
+	cron string,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	_, err = getAccountJob(ctx, jobclient, jobId, accountId)
+	if err != nil {
+		return err
+	}
+	req := &mgmtv1alpha1.UpdateJobScheduleRequest{Id: jobId}
+	if cron != "" {
+		req.CronSchedule = &cron
+	}
+	_, err = jobclient.UpdateJobSchedule(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if cron == "" {
+		fmt.Printf("Removed the schedule of job %s\n", jobId) //nolint:forbidigo
+	} else {
+		fmt.Printf("Set the schedule of job %s to %q\n", jobId, cron) //nolint:forbidigo
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package jobs_cmd
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type transformerRule struct {
+	// matches if the normalized column name equals one of these
+	exact []string
+	// matches if the normalized column name contains one of these
+	contains []string
+	// the transformer used for string columns
+	stringSource mgmtv1alpha1.TransformerSource
+	// the transformer used for integer columns. Unspecified if the rule only applies to string columns
+	intSource mgmtv1alpha1.TransformerSource
+}
+
+// Rules are evaluated in order and the first match wins, so more specific rules must come first
+var transformerRules = []*transformerRule{
+	{contains: []string{"email"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+	{exact: []string{"fname"}, contains: []string{"firstname", "givenname"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME},
+	{exact: []string{"lname", "surname"}, contains: []string{"lastname", "familyname"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME},
+	{contains: []string{"fullname", "displayname"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME},
+	{
+		contains:     []string{"phone", "mobile", "telephone"},
+		stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER,
+		intSource:    mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER,
+	},
+	{exact: []string{"ssn"}, contains: []string{"socialsecurity"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN},
+	{contains: []string{"creditcard", "cardnumber", "ccnumber"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER},
+	{exact: []string{"login"}, contains: []string{"username"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USERNAME},
+	{exact: []string{"zip", "postcode"}, contains: []string{"zipcode", "postalcode"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE},
+	{exact: []string{"address", "homeaddress", "street"}, contains: []string{"streetaddress", "addressline"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS},
+	{exact: []string{"city", "town"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY},
+	{exact: []string{"state", "province"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE},
+	{exact: []string{"country"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_COUNTRY},
+	{exact: []string{"gender", "sex"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER},
+	{contains: []string{"password", "secret", "apikey", "apitoken"}, stringSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH},
+}
+
+// Returns the transformer that is suggested for the column based on its name and data type.
+// Columns that do not match a rule, and generated columns, are passed through.
+func suggestTransformerSource(column *mgmtv1alpha1.DatabaseColumn) mgmtv1alpha1.TransformerSource {
+	if column.GeneratedType != nil {
+		return mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+	}
+	name := normalizeColumnName(column.GetColumn())
+	dataType := getTransformerDataType(column.GetDataType())
+	for _, rule := range transformerRules {
+		if !rule.matches(name) {
+			continue
+		}
+		if dataType == mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING {
+			return rule.stringSource
+		}
+		if dataType == mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64 && rule.intSource != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED {
+			return rule.intSource
+		}
+		return mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+	}
+	return mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+}
+
+func (r *transformerRule) matches(name string) bool {
+	if slices.Contains(r.exact, name) {
+		return true
+	}
+	for _, c := range r.contains {
+		if strings.Contains(name, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lowercases the column name and strips separators so that first_name, firstName and "First Name" are all firstname
+func normalizeColumnName(column string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, column)
+}
+
+// Maps a database data type to the transformer data type it is compatible with.
+// Returns unspecified if the data type is not known.
+func getTransformerDataType(dataType string) mgmtv1alpha1.TransformerDataType {
+	dt := strings.ToLower(strings.TrimSpace(dataType))
+	if idx := strings.IndexAny(dt, "(["); idx >= 0 {
+		dt = strings.TrimSpace(dt[:idx])
+	}
+	switch {
+	case strings.Contains(dt, "char"), strings.Contains(dt, "text"), dt == "citext", dt == "string", dt == "enum", dt == "set":
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING
+	case slices.Contains([]string{"smallint", "integer", "int", "bigint", "tinyint", "mediumint", "int2", "int4", "int8", "serial", "smallserial", "bigserial"}, dt):
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64
+	case slices.Contains([]string{"real", "double precision", "double", "float", "float4", "float8", "numeric", "decimal", "money", "smallmoney"}, dt):
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64
+	case dt == "boolean", dt == "bool", dt == "bit":
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_BOOLEAN
+	case dt == "uuid", dt == "uniqueidentifier":
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_UUID
+	case strings.HasPrefix(dt, "timestamp"), strings.HasPrefix(dt, "datetime"), dt == "date", strings.HasPrefix(dt, "time"), dt == "year", dt == "smalldatetime":
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME
+	default:
+		return mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_UNSPECIFIED
+	}
+}
+
+// Returns the system transformers that can be used for the column in a sync job.
+// All sync transformers are returned if the data type of the column is not known.
+func getCompatibleTransformers(
+	column *mgmtv1alpha1.DatabaseColumn,
+	transformers []*mgmtv1alpha1.SystemTransformer,
+) []*mgmtv1alpha1.SystemTransformer {
+	dataType := getTransformerDataType(column.GetDataType())
+	dataTypes := []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY, dataType}
+	if strings.EqualFold(column.GetIsNullable(), "yes") {
+		dataTypes = append(dataTypes, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL)
+	}
+
+	compatible := []*mgmtv1alpha1.SystemTransformer{}
+	for _, transformer := range transformers {
+		if !slices.Contains(transformer.GetSupportedJobTypes(), mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC) {
+			continue
+		}
+		if dataType == mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_UNSPECIFIED || slices.ContainsFunc(transformer.GetDataTypes(), func(dt mgmtv1alpha1.TransformerDataType) bool {
+			return slices.Contains(dataTypes, dt)
+		}) {
+			compatible = append(compatible, transformer)
+		}
+	}
+	return compatible
+}
@@ -0,0 +1,106 @@
+package jobs_cmd
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_suggestTransformerSource(t *testing.T) {
+	generated := "s"
+	tests := []struct {
+		column   *mgmtv1alpha1.DatabaseColumn
+		expected mgmtv1alpha1.TransformerSource
+	}{
+		{&mgmtv1alpha1.DatabaseColumn{Column: "email", DataType: "character varying(255)"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "workEmailAddress", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "first_name", DataType: "varchar"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "Surname", DataType: "nvarchar"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "phone_number", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "phone_number", DataType: "bigint"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "ssn", DataType: "char(11)"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "ip_address", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "state", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "statement", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "email_verified", DataType: "boolean"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "email", DataType: "text", GeneratedType: &generated}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+		{&mgmtv1alpha1.DatabaseColumn{Column: "id", DataType: "uuid"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column.GetColumn()+" "+tt.column.GetDataType(), func(t *testing.T) {
+			require.Equal(t, tt.expected, suggestTransformerSource(tt.column))
+		})
+	}
+}
+
+func Test_getTransformerDataType(t *testing.T) {
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, getTransformerDataType("character varying(255)"))
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, getTransformerDataType("BIGINT"))
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64, getTransformerDataType("numeric(10,2)"))
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME, getTransformerDataType("timestamp with time zone"))
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_UUID, getTransformerDataType("uuid"))
+	require.Equal(t, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_UNSPECIFIED, getTransformerDataType("jsonb"))
+}
+
+func Test_getCompatibleTransformers(t *testing.T) {
+	transformers := getTestSystemTransformers()
+
+	actual := getCompatibleTransformers(&mgmtv1alpha1.DatabaseColumn{DataType: "text", IsNullable: "NO"}, transformers)
+	require.Equal(t, []string{"Passthrough", "Transform Email"}, getTransformerNames(actual))
+
+	actual = getCompatibleTransformers(&mgmtv1alpha1.DatabaseColumn{DataType: "text", IsNullable: "YES"}, transformers)
+	require.Equal(t, []string{"Passthrough", "Transform Email", "Null"}, getTransformerNames(actual))
+
+	actual = getCompatibleTransformers(&mgmtv1alpha1.DatabaseColumn{DataType: "jsonb", IsNullable: "NO"}, transformers)
+	require.Equal(t, []string{"Passthrough", "Transform Email", "Null", "Transform Int64"}, getTransformerNames(actual))
+}
+
+func getTestSystemTransformers() []*mgmtv1alpha1.SystemTransformer {
+	sync := []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC}
+	return []*mgmtv1alpha1.SystemTransformer{
+		{
+			Name:              "Passthrough",
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY},
+			SupportedJobTypes: sync,
+			Config:            &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{PassthroughConfig: &mgmtv1alpha1.Passthrough{}}},
+		},
+		{
+			Name:              "Transform Email",
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING},
+			SupportedJobTypes: sync,
+			Config:            &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{TransformEmailConfig: &mgmtv1alpha1.TransformEmail{}}},
+		},
+		{
+			Name:              "Null",
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: sync,
+			Config:            &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{Nullconfig: &mgmtv1alpha1.Null{}}},
+		},
+		{
+			Name:              "Transform Int64",
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64},
+			SupportedJobTypes: sync,
+			Config:            &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformInt64Config{TransformInt64Config: &mgmtv1alpha1.TransformInt64{}}},
+		},
+		{
+			Name:              "Generate Default",
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE},
+			Config:            &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig{GenerateDefaultConfig: &mgmtv1alpha1.GenerateDefault{}}},
+		},
+	}
+}
+
+func getTransformerNames(transformers []*mgmtv1alpha1.SystemTransformer) []string {
+	names := make([]string, 0, len(transformers))
+	for _, transformer := range transformers {
+		names = append(names, transformer.GetName())
+	}
+	return names
+}
@@ -2,29 +2,49 @@ package jobs_cmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
-	"github.com/google/uuid"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
-	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
-	"github.com/nucleuscloud/neosync/cli/internal/auth"
-	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
-	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
-	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
-	"github.com/nucleuscloud/neosync/cli/internal/version"
-	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	runs_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/runs"
+	"github.com/nucleuscloud/neosync/cli/internal/exitcode"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
 )
 
+type triggerOptions struct {
+	wait      bool
+	timeout   time.Duration
+	interval  time.Duration
+	overrides *mgmtv1alpha1.JobRunOverrides
+}
+
 func newTriggerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "trigger [id]",
 		Short: "trigger a job",
+		Long: `Triggers a run of the job.
+With --wait the command blocks until the run completes and exits with a non-zero code if the run did not succeed:
+  1 - the command failed
+  2 - the run ended with an error or failed
+  3 - the run was canceled or terminated
+  4 - the run timed out or did not complete before --timeout
+
+Parts of the job can be overridden for a single run without changing the stored job, e.g.
+  neosync jobs trigger <id> --where "public.users=tenant_id = 42" --row-count "public.orders=500"
+Destination connections and options can be overridden with --overrides-file, which contains a JobRunOverrides in yaml or json.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 1 {
-				return errors.New("must provide job uuid as argument")
+			jobId, err := parseJobIdArg(args)
+			if err != nil {
+				return err
 			}
 
 			apiKey, err := cmd.Flags().GetString("api-key")
@@ -37,63 +57,208 @@ func newTriggerCmd() *cobra.Command {
 				return err
 			}
 
-			jobId := args[0]
+			opts := triggerOptions{}
+			opts.wait, err = cmd.Flags().GetBool("wait")
+			if err != nil {
+				return err
+			}
+			opts.timeout, err = cmd.Flags().GetDuration("timeout")
+			if err != nil {
+				return err
+			}
+			opts.interval, err = cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+			if opts.timeout < 0 {
+				return errors.New("timeout must not be negative")
+			}
+			if opts.interval <= 0 {
+				return errors.New("interval must be greater than zero")
+			}
+			if !opts.wait && (cmd.Flags().Changed("timeout") || cmd.Flags().Changed("interval")) {
+				return errors.New("timeout and interval can only be used with --wait")
+			}
 
-			jobUuid, err := uuid.Parse(jobId)
+			opts.overrides, err = getJobRunOverrides(cmd)
 			if err != nil {
 				return err
 			}
 
 			cmd.SilenceUsage = true
-			return triggerJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId)
+			return triggerJob(cmd.Context(), jobId, &apiKey, accountId, opts)
 		},
 	}
 	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().Bool("wait", false, "Wait for the job run to complete, printing its progress, and exit non-zero if it does not succeed")
+	cmd.Flags().Duration("timeout", 0, "The maximum time to wait for the job run to complete. The run is left running if the timeout is reached. Defaults to no timeout")
+	cmd.Flags().Duration("interval", 2*time.Second, "How often to poll the job run for progress while waiting")
+	cmd.Flags().StringArray("where", nil, "Overrides the where clause of a table for this run, in the form schema.table=clause. An empty clause removes the stored where clause. Can be repeated")
+	cmd.Flags().StringArray("row-count", nil, "Overrides the number of rows generated for a table for this run, in the form schema.table=count. Can be repeated")
+	cmd.Flags().String("overrides-file", "", "Path to a yaml or json file that contains the JobRunOverrides for this run. Flag overrides are applied on top of the file")
 	return cmd
 }
 
 func triggerJob(
 	ctx context.Context,
 	jobId string,
-	apiKey, accountIdFlag *string,
+	apiKey *string,
+	accountIdFlag string,
+	opts triggerOptions,
 ) error {
-	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	accountId, err := getAccountId(accountIdFlag)
 	if err != nil {
 		return err
 	}
-	var accountId = accountIdFlag
-	if accountId == nil || *accountId == "" {
-		aId, err := userconfig.GetAccountId()
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	_, err = getAccountJob(ctx, jobclient, jobId, accountId)
+	if err != nil {
+		return fmt.Errorf("Unable to trigger job run. %w", err)
+	}
+	res, err := jobclient.CreateJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:     jobId,
+		Overrides: opts.overrides,
+	}))
+	if err != nil {
+		return err
+	}
+	if res.Msg.JobRunId == nil {
+		if opts.wait {
+			return errors.New("job was triggered but the job run could not be found to wait on")
+		}
+		fmt.Println("Job triggered") //nolint:forbidigo
+		return nil
+	}
+	runId := res.Msg.GetJobRunId()
+	fmt.Printf("Triggered job run %s\n", runId) //nolint:forbidigo
+	if !opts.wait {
+		return nil
+	}
+
+	waitCtx := ctx
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+	jobRun, err := runs_cmd.WatchRun(waitCtx, jobclient, runId, accountId, opts.interval, output.TableFormat)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return exitcode.New(exitcode.JobRunTimedOut, fmt.Errorf("job run %s did not complete within %s", runId, opts.timeout))
+		}
+		return err
+	}
+	return getJobRunStatusError(jobRun)
+}
+
+// Returns an error with the exit code that corresponds to the status of the completed job run
+func getJobRunStatusError(jobRun *mgmtv1alpha1.JobRun) error {
+	switch jobRun.GetStatus() {
+	case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE:
+		return nil
+	case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED:
+		return exitcode.New(exitcode.JobRunFailed, fmt.Errorf("job run %s failed", jobRun.GetId()))
+	case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED:
+		return exitcode.New(exitcode.JobRunCanceled, fmt.Errorf("job run %s was canceled", jobRun.GetId()))
+	case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT:
+		return exitcode.New(exitcode.JobRunTimedOut, fmt.Errorf("job run %s timed out", jobRun.GetId()))
+	default:
+		return fmt.Errorf("job run %s completed with unexpected status %s", jobRun.GetId(), jobRun.GetStatus())
+	}
+}
+
+// Builds the job run overrides from the overrides file and the --where and --row-count flags.
+// Returns nil if no overrides were provided.
+func getJobRunOverrides(cmd *cobra.Command) (*mgmtv1alpha1.JobRunOverrides, error) {
+	overridesFile, err := cmd.Flags().GetString("overrides-file")
+	if err != nil {
+		return nil, err
+	}
+	whereClauses, err := cmd.Flags().GetStringArray("where")
+	if err != nil {
+		return nil, err
+	}
+	rowCounts, err := cmd.Flags().GetStringArray("row-count")
+	if err != nil {
+		return nil, err
+	}
+	if overridesFile == "" && len(whereClauses) == 0 && len(rowCounts) == 0 {
+		return nil, nil
+	}
+
+	overrides := &mgmtv1alpha1.JobRunOverrides{}
+	if overridesFile != "" {
+		overrides, err = readJobRunOverrides(overridesFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, where := range whereClauses {
+		schema, table, clause, err := parseTableOverrideFlag(where)
 		if err != nil {
-			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
-			return err
+			return nil, fmt.Errorf("invalid --where value: %w", err)
 		}
-		accountId = &aId
+		getTableOverride(overrides, schema, table).WhereClause = &clause
 	}
+	for _, rowCount := range rowCounts {
+		schema, table, value, err := parseTableOverrideFlag(rowCount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --row-count value: %w", err)
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid --row-count value: %q is not a valid row count", value)
+		}
+		getTableOverride(overrides, schema, table).RowCount = &count
+	}
+	return overrides, nil
+}
 
-	if accountId == nil || *accountId == "" {
-		return errors.New("Account Id not found. Please use account switch command to set account.")
+// Parses a flag value in the form schema.table=value
+func parseTableOverrideFlag(input string) (schema, table, value string, err error) {
+	tableName, value, ok := strings.Cut(input, "=")
+	if !ok {
+		return "", "", "", fmt.Errorf("%q must be in the form schema.table=value", input)
+	}
+	schema, table, ok = strings.Cut(strings.TrimSpace(tableName), ".")
+	if !ok || schema == "" || table == "" {
+		return "", "", "", fmt.Errorf("%q must be in the form schema.table=value", input)
 	}
+	return schema, table, strings.TrimSpace(value), nil
+}
 
-	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
-		http_client.NewWithHeaders(version.Get().Headers()),
-		serverconfig.GetApiBaseUrl(),
-		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
-	)
-	job, err := jobclient.GetJob(ctx, connect.NewRequest[mgmtv1alpha1.GetJobRequest](&mgmtv1alpha1.GetJobRequest{
-		Id: jobId,
-	}))
+// Returns the override for the table, adding it to the overrides if it does not exist
+func getTableOverride(overrides *mgmtv1alpha1.JobRunOverrides, schema, table string) *mgmtv1alpha1.JobRunTableOverride {
+	for _, tableOverride := range overrides.GetTables() {
+		if tableOverride.GetSchema() == schema && tableOverride.GetTable() == table {
+			return tableOverride
+		}
+	}
+	tableOverride := &mgmtv1alpha1.JobRunTableOverride{Schema: schema, Table: table}
+	overrides.Tables = append(overrides.Tables, tableOverride)
+	return tableOverride
+}
+
+// Reads a JobRunOverrides from a yaml or json file
+func readJobRunOverrides(path string) (*mgmtv1alpha1.JobRunOverrides, error) {
+	bits, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("unable to read overrides file: %w", err)
 	}
-	if job.Msg.Job.AccountId != *accountId {
-		return fmt.Errorf("Unable to trigger job run. Job not found. AccountId: %s", *accountId)
+	var raw map[string]any
+	if err := yaml.Unmarshal(bits, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse overrides file: %w", err)
 	}
-	_, err = jobclient.CreateJobRun(ctx, connect.NewRequest[mgmtv1alpha1.CreateJobRunRequest](&mgmtv1alpha1.CreateJobRunRequest{
-		JobId: jobId,
-	}))
+	jsonBits, err := json.Marshal(raw)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	overrides := &mgmtv1alpha1.JobRunOverrides{}
+	if err := protojson.Unmarshal(jsonBits, overrides); err != nil {
+		return nil, fmt.Errorf("invalid overrides file: %w", err)
 	}
-	return nil
+	return overrides, nil
 }
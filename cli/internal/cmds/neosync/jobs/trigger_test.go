@@ -0,0 +1,83 @@
+package jobs_cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/exitcode"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getJobRunStatusError(t *testing.T) {
+	tests := map[mgmtv1alpha1.JobRunStatus]int{
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE:    0,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR:       exitcode.JobRunFailed,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED:      exitcode.JobRunFailed,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED:    exitcode.JobRunCanceled,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED:  exitcode.JobRunCanceled,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT:   exitcode.JobRunTimedOut,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED: exitcode.General,
+	}
+	for status, expected := range tests {
+		t.Run(status.String(), func(t *testing.T) {
+			err := getJobRunStatusError(&mgmtv1alpha1.JobRun{Id: "run-id", Status: status})
+			require.Equal(t, expected, exitcode.FromError(err))
+		})
+	}
+}
+
+func Test_parseTableOverrideFlag(t *testing.T) {
+	schema, table, value, err := parseTableOverrideFlag("public.users=tenant_id = 42")
+	require.NoError(t, err)
+	require.Equal(t, "public", schema)
+	require.Equal(t, "users", table)
+	require.Equal(t, "tenant_id = 42", value)
+
+	_, _, value, err = parseTableOverrideFlag("public.users=")
+	require.NoError(t, err)
+	require.Empty(t, value)
+
+	for _, input := range []string{"public.users", "users=id > 1", ".users=1", "public.=1"} {
+		_, _, _, err := parseTableOverrideFlag(input)
+		require.Error(t, err, input)
+	}
+}
+
+func Test_getJobRunOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overrides.yaml")
+	err := os.WriteFile(path, []byte(`
+tables:
+  - schema: public
+    table: users
+    whereClause: id > 10
+destinations:
+  - destinationId: 3b5e3a9e-8f0a-4c5e-9a43-1f2d1c0b7f10
+    connectionId: 8d3c2a1b-6e5f-4d3c-8b2a-1f0e9d8c7b6a
+`), 0600)
+	require.NoError(t, err)
+
+	cmd := newTriggerCmd()
+	require.NoError(t, cmd.Flags().Set("overrides-file", path))
+	require.NoError(t, cmd.Flags().Set("where", "public.users=tenant_id = 42"))
+	require.NoError(t, cmd.Flags().Set("row-count", "public.orders=500"))
+
+	overrides, err := getJobRunOverrides(cmd)
+	require.NoError(t, err)
+	require.Len(t, overrides.GetTables(), 2)
+	require.Equal(t, "tenant_id = 42", overrides.GetTables()[0].GetWhereClause())
+	require.Equal(t, "orders", overrides.GetTables()[1].GetTable())
+	require.Equal(t, int64(500), overrides.GetTables()[1].GetRowCount())
+	require.Equal(t, "8d3c2a1b-6e5f-4d3c-8b2a-1f0e9d8c7b6a", overrides.GetDestinations()[0].GetConnectionId())
+
+	cmd = newTriggerCmd()
+	overrides, err = getJobRunOverrides(cmd)
+	require.NoError(t, err)
+	require.Nil(t, overrides)
+
+	cmd = newTriggerCmd()
+	require.NoError(t, cmd.Flags().Set("row-count", "public.orders=many"))
+	_, err = getJobRunOverrides(cmd)
+	require.Error(t, err)
+}
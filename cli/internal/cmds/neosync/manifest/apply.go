@@ -0,0 +1,43 @@
+package manifest_cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nucleuscloud/neosync/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+func NewApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Creates and updates the resources of a manifest in the account",
+		Long: `Reconciles the connections, user defined transformers and jobs of a manifest with the account by name.
+Resources that do not exist are created and resources that differ are updated. Resources that only exist in the account are left untouched.
+Connection secrets are never returned by the API, so changes to secret values are not detected. Masked secrets in a manifest keep the stored secret.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags, err := getManifestFlags(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			result, err := buildPlan(cmd.Context(), flags)
+			if err != nil {
+				return err
+			}
+			result.plan.Print(os.Stdout)
+			if !result.plan.HasChanges() {
+				fmt.Println("\nThe account already matches the manifest.") //nolint:forbidigo
+				return nil
+			}
+			fmt.Println() //nolint:forbidigo
+			if err := manifest.Apply(cmd.Context(), result.clients, result.accountId, result.plan, result.state, os.Stdout); err != nil {
+				return err
+			}
+			fmt.Println("\nApply complete.") //nolint:forbidigo
+			return nil
+		},
+	}
+	addManifestFlags(cmd)
+	return cmd
+}
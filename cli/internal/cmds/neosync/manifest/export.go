@@ -0,0 +1,76 @@
+package manifest_cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nucleuscloud/neosync/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the connections, user defined transformers and jobs of the account as a manifest",
+		Long: `Exports the account as a manifest that can be checked into version control and reconciled with the apply command.
+Connection secrets are masked. Replace them with secret references or leave them masked to keep the stored secrets when applying to the same account.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountIdFlag, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString("output-format")
+			if err != nil {
+				return err
+			}
+			if format != "yaml" && format != "json" {
+				return fmt.Errorf("unsupported output format %q, must be one of yaml, json", format)
+			}
+			cmd.SilenceUsage = true
+
+			accountId, err := getAccountId(accountIdFlag)
+			if err != nil {
+				return err
+			}
+			clients, err := newClients(cmd.Context(), &apiKey)
+			if err != nil {
+				return err
+			}
+			state, err := manifest.LoadState(cmd.Context(), clients, accountId)
+			if err != nil {
+				return err
+			}
+			resources, err := state.Export()
+			if err != nil {
+				return err
+			}
+
+			var w io.Writer = os.Stdout
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			if format == "json" {
+				return manifest.EncodeJson(w, resources)
+			}
+			return manifest.EncodeYaml(w, resources)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to export. Defaults to account id in cli context")
+	cmd.Flags().StringP("output", "o", "", "File to write the manifest to. Defaults to stdout")
+	cmd.Flags().String("output-format", "yaml", "The format of the manifest. One of yaml, json")
+	return cmd
+}
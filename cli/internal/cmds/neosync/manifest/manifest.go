@@ -0,0 +1,114 @@
+package manifest_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/manifest"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/spf13/cobra"
+)
+
+func newClients(ctx context.Context, apiKey *string) (*manifest.Clients, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	httpclient := http_client.NewWithHeaders(version.Get().Headers())
+	interceptors := connect.WithInterceptors(
+		auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+	)
+	return &manifest.Clients{
+		Connections:  mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, serverconfig.GetApiBaseUrl(), interceptors),
+		Transformers: mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, serverconfig.GetApiBaseUrl(), interceptors),
+		Jobs:         mgmtv1alpha1connect.NewJobServiceClient(httpclient, serverconfig.GetApiBaseUrl(), interceptors),
+	}, nil
+}
+
+func getAccountId(accountIdFlag string) (string, error) {
+	if accountIdFlag != "" {
+		return accountIdFlag, nil
+	}
+	accountId, err := userconfig.GetAccountId()
+	if err != nil {
+		fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+		return "", err
+	}
+	if accountId == "" {
+		return "", errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+	return accountId, nil
+}
+
+type manifestFlags struct {
+	apiKey    string
+	accountId string
+	files     []string
+}
+
+func addManifestFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceP("file", "f", nil, "Manifest files or directories of manifest files to read. May be provided multiple times")
+	cmd.Flags().String("account-id", "", "Account to reconcile the manifest with. Defaults to account id in cli context")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func getManifestFlags(cmd *cobra.Command) (*manifestFlags, error) {
+	flags := &manifestFlags{}
+	var err error
+	flags.apiKey, err = cmd.Flags().GetString("api-key")
+	if err != nil {
+		return nil, err
+	}
+	flags.accountId, err = cmd.Flags().GetString("account-id")
+	if err != nil {
+		return nil, err
+	}
+	flags.files, err = cmd.Flags().GetStringSlice("file")
+	if err != nil {
+		return nil, err
+	}
+	return flags, nil
+}
+
+type planResult struct {
+	plan      *manifest.Plan
+	state     *manifest.State
+	clients   *manifest.Clients
+	accountId string
+}
+
+// Reads the manifest and compares it with the account
+func buildPlan(
+	ctx context.Context,
+	flags *manifestFlags,
+) (*planResult, error) {
+	resources, err := manifest.ReadFiles(flags.files)
+	if err != nil {
+		return nil, err
+	}
+	accountId, err := getAccountId(flags.accountId)
+	if err != nil {
+		return nil, err
+	}
+	clients, err := newClients(ctx, &flags.apiKey)
+	if err != nil {
+		return nil, err
+	}
+	state, err := manifest.LoadState(ctx, clients, accountId)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := manifest.NewPlan(resources, state)
+	if err != nil {
+		return nil, err
+	}
+	return &planResult{plan: plan, state: state, clients: clients, accountId: accountId}, nil
+}
@@ -0,0 +1,32 @@
+package manifest_cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func NewPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Shows the changes that applying a manifest would make to the account",
+		Long: `Compares the connections, user defined transformers and jobs of a manifest with the account and prints the resources that would be created or updated.
+Resources are matched by name. Resources that only exist in the account are left untouched.
+Exits with a non-zero status code if the manifest can not be read or the account can not be reached.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags, err := getManifestFlags(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			result, err := buildPlan(cmd.Context(), flags)
+			if err != nil {
+				return err
+			}
+			result.plan.Print(os.Stdout)
+			return nil
+		},
+	}
+	addManifestFlags(cmd)
+	return cmd
+}
@@ -8,12 +8,16 @@ import (
 	"path/filepath"
 
 	accounts_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/accounts"
+	audit_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/audit"
 	connections_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/connections"
 	jobs_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/jobs"
 	login_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/login"
+	manifest_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/manifest"
+	runs_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/runs"
 	sync_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/sync"
 	version_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/version"
 	whoami_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/whoami"
+	"github.com/nucleuscloud/neosync/cli/internal/exitcode"
 	"github.com/nucleuscloud/neosync/cli/internal/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -67,14 +71,22 @@ func Execute() {
 	rootCmd.PersistentFlags().String(apiKeyFlag, "", fmt.Sprintf("Neosync API Key. Takes precedence over $%s", apiKeyEnvVarName))
 
 	rootCmd.AddCommand(jobs_cmd.NewCmd())
+	rootCmd.AddCommand(runs_cmd.NewCmd())
 	rootCmd.AddCommand(version_cmd.NewCmd())
 	rootCmd.AddCommand(whoami_cmd.NewCmd())
 	rootCmd.AddCommand(login_cmd.NewCmd())
 	rootCmd.AddCommand(sync_cmd.NewCmd())
 	rootCmd.AddCommand(accounts_cmd.NewCmd())
 	rootCmd.AddCommand(connections_cmd.NewCmd())
-
-	cobra.CheckErr(rootCmd.Execute())
+	rootCmd.AddCommand(audit_cmd.NewCmd())
+	rootCmd.AddCommand(manifest_cmd.NewApplyCmd())
+	rootCmd.AddCommand(manifest_cmd.NewPlanCmd())
+	rootCmd.AddCommand(manifest_cmd.NewExportCmd())
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(exitcode.FromError(err))
+	}
 }
 
 // Hack: This method attempts to migrate the old neosync-cli file to the new default location
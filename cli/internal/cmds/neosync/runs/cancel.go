@@ -0,0 +1,88 @@
+package runs_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func newCancelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [run-id]",
+		Short: "cancel a job run, allowing it to clean up",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStopCmd(cmd, args, false)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job run is in. Defaults to account id in cli context")
+	return cmd
+}
+
+func newTerminateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate [run-id]",
+		Short: "terminate a job run immediately",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStopCmd(cmd, args, true)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job run is in. Defaults to account id in cli context")
+	return cmd
+}
+
+func runStopCmd(cmd *cobra.Command, args []string, terminate bool) error {
+	runId, err := parseRunIdArg(args)
+	if err != nil {
+		return err
+	}
+	apiKey, err := cmd.Flags().GetString("api-key")
+	if err != nil {
+		return err
+	}
+	accountId, err := cmd.Flags().GetString("account-id")
+	if err != nil {
+		return err
+	}
+	cmd.SilenceUsage = true
+	return stopRun(cmd.Context(), runId, &apiKey, accountId, terminate)
+}
+
+func stopRun(
+	ctx context.Context,
+	runId string,
+	apiKey *string,
+	accountIdFlag string,
+	terminate bool,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	if terminate {
+		_, err = jobclient.TerminateJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.TerminateJobRunRequest{
+			JobRunId:  runId,
+			AccountId: accountId,
+		}))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Terminated job run %s\n", runId) //nolint:forbidigo
+		return nil
+	}
+	_, err = jobclient.CancelJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.CancelJobRunRequest{
+		JobRunId:  runId,
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Canceled job run %s\n", runId) //nolint:forbidigo
+	return nil
+}
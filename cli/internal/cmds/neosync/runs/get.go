@@ -0,0 +1,104 @@
+package runs_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func newGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [run-id]",
+		Short: "get a job run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runId, err := parseRunIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return getRun(cmd.Context(), runId, &apiKey, accountId, format)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job run is in. Defaults to account id in cli context")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func getRun(
+	ctx context.Context,
+	runId string,
+	apiKey *string,
+	accountIdFlag string,
+	format output.Format,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	res, err := jobclient.GetJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  runId,
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return err
+	}
+	run := res.Msg.GetJobRun()
+	if format != output.TableFormat {
+		return output.WriteMessage(os.Stdout, format, run)
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printRunTable([]*mgmtv1alpha1.JobRun{run})
+	fmt.Println() //nolint:forbidigo
+	if run.GetErrorMessage() != "" {
+		fmt.Printf("Error: %s\n\n", run.GetErrorMessage()) //nolint:forbidigo
+	}
+	if len(run.GetTables()) > 0 {
+		printRunTableSummaries(run.GetTables())
+		fmt.Println() //nolint:forbidigo
+	}
+	return nil
+}
+
+func printRunTableSummaries(summaries []*mgmtv1alpha1.JobRunTableSummary) {
+	tbl := table.
+		New("Table", "Rows Read", "Rows Written", "Rows Errored").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+	for _, summary := range summaries {
+		tbl.AddRow(
+			fmt.Sprintf("%s.%s", summary.GetSchema(), summary.GetTable()),
+			summary.GetRowsRead(),
+			summary.GetRowsWritten(),
+			summary.GetRowsErrored(),
+		)
+	}
+	tbl.Print()
+}
@@ -0,0 +1,146 @@
+package runs_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+type listRunsConfig struct {
+	accountId string
+	jobId     string
+	statuses  []mgmtv1alpha1.JobRunStatus
+	pageSize  uint32
+	pageToken string
+	format    output.Format
+}
+
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list job runs, most recently started first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			cfg := &listRunsConfig{}
+			cfg.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			cfg.jobId, err = cmd.Flags().GetString("job-id")
+			if err != nil {
+				return err
+			}
+			statuses, err := cmd.Flags().GetStringSlice("status")
+			if err != nil {
+				return err
+			}
+			cfg.statuses, err = parseRunStatuses(statuses)
+			if err != nil {
+				return err
+			}
+			cfg.pageSize, err = cmd.Flags().GetUint32("page-size")
+			if err != nil {
+				return err
+			}
+			cfg.pageToken, err = cmd.Flags().GetString("page-token")
+			if err != nil {
+				return err
+			}
+			cfg.format, err = output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return listRuns(cmd.Context(), &apiKey, cfg)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list job runs for. Defaults to account id in cli context")
+	cmd.Flags().String("job-id", "", "Only list the runs of the given job")
+	cmd.Flags().StringSlice("status", nil, "Only list runs with the given statuses (pending, running, complete, error, canceled, terminated)")
+	cmd.Flags().Uint32("page-size", 50, "The maximum number of runs to list")
+	cmd.Flags().String("page-token", "", "The page token printed by a previous list to retrieve the next page of runs")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func listRuns(
+	ctx context.Context,
+	apiKey *string,
+	cfg *listRunsConfig,
+) error {
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+
+	req := &mgmtv1alpha1.GetJobRunsRequest{
+		Statuses: cfg.statuses,
+		PageSize: cfg.pageSize,
+	}
+	if cfg.jobId != "" {
+		req.Id = &mgmtv1alpha1.GetJobRunsRequest_JobId{JobId: cfg.jobId}
+	} else {
+		accountId, err := getAccountId(cfg.accountId)
+		if err != nil {
+			return err
+		}
+		req.Id = &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: accountId}
+	}
+	if cfg.pageToken != "" {
+		req.PageToken = &cfg.pageToken
+	}
+	res, err := jobclient.GetJobRuns(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if cfg.format != output.TableFormat {
+		return output.WriteMessageList(os.Stdout, cfg.format, res.Msg.GetJobRuns())
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printRunTable(res.Msg.GetJobRuns())
+	fmt.Println() //nolint:forbidigo
+	if res.Msg.GetNextPageToken() != "" {
+		fmt.Printf("More runs are available. Use --page-token %s to view the next page.\n\n", res.Msg.GetNextPageToken()) //nolint:forbidigo
+	}
+	return nil
+}
+
+func printRunTable(runs []*mgmtv1alpha1.JobRun) {
+	tbl := table.
+		New("Id", "Job Id", "Status", "Started At", "Completed At").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, run := range runs {
+		completedAt := ""
+		if run.CompletedAt != nil {
+			completedAt = run.GetCompletedAt().AsTime().Local().Format(time.RFC3339)
+		}
+		tbl.AddRow(
+			run.GetId(),
+			run.GetJobId(),
+			formatRunStatus(run.GetStatus()),
+			run.GetStartedAt().AsTime().Local().Format(time.RFC3339),
+			completedAt,
+		)
+	}
+	tbl.Print()
+}
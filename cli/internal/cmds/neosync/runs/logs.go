@@ -0,0 +1,161 @@
+package runs_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logWindows = map[string]mgmtv1alpha1.LogWindow{
+		"15m": mgmtv1alpha1.LogWindow_LOG_WINDOW_FIFTEEN_MIN,
+		"1h":  mgmtv1alpha1.LogWindow_LOG_WINDOW_ONE_HOUR,
+		"1d":  mgmtv1alpha1.LogWindow_LOG_WINDOW_ONE_DAY,
+	}
+)
+
+type runLogsConfig struct {
+	accountId string
+	follow    bool
+	levels    []mgmtv1alpha1.LogLevel
+	window    mgmtv1alpha1.LogWindow
+	maxLines  int64
+	format    output.Format
+}
+
+func newLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [run-id]",
+		Short: "print the logs of a job run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runId, err := parseRunIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			cfg := &runLogsConfig{}
+			cfg.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			cfg.follow, err = cmd.Flags().GetBool("follow")
+			if err != nil {
+				return err
+			}
+			levels, err := cmd.Flags().GetStringSlice("level")
+			if err != nil {
+				return err
+			}
+			cfg.levels, err = parseLogLevels(levels)
+			if err != nil {
+				return err
+			}
+			window, err := cmd.Flags().GetString("window")
+			if err != nil {
+				return err
+			}
+			if window != "" {
+				logWindow, ok := logWindows[window]
+				if !ok {
+					return fmt.Errorf("window must be one of 15m, 1h, 1d")
+				}
+				cfg.window = logWindow
+			}
+			cfg.maxLines, err = cmd.Flags().GetInt64("max-lines")
+			if err != nil {
+				return err
+			}
+			cfg.format, err = output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return getRunLogs(cmd.Context(), runId, &apiKey, cfg)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job run is in. Defaults to account id in cli context")
+	cmd.Flags().BoolP("follow", "f", false, "Keep streaming new logs until the job run completes")
+	cmd.Flags().StringSlice("level", nil, "Only print logs with the given levels (debug, info, warn, error)")
+	cmd.Flags().String("window", "", "Only print logs from the given time window (15m, 1h, 1d)")
+	cmd.Flags().Int64("max-lines", 0, "The maximum number of log lines to print")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+// Parses levels such as info or error into log levels
+func parseLogLevels(values []string) ([]mgmtv1alpha1.LogLevel, error) {
+	levels := make([]mgmtv1alpha1.LogLevel, 0, len(values))
+	for _, value := range values {
+		level, ok := mgmtv1alpha1.LogLevel_value[fmt.Sprintf("LOG_LEVEL_%s", strings.ToUpper(value))]
+		if !ok || level == int32(mgmtv1alpha1.LogLevel_LOG_LEVEL_UNSPECIFIED) {
+			return nil, fmt.Errorf("%q is not a valid log level", value)
+		}
+		levels = append(levels, mgmtv1alpha1.LogLevel(level))
+	}
+	return levels, nil
+}
+
+func getRunLogs(
+	ctx context.Context,
+	runId string,
+	apiKey *string,
+	cfg *runLogsConfig,
+) error {
+	accountId, err := getAccountId(cfg.accountId)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+
+	req := &mgmtv1alpha1.GetJobRunLogsStreamRequest{
+		JobRunId:   runId,
+		AccountId:  accountId,
+		Window:     cfg.window,
+		ShouldTail: cfg.follow,
+		LogLevels:  cfg.levels,
+	}
+	if cfg.maxLines > 0 {
+		req.MaxLogLines = &cfg.maxLines
+	}
+	stream, err := jobclient.GetJobRunLogsStream(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for stream.Receive() {
+		msg := stream.Msg()
+		switch cfg.format {
+		case output.TableFormat:
+			if msg.Timestamp != nil {
+				fmt.Printf("%s %s\n", msg.GetTimestamp().AsTime().Local().Format(time.RFC3339), msg.GetLogLine()) //nolint:forbidigo
+			} else {
+				fmt.Println(msg.GetLogLine()) //nolint:forbidigo
+			}
+		case output.YamlFormat:
+			fmt.Println("---") //nolint:forbidigo
+			if err := output.WriteMessage(os.Stdout, cfg.format, msg); err != nil {
+				return err
+			}
+		default:
+			if err := output.WriteMessage(os.Stdout, cfg.format, msg); err != nil {
+				return err
+			}
+		}
+	}
+	return stream.Err()
+}
@@ -0,0 +1,89 @@
+package runs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runs",
+		Short: "Parent command for job runs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newListCmd())
+	cmd.AddCommand(newGetCmd())
+	cmd.AddCommand(newCancelCmd())
+	cmd.AddCommand(newTerminateCmd())
+	cmd.AddCommand(newWatchCmd())
+	cmd.AddCommand(newLogsCmd())
+	return cmd
+}
+
+func newJobClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.JobServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewJobServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	), nil
+}
+
+func getAccountId(accountIdFlag string) (string, error) {
+	if accountIdFlag != "" {
+		return accountIdFlag, nil
+	}
+	accountId, err := userconfig.GetAccountId()
+	if err != nil {
+		fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+		return "", err
+	}
+	if accountId == "" {
+		return "", errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+	return accountId, nil
+}
+
+func parseRunIdArg(args []string) (string, error) {
+	if len(args) != 1 || args[0] == "" {
+		return "", errors.New("must provide job run id as argument")
+	}
+	return args[0], nil
+}
+
+// Parses statuses such as running or error into job run statuses
+func parseRunStatuses(values []string) ([]mgmtv1alpha1.JobRunStatus, error) {
+	statuses := make([]mgmtv1alpha1.JobRunStatus, 0, len(values))
+	for _, value := range values {
+		status, ok := mgmtv1alpha1.JobRunStatus_value[fmt.Sprintf("JOB_RUN_STATUS_%s", strings.ToUpper(value))]
+		if !ok || status == int32(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED) {
+			return nil, fmt.Errorf("%q is not a valid job run status", value)
+		}
+		statuses = append(statuses, mgmtv1alpha1.JobRunStatus(status))
+	}
+	return statuses, nil
+}
+
+// Formats a job run status for display, e.g. JOB_RUN_STATUS_RUNNING as running
+func formatRunStatus(status mgmtv1alpha1.JobRunStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "JOB_RUN_STATUS_"))
+}
@@ -0,0 +1,72 @@
+package runs_cmd
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_parseRunStatuses(t *testing.T) {
+	statuses, err := parseRunStatuses([]string{"running", "ERROR"})
+	require.NoError(t, err)
+	require.Equal(t, []mgmtv1alpha1.JobRunStatus{
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR,
+	}, statuses)
+
+	_, err = parseRunStatuses([]string{"unspecified"})
+	require.Error(t, err)
+	_, err = parseRunStatuses([]string{"done"})
+	require.Error(t, err)
+}
+
+func Test_parseLogLevels(t *testing.T) {
+	levels, err := parseLogLevels([]string{"warn", "error"})
+	require.NoError(t, err)
+	require.Equal(t, []mgmtv1alpha1.LogLevel{mgmtv1alpha1.LogLevel_LOG_LEVEL_WARN, mgmtv1alpha1.LogLevel_LOG_LEVEL_ERROR}, levels)
+
+	_, err = parseLogLevels([]string{"fatal"})
+	require.Error(t, err)
+}
+
+func Test_formatRunStatus(t *testing.T) {
+	require.Equal(t, "complete", formatRunStatus(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE))
+}
+
+func Test_getTableStatuses(t *testing.T) {
+	totalRows := int64(100)
+	newSyncEvent := func(table string) *mgmtv1alpha1.JobRunEvent {
+		return &mgmtv1alpha1.JobRunEvent{
+			Type: "sync",
+			Metadata: &mgmtv1alpha1.JobRunEventMetadata{Metadata: &mgmtv1alpha1.JobRunEventMetadata_SyncMetadata{
+				SyncMetadata: &mgmtv1alpha1.JobRunSyncMetadata{Schema: "public", Table: table},
+			}},
+		}
+	}
+
+	running := newSyncEvent("users")
+	running.Progress = &mgmtv1alpha1.JobRunEventProgress{RowsWritten: 50, TotalRows: &totalRows, RowsPerSecond: 10}
+	complete := newSyncEvent("accounts")
+	complete.CloseTime = timestamppb.Now()
+	retrying := newSyncEvent("orders")
+	retrying.Tasks = []*mgmtv1alpha1.JobRunEventTask{{Error: &mgmtv1alpha1.JobRunEventTaskError{Message: "boom"}}}
+	failed := newSyncEvent("items")
+	failed.CloseTime = timestamppb.Now()
+	failed.Tasks = []*mgmtv1alpha1.JobRunEventTask{{Error: &mgmtv1alpha1.JobRunEventTaskError{Message: "boom"}}}
+
+	statuses := getTableStatuses([]*mgmtv1alpha1.JobRunEvent{
+		{Type: "GenerateBenthosConfigs"},
+		running,
+		complete,
+		retrying,
+		failed,
+	})
+	require.Equal(t, []*tableStatus{
+		{Table: "public.users", Status: tableStatusRunning, RowsWritten: 50, TotalRows: &totalRows, RowsPerSecond: 10},
+		{Table: "public.accounts", Status: tableStatusComplete},
+		{Table: "public.orders", Status: tableStatusRetrying},
+		{Table: "public.items", Status: tableStatusFailed},
+	}, statuses)
+}
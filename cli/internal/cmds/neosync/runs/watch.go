@@ -0,0 +1,251 @@
+package runs_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [run-id]",
+		Short: "watch the per-table progress of a job run until it completes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runId, err := parseRunIdArg(args)
+			if err != nil {
+				return err
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return fmt.Errorf("interval must be greater than zero")
+			}
+			format, err := output.ValidateAndRetrieveFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return watchRun(cmd.Context(), runId, &apiKey, accountId, interval, format)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job run is in. Defaults to account id in cli context")
+	cmd.Flags().Duration("interval", 2*time.Second, "How often to poll the job run for progress")
+	output.AttachFormatFlag(cmd)
+	return cmd
+}
+
+func watchRun(
+	ctx context.Context,
+	runId string,
+	apiKey *string,
+	accountIdFlag string,
+	interval time.Duration,
+	format output.Format,
+) error {
+	accountId, err := getAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	jobclient, err := newJobClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	_, err = WatchRun(ctx, jobclient, runId, accountId, interval, format)
+	return err
+}
+
+// Polls the job run, printing the status of each table whenever it changes, until the run completes.
+// Returns the completed job run.
+func WatchRun(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	runId, accountId string,
+	interval time.Duration,
+	format output.Format,
+) (*mgmtv1alpha1.JobRun, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastSnapshot := ""
+	for {
+		res, err := jobclient.GetJobRunEvents(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunEventsRequest{
+			JobRunId:  runId,
+			AccountId: accountId,
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		statuses := getTableStatuses(res.Msg.GetEvents())
+		snapshot := fmt.Sprint(statuses)
+		if snapshot != lastSnapshot || res.Msg.GetIsRunComplete() {
+			lastSnapshot = snapshot
+			err = printWatchUpdate(res.Msg, statuses, format)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if res.Msg.GetIsRunComplete() {
+			return getRunResult(ctx, jobclient, runId, accountId, format)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func printWatchUpdate(
+	res *mgmtv1alpha1.GetJobRunEventsResponse,
+	statuses []*tableStatus,
+	format output.Format,
+) error {
+	switch format {
+	case output.TableFormat:
+		fmt.Printf("\n%s\n", time.Now().Local().Format(time.RFC3339)) //nolint:forbidigo
+		printTableStatuses(statuses)
+		return nil
+	case output.YamlFormat:
+		fmt.Println("---") //nolint:forbidigo
+		return output.WriteMessage(os.Stdout, format, res)
+	default:
+		return output.WriteMessage(os.Stdout, format, res)
+	}
+}
+
+func getRunResult(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	runId, accountId string,
+	format output.Format,
+) (*mgmtv1alpha1.JobRun, error) {
+	res, err := jobclient.GetJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  runId,
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	jobRun := res.Msg.GetJobRun()
+	if format == output.TableFormat {
+		fmt.Printf("\nJob run %s finished with status %s\n", runId, formatRunStatus(jobRun.GetStatus())) //nolint:forbidigo
+		if jobRun.GetErrorMessage() != "" {
+			fmt.Printf("Error: %s\n", jobRun.GetErrorMessage()) //nolint:forbidigo
+		}
+	}
+	return jobRun, nil
+}
+
+const (
+	tableStatusRunning  = "running"
+	tableStatusRetrying = "retrying"
+	tableStatusComplete = "complete"
+	tableStatusFailed   = "failed"
+)
+
+// The sync status of a single table in a job run
+type tableStatus struct {
+	Table         string
+	Status        string
+	RowsWritten   int64
+	TotalRows     *int64
+	RowsPerSecond float64
+}
+
+func (t *tableStatus) String() string {
+	total := "-"
+	if t.TotalRows != nil {
+		total = fmt.Sprint(*t.TotalRows)
+	}
+	return fmt.Sprintf("%s:%s:%d:%s", t.Table, t.Status, t.RowsWritten, total)
+}
+
+// Builds the status of each table that is synced by the job run from its events
+func getTableStatuses(events []*mgmtv1alpha1.JobRunEvent) []*tableStatus {
+	statuses := []*tableStatus{}
+	for _, event := range events {
+		metadata := event.GetMetadata().GetSyncMetadata()
+		if metadata == nil {
+			continue
+		}
+		hasError := false
+		for _, task := range event.GetTasks() {
+			if task.GetError() != nil {
+				hasError = true
+			}
+		}
+		status := tableStatusRunning
+		switch {
+		case event.GetCloseTime() != nil && hasError:
+			status = tableStatusFailed
+		case event.GetCloseTime() != nil:
+			status = tableStatusComplete
+		case hasError:
+			status = tableStatusRetrying
+		}
+
+		progress := event.GetProgress()
+		var totalRows *int64
+		if progress != nil {
+			totalRows = progress.TotalRows
+		}
+		statuses = append(statuses, &tableStatus{
+			Table:         fmt.Sprintf("%s.%s", metadata.GetSchema(), metadata.GetTable()),
+			Status:        status,
+			RowsWritten:   progress.GetRowsWritten(),
+			TotalRows:     totalRows,
+			RowsPerSecond: progress.GetRowsPerSecond(),
+		})
+	}
+	return statuses
+}
+
+func printTableStatuses(statuses []*tableStatus) {
+	if len(statuses) == 0 {
+		fmt.Println("Waiting for tables to start syncing...") //nolint:forbidigo
+		return
+	}
+	tbl := table.
+		New("Table", "Status", "Rows Written", "Total Rows", "Rows/s").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+	for _, status := range statuses {
+		total := "-"
+		if status.TotalRows != nil {
+			total = fmt.Sprint(*status.TotalRows)
+		}
+		tbl.AddRow(
+			status.Table,
+			strings.ToUpper(status.Status[:1])+status.Status[1:],
+			status.RowsWritten,
+			total,
+			fmt.Sprintf("%.1f", status.RowsPerSecond),
+		)
+	}
+	tbl.Print()
+}
@@ -0,0 +1,346 @@
+package sync_cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	charmlog "github.com/charmbracelet/log"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	cli_neosync_benthos "github.com/nucleuscloud/neosync/cli/internal/benthos"
+	"github.com/nucleuscloud/neosync/cli/internal/output"
+	querybuilder "github.com/nucleuscloud/neosync/worker/pkg/query-builder"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// A column transformer that is applied to the records of an offline sync
+type columnMapping struct {
+	Schema      string             `yaml:"schema"`
+	Table       string             `yaml:"table"`
+	Column      string             `yaml:"column"`
+	Transformer *transformerConfig `yaml:"transformer"`
+}
+
+// Wraps the job mapping transformer so that it can be configured in the same format
+// that the Neosync API uses, e.g. {source: TRANSFORMER_SOURCE_TRANSFORM_EMAIL, config: {transformEmailConfig: {}}}
+type transformerConfig struct {
+	*mgmtv1alpha1.JobMappingTransformer
+}
+
+func (t *transformerConfig) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw map[string]any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	bits, err := json.Marshal(toJsonValue(raw))
+	if err != nil {
+		return err
+	}
+	transformer := &mgmtv1alpha1.JobMappingTransformer{}
+	if err := protojson.Unmarshal(bits, transformer); err != nil {
+		return fmt.Errorf("invalid transformer: %w", err)
+	}
+	t.JobMappingTransformer = transformer
+	return nil
+}
+
+// yaml.v2 decodes nested maps as map[any]any which can not be encoded as json
+func toJsonValue(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for key, val := range v {
+			out[fmt.Sprint(key)] = toJsonValue(val)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, val := range v {
+			out[key] = toJsonValue(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for idx, val := range v {
+			out[idx] = toJsonValue(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// Syncs directly from the source database to the destination without a Neosync API connection
+func syncOffline(
+	ctx context.Context,
+	outputType output.OutputType,
+	cmd *cmdConfig,
+) error {
+	logger := charmlog.NewWithOptions(os.Stderr, charmlog.Options{
+		ReportTimestamp: true,
+	})
+	logger.Info("Starting offline sync")
+
+	err := validateOfflineConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sqlmanagerclient := newSqlManagerClient()
+
+	logger.Info("Retrieving source schema...")
+	schemaConfig, columnInfoMap, err := getSourceSchemaConfig(ctx, sqlmanagerclient, cmd)
+	if err != nil {
+		return err
+	}
+	if len(schemaConfig.Schemas) == 0 {
+		logger.Warn("No tables found.")
+		return nil
+	}
+
+	tableMappings, err := groupMappingsByTable(cmd.Mappings, schemaConfig)
+	if err != nil {
+		return err
+	}
+	warnOnKeyColumnMappings(cmd.Mappings, schemaConfig, logger)
+
+	syncConfigs := buildSyncConfigs(schemaConfig, logger)
+	if syncConfigs == nil {
+		return nil
+	}
+	logger.Info("Running table init statements...")
+	err = runDestinationInitStatements(ctx, logger, sqlmanagerclient, cmd, syncConfigs, schemaConfig)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Generating %d sync configs...", len(syncConfigs))
+	configs := []*benthosConfigResponse{}
+	for _, cfg := range syncConfigs {
+		benthosConfig, err := generateOfflineBenthosConfig(cmd, cfg, tableMappings[cfg.Table()], columnInfoMap[cfg.Table()])
+		if err != nil {
+			return err
+		}
+		configs = append(configs, benthosConfig)
+	}
+
+	// order configs in run order by dependency
+	groupedConfigs := groupConfigsByDependency(configs, logger)
+	if groupedConfigs == nil {
+		return nil
+	}
+
+	return runSync(ctx, outputType, groupedConfigs, logger)
+}
+
+func validateOfflineConfig(cmd *cmdConfig) error {
+	if cmd.Source.Driver == "" {
+		return errors.New("must provide source-driver")
+	}
+	if cmd.Source.Driver != mysqlDriver && cmd.Source.Driver != postgresDriver {
+		return errors.New("unsupported source driver. only postgres and mysql are currently supported")
+	}
+	if cmd.Destination.Driver == "" {
+		return errors.New("must provide destination-driver")
+	}
+	if cmd.Destination.ConnectionUrl == "" {
+		return errors.New("must provide destination-connection-url")
+	}
+	if cmd.Source.Driver != cmd.Destination.Driver {
+		return fmt.Errorf("source and destination drivers are incompatible [%s, %s]", cmd.Source.Driver, cmd.Destination.Driver)
+	}
+	if cmd.Destination.TruncateCascade && cmd.Destination.Driver != postgresDriver {
+		return errors.New("wrong driver type. truncate cascade is only supported in postgres")
+	}
+	for _, mapping := range cmd.Mappings {
+		if mapping.Transformer == nil || mapping.Transformer.JobMappingTransformer == nil {
+			return fmt.Errorf("mapping %s.%s.%s must provide a transformer", mapping.Schema, mapping.Table, mapping.Column)
+		}
+		switch mapping.Transformer.GetSource() {
+		case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED,
+			mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT,
+			mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT:
+			return fmt.Errorf("mapping %s.%s.%s: user defined and javascript transformers are not supported in an offline sync", mapping.Schema, mapping.Table, mapping.Column)
+		}
+	}
+	return nil
+}
+
+func getSourceSchemaConfig(
+	ctx context.Context,
+	sqlmanagerclient sqlmanager.SqlManagerClient,
+	cmd *cmdConfig,
+) (*schemaConfig, map[string]map[string]*sql_manager.ColumnInfo, error) {
+	db, err := sqlmanagerclient.NewSqlDbFromUrl(ctx, string(cmd.Source.Driver), cmd.Source.ConnectionUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Db.Close()
+
+	dbschema, err := db.Db.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := []*mgmtv1alpha1.DatabaseColumn{}
+	schemaSet := map[string]struct{}{}
+	tables := []*sql_manager.SchemaTable{}
+	tableSet := map[string]struct{}{}
+	for _, row := range dbschema {
+		var columnDefault *string
+		if row.ColumnDefault != "" {
+			columnDefault = &row.ColumnDefault
+		}
+		columns = append(columns, &mgmtv1alpha1.DatabaseColumn{
+			Schema:             row.TableSchema,
+			Table:              row.TableName,
+			Column:             row.ColumnName,
+			DataType:           row.DataType,
+			IsNullable:         row.IsNullable,
+			ColumnDefault:      columnDefault,
+			GeneratedType:      row.GeneratedType,
+			IdentityGeneration: row.IdentityGeneration,
+		})
+		schemaSet[row.TableSchema] = struct{}{}
+		table := sql_manager.BuildTable(row.TableSchema, row.TableName)
+		if _, ok := tableSet[table]; !ok {
+			tableSet[table] = struct{}{}
+			tables = append(tables, &sql_manager.SchemaTable{Schema: row.TableSchema, Table: row.TableName})
+		}
+	}
+	if len(columns) == 0 {
+		return &schemaConfig{}, nil, nil
+	}
+	schemas := make([]string, 0, len(schemaSet))
+	for schema := range schemaSet {
+		schemas = append(schemas, schema)
+	}
+
+	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, nil, err
+	}
+	primaryKeys := map[string]*mgmtv1alpha1.PrimaryConstraint{}
+	for table, cols := range tableConstraints.PrimaryKeyConstraints {
+		primaryKeys[table] = &mgmtv1alpha1.PrimaryConstraint{
+			Columns: cols,
+		}
+	}
+
+	columnInfoMap, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	initSchemaStatements := []*mgmtv1alpha1.SchemaInitStatements{}
+	if cmd.Destination.InitSchema {
+		initBlocks, err := db.Db.GetSchemaInitStatements(ctx, tables)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, block := range initBlocks {
+			initSchemaStatements = append(initSchemaStatements, &mgmtv1alpha1.SchemaInitStatements{
+				Label:      block.Label,
+				Statements: block.Statements,
+			})
+		}
+	}
+
+	truncateTableStatementsMap, err := buildTruncateTableStatementsMap(cmd.Destination, getTableColMap(columns))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &schemaConfig{
+		Schemas:                    columns,
+		TableConstraints:           tableConstraints.ForeignKeyConstraints,
+		TablePrimaryKeys:           primaryKeys,
+		TruncateTableStatementsMap: truncateTableStatementsMap,
+		InitSchemaStatements:       initSchemaStatements,
+	}, columnInfoMap, nil
+}
+
+// Groups the mappings by table and verifies that each mapped column exists in the source
+func groupMappingsByTable(mappings []*columnMapping, schemaConfig *schemaConfig) (map[string][]*mgmtv1alpha1.JobMapping, error) {
+	tableColMap := getTableColMap(schemaConfig.Schemas)
+	tableMappings := map[string][]*mgmtv1alpha1.JobMapping{}
+	seen := map[string]struct{}{}
+	for _, mapping := range mappings {
+		table := sql_manager.BuildTable(mapping.Schema, mapping.Table)
+		if !slices.Contains(tableColMap[table], mapping.Column) {
+			return nil, fmt.Errorf("mapping %s.%s: column does not exist in the source", table, mapping.Column)
+		}
+		key := fmt.Sprintf("%s.%s", table, mapping.Column)
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("mapping %s is defined more than once", key)
+		}
+		seen[key] = struct{}{}
+		tableMappings[table] = append(tableMappings[table], &mgmtv1alpha1.JobMapping{
+			Schema:      mapping.Schema,
+			Table:       mapping.Table,
+			Column:      mapping.Column,
+			Transformer: mapping.Transformer.JobMappingTransformer,
+		})
+	}
+	return tableMappings, nil
+}
+
+// Transformed key columns are not kept consistent across the tables that reference them in an offline sync
+func warnOnKeyColumnMappings(mappings []*columnMapping, schemaConfig *schemaConfig, logger *charmlog.Logger) {
+	for _, mapping := range mappings {
+		table := sql_manager.BuildTable(mapping.Schema, mapping.Table)
+		isKey := slices.Contains(schemaConfig.TablePrimaryKeys[table].GetColumns(), mapping.Column)
+		for _, constraint := range schemaConfig.TableConstraints[table] {
+			if slices.Contains(constraint.Columns, mapping.Column) {
+				isKey = true
+			}
+		}
+		if isKey {
+			logger.Warnf("%s.%s is a key column. Transformed values are not propagated to the columns that reference it.", table, mapping.Column)
+		}
+	}
+}
+
+func generateOfflineBenthosConfig(
+	cmd *cmdConfig,
+	syncConfig *tabledependency.RunConfig,
+	mappings []*mgmtv1alpha1.JobMapping,
+	columnInfo map[string]*sql_manager.ColumnInfo,
+) (*benthosConfigResponse, error) {
+	selectColumns := syncConfig.SelectColumns()
+	query, err := querybuilder.BuildSelectQuery(string(cmd.Source.Driver), syncConfig.Table(), selectColumns, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build select query for %s: %w", syncConfig.Table(), err)
+	}
+
+	benthosConfig := buildBenthosConfig(cmd, syncConfig, &cli_neosync_benthos.InputConfig{
+		Inputs: cli_neosync_benthos.Inputs{
+			PooledSqlRaw: &cli_neosync_benthos.InputPooledSqlRaw{
+				Driver: string(cmd.Source.Driver),
+				Dsn:    cmd.Source.ConnectionUrl,
+				Query:  query,
+			},
+		},
+	})
+
+	selectedMappings := []*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range mappings {
+		if slices.Contains(selectColumns, mapping.Column) {
+			selectedMappings = append(selectedMappings, mapping)
+		}
+	}
+	mutation, err := genbenthosconfigs_activity.BuildMutation(selectedMappings, columnInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build transformers for %s: %w", syncConfig.Table(), err)
+	}
+	if mutation != "" {
+		benthosConfig.Config.Pipeline.Processors = append(benthosConfig.Config.Pipeline.Processors, cli_neosync_benthos.ProcessorConfig{Mutation: &mutation})
+	}
+	return benthosConfig, nil
+}
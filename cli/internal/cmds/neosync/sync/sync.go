@@ -37,7 +37,8 @@ import (
 	"gopkg.in/yaml.v2"
 
 	_ "github.com/nucleuscloud/neosync/cli/internal/benthos/inputs"
-	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/sql"
+	neosync_benthos_sql "github.com/nucleuscloud/neosync/worker/pkg/benthos/sql"
+	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
 	_ "github.com/warpstreamlabs/bento/public/components/aws"
 	_ "github.com/warpstreamlabs/bento/public/components/io"
 	_ "github.com/warpstreamlabs/bento/public/components/pure"
@@ -76,10 +77,15 @@ type cmdConfig struct {
 	Source                 *sourceConfig              `yaml:"source"`
 	Destination            *sqlDestinationConfig      `yaml:"destination"`
 	AwsDynamoDbDestination *dynamoDbDestinationConfig `yaml:"aws-dynamodb-destination,omitempty"`
+	// Column transformers that are applied when syncing from a source connection url
+	Mappings []*columnMapping `yaml:"mappings,omitempty"`
 }
 
 type sourceConfig struct {
-	ConnectionId   string          `yaml:"connection-id"`
+	ConnectionId string `yaml:"connection-id"`
+	// Syncs directly from the database instead of through a Neosync connection
+	ConnectionUrl  string          `yaml:"connection-url,omitempty"`
+	Driver         DriverType      `yaml:"driver,omitempty"`
 	ConnectionOpts *connectionOpts `yaml:"connection-opts,omitempty"`
 }
 
@@ -162,6 +168,23 @@ func NewCmd() *cobra.Command {
 				config.Source.ConnectionId = connectionId
 			}
 
+			sourceConnUrl, err := cmd.Flags().GetString("source-connection-url")
+			if err != nil {
+				return err
+			}
+			if sourceConnUrl != "" {
+				config.Source.ConnectionUrl = sourceConnUrl
+			}
+
+			sourceDriver, err := cmd.Flags().GetString("source-driver")
+			if err != nil {
+				return err
+			}
+			pSourceDriver, ok := parseDriverString(sourceDriver)
+			if ok {
+				config.Source.Driver = pSourceDriver
+			}
+
 			destConnUrl, err := cmd.Flags().GetString("destination-connection-url")
 			if err != nil {
 				return err
@@ -232,8 +255,11 @@ func NewCmd() *cobra.Command {
 				return err
 			}
 
-			if config.Source.ConnectionId == "" {
-				return fmt.Errorf("must provide connection-id")
+			if config.Source.ConnectionId == "" && config.Source.ConnectionUrl == "" {
+				return fmt.Errorf("must provide connection-id or source-connection-url")
+			}
+			if config.Source.ConnectionId != "" && config.Source.ConnectionUrl != "" {
+				return fmt.Errorf("connection-id and source-connection-url can not be used together")
 			}
 
 			accountId, err := cmd.Flags().GetString("account-id")
@@ -246,11 +272,19 @@ func NewCmd() *cobra.Command {
 				return err
 			}
 
+			if config.Source.ConnectionUrl != "" {
+				return syncOffline(cmd.Context(), outputType, config)
+			}
+			if len(config.Mappings) > 0 {
+				return fmt.Errorf("mappings are only supported when syncing from a source-connection-url")
+			}
 			return sync(cmd.Context(), outputType, apiKey, &accountId, config)
 		},
 	}
 
 	cmd.Flags().String("connection-id", "", "Connection id for sync source")
+	cmd.Flags().String("source-connection-url", "", "Connection url for sync source. Syncs without a Neosync API connection")
+	cmd.Flags().String("source-driver", "", "Connection driver for sync source. Used with source-connection-url")
 	cmd.Flags().String("job-id", "", "Id of Job to sync data from. Only used with [AWS S3, GCP Cloud Storage] connections. Can use job-run-id instead.")
 	cmd.Flags().String("job-run-id", "", "Id of Job run to sync data from. Only used with [AWS S3, GCP Cloud Storage] connections. Can use job-id instead.")
 	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output")
@@ -308,16 +342,9 @@ func sync(
 		),
 	)
 
-	pgpoolmap := &syncmap.Map{}
-	mysqlpoolmap := &syncmap.Map{}
-	mssqlpoolmap := &syncmap.Map{}
-	pgquerier := pg_queries.New()
-	mysqlquerier := mysql_queries.New()
-	mssqlquerier := mssql_queries.New()
-	sqlConnector := &sqlconnect.SqlOpenConnector{}
-	sqlmanagerclient := sqlmanager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlConnector)
+	sqlmanagerclient := newSqlManagerClient()
 
-	connResp, err := connectionclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	connResp, err := connectionclient.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: cmd.Source.ConnectionId,
 	}))
 	if err != nil {
@@ -535,6 +562,17 @@ func sync(
 	return runSync(ctx, outputType, groupedConfigs, logger)
 }
 
+func newSqlManagerClient() *sqlmanager.SqlManager {
+	pgpoolmap := &syncmap.Map{}
+	mysqlpoolmap := &syncmap.Map{}
+	mssqlpoolmap := &syncmap.Map{}
+	pgquerier := pg_queries.New()
+	mysqlquerier := mysql_queries.New()
+	mssqlquerier := mssql_queries.New()
+	sqlConnector := &sqlconnect.SqlOpenConnector{}
+	return sqlmanager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlConnector)
+}
+
 func areSourceAndDestCompatible(connection *mgmtv1alpha1.Connection, destinationDriver DriverType) error {
 	switch connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
@@ -565,10 +603,21 @@ func syncData(ctx context.Context, cfg *benthosConfigResponse, logger *charmlog.
 		return fmt.Errorf("unable to register dynamodb output to benthos instance: %w", err)
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stopChan := make(chan error, 3)
+	err = neosync_benthos_sql.RegisterPooledSqlRawInput(env, neosync_benthos_sql.NewDbPoolProvider(), stopChan)
+	if err != nil {
+		return fmt.Errorf("unable to register pooled_sql_raw input to benthos instance: %w", err)
+	}
+
 	var benthosStream *service.Stream
 	go func() {
-		for { //nolint
+		for {
 			select {
+			case err := <-stopChan:
+				logger.Error(err.Error())
+				cancel()
 			case <-ctx.Done():
 				if benthosStream != nil {
 					// this must be here because stream.Run(ctx) doesn't seem to fully obey a canceled context when
@@ -811,26 +860,37 @@ func generateBenthosConfig(
 		jobId = cmd.Source.ConnectionOpts.JobId
 	}
 
+	return buildBenthosConfig(cmd, syncConfig, &cli_neosync_benthos.InputConfig{
+		Inputs: cli_neosync_benthos.Inputs{
+			NeosyncConnectionData: &cli_neosync_benthos.NeosyncConnectionData{
+				ApiKey:         authToken,
+				ApiUrl:         apiUrl,
+				ConnectionId:   cmd.Source.ConnectionId,
+				ConnectionType: string(connectionType),
+				JobId:          jobId,
+				JobRunId:       jobRunId,
+				Schema:         schema,
+				Table:          table,
+			},
+		},
+	})
+}
+
+// Builds the benthos config that writes the records of the input to the destination table
+func buildBenthosConfig(
+	cmd *cmdConfig,
+	syncConfig *tabledependency.RunConfig,
+	input *cli_neosync_benthos.InputConfig,
+) *benthosConfigResponse {
+	schema, table := sqlmanager_shared.SplitTableKey(syncConfig.Table())
+
 	bc := &cli_neosync_benthos.BenthosConfig{
 		StreamConfig: cli_neosync_benthos.StreamConfig{
 			Logger: &cli_neosync_benthos.LoggerConfig{
 				Level:        "ERROR",
 				AddTimestamp: true,
 			},
-			Input: &cli_neosync_benthos.InputConfig{
-				Inputs: cli_neosync_benthos.Inputs{
-					NeosyncConnectionData: &cli_neosync_benthos.NeosyncConnectionData{
-						ApiKey:         authToken,
-						ApiUrl:         apiUrl,
-						ConnectionId:   cmd.Source.ConnectionId,
-						ConnectionType: string(connectionType),
-						JobId:          jobId,
-						JobRunId:       jobRunId,
-						Schema:         schema,
-						Table:          table,
-					},
-				},
-			},
+			Input:    input,
 			Pipeline: &cli_neosync_benthos.PipelineConfig{},
 			Output:   &cli_neosync_benthos.OutputConfig{},
 		},
@@ -1083,9 +1143,23 @@ func getDestinationSchemaConfig(
 		}
 	}
 
+	truncateTableStatementsMap, err := buildTruncateTableStatementsMap(cmd.Destination, tableColMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaConfig{
+		Schemas:                    schemaResp.Msg.GetSchemas(),
+		TableConstraints:           tableConstraints.ForeignKeyConstraints,
+		TablePrimaryKeys:           primaryKeys,
+		TruncateTableStatementsMap: truncateTableStatementsMap,
+	}, nil
+}
+
+func buildTruncateTableStatementsMap(destination *sqlDestinationConfig, tableColMap map[string][]string) (map[string]string, error) {
 	truncateTableStatementsMap := map[string]string{}
-	if cmd.Destination.Driver == postgresDriver {
-		if cmd.Destination.TruncateCascade {
+	if destination.Driver == postgresDriver {
+		if destination.TruncateCascade {
 			for t := range tableColMap {
 				schema, table := sqlmanager_shared.SplitTableKey(t)
 				stmt, err := sqlmanager_postgres.BuildPgTruncateCascadeStatement(schema, table)
@@ -1097,7 +1171,7 @@ func getDestinationSchemaConfig(
 		}
 		// truncate before insert handled in runDestinationInitStatements
 	} else {
-		if cmd.Destination.TruncateBeforeInsert {
+		if destination.TruncateBeforeInsert {
 			for t := range tableColMap {
 				schema, table := sqlmanager_shared.SplitTableKey(t)
 				stmt, err := sqlmanager_mysql.BuildMysqlTruncateStatement(schema, table)
@@ -1108,13 +1182,7 @@ func getDestinationSchemaConfig(
 			}
 		}
 	}
-
-	return &schemaConfig{
-		Schemas:                    schemaResp.Msg.GetSchemas(),
-		TableConstraints:           tableConstraints.ForeignKeyConstraints,
-		TablePrimaryKeys:           primaryKeys,
-		TruncateTableStatementsMap: truncateTableStatementsMap,
-	}, nil
+	return truncateTableStatementsMap, nil
 }
 
 func getDestinationTableConstraints(ctx context.Context, sqlmanagerclient sqlmanager.SqlManagerClient, connectionDriver DriverType, connectionUrl string, schemas []string) (*sql_manager.TableConstraints, error) {
@@ -0,0 +1,44 @@
+package exitcode
+
+import "errors"
+
+const (
+	// A general error occurred while running the command
+	General = 1
+	// The job run ended with an error or failed
+	JobRunFailed = 2
+	// The job run was canceled or terminated
+	JobRunCanceled = 3
+	// The job run timed out, or did not complete before the command timeout
+	JobRunTimedOut = 4
+)
+
+// An error that causes the CLI to exit with a specific exit code
+type Error struct {
+	Code int
+	Err  error
+}
+
+func New(code int, err error) *Error {
+	return &Error{Code: code, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Returns the exit code that the CLI should exit with for the given error
+func FromError(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *Error
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return General
+}
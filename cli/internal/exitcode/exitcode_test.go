@@ -0,0 +1,16 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FromError(t *testing.T) {
+	require.Equal(t, 0, FromError(nil))
+	require.Equal(t, General, FromError(errors.New("boom")))
+	require.Equal(t, JobRunFailed, FromError(New(JobRunFailed, errors.New("boom"))))
+	require.Equal(t, JobRunTimedOut, FromError(fmt.Errorf("wrapped: %w", New(JobRunTimedOut, errors.New("boom")))))
+}
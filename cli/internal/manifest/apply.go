@@ -0,0 +1,292 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Creates and updates the resources of the plan in the account.
+// Changes are applied in order and applying stops at the first error. Progress is written to w.
+func Apply(
+	ctx context.Context,
+	clients *Clients,
+	accountId string,
+	plan *Plan,
+	state *State,
+	w io.Writer,
+) error {
+	for _, change := range plan.Changes {
+		if change.Action == UnchangedAction {
+			continue
+		}
+		var err error
+		switch change.Resource.Kind {
+		case ConnectionKind:
+			err = applyConnection(ctx, clients, accountId, change, state)
+		case UserDefinedTransformerKind:
+			err = applyTransformer(ctx, clients, accountId, change, state)
+		case JobKind:
+			err = applyJob(ctx, clients, accountId, change, state)
+		default:
+			err = fmt.Errorf("unsupported manifest kind %q", change.Resource.Kind)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to %s %s: %w", change.Action, change.Resource, err)
+		}
+		fmt.Fprintf(w, "%s: %sd\n", change.Resource, change.Action)
+	}
+	return nil
+}
+
+func applyConnection(
+	ctx context.Context,
+	clients *Clients,
+	accountId string,
+	change *Change,
+	state *State,
+) error {
+	config, ok := change.Resource.Spec.(*mgmtv1alpha1.ConnectionConfig)
+	if !ok {
+		return fmt.Errorf("unexpected spec type %T", change.Resource.Spec)
+	}
+
+	if change.Action == CreateAction {
+		if hasMaskedValue(config) {
+			return fmt.Errorf("the connection does not exist yet and its spec holds masked secrets. Replace %q with the secret or a secret reference", maskedValue)
+		}
+		resp, err := clients.Connections.CreateConnection(ctx, connect.NewRequest(&mgmtv1alpha1.CreateConnectionRequest{
+			AccountId:        accountId,
+			Name:             change.Resource.Name,
+			ConnectionConfig: config,
+		}))
+		if err != nil {
+			return err
+		}
+		state.setConnection(resp.Msg.GetConnection())
+		return nil
+	}
+
+	id, _ := state.getId(ConnectionKind, change.Resource.Name)
+	// masked secrets are kept as is by the API
+	resp, err := clients.Connections.UpdateConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateConnectionRequest{
+		Id:               id,
+		Name:             change.Resource.Name,
+		ConnectionConfig: config,
+	}))
+	if err != nil {
+		return err
+	}
+	state.setConnection(resp.Msg.GetConnection())
+	return nil
+}
+
+func applyTransformer(
+	ctx context.Context,
+	clients *Clients,
+	accountId string,
+	change *Change,
+	state *State,
+) error {
+	spec, ok := change.Resource.Spec.(*mgmtv1alpha1.CreateUserDefinedTransformerRequest)
+	if !ok {
+		return fmt.Errorf("unexpected spec type %T", change.Resource.Spec)
+	}
+
+	if change.Action == CreateAction {
+		req := proto.Clone(spec).(*mgmtv1alpha1.CreateUserDefinedTransformerRequest)
+		req.AccountId = accountId
+		req.Name = change.Resource.Name
+		resp, err := clients.Transformers.CreateUserDefinedTransformer(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		state.setTransformer(resp.Msg.GetTransformer())
+		return nil
+	}
+
+	current := state.transformers[change.Resource.Name]
+	if current.GetSource() != spec.GetSource() {
+		return fmt.Errorf("the source of a user defined transformer can not be changed from %s to %s", current.GetSource(), spec.GetSource())
+	}
+	resp, err := clients.Transformers.UpdateUserDefinedTransformer(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateUserDefinedTransformerRequest{
+		TransformerId:     current.GetId(),
+		Name:              change.Resource.Name,
+		Description:       spec.GetDescription(),
+		TransformerConfig: spec.GetTransformerConfig(),
+	}))
+	if err != nil {
+		return err
+	}
+	state.setTransformer(resp.Msg.GetTransformer())
+	return nil
+}
+
+func applyJob(
+	ctx context.Context,
+	clients *Clients,
+	accountId string,
+	change *Change,
+	state *State,
+) error {
+	spec, ok := change.Resource.Spec.(*mgmtv1alpha1.CreateJobRequest)
+	if !ok {
+		return fmt.Errorf("unexpected spec type %T", change.Resource.Spec)
+	}
+	desired := proto.Clone(spec).(*mgmtv1alpha1.CreateJobRequest)
+	err := rewriteRefs(desired, func(kind Kind, name string) (string, error) {
+		id, ok := state.getId(kind, name)
+		if !ok {
+			return "", fmt.Errorf("references %s %q which does not exist", kind, name)
+		}
+		return id, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if change.Action == CreateAction {
+		desired.AccountId = accountId
+		desired.JobName = change.Resource.Name
+		resp, err := clients.Jobs.CreateJob(ctx, connect.NewRequest(desired))
+		if err != nil {
+			return err
+		}
+		state.setJob(resp.Msg.GetJob())
+		return nil
+	}
+
+	job := state.jobs[change.Resource.Name]
+	current := toJobSpec(job)
+
+	if current.GetCronSchedule() != desired.GetCronSchedule() {
+		if _, err := clients.Jobs.UpdateJobSchedule(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobScheduleRequest{
+			Id:           job.GetId(),
+			CronSchedule: desired.CronSchedule,
+		})); err != nil {
+			return err
+		}
+	}
+
+	if !isEqual(current.GetSource(), desired.GetSource()) ||
+		!isListEqual(current.GetMappings(), desired.GetMappings()) ||
+		!isListEqual(current.GetVirtualForeignKeys(), desired.GetVirtualForeignKeys()) {
+		if _, err := clients.Jobs.UpdateJobSourceConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+			Id:                 job.GetId(),
+			Source:             desired.GetSource(),
+			Mappings:           desired.GetMappings(),
+			VirtualForeignKeys: desired.GetVirtualForeignKeys(),
+		})); err != nil {
+			return err
+		}
+	}
+
+	if !isEqual(current.GetWorkflowOptions(), desired.GetWorkflowOptions()) {
+		if _, err := clients.Jobs.SetJobWorkflowOptions(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobWorkflowOptionsRequest{
+			Id:              job.GetId(),
+			WorfklowOptions: desired.GetWorkflowOptions(),
+		})); err != nil {
+			return err
+		}
+	}
+
+	if !isEqual(current.GetSyncOptions(), desired.GetSyncOptions()) {
+		if _, err := clients.Jobs.SetJobSyncOptions(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobSyncOptionsRequest{
+			Id:          job.GetId(),
+			SyncOptions: desired.GetSyncOptions(),
+		})); err != nil {
+			return err
+		}
+	}
+
+	if err := applyJobDestinations(ctx, clients, job, desired.GetDestinations()); err != nil {
+		return err
+	}
+
+	resp, err := clients.Jobs.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: job.GetId()}))
+	if err != nil {
+		return err
+	}
+	state.setJob(resp.Msg.GetJob())
+	return nil
+}
+
+// Reconciles the destinations of the job, matching destinations by their connection
+func applyJobDestinations(
+	ctx context.Context,
+	clients *Clients,
+	job *mgmtv1alpha1.Job,
+	desired []*mgmtv1alpha1.CreateJobDestination,
+) error {
+	currentByConnection := map[string]*mgmtv1alpha1.JobDestination{}
+	for _, destination := range job.GetDestinations() {
+		currentByConnection[destination.GetConnectionId()] = destination
+	}
+
+	toCreate := []*mgmtv1alpha1.CreateJobDestination{}
+	for _, destination := range desired {
+		current, ok := currentByConnection[destination.GetConnectionId()]
+		if !ok {
+			toCreate = append(toCreate, destination)
+			continue
+		}
+		delete(currentByConnection, destination.GetConnectionId())
+		if isEqual(current.GetOptions(), destination.GetOptions()) {
+			continue
+		}
+		if _, err := clients.Jobs.UpdateJobDestinationConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobDestinationConnectionRequest{
+			JobId:         job.GetId(),
+			ConnectionId:  destination.GetConnectionId(),
+			Options:       destination.GetOptions(),
+			DestinationId: current.GetId(),
+		})); err != nil {
+			return err
+		}
+	}
+
+	if len(toCreate) > 0 {
+		if _, err := clients.Jobs.CreateJobDestinationConnections(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobDestinationConnectionsRequest{
+			JobId:        job.GetId(),
+			Destinations: toCreate,
+		})); err != nil {
+			return err
+		}
+	}
+
+	for _, destination := range job.GetDestinations() {
+		if _, ok := currentByConnection[destination.GetConnectionId()]; !ok {
+			continue
+		}
+		if _, err := clients.Jobs.DeleteJobDestinationConnection(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteJobDestinationConnectionRequest{
+			DestinationId: destination.GetId(),
+		})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Compares the messages after normalizing them. An unset message is equal to an empty message.
+func isEqual[T proto.Message](a, b T) bool {
+	na, nb := normalize(a), normalize(b)
+	if isEmpty(na.ProtoReflect()) && isEmpty(nb.ProtoReflect()) {
+		return true
+	}
+	return proto.Equal(na, nb)
+}
+
+func isListEqual[T proto.Message](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if !isEqual(a[idx], b[idx]) {
+			return false
+		}
+	}
+	return true
+}
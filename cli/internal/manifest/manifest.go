@@ -0,0 +1,272 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The version of the manifest format
+	ApiVersion = "neosync.dev/v1alpha1"
+)
+
+type Kind string
+
+const (
+	ConnectionKind             Kind = "Connection"
+	UserDefinedTransformerKind Kind = "UserDefinedTransformer"
+	JobKind                    Kind = "Job"
+)
+
+// The order resources are reconciled in. Resources may only reference kinds that come before them.
+var kindOrder = []Kind{ConnectionKind, UserDefinedTransformerKind, JobKind}
+
+// A single Neosync resource that is described by a manifest.
+// Resources reference each other by name instead of by id so that manifests can be applied to any account.
+//
+// The spec of each kind is one of:
+//   - Connection: *mgmtv1alpha1.ConnectionConfig. Secrets are masked on export.
+//   - UserDefinedTransformer: *mgmtv1alpha1.CreateUserDefinedTransformerRequest without the account id and name
+//   - Job: *mgmtv1alpha1.CreateJobRequest without the account id and name. Connection and user defined transformer ids hold names.
+type Resource struct {
+	Kind Kind
+	Name string
+	Spec proto.Message
+}
+
+type resourceDocument struct {
+	ApiVersion string         `json:"apiVersion" yaml:"apiVersion"`
+	Kind       Kind           `json:"kind" yaml:"kind"`
+	Metadata   metadata       `json:"metadata" yaml:"metadata"`
+	Spec       map[string]any `json:"spec" yaml:"spec"`
+}
+
+type metadata struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+func newSpec(kind Kind) (proto.Message, error) {
+	switch kind {
+	case ConnectionKind:
+		return &mgmtv1alpha1.ConnectionConfig{}, nil
+	case UserDefinedTransformerKind:
+		return &mgmtv1alpha1.CreateUserDefinedTransformerRequest{}, nil
+	case JobKind:
+		return &mgmtv1alpha1.CreateJobRequest{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported manifest kind %q, must be one of %v", kind, kindOrder)
+	}
+}
+
+// Reads all of the resources from the given manifest files.
+// Directories are expanded to the .yaml, .yml and .json files they directly contain.
+func ReadFiles(paths []string) ([]*Resource, error) {
+	files, err := expandPaths(paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no manifest files were found")
+	}
+
+	resources := []*Resource{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		fileResources, err := Parse(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse manifest %s: %w", file, err)
+		}
+		resources = append(resources, fileResources...)
+	}
+	if err := validateUnique(resources); err != nil {
+		return nil, err
+	}
+	Sort(resources)
+	return resources, nil
+}
+
+func expandPaths(paths []string) ([]string, error) {
+	files := []string{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".yaml", ".yml", ".json":
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	return files, nil
+}
+
+// Parses a YAML or JSON manifest. A YAML manifest may contain multiple documents and a JSON manifest may be a single resource or an array of resources.
+func Parse(r io.Reader) ([]*Resource, error) {
+	decoder := yaml.NewDecoder(r)
+	resources := []*Resource{}
+	for {
+		var doc any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs := []any{doc}
+		if list, ok := doc.([]any); ok {
+			docs = list
+		}
+		for _, d := range docs {
+			if d == nil {
+				continue
+			}
+			resource, err := parseResource(d)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+func parseResource(doc any) (*Resource, error) {
+	bits, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read resource: %w", err)
+	}
+	rd := &resourceDocument{}
+	if err := json.Unmarshal(bits, rd); err != nil {
+		return nil, fmt.Errorf("unable to read resource: %w", err)
+	}
+	if rd.ApiVersion != ApiVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q for %s %q, must be %q", rd.ApiVersion, rd.Kind, rd.Metadata.Name, ApiVersion)
+	}
+	if rd.Metadata.Name == "" {
+		return nil, fmt.Errorf("%s is missing metadata.name", rd.Kind)
+	}
+	spec, err := newSpec(rd.Kind)
+	if err != nil {
+		return nil, err
+	}
+	specBits, err := json.Marshal(rd.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := protojson.Unmarshal(specBits, spec); err != nil {
+		return nil, fmt.Errorf("invalid spec for %s %q: %w", rd.Kind, rd.Metadata.Name, err)
+	}
+	return &Resource{Kind: rd.Kind, Name: rd.Metadata.Name, Spec: spec}, nil
+}
+
+func validateUnique(resources []*Resource) error {
+	seen := map[string]struct{}{}
+	for _, resource := range resources {
+		key := resource.String()
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("%s is defined more than once", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+func (r *Resource) String() string {
+	return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+}
+
+// Sorts resources in the order they are reconciled in, then by name
+func Sort(resources []*Resource) {
+	slices.SortStableFunc(resources, func(a, b *Resource) int {
+		if a.Kind != b.Kind {
+			return slices.Index(kindOrder, a.Kind) - slices.Index(kindOrder, b.Kind)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func toDocument(resource *Resource) (*resourceDocument, error) {
+	bits, err := protojson.Marshal(resource.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]any{}
+	if err := json.Unmarshal(bits, &spec); err != nil {
+		return nil, err
+	}
+	return &resourceDocument{
+		ApiVersion: ApiVersion,
+		Kind:       resource.Kind,
+		Metadata:   metadata{Name: resource.Name},
+		Spec:       spec,
+	}, nil
+}
+
+// Writes the resources as a multi document YAML manifest
+func EncodeYaml(w io.Writer, resources []*Resource) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	for _, resource := range resources {
+		doc, err := toDocument(resource)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+// Writes the resources as a JSON array
+func EncodeJson(w io.Writer, resources []*Resource) error {
+	docs := make([]*resourceDocument, 0, len(resources))
+	for _, resource := range resources {
+		doc, err := toDocument(resource)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(docs)
+}
+
+func toYaml(resource *Resource) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := EncodeYaml(buf, []*Resource{resource}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,303 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	sourceConnId        = "3b1f8a2e-2c4b-4b8e-9a53-0e6d9f7c1a01"
+	destConnId          = "7c2e9d4f-1a3b-4c5d-8e6f-0a1b2c3d4e02"
+	transformerId       = "9d8c7b6a-5f4e-4d3c-2b1a-0f9e8d7c6b03"
+	jobId               = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c04"
+	sourceDestinationId = "5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a805"
+)
+
+func Test_Parse_Yaml(t *testing.T) {
+	resources, err := Parse(strings.NewReader(`
+apiVersion: neosync.dev/v1alpha1
+kind: Connection
+metadata:
+  name: prod-db
+spec:
+  pgConfig:
+    url: env://PROD_DB_URL
+---
+apiVersion: neosync.dev/v1alpha1
+kind: Job
+metadata:
+  name: nightly
+spec:
+  cronSchedule: "0 0 * * *"
+  source:
+    options:
+      postgres:
+        connectionId: prod-db
+  workflowOptions:
+    runTimeout: 3600
+`))
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	require.Equal(t, "Connection/prod-db", resources[0].String())
+	require.Equal(t, "env://PROD_DB_URL", resources[0].Spec.(*mgmtv1alpha1.ConnectionConfig).GetPgConfig().GetUrl())
+	job := resources[1].Spec.(*mgmtv1alpha1.CreateJobRequest)
+	require.Equal(t, "0 0 * * *", job.GetCronSchedule())
+	require.Equal(t, "prod-db", job.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Equal(t, int64(3600), job.GetWorkflowOptions().GetRunTimeout())
+}
+
+func Test_Parse_JsonArray(t *testing.T) {
+	resources, err := Parse(strings.NewReader(`[{"apiVersion":"neosync.dev/v1alpha1","kind":"UserDefinedTransformer","metadata":{"name":"mask-email"},"spec":{"description":"masks emails","source":"TRANSFORMER_SOURCE_TRANSFORM_EMAIL"}}]`))
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, resources[0].Spec.(*mgmtv1alpha1.CreateUserDefinedTransformerRequest).GetSource())
+}
+
+func Test_Parse_Errors(t *testing.T) {
+	_, err := Parse(strings.NewReader("apiVersion: neosync.dev/v2\nkind: Job\nmetadata:\n  name: a\n"))
+	require.ErrorContains(t, err, "unsupported apiVersion")
+
+	_, err = Parse(strings.NewReader("apiVersion: neosync.dev/v1alpha1\nkind: Widget\nmetadata:\n  name: a\n"))
+	require.ErrorContains(t, err, "unsupported manifest kind")
+
+	_, err = Parse(strings.NewReader("apiVersion: neosync.dev/v1alpha1\nkind: Job\nmetadata:\n  name: a\nspec:\n  unknownField: 1\n"))
+	require.ErrorContains(t, err, "invalid spec for Job")
+}
+
+func Test_ReadFiles_RejectsDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	doc := "apiVersion: neosync.dev/v1alpha1\nkind: Connection\nmetadata:\n  name: prod-db\nspec:\n  pgConfig:\n    url: env://URL\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(doc), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(doc), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0600))
+
+	_, err := ReadFiles([]string{dir})
+	require.ErrorContains(t, err, "Connection/prod-db is defined more than once")
+}
+
+func Test_Export_RoundTrip(t *testing.T) {
+	state := newTestState()
+	resources, err := state.Export()
+	require.NoError(t, err)
+	require.Len(t, resources, 4)
+	require.Equal(t, []string{"Connection/dest-db", "Connection/prod-db", "UserDefinedTransformer/mask-email", "Job/nightly"}, resourceNames(resources))
+
+	job := resources[3].Spec.(*mgmtv1alpha1.CreateJobRequest)
+	require.Equal(t, "prod-db", job.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Equal(t, "dest-db", job.GetDestinations()[0].GetConnectionId())
+	require.Equal(t, "mask-email", job.GetMappings()[0].GetTransformer().GetConfig().GetUserDefinedTransformerConfig().GetId())
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, EncodeYaml(buf, resources))
+	parsed, err := Parse(buf)
+	require.NoError(t, err)
+	require.Len(t, parsed, len(resources))
+	for idx := range parsed {
+		require.Equal(t, resources[idx].String(), parsed[idx].String())
+		require.True(t, proto.Equal(resources[idx].Spec, parsed[idx].Spec), resources[idx].String())
+	}
+
+	plan, err := NewPlan(parsed, state)
+	require.NoError(t, err)
+	require.False(t, plan.HasChanges())
+}
+
+func Test_NewJobResource(t *testing.T) {
+	state := newTestState()
+	req := &mgmtv1alpha1.CreateJobRequest{
+		AccountId: "account-id",
+		JobName:   "weekly",
+		Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+			Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: sourceConnId},
+		}}},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{{ConnectionId: destConnId}},
+	}
+	resource, err := state.NewJobResource("weekly", req)
+	require.NoError(t, err)
+	require.Equal(t, "Job/weekly", resource.String())
+
+	job := resource.Spec.(*mgmtv1alpha1.CreateJobRequest)
+	require.Empty(t, job.GetAccountId())
+	require.Empty(t, job.GetJobName())
+	require.Equal(t, "prod-db", job.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Equal(t, "dest-db", job.GetDestinations()[0].GetConnectionId())
+	require.Equal(t, sourceConnId, req.GetSource().GetOptions().GetPostgres().GetConnectionId(), "the request must not be modified")
+}
+
+func Test_NewPlan(t *testing.T) {
+	state := newTestState()
+	resources, err := state.Export()
+	require.NoError(t, err)
+
+	// a real secret compares equal to the masked secret that the API returns
+	resources[1].Spec.(*mgmtv1alpha1.ConnectionConfig).GetPgConfig().GetConnection().Pass = "hunter2"
+	job := resources[3].Spec.(*mgmtv1alpha1.CreateJobRequest)
+	cron := "0 1 * * *"
+	job.CronSchedule = &cron
+	resources = append(resources, &Resource{Kind: ConnectionKind, Name: "new-db", Spec: &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+			ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{Url: "env://NEW_DB_URL"},
+		}},
+	}})
+	Sort(resources)
+
+	plan, err := NewPlan(resources, state)
+	require.NoError(t, err)
+	require.True(t, plan.HasChanges())
+	actions := map[string]Action{}
+	for _, change := range plan.Changes {
+		actions[change.Resource.String()] = change.Action
+	}
+	require.Equal(t, map[string]Action{
+		"Connection/dest-db":                UnchangedAction,
+		"Connection/new-db":                 CreateAction,
+		"Connection/prod-db":                UnchangedAction,
+		"UserDefinedTransformer/mask-email": UnchangedAction,
+		"Job/nightly":                       UpdateAction,
+	}, actions)
+
+	out := &bytes.Buffer{}
+	plan.Print(out)
+	require.Contains(t, out.String(), "-   cronSchedule: 0 0 * * *")
+	require.Contains(t, out.String(), "+   cronSchedule: 0 1 * * *")
+	require.Contains(t, out.String(), "Plan: 1 to create, 1 to update, 3 unchanged")
+}
+
+func Test_Apply_UpdatesJob(t *testing.T) {
+	state := newTestState()
+	resources, err := state.Export()
+	require.NoError(t, err)
+	job := resources[3].Spec.(*mgmtv1alpha1.CreateJobRequest)
+	cron := "0 1 * * *"
+	job.CronSchedule = &cron
+	job.Destinations = nil
+	job.Mappings[0].Column = "email_address"
+
+	plan, err := NewPlan(resources, state)
+	require.NoError(t, err)
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("UpdateJobSchedule", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.UpdateJobScheduleRequest]) bool {
+		return req.Msg.GetId() == jobId && req.Msg.GetCronSchedule() == cron
+	})).Return(connect.NewResponse(&mgmtv1alpha1.UpdateJobScheduleResponse{}), nil)
+	jobclient.On("UpdateJobSourceConnection", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest]) bool {
+		return req.Msg.GetSource().GetOptions().GetPostgres().GetConnectionId() == sourceConnId &&
+			req.Msg.GetMappings()[0].GetColumn() == "email_address" &&
+			req.Msg.GetMappings()[0].GetTransformer().GetConfig().GetUserDefinedTransformerConfig().GetId() == transformerId
+	})).Return(connect.NewResponse(&mgmtv1alpha1.UpdateJobSourceConnectionResponse{}), nil)
+	jobclient.On("DeleteJobDestinationConnection", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.DeleteJobDestinationConnectionRequest]) bool {
+		return req.Msg.GetDestinationId() == sourceDestinationId
+	})).Return(connect.NewResponse(&mgmtv1alpha1.DeleteJobDestinationConnectionResponse{}), nil)
+	jobclient.On("GetJob", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{Job: state.jobs["nightly"]}), nil)
+
+	err = Apply(context.Background(), &Clients{Jobs: jobclient}, "account-id", plan, state, io.Discard)
+	require.NoError(t, err)
+}
+
+func Test_Apply_CreateConnection_RejectsMaskedSecrets(t *testing.T) {
+	state := NewState(nil, nil, nil)
+	plan, err := NewPlan([]*Resource{{Kind: ConnectionKind, Name: "prod-db", Spec: newPgConfig(maskedValue)}}, state)
+	require.NoError(t, err)
+
+	err = Apply(context.Background(), &Clients{}, "account-id", plan, state, io.Discard)
+	require.ErrorContains(t, err, "masked secrets")
+}
+
+func Test_Apply_CreateJob_UnknownReference(t *testing.T) {
+	state := NewState(nil, nil, nil)
+	plan, err := NewPlan([]*Resource{{Kind: JobKind, Name: "nightly", Spec: &mgmtv1alpha1.CreateJobRequest{
+		Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: "missing-db"}},
+		}},
+	}}}, state)
+	require.NoError(t, err)
+
+	err = Apply(context.Background(), &Clients{}, "account-id", plan, state, io.Discard)
+	require.ErrorContains(t, err, `references Connection "missing-db" which does not exist`)
+}
+
+func Test_diffLines(t *testing.T) {
+	diff := diffLines("a\nb\nc\nd\ne\nf\ng\n", "a\nb\nc\nD\ne\nf\ng\n")
+	require.Equal(t, "  b\n  c\n- d\n+ D\n  e\n  f\n", diff)
+}
+
+func newTestState() *State {
+	return NewState(
+		[]*mgmtv1alpha1.Connection{
+			{Id: sourceConnId, Name: "prod-db", ConnectionConfig: newPgConfig(maskedValue)},
+			{Id: destConnId, Name: "dest-db", ConnectionConfig: newPgConfig(maskedValue)},
+		},
+		[]*mgmtv1alpha1.UserDefinedTransformer{
+			{
+				Id:          transformerId,
+				Name:        "mask-email",
+				Description: "masks emails",
+				Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+				Config: &mgmtv1alpha1.TransformerConfig{
+					Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true}},
+				},
+			},
+		},
+		[]*mgmtv1alpha1.Job{
+			{
+				Id:           jobId,
+				Name:         "nightly",
+				CronSchedule: proto.String("0 0 * * *"),
+				Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+					Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: sourceConnId}},
+				}},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{Id: sourceDestinationId, ConnectionId: destConnId, Options: &mgmtv1alpha1.JobDestinationOptions{}},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "email",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED,
+							Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_UserDefinedTransformerConfig{
+								UserDefinedTransformerConfig: &mgmtv1alpha1.UserDefinedTransformerConfig{Id: transformerId},
+							}},
+						},
+					},
+				},
+				WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{},
+			},
+		},
+	)
+}
+
+func newPgConfig(pass string) *mgmtv1alpha1.ConnectionConfig {
+	return &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+			ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Connection{Connection: &mgmtv1alpha1.PostgresConnection{
+				Host: "localhost",
+				Port: 5432,
+				Name: "neosync",
+				User: "postgres",
+				Pass: pass,
+			}},
+		}},
+	}
+}
+
+func resourceNames(resources []*Resource) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.String())
+	}
+	return names
+}
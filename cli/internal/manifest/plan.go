@@ -0,0 +1,168 @@
+package manifest
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type Action string
+
+const (
+	CreateAction    Action = "create"
+	UpdateAction    Action = "update"
+	UnchangedAction Action = "unchanged"
+)
+
+// The change that applying a manifest resource makes to the account
+type Change struct {
+	Resource *Resource
+	Action   Action
+	// A line diff between the stored and desired resource. Only set for updates.
+	Diff string
+}
+
+type Plan struct {
+	Changes []*Change
+}
+
+// Compares the desired resources with the resources that exist in the account.
+// Resources that exist in the account but not in the manifest are left untouched.
+func NewPlan(desired []*Resource, state *State) (*Plan, error) {
+	plan := &Plan{Changes: make([]*Change, 0, len(desired))}
+	for _, resource := range desired {
+		current, ok, err := state.getResource(resource.Kind, resource.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			plan.Changes = append(plan.Changes, &Change{Resource: resource, Action: CreateAction})
+			continue
+		}
+
+		desiredSpec := normalize(resource.Spec)
+		currentSpec := normalize(current.Spec)
+		if resource.Kind == ConnectionKind {
+			maskLike(desiredSpec.ProtoReflect(), currentSpec.ProtoReflect())
+		}
+		if proto.Equal(desiredSpec, currentSpec) {
+			plan.Changes = append(plan.Changes, &Change{Resource: resource, Action: UnchangedAction})
+			continue
+		}
+
+		currentYaml, err := toYaml(&Resource{Kind: current.Kind, Name: current.Name, Spec: currentSpec})
+		if err != nil {
+			return nil, err
+		}
+		desiredYaml, err := toYaml(&Resource{Kind: resource.Kind, Name: resource.Name, Spec: desiredSpec})
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, &Change{
+			Resource: resource,
+			Action:   UpdateAction,
+			Diff:     diffLines(currentYaml, desiredYaml),
+		})
+	}
+	return plan, nil
+}
+
+// Returns true if applying the plan changes the account
+func (p *Plan) HasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != UnchangedAction {
+			return true
+		}
+	}
+	return false
+}
+
+// Writes a summary of the plan along with the diff of each updated resource
+func (p *Plan) Print(w io.Writer) {
+	counts := map[Action]int{}
+	for _, change := range p.Changes {
+		counts[change.Action]++
+		fmt.Fprintf(w, "%s: %s\n", change.Resource, change.Action)
+		if change.Diff != "" {
+			for _, line := range strings.Split(strings.TrimRight(change.Diff, "\n"), "\n") {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+		}
+	}
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d unchanged\n", counts[CreateAction], counts[UpdateAction], counts[UnchangedAction])
+}
+
+// The number of unchanged lines that are printed around each change
+const diffContextLines = 2
+
+// Returns a unified style diff of the two texts that only contains the changed lines and their context
+func diffLines(from, to string) string {
+	a := strings.Split(strings.TrimRight(from, "\n"), "\n")
+	b := strings.Split(strings.TrimRight(to, "\n"), "\n")
+
+	// longest common subsequence table
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	type line struct {
+		op   byte
+		text string
+	}
+	lines := []line{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, line{' ', a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, line{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, line{'+', b[j]})
+			j++
+		}
+	}
+
+	keep := make([]bool, len(lines))
+	for idx, l := range lines {
+		if l.op == ' ' {
+			continue
+		}
+		for k := max(0, idx-diffContextLines); k <= min(len(lines)-1, idx+diffContextLines); k++ {
+			keep[k] = true
+		}
+	}
+
+	out := strings.Builder{}
+	skipped := false
+	for idx, l := range lines {
+		if !keep[idx] {
+			skipped = true
+			continue
+		}
+		if skipped && out.Len() > 0 {
+			out.WriteString("  ...\n")
+		}
+		skipped = false
+		out.WriteByte(l.op)
+		out.WriteByte(' ')
+		out.WriteString(l.text)
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
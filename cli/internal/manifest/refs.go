@@ -0,0 +1,178 @@
+package manifest
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// The value the API replaces connection secrets with
+	maskedValue = "********"
+)
+
+var (
+	connectionRefFieldNames = map[protoreflect.Name]struct{}{
+		"connection_id":           {},
+		"ai_connection_id":        {},
+		"fk_source_connection_id": {},
+	}
+	transformerRefFullFieldName protoreflect.FullName = "mgmt.v1alpha1.UserDefinedTransformerConfig.id"
+)
+
+// Replaces every connection and user defined transformer reference in the message with the value returned by fn.
+// Used to swap ids for names on export and names for ids on apply.
+func rewriteRefs(msg proto.Message, fn func(kind Kind, ref string) (string, error)) error {
+	return rewriteMessageRefs(msg.ProtoReflect(), fn)
+}
+
+func rewriteMessageRefs(msg protoreflect.Message, fn func(kind Kind, ref string) (string, error)) error {
+	for _, fd := range setFields(msg) {
+		value := msg.Get(fd)
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind {
+				continue
+			}
+			list := value.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				if err := rewriteMessageRefs(list.Get(idx).Message(), fn); err != nil {
+					return err
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			if err := rewriteMessageRefs(value.Message(), fn); err != nil {
+				return err
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			kind, ok := getRefKind(fd)
+			if !ok || value.String() == "" {
+				continue
+			}
+			ref, err := fn(kind, value.String())
+			if err != nil {
+				return err
+			}
+			msg.Set(fd, protoreflect.ValueOfString(ref))
+		}
+	}
+	return nil
+}
+
+func getRefKind(fd protoreflect.FieldDescriptor) (Kind, bool) {
+	if fd.FullName() == transformerRefFullFieldName {
+		return UserDefinedTransformerKind, true
+	}
+	if _, ok := connectionRefFieldNames[fd.Name()]; ok {
+		return ConnectionKind, true
+	}
+	return "", false
+}
+
+// Returns true if any string field of the message holds the masked secret placeholder
+func hasMaskedValue(msg proto.Message) bool {
+	return hasMaskedMessageValue(msg.ProtoReflect())
+}
+
+func hasMaskedMessageValue(msg protoreflect.Message) bool {
+	found := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			list := value.List()
+			for idx := 0; idx < list.Len() && !found; idx++ {
+				if fd.Kind() == protoreflect.MessageKind {
+					found = hasMaskedMessageValue(list.Get(idx).Message())
+				} else if fd.Kind() == protoreflect.StringKind {
+					found = list.Get(idx).String() == maskedValue
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			found = hasMaskedMessageValue(value.Message())
+		case fd.Kind() == protoreflect.StringKind:
+			found = value.String() == maskedValue
+		}
+		return !found
+	})
+	return found
+}
+
+// Masks every field of msg that is masked in the stored message.
+// The API never returns secrets, so they are excluded when comparing the desired state with the stored state.
+func maskLike(msg, stored protoreflect.Message) {
+	for _, fd := range setFields(msg) {
+		if !stored.Has(fd) {
+			continue
+		}
+		value := msg.Get(fd)
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind {
+				continue
+			}
+			list, storedList := value.List(), stored.Get(fd).List()
+			for idx := 0; idx < list.Len() && idx < storedList.Len(); idx++ {
+				maskLike(list.Get(idx).Message(), storedList.Get(idx).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			maskLike(value.Message(), stored.Get(fd).Message())
+		case fd.Kind() == protoreflect.StringKind:
+			if stored.Get(fd).String() == maskedValue {
+				msg.Set(fd, protoreflect.ValueOfString(maskedValue))
+			}
+		}
+	}
+}
+
+// Clears singular message fields that hold no values so that an omitted field and an empty field compare as equal.
+// Members of a oneof are kept as their presence selects the variant.
+func pruneEmpty(msg protoreflect.Message) {
+	for _, fd := range setFields(msg) {
+		value := msg.Get(fd)
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind {
+				continue
+			}
+			list := value.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				pruneEmpty(list.Get(idx).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			child := value.Message()
+			pruneEmpty(child)
+			if fd.ContainingOneof() == nil && isEmpty(child) {
+				msg.Clear(fd)
+			}
+		}
+	}
+}
+
+func isEmpty(msg protoreflect.Message) bool {
+	empty := true
+	msg.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// Returns the fields that are set on the message. The message may be modified while iterating over the result.
+func setFields(msg protoreflect.Message) []protoreflect.FieldDescriptor {
+	fields := []protoreflect.FieldDescriptor{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	return fields
+}
+
+// Returns a copy of the message that is normalized for comparison
+func normalize[T proto.Message](msg T) T {
+	cloned := proto.Clone(msg).(T)
+	pruneEmpty(cloned.ProtoReflect())
+	return cloned
+}
@@ -0,0 +1,213 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+type Clients struct {
+	Connections  mgmtv1alpha1connect.ConnectionServiceClient
+	Transformers mgmtv1alpha1connect.TransformersServiceClient
+	Jobs         mgmtv1alpha1connect.JobServiceClient
+}
+
+// The resources that currently exist in an account, keyed by name
+type State struct {
+	connections  map[string]*mgmtv1alpha1.Connection
+	transformers map[string]*mgmtv1alpha1.UserDefinedTransformer
+	jobs         map[string]*mgmtv1alpha1.Job
+
+	// resource names keyed by their kind and id
+	names map[Kind]map[string]string
+}
+
+// Retrieves the connections, user defined transformers and jobs of the account
+func LoadState(ctx context.Context, clients *Clients, accountId string) (*State, error) {
+	connResp, err := clients.Connections.GetConnections(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionsRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve connections: %w", err)
+	}
+	transformerResp, err := clients.Transformers.GetUserDefinedTransformers(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformersRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve user defined transformers: %w", err)
+	}
+	jobResp, err := clients.Jobs.GetJobs(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve jobs: %w", err)
+	}
+	return NewState(connResp.Msg.GetConnections(), transformerResp.Msg.GetTransformers(), jobResp.Msg.GetJobs()), nil
+}
+
+func NewState(
+	connections []*mgmtv1alpha1.Connection,
+	transformers []*mgmtv1alpha1.UserDefinedTransformer,
+	jobs []*mgmtv1alpha1.Job,
+) *State {
+	state := &State{
+		connections:  map[string]*mgmtv1alpha1.Connection{},
+		transformers: map[string]*mgmtv1alpha1.UserDefinedTransformer{},
+		jobs:         map[string]*mgmtv1alpha1.Job{},
+		names: map[Kind]map[string]string{
+			ConnectionKind:             {},
+			UserDefinedTransformerKind: {},
+			JobKind:                    {},
+		},
+	}
+	for _, connection := range connections {
+		state.setConnection(connection)
+	}
+	for _, transformer := range transformers {
+		state.setTransformer(transformer)
+	}
+	for _, job := range jobs {
+		state.setJob(job)
+	}
+	return state
+}
+
+func (s *State) setConnection(connection *mgmtv1alpha1.Connection) {
+	s.connections[connection.GetName()] = connection
+	s.names[ConnectionKind][connection.GetId()] = connection.GetName()
+}
+
+func (s *State) setTransformer(transformer *mgmtv1alpha1.UserDefinedTransformer) {
+	s.transformers[transformer.GetName()] = transformer
+	s.names[UserDefinedTransformerKind][transformer.GetId()] = transformer.GetName()
+}
+
+func (s *State) setJob(job *mgmtv1alpha1.Job) {
+	s.jobs[job.GetName()] = job
+	s.names[JobKind][job.GetId()] = job.GetName()
+}
+
+// Returns the id of the resource with the given name
+func (s *State) getId(kind Kind, name string) (string, bool) {
+	switch kind {
+	case ConnectionKind:
+		if connection, ok := s.connections[name]; ok {
+			return connection.GetId(), true
+		}
+	case UserDefinedTransformerKind:
+		if transformer, ok := s.transformers[name]; ok {
+			return transformer.GetId(), true
+		}
+	case JobKind:
+		if job, ok := s.jobs[name]; ok {
+			return job.GetId(), true
+		}
+	}
+	return "", false
+}
+
+// Returns all of the resources in the account
+func (s *State) Export() ([]*Resource, error) {
+	resources := []*Resource{}
+	for name := range s.connections {
+		resource, _, err := s.getResource(ConnectionKind, name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	for name := range s.transformers {
+		resource, _, err := s.getResource(UserDefinedTransformerKind, name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	for name := range s.jobs {
+		resource, _, err := s.getResource(JobKind, name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	Sort(resources)
+	return resources, nil
+}
+
+// Returns the stored resource in its manifest form. Returns false if the resource does not exist.
+func (s *State) getResource(kind Kind, name string) (*Resource, bool, error) {
+	var spec proto.Message
+	switch kind {
+	case ConnectionKind:
+		connection, ok := s.connections[name]
+		if !ok {
+			return nil, false, nil
+		}
+		spec = proto.Clone(connection.GetConnectionConfig())
+	case UserDefinedTransformerKind:
+		transformer, ok := s.transformers[name]
+		if !ok {
+			return nil, false, nil
+		}
+		spec = &mgmtv1alpha1.CreateUserDefinedTransformerRequest{
+			Description:       transformer.GetDescription(),
+			Source:            transformer.GetSource(),
+			TransformerConfig: proto.Clone(transformer.GetConfig()).(*mgmtv1alpha1.TransformerConfig),
+		}
+	case JobKind:
+		job, ok := s.jobs[name]
+		if !ok {
+			return nil, false, nil
+		}
+		spec = toJobSpec(job)
+	default:
+		return nil, false, fmt.Errorf("unsupported manifest kind %q", kind)
+	}
+
+	resource, err := s.toResource(kind, name, spec)
+	if err != nil {
+		return nil, false, err
+	}
+	return resource, true, nil
+}
+
+// Returns the manifest resource of a job that has not been created yet
+func (s *State) NewJobResource(name string, req *mgmtv1alpha1.CreateJobRequest) (*Resource, error) {
+	spec := proto.Clone(req).(*mgmtv1alpha1.CreateJobRequest)
+	spec.AccountId = ""
+	spec.JobName = ""
+	return s.toResource(JobKind, name, spec)
+}
+
+func (s *State) toResource(kind Kind, name string, spec proto.Message) (*Resource, error) {
+	// references to resources outside of the account are left as is
+	err := rewriteRefs(spec, func(kind Kind, id string) (string, error) {
+		if name, ok := s.names[kind][id]; ok {
+			return name, nil
+		}
+		return id, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Resource{Kind: kind, Name: name, Spec: spec}, nil
+}
+
+// Converts the job to the spec of its manifest. Ids are not yet replaced by names.
+func toJobSpec(job *mgmtv1alpha1.Job) *mgmtv1alpha1.CreateJobRequest {
+	cloned := proto.Clone(job).(*mgmtv1alpha1.Job)
+	destinations := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(cloned.GetDestinations()))
+	for _, destination := range cloned.GetDestinations() {
+		destinations = append(destinations, &mgmtv1alpha1.CreateJobDestination{
+			ConnectionId: destination.GetConnectionId(),
+			Options:      destination.GetOptions(),
+		})
+	}
+	return &mgmtv1alpha1.CreateJobRequest{
+		CronSchedule:       cloned.CronSchedule,
+		Mappings:           cloned.GetMappings(),
+		Source:             cloned.GetSource(),
+		Destinations:       destinations,
+		WorkflowOptions:    cloned.GetWorkflowOptions(),
+		SyncOptions:        cloned.GetSyncOptions(),
+		VirtualForeignKeys: cloned.GetVirtualForeignKeys(),
+	}
+}
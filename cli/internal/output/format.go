@@ -0,0 +1,102 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// The format that command results are printed in
+type Format string
+
+const (
+	TableFormat Format = "table"
+	JsonFormat  Format = "json"
+	YamlFormat  Format = "yaml"
+)
+
+var (
+	formats = []Format{TableFormat, JsonFormat, YamlFormat}
+)
+
+func AttachFormatFlag(cmd *cobra.Command) {
+	formatVals := make([]string, 0, len(formats))
+	for _, format := range formats {
+		formatVals = append(formatVals, string(format))
+	}
+	cmd.Flags().StringP("output", "o", string(TableFormat), fmt.Sprintf("Set the output format (%s).", strings.Join(formatVals, ", ")))
+}
+
+func ValidateAndRetrieveFormatFlag(cmd *cobra.Command) (Format, error) {
+	if cmd == nil {
+		return "", fmt.Errorf("must provide non-nil cmd")
+	}
+	formatFlag, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+	for _, format := range formats {
+		if strings.EqualFold(formatFlag, string(format)) {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("must provide valid output format")
+}
+
+// Writes the message as json or yaml
+func WriteMessage(w io.Writer, format Format, msg proto.Message) error {
+	value, err := toValue(msg)
+	if err != nil {
+		return err
+	}
+	return writeValue(w, format, value)
+}
+
+// Writes the messages as a json or yaml list
+func WriteMessageList[T proto.Message](w io.Writer, format Format, msgs []T) error {
+	values := make([]any, 0, len(msgs))
+	for _, msg := range msgs {
+		value, err := toValue(msg)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	return writeValue(w, format, values)
+}
+
+func toValue(msg proto.Message) (any, error) {
+	bits, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(bits, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func writeValue(w io.Writer, format Format, value any) error {
+	switch format {
+	case JsonFormat:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case YamlFormat:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return fmt.Errorf("%s is not a structured output format", format)
+	}
+}
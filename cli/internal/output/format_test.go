@@ -0,0 +1,46 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateAndRetrieveFormatFlag(t *testing.T) {
+	cmd := &cobra.Command{}
+	AttachFormatFlag(cmd)
+
+	format, err := ValidateAndRetrieveFormatFlag(cmd)
+	require.NoError(t, err)
+	require.Equal(t, TableFormat, format)
+
+	require.NoError(t, cmd.Flags().Set("output", "JSON"))
+	format, err = ValidateAndRetrieveFormatFlag(cmd)
+	require.NoError(t, err)
+	require.Equal(t, JsonFormat, format)
+
+	require.NoError(t, cmd.Flags().Set("output", "xml"))
+	_, err = ValidateAndRetrieveFormatFlag(cmd)
+	require.Error(t, err)
+}
+
+func Test_WriteMessage(t *testing.T) {
+	job := &mgmtv1alpha1.Job{Id: "job-id", Name: "nightly"}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteMessage(buf, JsonFormat, job))
+	require.JSONEq(t, `{"id": "job-id", "name": "nightly"}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteMessage(buf, YamlFormat, job))
+	require.Equal(t, "id: job-id\nname: nightly\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteMessageList(buf, JsonFormat, []*mgmtv1alpha1.Job{job}))
+	require.JSONEq(t, `[{"id": "job-id", "name": "nightly"}]`, buf.String())
+
+	require.Error(t, WriteMessage(buf, TableFormat, job))
+}
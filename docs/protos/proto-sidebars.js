@@ -1,3 +1,3 @@
 
-module.exports = {"protodocs":[{"type":"category","label":"Files","items":[{"type":"category","label":"/mgmt/v1alpha1","items":[{"type":"doc","id":"mgmt/v1alpha1/api_key.proto"},{"type":"doc","id":"mgmt/v1alpha1/auth.proto"},{"type":"doc","id":"mgmt/v1alpha1/connection.proto"},{"type":"doc","id":"mgmt/v1alpha1/connection_data.proto"},{"type":"doc","id":"mgmt/v1alpha1/transformer.proto"},{"type":"doc","id":"mgmt/v1alpha1/job.proto"},{"type":"doc","id":"mgmt/v1alpha1/metrics.proto"},{"type":"doc","id":"mgmt/v1alpha1/user_account.proto"}]}]}]};
+module.exports = {"protodocs":[{"type":"category","label":"Files","items":[{"type":"category","label":"/mgmt/v1alpha1","items":[{"type":"doc","id":"mgmt/v1alpha1/api_key.proto"},{"type":"doc","id":"mgmt/v1alpha1/audit.proto"},{"type":"doc","id":"mgmt/v1alpha1/auth.proto"},{"type":"doc","id":"mgmt/v1alpha1/connection.proto"},{"type":"doc","id":"mgmt/v1alpha1/transformer.proto"},{"type":"doc","id":"mgmt/v1alpha1/job.proto"},{"type":"doc","id":"mgmt/v1alpha1/connection_data.proto"},{"type":"doc","id":"mgmt/v1alpha1/metrics.proto"},{"type":"doc","id":"mgmt/v1alpha1/notification.proto"},{"type":"doc","id":"mgmt/v1alpha1/user_account.proto"}]}]}]};
   
\ No newline at end of file
@@ -93,6 +93,19 @@ const sidebars: SidebarsConfig = {
             },
           ],
         },
+        {
+          type: 'category',
+          label: 'connections',
+          collapsible: true,
+          collapsed: false,
+          items: [
+            {
+              type: 'doc',
+              id: 'cli/connections/diff',
+              label: 'diff',
+            },
+          ],
+        },
         {
           type: 'category',
           label: 'jobs',
@@ -104,11 +117,21 @@ const sidebars: SidebarsConfig = {
               id: 'cli/jobs/list',
               label: 'list',
             },
+            {
+              type: 'doc',
+              id: 'cli/jobs/init',
+              label: 'init',
+            },
             {
               type: 'doc',
               id: 'cli/jobs/trigger',
               label: 'trigger',
             },
+            {
+              type: 'doc',
+              id: 'cli/jobs/manage',
+              label: 'get, create, delete, pause and schedule',
+            },
           ],
         },
         {
@@ -116,11 +139,21 @@ const sidebars: SidebarsConfig = {
           id: 'cli/version',
           label: 'version',
         },
+        {
+          type: 'doc',
+          id: 'cli/runs',
+          label: 'runs',
+        },
         {
           type: 'doc',
           id: 'cli/sync',
           label: 'sync',
         },
+        {
+          type: 'doc',
+          id: 'cli/apply',
+          label: 'apply, plan and export',
+        },
       ],
     },
     {
@@ -244,6 +277,11 @@ const sidebars: SidebarsConfig = {
       id: 'guides/viewing-job-run-logs',
       label: 'Viewing Job Run Logs',
     },
+    {
+      type: 'doc',
+      id: 'guides/using-secret-references',
+      label: 'Using Secret References',
+    },
     {
       type: 'html',
       value: '<div>Connections</div>',
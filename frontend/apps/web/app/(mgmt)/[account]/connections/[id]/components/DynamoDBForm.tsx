@@ -87,6 +87,7 @@ export default function DynamoDBForm(props: Props) {
     setIsValidating(true);
     try {
       const res = await checkDbConnection({
+        id: connectionId,
         connectionConfig: buildConnectionConfigDynamoDB(form.getValues()),
       });
       setValidationResponse(res);
@@ -85,6 +85,7 @@ export default function MongoDbForm(props: Props): ReactElement {
     setIsValidating(true);
     try {
       const res = await checkConnectionConfigAsync({
+        id: connectionId,
         connectionConfig: buildConnectionConfigMongo(form.getValues()),
       });
       setValidationResponse(res);
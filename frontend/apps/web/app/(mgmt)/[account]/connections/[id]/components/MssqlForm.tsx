@@ -322,6 +322,7 @@ export default function MssqlForm(props: Props): ReactElement {
               try {
                 const values = form.getValues();
                 const res = await checkConnection({
+                  id: connectionId,
                   connectionConfig: buildConnectionConfigMssql(values),
                 });
 
@@ -491,6 +491,7 @@ export default function MysqlForm(props: Props) {
               try {
                 const values = form.getValues();
                 const res = await checkMysqlConnection({
+                  id: connectionId,
                   connectionConfig: buildConnectionConfigMysql({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
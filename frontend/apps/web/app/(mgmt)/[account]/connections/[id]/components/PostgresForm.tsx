@@ -564,6 +564,7 @@ export default function PostgresForm(props: Props): ReactElement {
               try {
                 const values = form.getValues();
                 const res = await checkPostgresConnection({
+                  id: connectionId,
                   connectionConfig: buildConnectionConfigPostgres({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
@@ -6,10 +6,12 @@ import {
 } from '@connectrpc/connect';
 
 import { ApiKeyService } from './mgmt/v1alpha1/api_key_connect.js';
+import { AuditService } from './mgmt/v1alpha1/audit_connect.js';
 import { ConnectionService } from './mgmt/v1alpha1/connection_connect.js';
 import { ConnectionDataService } from './mgmt/v1alpha1/connection_data_connect.js';
 import { JobService } from './mgmt/v1alpha1/job_connect.js';
 import { MetricsService } from './mgmt/v1alpha1/metrics_connect.js';
+import { NotificationService } from './mgmt/v1alpha1/notification_connect.js';
 import { TransformersService } from './mgmt/v1alpha1/transformer_connect.js';
 import { UserAccountService } from './mgmt/v1alpha1/user_account_connect.js';
 
@@ -24,6 +26,8 @@ export interface NeosyncV1alpha1Client {
   apikeys: PromiseClient<typeof ApiKeyService>;
   connectiondata: PromiseClient<typeof ConnectionDataService>;
   metrics: PromiseClient<typeof MetricsService>;
+  audit: PromiseClient<typeof AuditService>;
+  notifications: PromiseClient<typeof NotificationService>;
 }
 
 /**
@@ -89,6 +93,8 @@ export function getNeosyncV1alpha1Client(
     apikeys: createPromiseClient(ApiKeyService, transport),
     connectiondata: createPromiseClient(ConnectionDataService, transport),
     metrics: createPromiseClient(MetricsService, transport),
+    audit: createPromiseClient(AuditService, transport),
+    notifications: createPromiseClient(NotificationService, transport),
   };
 }
 
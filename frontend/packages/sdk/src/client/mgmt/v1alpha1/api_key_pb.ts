@@ -27,6 +27,21 @@ export class CreateAccountApiKeyRequest extends Message<CreateAccountApiKeyReque
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The scopes the API key is allowed to use. If no scopes are provided, the API key has full access to the account.
+   * Valid scopes: jobs:read, jobs:write, jobs:trigger, runs:logs, connections:read, connections:write, connections:secrets, transformers:read, transformers:write, metrics:read, notifications:read, notifications:write
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[] = [];
+
+  /**
+   * Restricts the job scopes of the API key to the provided jobs. If no jobs are provided, all jobs in the account may be accessed.
+   *
+   * @generated from field: repeated string job_ids = 5;
+   */
+  jobIds: string[] = [];
+
   constructor(data?: PartialMessage<CreateAccountApiKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -38,6 +53,8 @@ export class CreateAccountApiKeyRequest extends Message<CreateAccountApiKeyReque
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 4, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "job_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAccountApiKeyRequest {
@@ -154,6 +171,20 @@ export class AccountApiKey extends Message<AccountApiKey> {
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The scopes the API key is allowed to use. An empty list grants full access to the account.
+   *
+   * @generated from field: repeated string scopes = 11;
+   */
+  scopes: string[] = [];
+
+  /**
+   * The jobs the API key is restricted to. An empty list allows access to all jobs in the account.
+   *
+   * @generated from field: repeated string job_ids = 12;
+   */
+  jobIds: string[] = [];
+
   constructor(data?: PartialMessage<AccountApiKey>) {
     super();
     proto3.util.initPartial(data, this);
@@ -172,6 +203,8 @@ export class AccountApiKey extends Message<AccountApiKey> {
     { no: 8, name: "key_value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 11, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 12, name: "job_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountApiKey {
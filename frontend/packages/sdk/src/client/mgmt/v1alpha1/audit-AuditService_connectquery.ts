@@ -0,0 +1,23 @@
+// @generated by protoc-gen-connect-query v1.4.1 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { MethodKind } from "@bufbuild/protobuf";
+import { GetAuditEventsRequest, GetAuditEventsResponse } from "./audit_pb.js";
+
+/**
+ * Retrieves a page of audit events for the given account
+ *
+ * @generated from rpc mgmt.v1alpha1.AuditService.GetAuditEvents
+ */
+export const getAuditEvents = {
+  localName: "getAuditEvents",
+  name: "GetAuditEvents",
+  kind: MethodKind.Unary,
+  I: GetAuditEventsRequest,
+  O: GetAuditEventsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.AuditService"
+  }
+} as const;
@@ -0,0 +1,234 @@
+// @generated by protoc-gen-es v1.9.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * An audit event is recorded for every mutating API call made against an account
+ *
+ * @generated from message mgmt.v1alpha1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * The unique identifier of the audit event
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The account the mutation was performed against
+   *
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * The neosync user that performed the mutation, if known
+   *
+   * @generated from field: optional string actor_user_id = 3;
+   */
+  actorUserId?: string;
+
+  /**
+   * The account api key that was used to perform the mutation, if one was used
+   *
+   * @generated from field: optional string actor_api_key_id = 4;
+   */
+  actorApiKeyId?: string;
+
+  /**
+   * The full procedure name of the RPC that was called. Ex: /mgmt.v1alpha1.JobService/CreateJob
+   *
+   * @generated from field: string procedure = 5;
+   */
+  procedure = "";
+
+  /**
+   * The identifier of the resource the mutation targeted, if one could be determined
+   *
+   * @generated from field: optional string resource_id = 6;
+   */
+  resourceId?: string;
+
+  /**
+   * The JSON encoded request message with all sensitive fields redacted.
+   * Empty for successful updates of a stored resource, which record the changes instead.
+   *
+   * @generated from field: string request_json = 7;
+   */
+  requestJson = "";
+
+  /**
+   * The connect error code if the call failed. Unset for successful calls.
+   *
+   * @generated from field: optional string error_code = 8;
+   */
+  errorCode?: string;
+
+  /**
+   * The time the audit event was recorded
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * The JSON encoded fields that a successful update changed on the stored resource, keyed by field path.
+   * Each change holds the value before and after the update with all sensitive fields redacted.
+   * Ex: {"cronSchedule": {"before": "0 * * * *", "after": "0 0 * * *"}}
+   *
+   * @generated from field: optional string changes_json = 10;
+   */
+  changesJson?: string;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "actor_api_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "request_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "error_code", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+    { no: 10, name: "changes_json", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAuditEventsRequest
+ */
+export class GetAuditEventsRequest extends Message<GetAuditEventsRequest> {
+  /**
+   * The account to retrieve audit events for
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * The maximum number of events to return. Defaults to 50 if not provided.
+   *
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * The page token returned by a previous call to continue paginating
+   *
+   * @generated from field: optional string page_token = 3;
+   */
+  pageToken?: string;
+
+  /**
+   * Optionally filter the events down to a single resource
+   *
+   * @generated from field: optional string resource_id = 4;
+   */
+  resourceId?: string;
+
+  constructor(data?: PartialMessage<GetAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAuditEventsRequest | PlainMessage<GetAuditEventsRequest> | undefined, b: GetAuditEventsRequest | PlainMessage<GetAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(GetAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAuditEventsResponse
+ */
+export class GetAuditEventsResponse extends Message<GetAuditEventsResponse> {
+  /**
+   * The audit events, ordered from newest to oldest
+   *
+   * @generated from field: repeated mgmt.v1alpha1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * The token to retrieve the next page. Unset if there are no more events.
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+
+  constructor(data?: PartialMessage<GetAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAuditEventsResponse | PlainMessage<GetAuditEventsResponse> | undefined, b: GetAuditEventsResponse | PlainMessage<GetAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(GetAuditEventsResponse, a, b);
+  }
+}
+
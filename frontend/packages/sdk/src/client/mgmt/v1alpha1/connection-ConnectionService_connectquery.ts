@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { MethodKind } from "@bufbuild/protobuf";
-import { CheckConnectionConfigRequest, CheckConnectionConfigResponse, CheckSqlQueryRequest, CheckSqlQueryResponse, CreateConnectionRequest, CreateConnectionResponse, DeleteConnectionRequest, DeleteConnectionResponse, GetConnectionRequest, GetConnectionResponse, GetConnectionsRequest, GetConnectionsResponse, IsConnectionNameAvailableRequest, IsConnectionNameAvailableResponse, UpdateConnectionRequest, UpdateConnectionResponse } from "./connection_pb.js";
+import { CheckConnectionConfigRequest, CheckConnectionConfigResponse, CheckSqlQueryRequest, CheckSqlQueryResponse, CreateConnectionRequest, CreateConnectionResponse, DeleteConnectionRequest, DeleteConnectionResponse, GetConnectionColumnTagsRequest, GetConnectionColumnTagsResponse, GetConnectionRequest, GetConnectionResponse, GetConnectionsRequest, GetConnectionsResponse, GetConnectionUnmaskedRequest, GetConnectionUnmaskedResponse, IsConnectionNameAvailableRequest, IsConnectionNameAvailableResponse, SetConnectionColumnTagsRequest, SetConnectionColumnTagsResponse, UpdateConnectionRequest, UpdateConnectionResponse } from "./connection_pb.js";
 
 /**
  * Returns a list of connections associated with the account
@@ -23,7 +23,8 @@ export const getConnections = {
 } as const;
 
 /**
- * Returns a single connection
+ * Returns a single connection.
+ * Secret fields (passwords, private keys, access keys, connection urls) are masked in the responses of every method except GetConnectionUnmasked.
  *
  * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnection
  */
@@ -38,6 +39,23 @@ export const getConnection = {
   }
 } as const;
 
+/**
+ * Returns a single connection including its secrets.
+ * Intended for the worker and other clients that must connect to the underlying system, this requires the connections:secrets api key scope.
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnectionUnmasked
+ */
+export const getConnectionUnmasked = {
+  localName: "getConnectionUnmasked",
+  name: "GetConnectionUnmasked",
+  kind: MethodKind.Unary,
+  I: GetConnectionUnmaskedRequest,
+  O: GetConnectionUnmaskedResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionService"
+  }
+} as const;
+
 /**
  * Creates a new connection
  *
@@ -55,7 +73,8 @@ export const createConnection = {
 } as const;
 
 /**
- * Updates an existing connection
+ * Updates an existing connection.
+ * Secret fields that are sent back with the masked placeholder keep their stored value.
  *
  * @generated from rpc mgmt.v1alpha1.ConnectionService.UpdateConnection
  */
@@ -135,3 +154,36 @@ export const checkSqlQuery = {
     typeName: "mgmt.v1alpha1.ConnectionService"
   }
 } as const;
+
+/**
+ * Returns the classification tags of the columns of a connection
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnectionColumnTags
+ */
+export const getConnectionColumnTags = {
+  localName: "getConnectionColumnTags",
+  name: "GetConnectionColumnTags",
+  kind: MethodKind.Unary,
+  I: GetConnectionColumnTagsRequest,
+  O: GetConnectionColumnTagsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionService"
+  }
+} as const;
+
+/**
+ * Sets the classification tags of columns of a connection.
+ * Tags may be used by job mapping policies, so this is restricted to account admins.
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionService.SetConnectionColumnTags
+ */
+export const setConnectionColumnTags = {
+  localName: "setConnectionColumnTags",
+  name: "SetConnectionColumnTags",
+  kind: MethodKind.Unary,
+  I: SetConnectionColumnTagsRequest,
+  O: SetConnectionColumnTagsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionService"
+  }
+} as const;
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CheckConnectionConfigRequest, CheckConnectionConfigResponse, CheckSqlQueryRequest, CheckSqlQueryResponse, CreateConnectionRequest, CreateConnectionResponse, DeleteConnectionRequest, DeleteConnectionResponse, GetConnectionRequest, GetConnectionResponse, GetConnectionsRequest, GetConnectionsResponse, IsConnectionNameAvailableRequest, IsConnectionNameAvailableResponse, UpdateConnectionRequest, UpdateConnectionResponse } from "./connection_pb.js";
+import { CheckConnectionConfigRequest, CheckConnectionConfigResponse, CheckSqlQueryRequest, CheckSqlQueryResponse, CreateConnectionRequest, CreateConnectionResponse, DeleteConnectionRequest, DeleteConnectionResponse, GetConnectionColumnTagsRequest, GetConnectionColumnTagsResponse, GetConnectionRequest, GetConnectionResponse, GetConnectionsRequest, GetConnectionsResponse, GetConnectionUnmaskedRequest, GetConnectionUnmaskedResponse, IsConnectionNameAvailableRequest, IsConnectionNameAvailableResponse, SetConnectionColumnTagsRequest, SetConnectionColumnTagsResponse, UpdateConnectionRequest, UpdateConnectionResponse } from "./connection_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -27,7 +27,8 @@ export const ConnectionService = {
       kind: MethodKind.Unary,
     },
     /**
-     * Returns a single connection
+     * Returns a single connection.
+     * Secret fields (passwords, private keys, access keys, connection urls) are masked in the responses of every method except GetConnectionUnmasked.
      *
      * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnection
      */
@@ -37,6 +38,18 @@ export const ConnectionService = {
       O: GetConnectionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Returns a single connection including its secrets.
+     * Intended for the worker and other clients that must connect to the underlying system, this requires the connections:secrets api key scope.
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnectionUnmasked
+     */
+    getConnectionUnmasked: {
+      name: "GetConnectionUnmasked",
+      I: GetConnectionUnmaskedRequest,
+      O: GetConnectionUnmaskedResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Creates a new connection
      *
@@ -49,7 +62,8 @@ export const ConnectionService = {
       kind: MethodKind.Unary,
     },
     /**
-     * Updates an existing connection
+     * Updates an existing connection.
+     * Secret fields that are sent back with the masked placeholder keep their stored value.
      *
      * @generated from rpc mgmt.v1alpha1.ConnectionService.UpdateConnection
      */
@@ -105,6 +119,29 @@ export const ConnectionService = {
       O: CheckSqlQueryResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Returns the classification tags of the columns of a connection
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionService.GetConnectionColumnTags
+     */
+    getConnectionColumnTags: {
+      name: "GetConnectionColumnTags",
+      I: GetConnectionColumnTagsRequest,
+      O: GetConnectionColumnTagsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sets the classification tags of columns of a connection.
+     * Tags may be used by job mapping policies, so this is restricted to account admins.
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionService.SetConnectionColumnTags
+     */
+    setConnectionColumnTags: {
+      name: "SetConnectionColumnTags",
+      I: SetConnectionColumnTagsRequest,
+      O: SetConnectionColumnTagsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { MethodKind } from "@bufbuild/protobuf";
-import { GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse } from "./connection_data_pb.js";
+import { CompareConnectionSchemasRequest, CompareConnectionSchemasResponse, GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse, ScanConnectionForPiiRequest, ScanConnectionForPiiResponse } from "./connection_data_pb.js";
 
 /**
  * Returns the schema for a specific connection. Used mostly for SQL-based connections
@@ -168,3 +168,37 @@ export const getTableRowCount = {
     typeName: "mgmt.v1alpha1.ConnectionDataService"
   }
 } as const;
+
+/**
+ * Compares the tables, columns, constraints and triggers of two connections. Mostly useful for SQL-based connections.
+ * Used primarily by the CLI connections diff command.
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionDataService.CompareConnectionSchemas
+ */
+export const compareConnectionSchemas = {
+  localName: "compareConnectionSchemas",
+  name: "CompareConnectionSchemas",
+  kind: MethodKind.Unary,
+  I: CompareConnectionSchemasRequest,
+  O: CompareConnectionSchemasResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionDataService"
+  }
+} as const;
+
+/**
+ * Samples the rows of each table and classifies the columns that hold PII, with a recommended transformer for each column.
+ * Used to pre-populate job mappings so that columns holding PII are not passed through.
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionDataService.ScanConnectionForPii
+ */
+export const scanConnectionForPii = {
+  localName: "scanConnectionForPii",
+  name: "ScanConnectionForPii",
+  kind: MethodKind.Unary,
+  I: ScanConnectionForPiiRequest,
+  O: ScanConnectionForPiiResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionDataService"
+  }
+} as const;
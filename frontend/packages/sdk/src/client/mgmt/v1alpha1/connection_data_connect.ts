@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionDataStreamRequest, GetConnectionDataStreamResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse } from "./connection_data_pb.js";
+import { CompareConnectionSchemasRequest, CompareConnectionSchemasResponse, GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionDataStreamRequest, GetConnectionDataStreamResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse, ScanConnectionForPiiRequest, ScanConnectionForPiiResponse } from "./connection_data_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -140,6 +140,30 @@ export const ConnectionDataService = {
       O: GetTableRowCountResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Compares the tables, columns, constraints and triggers of two connections. Mostly useful for SQL-based connections.
+     * Used primarily by the CLI connections diff command.
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionDataService.CompareConnectionSchemas
+     */
+    compareConnectionSchemas: {
+      name: "CompareConnectionSchemas",
+      I: CompareConnectionSchemasRequest,
+      O: CompareConnectionSchemasResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Samples the rows of each table and classifies the columns that hold PII, with a recommended transformer for each column.
+     * Used to pre-populate job mappings so that columns holding PII are not passed through.
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionDataService.ScanConnectionForPii
+     */
+    scanConnectionForPii: {
+      name: "ScanConnectionForPii",
+      I: ScanConnectionForPiiRequest,
+      O: ScanConnectionForPiiResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,6 +5,157 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct } from "@bufbuild/protobuf";
+import { JobMappingTransformer } from "./job_pb.js";
+
+/**
+ * @generated from enum mgmt.v1alpha1.SchemaDiffType
+ */
+export enum SchemaDiffType {
+  /**
+   * @generated from enum value: SCHEMA_DIFF_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The object only exists in the source connection
+   *
+   * @generated from enum value: SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION = 1;
+   */
+  MISSING_IN_DESTINATION = 1,
+
+  /**
+   * The object only exists in the destination connection
+   *
+   * @generated from enum value: SCHEMA_DIFF_TYPE_MISSING_IN_SOURCE = 2;
+   */
+  MISSING_IN_SOURCE = 2,
+
+  /**
+   * The object exists in both connections, but its definition differs
+   *
+   * @generated from enum value: SCHEMA_DIFF_TYPE_CHANGED = 3;
+   */
+  CHANGED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaDiffType)
+proto3.util.setEnumType(SchemaDiffType, "mgmt.v1alpha1.SchemaDiffType", [
+  { no: 0, name: "SCHEMA_DIFF_TYPE_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_DIFF_TYPE_MISSING_IN_DESTINATION" },
+  { no: 2, name: "SCHEMA_DIFF_TYPE_MISSING_IN_SOURCE" },
+  { no: 3, name: "SCHEMA_DIFF_TYPE_CHANGED" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.SchemaConstraintType
+ */
+export enum SchemaConstraintType {
+  /**
+   * @generated from enum value: SCHEMA_CONSTRAINT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEMA_CONSTRAINT_TYPE_PRIMARY_KEY = 1;
+   */
+  PRIMARY_KEY = 1,
+
+  /**
+   * @generated from enum value: SCHEMA_CONSTRAINT_TYPE_FOREIGN_KEY = 2;
+   */
+  FOREIGN_KEY = 2,
+
+  /**
+   * @generated from enum value: SCHEMA_CONSTRAINT_TYPE_UNIQUE = 3;
+   */
+  UNIQUE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaConstraintType)
+proto3.util.setEnumType(SchemaConstraintType, "mgmt.v1alpha1.SchemaConstraintType", [
+  { no: 0, name: "SCHEMA_CONSTRAINT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_CONSTRAINT_TYPE_PRIMARY_KEY" },
+  { no: 2, name: "SCHEMA_CONSTRAINT_TYPE_FOREIGN_KEY" },
+  { no: 3, name: "SCHEMA_CONSTRAINT_TYPE_UNIQUE" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.PiiType
+ */
+export enum PiiType {
+  /**
+   * @generated from enum value: PII_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PII_TYPE_EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * @generated from enum value: PII_TYPE_PHONE = 2;
+   */
+  PHONE = 2,
+
+  /**
+   * @generated from enum value: PII_TYPE_SSN = 3;
+   */
+  SSN = 3,
+
+  /**
+   * @generated from enum value: PII_TYPE_CREDIT_CARD = 4;
+   */
+  CREDIT_CARD = 4,
+
+  /**
+   * @generated from enum value: PII_TYPE_IP_ADDRESS = 5;
+   */
+  IP_ADDRESS = 5,
+
+  /**
+   * @generated from enum value: PII_TYPE_FIRST_NAME = 6;
+   */
+  FIRST_NAME = 6,
+
+  /**
+   * @generated from enum value: PII_TYPE_LAST_NAME = 7;
+   */
+  LAST_NAME = 7,
+
+  /**
+   * @generated from enum value: PII_TYPE_FULL_NAME = 8;
+   */
+  FULL_NAME = 8,
+
+  /**
+   * @generated from enum value: PII_TYPE_DATE_OF_BIRTH = 9;
+   */
+  DATE_OF_BIRTH = 9,
+
+  /**
+   * @generated from enum value: PII_TYPE_STREET_ADDRESS = 10;
+   */
+  STREET_ADDRESS = 10,
+
+  /**
+   * @generated from enum value: PII_TYPE_ZIPCODE = 11;
+   */
+  ZIPCODE = 11,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PiiType)
+proto3.util.setEnumType(PiiType, "mgmt.v1alpha1.PiiType", [
+  { no: 0, name: "PII_TYPE_UNSPECIFIED" },
+  { no: 1, name: "PII_TYPE_EMAIL" },
+  { no: 2, name: "PII_TYPE_PHONE" },
+  { no: 3, name: "PII_TYPE_SSN" },
+  { no: 4, name: "PII_TYPE_CREDIT_CARD" },
+  { no: 5, name: "PII_TYPE_IP_ADDRESS" },
+  { no: 6, name: "PII_TYPE_FIRST_NAME" },
+  { no: 7, name: "PII_TYPE_LAST_NAME" },
+  { no: 8, name: "PII_TYPE_FULL_NAME" },
+  { no: 9, name: "PII_TYPE_DATE_OF_BIRTH" },
+  { no: 10, name: "PII_TYPE_STREET_ADDRESS" },
+  { no: 11, name: "PII_TYPE_ZIPCODE" },
+]);
 
 /**
  * @generated from message mgmt.v1alpha1.PostgresStreamConfig
@@ -459,6 +610,37 @@ export class MssqlSchemaConfig extends Message<MssqlSchemaConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.ClickhouseSchemaConfig
+ */
+export class ClickhouseSchemaConfig extends Message<ClickhouseSchemaConfig> {
+  constructor(data?: PartialMessage<ClickhouseSchemaConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ClickhouseSchemaConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClickhouseSchemaConfig {
+    return new ClickhouseSchemaConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClickhouseSchemaConfig {
+    return new ClickhouseSchemaConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClickhouseSchemaConfig {
+    return new ClickhouseSchemaConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClickhouseSchemaConfig | PlainMessage<ClickhouseSchemaConfig> | undefined, b: ClickhouseSchemaConfig | PlainMessage<ClickhouseSchemaConfig> | undefined): boolean {
+    return proto3.util.equals(ClickhouseSchemaConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3SchemaConfig
  */
@@ -670,6 +852,12 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
      */
     value: MssqlSchemaConfig;
     case: "mssqlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.ClickhouseSchemaConfig clickhouse_config = 8;
+     */
+    value: ClickhouseSchemaConfig;
+    case: "clickhouseConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionSchemaConfig>) {
@@ -687,6 +875,7 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
     { no: 5, name: "gcp_cloudstorage_config", kind: "message", T: GcpCloudStorageSchemaConfig, oneof: "config" },
     { no: 6, name: "dynamodb_config", kind: "message", T: DynamoDBSchemaConfig, oneof: "config" },
     { no: 7, name: "mssql_config", kind: "message", T: MssqlSchemaConfig, oneof: "config" },
+    { no: 8, name: "clickhouse_config", kind: "message", T: ClickhouseSchemaConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionSchemaConfig {
@@ -767,6 +956,13 @@ export class DatabaseColumn extends Message<DatabaseColumn> {
    */
   identityGeneration?: string;
 
+  /**
+   * The classification tags that have been set on the column. Only populated for sql connections
+   *
+   * @generated from field: repeated string tags = 9;
+   */
+  tags: string[] = [];
+
   constructor(data?: PartialMessage<DatabaseColumn>) {
     super();
     proto3.util.initPartial(data, this);
@@ -783,6 +979,7 @@ export class DatabaseColumn extends Message<DatabaseColumn> {
     { no: 6, name: "column_default", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 7, name: "generated_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 8, name: "identity_generation", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DatabaseColumn {
@@ -2071,3 +2268,611 @@ export class GetTableRowCountResponse extends Message<GetTableRowCountResponse>
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.CompareConnectionSchemasRequest
+ */
+export class CompareConnectionSchemasRequest extends Message<CompareConnectionSchemasRequest> {
+  /**
+   * The connection that holds the expected schema
+   *
+   * @generated from field: string source_connection_id = 1;
+   */
+  sourceConnectionId = "";
+
+  /**
+   * The connection that is compared against the source connection
+   *
+   * @generated from field: string destination_connection_id = 2;
+   */
+  destinationConnectionId = "";
+
+  /**
+   * Only compares the tables in these schemas. All schemas are compared if none are provided
+   *
+   * @generated from field: repeated string schemas = 3;
+   */
+  schemas: string[] = [];
+
+  /**
+   * Returns the statements that bring the destination schema in line with the source schema.
+   * Only supported when both connections are postgres or both connections are mysql.
+   *
+   * @generated from field: bool include_migration_statements = 4;
+   */
+  includeMigrationStatements = false;
+
+  constructor(data?: PartialMessage<CompareConnectionSchemasRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CompareConnectionSchemasRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "destination_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "schemas", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "include_migration_statements", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareConnectionSchemasRequest {
+    return new CompareConnectionSchemasRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareConnectionSchemasRequest {
+    return new CompareConnectionSchemasRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareConnectionSchemasRequest {
+    return new CompareConnectionSchemasRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompareConnectionSchemasRequest | PlainMessage<CompareConnectionSchemasRequest> | undefined, b: CompareConnectionSchemasRequest | PlainMessage<CompareConnectionSchemasRequest> | undefined): boolean {
+    return proto3.util.equals(CompareConnectionSchemasRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CompareConnectionSchemasResponse
+ */
+export class CompareConnectionSchemasResponse extends Message<CompareConnectionSchemasResponse> {
+  /**
+   * The tables that differ between the source and destination connections
+   *
+   * @generated from field: repeated mgmt.v1alpha1.TableSchemaDiff tables = 1;
+   */
+  tables: TableSchemaDiff[] = [];
+
+  /**
+   * The statements to run against the destination to bring it in line with the source
+   *
+   * @generated from field: repeated string migration_statements = 2;
+   */
+  migrationStatements: string[] = [];
+
+  constructor(data?: PartialMessage<CompareConnectionSchemasResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CompareConnectionSchemasResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tables", kind: "message", T: TableSchemaDiff, repeated: true },
+    { no: 2, name: "migration_statements", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareConnectionSchemasResponse {
+    return new CompareConnectionSchemasResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareConnectionSchemasResponse {
+    return new CompareConnectionSchemasResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareConnectionSchemasResponse {
+    return new CompareConnectionSchemasResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompareConnectionSchemasResponse | PlainMessage<CompareConnectionSchemasResponse> | undefined, b: CompareConnectionSchemasResponse | PlainMessage<CompareConnectionSchemasResponse> | undefined): boolean {
+    return proto3.util.equals(CompareConnectionSchemasResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TableSchemaDiff
+ */
+export class TableSchemaDiff extends Message<TableSchemaDiff> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SchemaDiffType type = 3;
+   */
+  type = SchemaDiffType.UNSPECIFIED;
+
+  /**
+   * The columns that differ. Only set when the table exists in both connections
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ColumnSchemaDiff columns = 4;
+   */
+  columns: ColumnSchemaDiff[] = [];
+
+  /**
+   * The constraints that differ. Only set when the table exists in both connections
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ConstraintSchemaDiff constraints = 5;
+   */
+  constraints: ConstraintSchemaDiff[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.TriggerSchemaDiff triggers = 6;
+   */
+  triggers: TriggerSchemaDiff[] = [];
+
+  constructor(data?: PartialMessage<TableSchemaDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TableSchemaDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(SchemaDiffType) },
+    { no: 4, name: "columns", kind: "message", T: ColumnSchemaDiff, repeated: true },
+    { no: 5, name: "constraints", kind: "message", T: ConstraintSchemaDiff, repeated: true },
+    { no: 6, name: "triggers", kind: "message", T: TriggerSchemaDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableSchemaDiff {
+    return new TableSchemaDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableSchemaDiff {
+    return new TableSchemaDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableSchemaDiff {
+    return new TableSchemaDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableSchemaDiff | PlainMessage<TableSchemaDiff> | undefined, b: TableSchemaDiff | PlainMessage<TableSchemaDiff> | undefined): boolean {
+    return proto3.util.equals(TableSchemaDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ColumnSchemaDiff
+ */
+export class ColumnSchemaDiff extends Message<ColumnSchemaDiff> {
+  /**
+   * @generated from field: string column = 1;
+   */
+  column = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SchemaDiffType type = 2;
+   */
+  type = SchemaDiffType.UNSPECIFIED;
+
+  /**
+   * The column as defined in the source. Not set if the column does not exist in the source
+   *
+   * @generated from field: optional mgmt.v1alpha1.ColumnSchemaDefinition source = 3;
+   */
+  source?: ColumnSchemaDefinition;
+
+  /**
+   * The column as defined in the destination. Not set if the column does not exist in the destination
+   *
+   * @generated from field: optional mgmt.v1alpha1.ColumnSchemaDefinition destination = 4;
+   */
+  destination?: ColumnSchemaDefinition;
+
+  constructor(data?: PartialMessage<ColumnSchemaDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ColumnSchemaDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(SchemaDiffType) },
+    { no: 3, name: "source", kind: "message", T: ColumnSchemaDefinition, opt: true },
+    { no: 4, name: "destination", kind: "message", T: ColumnSchemaDefinition, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnSchemaDiff {
+    return new ColumnSchemaDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnSchemaDiff {
+    return new ColumnSchemaDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnSchemaDiff {
+    return new ColumnSchemaDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnSchemaDiff | PlainMessage<ColumnSchemaDiff> | undefined, b: ColumnSchemaDiff | PlainMessage<ColumnSchemaDiff> | undefined): boolean {
+    return proto3.util.equals(ColumnSchemaDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ColumnSchemaDefinition
+ */
+export class ColumnSchemaDefinition extends Message<ColumnSchemaDefinition> {
+  /**
+   * The data type of the column, including its length or precision, e.g. varchar(255)
+   *
+   * @generated from field: string data_type = 1;
+   */
+  dataType = "";
+
+  /**
+   * @generated from field: bool is_nullable = 2;
+   */
+  isNullable = false;
+
+  /**
+   * @generated from field: optional string column_default = 3;
+   */
+  columnDefault?: string;
+
+  constructor(data?: PartialMessage<ColumnSchemaDefinition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ColumnSchemaDefinition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "is_nullable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "column_default", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnSchemaDefinition {
+    return new ColumnSchemaDefinition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnSchemaDefinition {
+    return new ColumnSchemaDefinition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnSchemaDefinition {
+    return new ColumnSchemaDefinition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnSchemaDefinition | PlainMessage<ColumnSchemaDefinition> | undefined, b: ColumnSchemaDefinition | PlainMessage<ColumnSchemaDefinition> | undefined): boolean {
+    return proto3.util.equals(ColumnSchemaDefinition, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ConstraintSchemaDiff
+ */
+export class ConstraintSchemaDiff extends Message<ConstraintSchemaDiff> {
+  /**
+   * @generated from field: mgmt.v1alpha1.SchemaConstraintType constraint_type = 1;
+   */
+  constraintType = SchemaConstraintType.UNSPECIFIED;
+
+  /**
+   * Primary and unique constraints only differ by being missing in either connection
+   *
+   * @generated from field: mgmt.v1alpha1.SchemaDiffType type = 2;
+   */
+  type = SchemaDiffType.UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated string columns = 3;
+   */
+  columns: string[] = [];
+
+  /**
+   * The referenced table and columns. Only set for foreign key constraints
+   *
+   * @generated from field: optional mgmt.v1alpha1.ForeignKey foreign_key = 4;
+   */
+  foreignKey?: ForeignKey;
+
+  constructor(data?: PartialMessage<ConstraintSchemaDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ConstraintSchemaDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "constraint_type", kind: "enum", T: proto3.getEnumType(SchemaConstraintType) },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(SchemaDiffType) },
+    { no: 3, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "foreign_key", kind: "message", T: ForeignKey, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConstraintSchemaDiff {
+    return new ConstraintSchemaDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConstraintSchemaDiff {
+    return new ConstraintSchemaDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConstraintSchemaDiff {
+    return new ConstraintSchemaDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConstraintSchemaDiff | PlainMessage<ConstraintSchemaDiff> | undefined, b: ConstraintSchemaDiff | PlainMessage<ConstraintSchemaDiff> | undefined): boolean {
+    return proto3.util.equals(ConstraintSchemaDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TriggerSchemaDiff
+ */
+export class TriggerSchemaDiff extends Message<TriggerSchemaDiff> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SchemaDiffType type = 2;
+   */
+  type = SchemaDiffType.UNSPECIFIED;
+
+  /**
+   * @generated from field: optional string source_definition = 3;
+   */
+  sourceDefinition?: string;
+
+  /**
+   * @generated from field: optional string destination_definition = 4;
+   */
+  destinationDefinition?: string;
+
+  constructor(data?: PartialMessage<TriggerSchemaDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TriggerSchemaDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(SchemaDiffType) },
+    { no: 3, name: "source_definition", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "destination_definition", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerSchemaDiff {
+    return new TriggerSchemaDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerSchemaDiff {
+    return new TriggerSchemaDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerSchemaDiff {
+    return new TriggerSchemaDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerSchemaDiff | PlainMessage<TriggerSchemaDiff> | undefined, b: TriggerSchemaDiff | PlainMessage<TriggerSchemaDiff> | undefined): boolean {
+    return proto3.util.equals(TriggerSchemaDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionForPiiRequest
+ */
+export class ScanConnectionForPiiRequest extends Message<ScanConnectionForPiiRequest> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * Only scans the tables in these schemas. Defaults to all schemas
+   *
+   * @generated from field: repeated string schemas = 2;
+   */
+  schemas: string[] = [];
+
+  /**
+   * The number of rows that are sampled from each table. Defaults to 100
+   *
+   * @generated from field: optional uint32 sample_size = 3;
+   */
+  sampleSize?: number;
+
+  /**
+   * Adds the pii tag (and pci for card numbers) to the column tags of the connection for every column that was detected with enough confidence.
+   * Existing tags are kept.
+   *
+   * @generated from field: bool update_column_tags = 4;
+   */
+  updateColumnTags = false;
+
+  constructor(data?: PartialMessage<ScanConnectionForPiiRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionForPiiRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schemas", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "sample_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "update_column_tags", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionForPiiRequest | PlainMessage<ScanConnectionForPiiRequest> | undefined, b: ScanConnectionForPiiRequest | PlainMessage<ScanConnectionForPiiRequest> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionForPiiRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionForPiiResponse
+ */
+export class ScanConnectionForPiiResponse extends Message<ScanConnectionForPiiResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.ColumnPiiScanResult columns = 1;
+   */
+  columns: ColumnPiiScanResult[] = [];
+
+  constructor(data?: PartialMessage<ScanConnectionForPiiResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionForPiiResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "message", T: ColumnPiiScanResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionForPiiResponse | PlainMessage<ScanConnectionForPiiResponse> | undefined, b: ScanConnectionForPiiResponse | PlainMessage<ScanConnectionForPiiResponse> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionForPiiResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ColumnPiiScanResult
+ */
+export class ColumnPiiScanResult extends Message<ColumnPiiScanResult> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * @generated from field: string data_type = 4;
+   */
+  dataType = "";
+
+  /**
+   * The kind of PII the column most likely holds. Unspecified if no PII was detected
+   *
+   * @generated from field: mgmt.v1alpha1.PiiType pii_type = 5;
+   */
+  piiType = PiiType.UNSPECIFIED;
+
+  /**
+   * How confident the scan is that the column holds the PII type, from 0 to 1
+   *
+   * @generated from field: double confidence = 6;
+   */
+  confidence = 0;
+
+  /**
+   * True if the column name matched the PII type
+   *
+   * @generated from field: bool name_match = 7;
+   */
+  nameMatch = false;
+
+  /**
+   * The number of non-empty values that were sampled
+   *
+   * @generated from field: uint32 sampled_count = 8;
+   */
+  sampledCount = 0;
+
+  /**
+   * The number of sampled values that matched the PII type
+   *
+   * @generated from field: uint32 matched_count = 9;
+   */
+  matchedCount = 0;
+
+  /**
+   * The transformer that is recommended for the column. Passthrough if no PII was detected with enough confidence
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer recommended_transformer = 10;
+   */
+  recommendedTransformer?: JobMappingTransformer;
+
+  constructor(data?: PartialMessage<ColumnPiiScanResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ColumnPiiScanResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "pii_type", kind: "enum", T: proto3.getEnumType(PiiType) },
+    { no: 6, name: "confidence", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "name_match", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "sampled_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "matched_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "recommended_transformer", kind: "message", T: JobMappingTransformer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnPiiScanResult {
+    return new ColumnPiiScanResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnPiiScanResult {
+    return new ColumnPiiScanResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnPiiScanResult {
+    return new ColumnPiiScanResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnPiiScanResult | PlainMessage<ColumnPiiScanResult> | undefined, b: ColumnPiiScanResult | PlainMessage<ColumnPiiScanResult> | undefined): boolean {
+    return proto3.util.equals(ColumnPiiScanResult, a, b);
+  }
+}
+
@@ -154,6 +154,80 @@ export class GetConnectionResponse extends Message<GetConnectionResponse> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.GetConnectionUnmaskedRequest
+ */
+export class GetConnectionUnmaskedRequest extends Message<GetConnectionUnmaskedRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetConnectionUnmaskedRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetConnectionUnmaskedRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectionUnmaskedRequest {
+    return new GetConnectionUnmaskedRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectionUnmaskedRequest {
+    return new GetConnectionUnmaskedRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectionUnmaskedRequest {
+    return new GetConnectionUnmaskedRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectionUnmaskedRequest | PlainMessage<GetConnectionUnmaskedRequest> | undefined, b: GetConnectionUnmaskedRequest | PlainMessage<GetConnectionUnmaskedRequest> | undefined): boolean {
+    return proto3.util.equals(GetConnectionUnmaskedRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetConnectionUnmaskedResponse
+ */
+export class GetConnectionUnmaskedResponse extends Message<GetConnectionUnmaskedResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Connection connection = 1;
+   */
+  connection?: Connection;
+
+  constructor(data?: PartialMessage<GetConnectionUnmaskedResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetConnectionUnmaskedResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection", kind: "message", T: Connection },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectionUnmaskedResponse {
+    return new GetConnectionUnmaskedResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectionUnmaskedResponse {
+    return new GetConnectionUnmaskedResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectionUnmaskedResponse {
+    return new GetConnectionUnmaskedResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectionUnmaskedResponse | PlainMessage<GetConnectionUnmaskedResponse> | undefined, b: GetConnectionUnmaskedResponse | PlainMessage<GetConnectionUnmaskedResponse> | undefined): boolean {
+    return proto3.util.equals(GetConnectionUnmaskedResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.CreateConnectionRequest
  */
@@ -405,6 +479,14 @@ export class CheckConnectionConfigRequest extends Message<CheckConnectionConfigR
    */
   connectionConfig?: ConnectionConfig;
 
+  /**
+   * The id of an existing connection. When set, the masked secrets of the connection config are filled in with
+   * the secrets that are stored for this connection so that an edited connection can be checked without re-entering them.
+   *
+   * @generated from field: optional string id = 2;
+   */
+  id?: string;
+
   constructor(data?: PartialMessage<CheckConnectionConfigRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -414,6 +496,7 @@ export class CheckConnectionConfigRequest extends Message<CheckConnectionConfigR
   static readonly typeName = "mgmt.v1alpha1.CheckConnectionConfigRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "connection_config", kind: "message", T: ConnectionConfig },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckConnectionConfigRequest {
@@ -725,6 +808,22 @@ export class ConnectionConfig extends Message<ConnectionConfig> {
      */
     value: MssqlConnectionConfig;
     case: "mssqlConfig";
+  } | {
+    /**
+     * Configures a ClickHouse Connection
+     *
+     * @generated from field: mgmt.v1alpha1.ClickhouseConnectionConfig clickhouse_config = 10;
+     */
+    value: ClickhouseConnectionConfig;
+    case: "clickhouseConfig";
+  } | {
+    /**
+     * Configures a Kafka Connection
+     *
+     * @generated from field: mgmt.v1alpha1.KafkaConnectionConfig kafka_config = 11;
+     */
+    value: KafkaConnectionConfig;
+    case: "kafkaConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionConfig>) {
@@ -744,6 +843,8 @@ export class ConnectionConfig extends Message<ConnectionConfig> {
     { no: 7, name: "gcp_cloudstorage_config", kind: "message", T: GcpCloudStorageConnectionConfig, oneof: "config" },
     { no: 8, name: "dynamodb_config", kind: "message", T: DynamoDBConnectionConfig, oneof: "config" },
     { no: 9, name: "mssql_config", kind: "message", T: MssqlConnectionConfig, oneof: "config" },
+    { no: 10, name: "clickhouse_config", kind: "message", T: ClickhouseConnectionConfig, oneof: "config" },
+    { no: 11, name: "kafka_config", kind: "message", T: KafkaConnectionConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionConfig {
@@ -826,6 +927,224 @@ export class MssqlConnectionConfig extends Message<MssqlConnectionConfig> {
   }
 }
 
+/**
+ * Configuration for a ClickHouse Connection
+ *
+ * @generated from message mgmt.v1alpha1.ClickhouseConnectionConfig
+ */
+export class ClickhouseConnectionConfig extends Message<ClickhouseConnectionConfig> {
+  /**
+   * May provide a raw string url or in the future other methods of connection
+   *
+   * @generated from oneof mgmt.v1alpha1.ClickhouseConnectionConfig.connection_config
+   */
+  connectionConfig: {
+    /**
+     * The URL with clickhouse scheme. This must point at the native protocol port (default 9000)
+     *
+     * @generated from field: string url = 1;
+     */
+    value: string;
+    case: "url";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SqlConnectionOptions connection_options = 2;
+   */
+  connectionOptions?: SqlConnectionOptions;
+
+  /**
+   * Provide tunnel configuration that can be used to access a clickhouse connection that is not publicly accessible to the internet
+   *
+   * @generated from field: mgmt.v1alpha1.SSHTunnel tunnel = 3;
+   */
+  tunnel?: SSHTunnel;
+
+  constructor(data?: PartialMessage<ClickhouseConnectionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ClickhouseConnectionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "connection_config" },
+    { no: 2, name: "connection_options", kind: "message", T: SqlConnectionOptions },
+    { no: 3, name: "tunnel", kind: "message", T: SSHTunnel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClickhouseConnectionConfig {
+    return new ClickhouseConnectionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClickhouseConnectionConfig {
+    return new ClickhouseConnectionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClickhouseConnectionConfig {
+    return new ClickhouseConnectionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClickhouseConnectionConfig | PlainMessage<ClickhouseConnectionConfig> | undefined, b: ClickhouseConnectionConfig | PlainMessage<ClickhouseConnectionConfig> | undefined): boolean {
+    return proto3.util.equals(ClickhouseConnectionConfig, a, b);
+  }
+}
+
+/**
+ * Configuration for a Kafka Connection
+ *
+ * @generated from message mgmt.v1alpha1.KafkaConnectionConfig
+ */
+export class KafkaConnectionConfig extends Message<KafkaConnectionConfig> {
+  /**
+   * The list of seed brokers in host:port format
+   *
+   * @generated from field: repeated string brokers = 1;
+   */
+  brokers: string[] = [];
+
+  /**
+   * Optionally provide SASL authentication that will be used when connecting to the brokers
+   *
+   * @generated from field: optional mgmt.v1alpha1.KafkaSaslConfig sasl = 2;
+   */
+  sasl?: KafkaSaslConfig;
+
+  /**
+   * Optionally enable TLS when connecting to the brokers
+   *
+   * @generated from field: optional mgmt.v1alpha1.KafkaTlsConfig tls = 3;
+   */
+  tls?: KafkaTlsConfig;
+
+  constructor(data?: PartialMessage<KafkaConnectionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaConnectionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "brokers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "sasl", kind: "message", T: KafkaSaslConfig, opt: true },
+    { no: 3, name: "tls", kind: "message", T: KafkaTlsConfig, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaConnectionConfig | PlainMessage<KafkaConnectionConfig> | undefined, b: KafkaConnectionConfig | PlainMessage<KafkaConnectionConfig> | undefined): boolean {
+    return proto3.util.equals(KafkaConnectionConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.KafkaSaslConfig
+ */
+export class KafkaSaslConfig extends Message<KafkaSaslConfig> {
+  /**
+   * The SASL mechanism. One of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512
+   *
+   * @generated from field: string mechanism = 1;
+   */
+  mechanism = "";
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password = "";
+
+  constructor(data?: PartialMessage<KafkaSaslConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaSaslConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mechanism", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaSaslConfig | PlainMessage<KafkaSaslConfig> | undefined, b: KafkaSaslConfig | PlainMessage<KafkaSaslConfig> | undefined): boolean {
+    return proto3.util.equals(KafkaSaslConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.KafkaTlsConfig
+ */
+export class KafkaTlsConfig extends Message<KafkaTlsConfig> {
+  /**
+   * Skips verification of the server's certificate chain and host name
+   *
+   * @generated from field: bool skip_cert_verify = 1;
+   */
+  skipCertVerify = false;
+
+  /**
+   * Optionally provide a root certificate and/or client certificates
+   *
+   * @generated from field: mgmt.v1alpha1.ClientTlsConfig client_tls = 2;
+   */
+  clientTls?: ClientTlsConfig;
+
+  constructor(data?: PartialMessage<KafkaTlsConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaTlsConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "skip_cert_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "client_tls", kind: "message", T: ClientTlsConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaTlsConfig {
+    return new KafkaTlsConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaTlsConfig {
+    return new KafkaTlsConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaTlsConfig {
+    return new KafkaTlsConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaTlsConfig | PlainMessage<KafkaTlsConfig> | undefined, b: KafkaTlsConfig | PlainMessage<KafkaTlsConfig> | undefined): boolean {
+    return proto3.util.equals(KafkaTlsConfig, a, b);
+  }
+}
+
 /**
  * Configuration for a DynaomDB Connection
  *
@@ -1999,3 +2318,221 @@ export class CheckSqlQueryResponse extends Message<CheckSqlQueryResponse> {
   }
 }
 
+/**
+ * The classification tags of a single column of a connection.
+ * Well known tags are pii, phi, pci, free_text and internal_id. Any other lowercase tag may be used as a custom tag.
+ *
+ * @generated from message mgmt.v1alpha1.ColumnTags
+ */
+export class ColumnTags extends Message<ColumnTags> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * @generated from field: repeated string tags = 4;
+   */
+  tags: string[] = [];
+
+  constructor(data?: PartialMessage<ColumnTags>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ColumnTags";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnTags {
+    return new ColumnTags().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnTags {
+    return new ColumnTags().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnTags {
+    return new ColumnTags().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnTags | PlainMessage<ColumnTags> | undefined, b: ColumnTags | PlainMessage<ColumnTags> | undefined): boolean {
+    return proto3.util.equals(ColumnTags, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetConnectionColumnTagsRequest
+ */
+export class GetConnectionColumnTagsRequest extends Message<GetConnectionColumnTagsRequest> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  constructor(data?: PartialMessage<GetConnectionColumnTagsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetConnectionColumnTagsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectionColumnTagsRequest {
+    return new GetConnectionColumnTagsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectionColumnTagsRequest {
+    return new GetConnectionColumnTagsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectionColumnTagsRequest {
+    return new GetConnectionColumnTagsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectionColumnTagsRequest | PlainMessage<GetConnectionColumnTagsRequest> | undefined, b: GetConnectionColumnTagsRequest | PlainMessage<GetConnectionColumnTagsRequest> | undefined): boolean {
+    return proto3.util.equals(GetConnectionColumnTagsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetConnectionColumnTagsResponse
+ */
+export class GetConnectionColumnTagsResponse extends Message<GetConnectionColumnTagsResponse> {
+  /**
+   * The tagged columns of the connection, ordered by schema, table and column
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ColumnTags columns = 1;
+   */
+  columns: ColumnTags[] = [];
+
+  constructor(data?: PartialMessage<GetConnectionColumnTagsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetConnectionColumnTagsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "message", T: ColumnTags, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectionColumnTagsResponse {
+    return new GetConnectionColumnTagsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectionColumnTagsResponse {
+    return new GetConnectionColumnTagsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectionColumnTagsResponse {
+    return new GetConnectionColumnTagsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectionColumnTagsResponse | PlainMessage<GetConnectionColumnTagsResponse> | undefined, b: GetConnectionColumnTagsResponse | PlainMessage<GetConnectionColumnTagsResponse> | undefined): boolean {
+    return proto3.util.equals(GetConnectionColumnTagsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetConnectionColumnTagsRequest
+ */
+export class SetConnectionColumnTagsRequest extends Message<SetConnectionColumnTagsRequest> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * Replaces the tags of each of these columns. Columns that are not present keep their tags, and a column with no tags is untagged.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ColumnTags columns = 2;
+   */
+  columns: ColumnTags[] = [];
+
+  constructor(data?: PartialMessage<SetConnectionColumnTagsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetConnectionColumnTagsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "columns", kind: "message", T: ColumnTags, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConnectionColumnTagsRequest {
+    return new SetConnectionColumnTagsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConnectionColumnTagsRequest {
+    return new SetConnectionColumnTagsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConnectionColumnTagsRequest {
+    return new SetConnectionColumnTagsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConnectionColumnTagsRequest | PlainMessage<SetConnectionColumnTagsRequest> | undefined, b: SetConnectionColumnTagsRequest | PlainMessage<SetConnectionColumnTagsRequest> | undefined): boolean {
+    return proto3.util.equals(SetConnectionColumnTagsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetConnectionColumnTagsResponse
+ */
+export class SetConnectionColumnTagsResponse extends Message<SetConnectionColumnTagsResponse> {
+  /**
+   * All of the tagged columns of the connection after the update
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ColumnTags columns = 1;
+   */
+  columns: ColumnTags[] = [];
+
+  constructor(data?: PartialMessage<SetConnectionColumnTagsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetConnectionColumnTagsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "message", T: ColumnTags, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConnectionColumnTagsResponse {
+    return new SetConnectionColumnTagsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConnectionColumnTagsResponse {
+    return new SetConnectionColumnTagsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConnectionColumnTagsResponse {
+    return new SetConnectionColumnTagsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConnectionColumnTagsResponse | PlainMessage<SetConnectionColumnTagsResponse> | undefined, b: SetConnectionColumnTagsResponse | PlainMessage<SetConnectionColumnTagsResponse> | undefined): boolean {
+    return proto3.util.equals(SetConnectionColumnTagsResponse, a, b);
+  }
+}
+
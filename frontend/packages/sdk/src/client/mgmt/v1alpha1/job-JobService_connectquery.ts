@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { MethodKind } from "@bufbuild/protobuf";
-import { CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, GetRunContextRequest, GetRunContextResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, SetRunContextRequest, SetRunContextResponse, TerminateJobRunRequest, TerminateJobRunResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse, ValidateJobMappingsRequest, ValidateJobMappingsResponse } from "./job_pb.js";
+import { CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobMappingPolicyConfigRequest, GetJobMappingPolicyConfigResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, GetRunContextRequest, GetRunContextResponse, IngestJobRunLogsRequest, IngestJobRunLogsResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, RecordJobRunRequest, RecordJobRunResponse, SetJobMappingPolicyConfigRequest, SetJobMappingPolicyConfigResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, SetRunContextRequest, SetRunContextResponse, TerminateJobRunRequest, TerminateJobRunResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse, ValidateJobMappingsRequest, ValidateJobMappingsResponse } from "./job_pb.js";
 
 /**
  * @generated from rpc mgmt.v1alpha1.JobService.GetJobs
@@ -235,7 +235,7 @@ export const getJobStatuses = {
 } as const;
 
 /**
- * Returns a list of job runs by either account or job
+ * Returns a page of job runs by either account or job. Runs are read from the job run history stored in Neosync
  *
  * @generated from rpc mgmt.v1alpha1.JobService.GetJobRuns
  */
@@ -336,6 +336,38 @@ export const terminateJobRun = {
   }
 } as const;
 
+/**
+ * Stores logs that have been shipped by the worker for a specific job run. Only used when run logs are stored in the Neosync database.
+ *
+ * @generated from rpc mgmt.v1alpha1.JobService.IngestJobRunLogs
+ */
+export const ingestJobRunLogs = {
+  localName: "ingestJobRunLogs",
+  name: "IngestJobRunLogs",
+  kind: MethodKind.Unary,
+  I: IngestJobRunLogsRequest,
+  O: IngestJobRunLogsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.JobService"
+  }
+} as const;
+
+/**
+ * Persists the status and outcome of a job run in the Neosync database so that run history outlives Temporal retention. Called by the worker.
+ *
+ * @generated from rpc mgmt.v1alpha1.JobService.RecordJobRun
+ */
+export const recordJobRun = {
+  localName: "recordJobRun",
+  name: "RecordJobRun",
+  kind: MethodKind.Unary,
+  I: RecordJobRunRequest,
+  O: RecordJobRunResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.JobService"
+  }
+} as const;
+
 /**
  * Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
  *
@@ -384,6 +416,38 @@ export const validateJobMappings = {
   }
 } as const;
 
+/**
+ * Returns the account's job mapping policies
+ *
+ * @generated from rpc mgmt.v1alpha1.JobService.GetJobMappingPolicyConfig
+ */
+export const getJobMappingPolicyConfig = {
+  localName: "getJobMappingPolicyConfig",
+  name: "GetJobMappingPolicyConfig",
+  kind: MethodKind.Unary,
+  I: GetJobMappingPolicyConfigRequest,
+  O: GetJobMappingPolicyConfigResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.JobService"
+  }
+} as const;
+
+/**
+ * Sets the account's job mapping policies. Must provide the entire object as it will fully override the previous configuration
+ *
+ * @generated from rpc mgmt.v1alpha1.JobService.SetJobMappingPolicyConfig
+ */
+export const setJobMappingPolicyConfig = {
+  localName: "setJobMappingPolicyConfig",
+  name: "SetJobMappingPolicyConfig",
+  kind: MethodKind.Unary,
+  I: SetJobMappingPolicyConfigRequest,
+  O: SetJobMappingPolicyConfigResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.JobService"
+  }
+} as const;
+
 /**
  * Gets a run context to be used by a workflow run
  *
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunLogsStreamRequest, GetJobRunLogsStreamResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, GetRunContextRequest, GetRunContextResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, SetRunContextRequest, SetRunContextResponse, SetRunContextsRequest, SetRunContextsResponse, TerminateJobRunRequest, TerminateJobRunResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse, ValidateJobMappingsRequest, ValidateJobMappingsResponse } from "./job_pb.js";
+import { CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobMappingPolicyConfigRequest, GetJobMappingPolicyConfigResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunLogsStreamRequest, GetJobRunLogsStreamResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, GetRunContextRequest, GetRunContextResponse, IngestJobRunLogsRequest, IngestJobRunLogsResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, RecordJobRunRequest, RecordJobRunResponse, SetJobMappingPolicyConfigRequest, SetJobMappingPolicyConfigResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, SetRunContextRequest, SetRunContextResponse, SetRunContextsRequest, SetRunContextsResponse, TerminateJobRunRequest, TerminateJobRunResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse, ValidateJobMappingsRequest, ValidateJobMappingsResponse, WatchJobRunRequest, WatchJobRunResponse } from "./job_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -161,7 +161,7 @@ export const JobService = {
       kind: MethodKind.Unary,
     },
     /**
-     * Returns a list of job runs by either account or job
+     * Returns a page of job runs by either account or job. Runs are read from the job run history stored in Neosync
      *
      * @generated from rpc mgmt.v1alpha1.JobService.GetJobRuns
      */
@@ -180,6 +180,17 @@ export const JobService = {
       O: GetJobRunEventsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Streams the events and per table progress of a job run until the run completes
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.WatchJobRun
+     */
+    watchJobRun: {
+      name: "WatchJobRun",
+      I: WatchJobRunRequest,
+      O: WatchJobRunResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * Returns a specific job run, along with any of its pending activities
      *
@@ -238,6 +249,28 @@ export const JobService = {
       O: GetJobRunLogsStreamResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Stores logs that have been shipped by the worker for a specific job run. Only used when run logs are stored in the Neosync database.
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.IngestJobRunLogs
+     */
+    ingestJobRunLogs: {
+      name: "IngestJobRunLogs",
+      I: IngestJobRunLogsRequest,
+      O: IngestJobRunLogsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Persists the status and outcome of a job run in the Neosync database so that run history outlives Temporal retention. Called by the worker.
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.RecordJobRun
+     */
+    recordJobRun: {
+      name: "RecordJobRun",
+      I: RecordJobRunRequest,
+      O: RecordJobRunResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
      *
@@ -271,6 +304,28 @@ export const JobService = {
       O: ValidateJobMappingsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Returns the account's job mapping policies
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobMappingPolicyConfig
+     */
+    getJobMappingPolicyConfig: {
+      name: "GetJobMappingPolicyConfig",
+      I: GetJobMappingPolicyConfigRequest,
+      O: GetJobMappingPolicyConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sets the account's job mapping policies. Must provide the entire object as it will fully override the previous configuration
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.SetJobMappingPolicyConfig
+     */
+    setJobMappingPolicyConfig: {
+      name: "SetJobMappingPolicyConfig",
+      I: SetJobMappingPolicyConfigRequest,
+      O: SetJobMappingPolicyConfigResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Gets a run context to be used by a workflow run
      *
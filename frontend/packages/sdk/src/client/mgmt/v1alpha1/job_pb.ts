@@ -7,6 +7,66 @@ import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialM
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { TransformerConfig, TransformerSource } from "./transformer_pb.js";
 
+/**
+ * @generated from enum mgmt.v1alpha1.DynamoDBDestinationWriteMode
+ */
+export enum DynamoDBDestinationWriteMode {
+  /**
+   * Defaults to overwrite
+   *
+   * @generated from enum value: DYNAMO_DB_DESTINATION_WRITE_MODE_UNSPECIFIED = 0;
+   */
+  DYNAMO_DB_DESTINATION_WRITE_MODE_UNSPECIFIED = 0,
+
+  /**
+   * Items replace any existing item with the same key
+   *
+   * @generated from enum value: DYNAMO_DB_DESTINATION_WRITE_MODE_OVERWRITE = 1;
+   */
+  DYNAMO_DB_DESTINATION_WRITE_MODE_OVERWRITE = 1,
+
+  /**
+   * Items are only written if an item with the same key does not already exist
+   *
+   * @generated from enum value: DYNAMO_DB_DESTINATION_WRITE_MODE_SKIP_EXISTING = 2;
+   */
+  DYNAMO_DB_DESTINATION_WRITE_MODE_SKIP_EXISTING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DynamoDBDestinationWriteMode)
+proto3.util.setEnumType(DynamoDBDestinationWriteMode, "mgmt.v1alpha1.DynamoDBDestinationWriteMode", [
+  { no: 0, name: "DYNAMO_DB_DESTINATION_WRITE_MODE_UNSPECIFIED" },
+  { no: 1, name: "DYNAMO_DB_DESTINATION_WRITE_MODE_OVERWRITE" },
+  { no: 2, name: "DYNAMO_DB_DESTINATION_WRITE_MODE_SKIP_EXISTING" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.KafkaSerializationFormat
+ */
+export enum KafkaSerializationFormat {
+  /**
+   * @generated from enum value: KAFKA_SERIALIZATION_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: KAFKA_SERIALIZATION_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * Binary encoded Avro. The schema is derived from the source column metadata.
+   *
+   * @generated from enum value: KAFKA_SERIALIZATION_FORMAT_AVRO = 2;
+   */
+  AVRO = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(KafkaSerializationFormat)
+proto3.util.setEnumType(KafkaSerializationFormat, "mgmt.v1alpha1.KafkaSerializationFormat", [
+  { no: 0, name: "KAFKA_SERIALIZATION_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "KAFKA_SERIALIZATION_FORMAT_JSON" },
+  { no: 2, name: "KAFKA_SERIALIZATION_FORMAT_AVRO" },
+]);
+
 /**
  * @generated from enum mgmt.v1alpha1.JobStatus
  */
@@ -229,6 +289,38 @@ proto3.util.setEnumType(LogLevel, "mgmt.v1alpha1.LogLevel", [
   { no: 4, name: "LOG_LEVEL_ERROR" },
 ]);
 
+/**
+ * @generated from enum mgmt.v1alpha1.JobMappingPolicyDestinationScope
+ */
+export enum JobMappingPolicyDestinationScope {
+  /**
+   * Applies to all jobs
+   *
+   * @generated from enum value: JOB_MAPPING_POLICY_DESTINATION_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Applies to all jobs
+   *
+   * @generated from enum value: JOB_MAPPING_POLICY_DESTINATION_SCOPE_ALL = 1;
+   */
+  ALL = 1,
+
+  /**
+   * Only applies to jobs that sync to one of the account's non-production connections
+   *
+   * @generated from enum value: JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION = 2;
+   */
+  NON_PRODUCTION = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(JobMappingPolicyDestinationScope)
+proto3.util.setEnumType(JobMappingPolicyDestinationScope, "mgmt.v1alpha1.JobMappingPolicyDestinationScope", [
+  { no: 0, name: "JOB_MAPPING_POLICY_DESTINATION_SCOPE_UNSPECIFIED" },
+  { no: 1, name: "JOB_MAPPING_POLICY_DESTINATION_SCOPE_ALL" },
+  { no: 2, name: "JOB_MAPPING_POLICY_DESTINATION_SCOPE_NON_PRODUCTION" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetJobsRequest
  */
@@ -839,6 +931,14 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
    */
   connectionId = "";
 
+  /**
+   * List of collection option configurations for any mapped source collection.
+   * Any collection listed in this must also be present as a job mapping to be applied.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBSourceCollectionOption collections = 2;
+   */
+  collections: MongoDBSourceCollectionOption[] = [];
+
   constructor(data?: PartialMessage<MongoDBSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -848,6 +948,7 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
   static readonly typeName = "mgmt.v1alpha1.MongoDBSourceConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collections", kind: "message", T: MongoDBSourceCollectionOption, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceConnectionOptions {
@@ -867,6 +968,133 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBSourceCollectionOption
+ */
+export class MongoDBSourceCollectionOption extends Message<MongoDBSourceCollectionOption> {
+  /**
+   * The database that the collection lives in
+   *
+   * @generated from field: string database = 1;
+   */
+  database = "";
+
+  /**
+   * The collection that this configuration will be applied to
+   *
+   * @generated from field: string collection = 2;
+   */
+  collection = "";
+
+  /**
+   * An optional filter document used to subset the collection. Must be a valid MongoDB Extended JSON document.
+   * Example: {"createdAt": {"$gte": {"$date": "2024-01-01T00:00:00Z"}}}
+   *
+   * @generated from field: optional string filter = 3;
+   */
+  filter?: string;
+
+  /**
+   * Optionally include (1) or exclude (0) fields from the returned documents
+   *
+   * @generated from field: map<string, int32> projection = 4;
+   */
+  projection: { [key: string]: number } = {};
+
+  /**
+   * Optionally sort the returned documents. The documents are sorted by each field in the order they are listed.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBSortField sort = 5;
+   */
+  sort: MongoDBSortField[] = [];
+
+  /**
+   * Optionally limit the number of documents returned from the collection
+   *
+   * @generated from field: optional int64 limit = 6;
+   */
+  limit?: bigint;
+
+  constructor(data?: PartialMessage<MongoDBSourceCollectionOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBSourceCollectionOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collection", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filter", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "projection", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 5 /* ScalarType.INT32 */} },
+    { no: 5, name: "sort", kind: "message", T: MongoDBSortField, repeated: true },
+    { no: 6, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBSourceCollectionOption | PlainMessage<MongoDBSourceCollectionOption> | undefined, b: MongoDBSourceCollectionOption | PlainMessage<MongoDBSourceCollectionOption> | undefined): boolean {
+    return proto3.util.equals(MongoDBSourceCollectionOption, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBSortField
+ */
+export class MongoDBSortField extends Message<MongoDBSortField> {
+  /**
+   * The field to sort the documents by
+   *
+   * @generated from field: string field = 1;
+   */
+  field = "";
+
+  /**
+   * The sort order of the field, ascending (1) or descending (-1)
+   *
+   * @generated from field: int32 direction = 2;
+   */
+  direction = 0;
+
+  constructor(data?: PartialMessage<MongoDBSortField>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBSortField";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "direction", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSortField {
+    return new MongoDBSortField().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBSortField {
+    return new MongoDBSortField().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBSortField {
+    return new MongoDBSortField().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBSortField | PlainMessage<MongoDBSortField> | undefined, b: MongoDBSortField | PlainMessage<MongoDBSortField> | undefined): boolean {
+    return proto3.util.equals(MongoDBSortField, a, b);
+  }
+}
+
 /**
  * DynamoDB connection options for a job source
  *
@@ -1561,6 +1789,22 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
      */
     value: MssqlDestinationConnectionOptions;
     case: "mssqlOptions";
+  } | {
+    /**
+     * Destination Connection options for ClickHouse
+     *
+     * @generated from field: mgmt.v1alpha1.ClickhouseDestinationConnectionOptions clickhouse_options = 8;
+     */
+    value: ClickhouseDestinationConnectionOptions;
+    case: "clickhouseOptions";
+  } | {
+    /**
+     * Destination Connection options for Kafka
+     *
+     * @generated from field: mgmt.v1alpha1.KafkaDestinationConnectionOptions kafka_options = 9;
+     */
+    value: KafkaDestinationConnectionOptions;
+    case: "kafkaOptions";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobDestinationOptions>) {
@@ -1578,6 +1822,8 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
     { no: 5, name: "gcp_cloudstorage_options", kind: "message", T: GcpCloudStorageDestinationConnectionOptions, oneof: "config" },
     { no: 6, name: "dynamodb_options", kind: "message", T: DynamoDBDestinationConnectionOptions, oneof: "config" },
     { no: 7, name: "mssql_options", kind: "message", T: MssqlDestinationConnectionOptions, oneof: "config" },
+    { no: 8, name: "clickhouse_options", kind: "message", T: ClickhouseDestinationConnectionOptions, oneof: "config" },
+    { no: 9, name: "kafka_options", kind: "message", T: KafkaDestinationConnectionOptions, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobDestinationOptions {
@@ -1601,6 +1847,27 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
  * @generated from message mgmt.v1alpha1.MongoDBDestinationConnectionOptions
  */
 export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinationConnectionOptions> {
+  /**
+   * Deletes all documents from each destination collection prior to inserting
+   *
+   * @generated from field: bool truncate_before_insert = 1;
+   */
+  truncateBeforeInsert = false;
+
+  /**
+   * Creates each destination collection with the indexes and validators of the source collection
+   *
+   * @generated from field: bool init_collection = 2;
+   */
+  initCollection = false;
+
+  /**
+   * Optionally override the key that documents are upserted on for a collection. Defaults to _id.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBDestinationUpsertKey upsert_keys = 3;
+   */
+  upsertKeys: MongoDBDestinationUpsertKey[] = [];
+
   constructor(data?: PartialMessage<MongoDBDestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1609,6 +1876,9 @@ export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinat
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.MongoDBDestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "init_collection", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "upsert_keys", kind: "message", T: MongoDBDestinationUpsertKey, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBDestinationConnectionOptions {
@@ -1628,6 +1898,61 @@ export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinat
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBDestinationUpsertKey
+ */
+export class MongoDBDestinationUpsertKey extends Message<MongoDBDestinationUpsertKey> {
+  /**
+   * The database that the collection lives in
+   *
+   * @generated from field: string database = 1;
+   */
+  database = "";
+
+  /**
+   * The collection that this upsert key will be applied to
+   *
+   * @generated from field: string collection = 2;
+   */
+  collection = "";
+
+  /**
+   * The document fields that uniquely identify a document in the collection
+   *
+   * @generated from field: repeated string fields = 3;
+   */
+  fields: string[] = [];
+
+  constructor(data?: PartialMessage<MongoDBDestinationUpsertKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBDestinationUpsertKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collection", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBDestinationUpsertKey {
+    return new MongoDBDestinationUpsertKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBDestinationUpsertKey {
+    return new MongoDBDestinationUpsertKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBDestinationUpsertKey {
+    return new MongoDBDestinationUpsertKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBDestinationUpsertKey | PlainMessage<MongoDBDestinationUpsertKey> | undefined, b: MongoDBDestinationUpsertKey | PlainMessage<MongoDBDestinationUpsertKey> | undefined): boolean {
+    return proto3.util.equals(MongoDBDestinationUpsertKey, a, b);
+  }
+}
+
 /**
  * Configuration for Google Cloud Storage Destination Connection Job Options
  *
@@ -1674,6 +1999,27 @@ export class DynamoDBDestinationConnectionOptions extends Message<DynamoDBDestin
    */
   tableMappings: DynamoDBDestinationTableMapping[] = [];
 
+  /**
+   * Determines how items are written when an item with the same key already exists in the destination table
+   *
+   * @generated from field: mgmt.v1alpha1.DynamoDBDestinationWriteMode write_mode = 2;
+   */
+  writeMode = DynamoDBDestinationWriteMode.DYNAMO_DB_DESTINATION_WRITE_MODE_UNSPECIFIED;
+
+  /**
+   * Deletes all items from each destination table prior to inserting
+   *
+   * @generated from field: bool truncate_before_insert = 3;
+   */
+  truncateBeforeInsert = false;
+
+  /**
+   * Creates any destination table that does not exist with the key schema, secondary indexes and billing mode of the source table
+   *
+   * @generated from field: bool init_table = 4;
+   */
+  initTable = false;
+
   constructor(data?: PartialMessage<DynamoDBDestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1683,6 +2029,9 @@ export class DynamoDBDestinationConnectionOptions extends Message<DynamoDBDestin
   static readonly typeName = "mgmt.v1alpha1.DynamoDBDestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table_mappings", kind: "message", T: DynamoDBDestinationTableMapping, repeated: true },
+    { no: 2, name: "write_mode", kind: "enum", T: proto3.getEnumType(DynamoDBDestinationWriteMode) },
+    { no: 3, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "init_table", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DynamoDBDestinationConnectionOptions {
@@ -2133,56 +2482,271 @@ export class MssqlOnConflictConfig extends Message<MssqlOnConflictConfig> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.AwsS3DestinationConnectionOptions
+ * Configuration for ClickHouse Destination Connection Job Options
+ *
+ * @generated from message mgmt.v1alpha1.ClickhouseDestinationConnectionOptions
  */
-export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationConnectionOptions> {
-  constructor(data?: PartialMessage<AwsS3DestinationConnectionOptions>) {
+export class ClickhouseDestinationConnectionOptions extends Message<ClickhouseDestinationConnectionOptions> {
+  /**
+   * @generated from field: mgmt.v1alpha1.ClickhouseTruncateTableConfig truncate_table = 1;
+   */
+  truncateTable?: ClickhouseTruncateTableConfig;
+
+  /**
+   * Creates the destination tables if they do not exist, deriving the DDL from the source column metadata
+   *
+   * @generated from field: bool init_table_schema = 2;
+   */
+  initTableSchema = false;
+
+  /**
+   * The table engine used when initializing the table schema. Defaults to MergeTree
+   *
+   * @generated from field: optional string table_engine = 3;
+   */
+  tableEngine?: string;
+
+  /**
+   * The ORDER BY columns used when initializing the table schema, applied to every table.
+   * If not provided, the source table's primary key columns are used
+   *
+   * @generated from field: repeated string order_by = 4;
+   */
+  orderBy: string[] = [];
+
+  /**
+   * Optional PARTITION BY expression used when initializing the table schema
+   *
+   * @generated from field: optional string partition_by = 5;
+   */
+  partitionBy?: string;
+
+  constructor(data?: PartialMessage<ClickhouseDestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.AwsS3DestinationConnectionOptions";
+  static readonly typeName = "mgmt.v1alpha1.ClickhouseDestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_table", kind: "message", T: ClickhouseTruncateTableConfig },
+    { no: 2, name: "init_table_schema", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "table_engine", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "order_by", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "partition_by", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3DestinationConnectionOptions {
-    return new AwsS3DestinationConnectionOptions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClickhouseDestinationConnectionOptions {
+    return new ClickhouseDestinationConnectionOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AwsS3DestinationConnectionOptions {
-    return new AwsS3DestinationConnectionOptions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClickhouseDestinationConnectionOptions {
+    return new ClickhouseDestinationConnectionOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AwsS3DestinationConnectionOptions {
-    return new AwsS3DestinationConnectionOptions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClickhouseDestinationConnectionOptions {
+    return new ClickhouseDestinationConnectionOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AwsS3DestinationConnectionOptions | PlainMessage<AwsS3DestinationConnectionOptions> | undefined, b: AwsS3DestinationConnectionOptions | PlainMessage<AwsS3DestinationConnectionOptions> | undefined): boolean {
-    return proto3.util.equals(AwsS3DestinationConnectionOptions, a, b);
+  static equals(a: ClickhouseDestinationConnectionOptions | PlainMessage<ClickhouseDestinationConnectionOptions> | undefined, b: ClickhouseDestinationConnectionOptions | PlainMessage<ClickhouseDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(ClickhouseDestinationConnectionOptions, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.CreateJobRequest
+ * @generated from message mgmt.v1alpha1.ClickhouseTruncateTableConfig
  */
-export class CreateJobRequest extends Message<CreateJobRequest> {
+export class ClickhouseTruncateTableConfig extends Message<ClickhouseTruncateTableConfig> {
   /**
-   * The unique account identifier that this job will be associated with
-   *
-   * @generated from field: string account_id = 1;
+   * @generated from field: bool truncate_before_insert = 1;
    */
-  accountId = "";
+  truncateBeforeInsert = false;
+
+  constructor(data?: PartialMessage<ClickhouseTruncateTableConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ClickhouseTruncateTableConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClickhouseTruncateTableConfig {
+    return new ClickhouseTruncateTableConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClickhouseTruncateTableConfig {
+    return new ClickhouseTruncateTableConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClickhouseTruncateTableConfig {
+    return new ClickhouseTruncateTableConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClickhouseTruncateTableConfig | PlainMessage<ClickhouseTruncateTableConfig> | undefined, b: ClickhouseTruncateTableConfig | PlainMessage<ClickhouseTruncateTableConfig> | undefined): boolean {
+    return proto3.util.equals(ClickhouseTruncateTableConfig, a, b);
+  }
+}
 
+/**
+ * @generated from message mgmt.v1alpha1.KafkaDestinationConnectionOptions
+ */
+export class KafkaDestinationConnectionOptions extends Message<KafkaDestinationConnectionOptions> {
   /**
-   * The unique, friendly name of the job. This is unique per account
+   * The topic that records are published to. The {schema} and {table} placeholders are replaced with the source table's schema and name.
+   * Defaults to {schema}.{table}, which results in one topic per table
    *
-   * @generated from field: string job_name = 2;
+   * @generated from field: optional string topic = 1;
    */
-  jobName = "";
+  topic?: string;
 
   /**
-   * Optionally provide a cron schedule. Goes into effect if the job status is set to enabled
+   * Optionally configure which columns make up the record key per table.
+   * If a table is not configured, its primary key columns are used. Tables without a primary key are published without a key.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.KafkaTableKeyColumns key_columns = 2;
+   */
+  keyColumns: KafkaTableKeyColumns[] = [];
+
+  /**
+   * The format the record value is serialized with. Defaults to JSON
+   *
+   * @generated from field: mgmt.v1alpha1.KafkaSerializationFormat format = 3;
+   */
+  format = KafkaSerializationFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<KafkaDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "topic", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "key_columns", kind: "message", T: KafkaTableKeyColumns, repeated: true },
+    { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(KafkaSerializationFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaDestinationConnectionOptions | PlainMessage<KafkaDestinationConnectionOptions> | undefined, b: KafkaDestinationConnectionOptions | PlainMessage<KafkaDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(KafkaDestinationConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.KafkaTableKeyColumns
+ */
+export class KafkaTableKeyColumns extends Message<KafkaTableKeyColumns> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: repeated string columns = 3;
+   */
+  columns: string[] = [];
+
+  constructor(data?: PartialMessage<KafkaTableKeyColumns>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaTableKeyColumns";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaTableKeyColumns {
+    return new KafkaTableKeyColumns().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaTableKeyColumns {
+    return new KafkaTableKeyColumns().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaTableKeyColumns {
+    return new KafkaTableKeyColumns().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaTableKeyColumns | PlainMessage<KafkaTableKeyColumns> | undefined, b: KafkaTableKeyColumns | PlainMessage<KafkaTableKeyColumns> | undefined): boolean {
+    return proto3.util.equals(KafkaTableKeyColumns, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.AwsS3DestinationConnectionOptions
+ */
+export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationConnectionOptions> {
+  constructor(data?: PartialMessage<AwsS3DestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AwsS3DestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3DestinationConnectionOptions {
+    return new AwsS3DestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AwsS3DestinationConnectionOptions {
+    return new AwsS3DestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AwsS3DestinationConnectionOptions {
+    return new AwsS3DestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AwsS3DestinationConnectionOptions | PlainMessage<AwsS3DestinationConnectionOptions> | undefined, b: AwsS3DestinationConnectionOptions | PlainMessage<AwsS3DestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(AwsS3DestinationConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateJobRequest
+ */
+export class CreateJobRequest extends Message<CreateJobRequest> {
+  /**
+   * The unique account identifier that this job will be associated with
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * The unique, friendly name of the job. This is unique per account
+   *
+   * @generated from field: string job_name = 2;
+   */
+  jobName = "";
+
+  /**
+   * Optionally provide a cron schedule. Goes into effect if the job status is set to enabled
    *
    * @generated from field: optional string cron_schedule = 3;
    */
@@ -3030,6 +3594,43 @@ export class MssqlSourceSchemaSubset extends Message<MssqlSourceSchemaSubset> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBSourceSchemaSubset
+ */
+export class MongoDBSourceSchemaSubset extends Message<MongoDBSourceSchemaSubset> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBSourceCollectionOption collections = 1;
+   */
+  collections: MongoDBSourceCollectionOption[] = [];
+
+  constructor(data?: PartialMessage<MongoDBSourceSchemaSubset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBSourceSchemaSubset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "collections", kind: "message", T: MongoDBSourceCollectionOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBSourceSchemaSubset | PlainMessage<MongoDBSourceSchemaSubset> | undefined, b: MongoDBSourceSchemaSubset | PlainMessage<MongoDBSourceSchemaSubset> | undefined): boolean {
+    return proto3.util.equals(MongoDBSourceSchemaSubset, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.JobSourceSqlSubetSchemas
  */
@@ -3061,6 +3662,12 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
      */
     value: MssqlSourceSchemaSubset;
     case: "mssqlSubset";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MongoDBSourceSchemaSubset mongodb_subset = 6;
+     */
+    value: MongoDBSourceSchemaSubset;
+    case: "mongodbSubset";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobSourceSqlSubetSchemas>) {
@@ -3075,6 +3682,7 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
     { no: 3, name: "mysql_subset", kind: "message", T: MysqlSourceSchemaSubset, oneof: "schemas" },
     { no: 4, name: "dynamodb_subset", kind: "message", T: DynamoDBSourceSchemaSubset, oneof: "schemas" },
     { no: 5, name: "mssql_subset", kind: "message", T: MssqlSourceSchemaSubset, oneof: "schemas" },
+    { no: 6, name: "mongodb_subset", kind: "message", T: MongoDBSourceSchemaSubset, oneof: "schemas" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSourceSqlSubetSchemas {
@@ -3595,6 +4203,41 @@ export class GetJobRunsRequest extends Message<GetJobRunsRequest> {
     case: "accountId";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * Only return runs that have one of the provided statuses
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunStatus statuses = 3;
+   */
+  statuses: JobRunStatus[] = [];
+
+  /**
+   * Only return runs that started at or after this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp started_after = 4;
+   */
+  startedAfter?: Timestamp;
+
+  /**
+   * Only return runs that started before this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp started_before = 5;
+   */
+  startedBefore?: Timestamp;
+
+  /**
+   * The maximum number of runs to return. Defaults to 50 if not provided.
+   *
+   * @generated from field: uint32 page_size = 6;
+   */
+  pageSize = 0;
+
+  /**
+   * The page token returned by a previous call to continue paginating
+   *
+   * @generated from field: optional string page_token = 7;
+   */
+  pageToken?: string;
+
   constructor(data?: PartialMessage<GetJobRunsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3605,6 +4248,11 @@ export class GetJobRunsRequest extends Message<GetJobRunsRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 3, name: "statuses", kind: "enum", T: proto3.getEnumType(JobRunStatus), repeated: true },
+    { no: 4, name: "started_after", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "started_before", kind: "message", T: Timestamp, opt: true },
+    { no: 6, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunsRequest {
@@ -3629,10 +4277,19 @@ export class GetJobRunsRequest extends Message<GetJobRunsRequest> {
  */
 export class GetJobRunsResponse extends Message<GetJobRunsResponse> {
   /**
+   * The job runs, ordered from most recently started to least recently started
+   *
    * @generated from field: repeated mgmt.v1alpha1.JobRun job_runs = 1;
    */
   jobRuns: JobRun[] = [];
 
+  /**
+   * The token to retrieve the next page. Unset if there are no more runs.
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+
   constructor(data?: PartialMessage<GetJobRunsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3642,6 +4299,7 @@ export class GetJobRunsResponse extends Message<GetJobRunsResponse> {
   static readonly typeName = "mgmt.v1alpha1.GetJobRunsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job_runs", kind: "message", T: JobRun, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunsResponse {
@@ -3750,6 +4408,14 @@ export class CreateJobRunRequest extends Message<CreateJobRunRequest> {
    */
   jobId = "";
 
+  /**
+   * Overrides parts of the job definition for this run only. The stored job is left unchanged.
+   * Requires the job editor role, and is rejected while another run of the job is in progress.
+   *
+   * @generated from field: optional mgmt.v1alpha1.JobRunOverrides overrides = 2;
+   */
+  overrides?: JobRunOverrides;
+
   constructor(data?: PartialMessage<CreateJobRunRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3759,6 +4425,7 @@ export class CreateJobRunRequest extends Message<CreateJobRunRequest> {
   static readonly typeName = "mgmt.v1alpha1.CreateJobRunRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "overrides", kind: "message", T: JobRunOverrides, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateJobRunRequest {
@@ -3779,122 +4446,295 @@ export class CreateJobRunRequest extends Message<CreateJobRunRequest> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.CreateJobRunResponse
+ * Changes to the job definition that only apply to a single job run
+ *
+ * @generated from message mgmt.v1alpha1.JobRunOverrides
  */
-export class CreateJobRunResponse extends Message<CreateJobRunResponse> {
-  constructor(data?: PartialMessage<CreateJobRunResponse>) {
+export class JobRunOverrides extends Message<JobRunOverrides> {
+  /**
+   * Overrides the options of individual source tables
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunTableOverride tables = 1;
+   */
+  tables: JobRunTableOverride[] = [];
+
+  /**
+   * Overrides the connection or options of existing job destinations
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunDestinationOverride destinations = 2;
+   */
+  destinations: JobRunDestinationOverride[] = [];
+
+  constructor(data?: PartialMessage<JobRunOverrides>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.CreateJobRunResponse";
+  static readonly typeName = "mgmt.v1alpha1.JobRunOverrides";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tables", kind: "message", T: JobRunTableOverride, repeated: true },
+    { no: 2, name: "destinations", kind: "message", T: JobRunDestinationOverride, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateJobRunResponse {
-    return new CreateJobRunResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunOverrides {
+    return new JobRunOverrides().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateJobRunResponse {
-    return new CreateJobRunResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunOverrides {
+    return new JobRunOverrides().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateJobRunResponse {
-    return new CreateJobRunResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunOverrides {
+    return new JobRunOverrides().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateJobRunResponse | PlainMessage<CreateJobRunResponse> | undefined, b: CreateJobRunResponse | PlainMessage<CreateJobRunResponse> | undefined): boolean {
-    return proto3.util.equals(CreateJobRunResponse, a, b);
+  static equals(a: JobRunOverrides | PlainMessage<JobRunOverrides> | undefined, b: JobRunOverrides | PlainMessage<JobRunOverrides> | undefined): boolean {
+    return proto3.util.equals(JobRunOverrides, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.CancelJobRunRequest
+ * @generated from message mgmt.v1alpha1.JobRunTableOverride
  */
-export class CancelJobRunRequest extends Message<CancelJobRunRequest> {
+export class JobRunTableOverride extends Message<JobRunTableOverride> {
   /**
-   * @generated from field: string job_run_id = 1;
+   * @generated from field: string schema = 1;
    */
-  jobRunId = "";
+  schema = "";
 
   /**
-   * @generated from field: string account_id = 2;
+   * @generated from field: string table = 2;
    */
-  accountId = "";
+  table = "";
 
-  constructor(data?: PartialMessage<CancelJobRunRequest>) {
+  /**
+   * Replaces the where clause of the table. An empty string removes the where clause.
+   * Only applies to Postgres, Mysql and Mssql sources.
+   *
+   * @generated from field: optional string where_clause = 3;
+   */
+  whereClause?: string;
+
+  /**
+   * Replaces the number of rows that are generated for the table. Only applies to generate sources.
+   *
+   * @generated from field: optional int64 row_count = 4;
+   */
+  rowCount?: bigint;
+
+  constructor(data?: PartialMessage<JobRunTableOverride>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.CancelJobRunRequest";
+  static readonly typeName = "mgmt.v1alpha1.JobRunTableOverride";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobRunRequest {
-    return new CancelJobRunRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunTableOverride {
+    return new JobRunTableOverride().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobRunRequest {
-    return new CancelJobRunRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunTableOverride {
+    return new JobRunTableOverride().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobRunRequest {
-    return new CancelJobRunRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunTableOverride {
+    return new JobRunTableOverride().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CancelJobRunRequest | PlainMessage<CancelJobRunRequest> | undefined, b: CancelJobRunRequest | PlainMessage<CancelJobRunRequest> | undefined): boolean {
-    return proto3.util.equals(CancelJobRunRequest, a, b);
+  static equals(a: JobRunTableOverride | PlainMessage<JobRunTableOverride> | undefined, b: JobRunTableOverride | PlainMessage<JobRunTableOverride> | undefined): boolean {
+    return proto3.util.equals(JobRunTableOverride, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.CancelJobRunResponse
+ * @generated from message mgmt.v1alpha1.JobRunDestinationOverride
  */
-export class CancelJobRunResponse extends Message<CancelJobRunResponse> {
-  constructor(data?: PartialMessage<CancelJobRunResponse>) {
+export class JobRunDestinationOverride extends Message<JobRunDestinationOverride> {
+  /**
+   * The id of the job destination that is overridden
+   *
+   * @generated from field: string destination_id = 1;
+   */
+  destinationId = "";
+
+  /**
+   * Writes to this connection instead of the connection of the job destination
+   *
+   * @generated from field: optional string connection_id = 2;
+   */
+  connectionId?: string;
+
+  /**
+   * Replaces the options of the job destination, such as truncate and on conflict options
+   *
+   * @generated from field: optional mgmt.v1alpha1.JobDestinationOptions options = 3;
+   */
+  options?: JobDestinationOptions;
+
+  constructor(data?: PartialMessage<JobRunDestinationOverride>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.CancelJobRunResponse";
+  static readonly typeName = "mgmt.v1alpha1.JobRunDestinationOverride";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "destination_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "options", kind: "message", T: JobDestinationOptions, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobRunResponse {
-    return new CancelJobRunResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunDestinationOverride {
+    return new JobRunDestinationOverride().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobRunResponse {
-    return new CancelJobRunResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunDestinationOverride {
+    return new JobRunDestinationOverride().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobRunResponse {
-    return new CancelJobRunResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunDestinationOverride {
+    return new JobRunDestinationOverride().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CancelJobRunResponse | PlainMessage<CancelJobRunResponse> | undefined, b: CancelJobRunResponse | PlainMessage<CancelJobRunResponse> | undefined): boolean {
-    return proto3.util.equals(CancelJobRunResponse, a, b);
+  static equals(a: JobRunDestinationOverride | PlainMessage<JobRunDestinationOverride> | undefined, b: JobRunDestinationOverride | PlainMessage<JobRunDestinationOverride> | undefined): boolean {
+    return proto3.util.equals(JobRunDestinationOverride, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.Job
+ * @generated from message mgmt.v1alpha1.CreateJobRunResponse
  */
-export class Job extends Message<Job> {
+export class CreateJobRunResponse extends Message<CreateJobRunResponse> {
   /**
-   * The unique identifier of the job
+   * The id of the job run that was started.
+   * Unset if the run could not be found after the job was triggered.
    *
-   * @generated from field: string id = 1;
+   * @generated from field: optional string job_run_id = 1;
    */
-  id = "";
+  jobRunId?: string;
 
-  /**
+  constructor(data?: PartialMessage<CreateJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateJobRunResponse {
+    return new CreateJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateJobRunResponse {
+    return new CreateJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateJobRunResponse {
+    return new CreateJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateJobRunResponse | PlainMessage<CreateJobRunResponse> | undefined, b: CreateJobRunResponse | PlainMessage<CreateJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(CreateJobRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CancelJobRunRequest
+ */
+export class CancelJobRunRequest extends Message<CancelJobRunRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<CancelJobRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CancelJobRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobRunRequest {
+    return new CancelJobRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobRunRequest {
+    return new CancelJobRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobRunRequest {
+    return new CancelJobRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelJobRunRequest | PlainMessage<CancelJobRunRequest> | undefined, b: CancelJobRunRequest | PlainMessage<CancelJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(CancelJobRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CancelJobRunResponse
+ */
+export class CancelJobRunResponse extends Message<CancelJobRunResponse> {
+  constructor(data?: PartialMessage<CancelJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CancelJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelJobRunResponse {
+    return new CancelJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelJobRunResponse {
+    return new CancelJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelJobRunResponse {
+    return new CancelJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelJobRunResponse | PlainMessage<CancelJobRunResponse> | undefined, b: CancelJobRunResponse | PlainMessage<CancelJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(CancelJobRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.Job
+ */
+export class Job extends Message<Job> {
+  /**
+   * The unique identifier of the job
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
    * @generated from field: string created_by_user_id = 2;
    */
   createdByUserId = "";
@@ -4568,6 +5408,34 @@ export class JobRun extends Message<JobRun> {
    */
   pendingActivities: PendingActivity[] = [];
 
+  /**
+   * The number of rows that were synced for each table. Only available once the run has been recorded as complete
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunTableSummary tables = 9;
+   */
+  tables: JobRunTableSummary[] = [];
+
+  /**
+   * A summary of the error that caused the run to fail, if any
+   *
+   * @generated from field: optional string error_message = 10;
+   */
+  errorMessage?: string;
+
+  /**
+   * The id of the user that manually triggered the run. Unset if the run was started by the job schedule
+   *
+   * @generated from field: optional string triggered_by = 11;
+   */
+  triggeredBy?: string;
+
+  /**
+   * The overrides that the run was triggered with, if any
+   *
+   * @generated from field: optional mgmt.v1alpha1.JobRunOverrides overrides = 12;
+   */
+  overrides?: JobRunOverrides;
+
   constructor(data?: PartialMessage<JobRun>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4583,6 +5451,10 @@ export class JobRun extends Message<JobRun> {
     { no: 6, name: "started_at", kind: "message", T: Timestamp },
     { no: 7, name: "completed_at", kind: "message", T: Timestamp, opt: true },
     { no: 8, name: "pending_activities", kind: "message", T: PendingActivity, repeated: true },
+    { no: 9, name: "tables", kind: "message", T: JobRunTableSummary, repeated: true },
+    { no: 10, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "triggered_by", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "overrides", kind: "message", T: JobRunOverrides, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRun {
@@ -4602,6 +5474,73 @@ export class JobRun extends Message<JobRun> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.JobRunTableSummary
+ */
+export class JobRunTableSummary extends Message<JobRunTableSummary> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * The number of rows that were read from the source
+   *
+   * @generated from field: int64 rows_read = 3;
+   */
+  rowsRead = protoInt64.zero;
+
+  /**
+   * The number of rows that were written to the destinations
+   *
+   * @generated from field: int64 rows_written = 4;
+   */
+  rowsWritten = protoInt64.zero;
+
+  /**
+   * The number of rows that failed to be written
+   *
+   * @generated from field: int64 rows_errored = 5;
+   */
+  rowsErrored = protoInt64.zero;
+
+  constructor(data?: PartialMessage<JobRunTableSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunTableSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rows_read", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "rows_written", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "rows_errored", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunTableSummary {
+    return new JobRunTableSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunTableSummary {
+    return new JobRunTableSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunTableSummary {
+    return new JobRunTableSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunTableSummary | PlainMessage<JobRunTableSummary> | undefined, b: JobRunTableSummary | PlainMessage<JobRunTableSummary> | undefined): boolean {
+    return proto3.util.equals(JobRunTableSummary, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.JobRunEventTaskError
  */
@@ -4786,6 +5725,101 @@ export class JobRunEventMetadata extends Message<JobRunEventMetadata> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.JobRunEventProgress
+ */
+export class JobRunEventProgress extends Message<JobRunEventProgress> {
+  /**
+   * The number of rows that have been read from the source
+   *
+   * @generated from field: int64 rows_read = 1;
+   */
+  rowsRead = protoInt64.zero;
+
+  /**
+   * The number of rows that have been written. Rows written to multiple destinations are only counted once
+   *
+   * @generated from field: int64 rows_written = 2;
+   */
+  rowsWritten = protoInt64.zero;
+
+  /**
+   * The number of rows that failed to be written
+   *
+   * @generated from field: int64 rows_errored = 3;
+   */
+  rowsErrored = protoInt64.zero;
+
+  /**
+   * The approximate number of bytes that have been read. Only reported for SQL sources
+   *
+   * @generated from field: int64 bytes_processed = 4;
+   */
+  bytesProcessed = protoInt64.zero;
+
+  /**
+   * The total number of rows in the source table, if it could be determined. Only set while the activity is running
+   *
+   * @generated from field: optional int64 total_rows = 5;
+   */
+  totalRows?: bigint;
+
+  /**
+   * The average number of rows written per second since the activity started
+   *
+   * @generated from field: double rows_per_second = 6;
+   */
+  rowsPerSecond = 0;
+
+  /**
+   * The estimated time the activity will complete at. Only set while the activity is running and the total rows are known
+   *
+   * @generated from field: google.protobuf.Timestamp estimated_completion_time = 7;
+   */
+  estimatedCompletionTime?: Timestamp;
+
+  /**
+   * The last time the progress was reported by the worker
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<JobRunEventProgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunEventProgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rows_read", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "rows_written", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "rows_errored", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "bytes_processed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "total_rows", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 6, name: "rows_per_second", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "estimated_completion_time", kind: "message", T: Timestamp },
+    { no: 8, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventProgress {
+    return new JobRunEventProgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventProgress {
+    return new JobRunEventProgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventProgress {
+    return new JobRunEventProgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunEventProgress | PlainMessage<JobRunEventProgress> | undefined, b: JobRunEventProgress | PlainMessage<JobRunEventProgress> | undefined): boolean {
+    return proto3.util.equals(JobRunEventProgress, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.JobRunEvent
  */
@@ -4820,6 +5854,13 @@ export class JobRunEvent extends Message<JobRunEvent> {
    */
   tasks: JobRunEventTask[] = [];
 
+  /**
+   * The progress of the activity, if it reports any
+   *
+   * @generated from field: mgmt.v1alpha1.JobRunEventProgress progress = 7;
+   */
+  progress?: JobRunEventProgress;
+
   constructor(data?: PartialMessage<JobRunEvent>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4834,6 +5875,7 @@ export class JobRunEvent extends Message<JobRunEvent> {
     { no: 4, name: "close_time", kind: "message", T: Timestamp },
     { no: 5, name: "metadata", kind: "message", T: JobRunEventMetadata },
     { no: 6, name: "tasks", kind: "message", T: JobRunEventTask, repeated: true },
+    { no: 7, name: "progress", kind: "message", T: JobRunEventProgress },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEvent {
@@ -4940,9 +5982,9 @@ export class GetJobRunEventsResponse extends Message<GetJobRunEventsResponse> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DeleteJobRunRequest
+ * @generated from message mgmt.v1alpha1.WatchJobRunRequest
  */
-export class DeleteJobRunRequest extends Message<DeleteJobRunRequest> {
+export class WatchJobRunRequest extends Message<WatchJobRunRequest> {
   /**
    * @generated from field: string job_run_id = 1;
    */
@@ -4953,48 +5995,136 @@ export class DeleteJobRunRequest extends Message<DeleteJobRunRequest> {
    */
   accountId = "";
 
-  constructor(data?: PartialMessage<DeleteJobRunRequest>) {
+  constructor(data?: PartialMessage<WatchJobRunRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunRequest";
+  static readonly typeName = "mgmt.v1alpha1.WatchJobRunRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchJobRunRequest {
+    return new WatchJobRunRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchJobRunRequest {
+    return new WatchJobRunRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchJobRunRequest {
+    return new WatchJobRunRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined, b: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteJobRunRequest, a, b);
+  static equals(a: WatchJobRunRequest | PlainMessage<WatchJobRunRequest> | undefined, b: WatchJobRunRequest | PlainMessage<WatchJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(WatchJobRunRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DeleteJobRunResponse
+ * @generated from message mgmt.v1alpha1.WatchJobRunResponse
  */
-export class DeleteJobRunResponse extends Message<DeleteJobRunResponse> {
-  constructor(data?: PartialMessage<DeleteJobRunResponse>) {
+export class WatchJobRunResponse extends Message<WatchJobRunResponse> {
+  /**
+   * All of the events of the job run, along with their current progress
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunEvent events = 1;
+   */
+  events: JobRunEvent[] = [];
+
+  /**
+   * @generated from field: bool is_run_complete = 2;
+   */
+  isRunComplete = false;
+
+  constructor(data?: PartialMessage<WatchJobRunResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunResponse";
+  static readonly typeName = "mgmt.v1alpha1.WatchJobRunResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-  ]);
+    { no: 1, name: "events", kind: "message", T: JobRunEvent, repeated: true },
+    { no: 2, name: "is_run_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchJobRunResponse {
+    return new WatchJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchJobRunResponse {
+    return new WatchJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchJobRunResponse {
+    return new WatchJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchJobRunResponse | PlainMessage<WatchJobRunResponse> | undefined, b: WatchJobRunResponse | PlainMessage<WatchJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(WatchJobRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteJobRunRequest
+ */
+export class DeleteJobRunRequest extends Message<DeleteJobRunRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<DeleteJobRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined, b: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteJobRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteJobRunResponse
+ */
+export class DeleteJobRunResponse extends Message<DeleteJobRunResponse> {
+  constructor(data?: PartialMessage<DeleteJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunResponse {
     return new DeleteJobRunResponse().fromBinary(bytes, options);
@@ -5109,7 +6239,7 @@ export class GetJobRunLogsStreamRequest extends Message<GetJobRunLogsStreamReque
   window = LogWindow.NO_TIME_UNSPECIFIED;
 
   /**
-   * Whether or not to tail the stream. Note: only works with k8s-pods and is not currently supported with Loki logs
+   * Whether or not to tail the stream. Note: only works with k8s-pods and database run logs and is not currently supported with Loki logs
    *
    * @generated from field: bool should_tail = 4;
    */
@@ -5205,6 +6335,277 @@ export class GetJobRunLogsStreamResponse extends Message<GetJobRunLogsStreamResp
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.JobRunLogEntry
+ */
+export class JobRunLogEntry extends Message<JobRunLogEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.LogLevel level = 2;
+   */
+  level = LogLevel.UNSPECIFIED;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * The name of the activity that emitted the log
+   *
+   * @generated from field: optional string activity = 4;
+   */
+  activity?: string;
+
+  /**
+   * The schema and table the activity was processing, if any
+   *
+   * @generated from field: optional string schema = 5;
+   */
+  schema?: string;
+
+  /**
+   * @generated from field: optional string table = 6;
+   */
+  table?: string;
+
+  /**
+   * Any other structured attributes of the log line
+   *
+   * @generated from field: map<string, string> attributes = 7;
+   */
+  attributes: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<JobRunLogEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunLogEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "level", kind: "enum", T: proto3.getEnumType(LogLevel) },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "activity", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunLogEntry {
+    return new JobRunLogEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunLogEntry {
+    return new JobRunLogEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunLogEntry {
+    return new JobRunLogEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunLogEntry | PlainMessage<JobRunLogEntry> | undefined, b: JobRunLogEntry | PlainMessage<JobRunLogEntry> | undefined): boolean {
+    return proto3.util.equals(JobRunLogEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.IngestJobRunLogsRequest
+ */
+export class IngestJobRunLogsRequest extends Message<IngestJobRunLogsRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobRunLogEntry entries = 3;
+   */
+  entries: JobRunLogEntry[] = [];
+
+  constructor(data?: PartialMessage<IngestJobRunLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.IngestJobRunLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "entries", kind: "message", T: JobRunLogEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestJobRunLogsRequest {
+    return new IngestJobRunLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestJobRunLogsRequest {
+    return new IngestJobRunLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestJobRunLogsRequest {
+    return new IngestJobRunLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestJobRunLogsRequest | PlainMessage<IngestJobRunLogsRequest> | undefined, b: IngestJobRunLogsRequest | PlainMessage<IngestJobRunLogsRequest> | undefined): boolean {
+    return proto3.util.equals(IngestJobRunLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.IngestJobRunLogsResponse
+ */
+export class IngestJobRunLogsResponse extends Message<IngestJobRunLogsResponse> {
+  constructor(data?: PartialMessage<IngestJobRunLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.IngestJobRunLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestJobRunLogsResponse {
+    return new IngestJobRunLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestJobRunLogsResponse {
+    return new IngestJobRunLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestJobRunLogsResponse {
+    return new IngestJobRunLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestJobRunLogsResponse | PlainMessage<IngestJobRunLogsResponse> | undefined, b: IngestJobRunLogsResponse | PlainMessage<IngestJobRunLogsResponse> | undefined): boolean {
+    return proto3.util.equals(IngestJobRunLogsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.RecordJobRunRequest
+ */
+export class RecordJobRunRequest extends Message<RecordJobRunRequest> {
+  /**
+   * The id of the job run. This is equivalent to the temporal workflow id
+   *
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string job_id = 2;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunStatus status = 3;
+   */
+  status = JobRunStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * Set once the run has finished
+   *
+   * @generated from field: optional google.protobuf.Timestamp completed_at = 5;
+   */
+  completedAt?: Timestamp;
+
+  /**
+   * The number of rows that were synced for each table
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunTableSummary tables = 6;
+   */
+  tables: JobRunTableSummary[] = [];
+
+  /**
+   * A summary of the error that caused the run to fail
+   *
+   * @generated from field: optional string error_message = 7;
+   */
+  errorMessage?: string;
+
+  constructor(data?: PartialMessage<RecordJobRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.RecordJobRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(JobRunStatus) },
+    { no: 4, name: "started_at", kind: "message", T: Timestamp },
+    { no: 5, name: "completed_at", kind: "message", T: Timestamp, opt: true },
+    { no: 6, name: "tables", kind: "message", T: JobRunTableSummary, repeated: true },
+    { no: 7, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecordJobRunRequest {
+    return new RecordJobRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecordJobRunRequest {
+    return new RecordJobRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecordJobRunRequest {
+    return new RecordJobRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecordJobRunRequest | PlainMessage<RecordJobRunRequest> | undefined, b: RecordJobRunRequest | PlainMessage<RecordJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(RecordJobRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.RecordJobRunResponse
+ */
+export class RecordJobRunResponse extends Message<RecordJobRunResponse> {
+  constructor(data?: PartialMessage<RecordJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.RecordJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecordJobRunResponse {
+    return new RecordJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecordJobRunResponse {
+    return new RecordJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecordJobRunResponse {
+    return new RecordJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecordJobRunResponse | PlainMessage<RecordJobRunResponse> | undefined, b: RecordJobRunResponse | PlainMessage<RecordJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(RecordJobRunResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.SetJobWorkflowOptionsRequest
  */
@@ -5399,6 +6800,13 @@ export class ValidateJobMappingsRequest extends Message<ValidateJobMappingsReque
    */
   virtualForeignKeys: VirtualForeignConstraint[] = [];
 
+  /**
+   * The connections the job syncs to. Used to evaluate job mapping policies that only apply to non-production destinations
+   *
+   * @generated from field: repeated string destination_connection_ids = 5;
+   */
+  destinationConnectionIds: string[] = [];
+
   constructor(data?: PartialMessage<ValidateJobMappingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -5411,6 +6819,7 @@ export class ValidateJobMappingsRequest extends Message<ValidateJobMappingsReque
     { no: 2, name: "mappings", kind: "message", T: JobMapping, repeated: true },
     { no: 3, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "virtual_foreign_keys", kind: "message", T: VirtualForeignConstraint, repeated: true },
+    { no: 5, name: "destination_connection_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateJobMappingsRequest {
@@ -5669,6 +7078,276 @@ export class VirtualForeignConstraint extends Message<VirtualForeignConstraint>
   }
 }
 
+/**
+ * The account's policies that job mappings must satisfy.
+ * Policies are evaluated by ValidateJobMappings and enforced when jobs, their mappings or their destinations are created or updated.
+ *
+ * @generated from message mgmt.v1alpha1.JobMappingPolicyConfig
+ */
+export class JobMappingPolicyConfig extends Message<JobMappingPolicyConfig> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMappingPolicy policies = 1;
+   */
+  policies: JobMappingPolicy[] = [];
+
+  /**
+   * Connections that hold non-production data, e.g. development or staging databases
+   *
+   * @generated from field: repeated string non_production_connection_ids = 2;
+   */
+  nonProductionConnectionIds: string[] = [];
+
+  constructor(data?: PartialMessage<JobMappingPolicyConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingPolicyConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policies", kind: "message", T: JobMappingPolicy, repeated: true },
+    { no: 2, name: "non_production_connection_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingPolicyConfig {
+    return new JobMappingPolicyConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingPolicyConfig {
+    return new JobMappingPolicyConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingPolicyConfig {
+    return new JobMappingPolicyConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingPolicyConfig | PlainMessage<JobMappingPolicyConfig> | undefined, b: JobMappingPolicyConfig | PlainMessage<JobMappingPolicyConfig> | undefined): boolean {
+    return proto3.util.equals(JobMappingPolicyConfig, a, b);
+  }
+}
+
+/**
+ * Requires the columns covered by the policy to use a transformer other than passthrough
+ *
+ * @generated from message mgmt.v1alpha1.JobMappingPolicy
+ */
+export class JobMappingPolicy extends Message<JobMappingPolicy> {
+  /**
+   * The name of the policy. Included in the column errors of the mappings that violate it.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Case-insensitive glob patterns that are matched against the column name, e.g. *email*.
+   * If neither patterns nor tags are set, the policy covers every column.
+   *
+   * @generated from field: repeated string column_patterns = 2;
+   */
+  columnPatterns: string[] = [];
+
+  /**
+   * The jobs the policy applies to, based on their destinations. Defaults to all jobs.
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingPolicyDestinationScope destination_scope = 3;
+   */
+  destinationScope = JobMappingPolicyDestinationScope.UNSPECIFIED;
+
+  /**
+   * Covers the columns that have any of these tags on the job's source connection, e.g. pii
+   *
+   * @generated from field: repeated string column_tags = 4;
+   */
+  columnTags: string[] = [];
+
+  constructor(data?: PartialMessage<JobMappingPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "column_patterns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "destination_scope", kind: "enum", T: proto3.getEnumType(JobMappingPolicyDestinationScope) },
+    { no: 4, name: "column_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingPolicy {
+    return new JobMappingPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingPolicy {
+    return new JobMappingPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingPolicy {
+    return new JobMappingPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingPolicy | PlainMessage<JobMappingPolicy> | undefined, b: JobMappingPolicy | PlainMessage<JobMappingPolicy> | undefined): boolean {
+    return proto3.util.equals(JobMappingPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobMappingPolicyConfigRequest
+ */
+export class GetJobMappingPolicyConfigRequest extends Message<GetJobMappingPolicyConfigRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetJobMappingPolicyConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobMappingPolicyConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobMappingPolicyConfigRequest {
+    return new GetJobMappingPolicyConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobMappingPolicyConfigRequest {
+    return new GetJobMappingPolicyConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobMappingPolicyConfigRequest {
+    return new GetJobMappingPolicyConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobMappingPolicyConfigRequest | PlainMessage<GetJobMappingPolicyConfigRequest> | undefined, b: GetJobMappingPolicyConfigRequest | PlainMessage<GetJobMappingPolicyConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobMappingPolicyConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobMappingPolicyConfigResponse
+ */
+export class GetJobMappingPolicyConfigResponse extends Message<GetJobMappingPolicyConfigResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.JobMappingPolicyConfig config = 1;
+   */
+  config?: JobMappingPolicyConfig;
+
+  constructor(data?: PartialMessage<GetJobMappingPolicyConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobMappingPolicyConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: JobMappingPolicyConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobMappingPolicyConfigResponse {
+    return new GetJobMappingPolicyConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobMappingPolicyConfigResponse {
+    return new GetJobMappingPolicyConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobMappingPolicyConfigResponse {
+    return new GetJobMappingPolicyConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobMappingPolicyConfigResponse | PlainMessage<GetJobMappingPolicyConfigResponse> | undefined, b: GetJobMappingPolicyConfigResponse | PlainMessage<GetJobMappingPolicyConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobMappingPolicyConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobMappingPolicyConfigRequest
+ */
+export class SetJobMappingPolicyConfigRequest extends Message<SetJobMappingPolicyConfigRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Fully overrides the account's previous configuration
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingPolicyConfig config = 2;
+   */
+  config?: JobMappingPolicyConfig;
+
+  constructor(data?: PartialMessage<SetJobMappingPolicyConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobMappingPolicyConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "config", kind: "message", T: JobMappingPolicyConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobMappingPolicyConfigRequest {
+    return new SetJobMappingPolicyConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobMappingPolicyConfigRequest {
+    return new SetJobMappingPolicyConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobMappingPolicyConfigRequest {
+    return new SetJobMappingPolicyConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobMappingPolicyConfigRequest | PlainMessage<SetJobMappingPolicyConfigRequest> | undefined, b: SetJobMappingPolicyConfigRequest | PlainMessage<SetJobMappingPolicyConfigRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobMappingPolicyConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobMappingPolicyConfigResponse
+ */
+export class SetJobMappingPolicyConfigResponse extends Message<SetJobMappingPolicyConfigResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.JobMappingPolicyConfig config = 1;
+   */
+  config?: JobMappingPolicyConfig;
+
+  constructor(data?: PartialMessage<SetJobMappingPolicyConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobMappingPolicyConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: JobMappingPolicyConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobMappingPolicyConfigResponse {
+    return new SetJobMappingPolicyConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobMappingPolicyConfigResponse {
+    return new SetJobMappingPolicyConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobMappingPolicyConfigResponse {
+    return new SetJobMappingPolicyConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobMappingPolicyConfigResponse | PlainMessage<SetJobMappingPolicyConfigResponse> | undefined, b: SetJobMappingPolicyConfigResponse | PlainMessage<SetJobMappingPolicyConfigResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobMappingPolicyConfigResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.RunContextKey
  */
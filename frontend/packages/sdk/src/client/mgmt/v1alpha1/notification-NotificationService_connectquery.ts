@@ -0,0 +1,152 @@
+// @generated by protoc-gen-connect-query v1.4.1 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { MethodKind } from "@bufbuild/protobuf";
+import { CreateNotificationChannelRequest, CreateNotificationChannelResponse, DeleteNotificationChannelRequest, DeleteNotificationChannelResponse, GetJobNotificationSubscriptionsRequest, GetJobNotificationSubscriptionsResponse, GetNotificationChannelRequest, GetNotificationChannelResponse, GetNotificationChannelsRequest, GetNotificationChannelsResponse, SendJobRunNotificationRequest, SendJobRunNotificationResponse, SendTestNotificationRequest, SendTestNotificationResponse, SetJobNotificationSubscriptionsRequest, SetJobNotificationSubscriptionsResponse, UpdateNotificationChannelRequest, UpdateNotificationChannelResponse } from "./notification_pb.js";
+
+/**
+ * Retrieves all of the notification channels in the account. Secrets are masked.
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationChannels
+ */
+export const getNotificationChannels = {
+  localName: "getNotificationChannels",
+  name: "GetNotificationChannels",
+  kind: MethodKind.Unary,
+  I: GetNotificationChannelsRequest,
+  O: GetNotificationChannelsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Retrieves a single notification channel. Secrets are masked.
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationChannel
+ */
+export const getNotificationChannel = {
+  localName: "getNotificationChannel",
+  name: "GetNotificationChannel",
+  kind: MethodKind.Unary,
+  I: GetNotificationChannelRequest,
+  O: GetNotificationChannelResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Creates a new notification channel
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.CreateNotificationChannel
+ */
+export const createNotificationChannel = {
+  localName: "createNotificationChannel",
+  name: "CreateNotificationChannel",
+  kind: MethodKind.Unary,
+  I: CreateNotificationChannelRequest,
+  O: CreateNotificationChannelResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Updates the name and config of a notification channel
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.UpdateNotificationChannel
+ */
+export const updateNotificationChannel = {
+  localName: "updateNotificationChannel",
+  name: "UpdateNotificationChannel",
+  kind: MethodKind.Unary,
+  I: UpdateNotificationChannelRequest,
+  O: UpdateNotificationChannelResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Deletes a notification channel along with all of the job subscriptions that use it
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.DeleteNotificationChannel
+ */
+export const deleteNotificationChannel = {
+  localName: "deleteNotificationChannel",
+  name: "DeleteNotificationChannel",
+  kind: MethodKind.Unary,
+  I: DeleteNotificationChannelRequest,
+  O: DeleteNotificationChannelResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Sends a test notification to the channel to verify that it is configured correctly
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.SendTestNotification
+ */
+export const sendTestNotification = {
+  localName: "sendTestNotification",
+  name: "SendTestNotification",
+  kind: MethodKind.Unary,
+  I: SendTestNotificationRequest,
+  O: SendTestNotificationResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Retrieves the notification subscriptions of a job
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.GetJobNotificationSubscriptions
+ */
+export const getJobNotificationSubscriptions = {
+  localName: "getJobNotificationSubscriptions",
+  name: "GetJobNotificationSubscriptions",
+  kind: MethodKind.Unary,
+  I: GetJobNotificationSubscriptionsRequest,
+  O: GetJobNotificationSubscriptionsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Replaces the notification subscriptions of a job
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.SetJobNotificationSubscriptions
+ */
+export const setJobNotificationSubscriptions = {
+  localName: "setJobNotificationSubscriptions",
+  name: "SetJobNotificationSubscriptions",
+  kind: MethodKind.Unary,
+  I: SetJobNotificationSubscriptionsRequest,
+  O: SetJobNotificationSubscriptionsResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Delivers a job run lifecycle event to every channel the job is subscribed to for that event.
+ * Called by the worker as the job run progresses.
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.SendJobRunNotification
+ */
+export const sendJobRunNotification = {
+  localName: "sendJobRunNotification",
+  name: "SendJobRunNotification",
+  kind: MethodKind.Unary,
+  I: SendJobRunNotificationRequest,
+  O: SendJobRunNotificationResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
@@ -0,0 +1,119 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateNotificationChannelRequest, CreateNotificationChannelResponse, DeleteNotificationChannelRequest, DeleteNotificationChannelResponse, GetJobNotificationSubscriptionsRequest, GetJobNotificationSubscriptionsResponse, GetNotificationChannelRequest, GetNotificationChannelResponse, GetNotificationChannelsRequest, GetNotificationChannelsResponse, SendJobRunNotificationRequest, SendJobRunNotificationResponse, SendTestNotificationRequest, SendTestNotificationResponse, SetJobNotificationSubscriptionsRequest, SetJobNotificationSubscriptionsResponse, UpdateNotificationChannelRequest, UpdateNotificationChannelResponse } from "./notification_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that manages notification channels and the job run events they are sent
+ *
+ * @generated from service mgmt.v1alpha1.NotificationService
+ */
+export const NotificationService = {
+  typeName: "mgmt.v1alpha1.NotificationService",
+  methods: {
+    /**
+     * Retrieves all of the notification channels in the account. Secrets are masked.
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationChannels
+     */
+    getNotificationChannels: {
+      name: "GetNotificationChannels",
+      I: GetNotificationChannelsRequest,
+      O: GetNotificationChannelsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Retrieves a single notification channel. Secrets are masked.
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationChannel
+     */
+    getNotificationChannel: {
+      name: "GetNotificationChannel",
+      I: GetNotificationChannelRequest,
+      O: GetNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a new notification channel
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.CreateNotificationChannel
+     */
+    createNotificationChannel: {
+      name: "CreateNotificationChannel",
+      I: CreateNotificationChannelRequest,
+      O: CreateNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Updates the name and config of a notification channel
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.UpdateNotificationChannel
+     */
+    updateNotificationChannel: {
+      name: "UpdateNotificationChannel",
+      I: UpdateNotificationChannelRequest,
+      O: UpdateNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes a notification channel along with all of the job subscriptions that use it
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.DeleteNotificationChannel
+     */
+    deleteNotificationChannel: {
+      name: "DeleteNotificationChannel",
+      I: DeleteNotificationChannelRequest,
+      O: DeleteNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sends a test notification to the channel to verify that it is configured correctly
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.SendTestNotification
+     */
+    sendTestNotification: {
+      name: "SendTestNotification",
+      I: SendTestNotificationRequest,
+      O: SendTestNotificationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Retrieves the notification subscriptions of a job
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.GetJobNotificationSubscriptions
+     */
+    getJobNotificationSubscriptions: {
+      name: "GetJobNotificationSubscriptions",
+      I: GetJobNotificationSubscriptionsRequest,
+      O: GetJobNotificationSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replaces the notification subscriptions of a job
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.SetJobNotificationSubscriptions
+     */
+    setJobNotificationSubscriptions: {
+      name: "SetJobNotificationSubscriptions",
+      I: SetJobNotificationSubscriptionsRequest,
+      O: SetJobNotificationSubscriptionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Delivers a job run lifecycle event to every channel the job is subscribed to for that event.
+     * Called by the worker as the job run progresses.
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.SendJobRunNotification
+     */
+    sendJobRunNotification: {
+      name: "SendJobRunNotification",
+      I: SendJobRunNotificationRequest,
+      O: SendJobRunNotificationResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
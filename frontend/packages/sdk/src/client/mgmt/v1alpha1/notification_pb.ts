@@ -0,0 +1,1143 @@
+// @generated by protoc-gen-es v1.9.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * The lifecycle events of a job run that a notification can be sent for
+ *
+ * @generated from enum mgmt.v1alpha1.NotificationEventType
+ */
+export enum NotificationEventType {
+  /**
+   * Unspecified event type
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The job run has started
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED = 1;
+   */
+  JOB_RUN_STARTED = 1,
+
+  /**
+   * The job run finished successfully
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED = 2;
+   */
+  JOB_RUN_SUCCEEDED = 2,
+
+  /**
+   * The job run finished with an error
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED = 3;
+   */
+  JOB_RUN_FAILED = 3,
+
+  /**
+   * One of the job run's activities exceeded its configured timeout
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT = 4;
+   */
+  JOB_RUN_TIMED_OUT = 4,
+
+  /**
+   * The schema of the job's source no longer matches the job's mappings
+   *
+   * @generated from enum value: NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED = 5;
+   */
+  SCHEMA_DRIFT_DETECTED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(NotificationEventType)
+proto3.util.setEnumType(NotificationEventType, "mgmt.v1alpha1.NotificationEventType", [
+  { no: 0, name: "NOTIFICATION_EVENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED" },
+  { no: 2, name: "NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED" },
+  { no: 3, name: "NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED" },
+  { no: 4, name: "NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT" },
+  { no: 5, name: "NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED" },
+]);
+
+/**
+ * An account level destination that notifications are delivered to
+ *
+ * @generated from message mgmt.v1alpha1.NotificationChannel
+ */
+export class NotificationChannel extends Message<NotificationChannel> {
+  /**
+   * The unique identifier of the channel
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The account the channel belongs to
+   *
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * The unique name of the channel within the account
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * Where and how notifications are delivered
+   *
+   * @generated from field: mgmt.v1alpha1.NotificationChannelConfig config = 4;
+   */
+  config?: NotificationChannelConfig;
+
+  /**
+   * @generated from field: string created_by_user_id = 5;
+   */
+  createdByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string updated_by_user_id = 7;
+   */
+  updatedByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<NotificationChannel>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.NotificationChannel";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "config", kind: "message", T: NotificationChannelConfig },
+    { no: 5, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationChannel | PlainMessage<NotificationChannel> | undefined, b: NotificationChannel | PlainMessage<NotificationChannel> | undefined): boolean {
+    return proto3.util.equals(NotificationChannel, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.NotificationChannelConfig
+ */
+export class NotificationChannelConfig extends Message<NotificationChannelConfig> {
+  /**
+   * @generated from oneof mgmt.v1alpha1.NotificationChannelConfig.config
+   */
+  config: {
+    /**
+     * @generated from field: mgmt.v1alpha1.WebhookNotificationConfig webhook = 1;
+     */
+    value: WebhookNotificationConfig;
+    case: "webhook";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SlackNotificationConfig slack = 2;
+     */
+    value: SlackNotificationConfig;
+    case: "slack";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.EmailNotificationConfig email = 3;
+     */
+    value: EmailNotificationConfig;
+    case: "email";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<NotificationChannelConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.NotificationChannelConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: WebhookNotificationConfig, oneof: "config" },
+    { no: 2, name: "slack", kind: "message", T: SlackNotificationConfig, oneof: "config" },
+    { no: 3, name: "email", kind: "message", T: EmailNotificationConfig, oneof: "config" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationChannelConfig {
+    return new NotificationChannelConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationChannelConfig {
+    return new NotificationChannelConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationChannelConfig {
+    return new NotificationChannelConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationChannelConfig | PlainMessage<NotificationChannelConfig> | undefined, b: NotificationChannelConfig | PlainMessage<NotificationChannelConfig> | undefined): boolean {
+    return proto3.util.equals(NotificationChannelConfig, a, b);
+  }
+}
+
+/**
+ * Posts the JSON encoded notification to an HTTP endpoint
+ *
+ * @generated from message mgmt.v1alpha1.WebhookNotificationConfig
+ */
+export class WebhookNotificationConfig extends Message<WebhookNotificationConfig> {
+  /**
+   * The endpoint the notification is posted to
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * Used to compute the HMAC-SHA256 of the request body which is sent in the X-Neosync-Signature header.
+   * Receivers should compute the same signature to verify the notification was sent by Neosync.
+   *
+   * @generated from field: string signing_secret = 2;
+   */
+  signingSecret = "";
+
+  constructor(data?: PartialMessage<WebhookNotificationConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.WebhookNotificationConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "signing_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookNotificationConfig {
+    return new WebhookNotificationConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookNotificationConfig {
+    return new WebhookNotificationConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookNotificationConfig {
+    return new WebhookNotificationConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookNotificationConfig | PlainMessage<WebhookNotificationConfig> | undefined, b: WebhookNotificationConfig | PlainMessage<WebhookNotificationConfig> | undefined): boolean {
+    return proto3.util.equals(WebhookNotificationConfig, a, b);
+  }
+}
+
+/**
+ * Posts the notification to a Slack incoming webhook
+ *
+ * @generated from message mgmt.v1alpha1.SlackNotificationConfig
+ */
+export class SlackNotificationConfig extends Message<SlackNotificationConfig> {
+  /**
+   * The incoming webhook url provided by Slack
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  constructor(data?: PartialMessage<SlackNotificationConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SlackNotificationConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SlackNotificationConfig {
+    return new SlackNotificationConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SlackNotificationConfig {
+    return new SlackNotificationConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SlackNotificationConfig {
+    return new SlackNotificationConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SlackNotificationConfig | PlainMessage<SlackNotificationConfig> | undefined, b: SlackNotificationConfig | PlainMessage<SlackNotificationConfig> | undefined): boolean {
+    return proto3.util.equals(SlackNotificationConfig, a, b);
+  }
+}
+
+/**
+ * Sends the notification as an email through an SMTP server
+ *
+ * @generated from message mgmt.v1alpha1.EmailNotificationConfig
+ */
+export class EmailNotificationConfig extends Message<EmailNotificationConfig> {
+  /**
+   * The hostname of the SMTP server
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * The port of the SMTP server. STARTTLS is used if the server supports it.
+   *
+   * @generated from field: uint32 port = 2;
+   */
+  port = 0;
+
+  /**
+   * The username used to authenticate with the SMTP server. Authentication is skipped if not provided.
+   *
+   * @generated from field: optional string username = 3;
+   */
+  username?: string;
+
+  /**
+   * @generated from field: optional string password = 4;
+   */
+  password?: string;
+
+  /**
+   * The address the email is sent from
+   *
+   * @generated from field: string from = 5;
+   */
+  from = "";
+
+  /**
+   * The addresses the email is sent to
+   *
+   * @generated from field: repeated string to = 6;
+   */
+  to: string[] = [];
+
+  constructor(data?: PartialMessage<EmailNotificationConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.EmailNotificationConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "port", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EmailNotificationConfig {
+    return new EmailNotificationConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EmailNotificationConfig {
+    return new EmailNotificationConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EmailNotificationConfig {
+    return new EmailNotificationConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EmailNotificationConfig | PlainMessage<EmailNotificationConfig> | undefined, b: EmailNotificationConfig | PlainMessage<EmailNotificationConfig> | undefined): boolean {
+    return proto3.util.equals(EmailNotificationConfig, a, b);
+  }
+}
+
+/**
+ * Subscribes a job to a set of events that are delivered to a notification channel
+ *
+ * @generated from message mgmt.v1alpha1.JobNotificationSubscription
+ */
+export class JobNotificationSubscription extends Message<JobNotificationSubscription> {
+  /**
+   * The channel the events are delivered to
+   *
+   * @generated from field: string channel_id = 1;
+   */
+  channelId = "";
+
+  /**
+   * The events that are delivered
+   *
+   * @generated from field: repeated mgmt.v1alpha1.NotificationEventType events = 2;
+   */
+  events: NotificationEventType[] = [];
+
+  constructor(data?: PartialMessage<JobNotificationSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobNotificationSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "events", kind: "enum", T: proto3.getEnumType(NotificationEventType), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobNotificationSubscription {
+    return new JobNotificationSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobNotificationSubscription {
+    return new JobNotificationSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobNotificationSubscription {
+    return new JobNotificationSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobNotificationSubscription | PlainMessage<JobNotificationSubscription> | undefined, b: JobNotificationSubscription | PlainMessage<JobNotificationSubscription> | undefined): boolean {
+    return proto3.util.equals(JobNotificationSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationChannelsRequest
+ */
+export class GetNotificationChannelsRequest extends Message<GetNotificationChannelsRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetNotificationChannelsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationChannelsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationChannelsRequest {
+    return new GetNotificationChannelsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationChannelsRequest {
+    return new GetNotificationChannelsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationChannelsRequest {
+    return new GetNotificationChannelsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationChannelsRequest | PlainMessage<GetNotificationChannelsRequest> | undefined, b: GetNotificationChannelsRequest | PlainMessage<GetNotificationChannelsRequest> | undefined): boolean {
+    return proto3.util.equals(GetNotificationChannelsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationChannelsResponse
+ */
+export class GetNotificationChannelsResponse extends Message<GetNotificationChannelsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.NotificationChannel channels = 1;
+   */
+  channels: NotificationChannel[] = [];
+
+  constructor(data?: PartialMessage<GetNotificationChannelsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationChannelsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channels", kind: "message", T: NotificationChannel, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationChannelsResponse {
+    return new GetNotificationChannelsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationChannelsResponse {
+    return new GetNotificationChannelsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationChannelsResponse {
+    return new GetNotificationChannelsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationChannelsResponse | PlainMessage<GetNotificationChannelsResponse> | undefined, b: GetNotificationChannelsResponse | PlainMessage<GetNotificationChannelsResponse> | undefined): boolean {
+    return proto3.util.equals(GetNotificationChannelsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationChannelRequest
+ */
+export class GetNotificationChannelRequest extends Message<GetNotificationChannelRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationChannelRequest {
+    return new GetNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationChannelRequest {
+    return new GetNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationChannelRequest {
+    return new GetNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationChannelRequest | PlainMessage<GetNotificationChannelRequest> | undefined, b: GetNotificationChannelRequest | PlainMessage<GetNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(GetNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationChannelResponse
+ */
+export class GetNotificationChannelResponse extends Message<GetNotificationChannelResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<GetNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationChannelResponse {
+    return new GetNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationChannelResponse {
+    return new GetNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationChannelResponse {
+    return new GetNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationChannelResponse | PlainMessage<GetNotificationChannelResponse> | undefined, b: GetNotificationChannelResponse | PlainMessage<GetNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(GetNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateNotificationChannelRequest
+ */
+export class CreateNotificationChannelRequest extends Message<CreateNotificationChannelRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationChannelConfig config = 3;
+   */
+  config?: NotificationChannelConfig;
+
+  constructor(data?: PartialMessage<CreateNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "config", kind: "message", T: NotificationChannelConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationChannelRequest | PlainMessage<CreateNotificationChannelRequest> | undefined, b: CreateNotificationChannelRequest | PlainMessage<CreateNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateNotificationChannelResponse
+ */
+export class CreateNotificationChannelResponse extends Message<CreateNotificationChannelResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<CreateNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationChannelResponse | PlainMessage<CreateNotificationChannelResponse> | undefined, b: CreateNotificationChannelResponse | PlainMessage<CreateNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdateNotificationChannelRequest
+ */
+export class UpdateNotificationChannelRequest extends Message<UpdateNotificationChannelRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Secrets that were masked by a previous read may be sent back as-is to keep their stored value
+   *
+   * @generated from field: mgmt.v1alpha1.NotificationChannelConfig config = 3;
+   */
+  config?: NotificationChannelConfig;
+
+  constructor(data?: PartialMessage<UpdateNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdateNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "config", kind: "message", T: NotificationChannelConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateNotificationChannelRequest | PlainMessage<UpdateNotificationChannelRequest> | undefined, b: UpdateNotificationChannelRequest | PlainMessage<UpdateNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdateNotificationChannelResponse
+ */
+export class UpdateNotificationChannelResponse extends Message<UpdateNotificationChannelResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<UpdateNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdateNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateNotificationChannelResponse | PlainMessage<UpdateNotificationChannelResponse> | undefined, b: UpdateNotificationChannelResponse | PlainMessage<UpdateNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteNotificationChannelRequest
+ */
+export class DeleteNotificationChannelRequest extends Message<DeleteNotificationChannelRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteNotificationChannelRequest | PlainMessage<DeleteNotificationChannelRequest> | undefined, b: DeleteNotificationChannelRequest | PlainMessage<DeleteNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteNotificationChannelResponse
+ */
+export class DeleteNotificationChannelResponse extends Message<DeleteNotificationChannelResponse> {
+  constructor(data?: PartialMessage<DeleteNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteNotificationChannelResponse {
+    return new DeleteNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteNotificationChannelResponse {
+    return new DeleteNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteNotificationChannelResponse {
+    return new DeleteNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteNotificationChannelResponse | PlainMessage<DeleteNotificationChannelResponse> | undefined, b: DeleteNotificationChannelResponse | PlainMessage<DeleteNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SendTestNotificationRequest
+ */
+export class SendTestNotificationRequest extends Message<SendTestNotificationRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<SendTestNotificationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SendTestNotificationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendTestNotificationRequest {
+    return new SendTestNotificationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendTestNotificationRequest {
+    return new SendTestNotificationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendTestNotificationRequest {
+    return new SendTestNotificationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendTestNotificationRequest | PlainMessage<SendTestNotificationRequest> | undefined, b: SendTestNotificationRequest | PlainMessage<SendTestNotificationRequest> | undefined): boolean {
+    return proto3.util.equals(SendTestNotificationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SendTestNotificationResponse
+ */
+export class SendTestNotificationResponse extends Message<SendTestNotificationResponse> {
+  constructor(data?: PartialMessage<SendTestNotificationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SendTestNotificationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendTestNotificationResponse {
+    return new SendTestNotificationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendTestNotificationResponse {
+    return new SendTestNotificationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendTestNotificationResponse {
+    return new SendTestNotificationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendTestNotificationResponse | PlainMessage<SendTestNotificationResponse> | undefined, b: SendTestNotificationResponse | PlainMessage<SendTestNotificationResponse> | undefined): boolean {
+    return proto3.util.equals(SendTestNotificationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobNotificationSubscriptionsRequest
+ */
+export class GetJobNotificationSubscriptionsRequest extends Message<GetJobNotificationSubscriptionsRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<GetJobNotificationSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobNotificationSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobNotificationSubscriptionsRequest {
+    return new GetJobNotificationSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobNotificationSubscriptionsRequest {
+    return new GetJobNotificationSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobNotificationSubscriptionsRequest {
+    return new GetJobNotificationSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobNotificationSubscriptionsRequest | PlainMessage<GetJobNotificationSubscriptionsRequest> | undefined, b: GetJobNotificationSubscriptionsRequest | PlainMessage<GetJobNotificationSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobNotificationSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobNotificationSubscriptionsResponse
+ */
+export class GetJobNotificationSubscriptionsResponse extends Message<GetJobNotificationSubscriptionsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobNotificationSubscription subscriptions = 1;
+   */
+  subscriptions: JobNotificationSubscription[] = [];
+
+  constructor(data?: PartialMessage<GetJobNotificationSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobNotificationSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: JobNotificationSubscription, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobNotificationSubscriptionsResponse {
+    return new GetJobNotificationSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobNotificationSubscriptionsResponse {
+    return new GetJobNotificationSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobNotificationSubscriptionsResponse {
+    return new GetJobNotificationSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobNotificationSubscriptionsResponse | PlainMessage<GetJobNotificationSubscriptionsResponse> | undefined, b: GetJobNotificationSubscriptionsResponse | PlainMessage<GetJobNotificationSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobNotificationSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobNotificationSubscriptionsRequest
+ */
+export class SetJobNotificationSubscriptionsRequest extends Message<SetJobNotificationSubscriptionsRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * Replaces all of the existing subscriptions of the job
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobNotificationSubscription subscriptions = 2;
+   */
+  subscriptions: JobNotificationSubscription[] = [];
+
+  constructor(data?: PartialMessage<SetJobNotificationSubscriptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobNotificationSubscriptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "subscriptions", kind: "message", T: JobNotificationSubscription, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobNotificationSubscriptionsRequest {
+    return new SetJobNotificationSubscriptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobNotificationSubscriptionsRequest {
+    return new SetJobNotificationSubscriptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobNotificationSubscriptionsRequest {
+    return new SetJobNotificationSubscriptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobNotificationSubscriptionsRequest | PlainMessage<SetJobNotificationSubscriptionsRequest> | undefined, b: SetJobNotificationSubscriptionsRequest | PlainMessage<SetJobNotificationSubscriptionsRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobNotificationSubscriptionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobNotificationSubscriptionsResponse
+ */
+export class SetJobNotificationSubscriptionsResponse extends Message<SetJobNotificationSubscriptionsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobNotificationSubscription subscriptions = 1;
+   */
+  subscriptions: JobNotificationSubscription[] = [];
+
+  constructor(data?: PartialMessage<SetJobNotificationSubscriptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobNotificationSubscriptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subscriptions", kind: "message", T: JobNotificationSubscription, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobNotificationSubscriptionsResponse {
+    return new SetJobNotificationSubscriptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobNotificationSubscriptionsResponse {
+    return new SetJobNotificationSubscriptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobNotificationSubscriptionsResponse {
+    return new SetJobNotificationSubscriptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobNotificationSubscriptionsResponse | PlainMessage<SetJobNotificationSubscriptionsResponse> | undefined, b: SetJobNotificationSubscriptionsResponse | PlainMessage<SetJobNotificationSubscriptionsResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobNotificationSubscriptionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SendJobRunNotificationRequest
+ */
+export class SendJobRunNotificationRequest extends Message<SendJobRunNotificationRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * The id of the job run. This is equivalent to the temporal workflow id
+   *
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationEventType event_type = 3;
+   */
+  eventType = NotificationEventType.UNSPECIFIED;
+
+  /**
+   * A summary of the error that caused the event, if any
+   *
+   * @generated from field: optional string error_message = 4;
+   */
+  errorMessage?: string;
+
+  constructor(data?: PartialMessage<SendJobRunNotificationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SendJobRunNotificationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_type", kind: "enum", T: proto3.getEnumType(NotificationEventType) },
+    { no: 4, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendJobRunNotificationRequest {
+    return new SendJobRunNotificationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendJobRunNotificationRequest {
+    return new SendJobRunNotificationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendJobRunNotificationRequest {
+    return new SendJobRunNotificationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendJobRunNotificationRequest | PlainMessage<SendJobRunNotificationRequest> | undefined, b: SendJobRunNotificationRequest | PlainMessage<SendJobRunNotificationRequest> | undefined): boolean {
+    return proto3.util.equals(SendJobRunNotificationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SendJobRunNotificationResponse
+ */
+export class SendJobRunNotificationResponse extends Message<SendJobRunNotificationResponse> {
+  /**
+   * The number of channels the notification was delivered to
+   *
+   * @generated from field: uint32 delivered_count = 1;
+   */
+  deliveredCount = 0;
+
+  constructor(data?: PartialMessage<SendJobRunNotificationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SendJobRunNotificationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "delivered_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendJobRunNotificationResponse {
+    return new SendJobRunNotificationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendJobRunNotificationResponse {
+    return new SendJobRunNotificationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendJobRunNotificationResponse {
+    return new SendJobRunNotificationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendJobRunNotificationResponse | PlainMessage<SendJobRunNotificationResponse> | undefined, b: SendJobRunNotificationResponse | PlainMessage<SendJobRunNotificationResponse> | undefined): boolean {
+    return proto3.util.equals(SendJobRunNotificationResponse, a, b);
+  }
+}
+
@@ -38,6 +38,54 @@ proto3.util.setEnumType(UserAccountType, "mgmt.v1alpha1.UserAccountType", [
   { no: 3, name: "USER_ACCOUNT_TYPE_ENTERPRISE" },
 ]);
 
+/**
+ * Roles that may be granted to members of an account. Roles are ordered, each role may do everything the roles below it may do.
+ *
+ * @generated from enum mgmt.v1alpha1.AccountRole
+ */
+export enum AccountRole {
+  /**
+   * @generated from enum value: ACCOUNT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * May manage connections, api keys, account settings, and team members
+   *
+   * @generated from enum value: ACCOUNT_ROLE_ADMIN = 1;
+   */
+  ADMIN = 1,
+
+  /**
+   * May create, update and delete jobs and transformers
+   *
+   * @generated from enum value: ACCOUNT_ROLE_JOB_EDITOR = 2;
+   */
+  JOB_EDITOR = 2,
+
+  /**
+   * May trigger, cancel, terminate and pause job runs
+   *
+   * @generated from enum value: ACCOUNT_ROLE_OPERATOR = 3;
+   */
+  OPERATOR = 3,
+
+  /**
+   * May only read account resources
+   *
+   * @generated from enum value: ACCOUNT_ROLE_VIEWER = 4;
+   */
+  VIEWER = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountRole)
+proto3.util.setEnumType(AccountRole, "mgmt.v1alpha1.AccountRole", [
+  { no: 0, name: "ACCOUNT_ROLE_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_ROLE_ADMIN" },
+  { no: 2, name: "ACCOUNT_ROLE_JOB_EDITOR" },
+  { no: 3, name: "ACCOUNT_ROLE_OPERATOR" },
+  { no: 4, name: "ACCOUNT_ROLE_VIEWER" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetUserRequest
  */
@@ -796,6 +844,13 @@ export class AccountUser extends Message<AccountUser> {
    */
   email = "";
 
+  /**
+   * The role the user has been granted in the account
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 5;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountUser>) {
     super();
     proto3.util.initPartial(data, this);
@@ -808,6 +863,7 @@ export class AccountUser extends Message<AccountUser> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountUser {
@@ -989,6 +1045,13 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
    */
   email = "";
 
+  /**
+   * The role the user will be granted once the invite is accepted. Defaults to viewer if not provided.
+   *
+   * @generated from field: optional mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role?: AccountRole;
+
   constructor(data?: PartialMessage<InviteUserToTeamAccountRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -999,6 +1062,7 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteUserToTeamAccountRequest {
@@ -1067,6 +1131,13 @@ export class AccountInvite extends Message<AccountInvite> {
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The role the user will be granted once the invite is accepted
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 10;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountInvite>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1084,6 +1155,7 @@ export class AccountInvite extends Message<AccountInvite> {
     { no: 7, name: "created_at", kind: "message", T: Timestamp },
     { no: 8, name: "updated_at", kind: "message", T: Timestamp },
     { no: 9, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 10, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountInvite {
@@ -1103,6 +1175,86 @@ export class AccountInvite extends Message<AccountInvite> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamMemberRoleRequest
+ */
+export class SetTeamMemberRoleRequest extends Message<SetTeamMemberRoleRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role = AccountRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SetTeamMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamMemberRoleRequest {
+    return new SetTeamMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamMemberRoleRequest {
+    return new SetTeamMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamMemberRoleRequest {
+    return new SetTeamMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamMemberRoleRequest | PlainMessage<SetTeamMemberRoleRequest> | undefined, b: SetTeamMemberRoleRequest | PlainMessage<SetTeamMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetTeamMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamMemberRoleResponse
+ */
+export class SetTeamMemberRoleResponse extends Message<SetTeamMemberRoleResponse> {
+  constructor(data?: PartialMessage<SetTeamMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamMemberRoleResponse {
+    return new SetTeamMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamMemberRoleResponse {
+    return new SetTeamMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamMemberRoleResponse {
+    return new SetTeamMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamMemberRoleResponse | PlainMessage<SetTeamMemberRoleResponse> | undefined, b: SetTeamMemberRoleResponse | PlainMessage<SetTeamMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetTeamMemberRoleResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.InviteUserToTeamAccountResponse
  */
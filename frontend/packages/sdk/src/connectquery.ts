@@ -1,7 +1,9 @@
 export * from './client/mgmt/v1alpha1/api_key-ApiKeyService_connectquery.js';
+export * from './client/mgmt/v1alpha1/audit-AuditService_connectquery.js';
 export * from './client/mgmt/v1alpha1/connection-ConnectionService_connectquery.js';
 export * from './client/mgmt/v1alpha1/connection_data-ConnectionDataService_connectquery.js';
 export * from './client/mgmt/v1alpha1/job-JobService_connectquery.js';
 export * from './client/mgmt/v1alpha1/metrics-MetricsService_connectquery.js';
+export * from './client/mgmt/v1alpha1/notification-NotificationService_connectquery.js';
 export * from './client/mgmt/v1alpha1/transformer-TransformersService_connectquery.js';
 export * from './client/mgmt/v1alpha1/user_account-UserAccountService_connectquery.js';
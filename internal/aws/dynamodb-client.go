@@ -2,8 +2,12 @@ package awsmanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
@@ -16,8 +20,17 @@ type dynamoDBAPIV2 interface {
 	DescribeTable(ctx context.Context, params *dynamodb.DescribeTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
 	Scan(ctx context.Context, params *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
 	ListTables(ctx context.Context, params *dynamodb.ListTablesInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ListTablesOutput, error)
+	CreateTable(ctx context.Context, params *dynamodb.CreateTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.CreateTableOutput, error)
+	BatchWriteItem(ctx context.Context, params *dynamodb.BatchWriteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchWriteItemOutput, error)
 }
 
+const (
+	// the maximum number of requests that may be sent in a single BatchWriteItem call
+	maxBatchWriteItems = 25
+	// the maximum amount of time to wait for a newly created table to become active
+	maxTableActiveWait = 5 * time.Minute
+)
+
 func NewDynamoDbClient(api dynamoDBAPIV2) *DynamoDbClient {
 	return &DynamoDbClient{client: api}
 }
@@ -84,3 +97,167 @@ func (d *DynamoDbClient) ScanTable(
 
 	return output, nil
 }
+
+// Returns the table description or nil if the table does not exist
+func (d *DynamoDbClient) DescribeTable(ctx context.Context, tableName string) (*types.TableDescription, error) {
+	output, err := d.client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: &tableName,
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe table %s: %w", tableName, err)
+	}
+	if output == nil || output.Table == nil {
+		return nil, fmt.Errorf("invalid DescribeTableOutput for table %s", tableName)
+	}
+	return output.Table, nil
+}
+
+// Creates the table using the key schema, secondary indexes and billing mode of the source table
+// and waits for the table to become active.
+func (d *DynamoDbClient) CreateTableFromDescription(ctx context.Context, tableName string, source *types.TableDescription) error {
+	_, err := d.client.CreateTable(ctx, BuildCreateTableInput(tableName, source))
+	if err != nil {
+		return fmt.Errorf("failed to create table %s: %w", tableName, err)
+	}
+	waiter := dynamodb.NewTableExistsWaiter(d.client)
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: &tableName}, maxTableActiveWait); err != nil {
+		return fmt.Errorf("failed waiting for table %s to become active: %w", tableName, err)
+	}
+	return nil
+}
+
+// Builds the create table input for a new table that mirrors the source table
+func BuildCreateTableInput(tableName string, source *types.TableDescription) *dynamodb.CreateTableInput {
+	input := &dynamodb.CreateTableInput{
+		TableName:            aws.String(tableName),
+		AttributeDefinitions: source.AttributeDefinitions,
+		KeySchema:            source.KeySchema,
+	}
+
+	isOnDemand := source.BillingModeSummary != nil && source.BillingModeSummary.BillingMode == types.BillingModePayPerRequest
+	if isOnDemand {
+		input.BillingMode = types.BillingModePayPerRequest
+	} else {
+		input.BillingMode = types.BillingModeProvisioned
+		input.ProvisionedThroughput = toProvisionedThroughput(source.ProvisionedThroughput)
+	}
+
+	for _, gsi := range source.GlobalSecondaryIndexes {
+		index := types.GlobalSecondaryIndex{
+			IndexName:  gsi.IndexName,
+			KeySchema:  gsi.KeySchema,
+			Projection: gsi.Projection,
+		}
+		if !isOnDemand {
+			index.ProvisionedThroughput = toProvisionedThroughput(gsi.ProvisionedThroughput)
+		}
+		input.GlobalSecondaryIndexes = append(input.GlobalSecondaryIndexes, index)
+	}
+	for _, lsi := range source.LocalSecondaryIndexes {
+		input.LocalSecondaryIndexes = append(input.LocalSecondaryIndexes, types.LocalSecondaryIndex{
+			IndexName:  lsi.IndexName,
+			KeySchema:  lsi.KeySchema,
+			Projection: lsi.Projection,
+		})
+	}
+	return input
+}
+
+func toProvisionedThroughput(desc *types.ProvisionedThroughputDescription) *types.ProvisionedThroughput {
+	// provisioned tables require capacity units to be at least 1
+	var read, write int64 = 1, 1
+	if desc != nil {
+		if desc.ReadCapacityUnits != nil && *desc.ReadCapacityUnits > 0 {
+			read = *desc.ReadCapacityUnits
+		}
+		if desc.WriteCapacityUnits != nil && *desc.WriteCapacityUnits > 0 {
+			write = *desc.WriteCapacityUnits
+		}
+	}
+	return &types.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(read),
+		WriteCapacityUnits: aws.Int64(write),
+	}
+}
+
+// Deletes every item in the table by scanning the table keys and issuing batched delete requests
+func (d *DynamoDbClient) TruncateTable(ctx context.Context, tableName string) error {
+	tableKey, err := d.GetTableKey(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	keyAttributes := []string{tableKey.HashKey}
+	if tableKey.RangeKey != "" {
+		keyAttributes = append(keyAttributes, tableKey.RangeKey)
+	}
+	projection, attributeNames := buildKeyProjection(keyAttributes)
+
+	var lastEvaluatedKey map[string]types.AttributeValue
+	for {
+		output, err := d.client.Scan(ctx, &dynamodb.ScanInput{
+			TableName:                &tableName,
+			ExclusiveStartKey:        lastEvaluatedKey,
+			ProjectionExpression:     &projection,
+			ExpressionAttributeNames: attributeNames,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scan table %s: %w", tableName, err)
+		}
+
+		for start := 0; start < len(output.Items); start += maxBatchWriteItems {
+			end := min(start+maxBatchWriteItems, len(output.Items))
+			deleteReqs := make([]types.WriteRequest, 0, end-start)
+			for _, item := range output.Items[start:end] {
+				deleteReqs = append(deleteReqs, types.WriteRequest{DeleteRequest: &types.DeleteRequest{Key: item}})
+			}
+			if err := d.batchWriteAll(ctx, tableName, deleteReqs); err != nil {
+				return err
+			}
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return nil
+		}
+		lastEvaluatedKey = output.LastEvaluatedKey
+	}
+}
+
+// Writes the requests, retrying any unprocessed items until they have all been processed
+func (d *DynamoDbClient) batchWriteAll(ctx context.Context, tableName string, requests []types.WriteRequest) error {
+	backoff := 100 * time.Millisecond
+	for len(requests) > 0 {
+		output, err := d.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]types.WriteRequest{tableName: requests},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to batch write items to table %s: %w", tableName, err)
+		}
+		requests = output.UnprocessedItems[tableName]
+		if len(requests) == 0 {
+			return nil
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff = min(backoff*2, 5*time.Second)
+	}
+	return nil
+}
+
+// Key attributes are referenced by placeholder as they may collide with dynamodb reserved words
+func buildKeyProjection(keyAttributes []string) (projection string, attributeNames map[string]string) {
+	attributeNames = map[string]string{}
+	placeholders := make([]string, len(keyAttributes))
+	for idx, attr := range keyAttributes {
+		placeholder := fmt.Sprintf("#k%d", idx)
+		attributeNames[placeholder] = attr
+		placeholders[idx] = placeholder
+	}
+	return strings.Join(placeholders, ", "), attributeNames
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -29,3 +30,97 @@ func Test_DynamoDbClient_ListAllTables(t *testing.T) {
 	require.NoError(t, err)
 	require.ElementsMatch(t, []string{"foo", "bar"}, tableNames)
 }
+
+func Test_DynamoDbClient_DescribeTable_NotFound(t *testing.T) {
+	mockApi := NewMockdynamoDBAPIV2(t)
+	client := NewDynamoDbClient(mockApi)
+
+	mockApi.On("DescribeTable", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &types.ResourceNotFoundException{Message: aws.String("not found")}).Once()
+
+	table, err := client.DescribeTable(context.Background(), "foo")
+	require.NoError(t, err)
+	require.Nil(t, table)
+}
+
+func Test_DynamoDbClient_TruncateTable(t *testing.T) {
+	mockApi := NewMockdynamoDBAPIV2(t)
+	client := NewDynamoDbClient(mockApi)
+
+	mockApi.On("DescribeTable", mock.Anything, mock.Anything, mock.Anything).
+		Return(&dynamodb.DescribeTableOutput{Table: &types.TableDescription{
+			KeySchema: []types.KeySchemaElement{
+				{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+				{AttributeName: aws.String("sk"), KeyType: types.KeyTypeRange},
+			},
+		}}, nil).Once()
+
+	items := make([]map[string]types.AttributeValue, 30)
+	for idx := range items {
+		items[idx] = map[string]types.AttributeValue{
+			"pk": &types.AttributeValueMemberS{Value: "a"},
+			"sk": &types.AttributeValueMemberN{Value: "1"},
+		}
+	}
+	mockApi.On("Scan", mock.Anything, mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return *input.ProjectionExpression == "#k0, #k1" && input.ExpressionAttributeNames["#k0"] == "pk" && input.ExpressionAttributeNames["#k1"] == "sk"
+	}), mock.Anything).Return(&dynamodb.ScanOutput{Items: items}, nil).Once()
+
+	unprocessed := []types.WriteRequest{{DeleteRequest: &types.DeleteRequest{Key: items[0]}}}
+	mockApi.On("BatchWriteItem", mock.Anything, mock.MatchedBy(func(input *dynamodb.BatchWriteItemInput) bool {
+		return len(input.RequestItems["foo"]) == 25
+	}), mock.Anything).Return(&dynamodb.BatchWriteItemOutput{UnprocessedItems: map[string][]types.WriteRequest{"foo": unprocessed}}, nil).Once()
+	mockApi.On("BatchWriteItem", mock.Anything, mock.MatchedBy(func(input *dynamodb.BatchWriteItemInput) bool {
+		return len(input.RequestItems["foo"]) == 1
+	}), mock.Anything).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+	mockApi.On("BatchWriteItem", mock.Anything, mock.MatchedBy(func(input *dynamodb.BatchWriteItemInput) bool {
+		return len(input.RequestItems["foo"]) == 5
+	}), mock.Anything).Return(&dynamodb.BatchWriteItemOutput{}, nil).Once()
+
+	err := client.TruncateTable(context.Background(), "foo")
+	require.NoError(t, err)
+}
+
+func Test_BuildCreateTableInput(t *testing.T) {
+	keySchema := []types.KeySchemaElement{{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash}}
+	attributes := []types.AttributeDefinition{
+		{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		{AttributeName: aws.String("email"), AttributeType: types.ScalarAttributeTypeS},
+	}
+	gsiKeySchema := []types.KeySchemaElement{{AttributeName: aws.String("email"), KeyType: types.KeyTypeHash}}
+	projection := &types.Projection{ProjectionType: types.ProjectionTypeAll}
+
+	t.Run("on demand", func(t *testing.T) {
+		input := BuildCreateTableInput("dest", &types.TableDescription{
+			AttributeDefinitions: attributes,
+			KeySchema:            keySchema,
+			BillingModeSummary:   &types.BillingModeSummary{BillingMode: types.BillingModePayPerRequest},
+			GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{
+				{IndexName: aws.String("email-index"), KeySchema: gsiKeySchema, Projection: projection},
+			},
+		})
+		require.Equal(t, "dest", *input.TableName)
+		require.Equal(t, types.BillingModePayPerRequest, input.BillingMode)
+		require.Nil(t, input.ProvisionedThroughput)
+		require.Equal(t, attributes, input.AttributeDefinitions)
+		require.Equal(t, keySchema, input.KeySchema)
+		require.Equal(t, []types.GlobalSecondaryIndex{
+			{IndexName: aws.String("email-index"), KeySchema: gsiKeySchema, Projection: projection},
+		}, input.GlobalSecondaryIndexes)
+	})
+
+	t.Run("provisioned", func(t *testing.T) {
+		input := BuildCreateTableInput("dest", &types.TableDescription{
+			AttributeDefinitions:  attributes,
+			KeySchema:             keySchema,
+			ProvisionedThroughput: &types.ProvisionedThroughputDescription{ReadCapacityUnits: aws.Int64(5), WriteCapacityUnits: aws.Int64(10)},
+			GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{
+				{IndexName: aws.String("email-index"), KeySchema: gsiKeySchema, Projection: projection},
+			},
+		})
+		require.Equal(t, types.BillingModeProvisioned, input.BillingMode)
+		require.Equal(t, &types.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(5), WriteCapacityUnits: aws.Int64(10)}, input.ProvisionedThroughput)
+		require.Len(t, input.GlobalSecondaryIndexes, 1)
+		require.Equal(t, &types.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)}, input.GlobalSecondaryIndexes[0].ProvisionedThroughput)
+	})
+}
@@ -12,17 +12,24 @@ import (
 	"sync"
 	"time"
 
+	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
 	"github.com/go-logr/logr"
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
+	"github.com/nucleuscloud/neosync/backend/pkg/secretresolver"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/nucleuscloud/neosync/worker/internal/runlogs"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	notifyjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/notify-job-run"
+	recordjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/record-job-run"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
@@ -44,6 +51,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/worker"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -114,8 +122,23 @@ func serve(ctx context.Context) error {
 		}
 	}
 
-	temporalClient, err := client.Dial(client.Options{
-		Logger:    logger,
+	neosyncurl := shared.GetNeosyncUrl()
+	httpclient := shared.GetNeosyncHttpClient()
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
+
+	// the temporal client is only used by the run log shipper once it starts flushing, which happens after it has been dialed
+	var temporalClient client.Client
+	var runLogShipper *runlogs.Shipper
+	temporalLogger := logger
+	if getIsRunLogShippingEnabled() {
+		runLogShipper = runlogs.NewShipper(jobclient, func(ctx context.Context, workflowId string) (string, error) {
+			return getJobRunAccountId(ctx, temporalClient, jobclient, workflowId)
+		}, logger)
+		temporalLogger = slog.New(runlogs.NewHandler(logger.Handler(), runLogShipper))
+	}
+
+	temporalClient, err = client.Dial(client.Options{
+		Logger:    temporalLogger,
 		HostPort:  temporalUrl,
 		Namespace: temporalNamespace,
 		ConnectionOptions: client.ConnectionOptions{
@@ -137,11 +160,14 @@ func serve(ctx context.Context) error {
 	mysqlquerier := mysql_queries.New()
 	mssqlquerier := mssql_queries.New()
 
-	neosyncurl := shared.GetNeosyncUrl()
-	httpclient := shared.GetNeosyncHttpClient()
-	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl)
-	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
+	secretResolver, err := secretresolver.NewFromConfig(ctx, secretresolver.GetConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("unable to configure secret resolver: %w", err)
+	}
+	// every activity retrieves its connections with this client, which resolves their secret references
+	connclient := secretresolver.NewConnectionServiceClient(mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl), secretResolver)
 	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl)
+	notificationclient := mgmtv1alpha1connect.NewNotificationServiceClient(httpclient, neosyncurl)
 	sqlconnector := &sqlconnect.SqlOpenConnector{}
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlconnector)
 	redisconfig := shared.GetRedisConfig()
@@ -157,7 +183,9 @@ func serve(ctx context.Context) error {
 	disableReaper := false
 	syncActivity := sync_activity.New(connclient, jobclient, &sync.Map{}, temporalClient, activityMeter, sync_activity.NewBenthosStreamManager(), disableReaper)
 	retrieveActivityOpts := syncactivityopts_activity.New(jobclient)
-	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager)
+	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager, mongoconnect.NewConnector())
+	recordJobRun := recordjobrun_activity.New(jobclient)
+	notifyJobRun := notifyjobrun_activity.New(notificationclient)
 
 	w.RegisterWorkflow(datasync_workflow.Workflow)
 	w.RegisterActivity(syncActivity.Sync)
@@ -165,11 +193,25 @@ func serve(ctx context.Context) error {
 	w.RegisterActivity(runSqlInitTableStatements.RunSqlInitTableStatements)
 	w.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
 	w.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
+	w.RegisterActivity(recordJobRun.RecordJobRun)
+	w.RegisterActivity(notifyJobRun.NotifyJobRun)
 
 	if err := w.Start(); err != nil {
 		return fmt.Errorf("unable to start temporal worker: %w", err)
 	}
 
+	shipperDone := make(chan struct{})
+	shipperCtx, stopShipper := context.WithCancel(context.Background())
+	defer stopShipper()
+	if runLogShipper != nil {
+		go func() {
+			runLogShipper.Run(shipperCtx)
+			close(shipperDone)
+		}()
+	} else {
+		close(shipperDone)
+	}
+
 	httpServer := getHttpServer(loglogger)
 
 	go func() {
@@ -183,6 +225,8 @@ func serve(ctx context.Context) error {
 	<-worker.InterruptCh()
 	logger.Info("received interrupt, stopping worker...")
 	w.Stop()
+	stopShipper()
+	<-shipperDone
 	logger.Info("temporal worker shut down, proceeding to shutting down http server")
 	ctx, cancelHandler := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
 	defer cancelHandler()
@@ -353,6 +397,36 @@ func getConfiguredMeterProvider(ctx context.Context) (*metricsdk.MeterProvider,
 	return provider, true, nil
 }
 
+func getIsRunLogShippingEnabled() bool {
+	return viper.GetBool("RUN_LOGS_SHIPPING_ENABLED")
+}
+
+// Looks up the account of a job run via the job that scheduled the workflow
+func getJobRunAccountId(
+	ctx context.Context,
+	temporalClient client.Client,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	workflowId string,
+) (string, error) {
+	resp, err := temporalClient.DescribeWorkflowExecution(ctx, workflowId, "")
+	if err != nil {
+		return "", fmt.Errorf("unable to describe workflow execution: %w", err)
+	}
+	payload, ok := resp.GetWorkflowExecutionInfo().GetSearchAttributes().GetIndexedFields()["TemporalScheduledById"]
+	if !ok {
+		return "", fmt.Errorf("workflow %s was not scheduled by a job", workflowId)
+	}
+	var jobId string
+	if err := converter.GetDefaultDataConverter().FromPayload(payload, &jobId); err != nil {
+		return "", fmt.Errorf("unable to decode job id of workflow: %w", err)
+	}
+	jobResp, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve job: %w", err)
+	}
+	return jobResp.Msg.GetJob().GetAccountId(), nil
+}
+
 func getIsOtelEnabled() bool {
 	isDisabledStr := viper.GetString("OTEL_SDK_DISABLED")
 	if isDisabledStr == "" {
@@ -287,6 +287,8 @@ func getDriverFromConnection(connection *mgmtv1alpha1.Connection) (string, error
 		return "mongodb", nil
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return "sqlserver", nil
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		return "clickhouse", nil
 	}
 	return "", fmt.Errorf("unsupported connection type when computing driver: %T", connection.GetConnectionConfig().Config)
 }
@@ -72,6 +72,11 @@ func getMaxConnectionLimitFromConnection(cc *mgmtv1alpha1.ConnectionConfig) *int
 			return config.MssqlConfig.GetConnectionOptions().MaxConnectionLimit
 		}
 		return nil
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		if config.ClickhouseConfig != nil && config.ClickhouseConfig.GetConnectionOptions() != nil {
+			return config.ClickhouseConfig.GetConnectionOptions().MaxConnectionLimit
+		}
+		return nil
 	}
 	return nil
 }
@@ -0,0 +1,182 @@
+package runlogs
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	workflowIdKey   = "WorkflowID"
+	accountIdKey    = "accountId"
+	activityTypeKey = "ActivityType"
+	schemaKey       = "schema"
+	tableKey        = "table"
+	// the sync activity logs its schema and table as a struct under this key
+	metadataKey = "metadata"
+)
+
+// Handler is a slog.Handler that passes every record on to the wrapped handler
+// and additionally ships records that belong to a job run to the Neosync API.
+// Records belong to a job run if they carry a WorkflowID attribute, which Temporal adds to all workflow and activity loggers.
+type Handler struct {
+	inner   slog.Handler
+	shipper *Shipper
+
+	attrs  []slog.Attr
+	groups []string
+}
+
+var _ slog.Handler = &Handler{}
+
+func NewHandler(inner slog.Handler, shipper *Shipper) *Handler {
+	return &Handler{inner: inner, shipper: shipper}
+}
+
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	err := h.inner.Handle(ctx, record)
+	h.ship(record)
+	return err
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	prefixed := make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	prefixed = append(prefixed, h.attrs...)
+	prefix := h.groupPrefix()
+	for _, attr := range attrs {
+		prefixed = append(prefixed, slog.Attr{Key: prefix + attr.Key, Value: attr.Value})
+	}
+	return &Handler{inner: h.inner.WithAttrs(attrs), shipper: h.shipper, attrs: prefixed, groups: h.groups}
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	groups := make([]string, 0, len(h.groups)+1)
+	groups = append(groups, h.groups...)
+	groups = append(groups, name)
+	return &Handler{inner: h.inner.WithGroup(name), shipper: h.shipper, attrs: h.attrs, groups: groups}
+}
+
+func (h *Handler) groupPrefix() string {
+	if len(h.groups) == 0 {
+		return ""
+	}
+	return strings.Join(h.groups, ".") + "."
+}
+
+func (h *Handler) ship(record slog.Record) {
+	fields := map[string]slog.Value{}
+	for _, attr := range h.attrs {
+		flattenAttr(fields, "", attr)
+	}
+	prefix := h.groupPrefix()
+	record.Attrs(func(attr slog.Attr) bool {
+		flattenAttr(fields, prefix, attr)
+		return true
+	})
+
+	workflowId := popString(fields, workflowIdKey)
+	if workflowId == "" {
+		return
+	}
+	accountId := popString(fields, accountIdKey)
+
+	entry := &mgmtv1alpha1.JobRunLogEntry{
+		Timestamp:  timestamppb.New(record.Time),
+		Level:      toLogLevel(record.Level),
+		Message:    record.Message,
+		Attributes: map[string]string{},
+	}
+	if activity := popString(fields, activityTypeKey); activity != "" {
+		entry.Activity = &activity
+	}
+	schema, table := popString(fields, schemaKey), popString(fields, tableKey)
+	if schema == "" && table == "" {
+		schema, table = popMetadata(fields)
+	}
+	if schema != "" {
+		entry.Schema = &schema
+	}
+	if table != "" {
+		entry.Table = &table
+	}
+	for key, value := range fields {
+		entry.Attributes[key] = value.String()
+	}
+
+	h.shipper.Enqueue(workflowId, accountId, entry)
+}
+
+func flattenAttr(fields map[string]slog.Value, prefix string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix = prefix + attr.Key + "."
+		}
+		for _, groupAttr := range value.Group() {
+			flattenAttr(fields, groupPrefix, groupAttr)
+		}
+		return
+	}
+	if attr.Key == "" {
+		return
+	}
+	fields[prefix+attr.Key] = value
+}
+
+func popString(fields map[string]slog.Value, key string) string {
+	value, ok := fields[key]
+	if !ok {
+		return ""
+	}
+	delete(fields, key)
+	return value.String()
+}
+
+// Pulls the schema and table out of a metadata struct, if one exists
+func popMetadata(fields map[string]slog.Value) (schema, table string) {
+	value, ok := fields[metadataKey]
+	if !ok || value.Kind() != slog.KindAny {
+		return "", ""
+	}
+	bits, err := json.Marshal(value.Any())
+	if err != nil {
+		return "", ""
+	}
+	var metadata struct {
+		Schema string
+		Table  string
+	}
+	if err := json.Unmarshal(bits, &metadata); err != nil {
+		return "", ""
+	}
+	if metadata.Schema == "" && metadata.Table == "" {
+		return "", ""
+	}
+	delete(fields, metadataKey)
+	return metadata.Schema, metadata.Table
+}
+
+func toLogLevel(level slog.Level) mgmtv1alpha1.LogLevel {
+	switch {
+	case level < slog.LevelInfo:
+		return mgmtv1alpha1.LogLevel_LOG_LEVEL_DEBUG
+	case level < slog.LevelWarn:
+		return mgmtv1alpha1.LogLevel_LOG_LEVEL_INFO
+	case level < slog.LevelError:
+		return mgmtv1alpha1.LogLevel_LOG_LEVEL_WARN
+	default:
+		return mgmtv1alpha1.LogLevel_LOG_LEVEL_ERROR
+	}
+}
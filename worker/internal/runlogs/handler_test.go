@@ -0,0 +1,61 @@
+package runlogs
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/require"
+)
+
+type syncMetadata struct {
+	Schema string
+	Table  string
+}
+
+func Test_Handler_ShipsJobRunRecords(t *testing.T) {
+	shipper := NewShipper(mgmtv1alpha1connect.NewMockJobServiceClient(t), nil, slog.Default())
+	buf := &bytes.Buffer{}
+	logger := slog.New(NewHandler(slog.NewJSONHandler(buf, nil), shipper))
+
+	logger.With("WorkflowID", "wf-1", "ActivityType", "Sync").
+		Error("sync failed", "metadata", &syncMetadata{Schema: "public", Table: "users"}, "accountId", "acc-1", "Attempt", 2)
+
+	require.Contains(t, buf.String(), "sync failed", "records must still be passed to the wrapped handler")
+	run, ok := shipper.pending["wf-1"]
+	require.True(t, ok)
+	require.Equal(t, "acc-1", run.accountId)
+	require.Len(t, run.entries, 1)
+	entry := run.entries[0]
+	require.Equal(t, "sync failed", entry.GetMessage())
+	require.Equal(t, mgmtv1alpha1.LogLevel_LOG_LEVEL_ERROR, entry.GetLevel())
+	require.Equal(t, "Sync", entry.GetActivity())
+	require.Equal(t, "public", entry.GetSchema())
+	require.Equal(t, "users", entry.GetTable())
+	require.Equal(t, map[string]string{"Attempt": "2"}, entry.GetAttributes())
+}
+
+func Test_Handler_SkipsRecordsWithoutWorkflow(t *testing.T) {
+	shipper := NewShipper(mgmtv1alpha1connect.NewMockJobServiceClient(t), nil, slog.Default())
+	buf := &bytes.Buffer{}
+	logger := slog.New(NewHandler(slog.NewJSONHandler(buf, nil), shipper))
+
+	logger.Info("worker started")
+
+	require.Contains(t, buf.String(), "worker started")
+	require.Empty(t, shipper.pending)
+}
+
+func Test_Handler_Groups(t *testing.T) {
+	shipper := NewShipper(mgmtv1alpha1connect.NewMockJobServiceClient(t), nil, slog.Default())
+	logger := slog.New(NewHandler(slog.NewJSONHandler(&bytes.Buffer{}, nil), shipper))
+
+	logger.With("WorkflowID", "wf-1").WithGroup("benthos").Warn("retrying", "count", 3)
+
+	run := shipper.pending["wf-1"]
+	require.NotNil(t, run)
+	require.Equal(t, mgmtv1alpha1.LogLevel_LOG_LEVEL_WARN, run.entries[0].GetLevel())
+	require.Equal(t, map[string]string{"benthos.count": "3"}, run.entries[0].GetAttributes())
+}
@@ -0,0 +1,183 @@
+package runlogs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+const (
+	defaultFlushInterval = 2 * time.Second
+	// a flush is triggered early once this many entries are buffered
+	flushBatchSize = 500
+	// entries are dropped once this many are buffered and have not yet been shipped
+	maxBufferedEntries = 10000
+	// must line up with the max_items validation of IngestJobRunLogsRequest.entries
+	maxEntriesPerRequest = 1000
+	// the account cache is reset once it grows beyond this size
+	maxCachedAccounts = 1000
+)
+
+// Resolves the Neosync account that a job run belongs to
+type AccountResolver func(ctx context.Context, workflowId string) (string, error)
+
+// Buffers run log entries and periodically ships them to the Neosync API in batches
+type Shipper struct {
+	jobclient      mgmtv1alpha1connect.JobServiceClient
+	resolveAccount AccountResolver
+	// used to report errors of the shipper itself. Must not be backed by a handler that ships logs.
+	logger *slog.Logger
+
+	flushInterval time.Duration
+	flushCh       chan struct{}
+
+	mu       sync.Mutex
+	pending  map[string]*pendingRun
+	size     int
+	dropped  int
+	accounts map[string]string
+}
+
+type pendingRun struct {
+	accountId string
+	entries   []*mgmtv1alpha1.JobRunLogEntry
+}
+
+func NewShipper(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	resolveAccount AccountResolver,
+	logger *slog.Logger,
+) *Shipper {
+	return &Shipper{
+		jobclient:      jobclient,
+		resolveAccount: resolveAccount,
+		logger:         logger,
+		flushInterval:  defaultFlushInterval,
+		flushCh:        make(chan struct{}, 1),
+		pending:        map[string]*pendingRun{},
+		accounts:       map[string]string{},
+	}
+}
+
+// Buffers a log entry for the given job run. The account id is optional and is resolved at flush time if it is not known.
+func (s *Shipper) Enqueue(workflowId, accountId string, entry *mgmtv1alpha1.JobRunLogEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size >= maxBufferedEntries {
+		s.dropped++
+		return
+	}
+	run, ok := s.pending[workflowId]
+	if !ok {
+		run = &pendingRun{}
+		s.pending[workflowId] = run
+	}
+	if accountId != "" {
+		run.accountId = accountId
+	}
+	run.entries = append(run.entries, entry)
+	s.size++
+
+	if s.size >= flushBatchSize {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Ships buffered entries until the context is canceled, at which point any remaining entries are flushed one last time
+func (s *Shipper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			s.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			s.Flush(ctx)
+		case <-s.flushCh:
+			s.Flush(ctx)
+		}
+	}
+}
+
+// Ships all currently buffered entries to the Neosync API.
+// Entries that fail to be shipped are dropped so that a misbehaving API can never grow the buffer without bounds.
+func (s *Shipper) Flush(ctx context.Context) {
+	s.mu.Lock()
+	pending := s.pending
+	dropped := s.dropped
+	s.pending = map[string]*pendingRun{}
+	s.size = 0
+	s.dropped = 0
+	s.mu.Unlock()
+
+	if dropped > 0 {
+		s.logger.Warn(fmt.Sprintf("dropped %d run log entries as the run log buffer was full", dropped))
+	}
+
+	for workflowId, run := range pending {
+		accountId, err := s.getAccountId(ctx, workflowId, run.accountId)
+		if err != nil {
+			s.logger.Error(fmt.Sprintf("unable to resolve account for run logs of job run %s: %s", workflowId, err.Error()))
+			continue
+		}
+		for start := 0; start < len(run.entries); start += maxEntriesPerRequest {
+			end := min(start+maxEntriesPerRequest, len(run.entries))
+			_, err := s.jobclient.IngestJobRunLogs(ctx, connect.NewRequest(&mgmtv1alpha1.IngestJobRunLogsRequest{
+				AccountId: accountId,
+				JobRunId:  workflowId,
+				Entries:   run.entries[start:end],
+			}))
+			if err != nil {
+				s.logger.Error(fmt.Sprintf("unable to ship run logs of job run %s: %s", workflowId, err.Error()))
+				break
+			}
+		}
+	}
+}
+
+func (s *Shipper) getAccountId(ctx context.Context, workflowId, accountId string) (string, error) {
+	s.mu.Lock()
+	if accountId != "" {
+		s.cacheAccount(workflowId, accountId)
+		s.mu.Unlock()
+		return accountId, nil
+	}
+	cached, ok := s.accounts[workflowId]
+	s.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+	if s.resolveAccount == nil {
+		return "", fmt.Errorf("account id is unknown and no account resolver was provided")
+	}
+
+	resolved, err := s.resolveAccount(ctx, workflowId)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	s.cacheAccount(workflowId, resolved)
+	s.mu.Unlock()
+	return resolved, nil
+}
+
+// must be called with the mutex held
+func (s *Shipper) cacheAccount(workflowId, accountId string) {
+	if _, ok := s.accounts[workflowId]; !ok && len(s.accounts) >= maxCachedAccounts {
+		s.accounts = map[string]string{}
+	}
+	s.accounts[workflowId] = accountId
+}
@@ -0,0 +1,55 @@
+package runlogs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Shipper_Flush(t *testing.T) {
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	resolved := 0
+	shipper := NewShipper(jobclient, func(ctx context.Context, workflowId string) (string, error) {
+		resolved++
+		return "acc-2", nil
+	}, slog.Default())
+
+	for i := 0; i < maxEntriesPerRequest+1; i++ {
+		shipper.Enqueue("wf-1", "acc-1", &mgmtv1alpha1.JobRunLogEntry{Message: "a"})
+	}
+	shipper.Enqueue("wf-2", "", &mgmtv1alpha1.JobRunLogEntry{Message: "b"})
+
+	jobclient.On("IngestJobRunLogs", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) bool {
+		return req.Msg.GetJobRunId() == "wf-1" && req.Msg.GetAccountId() == "acc-1"
+	})).Return(connect.NewResponse(&mgmtv1alpha1.IngestJobRunLogsResponse{}), nil).Twice()
+	jobclient.On("IngestJobRunLogs", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.IngestJobRunLogsRequest]) bool {
+		return req.Msg.GetJobRunId() == "wf-2" && req.Msg.GetAccountId() == "acc-2" && len(req.Msg.GetEntries()) == 1
+	})).Return(connect.NewResponse(&mgmtv1alpha1.IngestJobRunLogsResponse{}), nil).Once()
+
+	shipper.Flush(context.Background())
+	require.Empty(t, shipper.pending)
+	require.Equal(t, 1, resolved)
+
+	// the resolved account is cached for subsequent flushes
+	jobclient.On("IngestJobRunLogs", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IngestJobRunLogsResponse{}), nil).Once()
+	shipper.Enqueue("wf-2", "", &mgmtv1alpha1.JobRunLogEntry{Message: "c"})
+	shipper.Flush(context.Background())
+	require.Equal(t, 1, resolved)
+}
+
+func Test_Shipper_DropsWhenFull(t *testing.T) {
+	shipper := NewShipper(mgmtv1alpha1connect.NewMockJobServiceClient(t), nil, slog.Default())
+
+	for i := 0; i < maxBufferedEntries+5; i++ {
+		shipper.Enqueue("wf-1", "acc-1", &mgmtv1alpha1.JobRunLogEntry{Message: "a"})
+	}
+
+	require.Len(t, shipper.pending["wf-1"].entries, maxBufferedEntries)
+	require.Equal(t, 5, shipper.dropped)
+}
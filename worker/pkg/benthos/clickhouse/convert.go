@@ -0,0 +1,300 @@
+package neosync_benthos_clickhouse
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// The native protocol requires that values match the Go type of the destination column exactly.
+// This takes the loosely typed values produced by benthos and converts them to the type expected by the ClickHouse column.
+func convertValue(value any, chType string) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	baseType := unwrapType(chType)
+
+	switch {
+	case strings.HasPrefix(baseType, "UInt") || strings.HasPrefix(baseType, "Int"):
+		return toInteger(value, baseType)
+	case baseType == "Float32":
+		f, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		return float32(f), nil
+	case baseType == "Float64":
+		return toFloat64(value)
+	case strings.HasPrefix(baseType, "Decimal"):
+		return toDecimal(value)
+	case baseType == "Bool":
+		return toBool(value)
+	case strings.HasPrefix(baseType, "Date"):
+		return toTime(value)
+	case baseType == "UUID":
+		return toString(value)
+	case strings.HasPrefix(baseType, "String"), strings.HasPrefix(baseType, "FixedString"), strings.HasPrefix(baseType, "Enum"):
+		return toString(value)
+	default:
+		return value, nil
+	}
+}
+
+func unwrapType(chType string) string {
+	for _, wrapper := range []string{"Nullable(", "LowCardinality("} {
+		if strings.HasPrefix(chType, wrapper) && strings.HasSuffix(chType, ")") {
+			return unwrapType(strings.TrimSuffix(strings.TrimPrefix(chType, wrapper), ")"))
+		}
+	}
+	return chType
+}
+
+func toInteger(value any, chType string) (any, error) {
+	if strings.HasPrefix(chType, "UInt") {
+		u, err := toUint64(value)
+		if err != nil {
+			return nil, err
+		}
+		switch chType {
+		case "UInt8":
+			if u > math.MaxUint8 {
+				return nil, fmt.Errorf("value %d overflows %s", u, chType)
+			}
+			return uint8(u), nil
+		case "UInt16":
+			if u > math.MaxUint16 {
+				return nil, fmt.Errorf("value %d overflows %s", u, chType)
+			}
+			return uint16(u), nil
+		case "UInt32":
+			if u > math.MaxUint32 {
+				return nil, fmt.Errorf("value %d overflows %s", u, chType)
+			}
+			return uint32(u), nil
+		default:
+			return u, nil
+		}
+	}
+	i, err := toInt64(value)
+	if err != nil {
+		return nil, err
+	}
+	switch chType {
+	case "Int8":
+		if i < math.MinInt8 || i > math.MaxInt8 {
+			return nil, fmt.Errorf("value %d overflows %s", i, chType)
+		}
+		return int8(i), nil
+	case "Int16":
+		if i < math.MinInt16 || i > math.MaxInt16 {
+			return nil, fmt.Errorf("value %d overflows %s", i, chType)
+		}
+		return int16(i), nil
+	case "Int32":
+		if i < math.MinInt32 || i > math.MaxInt32 {
+			return nil, fmt.Errorf("value %d overflows %s", i, chType)
+		}
+		return int32(i), nil
+	default:
+		return i, nil
+	}
+}
+
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", v)
+		}
+		return int64(v), nil
+	case float32:
+		return floatToInt64(float64(v))
+	case float64:
+		return floatToInt64(v)
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case json.Number:
+		return v.Int64()
+	case string:
+		return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	case []byte:
+		return strconv.ParseInt(strings.TrimSpace(string(v)), 10, 64)
+	default:
+		return 0, fmt.Errorf("unable to convert %T to integer", value)
+	}
+}
+
+// Benthos decodes JSON numbers as float64, so whole floats are accepted, but fractional values are not silently truncated
+func floatToInt64(v float64) (int64, error) {
+	if v != math.Trunc(v) {
+		return 0, fmt.Errorf("fractional value %v can not be converted to an integer", v)
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, which is already out of range
+	if v < math.MinInt64 || v >= math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", v)
+	}
+	return int64(v), nil
+}
+
+func toUint64(value any) (uint64, error) {
+	switch v := value.(type) {
+	case uint64:
+		return v, nil
+	case string:
+		return strconv.ParseUint(strings.TrimSpace(v), 10, 64)
+	case json.Number:
+		return strconv.ParseUint(v.String(), 10, 64)
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return 0, err
+		}
+		if i < 0 {
+			return 0, fmt.Errorf("negative value %d can not be converted to an unsigned integer", i)
+		}
+		return uint64(i), nil
+	}
+}
+
+func toFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case []byte:
+		return strconv.ParseFloat(strings.TrimSpace(string(v)), 64)
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return 0, fmt.Errorf("unable to convert %T to float", value)
+		}
+		return float64(i), nil
+	}
+}
+
+func toDecimal(value any) (decimal.Decimal, error) {
+	switch v := value.(type) {
+	case decimal.Decimal:
+		return v, nil
+	case float64:
+		return decimal.NewFromFloat(v), nil
+	case float32:
+		return decimal.NewFromFloat32(v), nil
+	case json.Number:
+		return decimal.NewFromString(v.String())
+	case string:
+		return decimal.NewFromString(strings.TrimSpace(v))
+	case []byte:
+		return decimal.NewFromString(strings.TrimSpace(string(v)))
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("unable to convert %T to decimal", value)
+		}
+		return decimal.NewFromInt(i), nil
+	}
+}
+
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	case []byte:
+		return strconv.ParseBool(strings.TrimSpace(string(v)))
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return false, fmt.Errorf("unable to convert %T to bool", value)
+		}
+		return i != 0, nil
+	}
+}
+
+func toTime(value any) (any, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+		// let clickhouse attempt to parse any remaining formats
+		return v, nil
+	case []byte:
+		return toTime(string(v))
+	case float64:
+		// fractional unix timestamps keep their sub-second precision
+		sec, frac := math.Modf(v)
+		if _, err := floatToInt64(sec); err != nil {
+			return nil, err
+		}
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert %T to time", value)
+		}
+		return time.Unix(i, 0).UTC(), nil
+	}
+}
+
+func toString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case map[string]any, []any:
+		bits, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(bits), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
@@ -0,0 +1,80 @@
+package neosync_benthos_clickhouse
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_convertValue(t *testing.T) {
+	ts := time.Date(2024, 8, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    any
+		chType   string
+		expected any
+	}{
+		{name: "nil", value: nil, chType: "Nullable(Int32)", expected: nil},
+		{name: "float to int32", value: float64(12), chType: "Int32", expected: int32(12)},
+		{name: "json number to int64", value: json.Number("42"), chType: "Nullable(Int64)", expected: int64(42)},
+		{name: "string to uint8", value: "7", chType: "UInt8", expected: uint8(7)},
+		{name: "min int8", value: float64(-128), chType: "Int8", expected: int8(-128)},
+		{name: "max uint32", value: int64(4294967295), chType: "UInt32", expected: uint32(4294967295)},
+		{name: "int to float32", value: int64(3), chType: "Float32", expected: float32(3)},
+		{name: "string to float64", value: "1.5", chType: "Float64", expected: float64(1.5)},
+		{name: "string to decimal", value: "10.25", chType: "Decimal(10, 2)", expected: decimal.RequireFromString("10.25")},
+		{name: "int to bool", value: int64(1), chType: "Bool", expected: true},
+		{name: "string to datetime", value: "2024-08-01T12:30:00Z", chType: "DateTime64(6)", expected: ts},
+		{name: "string to date", value: "2024-08-01", chType: "Date32", expected: time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "fractional unix seconds to datetime", value: float64(ts.Unix()) + 0.5, chType: "DateTime64(3)", expected: ts.Add(500 * time.Millisecond)},
+		{name: "bytes to string", value: []byte("foo"), chType: "LowCardinality(String)", expected: "foo"},
+		{name: "map to string", value: map[string]any{"a": float64(1)}, chType: "String", expected: `{"a":1}`},
+		{name: "int to string", value: int64(5), chType: "String", expected: "5"},
+		{name: "uuid passthrough", value: "6f1e0a1c-8a43-4c8e-8b52-2a3d4e6a6c11", chType: "UUID", expected: "6f1e0a1c-8a43-4c8e-8b52-2a3d4e6a6c11"},
+		{name: "unknown type passthrough", value: []any{"a"}, chType: "Array(String)", expected: []any{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := convertValue(tt.value, tt.chType)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_convertValue_Errors(t *testing.T) {
+	_, err := convertValue("foo", "Int32")
+	require.Error(t, err)
+	_, err = convertValue(int64(-1), "UInt64")
+	require.Error(t, err)
+	_, err = convertValue("maybe", "Bool")
+	require.Error(t, err)
+
+	_, err = convertValue(float64(128), "Int8")
+	require.ErrorContains(t, err, "overflows Int8")
+	_, err = convertValue(int64(-32769), "Int16")
+	require.ErrorContains(t, err, "overflows Int16")
+	_, err = convertValue(int64(2147483648), "Int32")
+	require.ErrorContains(t, err, "overflows Int32")
+	_, err = convertValue("256", "UInt8")
+	require.ErrorContains(t, err, "overflows UInt8")
+	_, err = convertValue(int64(65536), "UInt16")
+	require.ErrorContains(t, err, "overflows UInt16")
+	_, err = convertValue(float64(1e19), "Int64")
+	require.ErrorContains(t, err, "overflows int64")
+	_, err = convertValue(float64(1.5), "Int32")
+	require.ErrorContains(t, err, "fractional value")
+	_, err = convertValue(float32(2.25), "UInt64")
+	require.ErrorContains(t, err, "fractional value")
+}
+
+func Test_unwrapType(t *testing.T) {
+	require.Equal(t, "String", unwrapType("LowCardinality(Nullable(String))"))
+	require.Equal(t, "Int32", unwrapType("Nullable(Int32)"))
+	require.Equal(t, "DateTime64(6)", unwrapType("DateTime64(6)"))
+}
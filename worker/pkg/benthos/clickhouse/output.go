@@ -0,0 +1,302 @@
+package neosync_benthos_clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/Jeffail/shutdown"
+	sqlmanager_clickhouse "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/clickhouse"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+const (
+	getColumnTypesQuery = `
+SELECT name, type
+FROM system.columns
+WHERE database = ? AND table = ?
+`
+)
+
+func clickhouseInsertOutputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Inserts batches of rows into a ClickHouse table using the native protocol.").
+		Field(service.NewStringField("dsn")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table")).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewBloblangField("args_mapping")).
+		Field(service.NewBoolField("truncate_on_retry").Optional().Default(false)).
+		Field(service.NewIntField("max_in_flight").Default(64)).
+		Field(service.NewBatchPolicyField("batching"))
+}
+
+// Registers an output on a benthos environment called clickhouse_insert
+func RegisterClickhouseInsertOutput(env *service.Environment, isRetry bool) error {
+	return env.RegisterBatchOutput(
+		"clickhouse_insert", clickhouseInsertOutputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
+			batchPolicy, err := conf.FieldBatchPolicy("batching")
+			if err != nil {
+				return nil, batchPolicy, -1, err
+			}
+
+			maxInFlight, err := conf.FieldInt("max_in_flight")
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out, err := newClickhouseInsertOutput(conf, mgr, isRetry)
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			return out, batchPolicy, maxInFlight, nil
+		},
+	)
+}
+
+var _ service.BatchOutput = &clickhouseInsertOutput{}
+
+type clickhouseInsertOutput struct {
+	dsn    string
+	connMu sync.RWMutex
+	conn   driver.Conn
+	logger *service.Logger
+
+	schema          string
+	table           string
+	columns         []string
+	columnTypes     map[string]string
+	truncateOnRetry bool
+
+	argsMapping *bloblang.Executor
+	shutSig     *shutdown.Signaller
+	isRetry     bool
+}
+
+func newClickhouseInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, isRetry bool) (*clickhouseInsertOutput, error) {
+	dsn, err := conf.FieldString("dsn")
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := conf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := conf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+
+	truncateOnRetry, err := conf.FieldBool("truncate_on_retry")
+	if err != nil {
+		return nil, err
+	}
+
+	argsMapping, err := conf.FieldBloblang("args_mapping")
+	if err != nil {
+		return nil, err
+	}
+
+	return &clickhouseInsertOutput{
+		dsn:             dsn,
+		logger:          mgr.Logger(),
+		shutSig:         shutdown.NewSignaller(),
+		argsMapping:     argsMapping,
+		schema:          schema,
+		table:           table,
+		columns:         columns,
+		truncateOnRetry: truncateOnRetry,
+		isRetry:         isRetry,
+	}, nil
+}
+
+func (c *clickhouseInsertOutput) Connect(ctx context.Context) error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.conn != nil {
+		return nil
+	}
+
+	opts, err := clickhouse.ParseDSN(c.dsn)
+	if err != nil {
+		return fmt.Errorf("unable to parse clickhouse dsn: %w", err)
+	}
+	conn, err := clickhouse.Open(opts)
+	if err != nil {
+		return fmt.Errorf("unable to open clickhouse connection: %w", err)
+	}
+	if err := conn.Ping(ctx); err != nil {
+		conn.Close()
+		return fmt.Errorf("unable to ping clickhouse: %w", err)
+	}
+
+	columnTypes, err := getColumnTypes(ctx, conn, c.schema, c.table)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	// truncate table on retry
+	if c.isRetry && c.truncateOnRetry {
+		c.logger.Info("retry: truncating table before inserting")
+		if err := conn.Exec(ctx, sqlmanager_clickhouse.BuildTruncateStatement(c.schema, c.table)); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+	c.conn = conn
+	c.columnTypes = columnTypes
+
+	go func() {
+		<-c.shutSig.HardStopChan()
+
+		c.connMu.Lock()
+		if c.conn != nil {
+			if err := c.conn.Close(); err != nil {
+				c.logger.Errorf("unable to close clickhouse connection: %s", err.Error())
+			}
+			c.conn = nil
+		}
+		c.connMu.Unlock()
+
+		c.shutSig.TriggerHasStopped()
+	}()
+	return nil
+}
+
+func getColumnTypes(ctx context.Context, conn driver.Conn, schema, table string) (map[string]string, error) {
+	rows, err := conn.Query(ctx, getColumnTypesQuery, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve clickhouse column types for %s.%s: %w", schema, table, err)
+	}
+	defer rows.Close()
+
+	columnTypes := map[string]string{}
+	for rows.Next() {
+		var name, chType string
+		if err := rows.Scan(&name, &chType); err != nil {
+			return nil, fmt.Errorf("unable to scan clickhouse column types: %w", err)
+		}
+		columnTypes[name] = chType
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read clickhouse column types: %w", err)
+	}
+	if len(columnTypes) == 0 {
+		return nil, fmt.Errorf("clickhouse table %s.%s does not exist or has no columns", schema, table)
+	}
+	return columnTypes, nil
+}
+
+func (c *clickhouseInsertOutput) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	c.connMu.RLock()
+	defer c.connMu.RUnlock()
+
+	if c.conn == nil {
+		return service.ErrNotConnected
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	if c.argsMapping == nil {
+		return errors.New("clickhouse insert output requires an args_mapping to build the row values")
+	}
+
+	insertBatch, err := c.conn.PrepareBatch(ctx, buildInsertPrefix(c.schema, c.table, c.columns))
+	if err != nil {
+		return fmt.Errorf("unable to prepare clickhouse batch: %w", err)
+	}
+
+	executor := batch.BloblangExecutor(c.argsMapping)
+	for i := range batch {
+		resMsg, err := executor.Query(i)
+		if err != nil {
+			_ = insertBatch.Abort()
+			return err
+		}
+
+		iargs, err := resMsg.AsStructured()
+		if err != nil {
+			_ = insertBatch.Abort()
+			return err
+		}
+
+		args, ok := iargs.([]any)
+		if !ok {
+			_ = insertBatch.Abort()
+			return fmt.Errorf("mapping returned non-array result: %T", iargs)
+		}
+
+		row, err := c.convertRow(args)
+		if err != nil {
+			_ = insertBatch.Abort()
+			return err
+		}
+		if err := insertBatch.Append(row...); err != nil {
+			_ = insertBatch.Abort()
+			return fmt.Errorf("unable to append row to clickhouse batch: %w", err)
+		}
+	}
+
+	if err := insertBatch.Send(); err != nil {
+		return fmt.Errorf("unable to send clickhouse batch: %w", err)
+	}
+	return nil
+}
+
+func (c *clickhouseInsertOutput) convertRow(args []any) ([]any, error) {
+	if len(args) != len(c.columns) {
+		return nil, fmt.Errorf("mapping returned %d values but %d columns were configured", len(args), len(c.columns))
+	}
+	row := make([]any, len(args))
+	for idx, arg := range args {
+		col := c.columns[idx]
+		chType, ok := c.columnTypes[col]
+		if !ok {
+			return nil, fmt.Errorf("column %s does not exist in clickhouse table %s.%s", col, c.schema, c.table)
+		}
+		value, err := convertValue(arg, chType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert value for column %s to %s: %w", col, chType, err)
+		}
+		row[idx] = value
+	}
+	return row, nil
+}
+
+func buildInsertPrefix(schema, table string, columns []string) string {
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s)",
+		sqlmanager_clickhouse.BuildTableName(schema, table),
+		strings.Join(sqlmanager_clickhouse.EscapeClickhouseColumns(columns), ", "),
+	)
+}
+
+func (c *clickhouseInsertOutput) Close(ctx context.Context) error {
+	c.shutSig.TriggerHardStop()
+	c.connMu.RLock()
+	isNil := c.conn == nil
+	c.connMu.RUnlock()
+	if isNil {
+		return nil
+	}
+	select {
+	case <-c.shutSig.HasStoppedChan():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
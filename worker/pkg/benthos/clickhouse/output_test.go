@@ -0,0 +1,56 @@
+package neosync_benthos_clickhouse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func Test_ClickhouseInsertOutputEmptyShutdown(t *testing.T) {
+	conf := `
+dsn: clickhouse://localhost:9000
+schema: bar
+table: baz
+columns: [id]
+args_mapping: 'root = [this.id]'
+`
+	spec := clickhouseInsertOutputSpec()
+	env := service.NewEnvironment()
+
+	insertConfig, err := spec.ParseYAML(conf, env)
+	require.NoError(t, err)
+
+	insertOutput, err := newClickhouseInsertOutput(insertConfig, service.MockResources(), false)
+	require.NoError(t, err)
+	require.NoError(t, insertOutput.Close(context.Background()))
+}
+
+func Test_buildInsertPrefix(t *testing.T) {
+	require.Equal(
+		t,
+		"INSERT INTO `public`.`users` (`id`, `name`)",
+		buildInsertPrefix("public", "users", []string{"id", "name"}),
+	)
+}
+
+func Test_convertRow(t *testing.T) {
+	out := &clickhouseInsertOutput{
+		schema:      "public",
+		table:       "users",
+		columns:     []string{"id", "name"},
+		columnTypes: map[string]string{"id": "Int64", "name": "Nullable(String)"},
+	}
+
+	row, err := out.convertRow([]any{float64(1), nil})
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(1), nil}, row)
+
+	_, err = out.convertRow([]any{float64(1)})
+	require.Error(t, err)
+
+	out.columns = []string{"id", "missing"}
+	_, err = out.convertRow([]any{float64(1), "foo"})
+	require.Error(t, err)
+}
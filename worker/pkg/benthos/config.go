@@ -77,6 +77,7 @@ type InputAwsDynamoDB struct {
 type OutputAwsDynamoDB struct {
 	Table          string            `json:"table" yaml:"table"`
 	JsonMapColumns map[string]string `json:"json_map_columns,omitempty" yaml:"json_map_columns,omitempty"`
+	WriteMode      string            `json:"write_mode,omitempty" yaml:"write_mode,omitempty"`
 
 	Region   string `json:"region,omitempty" yaml:"region,omitempty"`
 	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
@@ -88,18 +89,24 @@ type OutputAwsDynamoDB struct {
 }
 
 type InputMongoDb struct {
-	Url             string         `json:"url" yaml:"url"`
-	Database        string         `json:"database" yaml:"database"`
-	Username        string         `json:"username,omitempty" yaml:"username,omitempty"`
-	Password        string         `json:"password,omitempty" yaml:"password,omitempty"`
-	Operation       *string        `json:"operation,omitempty" yaml:"operation,omitempty"`
-	Collection      string         `json:"collection" yaml:"collection"`
-	JsonMarshalMode *string        `json:"json_marshal_mode,omitempty" yaml:"json_marshal_mode,omitempty"`
-	Query           string         `json:"query" yaml:"query"`
-	AutoReplayNacks *bool          `json:"auto_replay_nacks,omitempty" yaml:"auto_replay_nacks,omitempty"`
-	BatchSize       *int32         `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
-	Sort            map[string]int `json:"sort,omitempty" yaml:"sort,omitempty"`
-	Limit           *int32         `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Url             string              `json:"url" yaml:"url"`
+	Database        string              `json:"database" yaml:"database"`
+	Username        string              `json:"username,omitempty" yaml:"username,omitempty"`
+	Password        string              `json:"password,omitempty" yaml:"password,omitempty"`
+	Operation       *string             `json:"operation,omitempty" yaml:"operation,omitempty"`
+	Collection      string              `json:"collection" yaml:"collection"`
+	JsonMarshalMode *string             `json:"json_marshal_mode,omitempty" yaml:"json_marshal_mode,omitempty"`
+	Query           string              `json:"query" yaml:"query"`
+	AutoReplayNacks *bool               `json:"auto_replay_nacks,omitempty" yaml:"auto_replay_nacks,omitempty"`
+	BatchSize       *int32              `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+	Sort            []*MongoDbSortField `json:"sort,omitempty" yaml:"sort,omitempty"`
+	Limit           *int64              `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Projection      map[string]int      `json:"projection,omitempty" yaml:"projection,omitempty"`
+}
+
+type MongoDbSortField struct {
+	Field     string `json:"field" yaml:"field"`
+	Direction int    `json:"direction" yaml:"direction"`
 }
 
 type OutputMongoDb struct {
@@ -179,6 +186,13 @@ type ProcessorConfig struct {
 	Catch                     []*ProcessorConfig               `json:"catch,omitempty" yaml:"catch,omitempty"`
 	While                     *WhileProcessorConfig            `json:"while,omitempty" yaml:"while,omitempty"`
 	NeosyncDefaultTransformer *NeosyncDefaultTransformerConfig `json:"neosync_default_transformer,omitempty" yaml:"neosync_default_transformer,omitempty"`
+	Avro                      *AvroProcessorConfig             `json:"avro,omitempty" yaml:"avro,omitempty"`
+}
+
+type AvroProcessorConfig struct {
+	Operator string `json:"operator" yaml:"operator"`
+	Encoding string `json:"encoding" yaml:"encoding"`
+	Schema   string `json:"schema" yaml:"schema"`
 }
 
 type NeosyncDefaultTransformerConfig struct {
@@ -243,25 +257,27 @@ type OutputConfig struct {
 }
 
 type Outputs struct {
-	SqlInsert       *SqlInsert             `json:"sql_insert,omitempty" yaml:"sql_insert,omitempty"`
-	SqlRaw          *SqlRaw                `json:"sql_raw,omitempty" yaml:"sql_raw,omitempty"`
-	PooledSqlRaw    *PooledSqlRaw          `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
-	PooledSqlInsert *PooledSqlInsert       `json:"pooled_sql_insert,omitempty" yaml:"pooled_sql_insert,omitempty"`
-	PooledSqlUpdate *PooledSqlUpdate       `json:"pooled_sql_update,omitempty" yaml:"pooled_sql_update,omitempty"`
-	AwsS3           *AwsS3Insert           `json:"aws_s3,omitempty" yaml:"aws_s3,omitempty"`
-	GcpCloudStorage *GcpCloudStorageOutput `json:"gcp_cloud_storage,omitempty" yaml:"gcp_cloud_storage,omitempty"`
-	Retry           *RetryConfig           `json:"retry,omitempty" yaml:"retry,omitempty"`
-	Broker          *OutputBrokerConfig    `json:"broker,omitempty" yaml:"broker,omitempty"`
-	DropOn          *DropOnConfig          `json:"drop_on,omitempty" yaml:"drop_on,omitempty"`
-	Drop            *DropConfig            `json:"drop,omitempty" yaml:"drop,omitempty"`
-	Resource        string                 `json:"resource,omitempty" yaml:"resource,omitempty"`
-	Fallback        []Outputs              `json:"fallback,omitempty" yaml:"fallback,omitempty"`
-	RedisHashOutput *RedisHashOutputConfig `json:"redis_hash_output,omitempty" yaml:"redis_hash_output,omitempty"`
-	Error           *ErrorOutputConfig     `json:"error,omitempty" yaml:"error,omitempty"`
-	Switch          *SwitchOutputConfig    `json:"switch,omitempty" yaml:"switch,omitempty"`
-	MongoDB         *OutputMongoDb         `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
-	PooledMongoDB   *OutputMongoDb         `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
-	AwsDynamoDB     *OutputAwsDynamoDB     `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	SqlInsert        *SqlInsert             `json:"sql_insert,omitempty" yaml:"sql_insert,omitempty"`
+	SqlRaw           *SqlRaw                `json:"sql_raw,omitempty" yaml:"sql_raw,omitempty"`
+	PooledSqlRaw     *PooledSqlRaw          `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
+	PooledSqlInsert  *PooledSqlInsert       `json:"pooled_sql_insert,omitempty" yaml:"pooled_sql_insert,omitempty"`
+	PooledSqlUpdate  *PooledSqlUpdate       `json:"pooled_sql_update,omitempty" yaml:"pooled_sql_update,omitempty"`
+	AwsS3            *AwsS3Insert           `json:"aws_s3,omitempty" yaml:"aws_s3,omitempty"`
+	GcpCloudStorage  *GcpCloudStorageOutput `json:"gcp_cloud_storage,omitempty" yaml:"gcp_cloud_storage,omitempty"`
+	Retry            *RetryConfig           `json:"retry,omitempty" yaml:"retry,omitempty"`
+	Broker           *OutputBrokerConfig    `json:"broker,omitempty" yaml:"broker,omitempty"`
+	DropOn           *DropOnConfig          `json:"drop_on,omitempty" yaml:"drop_on,omitempty"`
+	Drop             *DropConfig            `json:"drop,omitempty" yaml:"drop,omitempty"`
+	Resource         string                 `json:"resource,omitempty" yaml:"resource,omitempty"`
+	Fallback         []Outputs              `json:"fallback,omitempty" yaml:"fallback,omitempty"`
+	RedisHashOutput  *RedisHashOutputConfig `json:"redis_hash_output,omitempty" yaml:"redis_hash_output,omitempty"`
+	Error            *ErrorOutputConfig     `json:"error,omitempty" yaml:"error,omitempty"`
+	Switch           *SwitchOutputConfig    `json:"switch,omitempty" yaml:"switch,omitempty"`
+	MongoDB          *OutputMongoDb         `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
+	PooledMongoDB    *OutputMongoDb         `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
+	AwsDynamoDB      *OutputAwsDynamoDB     `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	ClickhouseInsert *ClickhouseInsert      `json:"clickhouse_insert,omitempty" yaml:"clickhouse_insert,omitempty"`
+	KafkaFranz       *KafkaFranzOutput      `json:"kafka_franz,omitempty" yaml:"kafka_franz,omitempty"`
 }
 
 type SwitchOutputConfig struct {
@@ -381,6 +397,44 @@ type PooledSqlInsert struct {
 	Suffix              *string   `json:"suffix,omitempty" yaml:"suffix,omitempty"`
 }
 
+type ClickhouseInsert struct {
+	Dsn             string    `json:"dsn" yaml:"dsn"`
+	Schema          string    `json:"schema" yaml:"schema"`
+	Table           string    `json:"table" yaml:"table"`
+	Columns         []string  `json:"columns" yaml:"columns"`
+	ArgsMapping     string    `json:"args_mapping" yaml:"args_mapping"`
+	TruncateOnRetry bool      `json:"truncate_on_retry" yaml:"truncate_on_retry"`
+	Batching        *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+type KafkaFranzOutput struct {
+	SeedBrokers []string          `json:"seed_brokers" yaml:"seed_brokers"`
+	Topic       string            `json:"topic" yaml:"topic"`
+	Key         string            `json:"key,omitempty" yaml:"key,omitempty"`
+	MaxInFlight int               `json:"max_in_flight" yaml:"max_in_flight"`
+	Tls         *KafkaTlsConfig   `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Sasl        []KafkaSaslConfig `json:"sasl,omitempty" yaml:"sasl,omitempty"`
+	Batching    *Batching         `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+type KafkaTlsConfig struct {
+	Enabled        bool              `json:"enabled" yaml:"enabled"`
+	SkipCertVerify bool              `json:"skip_cert_verify" yaml:"skip_cert_verify"`
+	RootCas        *string           `json:"root_cas,omitempty" yaml:"root_cas,omitempty"`
+	ClientCerts    []KafkaClientCert `json:"client_certs,omitempty" yaml:"client_certs,omitempty"`
+}
+
+type KafkaClientCert struct {
+	Cert string `json:"cert" yaml:"cert"`
+	Key  string `json:"key" yaml:"key"`
+}
+
+type KafkaSaslConfig struct {
+	Mechanism string `json:"mechanism" yaml:"mechanism"`
+	Username  string `json:"username" yaml:"username"`
+	Password  string `json:"password" yaml:"password"`
+}
+
 type SqlInsert struct {
 	Driver          string    `json:"driver" yaml:"driver"`
 	Dsn             string    `json:"dsn" yaml:"dsn"`
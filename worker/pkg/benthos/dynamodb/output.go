@@ -28,6 +28,12 @@ const (
 	ddboFieldTTL            = "ttl"
 	ddboFieldTTLKey         = "ttl_key"
 	ddboFieldBatching       = "batching"
+	ddboFieldWriteMode      = "write_mode"
+
+	// Items replace any existing item with the same key
+	WriteModeOverwrite = "overwrite"
+	// Items are only written if an item with the same key does not already exist
+	WriteModeSkipExisting = "skip_existing"
 
 	crboFieldMaxRetries     = "max_retries"
 	crboFieldBackOff        = "backoff"
@@ -42,6 +48,7 @@ type ddboConfig struct {
 	JSONMapColumns map[string]string
 	TTL            string
 	TTLKey         string
+	WriteMode      string
 
 	backoffCtor func() backoff.BackOff
 	awsConfig   aws.Config
@@ -64,6 +71,9 @@ func ddboConfigFromParsed(pConf *service.ParsedConfig) (conf *ddboConfig, err er
 	if c.TTLKey, err = pConf.FieldString(ddboFieldTTLKey); err != nil {
 		return
 	}
+	if c.WriteMode, err = pConf.FieldString(ddboFieldWriteMode); err != nil {
+		return
+	}
 	if c.backoffCtor, err = commonRetryBackOffCtorFromParsed(pConf); err != nil {
 		return
 	}
@@ -111,6 +121,12 @@ func dynamoOutputConfigSpec() *service.ConfigSpec {
 				Description("The column key to place the TTL value within.").
 				Default("").
 				Advanced(),
+			service.NewStringAnnotatedEnumField(ddboFieldWriteMode, map[string]string{
+				WriteModeOverwrite:    "Items replace any existing item with the same key.",
+				WriteModeSkipExisting: "Items are written with a condition that an item with the same key does not exist. Existing items are left untouched.",
+			}).
+				Description("Determines how items are written when an item with the same key already exists.").
+				Default(WriteModeOverwrite),
 			service.NewOutputMaxInFlightField(),
 			service.NewBatchPolicyField(ddboFieldBatching),
 		).
@@ -156,8 +172,9 @@ type dynamoDBWriter struct {
 
 	boffPool sync.Pool
 
-	table *string
-	ttl   time.Duration
+	table   *string
+	ttl     time.Duration
+	hashKey string
 }
 
 func newDynamoDBWriter(conf *ddboConfig, mgr *service.Resources) (*dynamoDBWriter, error) {
@@ -203,6 +220,11 @@ func (d *dynamoDBWriter) Connect(ctx context.Context) error {
 	} else if out == nil || out.Table == nil || out.Table.TableStatus != types.TableStatusActive {
 		return fmt.Errorf("dynamodb table '%s' must be active", d.conf.Table)
 	}
+	for _, key := range out.Table.KeySchema {
+		if key.KeyType == types.KeyTypeHash && key.AttributeName != nil {
+			d.hashKey = *key.AttributeName
+		}
+	}
 
 	d.client = client
 	return nil
@@ -271,6 +293,10 @@ func (d *dynamoDBWriter) WriteBatch(ctx context.Context, b service.MessageBatch)
 		return err
 	}
 
+	if d.conf.WriteMode == WriteModeSkipExisting {
+		return d.putItemsIfNotExists(ctx, b, writeReqs)
+	}
+
 	batchResult, err := d.client.BatchWriteItem(ctx, &dynamodb.BatchWriteItemInput{
 		RequestItems: map[string][]types.WriteRequest{
 			*d.table: writeReqs,
@@ -349,6 +375,40 @@ unprocessedLoop:
 	return err
 }
 
+// BatchWriteItem does not support condition expressions so each item is written individually.
+// Items that fail the condition already exist in the table and are skipped.
+func (d *dynamoDBWriter) putItemsIfNotExists(ctx context.Context, b service.MessageBatch, writeReqs []types.WriteRequest) error {
+	if d.hashKey == "" {
+		return fmt.Errorf("unable to determine hash key of dynamodb table '%s'", d.conf.Table)
+	}
+	var batchErr *service.BatchError
+	for i, req := range writeReqs {
+		_, err := d.client.PutItem(ctx, &dynamodb.PutItemInput{
+			TableName:                d.table,
+			Item:                     req.PutRequest.Item,
+			ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+			ExpressionAttributeNames: map[string]string{"#pk": d.hashKey},
+		})
+		if err == nil || isConditionalCheckFailed(err) {
+			continue
+		}
+		d.log.Errorf("Put error: %v\n", err)
+		if batchErr == nil {
+			batchErr = service.NewBatchError(b, err)
+		}
+		batchErr.Failed(i, err)
+	}
+	if batchErr != nil {
+		return batchErr
+	}
+	return nil
+}
+
+func isConditionalCheckFailed(err error) bool {
+	var conditionalErr *types.ConditionalCheckFailedException
+	return errors.As(err, &conditionalErr)
+}
+
 func (d *dynamoDBWriter) Close(context.Context) error {
 	return nil
 }
@@ -496,3 +496,64 @@ string_columns:
 
 	assert.Equal(t, expected, requests)
 }
+
+func TestDynamoDBSkipExisting(t *testing.T) {
+	db := testDDBOWriter(t, `
+table: FooTable
+write_mode: skip_existing
+string_columns:
+  id: ${!json("id")}
+`)
+	db.hashKey = "id"
+
+	putIds := []string{}
+	db.client = &mockDynamoDB{
+		fn: func(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+			require.Equal(t, "attribute_not_exists(#pk)", *input.ConditionExpression)
+			require.Equal(t, map[string]string{"#pk": "id"}, input.ExpressionAttributeNames)
+			id := input.Item["id"].(*types.AttributeValueMemberS).Value
+			putIds = append(putIds, id)
+			if id == "bar" {
+				return nil, &types.ConditionalCheckFailedException{Message: aws.String("exists")}
+			}
+			return &dynamodb.PutItemOutput{}, nil
+		},
+		batchFn: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+			t.Error("not expected")
+			return nil, errors.New("not implemented")
+		},
+	}
+
+	require.NoError(t, db.WriteBatch(context.Background(), service.MessageBatch{
+		service.NewMessage([]byte(`{"id":"foo"}`)),
+		service.NewMessage([]byte(`{"id":"bar"}`)),
+	}))
+	assert.Equal(t, []string{"foo", "bar"}, putIds)
+}
+
+func TestDynamoDBSkipExistingError(t *testing.T) {
+	db := testDDBOWriter(t, `
+table: FooTable
+write_mode: skip_existing
+string_columns:
+  id: ${!json("id")}
+`)
+	db.hashKey = "id"
+
+	db.client = &mockDynamoDB{
+		fn: func(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+			if input.Item["id"].(*types.AttributeValueMemberS).Value == "bar" {
+				return nil, errors.New("boom")
+			}
+			return &dynamodb.PutItemOutput{}, nil
+		},
+	}
+
+	err := db.WriteBatch(context.Background(), service.MessageBatch{
+		service.NewMessage([]byte(`{"id":"foo"}`)),
+		service.NewMessage([]byte(`{"id":"bar"}`)),
+	})
+	var batchErr *service.BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 1, batchErr.IndexedErrors())
+}
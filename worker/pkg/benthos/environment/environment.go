@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	neosync_benthos_clickhouse "github.com/nucleuscloud/neosync/worker/pkg/benthos/clickhouse"
 	neosync_benthos_defaulttransform "github.com/nucleuscloud/neosync/worker/pkg/benthos/default_transform"
 	neosync_benthos_dynamodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/dynamodb"
 	neosync_benthos_error "github.com/nucleuscloud/neosync/worker/pkg/benthos/error"
@@ -18,6 +19,8 @@ import (
 type RegisterConfig struct {
 	Meter metric.Meter // nil to disable
 
+	Progress *benthos_metrics.ProgressTracker // nil to disable
+
 	SqlConfig *SqlConfig // nil to disable
 
 	MongoConfig *MongoConfig // nil to disable
@@ -49,8 +52,8 @@ func NewWithEnvironment(env *service.Environment, config *RegisterConfig) (*serv
 		return nil, errors.New("must provide non-nil StopChannel")
 	}
 
-	if config.Meter != nil {
-		err := benthos_metrics.RegisterOtelMetricsExporter(env, config.Meter)
+	if config.Meter != nil || config.Progress != nil {
+		err := benthos_metrics.RegisterOtelMetricsExporter(env, config.Meter, config.Progress)
 		if err != nil {
 			return nil, fmt.Errorf("unable to register otel_collector for benthos metering: %w", err)
 		}
@@ -61,6 +64,10 @@ func NewWithEnvironment(env *service.Environment, config *RegisterConfig) (*serv
 		if err != nil {
 			return nil, fmt.Errorf("unable to register pooled_sql_insert output to benthos instance: %w", err)
 		}
+		err = neosync_benthos_clickhouse.RegisterClickhouseInsertOutput(env, config.SqlConfig.IsRetry)
+		if err != nil {
+			return nil, fmt.Errorf("unable to register clickhouse_insert output to benthos instance: %w", err)
+		}
 		err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(env, config.SqlConfig.Provider)
 		if err != nil {
 			return nil, fmt.Errorf("unable to register pooled_sql_update output to benthos instance: %w", err)
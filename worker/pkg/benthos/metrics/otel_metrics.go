@@ -2,18 +2,27 @@ package benthos_metrics
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/warpstreamlabs/bento/public/service"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 )
 
-func RegisterOtelMetricsExporter(env *service.Environment, meter metric.Meter) error {
+// Registers the otel_collector metrics exporter.
+// The meter may be nil if metrics should only be fed to the progress tracker, and the progress tracker may be nil if progress is not tracked.
+func RegisterOtelMetricsExporter(env *service.Environment, meter metric.Meter, progress *ProgressTracker) error {
+	if meter == nil {
+		meter = noop.NewMeterProvider().Meter("")
+	}
 	return env.RegisterMetricsExporter(
 		"otel_collector",
 		service.NewConfigSpec(),
 		func(conf *service.ParsedConfig, log *service.Logger) (service.MetricsExporter, error) {
-			return newOtlpMetricsExporter(meter, log), nil
+			exporter := newOtlpMetricsExporter(meter, log)
+			exporter.progress = progress
+			return exporter, nil
 		},
 	)
 }
@@ -21,9 +30,10 @@ func RegisterOtelMetricsExporter(env *service.Environment, meter metric.Meter) e
 var _ service.MetricsExporter = &otlpMetricsExporter{}
 
 type otlpMetricsExporter struct {
-	logger  *service.Logger
-	meter   metric.Meter
-	buckets []float64
+	logger   *service.Logger
+	meter    metric.Meter
+	buckets  []float64
+	progress *ProgressTracker
 }
 
 func newOtlpMetricsExporter(meter metric.Meter, logger *service.Logger) *otlpMetricsExporter {
@@ -35,12 +45,16 @@ func newOtlpMetricsExporter(meter metric.Meter, logger *service.Logger) *otlpMet
 }
 
 type otlpCounter struct {
-	counter metric.Int64Counter
-	labels  []attribute.KeyValue
+	counter  metric.Int64Counter
+	labels   []attribute.KeyValue
+	progress *atomic.Int64
 }
 
 func (c *otlpCounter) Incr(count int64) {
 	c.counter.Add(context.Background(), count, metric.WithAttributes(c.labels...))
+	if c.progress != nil {
+		c.progress.Add(count)
+	}
 }
 
 func (om *otlpMetricsExporter) NewCounterCtor(path string, labelNames ...string) service.MetricsExporterCounterCtor {
@@ -56,8 +70,9 @@ func (om *otlpMetricsExporter) NewCounterCtor(path string, labelNames ...string)
 			return nil
 		}
 		return &otlpCounter{
-			counter: counter,
-			labels:  attrs,
+			counter:  counter,
+			labels:   attrs,
+			progress: om.progress.counter(path, labelNames, labelValues),
 		}
 	}
 }
@@ -21,7 +21,7 @@ func Test_RegisterOtelMetricsExporter(t *testing.T) {
 	testenv := service.NewEnvironment()
 	meter := getTestMeter(t, "test")
 
-	err := RegisterOtelMetricsExporter(testenv, meter)
+	err := RegisterOtelMetricsExporter(testenv, meter, nil)
 	assert.NoError(t, err)
 }
 
@@ -0,0 +1,102 @@
+package benthos_metrics
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	inputReceivedMetric      = "input_received"
+	inputReceivedBytesMetric = "input_received_bytes"
+	outputSentMetric         = "output_sent"
+	outputErrorMetric        = "output_error"
+
+	// label benthos uses to identify the component that emitted a metric
+	componentPathLabel = "path"
+)
+
+// Progress is a point in time snapshot of how far along a benthos stream is
+type Progress struct {
+	RowsRead    int64 `json:"rowsRead"`
+	RowsWritten int64 `json:"rowsWritten"`
+	RowsErrored int64 `json:"rowsErrored"`
+	// Approximate as it is only reported by inputs that are able to estimate it
+	BytesProcessed int64 `json:"bytesProcessed"`
+}
+
+// ProgressTracker keeps track of the benthos metrics that are needed to report the progress of a stream
+type ProgressTracker struct {
+	mu sync.Mutex
+	// metric name -> component path -> count
+	counters map[string]map[string]*atomic.Int64
+}
+
+func NewProgressTracker() *ProgressTracker {
+	return &ProgressTracker{counters: map[string]map[string]*atomic.Int64{}}
+}
+
+// Returns the counter for the metric emitted by the given component, or nil if the metric is not tracked
+func (p *ProgressTracker) counter(name string, labelNames, labelValues []string) *atomic.Int64 {
+	if p == nil {
+		return nil
+	}
+	switch name {
+	case inputReceivedMetric, inputReceivedBytesMetric, outputSentMetric, outputErrorMetric:
+	default:
+		return nil
+	}
+	componentPath := ""
+	for idx, label := range labelNames {
+		if label == componentPathLabel && idx < len(labelValues) {
+			componentPath = labelValues[idx]
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	byPath, ok := p.counters[name]
+	if !ok {
+		byPath = map[string]*atomic.Int64{}
+		p.counters[name] = byPath
+	}
+	ctr, ok := byPath[componentPath]
+	if !ok {
+		ctr = &atomic.Int64{}
+		byPath[componentPath] = ctr
+	}
+	return ctr
+}
+
+// Returns a snapshot of the current progress.
+// Rows that fan out to multiple outputs are only counted once, while errors are summed across all outputs.
+func (p *ProgressTracker) Progress() *Progress {
+	if p == nil {
+		return &Progress{}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &Progress{
+		RowsRead:       p.max(inputReceivedMetric),
+		RowsWritten:    p.max(outputSentMetric),
+		RowsErrored:    p.sum(outputErrorMetric),
+		BytesProcessed: p.sum(inputReceivedBytesMetric),
+	}
+}
+
+// must be called with the mutex held
+func (p *ProgressTracker) max(name string) int64 {
+	var out int64
+	for _, ctr := range p.counters[name] {
+		out = max(out, ctr.Load())
+	}
+	return out
+}
+
+// must be called with the mutex held
+func (p *ProgressTracker) sum(name string) int64 {
+	var out int64
+	for _, ctr := range p.counters[name] {
+		out += ctr.Load()
+	}
+	return out
+}
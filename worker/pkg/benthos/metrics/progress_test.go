@@ -0,0 +1,41 @@
+package benthos_metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProgressTracker(t *testing.T) {
+	tracker := NewProgressTracker()
+	nm := newOtlpMetricsExporter(getTestMeter(t, "test"), nil)
+	nm.progress = tracker
+
+	nm.NewCounterCtor(inputReceivedMetric, "label", "path")("input", "root.input").Incr(10)
+	nm.NewCounterCtor(inputReceivedBytesMetric, "label", "path")("input", "root.input").Incr(100)
+
+	// rows that fan out to multiple outputs are only counted once
+	outputSent := nm.NewCounterCtor(outputSentMetric, "label", "path")
+	outputSent("", "root.output.broker.outputs.0").Incr(8)
+	outputSent("", "root.output.broker.outputs.1").Incr(7)
+
+	outputErrors := nm.NewCounterCtor(outputErrorMetric, "label", "path")
+	outputErrors("", "root.output.broker.outputs.0").Incr(1)
+	outputErrors("", "root.output.broker.outputs.1").Incr(2)
+
+	// untracked metrics are ignored
+	nm.NewCounterCtor("processor_received", "label", "path")("", "root.pipeline.processors.0").Incr(5)
+
+	require.Equal(t, &Progress{
+		RowsRead:       10,
+		RowsWritten:    8,
+		RowsErrored:    3,
+		BytesProcessed: 100,
+	}, tracker.Progress())
+}
+
+func Test_ProgressTracker_Nil(t *testing.T) {
+	var tracker *ProgressTracker
+	require.Nil(t, tracker.counter(inputReceivedMetric, nil, nil))
+	require.Equal(t, &Progress{}, tracker.Progress())
+}
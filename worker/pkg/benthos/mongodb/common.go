@@ -367,8 +367,10 @@ func getKeyTypMap(p *service.Message) (map[string]neosync_types.KeyType, error)
 	}
 	ktm := map[string]neosync_types.KeyType{}
 	for k, v := range keyTypeMap {
+		// top level keys are used by filter maps that upsert on a custom key
+		ktm[k] = v
 		if k == "_id" {
-			ktm[k] = v
+			ktm[fmt.Sprintf("$setOnInsert.%s", k)] = v
 		}
 		ktm[fmt.Sprintf("$set.%s", k)] = v
 	}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -56,16 +57,26 @@ func mongoConfigSpec() *service.ConfigSpec {
 			Optional().
 			Example(1000).
 			Version("4.26.0")).
-		Field(service.NewIntMapField("sort").
-			Description("An object specifying fields to sort by, and the respective sort order (`1` ascending, `-1` descending). Note: The driver currently appears to support only one sorting key. Operations: `find`").
+		Field(service.NewObjectListField("sort",
+			service.NewStringField("field").
+				Description("The field to sort by."),
+			service.NewIntField("direction").
+				Description("The sort order of the field, `1` ascending or `-1` descending."),
+		).
+			Description("A list of fields to sort by. The documents are sorted by each field in the order they are listed. Operations: `find`").
 			Optional().
-			Example(map[string]int{"name": 1}).
-			Example(map[string]int{"age": -1}).
+			Example([]map[string]any{{"field": "name", "direction": 1}}).
+			Example([]map[string]any{{"field": "age", "direction": -1}, {"field": "name", "direction": 1}}).
 			Version("4.26.0")).
 		Field(service.NewIntField("limit").
 			Description("An explicit maximum number of documents to return. Operations: `find`").
 			Optional().
-			Version("4.26.0"))
+			Version("4.26.0")).
+		Field(service.NewIntMapField("projection").
+			Description("An object specifying fields to include (`1`) or exclude (`0`) from the returned documents. Operations: `find`").
+			Optional().
+			Example(map[string]int{"name": 1, "email": 1}).
+			Example(map[string]int{"ssn": 0}))
 }
 
 type MongoPoolProvider interface {
@@ -90,7 +101,8 @@ func RegisterPooledMongoDbInput(env *service.Environment, clientProvider MongoPo
 func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider, logger *service.Logger) (service.BatchInput, error) {
 	var (
 		limit, batchSize int
-		sort             map[string]int
+		sort             bson.D
+		projection       map[string]int
 	)
 
 	mongoUrl, err := conf.FieldString(commonFieldClientURL)
@@ -130,7 +142,7 @@ func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider,
 		}
 	}
 	if conf.Contains("sort") {
-		if sort, err = conf.FieldIntMap("sort"); err != nil {
+		if sort, err = getSort(conf); err != nil {
 			return nil, err
 		}
 	}
@@ -139,6 +151,16 @@ func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider,
 			return nil, err
 		}
 	}
+	if conf.Contains("projection") {
+		if projection, err = conf.FieldIntMap("projection"); err != nil {
+			return nil, err
+		}
+	}
+	if operation == FindInputOperation {
+		if query, err = toFindFilter(query); err != nil {
+			return nil, err
+		}
+	}
 
 	mClient, err := clientProvider.GetClient(mongoUrl)
 	if err != nil {
@@ -155,6 +177,7 @@ func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider,
 		marshalCanon: marshalMode == string(JSONMarshalModeCanonical),
 		batchSize:    int32(batchSize), //nolint:gosec // Ignoring for now
 		sort:         sort,
+		projection:   projection,
 		limit:        int64(limit),
 		count:        0,
 		logger:       logger,
@@ -170,7 +193,8 @@ type mongoInput struct {
 	operation    string
 	marshalCanon bool
 	batchSize    int32
-	sort         map[string]int
+	sort         bson.D
+	projection   map[string]int
 	limit        int64
 	count        int
 	logger       *service.Logger
@@ -270,9 +294,53 @@ func (m *mongoInput) getFindOptions() (*options.FindOptions, error) { //nolint:
 	if m.limit > 0 {
 		findOptions.SetLimit(m.limit)
 	}
+	if m.projection != nil {
+		findOptions.SetProjection(m.projection)
+	}
 	return findOptions, nil
 }
 
+// The sort is a document so that the order of the fields is preserved when it is sent to mongo
+func getSort(conf *service.ParsedConfig) (bson.D, error) {
+	fieldConfs, err := conf.FieldObjectList("sort")
+	if err != nil {
+		return nil, err
+	}
+	sort := make(bson.D, 0, len(fieldConfs))
+	for _, fieldConf := range fieldConfs {
+		field, err := fieldConf.FieldString("field")
+		if err != nil {
+			return nil, err
+		}
+		direction, err := fieldConf.FieldInt("direction")
+		if err != nil {
+			return nil, err
+		}
+		if direction != 1 && direction != -1 {
+			return nil, fmt.Errorf("sort direction of field %s must be 1 or -1, got %d", field, direction)
+		}
+		sort = append(sort, bson.E{Key: field, Value: direction})
+	}
+	return sort, nil
+}
+
+// The query mapping produces plain JSON values. Round tripping through extended json allows filters
+// to contain typed values such as {"$oid": "..."} or {"$date": "..."} that would otherwise be sent as literal documents.
+func toFindFilter(query any) (bson.D, error) {
+	if query == nil {
+		return bson.D{}, nil
+	}
+	bits, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal mongodb query: %w", err)
+	}
+	var filter bson.D
+	if err := bson.UnmarshalExtJSON(bits, false, &filter); err != nil {
+		return nil, fmt.Errorf("mongodb query must be a valid extended json document: %w", err)
+	}
+	return filter, nil
+}
+
 func (m *mongoInput) getAggregateOptions() (*options.AggregateOptions, error) { //nolint: unparam
 	aggregateOptions := options.Aggregate()
 	if m.batchSize > 0 {
@@ -0,0 +1,48 @@
+package neosync_benthos_mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/service"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_getSort(t *testing.T) {
+	conf := `
+url: mongodb://localhost:27017
+database: app
+collection: users
+query: 'root = this'
+sort:
+  - field: name
+    direction: 1
+  - field: age
+    direction: -1
+  - field: email
+    direction: 1
+`
+	parsed, err := mongoConfigSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	actual, err := getSort(parsed)
+	require.NoError(t, err)
+	require.Equal(t, bson.D{{Key: "name", Value: 1}, {Key: "age", Value: -1}, {Key: "email", Value: 1}}, actual)
+}
+
+func Test_getSort_InvalidDirection(t *testing.T) {
+	conf := `
+url: mongodb://localhost:27017
+database: app
+collection: users
+query: 'root = this'
+sort:
+  - field: name
+    direction: 2
+`
+	parsed, err := mongoConfigSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	_, err = getSort(parsed)
+	require.Error(t, err)
+}
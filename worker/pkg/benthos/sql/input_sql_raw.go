@@ -50,6 +50,9 @@ type pooledInput struct {
 
 	shutSig *shutdown.Signaller
 
+	// approximate number of bytes read from the source, used to report the progress of a sync
+	bytesRead *service.MetricCounter
+
 	stopActivityChannel chan<- error
 }
 
@@ -84,6 +87,7 @@ func newInput(conf *service.ParsedConfig, mgr *service.Resources, dbprovider DbP
 		queryStatic:         queryStatic,
 		argsMapping:         argsMapping,
 		provider:            dbprovider,
+		bytesRead:           mgr.Metrics().NewCounter("input_received_bytes"),
 		stopActivityChannel: channel,
 	}, nil
 }
@@ -170,6 +174,8 @@ func (s *pooledInput) Read(ctx context.Context) (*service.Message, service.AckFu
 		return nil, nil, err
 	}
 
+	s.bytesRead.Incr(estimateRowSize(obj))
+
 	msg := service.NewMessage(nil)
 	msg.SetStructured(obj)
 	return msg, emptyAck, nil
@@ -230,3 +236,26 @@ func sqlRowToMap(rows *sql.Rows) (map[string]any, error) {
 	}
 	return jObj, nil
 }
+
+// Returns the approximate size in bytes of a row that was read from the database
+func estimateRowSize(row map[string]any) int64 {
+	var size int64
+	for _, value := range row {
+		switch t := value.(type) {
+		case nil:
+		case string:
+			size += int64(len(t))
+		case []byte:
+			size += int64(len(t))
+		case bool, int8, uint8:
+			size++
+		case int16, uint16:
+			size += 2
+		case int32, uint32, float32:
+			size += 4
+		default:
+			size += 8
+		}
+	}
+	return size
+}
@@ -8,20 +8,26 @@ import (
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
 )
 
 type GenerateBenthosConfigsRequest struct {
 	JobId      string
 	WorkflowId string
+	// Applied in place of the stored job definition
+	Overrides *shared.JobRunOverrides
 }
 type GenerateBenthosConfigsResponse struct {
 	BenthosConfigs []*BenthosConfigResponse
 	AccountId      string
+	// Source columns that have no job mapping and are therefore not synced, as schema.table.column
+	UnmappedColumns []string
 }
 
 type BenthosRedisConfig struct {
@@ -45,7 +51,8 @@ type BenthosConfigResponse struct {
 	BenthosDsns []*shared.BenthosDsn
 	RedisConfig []*BenthosRedisConfig
 
-	primaryKeys []string
+	primaryKeys   []string
+	columnInfoMap map[string]*sqlmanager_shared.ColumnInfo
 
 	metriclabels metrics.MetricLabels
 }
@@ -120,5 +127,10 @@ func (a *Activity) GenerateBenthosConfigs(
 		a.metricsEnabled,
 	)
 	slogger := neosynclogger.NewJsonSLogger().With(loggerKeyVals...)
-	return bbuilder.GenerateBenthosConfigs(ctx, req, slogger)
+	resp, err := bbuilder.GenerateBenthosConfigs(ctx, req, slogger)
+	if err != nil && isSchemaDriftError(err) {
+		// typed so that the workflow is able to tell schema drift apart from other failures
+		return nil, temporal.NewApplicationError(err.Error(), SchemaDriftErrorType)
+	}
+	return resp, err
 }
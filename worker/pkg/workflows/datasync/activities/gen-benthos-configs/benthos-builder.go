@@ -22,8 +22,21 @@ const (
 	jobmappingSubsetErrMsg     = "job mappings are not equal to or a subset of the database schema found in the source connection"
 	haltOnSchemaAdditionErrMsg = "job mappings does not contain a column mapping for all " +
 		"columns found in the source connection for the selected schemas and tables"
+
+	// Application error type returned when the source schema no longer matches the job mappings
+	SchemaDriftErrorType = "SchemaDrift"
+)
+
+var (
+	errJobMappingsNotSubset = errors.New(jobmappingSubsetErrMsg)
+	errSchemaAdditionHalted = errors.New(haltOnSchemaAdditionErrMsg)
 )
 
+// Returns true if the error was caused by the source schema drifting from the job mappings
+func isSchemaDriftError(err error) bool {
+	return errors.Is(err, errJobMappingsNotSubset) || errors.Is(err, errSchemaAdditionHalted)
+}
+
 type benthosBuilder struct {
 	sqlmanagerclient sqlmanager.SqlManagerClient
 
@@ -75,6 +88,10 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
+	job, err = shared.ApplyJobRunOverrides(job, req.Overrides.Get())
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply job run overrides: %w", err)
+	}
 	responses := []*BenthosConfigResponse{}
 
 	// reverse of table dependency
@@ -82,6 +99,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 	var primaryKeyToForeignKeysMap map[string]map[string][]*referenceKey            // schema.table -> column -> ForeignKey
 	var colTransformerMap map[string]map[string]*mgmtv1alpha1.JobMappingTransformer // schema.table -> column -> transformer
 	var aiGroupedTableCols map[string][]string                                      // map of table key to columns for AI Generated schemas
+	var unmappedColumns []string
 
 	switch job.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
@@ -104,6 +122,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		}
 		primaryKeyToForeignKeysMap = resp.primaryKeyToForeignKeysMap
 		colTransformerMap = resp.ColumnTransformerMap
+		unmappedColumns = resp.UnmappedColumns
 		responses = append(responses, resp.BenthosConfigs...)
 	case *mgmtv1alpha1.JobSourceOptions_Mongodb:
 		resp, err := b.getMongoDbSyncBenthosConfigResponses(ctx, job, slogger)
@@ -158,6 +177,28 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 				} else {
 					return nil, errors.New("unable to build destination connection due to unsupported source connection")
 				}
+			case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+				// clickhouse does not enforce foreign keys so there is nothing to update after the initial insert.
+				// Circular foreign key columns that are deferred to the update run are left null.
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destinationConnection.GetId()})
+				outputs, err := b.getClickhouseBenthosOutput(destination, resp, dsn)
+				if err != nil {
+					return nil, err
+				}
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
+			case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+				// kafka is append only, so like the object storage destinations only the insert run is published
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				outputs, err := b.getKafkaBenthosOutput(connection, destination, resp)
+				if err != nil {
+					return nil, err
+				}
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
 			case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 				if resp.RunType == tabledependency.RunTypeUpdate {
 					continue
@@ -173,21 +214,16 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
 				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destinationConnection.GetId()})
 				if resp.Config.Input.PooledMongoDB != nil || resp.Config.Input.MongoDB != nil {
+					documentMap, filterMap := buildMongoDbUpsertMaps(getMongoDbUpsertKey(destination.GetOptions().GetMongodbOptions(), resp.TableSchema, resp.TableName))
 					resp.Config.Output.PooledMongoDB = &neosync_benthos.OutputMongoDb{
 						Url: dsn,
 
-						Database:   resp.TableSchema,
-						Collection: resp.TableName,
-						Operation:  "update-one",
-						Upsert:     true,
-						DocumentMap: `
-						  root = {
-								"$set": this
-							}
-						`,
-						FilterMap: `
-						  root._id = this._id
-						`,
+						Database:    resp.TableSchema,
+						Collection:  resp.TableName,
+						Operation:   "update-one",
+						Upsert:      true,
+						DocumentMap: documentMap,
+						FilterMap:   filterMap,
 						WriteConcern: &neosync_benthos.MongoWriteConcern{
 							W: "1",
 						},
@@ -217,6 +253,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 						JsonMapColumns: map[string]string{
 							"": ".",
 						},
+						WriteMode: getDynamoDbWriteMode(dynamoDestinationOpts.GetWriteMode()),
 
 						Batching: &neosync_benthos.Batching{
 							// https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html
@@ -273,8 +310,9 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 
 	slogger.Info(fmt.Sprintf("successfully built %d benthos configs", len(outputConfigs)))
 	return &GenerateBenthosConfigsResponse{
-		BenthosConfigs: outputConfigs,
-		AccountId:      job.GetAccountId(),
+		BenthosConfigs:  outputConfigs,
+		AccountId:       job.GetAccountId(),
+		UnmappedColumns: unmappedColumns,
 	}, nil
 }
 
@@ -362,6 +400,8 @@ func getSqlDriverFromConnection(conn *mgmtv1alpha1.Connection) (string, error) {
 		return sqlmanager_shared.MysqlDriver, nil
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return sqlmanager_shared.MssqlDriver, nil
+	case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+		return sqlmanager_shared.ClickhouseDriver, nil
 	default:
 		return "", fmt.Errorf("unsupported sql connection config")
 	}
@@ -2,6 +2,7 @@ package genbenthosconfigs_activity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -274,6 +275,44 @@ func TestShouldHaltOnSchemaAddition(t *testing.T) {
 	require.True(t, ok, "job mappings have same column count, but missing specific column")
 }
 
+func Test_getUnmappedColumns(t *testing.T) {
+	unmapped := getUnmappedColumns(
+		map[string]map[string]*sqlmanager_shared.ColumnInfo{
+			"public.users": {
+				"id":         &sqlmanager_shared.ColumnInfo{},
+				"created_by": &sqlmanager_shared.ColumnInfo{},
+				"email":      &sqlmanager_shared.ColumnInfo{},
+			},
+			"public.accounts": {
+				"id": &sqlmanager_shared.ColumnInfo{},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "accounts", Column: "id"},
+		},
+	)
+	require.Equal(t, []string{"public.users.created_by", "public.users.email"}, unmapped)
+
+	unmapped = getUnmappedColumns(
+		map[string]map[string]*sqlmanager_shared.ColumnInfo{
+			"public.users": {
+				"id": &sqlmanager_shared.ColumnInfo{},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+		},
+	)
+	require.Empty(t, unmapped)
+}
+
+func Test_isSchemaDriftError(t *testing.T) {
+	require.True(t, isSchemaDriftError(fmt.Errorf("unable to build configs: %w", errJobMappingsNotSubset)))
+	require.True(t, isSchemaDriftError(errSchemaAdditionHalted))
+	require.False(t, isSchemaDriftError(errors.New(jobmappingSubsetErrMsg)))
+}
+
 func Test_buildProcessorConfigsMutation(t *testing.T) {
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 
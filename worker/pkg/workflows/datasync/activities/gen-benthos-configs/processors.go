@@ -229,6 +229,23 @@ func buildMutationConfigs(
 	return strings.Join(mutations, "\n"), nil
 }
 
+// Builds the bloblang mutation that applies the transformers of the given job mappings to a record.
+// User defined transformers must be resolved to their underlying transformer beforehand and javascript transformers are not supported.
+func BuildMutation(cols []*mgmtv1alpha1.JobMapping, tableColumnInfo map[string]*sqlmanager_shared.ColumnInfo) (string, error) {
+	mutations := []string{}
+	for _, col := range cols {
+		if !shouldProcessColumn(col.Transformer) {
+			continue
+		}
+		mutation, err := computeMutationFunction(col, tableColumnInfo[col.Column], false)
+		if err != nil {
+			return "", fmt.Errorf("%s is not a supported transformer: %w", col.Transformer.Source, err)
+		}
+		mutations = append(mutations, fmt.Sprintf("root.%s = %s", getBenthosColumnKey(col.Column, false), mutation))
+	}
+	return strings.Join(mutations, "\n"), nil
+}
+
 const pathSeparator = "."
 
 func getBenthosColumnKey(column string, shouldSplitPath bool) string {
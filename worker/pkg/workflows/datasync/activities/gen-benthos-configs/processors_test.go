@@ -423,3 +423,30 @@ const benthos = {
 	require.NotNil(t, barMap)
 	require.Equal(t, "hello world", barMap["baz"])
 }
+
+func Test_BuildMutation(t *testing.T) {
+	maxLen := int32(40)
+	mutation, err := BuildMutation(
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+			{Schema: "public", Table: "users", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}},
+			{Schema: "public", Table: "users", Column: "last_name", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
+					TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{PreserveLength: true},
+				}},
+			}},
+		},
+		map[string]*sqlmanager_shared.ColumnInfo{"last_name": {CharacterMaximumLength: &maxLen}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "root.\"name\" = null\nroot.\"last_name\" = transform_last_name(value:this.\"last_name\",preserve_length:true,max_length:40)", mutation)
+
+	_, err = BuildMutation(
+		[]*mgmtv1alpha1.JobMapping{
+			{Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT}},
+		},
+		nil,
+	)
+	require.Error(t, err)
+}
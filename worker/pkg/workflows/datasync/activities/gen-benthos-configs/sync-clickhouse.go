@@ -0,0 +1,73 @@
+package genbenthosconfigs_activity
+
+import (
+	"errors"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+)
+
+func (b *benthosBuilder) getClickhouseBenthosOutput(
+	destination *mgmtv1alpha1.JobDestination,
+	benthosConfig *BenthosConfigResponse,
+	dsn string,
+) ([]neosync_benthos.Outputs, error) {
+	destOpts := getDestinationOptions(destination)
+
+	insert := neosync_benthos.Outputs{
+		ClickhouseInsert: &neosync_benthos.ClickhouseInsert{
+			Dsn: dsn,
+
+			Schema:          benthosConfig.TableSchema,
+			Table:           benthosConfig.TableName,
+			Columns:         benthosConfig.Columns,
+			TruncateOnRetry: destOpts.Truncate,
+			ArgsMapping:     buildPlainInsertArgs(benthosConfig.Columns),
+
+			// clickhouse performs best with large, infrequent inserts
+			Batching: &neosync_benthos.Batching{
+				Period: "5s",
+				Count:  1000,
+			},
+		},
+	}
+
+	switch {
+	case benthosConfig.Config.Input.SqlSelect != nil || benthosConfig.Config.Input.PooledSqlRaw != nil:
+	case benthosConfig.Config.Input.Generate != nil:
+		processorConfigs := []neosync_benthos.ProcessorConfig{}
+		for _, pc := range benthosConfig.Processors {
+			processorConfigs = append(processorConfigs, *pc)
+		}
+		insert = neosync_benthos.Outputs{
+			// retry processor and output several times
+			Retry: &neosync_benthos.RetryConfig{
+				InlineRetryConfig: neosync_benthos.InlineRetryConfig{
+					MaxRetries: 10,
+				},
+				Output: neosync_benthos.OutputConfig{
+					Outputs:    insert,
+					Processors: processorConfigs,
+				},
+			},
+		}
+	default:
+		return nil, errors.New("unable to build clickhouse destination connection due to unsupported source connection")
+	}
+
+	return []neosync_benthos.Outputs{
+		{
+			Fallback: []neosync_benthos.Outputs{
+				insert,
+				// kills activity depending on error
+				{Error: &neosync_benthos.ErrorOutputConfig{
+					ErrorMsg: `${! meta("fallback_error")}`,
+					Batching: &neosync_benthos.Batching{
+						Period: "5s",
+						Count:  100,
+					},
+				}},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getClickhouseBenthosOutput(t *testing.T) {
+	b := &benthosBuilder{}
+	destination := &mgmtv1alpha1.JobDestination{
+		Options: &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_ClickhouseOptions{
+				ClickhouseOptions: &mgmtv1alpha1.ClickhouseDestinationConnectionOptions{
+					TruncateTable: &mgmtv1alpha1.ClickhouseTruncateTableConfig{TruncateBeforeInsert: true},
+				},
+			},
+		},
+	}
+
+	t.Run("sync", func(t *testing.T) {
+		resp := &BenthosConfigResponse{
+			TableSchema: "public",
+			TableName:   "users",
+			Columns:     []string{"id", "name"},
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Input: &neosync_benthos.InputConfig{
+						Inputs: neosync_benthos.Inputs{PooledSqlRaw: &neosync_benthos.InputPooledSqlRaw{}},
+					},
+				},
+			},
+		}
+		outputs, err := b.getClickhouseBenthosOutput(destination, resp, "${DESTINATION_0_CONNECTION_DSN}")
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Len(t, outputs[0].Fallback, 2)
+		insert := outputs[0].Fallback[0].ClickhouseInsert
+		require.NotNil(t, insert)
+		require.Equal(t, "${DESTINATION_0_CONNECTION_DSN}", insert.Dsn)
+		require.Equal(t, "public", insert.Schema)
+		require.Equal(t, "users", insert.Table)
+		require.Equal(t, []string{"id", "name"}, insert.Columns)
+		require.True(t, insert.TruncateOnRetry)
+		require.NotNil(t, outputs[0].Fallback[1].Error)
+	})
+
+	t.Run("generate", func(t *testing.T) {
+		resp := &BenthosConfigResponse{
+			TableSchema: "public",
+			TableName:   "users",
+			Columns:     []string{"id"},
+			Processors:  []*neosync_benthos.ProcessorConfig{{Mutation: shared.Ptr("root.id = 1")}},
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Input: &neosync_benthos.InputConfig{
+						Inputs: neosync_benthos.Inputs{Generate: &neosync_benthos.Generate{}},
+					},
+				},
+			},
+		}
+		outputs, err := b.getClickhouseBenthosOutput(destination, resp, "dsn")
+		require.NoError(t, err)
+		retry := outputs[0].Fallback[0].Retry
+		require.NotNil(t, retry)
+		require.NotNil(t, retry.Output.Outputs.ClickhouseInsert)
+		require.Len(t, retry.Output.Processors, 1)
+	})
+
+	t.Run("unsupported source", func(t *testing.T) {
+		resp := &BenthosConfigResponse{
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Input: &neosync_benthos.InputConfig{
+						Inputs: neosync_benthos.Inputs{AwsDynamoDB: &neosync_benthos.InputAwsDynamoDB{}},
+					},
+				},
+			},
+		}
+		_, err := b.getClickhouseBenthosOutput(destination, resp, "dsn")
+		require.Error(t, err)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	neosync_benthos_dynamodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/dynamodb"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 )
 
@@ -151,3 +152,12 @@ func toDynamoDbSourceTableOptionMap(tableOpts []*mgmtv1alpha1.DynamoDBSourceTabl
 	}
 	return output
 }
+
+func getDynamoDbWriteMode(writeMode mgmtv1alpha1.DynamoDBDestinationWriteMode) string {
+	switch writeMode {
+	case mgmtv1alpha1.DynamoDBDestinationWriteMode_DYNAMO_DB_DESTINATION_WRITE_MODE_SKIP_EXISTING:
+		return neosync_benthos_dynamodb.WriteModeSkipExisting
+	default:
+		return neosync_benthos_dynamodb.WriteModeOverwrite
+	}
+}
@@ -0,0 +1,264 @@
+package genbenthosconfigs_activity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+)
+
+const (
+	defaultKafkaTopic = "{schema}.{table}"
+	kafkaKeyMetaKey   = "neosync_kafka_key"
+)
+
+var invalidAvroNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func (b *benthosBuilder) getKafkaBenthosOutput(
+	connection *mgmtv1alpha1.ConnectionConfig_KafkaConfig,
+	destination *mgmtv1alpha1.JobDestination,
+	benthosConfig *BenthosConfigResponse,
+) ([]neosync_benthos.Outputs, error) {
+	kafkaOpts := destination.GetOptions().GetKafkaOptions()
+
+	processorConfigs := []neosync_benthos.ProcessorConfig{}
+	switch {
+	case benthosConfig.Config.Input.SqlSelect != nil || benthosConfig.Config.Input.PooledSqlRaw != nil ||
+		benthosConfig.Config.Input.PooledMongoDB != nil || benthosConfig.Config.Input.MongoDB != nil ||
+		benthosConfig.Config.Input.AwsDynamoDB != nil:
+	case benthosConfig.Config.Input.Generate != nil:
+		for _, pc := range benthosConfig.Processors {
+			processorConfigs = append(processorConfigs, *pc)
+		}
+	default:
+		return nil, errors.New("unable to build kafka destination connection due to unsupported source connection")
+	}
+
+	keyColumns, err := getKafkaKeyColumns(kafkaOpts, benthosConfig)
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	if len(keyColumns) > 0 {
+		keyMapping := buildKafkaKeyMapping(keyColumns)
+		processorConfigs = append(processorConfigs, neosync_benthos.ProcessorConfig{Mutation: &keyMapping})
+		key = fmt.Sprintf(`${! meta(%q) }`, kafkaKeyMetaKey)
+	}
+
+	if kafkaOpts.GetFormat() == mgmtv1alpha1.KafkaSerializationFormat_KAFKA_SERIALIZATION_FORMAT_AVRO {
+		schema, err := buildAvroSchema(benthosConfig.TableSchema, benthosConfig.TableName, benthosConfig.Columns, benthosConfig.columnInfoMap)
+		if err != nil {
+			return nil, err
+		}
+		avroMapping := buildAvroMapping(benthosConfig.Columns, benthosConfig.columnInfoMap)
+		processorConfigs = append(
+			processorConfigs,
+			neosync_benthos.ProcessorConfig{Mutation: &avroMapping},
+			neosync_benthos.ProcessorConfig{Avro: &neosync_benthos.AvroProcessorConfig{
+				Operator: "from_json",
+				Encoding: "binary",
+				Schema:   schema,
+			}},
+		)
+	}
+
+	return []neosync_benthos.Outputs{
+		{
+			Fallback: []neosync_benthos.Outputs{
+				{
+					// retry processor and output several times
+					Retry: &neosync_benthos.RetryConfig{
+						InlineRetryConfig: neosync_benthos.InlineRetryConfig{
+							MaxRetries: 10,
+						},
+						Output: neosync_benthos.OutputConfig{
+							Outputs: neosync_benthos.Outputs{
+								KafkaFranz: &neosync_benthos.KafkaFranzOutput{
+									SeedBrokers: connection.KafkaConfig.GetBrokers(),
+									Topic:       buildKafkaTopic(kafkaOpts.GetTopic(), benthosConfig.TableSchema, benthosConfig.TableName),
+									Key:         key,
+									MaxInFlight: 10,
+									Tls:         buildBenthosKafkaTls(connection.KafkaConfig.GetTls()),
+									Sasl:        buildBenthosKafkaSasl(connection.KafkaConfig.GetSasl()),
+									Batching: &neosync_benthos.Batching{
+										Period: "5s",
+										Count:  100,
+									},
+								},
+							},
+							Processors: processorConfigs,
+						},
+					},
+				},
+				// kills activity depending on error
+				{Error: &neosync_benthos.ErrorOutputConfig{
+					ErrorMsg: `${! meta("fallback_error")}`,
+					Batching: &neosync_benthos.Batching{
+						Period: "5s",
+						Count:  100,
+					},
+				}},
+			},
+		},
+	}, nil
+}
+
+func buildKafkaTopic(topic, schema, table string) string {
+	if topic == "" {
+		topic = defaultKafkaTopic
+	}
+	return strings.NewReplacer("{schema}", schema, "{table}", table).Replace(topic)
+}
+
+// Returns the configured key columns for the table, falling back to the table's primary key
+func getKafkaKeyColumns(opts *mgmtv1alpha1.KafkaDestinationConnectionOptions, benthosConfig *BenthosConfigResponse) ([]string, error) {
+	for _, keyCols := range opts.GetKeyColumns() {
+		if keyCols.GetSchema() != benthosConfig.TableSchema || keyCols.GetTable() != benthosConfig.TableName {
+			continue
+		}
+		for _, col := range keyCols.GetColumns() {
+			if !slices.Contains(benthosConfig.Columns, col) {
+				return nil, fmt.Errorf("kafka key column %q is not a mapped column of %s.%s", col, benthosConfig.TableSchema, benthosConfig.TableName)
+			}
+		}
+		return keyCols.GetColumns(), nil
+	}
+	return benthosConfig.primaryKeys, nil
+}
+
+func buildKafkaKeyMapping(keyColumns []string) string {
+	if len(keyColumns) == 1 {
+		return fmt.Sprintf("meta %s = this.%q.string()", kafkaKeyMetaKey, keyColumns[0])
+	}
+	pieces := make([]string, len(keyColumns))
+	for idx, col := range keyColumns {
+		pieces[idx] = fmt.Sprintf("%q: this.%q", col, col)
+	}
+	return fmt.Sprintf("meta %s = {%s}.format_json(no_indent: true).string()", kafkaKeyMetaKey, strings.Join(pieces, ", "))
+}
+
+func buildBenthosKafkaTls(tlsConfig *mgmtv1alpha1.KafkaTlsConfig) *neosync_benthos.KafkaTlsConfig {
+	if tlsConfig == nil {
+		return nil
+	}
+	benthosTls := &neosync_benthos.KafkaTlsConfig{
+		Enabled:        true,
+		SkipCertVerify: tlsConfig.GetSkipCertVerify(),
+	}
+	clientTls := tlsConfig.GetClientTls()
+	if clientTls.GetRootCert() != "" {
+		benthosTls.RootCas = clientTls.RootCert
+	}
+	if clientTls.GetClientCert() != "" && clientTls.GetClientKey() != "" {
+		benthosTls.ClientCerts = []neosync_benthos.KafkaClientCert{
+			{Cert: clientTls.GetClientCert(), Key: clientTls.GetClientKey()},
+		}
+	}
+	return benthosTls
+}
+
+func buildBenthosKafkaSasl(saslConfig *mgmtv1alpha1.KafkaSaslConfig) []neosync_benthos.KafkaSaslConfig {
+	if saslConfig == nil {
+		return nil
+	}
+	return []neosync_benthos.KafkaSaslConfig{
+		{
+			Mechanism: saslConfig.GetMechanism(),
+			Username:  saslConfig.GetUsername(),
+			Password:  saslConfig.GetPassword(),
+		},
+	}
+}
+
+type avroSchema struct {
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	Fields    []*avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name string `json:"name"`
+	Type []any  `json:"type"`
+}
+
+// Builds an Avro record schema for the table. Every field is nullable.
+// Columns without known metadata (generated or non-sql sources) are serialized as strings.
+func buildAvroSchema(schema, table string, columns []string, colInfoMap map[string]*sqlmanager_shared.ColumnInfo) (string, error) {
+	if len(columns) == 0 {
+		return "", fmt.Errorf("unable to build avro schema for %s.%s as it has no columns", schema, table)
+	}
+	fields := make([]*avroField, len(columns))
+	for idx, col := range columns {
+		fields[idx] = &avroField{
+			Name: toAvroName(col),
+			Type: []any{"null", toAvroType(colInfoMap[col])},
+		}
+	}
+	bits, err := json.Marshal(&avroSchema{
+		Type:      "record",
+		Name:      toAvroName(table),
+		Namespace: toAvroName(schema),
+		Fields:    fields,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal avro schema for %s.%s: %w", schema, table, err)
+	}
+	return string(bits), nil
+}
+
+// Avro requires that non-null union values are wrapped in an object keyed by the type name.
+// This is run as a mutation so that the message metadata (kafka key) is retained.
+func buildAvroMapping(columns []string, colInfoMap map[string]*sqlmanager_shared.ColumnInfo) string {
+	lines := make([]string, len(columns))
+	for idx, col := range columns {
+		avroType := toAvroType(colInfoMap[col])
+		var conversion string
+		switch avroType {
+		case "long":
+			conversion = "int64()"
+		case "double":
+			conversion = "number()"
+		case "boolean":
+			conversion = "bool()"
+		default:
+			conversion = "string()"
+		}
+		lines[idx] = fmt.Sprintf(
+			"root.%s = if this.%q == null { null } else { {%q: this.%q.%s} }",
+			toAvroName(col), col, avroType, col, conversion,
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func toAvroType(colInfo *sqlmanager_shared.ColumnInfo) string {
+	if colInfo == nil {
+		return "string"
+	}
+	switch strings.ToLower(colInfo.DataType) {
+	case "smallint", "integer", "int", "bigint", "tinyint", "mediumint", "int2", "int4", "int8", "smallserial", "serial", "bigserial":
+		return "long"
+	case "real", "double precision", "float", "double", "float4", "float8":
+		return "double"
+	case "boolean", "bool":
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// Avro names must start with a letter or underscore and only contain letters, digits, and underscores
+func toAvroName(name string) string {
+	sanitized := invalidAvroNameChars.ReplaceAllString(name, "_")
+	if sanitized == "" || (sanitized[0] >= '0' && sanitized[0] <= '9') {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
@@ -0,0 +1,155 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	_ "github.com/warpstreamlabs/bento/public/components/pure"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func Test_getKafkaBenthosOutput(t *testing.T) {
+	b := &benthosBuilder{}
+	connection := &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+		KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{
+			Brokers: []string{"localhost:9092"},
+			Sasl:    &mgmtv1alpha1.KafkaSaslConfig{Mechanism: "PLAIN", Username: "user", Password: "pass"},
+			Tls:     &mgmtv1alpha1.KafkaTlsConfig{SkipCertVerify: true},
+		},
+	}
+	newResp := func() *BenthosConfigResponse {
+		return &BenthosConfigResponse{
+			TableSchema: "public",
+			TableName:   "users",
+			Columns:     []string{"id", "name"},
+			primaryKeys: []string{"id"},
+			columnInfoMap: map[string]*sqlmanager_shared.ColumnInfo{
+				"id":   {DataType: "integer"},
+				"name": {DataType: "text"},
+			},
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Input: &neosync_benthos.InputConfig{
+						Inputs: neosync_benthos.Inputs{PooledSqlRaw: &neosync_benthos.InputPooledSqlRaw{}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("json with primary key", func(t *testing.T) {
+		destination := &mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+					KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{},
+				},
+			},
+		}
+		outputs, err := b.getKafkaBenthosOutput(connection, destination, newResp())
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Len(t, outputs[0].Fallback, 2)
+		retry := outputs[0].Fallback[0].Retry
+		require.NotNil(t, retry)
+		output := retry.Output.KafkaFranz
+		require.NotNil(t, output)
+		require.Equal(t, []string{"localhost:9092"}, output.SeedBrokers)
+		require.Equal(t, "public.users", output.Topic)
+		require.Equal(t, `${! meta("neosync_kafka_key") }`, output.Key)
+		require.True(t, output.Tls.Enabled)
+		require.True(t, output.Tls.SkipCertVerify)
+		require.Len(t, output.Sasl, 1)
+		require.Len(t, retry.Output.Processors, 1)
+		require.NotNil(t, outputs[0].Fallback[1].Error)
+	})
+
+	t.Run("avro with key columns and topic", func(t *testing.T) {
+		destination := &mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+					KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+						Topic:      shared.Ptr("neosync-{table}"),
+						Format:     mgmtv1alpha1.KafkaSerializationFormat_KAFKA_SERIALIZATION_FORMAT_AVRO,
+						KeyColumns: []*mgmtv1alpha1.KafkaTableKeyColumns{{Schema: "public", Table: "users", Columns: []string{"id", "name"}}},
+					},
+				},
+			},
+		}
+		outputs, err := b.getKafkaBenthosOutput(connection, destination, newResp())
+		require.NoError(t, err)
+		retry := outputs[0].Fallback[0].Retry
+		require.Equal(t, "neosync-users", retry.Output.KafkaFranz.Topic)
+		require.Len(t, retry.Output.Processors, 3)
+		require.NotNil(t, retry.Output.Processors[2].Avro)
+		require.Equal(t, "from_json", retry.Output.Processors[2].Avro.Operator)
+		require.Equal(t, "binary", retry.Output.Processors[2].Avro.Encoding)
+	})
+
+	t.Run("invalid key column", func(t *testing.T) {
+		destination := &mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+					KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+						KeyColumns: []*mgmtv1alpha1.KafkaTableKeyColumns{{Schema: "public", Table: "users", Columns: []string{"missing"}}},
+					},
+				},
+			},
+		}
+		_, err := b.getKafkaBenthosOutput(connection, destination, newResp())
+		require.Error(t, err)
+	})
+}
+
+func Test_buildKafkaKeyMapping(t *testing.T) {
+	exec, err := bloblang.Parse(buildKafkaKeyMapping([]string{"id", "name"}))
+	require.NoError(t, err)
+	msg := service.NewMessage(nil)
+	msg.SetStructured(map[string]any{"id": 1, "name": "foo"})
+	actual, err := msg.BloblangMutate(exec)
+	require.NoError(t, err)
+	key, ok := actual.MetaGet("neosync_kafka_key")
+	require.True(t, ok)
+	require.Equal(t, `{"id":1,"name":"foo"}`, key)
+
+	require.Equal(t, `meta neosync_kafka_key = this."id".string()`, buildKafkaKeyMapping([]string{"id"}))
+}
+
+func Test_buildAvroMapping(t *testing.T) {
+	colInfo := map[string]*sqlmanager_shared.ColumnInfo{
+		"id":        {DataType: "bigint"},
+		"is_active": {DataType: "boolean"},
+		"score":     {DataType: "double precision"},
+	}
+	mapping := buildAvroMapping([]string{"id", "is_active", "score", "first-name"}, colInfo)
+	exec, err := bloblang.Parse(mapping)
+	require.NoError(t, err)
+
+	actual, err := exec.Query(map[string]any{"id": "1", "is_active": true, "score": 1.5, "first-name": nil})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"id":         map[string]any{"long": int64(1)},
+		"is_active":  map[string]any{"boolean": true},
+		"score":      map[string]any{"double": 1.5},
+		"first_name": nil,
+	}, actual)
+}
+
+func Test_buildAvroSchema(t *testing.T) {
+	actual, err := buildAvroSchema("public", "users", []string{"id", "1st"}, map[string]*sqlmanager_shared.ColumnInfo{"id": {DataType: "integer"}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"record","name":"users","namespace":"public","fields":[{"name":"id","type":["null","long"]},{"name":"_1st","type":["null","string"]}]}`, actual)
+
+	_, err = buildAvroSchema("public", "users", []string{}, nil)
+	require.Error(t, err)
+}
+
+func Test_buildKafkaTopic(t *testing.T) {
+	require.Equal(t, "public.users", buildKafkaTopic("", "public", "users"))
+	require.Equal(t, "events", buildKafkaTopic("events", "public", "users"))
+	require.Equal(t, "dev.users", buildKafkaTopic("dev.{table}", "public", "users"))
+}
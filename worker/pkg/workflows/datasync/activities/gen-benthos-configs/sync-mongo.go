@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
@@ -29,6 +30,7 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 	}
 
 	groupedMappings := groupMappingsByTable(job.GetMappings())
+	collectionOpts := groupMongoDbCollectionOptions(job.GetSource().GetOptions().GetMongodb().GetCollections())
 
 	benthosConfigs := []*BenthosConfigResponse{}
 	for _, tableMapping := range groupedMappings {
@@ -36,12 +38,7 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 			StreamConfig: neosync_benthos.StreamConfig{
 				Input: &neosync_benthos.InputConfig{
 					Inputs: neosync_benthos.Inputs{
-						PooledMongoDB: &neosync_benthos.InputMongoDb{
-							Url:        "${SOURCE_CONNECTION_DSN}",
-							Database:   tableMapping.Schema,
-							Collection: tableMapping.Table,
-							Query:      "root = this",
-						},
+						PooledMongoDB: buildMongoDbInput(tableMapping.Schema, tableMapping.Table, collectionOpts[neosync_benthos.BuildBenthosTable(tableMapping.Schema, tableMapping.Table)]),
 					},
 				},
 				Pipeline: &neosync_benthos.PipelineConfig{
@@ -103,3 +100,81 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 		BenthosConfigs: benthosConfigs,
 	}, nil
 }
+
+func groupMongoDbCollectionOptions(collections []*mgmtv1alpha1.MongoDBSourceCollectionOption) map[string]*mgmtv1alpha1.MongoDBSourceCollectionOption {
+	output := map[string]*mgmtv1alpha1.MongoDBSourceCollectionOption{}
+	for _, collection := range collections {
+		output[neosync_benthos.BuildBenthosTable(collection.GetDatabase(), collection.GetCollection())] = collection
+	}
+	return output
+}
+
+func buildMongoDbInput(database, collection string, opts *mgmtv1alpha1.MongoDBSourceCollectionOption) *neosync_benthos.InputMongoDb {
+	input := &neosync_benthos.InputMongoDb{
+		Url:        "${SOURCE_CONNECTION_DSN}",
+		Database:   database,
+		Collection: collection,
+		Query:      "root = this",
+	}
+	if opts == nil {
+		return input
+	}
+	if opts.GetFilter() != "" {
+		input.Query = fmt.Sprintf("root = %q.parse_json()", opts.GetFilter())
+	}
+	if len(opts.GetSort()) > 0 {
+		input.Sort = make([]*neosync_benthos.MongoDbSortField, 0, len(opts.GetSort()))
+		for _, field := range opts.GetSort() {
+			input.Sort = append(input.Sort, &neosync_benthos.MongoDbSortField{Field: field.GetField(), Direction: int(field.GetDirection())})
+		}
+	}
+	if len(opts.GetProjection()) > 0 {
+		input.Projection = toIntMap(opts.GetProjection())
+	}
+	input.Limit = opts.Limit
+	return input
+}
+
+func toIntMap(input map[string]int32) map[string]int {
+	output := make(map[string]int, len(input))
+	for key, value := range input {
+		output[key] = int(value)
+	}
+	return output
+}
+
+// Returns the configured upsert key fields for the collection, or nil if documents should be upserted on _id
+func getMongoDbUpsertKey(opts *mgmtv1alpha1.MongoDBDestinationConnectionOptions, database, collection string) []string {
+	for _, key := range opts.GetUpsertKeys() {
+		if key.GetDatabase() == database && key.GetCollection() == collection {
+			return key.GetFields()
+		}
+	}
+	return nil
+}
+
+// Builds the document and filter maps used to upsert documents into the destination collection.
+// When upserting on a custom key the source _id is only set when the document is inserted as _id is immutable.
+func buildMongoDbUpsertMaps(keyFields []string) (documentMap, filterMap string) {
+	if len(keyFields) == 0 || (len(keyFields) == 1 && keyFields[0] == "_id") {
+		return `root."$set" = this`, `root._id = this._id`
+	}
+
+	filterLines := make([]string, len(keyFields))
+	for idx, field := range keyFields {
+		filterLines[idx] = fmt.Sprintf("root.%q = %s", field, buildBloblangFieldPath(field))
+	}
+	documentMap = `root."$set" = this.without("_id")
+root."$setOnInsert" = if this._id != null { {"_id": this._id} }`
+	return documentMap, strings.Join(filterLines, "\n")
+}
+
+// Converts a dot delimited document path into a bloblang path with each segment quoted
+func buildBloblangFieldPath(field string) string {
+	segments := strings.Split(field, ".")
+	quoted := make([]string, len(segments))
+	for idx, segment := range segments {
+		quoted[idx] = fmt.Sprintf("%q", segment)
+	}
+	return "this." + strings.Join(quoted, ".")
+}
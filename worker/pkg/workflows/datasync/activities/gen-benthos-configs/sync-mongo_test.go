@@ -0,0 +1,118 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+func Test_groupMongoDbCollectionOptions(t *testing.T) {
+	actual := groupMongoDbCollectionOptions(nil)
+	require.Empty(t, actual)
+
+	users := &mgmtv1alpha1.MongoDBSourceCollectionOption{Database: "app", Collection: "users"}
+	orders := &mgmtv1alpha1.MongoDBSourceCollectionOption{Database: "app", Collection: "orders"}
+	actual = groupMongoDbCollectionOptions([]*mgmtv1alpha1.MongoDBSourceCollectionOption{users, orders})
+	require.Equal(t, map[string]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		"app.users":  users,
+		"app.orders": orders,
+	}, actual)
+}
+
+func Test_buildMongoDbInput(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		actual := buildMongoDbInput("app", "users", nil)
+		require.Equal(t, "app", actual.Database)
+		require.Equal(t, "users", actual.Collection)
+		require.Equal(t, "root = this", actual.Query)
+		require.Nil(t, actual.Sort)
+		require.Nil(t, actual.Projection)
+		require.Nil(t, actual.Limit)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		filter := `{"createdAt": {"$gte": {"$date": "2024-01-01T00:00:00Z"}}, "name": "a \"quoted\" name"}`
+		actual := buildMongoDbInput("app", "users", &mgmtv1alpha1.MongoDBSourceCollectionOption{
+			Database:   "app",
+			Collection: "users",
+			Filter:     &filter,
+			Projection: map[string]int32{"ssn": 0},
+			Sort: []*mgmtv1alpha1.MongoDBSortField{
+				{Field: "createdAt", Direction: -1},
+				{Field: "name", Direction: 1},
+			},
+			Limit: shared.Ptr(int64(100)),
+		})
+		require.Equal(t, map[string]int{"ssn": 0}, actual.Projection)
+		require.Equal(t, []*neosync_benthos.MongoDbSortField{
+			{Field: "createdAt", Direction: -1},
+			{Field: "name", Direction: 1},
+		}, actual.Sort)
+		require.NotNil(t, actual.Limit)
+		require.Equal(t, int64(100), *actual.Limit)
+
+		exec, err := bloblang.Parse(actual.Query)
+		require.NoError(t, err)
+		query, err := exec.Query(struct{}{})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"createdAt": map[string]any{"$gte": map[string]any{"$date": "2024-01-01T00:00:00Z"}},
+			"name":      `a "quoted" name`,
+		}, query)
+	})
+}
+
+func Test_getMongoDbUpsertKey(t *testing.T) {
+	require.Nil(t, getMongoDbUpsertKey(nil, "app", "users"))
+
+	opts := &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+		UpsertKeys: []*mgmtv1alpha1.MongoDBDestinationUpsertKey{
+			{Database: "app", Collection: "users", Fields: []string{"email"}},
+		},
+	}
+	require.Equal(t, []string{"email"}, getMongoDbUpsertKey(opts, "app", "users"))
+	require.Nil(t, getMongoDbUpsertKey(opts, "app", "orders"))
+}
+
+func Test_buildMongoDbUpsertMaps(t *testing.T) {
+	t.Run("default _id", func(t *testing.T) {
+		documentMap, filterMap := buildMongoDbUpsertMaps(nil)
+		require.Equal(t, `root."$set" = this`, documentMap)
+		require.Equal(t, `root._id = this._id`, filterMap)
+	})
+
+	t.Run("custom key", func(t *testing.T) {
+		documentMap, filterMap := buildMongoDbUpsertMaps([]string{"email", "address.zip"})
+		input := map[string]any{
+			"_id":     "abc",
+			"email":   "foo@example.com",
+			"address": map[string]any{"zip": "12345"},
+		}
+
+		filterExec, err := bloblang.Parse(filterMap)
+		require.NoError(t, err)
+		filter, err := filterExec.Query(input)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"email": "foo@example.com", "address.zip": "12345"}, filter)
+
+		docExec, err := bloblang.Parse(documentMap)
+		require.NoError(t, err)
+		doc, err := docExec.Query(input)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"$set": map[string]any{
+				"email":   "foo@example.com",
+				"address": map[string]any{"zip": "12345"},
+			},
+			"$setOnInsert": map[string]any{"_id": "abc"},
+		}, doc)
+
+		doc, err = docExec.Query(map[string]any{"email": "foo@example.com"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"$set": map[string]any{"email": "foo@example.com"}}, doc)
+	})
+}
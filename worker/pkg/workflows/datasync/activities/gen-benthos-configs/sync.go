@@ -2,7 +2,6 @@ package genbenthosconfigs_activity
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log/slog"
 	"slices"
@@ -22,6 +21,7 @@ type sqlSyncResp struct {
 	BenthosConfigs             []*BenthosConfigResponse
 	primaryKeyToForeignKeysMap map[string]map[string][]*referenceKey
 	ColumnTransformerMap       map[string]map[string]*mgmtv1alpha1.JobMappingTransformer
+	UnmappedColumns            []string
 }
 
 func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
@@ -54,11 +54,15 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 		return nil, fmt.Errorf("unable to get database schema for connection: %w", err)
 	}
 	if !areMappingsSubsetOfSchemas(groupedSchemas, job.Mappings) {
-		return nil, errors.New(jobmappingSubsetErrMsg)
+		return nil, errJobMappingsNotSubset
 	}
 	if sqlSourceOpts != nil && sqlSourceOpts.HaltOnNewColumnAddition &&
 		shouldHaltOnSchemaAddition(groupedSchemas, job.Mappings) {
-		return nil, errors.New(haltOnSchemaAdditionErrMsg)
+		return nil, errSchemaAdditionHalted
+	}
+	unmappedColumns := getUnmappedColumns(groupedSchemas, job.Mappings)
+	if len(unmappedColumns) > 0 {
+		slogger.Warn(fmt.Sprintf("found %d source columns without a job mapping", len(unmappedColumns)))
 	}
 	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.Mappings)
 
@@ -102,6 +106,7 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 		BenthosConfigs:             sourceResponses,
 		primaryKeyToForeignKeysMap: primaryKeyToForeignKeysMap,
 		ColumnTransformerMap:       colTransformerMap,
+		UnmappedColumns:            unmappedColumns,
 	}, nil
 }
 
@@ -275,6 +280,7 @@ func buildBenthosSqlSourceConfigResponses(
 			Columns:         config.InsertColumns(),
 			IdentityColumns: getIdentityColumns(config.Table(), config.InsertColumns(), groupedColumnInfo),
 			primaryKeys:     config.PrimaryKeys(),
+			columnInfoMap:   colInfoMap,
 
 			metriclabels: metrics.MetricLabels{
 				metrics.NewEqLabel(metrics.TableSchemaLabel, mappings.Schema),
@@ -744,6 +750,10 @@ func getDestinationOptions(dest *mgmtv1alpha1.JobDestination) *destinationOption
 			OnConflictDoNothing: config.MysqlOptions.GetOnConflict().GetDoNothing(),
 			Truncate:            config.MysqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 		}
+	case *mgmtv1alpha1.JobDestinationOptions_ClickhouseOptions:
+		return &destinationOptions{
+			Truncate: config.ClickhouseOptions.GetTruncateTable().GetTruncateBeforeInsert(),
+		}
 	default:
 		return &destinationOptions{}
 	}
@@ -951,6 +961,26 @@ func shouldHaltOnSchemaAddition(
 	return false
 }
 
+// Returns the schema.table.column of each column in the grouped schemas that does not have a job mapping
+func getUnmappedColumns(
+	groupedSchemas map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	mappings []*mgmtv1alpha1.JobMapping,
+) []string {
+	tableColMappings := getUniqueColMappingsMap(mappings)
+
+	unmapped := []string{}
+	for table, cols := range groupedSchemas {
+		mappingCols := tableColMappings[table]
+		for col := range cols {
+			if _, ok := mappingCols[col]; !ok {
+				unmapped = append(unmapped, fmt.Sprintf("%s.%s", table, col))
+			}
+		}
+	}
+	slices.Sort(unmapped)
+	return unmapped
+}
+
 type sqlSourceTableOptions struct {
 	WhereClause *string
 }
@@ -0,0 +1,59 @@
+package notifyjobrun_activity
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type Activity struct {
+	notificationclient mgmtv1alpha1connect.NotificationServiceClient
+}
+
+func New(
+	notificationclient mgmtv1alpha1connect.NotificationServiceClient,
+) *Activity {
+	return &Activity{
+		notificationclient: notificationclient,
+	}
+}
+
+type NotifyJobRunRequest struct {
+	JobId        string
+	WorkflowId   string
+	EventType    mgmtv1alpha1.NotificationEventType
+	ErrorMessage *string
+}
+
+type NotifyJobRunResponse struct {
+	DeliveredCount uint32
+}
+
+// Sends the job run lifecycle event to every notification channel the job is subscribed to for that event
+func (a *Activity) NotifyJobRun(
+	ctx context.Context,
+	req *NotifyJobRunRequest,
+) (*NotifyJobRunResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.WorkflowId,
+	)
+	logger.Debug("sending job run notification", "event", req.EventType.String())
+
+	resp, err := a.notificationclient.SendJobRunNotification(ctx, connect.NewRequest(&mgmtv1alpha1.SendJobRunNotificationRequest{
+		JobId:        req.JobId,
+		JobRunId:     req.WorkflowId,
+		EventType:    req.EventType,
+		ErrorMessage: req.ErrorMessage,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to send job run notification: %w", err)
+	}
+	return &NotifyJobRunResponse{DeliveredCount: resp.Msg.GetDeliveredCount()}, nil
+}
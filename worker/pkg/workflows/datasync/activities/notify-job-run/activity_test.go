@@ -0,0 +1,60 @@
+package notifyjobrun_activity
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_NotifyJobRun(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	errorMessage := "unable to connect"
+
+	notificationclient := mgmtv1alpha1connect.NewMockNotificationServiceClient(t)
+	notificationclient.On("SendJobRunNotification", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.SendJobRunNotificationRequest]) bool {
+		return req.Msg.GetJobRunId() == "wf-id" &&
+			req.Msg.GetJobId() == "job-id" &&
+			req.Msg.GetEventType() == mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED &&
+			req.Msg.GetErrorMessage() == errorMessage
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SendJobRunNotificationResponse{DeliveredCount: 2}), nil)
+
+	activity := New(notificationclient)
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.NotifyJobRun, &NotifyJobRunRequest{
+		JobId:        "job-id",
+		WorkflowId:   "wf-id",
+		EventType:    mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED,
+		ErrorMessage: &errorMessage,
+	})
+	require.NoError(t, err)
+	res := &NotifyJobRunResponse{}
+	require.NoError(t, val.Get(res))
+	require.Equal(t, uint32(2), res.DeliveredCount)
+}
+
+func Test_NotifyJobRun_Error(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	notificationclient := mgmtv1alpha1connect.NewMockNotificationServiceClient(t)
+	notificationclient.On("SendJobRunNotification", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+
+	activity := New(notificationclient)
+	env.RegisterActivity(activity)
+
+	_, err := env.ExecuteActivity(activity.NotifyJobRun, &NotifyJobRunRequest{
+		JobId:      "job-id",
+		WorkflowId: "wf-id",
+		EventType:  mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package recordjobrun_activity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Activity struct {
+	jobclient mgmtv1alpha1connect.JobServiceClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+) *Activity {
+	return &Activity{
+		jobclient: jobclient,
+	}
+}
+
+type TableSummary struct {
+	Schema      string
+	Table       string
+	RowsRead    int64
+	RowsWritten int64
+	RowsErrored int64
+}
+
+type RecordJobRunRequest struct {
+	JobId      string
+	WorkflowId string
+	Status     mgmtv1alpha1.JobRunStatus
+	StartedAt  time.Time
+	// Unset while the run is still in progress
+	CompletedAt  *time.Time
+	Tables       []*TableSummary
+	ErrorMessage *string
+}
+
+type RecordJobRunResponse struct{}
+
+// Persists the current state of the job run to the Neosync run history
+func (a *Activity) RecordJobRun(
+	ctx context.Context,
+	req *RecordJobRunRequest,
+) (*RecordJobRunResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.WorkflowId,
+	)
+	logger.Debug("recording job run", "status", req.Status.String())
+
+	_, err := a.jobclient.RecordJobRun(ctx, connect.NewRequest(toRecordJobRunRequest(req)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to record job run: %w", err)
+	}
+	return &RecordJobRunResponse{}, nil
+}
+
+func toRecordJobRunRequest(req *RecordJobRunRequest) *mgmtv1alpha1.RecordJobRunRequest {
+	dto := &mgmtv1alpha1.RecordJobRunRequest{
+		JobRunId:     req.WorkflowId,
+		JobId:        req.JobId,
+		Status:       req.Status,
+		StartedAt:    timestamppb.New(req.StartedAt),
+		Tables:       make([]*mgmtv1alpha1.JobRunTableSummary, 0, len(req.Tables)),
+		ErrorMessage: req.ErrorMessage,
+	}
+	if req.CompletedAt != nil {
+		dto.CompletedAt = timestamppb.New(*req.CompletedAt)
+	}
+	for _, table := range req.Tables {
+		dto.Tables = append(dto.Tables, &mgmtv1alpha1.JobRunTableSummary{
+			Schema:      table.Schema,
+			Table:       table.Table,
+			RowsRead:    table.RowsRead,
+			RowsWritten: table.RowsWritten,
+			RowsErrored: table.RowsErrored,
+		})
+	}
+	return dto
+}
@@ -0,0 +1,72 @@
+package recordjobrun_activity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_RecordJobRun(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	startedAt := time.Date(2024, 9, 4, 10, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(time.Minute)
+	errorMessage := "unable to connect"
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("RecordJobRun", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.RecordJobRunRequest]) bool {
+		return req.Msg.GetJobRunId() == "wf-id" &&
+			req.Msg.GetJobId() == "job-id" &&
+			req.Msg.GetStatus() == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED &&
+			req.Msg.GetStartedAt().AsTime().Equal(startedAt) &&
+			req.Msg.GetCompletedAt().AsTime().Equal(completedAt) &&
+			req.Msg.GetErrorMessage() == errorMessage &&
+			len(req.Msg.GetTables()) == 1 &&
+			req.Msg.GetTables()[0].GetRowsWritten() == 8
+	})).Return(connect.NewResponse(&mgmtv1alpha1.RecordJobRunResponse{}), nil)
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.RecordJobRun, &RecordJobRunRequest{
+		JobId:       "job-id",
+		WorkflowId:  "wf-id",
+		Status:      mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED,
+		StartedAt:   startedAt,
+		CompletedAt: &completedAt,
+		Tables: []*TableSummary{
+			{Schema: "public", Table: "users", RowsRead: 10, RowsWritten: 8, RowsErrored: 2},
+		},
+		ErrorMessage: &errorMessage,
+	})
+	require.NoError(t, err)
+	res := &RecordJobRunResponse{}
+	require.NoError(t, val.Get(res))
+}
+
+func Test_RecordJobRun_Error(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("RecordJobRun", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	_, err := env.ExecuteActivity(activity.RecordJobRun, &RecordJobRunRequest{
+		JobId:      "job-id",
+		WorkflowId: "wf-id",
+		Status:     mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+		StartedAt:  time.Now(),
+	})
+	require.Error(t, err)
+}
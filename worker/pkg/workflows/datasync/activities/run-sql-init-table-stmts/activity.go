@@ -6,7 +6,9 @@ import (
 
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/log"
 )
@@ -15,24 +17,29 @@ type Activity struct {
 	jobclient  mgmtv1alpha1connect.JobServiceClient
 	connclient mgmtv1alpha1connect.ConnectionServiceClient
 
-	sqlmanager sql_manager.SqlManagerClient
+	sqlmanager     sql_manager.SqlManagerClient
+	mongoconnector mongoconnect.Interface
 }
 
 func New(
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 	sqlmanager sql_manager.SqlManagerClient,
+	mongoconnector mongoconnect.Interface,
 ) *Activity {
 	return &Activity{
-		jobclient:  jobclient,
-		connclient: connclient,
-		sqlmanager: sqlmanager,
+		jobclient:      jobclient,
+		connclient:     connclient,
+		sqlmanager:     sqlmanager,
+		mongoconnector: mongoconnector,
 	}
 }
 
 type RunSqlInitTableStatementsRequest struct {
 	JobId      string
 	WorkflowId string
+	// Applied in place of the stored job definition
+	Overrides *shared.JobRunOverrides
 }
 
 type RunSqlInitTableStatementsResponse struct {
@@ -65,6 +72,7 @@ func (a *Activity) RunSqlInitTableStatements(
 		a.sqlmanager,
 		a.jobclient,
 		a.connclient,
+		a.mongoconnector,
 	)
 	slogger := neosynclogger.NewJsonSLogger().With(
 		"jobId", req.JobId,
@@ -0,0 +1,94 @@
+package runsqlinittablestmts_activity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_clickhouse "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/clickhouse"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+// Builds the ClickHouse DDL for every table in the job mappings using the column metadata of the source.
+// Only the mapped columns are created on the destination table.
+func buildClickhouseInitStatements(
+	ctx context.Context,
+	sourcedb sql_manager.SqlDatabase,
+	mappings []*mgmtv1alpha1.JobMapping,
+	schemas []string,
+	opts *mgmtv1alpha1.ClickhouseDestinationConnectionOptions,
+) ([]string, error) {
+	columnMap, err := sourcedb.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve source schema column map: %w", err)
+	}
+	constraints, err := sourcedb.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve source table constraints: %w", err)
+	}
+	return getClickhouseInitStatements(columnMap, constraints.PrimaryKeyConstraints, mappings, opts)
+}
+
+func getClickhouseInitStatements(
+	columnMap map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	primaryKeyMap map[string][]string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	opts *mgmtv1alpha1.ClickhouseDestinationConnectionOptions,
+) ([]string, error) {
+	createOpts := &sqlmanager_clickhouse.CreateTableOptions{
+		Engine:  opts.GetTableEngine(),
+		OrderBy: opts.GetOrderBy(),
+	}
+	if opts.GetPartitionBy() != "" {
+		partitionBy := opts.GetPartitionBy()
+		createOpts.PartitionBy = &partitionBy
+	}
+
+	schemas := []string{}
+	tableKeys := []string{}
+	tableColumns := map[string]map[string]*sqlmanager_shared.ColumnInfo{}
+	for _, mapping := range mappings {
+		tableKey := sqlmanager_shared.BuildTable(mapping.GetSchema(), mapping.GetTable())
+		sourceCols, ok := columnMap[tableKey]
+		if !ok {
+			return nil, fmt.Errorf("unable to find table %s in source schema", tableKey)
+		}
+		colInfo, ok := sourceCols[mapping.GetColumn()]
+		if !ok {
+			return nil, fmt.Errorf("unable to find column %s in source table %s", mapping.GetColumn(), tableKey)
+		}
+		if _, ok := tableColumns[tableKey]; !ok {
+			tableColumns[tableKey] = map[string]*sqlmanager_shared.ColumnInfo{}
+			tableKeys = append(tableKeys, tableKey)
+		}
+		tableColumns[tableKey][mapping.GetColumn()] = colInfo
+		if !slices.Contains(schemas, mapping.GetSchema()) {
+			schemas = append(schemas, mapping.GetSchema())
+		}
+	}
+	slices.Sort(schemas)
+	slices.Sort(tableKeys)
+
+	stmts := make([]string, 0, len(schemas)+len(tableKeys))
+	for _, schema := range schemas {
+		stmts = append(stmts, sqlmanager_clickhouse.BuildCreateDatabaseStatement(schema))
+	}
+	for _, tableKey := range tableKeys {
+		schema, table := sqlmanager_shared.SplitTableKey(tableKey)
+		primaryKeys := []string{}
+		for _, pk := range primaryKeyMap[tableKey] {
+			// the sort key must only reference columns that exist in the destination table
+			if _, ok := tableColumns[tableKey][pk]; ok {
+				primaryKeys = append(primaryKeys, pk)
+			}
+		}
+		stmt, err := sqlmanager_clickhouse.BuildCreateTableStatement(schema, table, tableColumns[tableKey], primaryKeys, createOpts)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
+}
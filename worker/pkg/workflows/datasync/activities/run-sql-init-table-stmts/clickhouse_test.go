@@ -0,0 +1,70 @@
+package runsqlinittablestmts_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getClickhouseInitStatements(t *testing.T) {
+	columnMap := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":    {OrdinalPosition: 1, DataType: "integer"},
+			"email": {OrdinalPosition: 2, DataType: "text", IsNullable: true},
+			"notes": {OrdinalPosition: 3, DataType: "text", IsNullable: true},
+		},
+		"public.orders": {
+			"id":      {OrdinalPosition: 1, DataType: "bigint"},
+			"user_id": {OrdinalPosition: 2, DataType: "integer"},
+		},
+	}
+	primaryKeys := map[string][]string{
+		"public.users":  {"id"},
+		"public.orders": {"id"},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email"},
+		{Schema: "public", Table: "orders", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "user_id"},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		actual, err := getClickhouseInitStatements(columnMap, primaryKeys, mappings, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"CREATE DATABASE IF NOT EXISTS `public`",
+			"CREATE TABLE IF NOT EXISTS `public`.`orders` (`id` Int64, `user_id` Int32) ENGINE = MergeTree ORDER BY (`id`)",
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` Int32, `email` Nullable(String)) ENGINE = MergeTree ORDER BY (`id`)",
+		}, actual)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		engine := "ReplacingMergeTree"
+		actual, err := getClickhouseInitStatements(columnMap, primaryKeys, mappings[:2], &mgmtv1alpha1.ClickhouseDestinationConnectionOptions{
+			TableEngine: &engine,
+			OrderBy:     []string{"id"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"CREATE DATABASE IF NOT EXISTS `public`",
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` Int32, `email` Nullable(String)) ENGINE = ReplacingMergeTree ORDER BY (`id`)",
+		}, actual)
+	})
+
+	t.Run("unmapped primary key", func(t *testing.T) {
+		actual, err := getClickhouseInitStatements(columnMap, primaryKeys, mappings[1:2], nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"CREATE DATABASE IF NOT EXISTS `public`",
+			"CREATE TABLE IF NOT EXISTS `public`.`users` (`email` Nullable(String)) ENGINE = MergeTree ORDER BY tuple()",
+		}, actual)
+	})
+
+	t.Run("missing source column", func(t *testing.T) {
+		_, err := getClickhouseInitStatements(columnMap, primaryKeys, []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "missing"}}, nil)
+		require.Error(t, err)
+	})
+}
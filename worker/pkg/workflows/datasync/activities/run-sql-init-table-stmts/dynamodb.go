@@ -0,0 +1,114 @@
+package runsqlinittablestmts_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// Creates and truncates the destination tables of every dynamodb destination.
+// Tables are only created if they do not already exist and are created prior to being truncated.
+func (b *initStatementBuilder) runDynamoDbInitStatements(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	sourceConnection *mgmtv1alpha1.Connection,
+	slogger *slog.Logger,
+) error {
+	sourceTables := getUniqueDynamoDbTables(job)
+	awsManager := awsmanager.New()
+
+	for _, destination := range job.GetDestinations() {
+		opts := destination.GetOptions().GetDynamodbOptions()
+		if !opts.GetInitTable() && !opts.GetTruncateBeforeInsert() {
+			slogger.Info("skipping table truncate and init as none were set to true")
+			continue
+		}
+		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.GetConnectionId())
+		if err != nil {
+			return fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if destinationConnection.GetConnectionConfig().GetDynamodbConfig() == nil {
+			return fmt.Errorf("unsupported destination connection config for dynamodb source: %T", destinationConnection.GetConnectionConfig().GetConfig())
+		}
+		tableMap, err := getDynamoDbDestinationTableMap(sourceTables, opts)
+		if err != nil {
+			return err
+		}
+
+		destClient, err := awsManager.NewDynamoDbClient(ctx, destinationConnection.GetConnectionConfig().GetDynamodbConfig())
+		if err != nil {
+			return fmt.Errorf("unable to create dynamodb client for destination connection (%s): %w", destinationConnection.GetId(), err)
+		}
+
+		if opts.GetInitTable() {
+			sourceClient, err := awsManager.NewDynamoDbClient(ctx, sourceConnection.GetConnectionConfig().GetDynamodbConfig())
+			if err != nil {
+				return fmt.Errorf("unable to create dynamodb client for source connection (%s): %w", sourceConnection.GetId(), err)
+			}
+			for _, sourceTable := range sourceTables {
+				destTable := tableMap[sourceTable]
+				existing, err := destClient.DescribeTable(ctx, destTable)
+				if err != nil {
+					return err
+				}
+				if existing != nil {
+					slogger.Info(fmt.Sprintf("dynamodb table %s already exists, skipping table initialization", destTable))
+					continue
+				}
+				sourceDesc, err := sourceClient.DescribeTable(ctx, sourceTable)
+				if err != nil {
+					return err
+				}
+				if sourceDesc == nil {
+					return fmt.Errorf("source dynamodb table %s does not exist", sourceTable)
+				}
+				slogger.Info(fmt.Sprintf("creating dynamodb table %s from source table %s", destTable, sourceTable))
+				if err := destClient.CreateTableFromDescription(ctx, destTable, sourceDesc); err != nil {
+					return err
+				}
+			}
+		}
+
+		if opts.GetTruncateBeforeInsert() {
+			slogger.Info(fmt.Sprintf("truncating %d dynamodb tables", len(sourceTables)))
+			for _, sourceTable := range sourceTables {
+				if err := destClient.TruncateTable(ctx, tableMap[sourceTable]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func getUniqueDynamoDbTables(job *mgmtv1alpha1.Job) []string {
+	tableSet := map[string]struct{}{}
+	for _, mapping := range job.GetMappings() {
+		tableSet[mapping.GetTable()] = struct{}{}
+	}
+	tables := make([]string, 0, len(tableSet))
+	for table := range tableSet {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// Returns a map of source table to destination table. Every source table must have a destination table mapping.
+func getDynamoDbDestinationTableMap(sourceTables []string, opts *mgmtv1alpha1.DynamoDBDestinationConnectionOptions) (map[string]string, error) {
+	tableMap := map[string]string{}
+	for _, tm := range opts.GetTableMappings() {
+		tableMap[tm.GetSourceTable()] = tm.GetDestinationTable()
+	}
+	for _, table := range sourceTables {
+		if _, ok := tableMap[table]; !ok {
+			return nil, fmt.Errorf("did not find table map for %q when initializing dynamodb destination", table)
+		}
+	}
+	return tableMap, nil
+}
@@ -0,0 +1,33 @@
+package runsqlinittablestmts_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getUniqueDynamoDbTables(t *testing.T) {
+	actual := getUniqueDynamoDbTables(&mgmtv1alpha1.Job{
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "dynamodb", Table: "users", Column: "id"},
+			{Schema: "dynamodb", Table: "users", Column: "email"},
+			{Schema: "dynamodb", Table: "orders", Column: "id"},
+		},
+	})
+	require.Equal(t, []string{"orders", "users"}, actual)
+}
+
+func Test_getDynamoDbDestinationTableMap(t *testing.T) {
+	opts := &mgmtv1alpha1.DynamoDBDestinationConnectionOptions{
+		TableMappings: []*mgmtv1alpha1.DynamoDBDestinationTableMapping{
+			{SourceTable: "users", DestinationTable: "users-copy"},
+		},
+	}
+	actual, err := getDynamoDbDestinationTableMap([]string{"users"}, opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"users": "users-copy"}, actual)
+
+	_, err = getDynamoDbDestinationTableMap([]string{"users", "orders"}, opts)
+	require.Error(t, err)
+}
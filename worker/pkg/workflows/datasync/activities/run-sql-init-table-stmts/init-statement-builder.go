@@ -8,7 +8,9 @@ import (
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_clickhouse "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/clickhouse"
 	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
@@ -21,20 +23,23 @@ const (
 )
 
 type initStatementBuilder struct {
-	sqlmanager sql_manager.SqlManagerClient
-	jobclient  mgmtv1alpha1connect.JobServiceClient
-	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager     sql_manager.SqlManagerClient
+	jobclient      mgmtv1alpha1connect.JobServiceClient
+	connclient     mgmtv1alpha1connect.ConnectionServiceClient
+	mongoconnector mongoconnect.Interface
 }
 
 func newInitStatementBuilder(
 	sqlmanager sql_manager.SqlManagerClient,
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	mongoconnector mongoconnect.Interface,
 ) *initStatementBuilder {
 	return &initStatementBuilder{
-		sqlmanager: sqlmanager,
-		jobclient:  jobclient,
-		connclient: connclient,
+		sqlmanager:     sqlmanager,
+		jobclient:      jobclient,
+		connclient:     connclient,
+		mongoconnector: mongoconnector,
 	}
 }
 
@@ -47,6 +52,10 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
+	job, err = shared.ApplyJobRunOverrides(job, req.Overrides.Get())
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply job run overrides: %w", err)
+	}
 
 	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
 	if err != nil {
@@ -59,7 +68,16 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		}
 	}
 
-	if sourceConnection.GetConnectionConfig().GetMongoConfig() != nil || sourceConnection.GetConnectionConfig().GetDynamodbConfig() != nil {
+	if sourceConnection.GetConnectionConfig().GetMongoConfig() != nil {
+		if err := b.runMongoInitStatements(ctx, job, sourceConnection, slogger); err != nil {
+			return nil, err
+		}
+		return &RunSqlInitTableStatementsResponse{}, nil
+	}
+	if sourceConnection.GetConnectionConfig().GetDynamodbConfig() != nil {
+		if err := b.runDynamoDbInitStatements(ctx, job, sourceConnection, slogger); err != nil {
+			return nil, err
+		}
 		return &RunSqlInitTableStatementsResponse{}, nil
 	}
 
@@ -77,8 +95,9 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		if err != nil {
 			return nil, fmt.Errorf("unable to get destination connection by id (%s): %w", destination.ConnectionId, err)
 		}
-		if destinationConnection.GetConnectionConfig().GetAwsS3Config() != nil || destinationConnection.GetConnectionConfig().GetGcpCloudstorageConfig() != nil {
-			// nothing to do for Bucket destinations
+		if destinationConnection.GetConnectionConfig().GetAwsS3Config() != nil || destinationConnection.GetConnectionConfig().GetGcpCloudstorageConfig() != nil ||
+			destinationConnection.GetConnectionConfig().GetKafkaConfig() != nil {
+			// nothing to do for Bucket or Kafka destinations
 			continue
 		}
 		sqlopts, err := getSqlJobDestinationOpts(destination.GetOptions())
@@ -240,6 +259,35 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 			// nothing to do here
 		case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 			slogger.Info("Mssql does not currently implement sql init table statements. Skipping for now until this gets implemented..")
+		case *mgmtv1alpha1.ConnectionConfig_ClickhouseConfig:
+			if sqlopts.InitSchema {
+				stmts, err := buildClickhouseInitStatements(ctx, sourcedb.Db, job.GetMappings(), uniqueSchemas, destination.GetOptions().GetClickhouseOptions())
+				if err != nil {
+					destdb.Db.Close()
+					return nil, fmt.Errorf("unable to build clickhouse init statements: %w", err)
+				}
+				slogger.Info(fmt.Sprintf("executing %d clickhouse statements during schema initialization", len(stmts)))
+				err = destdb.Db.BatchExec(ctx, batchSizeConst, stmts, &sqlmanager_shared.BatchExecOpts{})
+				if err != nil {
+					destdb.Db.Close()
+					return nil, fmt.Errorf("unable to exec clickhouse init statements: %w", err)
+				}
+			}
+			// truncate statements
+			if sqlopts.TruncateBeforeInsert {
+				tableTruncate := []string{}
+				for table := range uniqueTables {
+					schema, table := sqlmanager_shared.SplitTableKey(table)
+					tableTruncate = append(tableTruncate, sqlmanager_clickhouse.BuildTruncateStatement(schema, table))
+				}
+				slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(tableTruncate)))
+				err = destdb.Db.BatchExec(ctx, batchSizeConst, tableTruncate, &sqlmanager_shared.BatchExecOpts{})
+				if err != nil {
+					destdb.Db.Close()
+					return nil, fmt.Errorf("unable to exec clickhouse truncate statements: %w", err)
+				}
+			}
+			destdb.Db.Close()
 		default:
 			return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.ConnectionConfig.Config)
 		}
@@ -313,6 +361,11 @@ func getSqlJobDestinationOpts(
 			TruncateBeforeInsert: opts.MssqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			InitSchema:           opts.MssqlOptions.GetInitTableSchema(),
 		}, nil
+	case *mgmtv1alpha1.JobDestinationOptions_ClickhouseOptions:
+		return &sqlJobDestinationOpts{
+			TruncateBeforeInsert: opts.ClickhouseOptions.GetTruncateTable().GetTruncateBeforeInsert(),
+			InitSchema:           opts.ClickhouseOptions.GetInitTableSchema(),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported job destination options type: %T", opts)
 	}
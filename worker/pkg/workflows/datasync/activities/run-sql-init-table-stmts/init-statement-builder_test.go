@@ -9,6 +9,7 @@ import (
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"github.com/stretchr/testify/assert"
@@ -96,12 +97,12 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: fkconnectionId,
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   connectionId,
 			Name: "prod",
@@ -117,12 +118,12 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 		},
 	}), nil)
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: connectionId,
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   connectionId,
 			Name: "stage",
@@ -154,7 +155,7 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -232,12 +233,12 @@ func Test_InitStatementBuilder_Pg_Generate_NoInitStatement(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: "456",
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   "456",
 			Name: "stage",
@@ -255,7 +256,7 @@ func Test_InitStatementBuilder_Pg_Generate_NoInitStatement(t *testing.T) {
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSqlDb}, nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -361,12 +362,12 @@ func Test_InitStatementBuilder_Pg_TruncateCascade(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: "456",
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   "456",
 			Name: "stage",
@@ -386,7 +387,7 @@ func Test_InitStatementBuilder_Pg_TruncateCascade(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, mock.MatchedBy(func(query []string) bool { return compareSlices(query, stmts) }), &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -492,12 +493,12 @@ func Test_InitStatementBuilder_Pg_Truncate(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: "456",
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   "456",
 			Name: "stage",
@@ -525,7 +526,7 @@ func Test_InitStatementBuilder_Pg_Truncate(t *testing.T) {
 	mockSqlDb.On("Exec", mock.Anything, "TRUNCATE TABLE \"public\".\"accounts\", \"public\".\"users\";").Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -631,12 +632,12 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: "456",
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   "456",
 			Name: "stage",
@@ -670,7 +671,7 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -758,12 +759,12 @@ func Test_InitStatementBuilder_Mysql_Generate(t *testing.T) {
 		}), nil)
 
 	mockConnectionClient.On(
-		"GetConnection",
+		"GetConnectionUnmasked",
 		mock.Anything,
-		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 			Id: "456",
 		}),
-	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 		Connection: &mgmtv1alpha1.Connection{
 			Id:   "456",
 			Name: "stage",
@@ -781,7 +782,7 @@ func Test_InitStatementBuilder_Mysql_Generate(t *testing.T) {
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSqlDb}, nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mongoconnect.NewMockInterface(t))
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
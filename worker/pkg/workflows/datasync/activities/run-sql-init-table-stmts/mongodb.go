@@ -0,0 +1,107 @@
+package runsqlinittablestmts_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongomanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Initializes and truncates the destination collections of every mongo destination.
+// Collections are initialized with the indexes and validators of the source collection prior to being truncated.
+func (b *initStatementBuilder) runMongoInitStatements(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	sourceConnection *mgmtv1alpha1.Connection,
+	slogger *slog.Logger,
+) error {
+	collections := getUniqueCollections(job)
+
+	for _, destination := range job.GetDestinations() {
+		opts := destination.GetOptions().GetMongodbOptions()
+		if !opts.GetInitCollection() && !opts.GetTruncateBeforeInsert() {
+			slogger.Info("skipping collection truncate and init as none were set to true")
+			continue
+		}
+		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.GetConnectionId())
+		if err != nil {
+			return fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if destinationConnection.GetConnectionConfig().GetMongoConfig() == nil {
+			return fmt.Errorf("unsupported destination connection config for mongodb source: %T", destinationConnection.GetConnectionConfig().GetConfig())
+		}
+
+		destClient, err := b.openMongoClient(ctx, destinationConnection, slogger)
+		if err != nil {
+			return err
+		}
+
+		if opts.GetInitCollection() {
+			sourceClient, err := b.openMongoClient(ctx, sourceConnection, slogger)
+			if err != nil {
+				_ = destClient.Disconnect(ctx)
+				return err
+			}
+			slogger.Info(fmt.Sprintf("initializing %d mongodb collections", len(collections)))
+			for _, collection := range collections {
+				err := mongomanager.InitCollection(ctx, sourceClient.Database(collection.Schema), destClient.Database(collection.Schema), collection.Table)
+				if err != nil {
+					_ = sourceClient.Disconnect(ctx)
+					_ = destClient.Disconnect(ctx)
+					return err
+				}
+			}
+			_ = sourceClient.Disconnect(ctx)
+		}
+
+		if opts.GetTruncateBeforeInsert() {
+			slogger.Info(fmt.Sprintf("truncating %d mongodb collections", len(collections)))
+			for _, collection := range collections {
+				if err := mongomanager.TruncateCollection(ctx, destClient.Database(collection.Schema), collection.Table); err != nil {
+					_ = destClient.Disconnect(ctx)
+					return err
+				}
+			}
+		}
+		_ = destClient.Disconnect(ctx)
+	}
+	return nil
+}
+
+func (b *initStatementBuilder) openMongoClient(
+	ctx context.Context,
+	connection *mgmtv1alpha1.Connection,
+	slogger *slog.Logger,
+) (*mongo.Client, error) {
+	container, err := b.mongoconnector.NewFromConnectionConfig(connection.GetConnectionConfig(), slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create mongodb client for connection (%s): %w", connection.GetId(), err)
+	}
+	client, err := container.Open(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open mongodb connection (%s): %w", connection.GetId(), err)
+	}
+	return client, nil
+}
+
+// Returns the database and collection of every mapped collection. Mongo job mappings store the database in the schema field.
+func getUniqueCollections(job *mgmtv1alpha1.Job) []*sqlmanager_shared.SchemaTable {
+	tableKeys := []string{}
+	for tableKey := range shared.GetUniqueTablesMapFromJob(job) {
+		tableKeys = append(tableKeys, tableKey)
+	}
+	sort.Strings(tableKeys)
+
+	collections := make([]*sqlmanager_shared.SchemaTable, len(tableKeys))
+	for idx, tableKey := range tableKeys {
+		database, collection := sqlmanager_shared.SplitTableKey(tableKey)
+		collections[idx] = &sqlmanager_shared.SchemaTable{Schema: database, Table: collection}
+	}
+	return collections
+}
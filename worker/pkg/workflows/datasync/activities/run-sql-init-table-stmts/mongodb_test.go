@@ -0,0 +1,23 @@
+package runsqlinittablestmts_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getUniqueCollections(t *testing.T) {
+	actual := getUniqueCollections(&mgmtv1alpha1.Job{
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "app", Table: "users", Column: "_id"},
+			{Schema: "app", Table: "users", Column: "email"},
+			{Schema: "app", Table: "orders", Column: "_id"},
+		},
+	})
+	require.Equal(t, []*sqlmanager_shared.SchemaTable{
+		{Schema: "app", Table: "orders"},
+		{Schema: "app", Table: "users"},
+	}, actual)
+}
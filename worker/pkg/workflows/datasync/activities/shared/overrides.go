@@ -0,0 +1,193 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Wraps the job run overrides so that they are encoded with protojson when passed through temporal.
+// The default json encoding does not support the oneofs found in the destination options.
+type JobRunOverrides struct {
+	*mgmtv1alpha1.JobRunOverrides
+}
+
+func (o *JobRunOverrides) MarshalJSON() ([]byte, error) {
+	if o == nil || o.JobRunOverrides == nil {
+		return []byte("null"), nil
+	}
+	return protojson.Marshal(o.JobRunOverrides)
+}
+
+func (o *JobRunOverrides) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.JobRunOverrides = nil
+		return nil
+	}
+	overrides := &mgmtv1alpha1.JobRunOverrides{}
+	if err := protojson.Unmarshal(data, overrides); err != nil {
+		return err
+	}
+	o.JobRunOverrides = overrides
+	return nil
+}
+
+// Returns the overrides, or nil if the wrapper is nil
+func (o *JobRunOverrides) Get() *mgmtv1alpha1.JobRunOverrides {
+	if o == nil {
+		return nil
+	}
+	return o.JobRunOverrides
+}
+
+var _ json.Marshaler = (*JobRunOverrides)(nil)
+var _ json.Unmarshaler = (*JobRunOverrides)(nil)
+
+// Returns a copy of the job with the run overrides applied in place of the stored job definition.
+// The job is returned as is if there are no overrides.
+func ApplyJobRunOverrides(job *mgmtv1alpha1.Job, overrides *mgmtv1alpha1.JobRunOverrides) (*mgmtv1alpha1.Job, error) {
+	if overrides == nil {
+		return job, nil
+	}
+	job = proto.Clone(job).(*mgmtv1alpha1.Job)
+
+	mappedTables := GetUniqueTablesFromMappings(job.GetMappings())
+	for _, tableOverride := range overrides.GetTables() {
+		tableName := sqlmanager_shared.BuildTable(tableOverride.GetSchema(), tableOverride.GetTable())
+		if tableOverride.WhereClause == nil && tableOverride.RowCount == nil {
+			return nil, fmt.Errorf("override for table %s must provide a where clause or row count", tableName)
+		}
+		if tableOverride.WhereClause != nil {
+			if _, ok := mappedTables[tableName]; !ok {
+				return nil, fmt.Errorf("unable to override where clause: table %s is not mapped by the job", tableName)
+			}
+			err := setSourceTableWhereClause(job.GetSource().GetOptions(), tableOverride.GetSchema(), tableOverride.GetTable(), tableOverride.GetWhereClause())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if tableOverride.RowCount != nil {
+			err := setGenerateTableRowCount(job.GetSource().GetOptions(), tableOverride.GetSchema(), tableOverride.GetTable(), tableOverride.GetRowCount())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, destinationOverride := range overrides.GetDestinations() {
+		var destination *mgmtv1alpha1.JobDestination
+		for _, dest := range job.GetDestinations() {
+			if dest.GetId() == destinationOverride.GetDestinationId() {
+				destination = dest
+				break
+			}
+		}
+		if destination == nil {
+			return nil, fmt.Errorf("unable to override destination: destination %s does not exist in job", destinationOverride.GetDestinationId())
+		}
+		if destinationOverride.ConnectionId != nil {
+			destination.ConnectionId = destinationOverride.GetConnectionId()
+		}
+		if destinationOverride.GetOptions() != nil {
+			destination.Options = destinationOverride.GetOptions()
+		}
+	}
+	return job, nil
+}
+
+// Sets the where clause of the source table, adding the table to the source options if it is not present.
+// An empty where clause removes the where clause of the table.
+func setSourceTableWhereClause(options *mgmtv1alpha1.JobSourceOptions, schema, table, whereClause string) error {
+	var where *string
+	if whereClause != "" {
+		where = &whereClause
+	}
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		var schemaOpt *mgmtv1alpha1.PostgresSourceSchemaOption
+		for _, opt := range config.Postgres.GetSchemas() {
+			if opt.GetSchema() == schema {
+				schemaOpt = opt
+				break
+			}
+		}
+		if schemaOpt == nil {
+			schemaOpt = &mgmtv1alpha1.PostgresSourceSchemaOption{Schema: schema}
+			config.Postgres.Schemas = append(config.Postgres.Schemas, schemaOpt)
+		}
+		for _, tableOpt := range schemaOpt.GetTables() {
+			if tableOpt.GetTable() == table {
+				tableOpt.WhereClause = where
+				return nil
+			}
+		}
+		schemaOpt.Tables = append(schemaOpt.Tables, &mgmtv1alpha1.PostgresSourceTableOption{Table: table, WhereClause: where})
+		return nil
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		var schemaOpt *mgmtv1alpha1.MysqlSourceSchemaOption
+		for _, opt := range config.Mysql.GetSchemas() {
+			if opt.GetSchema() == schema {
+				schemaOpt = opt
+				break
+			}
+		}
+		if schemaOpt == nil {
+			schemaOpt = &mgmtv1alpha1.MysqlSourceSchemaOption{Schema: schema}
+			config.Mysql.Schemas = append(config.Mysql.Schemas, schemaOpt)
+		}
+		for _, tableOpt := range schemaOpt.GetTables() {
+			if tableOpt.GetTable() == table {
+				tableOpt.WhereClause = where
+				return nil
+			}
+		}
+		schemaOpt.Tables = append(schemaOpt.Tables, &mgmtv1alpha1.MysqlSourceTableOption{Table: table, WhereClause: where})
+		return nil
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		var schemaOpt *mgmtv1alpha1.MssqlSourceSchemaOption
+		for _, opt := range config.Mssql.GetSchemas() {
+			if opt.GetSchema() == schema {
+				schemaOpt = opt
+				break
+			}
+		}
+		if schemaOpt == nil {
+			schemaOpt = &mgmtv1alpha1.MssqlSourceSchemaOption{Schema: schema}
+			config.Mssql.Schemas = append(config.Mssql.Schemas, schemaOpt)
+		}
+		for _, tableOpt := range schemaOpt.GetTables() {
+			if tableOpt.GetTable() == table {
+				tableOpt.WhereClause = where
+				return nil
+			}
+		}
+		schemaOpt.Tables = append(schemaOpt.Tables, &mgmtv1alpha1.MssqlSourceTableOption{Table: table, WhereClause: where})
+		return nil
+	default:
+		return fmt.Errorf("where clause overrides are only supported for postgres, mysql and mssql sources")
+	}
+}
+
+// Sets the number of rows that are generated for the table
+func setGenerateTableRowCount(options *mgmtv1alpha1.JobSourceOptions, schema, table string, rowCount int64) error {
+	generate := options.GetGenerate()
+	if generate == nil {
+		return fmt.Errorf("row count overrides are only supported for generate sources")
+	}
+	for _, schemaOpt := range generate.GetSchemas() {
+		if schemaOpt.GetSchema() != schema {
+			continue
+		}
+		for _, tableOpt := range schemaOpt.GetTables() {
+			if tableOpt.GetTable() == table {
+				tableOpt.RowCount = rowCount
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unable to override row count: table %s is not generated by the job", sqlmanager_shared.BuildTable(schema, table))
+}
@@ -0,0 +1,174 @@
+package shared
+
+import (
+	"encoding/json"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newPostgresJob() *mgmtv1alpha1.Job {
+	return &mgmtv1alpha1.Job{
+		Id: "job-id",
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+					Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+						ConnectionId: "source-id",
+						Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+							{Schema: "public", Tables: []*mgmtv1alpha1.PostgresSourceTableOption{{Table: "users", WhereClause: Ptr("id > 10")}}},
+						},
+					},
+				},
+			},
+		},
+		Destinations: []*mgmtv1alpha1.JobDestination{
+			{
+				Id:           "dest-id",
+				ConnectionId: "dest-conn-id",
+				Options: &mgmtv1alpha1.JobDestinationOptions{
+					Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+						PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{},
+					},
+				},
+			},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "orders", Column: "id"},
+		},
+	}
+}
+
+func Test_ApplyJobRunOverrides_Nil(t *testing.T) {
+	job := newPostgresJob()
+	actual, err := ApplyJobRunOverrides(job, nil)
+	require.NoError(t, err)
+	require.Same(t, job, actual)
+}
+
+func Test_ApplyJobRunOverrides_WhereClauses(t *testing.T) {
+	job := newPostgresJob()
+	original := proto.Clone(job)
+
+	actual, err := ApplyJobRunOverrides(job, &mgmtv1alpha1.JobRunOverrides{
+		Tables: []*mgmtv1alpha1.JobRunTableOverride{
+			{Schema: "public", Table: "users", WhereClause: Ptr("tenant_id = 42")},
+			{Schema: "public", Table: "orders", WhereClause: Ptr("tenant_id = 42")},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(original, job), "the stored job must not be modified")
+	require.Equal(t, []*mgmtv1alpha1.PostgresSourceTableOption{
+		{Table: "users", WhereClause: Ptr("tenant_id = 42")},
+		{Table: "orders", WhereClause: Ptr("tenant_id = 42")},
+	}, actual.GetSource().GetOptions().GetPostgres().GetSchemas()[0].GetTables())
+
+	actual, err = ApplyJobRunOverrides(job, &mgmtv1alpha1.JobRunOverrides{
+		Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "users", WhereClause: Ptr("")}},
+	})
+	require.NoError(t, err)
+	require.Nil(t, actual.GetSource().GetOptions().GetPostgres().GetSchemas()[0].GetTables()[0].WhereClause)
+}
+
+func Test_ApplyJobRunOverrides_Destinations(t *testing.T) {
+	job := newPostgresJob()
+	options := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+			PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+				TruncateTable: &mgmtv1alpha1.PostgresTruncateTableConfig{TruncateBeforeInsert: true, Cascade: true},
+			},
+		},
+	}
+	actual, err := ApplyJobRunOverrides(job, &mgmtv1alpha1.JobRunOverrides{
+		Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{
+			{DestinationId: "dest-id", ConnectionId: Ptr("staging-conn-id"), Options: options},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "staging-conn-id", actual.GetDestinations()[0].GetConnectionId())
+	require.True(t, proto.Equal(options, actual.GetDestinations()[0].GetOptions()))
+	require.Equal(t, "dest-conn-id", job.GetDestinations()[0].GetConnectionId())
+}
+
+func Test_ApplyJobRunOverrides_RowCount(t *testing.T) {
+	job := &mgmtv1alpha1.Job{
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Generate{
+					Generate: &mgmtv1alpha1.GenerateSourceOptions{
+						Schemas: []*mgmtv1alpha1.GenerateSourceSchemaOption{
+							{Schema: "public", Tables: []*mgmtv1alpha1.GenerateSourceTableOption{{Table: "users", RowCount: 10}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	actual, err := ApplyJobRunOverrides(job, &mgmtv1alpha1.JobRunOverrides{
+		Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "users", RowCount: Ptr(int64(500))}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), actual.GetSource().GetOptions().GetGenerate().GetSchemas()[0].GetTables()[0].GetRowCount())
+
+	_, err = ApplyJobRunOverrides(job, &mgmtv1alpha1.JobRunOverrides{
+		Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "orders", RowCount: Ptr(int64(500))}},
+	})
+	require.Error(t, err)
+}
+
+func Test_ApplyJobRunOverrides_Errors(t *testing.T) {
+	tests := map[string]*mgmtv1alpha1.JobRunOverrides{
+		"unmapped table": {
+			Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "items", WhereClause: Ptr("id > 1")}},
+		},
+		"empty table override": {
+			Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "users"}},
+		},
+		"row count on sql source": {
+			Tables: []*mgmtv1alpha1.JobRunTableOverride{{Schema: "public", Table: "users", RowCount: Ptr(int64(1))}},
+		},
+		"unknown destination": {
+			Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{{DestinationId: "other-id", ConnectionId: Ptr("conn-id")}},
+		},
+	}
+	for name, overrides := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ApplyJobRunOverrides(newPostgresJob(), overrides)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_JobRunOverrides_Json(t *testing.T) {
+	type request struct {
+		JobId     string
+		Overrides *JobRunOverrides
+	}
+	overrides := &mgmtv1alpha1.JobRunOverrides{
+		Destinations: []*mgmtv1alpha1.JobRunDestinationOverride{
+			{
+				DestinationId: "dest-id",
+				Options: &mgmtv1alpha1.JobDestinationOptions{
+					Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+						PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{InitTableSchema: true},
+					},
+				},
+			},
+		},
+	}
+
+	bits, err := json.Marshal(&request{JobId: "job-id", Overrides: &JobRunOverrides{JobRunOverrides: overrides}})
+	require.NoError(t, err)
+	var actual request
+	require.NoError(t, json.Unmarshal(bits, &actual))
+	require.True(t, proto.Equal(overrides, actual.Overrides.Get()))
+
+	bits, err = json.Marshal(&request{JobId: "job-id"})
+	require.NoError(t, err)
+	actual = request{}
+	require.NoError(t, json.Unmarshal(bits, &actual))
+	require.Nil(t, actual.Overrides.Get())
+}
@@ -228,7 +228,7 @@ func GetConnectionById(
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 	connectionId string,
 ) (*mgmtv1alpha1.Connection, error) {
-	getConnResp, err := connclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+	getConnResp, err := connclient.GetConnectionUnmasked(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{
 		Id: connectionId,
 	}))
 	if err != nil {
@@ -11,7 +11,9 @@ import (
 	_ "github.com/warpstreamlabs/bento/public/components/io"
 
 	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/javascript"
+	_ "github.com/warpstreamlabs/bento/public/components/avro"
 	_ "github.com/warpstreamlabs/bento/public/components/aws"
+	_ "github.com/warpstreamlabs/bento/public/components/kafka"
 	_ "github.com/warpstreamlabs/bento/public/components/mongodb"
 	_ "github.com/warpstreamlabs/bento/public/components/pure"
 	_ "github.com/warpstreamlabs/bento/public/components/pure/extended"
@@ -24,6 +26,7 @@ import (
 	"github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager/providers/mongoprovider"
 	"github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager/providers/sqlprovider"
 	benthos_environment "github.com/nucleuscloud/neosync/worker/pkg/benthos/environment"
+	benthos_metrics "github.com/nucleuscloud/neosync/worker/pkg/benthos/metrics"
 	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/redis"
 	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
 
@@ -41,6 +44,7 @@ import (
 
 	"github.com/google/uuid"
 	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 )
 
 type SyncMetadata struct {
@@ -59,6 +63,8 @@ type SyncRequest struct {
 type SyncResponse struct {
 	Schema string
 	Table  string
+	// The final progress of the sync. The same progress is recorded as heartbeat details while the sync is running.
+	Progress *benthos_metrics.Progress
 }
 
 func New(
@@ -143,6 +149,7 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	}
 	logger := log.With(activity.GetLogger(ctx), loggerKeyVals...)
 	slogger := neosynclogger.NewJsonSLogger().With(loggerKeyVals...)
+	progress := benthos_metrics.NewProgressTracker()
 	stopActivityChan := make(chan error, 3)
 	resultChan := make(chan error, 1)
 	benthosStreamMutex := sync.Mutex{}
@@ -197,7 +204,7 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 				benthosStreamMutex.Unlock()
 				return
 			case <-time.After(1 * time.Second):
-				activity.RecordHeartbeat(ctx)
+				activity.RecordHeartbeat(ctx, progress.Progress())
 			}
 		}
 	}()
@@ -264,7 +271,8 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	}
 
 	benthosenv, err := benthos_environment.New(&benthos_environment.RegisterConfig{
-		Meter: a.meter,
+		Meter:    a.meter,
+		Progress: progress,
 		SqlConfig: &benthos_environment.SqlConfig{
 			Provider: newSqlPoolProvider(getSqlPoolProviderGetter(tunnelmanager, &dsnToConnectionIdMap, connectionMap, session, slogger)),
 			IsRetry:  isRetry,
@@ -297,6 +305,14 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 		streamBuilderMu.Unlock()
 		return nil, fmt.Errorf("unable to convert benthos config to yaml for stream builder: %w", err)
 	}
+	// metrics are required to report the progress of the sync, even if they are not being exported
+	if !hasMetricsConfig(benthosConfig) {
+		err = streambldr.SetMetricsYAML("otel_collector: {}")
+		if err != nil {
+			streamBuilderMu.Unlock()
+			return nil, fmt.Errorf("unable to set benthos metrics config: %w", err)
+		}
+	}
 
 	stream, err := a.benthosStreamManager.NewBenthosStreamFromBuilder(streambldr)
 	streamBuilderMu.Unlock()
@@ -329,8 +345,9 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	benthosStream = nil
 	benthosStreamMutex.Unlock()
 
-	logger.Info("sync complete")
-	return &SyncResponse{Schema: metadata.Schema, Table: metadata.Table}, nil
+	finalProgress := progress.Progress()
+	logger.Info("sync complete", "rowsRead", finalProgress.RowsRead, "rowsWritten", finalProgress.RowsWritten)
+	return &SyncResponse{Schema: metadata.Schema, Table: metadata.Table, Progress: finalProgress}, nil
 }
 
 func getConnectionsFromBenthosDsns(
@@ -345,7 +362,7 @@ func getConnectionsFromBenthosDsns(
 		idx := idx
 		bdns := bdns
 		errgrp.Go(func() error {
-			resp, err := connclient.GetConnection(errctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: bdns.ConnectionId}))
+			resp, err := connclient.GetConnectionUnmasked(errctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionUnmaskedRequest{Id: bdns.ConnectionId}))
 			if err != nil {
 				return fmt.Errorf("failed to retrieve connection: %w", err)
 			}
@@ -359,6 +376,16 @@ func getConnectionsFromBenthosDsns(
 	return connections, nil
 }
 
+func hasMetricsConfig(benthosConfig string) bool {
+	var config struct {
+		Metrics map[string]any `yaml:"metrics"`
+	}
+	if err := yaml.Unmarshal([]byte(benthosConfig), &config); err != nil {
+		return false
+	}
+	return len(config.Metrics) > 0
+}
+
 func getEnvVarLookupFn(input map[string]string) func(key string) (string, bool) {
 	return func(key string) (string, bool) {
 		if input == nil {
@@ -71,6 +71,9 @@ output:
 	res := &SyncResponse{}
 	err = val.Get(res)
 	require.NoError(t, err)
+	require.NotNil(t, res.Progress)
+	require.Equal(t, int64(1), res.Progress.RowsRead)
+	require.Equal(t, int64(1), res.Progress.RowsWritten)
 }
 
 func Test_Sync_Run_No_BenthosConfig(t *testing.T) {
@@ -508,3 +511,9 @@ func startHTTPServer(tb testing.TB, h http.Handler) *httptest.Server {
 	tb.Cleanup(srv.Close)
 	return srv
 }
+
+func Test_hasMetricsConfig(t *testing.T) {
+	require.True(t, hasMetricsConfig("input:\n  generate: {}\nmetrics:\n  otel_collector: {}\n"))
+	require.False(t, hasMetricsConfig("input:\n  generate: {}\n"))
+	require.False(t, hasMetricsConfig("not: [valid"))
+}
@@ -1,13 +1,18 @@
 package datasync_workflow
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	notifyjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/notify-job-run"
+	recordjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/record-job-run"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
@@ -22,12 +27,138 @@ import (
 
 type WorkflowRequest struct {
 	JobId string
+	// Overrides of the job definition that only apply to this run. Unset for scheduled runs.
+	Overrides *shared.JobRunOverrides
 }
 
 type WorkflowResponse struct{}
 
+// Workflow change ids. Runs that were started by an older worker replay without the activities guarded by these changes.
+const (
+	recordJobRunChangeId      = "record-job-run"
+	notifyJobRunChangeId      = "notify-job-run"
+	notifySchemaDriftChangeId = "notify-schema-drift"
+)
+
+// the most unmapped columns that are listed in a schema drift notification
+const maxSchemaDriftColumns = 10
+
 func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
 	wfinfo := workflow.GetInfo(wfctx)
+	logger := log.With(workflow.GetLogger(wfctx), "jobId", req.JobId)
+
+	shouldRecordJobRun := workflow.GetVersion(wfctx, recordJobRunChangeId, workflow.DefaultVersion, 1) != workflow.DefaultVersion
+	shouldNotifyJobRun := workflow.GetVersion(wfctx, notifyJobRunChangeId, workflow.DefaultVersion, 1) != workflow.DefaultVersion
+
+	startedAt := wfinfo.WorkflowStartTime
+	if shouldRecordJobRun {
+		recordJobRun(wfctx, logger, &recordjobrun_activity.RecordJobRunRequest{
+			JobId:      req.JobId,
+			WorkflowId: wfinfo.WorkflowExecution.ID,
+			Status:     mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+			StartedAt:  startedAt,
+		})
+	}
+	if shouldNotifyJobRun {
+		notifyJobRun(wfctx, logger, &notifyjobrun_activity.NotifyJobRunRequest{
+			JobId:      req.JobId,
+			WorkflowId: wfinfo.WorkflowExecution.ID,
+			EventType:  mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED,
+		})
+	}
+
+	tables := newTableSummaries()
+	drift := &schemaDrift{}
+	resp, err := syncData(wfctx, req, tables, drift)
+
+	completedAt := workflow.Now(wfctx)
+	finalRun := &recordjobrun_activity.RecordJobRunRequest{
+		JobId:       req.JobId,
+		WorkflowId:  wfinfo.WorkflowExecution.ID,
+		Status:      mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE,
+		StartedAt:   startedAt,
+		CompletedAt: &completedAt,
+		Tables:      tables.list(),
+	}
+	if err != nil {
+		finalRun.Status = mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED
+		if temporal.IsCanceledError(err) || errors.Is(wfctx.Err(), workflow.ErrCanceled) {
+			finalRun.Status = mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED
+		}
+		errMsg := err.Error()
+		finalRun.ErrorMessage = &errMsg
+	}
+	// the workflow context is already canceled if the run was canceled, so a disconnected context is used to still record the outcome
+	disconnectedCtx, cancel := workflow.NewDisconnectedContext(wfctx)
+	defer cancel()
+	if shouldRecordJobRun {
+		recordJobRun(disconnectedCtx, logger, finalRun)
+	}
+	if err != nil && isSchemaDriftError(err) {
+		drift.message = finalRun.ErrorMessage
+	}
+	if drift.message != nil && shouldNotifyJobRun &&
+		workflow.GetVersion(wfctx, notifySchemaDriftChangeId, workflow.DefaultVersion, 1) != workflow.DefaultVersion {
+		notifyJobRun(disconnectedCtx, logger, &notifyjobrun_activity.NotifyJobRunRequest{
+			JobId:        req.JobId,
+			WorkflowId:   wfinfo.WorkflowExecution.ID,
+			EventType:    mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED,
+			ErrorMessage: drift.message,
+		})
+	}
+	if eventType, ok := getCompletedEventType(finalRun.Status, err); ok && shouldNotifyJobRun {
+		notifyJobRun(disconnectedCtx, logger, &notifyjobrun_activity.NotifyJobRunRequest{
+			JobId:        req.JobId,
+			WorkflowId:   wfinfo.WorkflowExecution.ID,
+			EventType:    eventType,
+			ErrorMessage: finalRun.ErrorMessage,
+		})
+	}
+	return resp, err
+}
+
+// Returns the notification event for the outcome of the job run. Canceled runs do not send a notification.
+func getCompletedEventType(status mgmtv1alpha1.JobRunStatus, err error) (mgmtv1alpha1.NotificationEventType, bool) {
+	switch {
+	case status == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE:
+		return mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED, true
+	case status == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED && temporal.IsTimeoutError(err):
+		return mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT, true
+	case status == mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED:
+		return mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED, true
+	default:
+		return mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_UNSPECIFIED, false
+	}
+}
+
+// Describes how the source schema has drifted from the job mappings, if at all
+type schemaDrift struct {
+	message *string
+}
+
+func (s *schemaDrift) setUnmappedColumns(columns []string) {
+	if len(columns) == 0 {
+		return
+	}
+	listed := columns
+	if len(listed) > maxSchemaDriftColumns {
+		listed = listed[:maxSchemaDriftColumns]
+	}
+	msg := fmt.Sprintf("found %d source columns without a job mapping that were not synced: %s", len(columns), strings.Join(listed, ", "))
+	if len(columns) > len(listed) {
+		msg = fmt.Sprintf("%s and %d more", msg, len(columns)-len(listed))
+	}
+	s.message = &msg
+}
+
+// Returns true if the job failed because the source schema no longer matches the job mappings
+func isSchemaDriftError(err error) bool {
+	var applicationErr *temporal.ApplicationError
+	return errors.As(err, &applicationErr) && applicationErr.Type() == genbenthosconfigs_activity.SchemaDriftErrorType
+}
+
+func syncData(wfctx workflow.Context, req *WorkflowRequest, tables *tableSummaries, drift *schemaDrift) (*WorkflowResponse, error) {
+	wfinfo := workflow.GetInfo(wfctx)
 
 	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
 		StartToCloseTimeout: 5 * time.Minute,
@@ -52,11 +183,13 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	err := workflow.ExecuteActivity(ctx, genbenthosactivity.GenerateBenthosConfigs, &genbenthosconfigs_activity.GenerateBenthosConfigsRequest{
 		JobId:      req.JobId,
 		WorkflowId: wfinfo.WorkflowExecution.ID,
+		Overrides:  req.Overrides,
 	}).Get(ctx, &bcResp)
 	if err != nil {
 		return nil, err
 	}
 	logger = log.With(logger, "accountId", bcResp.AccountId)
+	drift.setUnmappedColumns(bcResp.UnmappedColumns)
 
 	if len(bcResp.BenthosConfigs) == 0 {
 		logger.Info("found 0 benthos configs, ending workflow.")
@@ -90,6 +223,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	err = workflow.ExecuteActivity(ctx, runSqlInitTableStatements.RunSqlInitTableStatements, &runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest{
 		JobId:      req.JobId,
 		WorkflowId: wfinfo.WorkflowExecution.ID,
+		Overrides:  req.Overrides,
 	}).Get(ctx, &resp)
 	if err != nil {
 		return nil, err
@@ -123,6 +257,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 		workselector.AddFuture(future, func(f workflow.Future) {
 			var result sync_activity.SyncResponse
 			err := f.Get(childctx, &result)
+			tables.add(&result)
 			if err != nil {
 				logger.Error("activity did not complete", "err", err)
 				redisErr := runRedisCleanUpActivity(wfctx, logger, actOptResp, map[string]map[string][]string{}, req.JobId, wfinfo.WorkflowExecution.ID, redisConfigs)
@@ -172,6 +307,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 			workselector.AddFuture(future, func(f workflow.Future) {
 				var result sync_activity.SyncResponse
 				err := f.Get(childctx, &result)
+				tables.add(&result)
 				if err != nil {
 					logger.Error("activity did not complete", "err", err)
 					redisErr := runRedisCleanUpActivity(wfctx, logger, actOptResp, map[string]map[string][]string{}, req.JobId, wfinfo.WorkflowExecution.ID, redisConfigs)
@@ -195,6 +331,87 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	return &WorkflowResponse{}, nil
 }
 
+// Records the state of the job run in the Neosync run history.
+// This is best effort and will not fail the workflow if the run is unable to be recorded
+func recordJobRun(
+	wfctx workflow.Context,
+	logger log.Logger,
+	req *recordjobrun_activity.RecordJobRunRequest,
+) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+	var resp *recordjobrun_activity.RecordJobRunResponse
+	var recordJobRunActivity *recordjobrun_activity.Activity
+	err := workflow.ExecuteActivity(ctx, recordJobRunActivity.RecordJobRun, req).Get(ctx, &resp)
+	if err != nil {
+		logger.Warn("unable to record job run", "status", req.Status.String(), "err", err)
+	}
+}
+
+// Sends the job run event to the job's notification channels.
+// Failed deliveries are retried but will not fail the workflow.
+func notifyJobRun(
+	wfctx workflow.Context,
+	logger log.Logger,
+	req *notifyjobrun_activity.NotifyJobRunRequest,
+) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 5 * time.Second,
+			MaximumAttempts: 3,
+		},
+	})
+	var resp *notifyjobrun_activity.NotifyJobRunResponse
+	var notifyJobRunActivity *notifyjobrun_activity.Activity
+	err := workflow.ExecuteActivity(ctx, notifyJobRunActivity.NotifyJobRun, req).Get(ctx, &resp)
+	if err != nil {
+		logger.Warn("unable to send job run notification", "event", req.EventType.String(), "err", err)
+	}
+}
+
+// Aggregates the progress of each sync activity into per table row counts.
+// Workflow code is not run concurrently, so no locking is needed.
+type tableSummaries struct {
+	keys   []string
+	tables map[string]*recordjobrun_activity.TableSummary
+}
+
+func newTableSummaries() *tableSummaries {
+	return &tableSummaries{tables: map[string]*recordjobrun_activity.TableSummary{}}
+}
+
+func (t *tableSummaries) add(result *sync_activity.SyncResponse) {
+	if result == nil || (result.Schema == "" && result.Table == "") {
+		return
+	}
+	key := neosync_benthos.BuildBenthosTable(result.Schema, result.Table)
+	summary, ok := t.tables[key]
+	if !ok {
+		summary = &recordjobrun_activity.TableSummary{Schema: result.Schema, Table: result.Table}
+		t.tables[key] = summary
+		t.keys = append(t.keys, key)
+	}
+	if result.Progress != nil {
+		summary.RowsRead += result.Progress.RowsRead
+		summary.RowsWritten += result.Progress.RowsWritten
+		summary.RowsErrored += result.Progress.RowsErrored
+	}
+}
+
+// Returns the table summaries in the order the tables finished syncing
+func (t *tableSummaries) list() []*recordjobrun_activity.TableSummary {
+	output := make([]*recordjobrun_activity.TableSummary, 0, len(t.keys))
+	for _, key := range t.keys {
+		output = append(output, t.tables[key])
+	}
+	return output
+}
+
 func runRedisCleanUpActivity(
 	wfctx workflow.Context,
 	logger log.Logger,
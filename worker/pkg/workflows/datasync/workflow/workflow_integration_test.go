@@ -15,6 +15,7 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
@@ -149,11 +150,11 @@ func (s *IntegrationTestSuite) Test_Workflow_Sync_Postgres() {
 						},
 					))
 
-					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-						mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+						mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 							if r.Msg.GetId() == "c9b6ce58-5c8e-4dce-870d-96841b19d988" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "c9b6ce58-5c8e-4dce-870d-96841b19d988",
 										Name: "source",
@@ -170,7 +171,7 @@ func (s *IntegrationTestSuite) Test_Workflow_Sync_Postgres() {
 								}), nil
 							}
 							if r.Msg.GetId() == "226add85-5751-4232-b085-a0ae93afc7ce" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "226add85-5751-4232-b085-a0ae93afc7ce",
 										Name: "target",
@@ -304,11 +305,11 @@ func (s *IntegrationTestSuite) Test_Workflow_Sync_Mssql() {
 						},
 					))
 
-					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-						mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+						mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 							if r.Msg.GetId() == "c9b6ce58-5c8e-4dce-870d-96841b19d988" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "c9b6ce58-5c8e-4dce-870d-96841b19d988",
 										Name: "source",
@@ -325,7 +326,7 @@ func (s *IntegrationTestSuite) Test_Workflow_Sync_Mssql() {
 								}), nil
 							}
 							if r.Msg.GetId() == "226add85-5751-4232-b085-a0ae93afc7ce" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "226add85-5751-4232-b085-a0ae93afc7ce",
 										Name: "target",
@@ -456,11 +457,11 @@ func (s *IntegrationTestSuite) Test_Workflow_VirtualForeignKeys_Transform() {
 		},
 	))
 
-	mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-		mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+	mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 			if r.Msg.GetId() == "c9b6ce58-5c8e-4dce-870d-96841b19d988" {
-				return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+				return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 					Connection: &mgmtv1alpha1.Connection{
 						Id:   "c9b6ce58-5c8e-4dce-870d-96841b19d988",
 						Name: "source",
@@ -477,7 +478,7 @@ func (s *IntegrationTestSuite) Test_Workflow_VirtualForeignKeys_Transform() {
 				}), nil
 			}
 			if r.Msg.GetId() == "226add85-5751-4232-b085-a0ae93afc7ce" {
-				return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+				return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 					Connection: &mgmtv1alpha1.Connection{
 						Id:   "226add85-5751-4232-b085-a0ae93afc7ce",
 						Name: "target",
@@ -638,11 +639,11 @@ func (s *IntegrationTestSuite) Test_Workflow_Mysql_Sync() {
 						},
 					))
 
-					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-						mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+						mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 							if r.Msg.GetId() == "c9b6ce58-5c8e-4dce-870d-96841b19d988" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "c9b6ce58-5c8e-4dce-870d-96841b19d988",
 										Name: "source",
@@ -659,7 +660,7 @@ func (s *IntegrationTestSuite) Test_Workflow_Mysql_Sync() {
 								}), nil
 							}
 							if r.Msg.GetId() == "226add85-5751-4232-b085-a0ae93afc7ce" {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   "226add85-5751-4232-b085-a0ae93afc7ce",
 										Name: "target",
@@ -823,11 +824,11 @@ func (s *IntegrationTestSuite) Test_Workflow_DynamoDB_Sync() {
 						},
 					))
 
-					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-						mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+						mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 							if r.Msg.GetId() == sourceConnectionId {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   sourceConnectionId,
 										Name: "source",
@@ -843,7 +844,7 @@ func (s *IntegrationTestSuite) Test_Workflow_DynamoDB_Sync() {
 								}), nil
 							}
 							if r.Msg.GetId() == destConnectionId {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   destConnectionId,
 										Name: "target",
@@ -1100,11 +1101,11 @@ func (s *IntegrationTestSuite) Test_Workflow_MongoDB_Sync() {
 						},
 					))
 
-					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
-						mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+					mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+						mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+						func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
 							if r.Msg.GetId() == sourceConnectionId {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   sourceConnectionId,
 										Name: "source",
@@ -1121,7 +1122,7 @@ func (s *IntegrationTestSuite) Test_Workflow_MongoDB_Sync() {
 								}), nil
 							}
 							if r.Msg.GetId() == destConnectionId {
-								return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+								return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
 									Connection: &mgmtv1alpha1.Connection{
 										Id:   destConnectionId,
 										Name: "target",
@@ -1291,6 +1292,120 @@ func getAllMongoDBSyncTests() map[string][]*workflow_testdata.IntegrationTest {
 	return allTests
 }
 
+func (s *IntegrationTestSuite) Test_Workflow_MongoDB_Sync_UpsertKey() {
+	t := s.T()
+	dbName := "data"
+	collectionName := "test-upsert"
+
+	targetId := primitive.NewObjectID()
+	sourceId := primitive.NewObjectID()
+	_, err := s.InsertMongoDbRecords(s.mongodb.source.client, dbName, collectionName, []any{
+		bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "email", Value: "alice@example.com"}, {Key: "name", Value: "Alice Updated"}},
+		bson.D{{Key: "_id", Value: sourceId}, {Key: "email", Value: "bob@example.com"}, {Key: "name", Value: "Bob"}},
+	})
+	require.NoError(t, err)
+	_, err = s.InsertMongoDbRecords(s.mongodb.target.client, dbName, collectionName, []any{
+		bson.D{{Key: "_id", Value: targetId}, {Key: "email", Value: "alice@example.com"}, {Key: "name", Value: "Alice"}},
+	})
+	require.NoError(t, err)
+
+	jobId := "115aaf2c-776e-4847-8268-d914e3c15968"
+	sourceConnectionId := "c9b6ce58-5c8e-4dce-870d-96841b19d988"
+	destConnectionId := "226add85-5751-4232-b085-a0ae93afc7ce"
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{
+		Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+		Config: &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceGetJobProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceGetJobProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
+			return connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+				Job: &mgmtv1alpha1.Job{
+					Id:        jobId,
+					AccountId: "225aaf2c-776e-4847-8268-d914e3c15988",
+					Source: &mgmtv1alpha1.JobSource{
+						Options: &mgmtv1alpha1.JobSourceOptions{
+							Config: &mgmtv1alpha1.JobSourceOptions_Mongodb{
+								Mongodb: &mgmtv1alpha1.MongoDBSourceConnectionOptions{
+									ConnectionId: sourceConnectionId,
+								},
+							},
+						},
+					},
+					Destinations: []*mgmtv1alpha1.JobDestination{
+						{
+							ConnectionId: destConnectionId,
+							Options: &mgmtv1alpha1.JobDestinationOptions{
+								Config: &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
+									MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+										UpsertKeys: []*mgmtv1alpha1.MongoDBDestinationUpsertKey{
+											{Database: dbName, Collection: collectionName, Fields: []string{"email"}},
+										},
+									},
+								},
+							},
+						},
+					},
+					Mappings: []*mgmtv1alpha1.JobMapping{
+						{Schema: dbName, Table: collectionName, Column: "email", Transformer: passthrough},
+						{Schema: dbName, Table: collectionName, Column: "name", Transformer: passthrough},
+					},
+				}}), nil
+		},
+	))
+	mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionUnmaskedProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionUnmaskedRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUnmaskedResponse], error) {
+			url := s.mongodb.source.url
+			if r.Msg.GetId() == destConnectionId {
+				url = s.mongodb.target.url
+			} else if r.Msg.GetId() != sourceConnectionId {
+				return nil, fmt.Errorf("unknown test connection")
+			}
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionUnmaskedResponse{
+				Connection: &mgmtv1alpha1.Connection{
+					Id: r.Msg.GetId(),
+					ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+						Config: &mgmtv1alpha1.ConnectionConfig_MongoConfig{
+							MongoConfig: &mgmtv1alpha1.MongoConnectionConfig{
+								ConnectionConfig: &mgmtv1alpha1.MongoConnectionConfig_Url{Url: url},
+							},
+						},
+					},
+				},
+			}), nil
+		},
+	))
+	addRunContextProcedureMux(mux)
+	srv := startHTTPServer(t, mux)
+	executeWorkflow(t, srv, s.redis.url, jobId, "MongoDB Upsert Key Sync")
+
+	cursor, err := s.mongodb.target.client.Database(dbName).Collection(collectionName).Find(s.ctx, bson.D{})
+	require.NoError(t, err)
+	var results []bson.M
+	require.NoError(t, cursor.All(s.ctx, &results))
+	require.Len(t, results, 2)
+	byEmail := map[string]bson.M{}
+	for _, result := range results {
+		byEmail[result["email"].(string)] = result
+	}
+	// the existing document keeps its _id while the new document is inserted with the source _id
+	require.Equal(t, targetId, byEmail["alice@example.com"]["_id"])
+	require.Equal(t, "Alice Updated", byEmail["alice@example.com"]["name"])
+	require.Equal(t, sourceId, byEmail["bob@example.com"]["_id"])
+	require.Equal(t, "Bob", byEmail["bob@example.com"]["name"])
+
+	// tear down
+	errgrp, errctx := errgroup.WithContext(s.ctx)
+	errgrp.Go(func() error { return s.DropMongoDbCollection(errctx, s.mongodb.source.client, dbName, collectionName) })
+	errgrp.Go(func() error { return s.DropMongoDbCollection(errctx, s.mongodb.target.client, dbName, collectionName) })
+	require.NoError(t, errgrp.Wait())
+}
+
 func executeWorkflow(
 	t *testing.T,
 	srv *httptest.Server,
@@ -1335,13 +1450,15 @@ func executeWorkflow(
 	disableReaper := true
 	syncActivity := sync_activity.New(connclient, jobclient, &sync.Map{}, temporalClientMock, activityMeter, sync_activity.NewBenthosStreamManager(), disableReaper)
 	retrieveActivityOpts := syncactivityopts_activity.New(jobclient)
-	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager)
+	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager, mongoconnect.NewConnector())
 	env.RegisterWorkflow(Workflow)
 	env.RegisterActivity(syncActivity.Sync)
 	env.RegisterActivity(retrieveActivityOpts.RetrieveActivityOptions)
 	env.RegisterActivity(runSqlInitTableStatements.RunSqlInitTableStatements)
 	env.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
 	env.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 	env.SetTestTimeout(600 * time.Second) // increase the test timeout
 
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: jobId})
@@ -3,19 +3,26 @@ package datasync_workflow
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	benthos_metrics "github.com/nucleuscloud/neosync/worker/pkg/benthos/metrics"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	notifyjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/notify-job-run"
+	recordjobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/record-job-run"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
@@ -25,6 +32,8 @@ import (
 func Test_Workflow_BenthosConfigsFails(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	recordedRuns := mockRecordJobRun(env)
+	notifications := mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
@@ -40,12 +49,86 @@ func Test_Workflow_BenthosConfigsFails(t *testing.T) {
 	assert.True(t, errors.As(err, &applicationErr))
 	assert.Equal(t, "TestFailure", applicationErr.Error())
 
+	assert.Len(t, *recordedRuns, 2)
+	finalRun := (*recordedRuns)[1]
+	assert.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, finalRun.Status)
+	assert.NotNil(t, finalRun.CompletedAt)
+	assert.NotNil(t, finalRun.ErrorMessage)
+
+	assert.Len(t, *notifications, 2)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_STARTED, (*notifications)[0].EventType)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED, (*notifications)[1].EventType)
+	assert.Equal(t, finalRun.ErrorMessage, (*notifications)[1].ErrorMessage)
+
 	env.AssertExpectations(t)
 }
 
+func Test_Workflow_BenthosConfigsFails_SchemaDrift(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	notifications := mockNotifyJobRun(env)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(nil, temporal.NewApplicationError("job mappings are not equal to or a subset of the database schema", genbenthosconfigs_activity.SchemaDriftErrorType))
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	assert.Len(t, *notifications, 3)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED, (*notifications)[1].EventType)
+	assert.Contains(t, *(*notifications)[1].ErrorMessage, "job mappings are not equal to or a subset of the database schema")
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED, (*notifications)[2].EventType)
+}
+
+func Test_Workflow_UnmappedColumns_SchemaDrift(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	notifications := mockNotifyJobRun(env)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{
+			BenthosConfigs:  []*genbenthosconfigs_activity.BenthosConfigResponse{},
+			UnmappedColumns: []string{"public.users.email"},
+		}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+
+	assert.Len(t, *notifications, 3)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_SCHEMA_DRIFT_DETECTED, (*notifications)[1].EventType)
+	assert.Equal(t, "found 1 source columns without a job mapping that were not synced: public.users.email", *(*notifications)[1].ErrorMessage)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED, (*notifications)[2].EventType)
+}
+
+func Test_schemaDrift_setUnmappedColumns(t *testing.T) {
+	drift := &schemaDrift{}
+	drift.setUnmappedColumns(nil)
+	assert.Nil(t, drift.message)
+
+	columns := []string{}
+	for i := 0; i < maxSchemaDriftColumns+2; i++ {
+		columns = append(columns, fmt.Sprintf("public.users.col%d", i))
+	}
+	drift.setUnmappedColumns(columns)
+	assert.NotNil(t, drift.message)
+	assert.Contains(t, *drift.message, "found 12 source columns")
+	assert.NotContains(t, *drift.message, "col10")
+	assert.True(t, strings.HasSuffix(*drift.message, "and 2 more"))
+}
+
 func Test_Workflow_Succeeds_Zero_BenthosConfigs(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -66,9 +149,32 @@ func Test_Workflow_Succeeds_Zero_BenthosConfigs(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func Test_Workflow_DefaultVersion_SkipsRunHistoryAndNotifications(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	// runs started by an older worker do not have the version markers
+	env.OnGetVersion(recordJobRunChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(notifyJobRunChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	recordedRuns := mockRecordJobRun(env)
+	notifications := mockNotifyJobRun(env)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{}}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.Empty(t, *recordedRuns)
+	assert.Empty(t, *notifications)
+}
+
 func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	recordedRuns := mockRecordJobRun(env)
+	notifications := mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -90,9 +196,13 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	syncActivity := sync_activity.Activity{}
-	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{
+		Schema:   "public",
+		Table:    "users",
+		Progress: &benthos_metrics.Progress{RowsRead: 10, RowsWritten: 9, RowsErrored: 1},
+	}, nil)
 
-	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
 
 	assert.True(t, env.IsWorkflowCompleted())
 
@@ -104,12 +214,29 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, result, &WorkflowResponse{})
 
+	assert.Len(t, *recordedRuns, 2)
+	assert.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING, (*recordedRuns)[0].Status)
+	assert.Equal(t, "job-id", (*recordedRuns)[0].JobId)
+	assert.Nil(t, (*recordedRuns)[0].CompletedAt)
+	finalRun := (*recordedRuns)[1]
+	assert.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, finalRun.Status)
+	assert.NotNil(t, finalRun.CompletedAt)
+	assert.Nil(t, finalRun.ErrorMessage)
+	assert.Len(t, *notifications, 2)
+	assert.Equal(t, "job-id", (*notifications)[1].JobId)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED, (*notifications)[1].EventType)
+	assert.Equal(t, []*recordjobrun_activity.TableSummary{
+		{Schema: "public", Table: "users", RowsRead: 10, RowsWritten: 9, RowsErrored: 1},
+	}, finalRun.Tables)
+
 	env.AssertExpectations(t)
 }
 
 func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -197,6 +324,8 @@ func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 func Test_Workflow_Follows_Multiple_Dependents(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -307,6 +436,8 @@ func Test_Workflow_Follows_Multiple_Dependents(t *testing.T) {
 func Test_Workflow_Follows_Multiple_Dependent_Redis_Cleanup(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -436,6 +567,8 @@ func Test_Workflow_Follows_Multiple_Dependent_Redis_Cleanup(t *testing.T) {
 func Test_Workflow_Halts_Activities_OnError(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	recordedRuns := mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -526,12 +659,19 @@ func Test_Workflow_Halts_Activities_OnError(t *testing.T) {
 	assert.True(t, errors.As(err, &applicationErr))
 	assert.Equal(t, "TestFailure", applicationErr.Error())
 
+	finalRun := (*recordedRuns)[len(*recordedRuns)-1]
+	assert.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, finalRun.Status)
+	assert.NotNil(t, finalRun.ErrorMessage)
+	assert.Contains(t, *finalRun.ErrorMessage, "TestFailure")
+
 	env.AssertExpectations(t)
 }
 
 func Test_Workflow_Cleans_Up_Redis_OnError(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
+	mockRecordJobRun(env)
+	mockNotifyJobRun(env)
 
 	var genact *genbenthosconfigs_activity.Activity
 	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
@@ -740,3 +880,56 @@ func Test_isReadyForCleanUp(t *testing.T) {
 		"no dependency",
 	)
 }
+
+func Test_tableSummaries(t *testing.T) {
+	tables := newTableSummaries()
+	tables.add(nil)
+	tables.add(&sync_activity.SyncResponse{})
+	tables.add(&sync_activity.SyncResponse{Schema: "public", Table: "users", Progress: &benthos_metrics.Progress{RowsRead: 5, RowsWritten: 5}})
+	tables.add(&sync_activity.SyncResponse{Schema: "public", Table: "accounts"})
+	tables.add(&sync_activity.SyncResponse{Schema: "public", Table: "users", Progress: &benthos_metrics.Progress{RowsWritten: 2, RowsErrored: 1}})
+
+	assert.Equal(t, []*recordjobrun_activity.TableSummary{
+		{Schema: "public", Table: "users", RowsRead: 5, RowsWritten: 7, RowsErrored: 1},
+		{Schema: "public", Table: "accounts"},
+	}, tables.list())
+}
+
+func mockNotifyJobRun(env *testsuite.TestWorkflowEnvironment) *[]*notifyjobrun_activity.NotifyJobRunRequest {
+	sent := []*notifyjobrun_activity.NotifyJobRunRequest{}
+	var notifyJobRunActivity *notifyjobrun_activity.Activity
+	env.OnActivity(notifyJobRunActivity.NotifyJobRun, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *notifyjobrun_activity.NotifyJobRunRequest) (*notifyjobrun_activity.NotifyJobRunResponse, error) {
+			sent = append(sent, req)
+			return &notifyjobrun_activity.NotifyJobRunResponse{}, nil
+		})
+	return &sent
+}
+
+func Test_getCompletedEventType(t *testing.T) {
+	eventType, ok := getCompletedEventType(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, nil)
+	assert.True(t, ok)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_SUCCEEDED, eventType)
+
+	eventType, ok = getCompletedEventType(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, errors.New("test"))
+	assert.True(t, ok)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_FAILED, eventType)
+
+	eventType, ok = getCompletedEventType(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, temporal.NewTimeoutError(enums.TIMEOUT_TYPE_START_TO_CLOSE, nil))
+	assert.True(t, ok)
+	assert.Equal(t, mgmtv1alpha1.NotificationEventType_NOTIFICATION_EVENT_TYPE_JOB_RUN_TIMED_OUT, eventType)
+
+	_, ok = getCompletedEventType(mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED, errors.New("canceled"))
+	assert.False(t, ok)
+}
+
+func mockRecordJobRun(env *testsuite.TestWorkflowEnvironment) *[]*recordjobrun_activity.RecordJobRunRequest {
+	recorded := []*recordjobrun_activity.RecordJobRunRequest{}
+	var recordJobRunActivity *recordjobrun_activity.Activity
+	env.OnActivity(recordJobRunActivity.RecordJobRun, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *recordjobrun_activity.RecordJobRunRequest) (*recordjobrun_activity.RecordJobRunResponse, error) {
+			recorded = append(recorded, req)
+			return &recordjobrun_activity.RecordJobRunResponse{}, nil
+		})
+	return &recorded
+}